@@ -54,60 +54,86 @@ func init() {
 }
 
 var fileDescriptor_cf5ca5e0c737570d = []byte{
-	// 842 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0x4d, 0x6f, 0xd3, 0x48,
-	0x18, 0xc7, 0x33, 0x97, 0xd5, 0x6a, 0xd4, 0x7d, 0xf3, 0xae, 0x56, 0xbb, 0x3d, 0xb8, 0xab, 0xe5,
-	0x9e, 0xa8, 0x05, 0x0a, 0x7d, 0x6f, 0x9a, 0x86, 0x54, 0x22, 0x01, 0x9a, 0xf0, 0x22, 0x71, 0x41,
-	0x93, 0xf8, 0x69, 0x6b, 0xd5, 0x89, 0xcd, 0xcc, 0x38, 0xa5, 0x27, 0xb8, 0x20, 0x21, 0x21, 0x21,
-	0x90, 0x90, 0x90, 0x90, 0x38, 0x71, 0x01, 0x89, 0xcf, 0x80, 0xc4, 0x8d, 0x0b, 0x52, 0x8f, 0x3d,
-	0xd2, 0xf4, 0xc2, 0xb1, 0x1f, 0x01, 0xb9, 0xce, 0x4c, 0xed, 0x64, 0xa8, 0x66, 0x9c, 0xde, 0x9a,
-	0xda, 0xbf, 0xff, 0xf3, 0xcb, 0x13, 0xcf, 0x3c, 0x93, 0xe0, 0x49, 0x0e, 0xed, 0xc0, 0xa7, 0xc4,
-	0x2b, 0x30, 0xa0, 0x5d, 0xa0, 0x05, 0x12, 0xb8, 0x05, 0xe2, 0xb4, 0xdd, 0x4e, 0xf4, 0xda, 0x6d,
-	0x41, 0xa1, 0x3b, 0x59, 0xe8, 0xff, 0x99, 0x0f, 0xa8, 0xcf, 0x7d, 0xeb, 0x9c, 0x40, 0xf2, 0x31,
-	0x92, 0x27, 0x81, 0x9b, 0x4f, 0x22, 0xf9, 0xee, 0xe4, 0xf8, 0xac, 0x4e, 0x2e, 0x85, 0xfb, 0x21,
-	0x30, 0x7e, 0x8f, 0x02, 0x0b, 0xfc, 0x0e, 0xeb, 0x17, 0x98, 0xfa, 0x32, 0x81, 0xc7, 0x8a, 0xd1,
-	0xad, 0x8d, 0xf8, 0x56, 0xeb, 0x35, 0xc2, 0x7f, 0xd6, 0xa1, 0x19, 0xba, 0x9e, 0x53, 0x0b, 0x39,
-	0x69, 0x7a, 0xd0, 0xe0, 0x84, 0x83, 0xb5, 0x94, 0xd7, 0x50, 0xc9, 0x2b, 0xc8, 0x7a, 0x5c, 0x78,
-	0x7c, 0x39, 0x7b, 0x40, 0x6c, 0xfc, 0x7f, 0xce, 0x7a, 0x83, 0xf0, 0x5f, 0xab, 0xc0, 0x5a, 0xd4,
-	0x6d, 0x42, 0xca, 0x4e, 0x2f, 0x5c, 0x85, 0x0a, 0xbd, 0xe2, 0x08, 0x09, 0xd2, 0x2f, 0x6a, 0x9e,
-	0xb8, 0x65, 0xcd, 0x65, 0xdc, 0xa7, 0xbb, 0x6b, 0x3e, 0xe3, 0x9a, 0xcd, 0x53, 0x90, 0x66, 0xcd,
-	0x53, 0x06, 0x48, 0xb9, 0x5d, 0xfc, 0x73, 0x05, 0x78, 0x63, 0x8b, 0x50, 0xc7, 0xba, 0xa0, 0x95,
-	0x27, 0x6e, 0x17, 0x16, 0x17, 0x0d, 0x29, 0x59, 0xfa, 0x21, 0xc6, 0x25, 0xcf, 0x67, 0x10, 0x17,
-	0x9f, 0xd6, 0x8a, 0x39, 0x01, 0x44, 0xf9, 0x4b, 0xc6, 0x9c, 0x14, 0x78, 0x81, 0xf0, 0xef, 0x55,
-	0x97, 0xf1, 0x7e, 0x67, 0x6e, 0x12, 0xb6, 0xcd, 0xac, 0x79, 0xad, 0xbc, 0x41, 0x4c, 0xd8, 0x2c,
-	0x64, 0xa4, 0x93, 0x4d, 0xa9, 0x43, 0xdb, 0xef, 0x42, 0x74, 0x41, 0xb3, 0x29, 0x27, 0x80, 0x59,
-	0x53, 0x92, 0x9c, 0x14, 0xf8, 0x84, 0xf0, 0x7f, 0x15, 0xe0, 0x77, 0x7c, 0xba, 0xbd, 0xe1, 0xf9,
-	0x3b, 0xe5, 0x07, 0xd0, 0x0a, 0xb9, 0xeb, 0x77, 0xea, 0x64, 0xa7, 0xaf, 0x7c, 0x7b, 0xca, 0xaa,
-	0xea, 0x7e, 0xe6, 0xa7, 0xc6, 0x08, 0xdb, 0xda, 0x19, 0xa5, 0xc9, 0xf7, 0xf0, 0x16, 0xe1, 0xbf,
-	0x2b, 0xc0, 0xeb, 0x10, 0x78, 0x6e, 0x8b, 0x44, 0x37, 0xd6, 0x80, 0x31, 0xb2, 0x09, 0xcc, 0x5a,
-	0xd1, 0xad, 0xa5, 0x80, 0x85, 0x6f, 0x69, 0xa4, 0x0c, 0x69, 0xf9, 0x11, 0xe1, 0x89, 0x0a, 0xf0,
-	0x6b, 0xa4, 0x0d, 0x2c, 0x20, 0x2d, 0x50, 0xe9, 0x5e, 0xd5, 0x2d, 0x75, 0x5a, 0x8a, 0xf0, 0xae,
-	0x9e, 0x4d, 0x98, 0x7c, 0x03, 0x1f, 0x10, 0xfe, 0xb7, 0x02, 0x7c, 0xb5, 0xba, 0xae, 0x52, 0x2f,
-	0xeb, 0x56, 0x53, 0xf3, 0x42, 0xfa, 0xca, 0xa8, 0x31, 0x52, 0xf7, 0x09, 0xc2, 0xbf, 0xd4, 0x81,
-	0x04, 0x81, 0xb7, 0x5b, 0xee, 0x42, 0x87, 0x33, 0x6b, 0x46, 0x73, 0x99, 0x24, 0x18, 0xa1, 0x35,
-	0x9b, 0x05, 0x4d, 0x8d, 0x84, 0xa2, 0xe3, 0x34, 0x80, 0xd0, 0xd6, 0x56, 0x91, 0x73, 0xea, 0x36,
-	0x43, 0x0e, 0x4c, 0x73, 0x24, 0x28, 0x48, 0xb3, 0x91, 0xa0, 0x0c, 0x48, 0xad, 0x9e, 0x78, 0x6b,
-	0x18, 0xf2, 0x5b, 0x31, 0xd8, 0x57, 0x7e, 0xa4, 0x58, 0x1a, 0x29, 0x23, 0xd5, 0xc2, 0x68, 0xa8,
-	0x64, 0x6b, 0xa1, 0x82, 0x34, 0x6b, 0xa1, 0x32, 0x40, 0xca, 0x3d, 0x43, 0xf8, 0x37, 0x31, 0x77,
-	0x4b, 0x5e, 0xc8, 0x38, 0x50, 0x6b, 0xce, 0x68, 0x5a, 0xf7, 0x29, 0x21, 0x35, 0x9f, 0x0d, 0x96,
-	0x42, 0x8f, 0x11, 0x1e, 0x8b, 0xa6, 0x4e, 0xff, 0x0a, 0xb3, 0x2e, 0x6b, 0x0f, 0x2a, 0x81, 0x08,
-	0x95, 0x99, 0x0c, 0xa4, 0xf4, 0x78, 0x85, 0xb0, 0x95, 0xb8, 0x54, 0x83, 0x76, 0x33, 0xb2, 0x59,
-	0x34, 0xcd, 0xec, 0x83, 0xc2, 0x69, 0x29, 0x33, 0x2f, 0xcd, 0xde, 0x23, 0xfc, 0x4f, 0xd1, 0x71,
-	0xae, 0xd3, 0x5b, 0x81, 0x73, 0x7c, 0x7e, 0x6b, 0xfb, 0x5c, 0x7e, 0x76, 0xab, 0xba, 0xcb, 0x4a,
-	0x89, 0x0b, 0xcb, 0xf2, 0x88, 0x29, 0xa9, 0x67, 0x3f, 0x5e, 0x20, 0x69, 0xcd, 0x25, 0x83, 0xa5,
-	0xa5, 0x34, 0x5c, 0xce, 0x1e, 0x20, 0xe5, 0x9e, 0x22, 0xfc, 0x6b, 0xbc, 0x1d, 0xcb, 0x51, 0x30,
-	0x6b, 0xb0, 0x87, 0x0f, 0xee, 0xff, 0x73, 0x99, 0xd8, 0xd4, 0x19, 0xef, 0x46, 0x48, 0x37, 0x21,
-	0xe9, 0xa3, 0xb7, 0x9a, 0x06, 0x31, 0xb3, 0x33, 0xde, 0x30, 0x9d, 0x72, 0xaa, 0x41, 0x26, 0xa7,
-	0x41, 0xcc, 0xcc, 0x69, 0x98, 0x4e, 0x7d, 0x89, 0xaa, 0xc3, 0x06, 0x05, 0xb6, 0x25, 0x4e, 0x59,
-	0xf1, 0x79, 0x58, 0xf7, 0x91, 0x18, 0x46, 0xcd, 0xbe, 0x44, 0xa9, 0x13, 0x06, 0x86, 0x12, 0x83,
-	0x8e, 0x93, 0x18, 0xf2, 0xb1, 0xa1, 0xee, 0x50, 0x52, 0xc1, 0xa6, 0x43, 0x49, 0x9d, 0x21, 0x2d,
-	0x5f, 0x22, 0xfc, 0x47, 0x05, 0x78, 0xf4, 0xef, 0xf5, 0x10, 0x42, 0x88, 0x05, 0x17, 0x74, 0x1f,
-	0xe1, 0x34, 0x27, 0xdc, 0x16, 0xb3, 0xe2, 0x42, 0x6b, 0xc5, 0xdb, 0x3b, 0xb0, 0x73, 0xfb, 0x07,
-	0x76, 0xee, 0xe8, 0xc0, 0x46, 0x8f, 0x7a, 0x36, 0x7a, 0xd7, 0xb3, 0xd1, 0xe7, 0x9e, 0x8d, 0xf6,
-	0x7a, 0x36, 0xfa, 0xda, 0xb3, 0xd1, 0xb7, 0x9e, 0x9d, 0x3b, 0xea, 0xd9, 0xe8, 0xf9, 0xa1, 0x9d,
-	0xdb, 0x3b, 0xb4, 0x73, 0xfb, 0x87, 0x76, 0xee, 0xee, 0xf4, 0xa6, 0x7f, 0x52, 0xd9, 0xf5, 0x4f,
-	0xf9, 0x1d, 0x61, 0x2e, 0xf9, 0xba, 0xf9, 0xd3, 0xf1, 0x8f, 0x08, 0xe7, 0xbf, 0x07, 0x00, 0x00,
-	0xff, 0xff, 0x69, 0x17, 0x5e, 0xcb, 0xda, 0x10, 0x00, 0x00,
+	// 1259 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xc6, 0x3d, 0x97, 0xaf, 0xbe, 0x1a, 0xca, 0xaf, 0x05, 0x01, 0xed, 0x61, 0x41, 0xe5, 0xc2,
+	0xc9, 0x21, 0x05, 0xda, 0x26, 0x69, 0x9b, 0x3a, 0x4e, 0xe2, 0x48, 0xb5, 0xdb, 0x64, 0x4d, 0x8a,
+	0xc4, 0x05, 0x8d, 0xbd, 0x6f, 0x92, 0x55, 0xd6, 0x9e, 0x65, 0x66, 0xd6, 0xc5, 0x27, 0x10, 0x12,
+	0x12, 0x12, 0x12, 0x02, 0x09, 0x09, 0x09, 0x09, 0x71, 0x40, 0x42, 0x20, 0x21, 0xc1, 0x5f, 0x80,
+	0xc4, 0x09, 0x8e, 0x39, 0xf6, 0x48, 0x9c, 0x0b, 0xc7, 0xfe, 0x09, 0x68, 0xb3, 0x9e, 0xcd, 0x8e,
+	0x3d, 0xeb, 0xcc, 0xac, 0x73, 0x4b, 0xe4, 0x79, 0x9e, 0xf9, 0xec, 0x63, 0xef, 0xbc, 0xef, 0xbb,
+	0x8b, 0x17, 0x05, 0xf4, 0x22, 0xca, 0x48, 0xb8, 0xc0, 0x81, 0x0d, 0x80, 0x2d, 0x90, 0x28, 0x58,
+	0x20, 0x7e, 0x2f, 0xe8, 0x27, 0xff, 0x07, 0x5d, 0x58, 0x18, 0x2c, 0x2e, 0x8c, 0xff, 0xac, 0x46,
+	0x8c, 0x0a, 0xea, 0xbc, 0x2e, 0x25, 0xd5, 0x54, 0x52, 0x25, 0x51, 0x50, 0xcd, 0x4b, 0xaa, 0x83,
+	0xc5, 0x2b, 0xcb, 0x26, 0xbe, 0x0c, 0x3e, 0x8c, 0x81, 0x8b, 0x0f, 0x18, 0xf0, 0x88, 0xf6, 0xf9,
+	0x78, 0x83, 0x6b, 0x3f, 0x2c, 0xe1, 0x4b, 0xb5, 0x64, 0x69, 0x3b, 0x5d, 0xea, 0x7c, 0x87, 0xf0,
+	0x0b, 0x1e, 0x74, 0xe2, 0x20, 0xf4, 0x5b, 0xb1, 0x20, 0x9d, 0x10, 0xda, 0x82, 0x08, 0x70, 0x56,
+	0xab, 0x06, 0x28, 0x55, 0x8d, 0xd2, 0x4b, 0x37, 0xbe, 0x72, 0xb7, 0xbc, 0x41, 0x4a, 0x7c, 0xb5,
+	0xe2, 0x7c, 0x8f, 0xf0, 0x8b, 0xeb, 0xc0, 0xbb, 0x2c, 0xe8, 0x80, 0x42, 0x67, 0x66, 0xae, 0x93,
+	0x4a, 0xbc, 0xda, 0x1c, 0x0e, 0x19, 0x5f, 0x12, 0x9e, 0x5c, 0xb2, 0x15, 0x70, 0x41, 0xd9, 0x70,
+	0x8b, 0x72, 0x61, 0x18, 0x9e, 0x46, 0x69, 0x17, 0x9e, 0xd6, 0x20, 0x83, 0x1b, 0xe2, 0xff, 0x37,
+	0x40, 0xb4, 0x0f, 0x08, 0xf3, 0x9d, 0xb7, 0x8d, 0xfc, 0xe4, 0x72, 0x49, 0xf1, 0x8e, 0xa5, 0x2a,
+	0xdb, 0xfa, 0x63, 0x8c, 0xeb, 0x21, 0xe5, 0x90, 0x6e, 0x7e, 0xdd, 0xc8, 0xe6, 0x4c, 0x20, 0xb7,
+	0xbf, 0x61, 0xad, 0xcb, 0x00, 0xbe, 0x46, 0xf8, 0xb9, 0x66, 0xc0, 0xc5, 0x38, 0x99, 0x77, 0x09,
+	0x3f, 0xe4, 0xce, 0x2d, 0x23, 0xbf, 0x49, 0x99, 0xa4, 0xb9, 0x5d, 0x52, 0x9d, 0x0f, 0xc5, 0x83,
+	0x1e, 0x1d, 0x40, 0xf2, 0x81, 0x61, 0x28, 0x67, 0x02, 0xbb, 0x50, 0xf2, 0xba, 0x0c, 0xe0, 0x4f,
+	0x84, 0x5f, 0x6b, 0x80, 0x78, 0x8f, 0xb2, 0xc3, 0xbd, 0x90, 0x3e, 0xda, 0xf8, 0x08, 0xba, 0xb1,
+	0x08, 0x68, 0xdf, 0x23, 0x8f, 0xc6, 0xc8, 0x0f, 0xaf, 0x39, 0x4d, 0xd3, 0xef, 0x7c, 0xa6, 0x8d,
+	0xa4, 0x6d, 0x5d, 0x90, 0x5b, 0x76, 0x0d, 0x3f, 0x22, 0xfc, 0x52, 0x03, 0x84, 0x07, 0x51, 0x18,
+	0x74, 0x49, 0xb2, 0xb0, 0x05, 0x9c, 0x93, 0x7d, 0xe0, 0xce, 0x9a, 0xe9, 0x5e, 0x1a, 0xb1, 0xe4,
+	0xad, 0xcf, 0xe5, 0x91, 0x51, 0xfe, 0x8e, 0xf0, 0xe5, 0xb6, 0x60, 0x40, 0x7a, 0x3a, 0xd0, 0x0d,
+	0xa3, 0x4d, 0x0a, 0xf5, 0x92, 0x75, 0x73, 0x5e, 0x1b, 0x89, 0xfb, 0x06, 0x7a, 0x13, 0x39, 0x7f,
+	0x20, 0xfc, 0x6a, 0x03, 0xc4, 0x7d, 0xd2, 0x03, 0x1e, 0x91, 0x2e, 0xe8, 0xc0, 0xef, 0x99, 0xa6,
+	0x33, 0xcb, 0x45, 0xe2, 0x37, 0x2f, 0xc6, 0x2c, 0xcb, 0xfc, 0x57, 0x84, 0x2f, 0x37, 0x40, 0xac,
+	0x37, 0x77, 0xca, 0x67, 0x5e, 0xa8, 0xb7, 0xcb, 0x7c, 0x86, 0x4d, 0x86, 0xfb, 0x39, 0xc2, 0x4f,
+	0x7b, 0x40, 0xa2, 0x28, 0x1c, 0x6e, 0x0c, 0xa0, 0x2f, 0xb8, 0xb3, 0x64, 0x78, 0x67, 0xe7, 0x34,
+	0x12, 0x6b, 0xb9, 0x8c, 0x54, 0xa9, 0x62, 0x35, 0xdf, 0x6f, 0x03, 0x61, 0xdd, 0x83, 0x9a, 0x10,
+	0x2c, 0xe8, 0xc4, 0x02, 0xb8, 0x61, 0x15, 0xd3, 0x28, 0xed, 0xaa, 0x98, 0xd6, 0x40, 0xb9, 0xe1,
+	0xd3, 0xd3, 0x6c, 0x8a, 0x6f, 0xcd, 0xe2, 0x28, 0x2c, 0x42, 0xac, 0xcf, 0xe5, 0xa1, 0x44, 0x98,
+	0xd4, 0xc1, 0x72, 0x11, 0x6a, 0x94, 0x76, 0x11, 0x6a, 0x0d, 0x32, 0xb8, 0x2f, 0x11, 0x7e, 0x56,
+	0xb6, 0x0a, 0xf5, 0x30, 0xe6, 0x02, 0x98, 0xb3, 0x62, 0xd5, 0x60, 0x8c, 0x55, 0x12, 0xea, 0x56,
+	0x39, 0x71, 0x06, 0xf4, 0x19, 0xc2, 0x97, 0x92, 0x42, 0x39, 0xfe, 0x84, 0x3b, 0x37, 0x8d, 0x6b,
+	0xab, 0x94, 0x48, 0x94, 0xa5, 0x12, 0xca, 0x8c, 0xe3, 0x5b, 0x84, 0x9d, 0xdc, 0x47, 0x2d, 0xe8,
+	0x75, 0x12, 0x9a, 0x3b, 0xb6, 0x9e, 0x63, 0xa1, 0x64, 0x5a, 0x2d, 0xad, 0xcf, 0xc8, 0x7e, 0x41,
+	0xf8, 0x95, 0x9a, 0xef, 0x3f, 0x60, 0xbb, 0x91, 0x7f, 0xda, 0x72, 0xf6, 0xa8, 0xc8, 0xbe, 0xbb,
+	0x75, 0xd3, 0xdb, 0x4a, 0x2b, 0x97, 0x94, 0x1b, 0x73, 0xba, 0x28, 0xbf, 0xfd, 0xf4, 0x06, 0x51,
+	0x31, 0x57, 0x2d, 0x6e, 0x2d, 0x2d, 0xe1, 0xdd, 0xf2, 0x06, 0x19, 0xdc, 0x6f, 0x08, 0x5f, 0x51,
+	0x92, 0x16, 0xc4, 0x27, 0x82, 0x8c, 0x5b, 0x0b, 0x67, 0xd3, 0xfe, 0xab, 0x52, 0x0c, 0x24, 0x6a,
+	0x63, 0x6e, 0x9f, 0x8c, 0xf8, 0x27, 0x84, 0x5f, 0xf6, 0x68, 0x18, 0x76, 0x48, 0xf7, 0x70, 0x62,
+	0xb1, 0x63, 0x78, 0x5a, 0xe9, 0xd5, 0x92, 0x75, 0x7d, 0x3e, 0x93, 0x0c, 0xf4, 0x0b, 0x84, 0x9f,
+	0x49, 0x2b, 0x5d, 0x56, 0x65, 0x97, 0x2d, 0xca, 0xe3, 0x64, 0x69, 0x5d, 0x29, 0xa5, 0x55, 0x3a,
+	0xfe, 0xed, 0x98, 0xed, 0x43, 0x9e, 0xc7, 0xec, 0xa0, 0x9a, 0x94, 0xd9, 0x75, 0xfc, 0xd3, 0x6a,
+	0x85, 0xa9, 0x05, 0xa5, 0x98, 0x5a, 0x30, 0x0f, 0x53, 0x0b, 0x0a, 0x99, 0x92, 0x91, 0xda, 0x83,
+	0x3d, 0x06, 0xfc, 0x40, 0xf6, 0xdc, 0xe9, 0x74, 0x64, 0x7a, 0xb7, 0x4d, 0x4b, 0xed, 0x46, 0x6a,
+	0xbd, 0x83, 0x72, 0xf2, 0xed, 0xf6, 0x23, 0x12, 0x73, 0x98, 0x9a, 0x09, 0x0c, 0x4f, 0xbe, 0x22,
+	0xb9, 0xdd, 0xc9, 0x57, 0xec, 0x92, 0xb1, 0x7e, 0x83, 0xf0, 0xf3, 0xea, 0x2c, 0xd0, 0x24, 0xfb,
+	0xce, 0xed, 0x12, 0x33, 0x44, 0x93, 0xec, 0x4b, 0xba, 0x3b, 0x65, 0xe5, 0xca, 0x9c, 0x97, 0x1e,
+	0xd9, 0xba, 0xd6, 0x79, 0x33, 0x08, 0x93, 0xd3, 0xd9, 0xac, 0xfd, 0x3e, 0xcf, 0xc6, 0x6e, 0xce,
+	0x3b, 0xdf, 0x2d, 0xbb, 0x86, 0xbf, 0x10, 0xbe, 0x3a, 0xb1, 0x7c, 0x3d, 0x3d, 0x7e, 0x02, 0x1f,
+	0xfa, 0xdd, 0xe1, 0x36, 0x0d, 0x83, 0xee, 0xd0, 0xb9, 0x5f, 0x66, 0x5f, 0x8d, 0x91, 0xbc, 0x8e,
+	0x07, 0x17, 0xe6, 0xa7, 0x74, 0x5f, 0x1e, 0xf4, 0x49, 0xef, 0x4c, 0x60, 0xd8, 0x7d, 0x4d, 0xa8,
+	0xec, 0xba, 0xaf, 0x29, 0xb1, 0xd2, 0x7d, 0xd5, 0x19, 0x10, 0x01, 0xb5, 0x28, 0xb8, 0x07, 0x43,
+	0xc3, 0xee, 0x2b, 0x2f, 0xb1, 0xeb, 0xbe, 0x54, 0x65, 0xc6, 0xf1, 0x29, 0xc2, 0x4f, 0x25, 0x15,
+	0x31, 0xfd, 0x80, 0x3b, 0x37, 0x8c, 0x6b, 0xe8, 0x58, 0x21, 0x29, 0x6e, 0xda, 0x0b, 0x95, 0x30,
+	0x3c, 0x2a, 0x6c, 0xc3, 0xc8, 0x4b, 0xec, 0xc2, 0x50, 0x95, 0x2a, 0x07, 0x0c, 0xe8, 0xa1, 0x25,
+	0x47, 0x4e, 0x62, 0xc9, 0xa1, 0x28, 0x95, 0x71, 0xab, 0x2d, 0x08, 0x3b, 0x9b, 0xba, 0xb7, 0x48,
+	0xdf, 0xa7, 0x03, 0x60, 0x86, 0xe3, 0x96, 0x5e, 0x6c, 0x37, 0x6e, 0x15, 0x79, 0x28, 0xb3, 0xbe,
+	0x1c, 0x2f, 0xa6, 0x41, 0x37, 0xac, 0xc6, 0x93, 0x42, 0xd6, 0xcd, 0x79, 0x6d, 0x94, 0x9a, 0xdb,
+	0x1e, 0xf6, 0xbb, 0x53, 0xe3, 0xa1, 0x59, 0xcd, 0xd5, 0x49, 0xed, 0x6a, 0xae, 0xde, 0x41, 0x89,
+	0x73, 0xb2, 0x8d, 0xa9, 0x85, 0xe1, 0xe9, 0x43, 0x55, 0xd3, 0x47, 0x27, 0x85, 0x7a, 0xbb, 0x38,
+	0x67, 0xd8, 0x28, 0xb8, 0x2d, 0x28, 0x58, 0x67, 0x88, 0xdb, 0x82, 0x0b, 0xc1, 0x6d, 0xc1, 0xf9,
+	0xb8, 0xe9, 0x13, 0x0c, 0x0e, 0x7d, 0x3f, 0x57, 0xf0, 0xd2, 0x9e, 0xcb, 0xf4, 0x09, 0x86, 0x4e,
+	0x6c, 0xfb, 0x04, 0x43, 0xef, 0xa1, 0x84, 0xfa, 0x10, 0x58, 0xb0, 0x37, 0x94, 0x1d, 0x4f, 0x6e,
+	0xb1, 0x61, 0xa8, 0x85, 0x7a, 0xbb, 0x50, 0x67, 0xd8, 0x4c, 0xb6, 0x5e, 0xc9, 0x55, 0xec, 0xc4,
+	0x10, 0x43, 0x9a, 0xa7, 0x71, 0xeb, 0xa5, 0xea, 0xac, 0x5b, 0xaf, 0x49, 0xb9, 0x92, 0xa2, 0x07,
+	0x11, 0xcd, 0x1d, 0x5f, 0x3b, 0x31, 0x15, 0x64, 0x37, 0xf9, 0x75, 0x18, 0xa6, 0x58, 0xa8, 0xb7,
+	0x4b, 0x71, 0x86, 0xcd, 0xd4, 0x6b, 0x92, 0x6d, 0x1a, 0x86, 0xc0, 0xea, 0x34, 0x4e, 0x9e, 0x43,
+	0x9a, 0xbf, 0x26, 0xc9, 0xcb, 0xec, 0x5f, 0x93, 0xa8, 0x6a, 0xc9, 0xb4, 0x16, 0x1e, 0x1d, 0xbb,
+	0x95, 0xc7, 0xc7, 0x6e, 0xe5, 0xc9, 0xb1, 0x8b, 0x3e, 0x19, 0xb9, 0xe8, 0xe7, 0x91, 0x8b, 0xfe,
+	0x1e, 0xb9, 0xe8, 0x68, 0xe4, 0xa2, 0x7f, 0x46, 0x2e, 0xfa, 0x77, 0xe4, 0x56, 0x9e, 0x8c, 0x5c,
+	0xf4, 0xd5, 0x89, 0x5b, 0x39, 0x3a, 0x71, 0x2b, 0x8f, 0x4f, 0xdc, 0xca, 0xfb, 0xd7, 0xf7, 0xe9,
+	0xd9, 0xc6, 0x01, 0x9d, 0xf1, 0x66, 0x74, 0x25, 0xff, 0x7f, 0xe7, 0x7f, 0xa7, 0xaf, 0x45, 0xdf,
+	0xfa, 0x6f, 0x00, 0x19, 0x67, 0xa8, 0xc8, 0xac, 0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -140,6 +166,9 @@ type AdminServiceClient interface {
 	GetWorkflowExecutionRawHistoryV2(ctx context.Context, in *GetWorkflowExecutionRawHistoryV2Request, opts ...grpc.CallOption) (*GetWorkflowExecutionRawHistoryV2Response, error)
 	// GetReplicationMessages returns new replication tasks since the read level provided in the token.
 	GetReplicationMessages(ctx context.Context, in *GetReplicationMessagesRequest, opts ...grpc.CallOption) (*GetReplicationMessagesResponse, error)
+	// StreamReplicationMessages is a per shard channel pushing replication tasks to the remote cluster as they are generated.
+	// Each request acks processed tasks and allows the source cluster to send one batch of tasks in response.
+	StreamReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (AdminService_StreamReplicationMessagesClient, error)
 	// GetNamespaceReplicationMessages returns new namespace replication tasks since last retrieved task Id.
 	GetNamespaceReplicationMessages(ctx context.Context, in *GetNamespaceReplicationMessagesRequest, opts ...grpc.CallOption) (*GetNamespaceReplicationMessagesResponse, error)
 	// GetDLQReplicationMessages return replication messages based on DLQ info.
@@ -162,6 +191,10 @@ type AdminServiceClient interface {
 	AddOrUpdateRemoteCluster(ctx context.Context, in *AddOrUpdateRemoteClusterRequest, opts ...grpc.CallOption) (*AddOrUpdateRemoteClusterResponse, error)
 	// RemoveRemoteCluster removes remote cluster.
 	RemoveRemoteCluster(ctx context.Context, in *RemoveRemoteClusterRequest, opts ...grpc.CallOption) (*RemoveRemoteClusterResponse, error)
+	// ListClusterMetadataHistory returns the audit log of cluster metadata changes.
+	ListClusterMetadataHistory(ctx context.Context, in *ListClusterMetadataHistoryRequest, opts ...grpc.CallOption) (*ListClusterMetadataHistoryResponse, error)
+	// RollbackClusterMetadata restores metadata of a cluster to the state right after a previous change.
+	RollbackClusterMetadata(ctx context.Context, in *RollbackClusterMetadataRequest, opts ...grpc.CallOption) (*RollbackClusterMetadataResponse, error)
 	// GetDLQMessages returns messages from DLQ.
 	GetDLQMessages(ctx context.Context, in *GetDLQMessagesRequest, opts ...grpc.CallOption) (*GetDLQMessagesResponse, error)
 	// (-- api-linter: core::0165::response-message-name=disabled
@@ -172,10 +205,50 @@ type AdminServiceClient interface {
 	MergeDLQMessages(ctx context.Context, in *MergeDLQMessagesRequest, opts ...grpc.CallOption) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(ctx context.Context, in *RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*RefreshWorkflowTasksResponse, error)
+	// UnpauseWorkflowExecution resumes workflow task scheduling of a workflow paused after repeated workflow task failures.
+	UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error)
+	// GetReplicationLag returns replication lag of all shards aggregated per remote cluster and namespace.
+	GetReplicationLag(ctx context.Context, in *GetReplicationLagRequest, opts ...grpc.CallOption) (*GetReplicationLagResponse, error)
+	// UpdateNamespaceReplicationFilter sets the filter of workflow executions replicated to remote clusters of a global namespace.
+	UpdateNamespaceReplicationFilter(ctx context.Context, in *UpdateNamespaceReplicationFilterRequest, opts ...grpc.CallOption) (*UpdateNamespaceReplicationFilterResponse, error)
+	// UpdateNamespaceDataResidencyPolicy sets the clusters and the archival destinations data of a namespace may be stored in.
+	// The policy can't be changed through UpdateNamespace.
+	UpdateNamespaceDataResidencyPolicy(ctx context.Context, in *UpdateNamespaceDataResidencyPolicyRequest, opts ...grpc.CallOption) (*UpdateNamespaceDataResidencyPolicyResponse, error)
+	// RenameNamespace changes the name of a namespace, the namespace id stays the same.
+	// Previous name keeps resolving to the namespace during a grace period.
+	RenameNamespace(ctx context.Context, in *RenameNamespaceRequest, opts ...grpc.CallOption) (*RenameNamespaceResponse, error)
+	// CreateApiKey creates an API key bound to a namespace and role. Key is returned only once, the server stores its hash.
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	// ListApiKeys lists API keys of a namespace.
+	ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error)
+	// RotateApiKey replaces the secret of an API key. Previous secret stays valid during a grace period.
+	RotateApiKey(ctx context.Context, in *RotateApiKeyRequest, opts ...grpc.CallOption) (*RotateApiKeyResponse, error)
+	// RevokeApiKey deletes an API key of a namespace.
+	RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error)
+	// StartNamespaceHandover starts a managed failover of a global namespace to a remote cluster.
+	// Pre-checks are run before handover, and namespace is moved back to normal state if any step fails.
+	StartNamespaceHandover(ctx context.Context, in *StartNamespaceHandoverRequest, opts ...grpc.CallOption) (*StartNamespaceHandoverResponse, error)
+	// DescribeNamespaceHandover returns the status of each step of a namespace handover.
+	DescribeNamespaceHandover(ctx context.Context, in *DescribeNamespaceHandoverRequest, opts ...grpc.CallOption) (*DescribeNamespaceHandoverResponse, error)
+	// SyncSearchAttributes reports custom search attributes which differ between current and remote cluster.
+	// Unless dry run, attributes missing in current cluster are added and attributes missing in remote cluster are replicated to it.
+	SyncSearchAttributes(ctx context.Context, in *SyncSearchAttributesRequest, opts ...grpc.CallOption) (*SyncSearchAttributesResponse, error)
+	// PurgeDLQMessagesAllShards purges replication DLQ messages of all shards, optionally filtered by namespace, workflow and task type.
+	PurgeDLQMessagesAllShards(ctx context.Context, in *PurgeDLQMessagesAllShardsRequest, opts ...grpc.CallOption) (*PurgeDLQMessagesAllShardsResponse, error)
+	// MergeDLQMessagesAllShards merges replication DLQ messages of all shards, optionally filtered by namespace, workflow and task type.
+	MergeDLQMessagesAllShards(ctx context.Context, in *MergeDLQMessagesAllShardsRequest, opts ...grpc.CallOption) (*MergeDLQMessagesAllShardsResponse, error)
 	// ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster.
 	ResendReplicationTasks(ctx context.Context, in *ResendReplicationTasksRequest, opts ...grpc.CallOption) (*ResendReplicationTasksResponse, error)
+	// VerifyWorkflowReplication compares one workflow execution across all clusters of its namespace.
+	// Unless skipped, replication tasks are resent from the active cluster to clusters whose state diverged.
+	VerifyWorkflowReplication(ctx context.Context, in *VerifyWorkflowReplicationRequest, opts ...grpc.CallOption) (*VerifyWorkflowReplicationResponse, error)
 	// GetTaskQueueTasks returns tasks from task queue.
 	GetTaskQueueTasks(ctx context.Context, in *GetTaskQueueTasksRequest, opts ...grpc.CallOption) (*GetTaskQueueTasksResponse, error)
+	// ReportNamespaceQuotaUsage reports the namespace request rates observed by a frontend host to the frontend host
+	// coordinating global namespace rate limits, and returns the rate limits allotted to the reporting host.
+	ReportNamespaceQuotaUsage(ctx context.Context, in *ReportNamespaceQuotaUsageRequest, opts ...grpc.CallOption) (*ReportNamespaceQuotaUsageResponse, error)
+	// ListPollerCounts returns the number of concurrent pollers per namespace and task queue of frontend hosts.
+	ListPollerCounts(ctx context.Context, in *ListPollerCountsRequest, opts ...grpc.CallOption) (*ListPollerCountsResponse, error)
 }
 
 type adminServiceClient struct {
@@ -267,6 +340,37 @@ func (c *adminServiceClient) GetReplicationMessages(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *adminServiceClient) StreamReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (AdminService_StreamReplicationMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AdminService_serviceDesc.Streams[0], "/temporal.server.api.adminservice.v1.AdminService/StreamReplicationMessages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminServiceStreamReplicationMessagesClient{stream}
+	return x, nil
+}
+
+type AdminService_StreamReplicationMessagesClient interface {
+	Send(*StreamReplicationMessagesRequest) error
+	Recv() (*StreamReplicationMessagesResponse, error)
+	grpc.ClientStream
+}
+
+type adminServiceStreamReplicationMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminServiceStreamReplicationMessagesClient) Send(m *StreamReplicationMessagesRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *adminServiceStreamReplicationMessagesClient) Recv() (*StreamReplicationMessagesResponse, error) {
+	m := new(StreamReplicationMessagesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *adminServiceClient) GetNamespaceReplicationMessages(ctx context.Context, in *GetNamespaceReplicationMessagesRequest, opts ...grpc.CallOption) (*GetNamespaceReplicationMessagesResponse, error) {
 	out := new(GetNamespaceReplicationMessagesResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetNamespaceReplicationMessages", in, out, opts...)
@@ -366,6 +470,24 @@ func (c *adminServiceClient) RemoveRemoteCluster(ctx context.Context, in *Remove
 	return out, nil
 }
 
+func (c *adminServiceClient) ListClusterMetadataHistory(ctx context.Context, in *ListClusterMetadataHistoryRequest, opts ...grpc.CallOption) (*ListClusterMetadataHistoryResponse, error) {
+	out := new(ListClusterMetadataHistoryResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListClusterMetadataHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RollbackClusterMetadata(ctx context.Context, in *RollbackClusterMetadataRequest, opts ...grpc.CallOption) (*RollbackClusterMetadataResponse, error) {
+	out := new(RollbackClusterMetadataResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/RollbackClusterMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) GetDLQMessages(ctx context.Context, in *GetDLQMessagesRequest, opts ...grpc.CallOption) (*GetDLQMessagesResponse, error) {
 	out := new(GetDLQMessagesResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetDLQMessages", in, out, opts...)
@@ -402,6 +524,132 @@ func (c *adminServiceClient) RefreshWorkflowTasks(ctx context.Context, in *Refre
 	return out, nil
 }
 
+func (c *adminServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error) {
+	out := new(UnpauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UnpauseWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetReplicationLag(ctx context.Context, in *GetReplicationLagRequest, opts ...grpc.CallOption) (*GetReplicationLagResponse, error) {
+	out := new(GetReplicationLagResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetReplicationLag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateNamespaceReplicationFilter(ctx context.Context, in *UpdateNamespaceReplicationFilterRequest, opts ...grpc.CallOption) (*UpdateNamespaceReplicationFilterResponse, error) {
+	out := new(UpdateNamespaceReplicationFilterResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateNamespaceReplicationFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateNamespaceDataResidencyPolicy(ctx context.Context, in *UpdateNamespaceDataResidencyPolicyRequest, opts ...grpc.CallOption) (*UpdateNamespaceDataResidencyPolicyResponse, error) {
+	out := new(UpdateNamespaceDataResidencyPolicyResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateNamespaceDataResidencyPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RenameNamespace(ctx context.Context, in *RenameNamespaceRequest, opts ...grpc.CallOption) (*RenameNamespaceResponse, error) {
+	out := new(RenameNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/RenameNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/CreateApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error) {
+	out := new(ListApiKeysResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListApiKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RotateApiKey(ctx context.Context, in *RotateApiKeyRequest, opts ...grpc.CallOption) (*RotateApiKeyResponse, error) {
+	out := new(RotateApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/RotateApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error) {
+	out := new(RevokeApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/RevokeApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartNamespaceHandover(ctx context.Context, in *StartNamespaceHandoverRequest, opts ...grpc.CallOption) (*StartNamespaceHandoverResponse, error) {
+	out := new(StartNamespaceHandoverResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/StartNamespaceHandover", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeNamespaceHandover(ctx context.Context, in *DescribeNamespaceHandoverRequest, opts ...grpc.CallOption) (*DescribeNamespaceHandoverResponse, error) {
+	out := new(DescribeNamespaceHandoverResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeNamespaceHandover", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SyncSearchAttributes(ctx context.Context, in *SyncSearchAttributesRequest, opts ...grpc.CallOption) (*SyncSearchAttributesResponse, error) {
+	out := new(SyncSearchAttributesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/SyncSearchAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PurgeDLQMessagesAllShards(ctx context.Context, in *PurgeDLQMessagesAllShardsRequest, opts ...grpc.CallOption) (*PurgeDLQMessagesAllShardsResponse, error) {
+	out := new(PurgeDLQMessagesAllShardsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQMessagesAllShards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) MergeDLQMessagesAllShards(ctx context.Context, in *MergeDLQMessagesAllShardsRequest, opts ...grpc.CallOption) (*MergeDLQMessagesAllShardsResponse, error) {
+	out := new(MergeDLQMessagesAllShardsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/MergeDLQMessagesAllShards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ResendReplicationTasks(ctx context.Context, in *ResendReplicationTasksRequest, opts ...grpc.CallOption) (*ResendReplicationTasksResponse, error) {
 	out := new(ResendReplicationTasksResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ResendReplicationTasks", in, out, opts...)
@@ -411,6 +659,15 @@ func (c *adminServiceClient) ResendReplicationTasks(ctx context.Context, in *Res
 	return out, nil
 }
 
+func (c *adminServiceClient) VerifyWorkflowReplication(ctx context.Context, in *VerifyWorkflowReplicationRequest, opts ...grpc.CallOption) (*VerifyWorkflowReplicationResponse, error) {
+	out := new(VerifyWorkflowReplicationResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/VerifyWorkflowReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) GetTaskQueueTasks(ctx context.Context, in *GetTaskQueueTasksRequest, opts ...grpc.CallOption) (*GetTaskQueueTasksResponse, error) {
 	out := new(GetTaskQueueTasksResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetTaskQueueTasks", in, out, opts...)
@@ -420,6 +677,24 @@ func (c *adminServiceClient) GetTaskQueueTasks(ctx context.Context, in *GetTaskQ
 	return out, nil
 }
 
+func (c *adminServiceClient) ReportNamespaceQuotaUsage(ctx context.Context, in *ReportNamespaceQuotaUsageRequest, opts ...grpc.CallOption) (*ReportNamespaceQuotaUsageResponse, error) {
+	out := new(ReportNamespaceQuotaUsageResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ReportNamespaceQuotaUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListPollerCounts(ctx context.Context, in *ListPollerCountsRequest, opts ...grpc.CallOption) (*ListPollerCountsResponse, error) {
+	out := new(ListPollerCountsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListPollerCounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 type AdminServiceServer interface {
 	// RebuildMutableState attempts to rebuild mutable state according to persisted history events.
@@ -440,6 +715,9 @@ type AdminServiceServer interface {
 	GetWorkflowExecutionRawHistoryV2(context.Context, *GetWorkflowExecutionRawHistoryV2Request) (*GetWorkflowExecutionRawHistoryV2Response, error)
 	// GetReplicationMessages returns new replication tasks since the read level provided in the token.
 	GetReplicationMessages(context.Context, *GetReplicationMessagesRequest) (*GetReplicationMessagesResponse, error)
+	// StreamReplicationMessages is a per shard channel pushing replication tasks to the remote cluster as they are generated.
+	// Each request acks processed tasks and allows the source cluster to send one batch of tasks in response.
+	StreamReplicationMessages(AdminService_StreamReplicationMessagesServer) error
 	// GetNamespaceReplicationMessages returns new namespace replication tasks since last retrieved task Id.
 	GetNamespaceReplicationMessages(context.Context, *GetNamespaceReplicationMessagesRequest) (*GetNamespaceReplicationMessagesResponse, error)
 	// GetDLQReplicationMessages return replication messages based on DLQ info.
@@ -462,6 +740,10 @@ type AdminServiceServer interface {
 	AddOrUpdateRemoteCluster(context.Context, *AddOrUpdateRemoteClusterRequest) (*AddOrUpdateRemoteClusterResponse, error)
 	// RemoveRemoteCluster removes remote cluster.
 	RemoveRemoteCluster(context.Context, *RemoveRemoteClusterRequest) (*RemoveRemoteClusterResponse, error)
+	// ListClusterMetadataHistory returns the audit log of cluster metadata changes.
+	ListClusterMetadataHistory(context.Context, *ListClusterMetadataHistoryRequest) (*ListClusterMetadataHistoryResponse, error)
+	// RollbackClusterMetadata restores metadata of a cluster to the state right after a previous change.
+	RollbackClusterMetadata(context.Context, *RollbackClusterMetadataRequest) (*RollbackClusterMetadataResponse, error)
 	// GetDLQMessages returns messages from DLQ.
 	GetDLQMessages(context.Context, *GetDLQMessagesRequest) (*GetDLQMessagesResponse, error)
 	// (-- api-linter: core::0165::response-message-name=disabled
@@ -472,10 +754,50 @@ type AdminServiceServer interface {
 	MergeDLQMessages(context.Context, *MergeDLQMessagesRequest) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
+	// UnpauseWorkflowExecution resumes workflow task scheduling of a workflow paused after repeated workflow task failures.
+	UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error)
+	// GetReplicationLag returns replication lag of all shards aggregated per remote cluster and namespace.
+	GetReplicationLag(context.Context, *GetReplicationLagRequest) (*GetReplicationLagResponse, error)
+	// UpdateNamespaceReplicationFilter sets the filter of workflow executions replicated to remote clusters of a global namespace.
+	UpdateNamespaceReplicationFilter(context.Context, *UpdateNamespaceReplicationFilterRequest) (*UpdateNamespaceReplicationFilterResponse, error)
+	// UpdateNamespaceDataResidencyPolicy sets the clusters and the archival destinations data of a namespace may be stored in.
+	// The policy can't be changed through UpdateNamespace.
+	UpdateNamespaceDataResidencyPolicy(context.Context, *UpdateNamespaceDataResidencyPolicyRequest) (*UpdateNamespaceDataResidencyPolicyResponse, error)
+	// RenameNamespace changes the name of a namespace, the namespace id stays the same.
+	// Previous name keeps resolving to the namespace during a grace period.
+	RenameNamespace(context.Context, *RenameNamespaceRequest) (*RenameNamespaceResponse, error)
+	// CreateApiKey creates an API key bound to a namespace and role. Key is returned only once, the server stores its hash.
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error)
+	// ListApiKeys lists API keys of a namespace.
+	ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error)
+	// RotateApiKey replaces the secret of an API key. Previous secret stays valid during a grace period.
+	RotateApiKey(context.Context, *RotateApiKeyRequest) (*RotateApiKeyResponse, error)
+	// RevokeApiKey deletes an API key of a namespace.
+	RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error)
+	// StartNamespaceHandover starts a managed failover of a global namespace to a remote cluster.
+	// Pre-checks are run before handover, and namespace is moved back to normal state if any step fails.
+	StartNamespaceHandover(context.Context, *StartNamespaceHandoverRequest) (*StartNamespaceHandoverResponse, error)
+	// DescribeNamespaceHandover returns the status of each step of a namespace handover.
+	DescribeNamespaceHandover(context.Context, *DescribeNamespaceHandoverRequest) (*DescribeNamespaceHandoverResponse, error)
+	// SyncSearchAttributes reports custom search attributes which differ between current and remote cluster.
+	// Unless dry run, attributes missing in current cluster are added and attributes missing in remote cluster are replicated to it.
+	SyncSearchAttributes(context.Context, *SyncSearchAttributesRequest) (*SyncSearchAttributesResponse, error)
+	// PurgeDLQMessagesAllShards purges replication DLQ messages of all shards, optionally filtered by namespace, workflow and task type.
+	PurgeDLQMessagesAllShards(context.Context, *PurgeDLQMessagesAllShardsRequest) (*PurgeDLQMessagesAllShardsResponse, error)
+	// MergeDLQMessagesAllShards merges replication DLQ messages of all shards, optionally filtered by namespace, workflow and task type.
+	MergeDLQMessagesAllShards(context.Context, *MergeDLQMessagesAllShardsRequest) (*MergeDLQMessagesAllShardsResponse, error)
 	// ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster.
 	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error)
+	// VerifyWorkflowReplication compares one workflow execution across all clusters of its namespace.
+	// Unless skipped, replication tasks are resent from the active cluster to clusters whose state diverged.
+	VerifyWorkflowReplication(context.Context, *VerifyWorkflowReplicationRequest) (*VerifyWorkflowReplicationResponse, error)
 	// GetTaskQueueTasks returns tasks from task queue.
 	GetTaskQueueTasks(context.Context, *GetTaskQueueTasksRequest) (*GetTaskQueueTasksResponse, error)
+	// ReportNamespaceQuotaUsage reports the namespace request rates observed by a frontend host to the frontend host
+	// coordinating global namespace rate limits, and returns the rate limits allotted to the reporting host.
+	ReportNamespaceQuotaUsage(context.Context, *ReportNamespaceQuotaUsageRequest) (*ReportNamespaceQuotaUsageResponse, error)
+	// ListPollerCounts returns the number of concurrent pollers per namespace and task queue of frontend hosts.
+	ListPollerCounts(context.Context, *ListPollerCountsRequest) (*ListPollerCountsResponse, error)
 }
 
 // UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
@@ -509,6 +831,9 @@ func (*UnimplementedAdminServiceServer) GetWorkflowExecutionRawHistoryV2(ctx con
 func (*UnimplementedAdminServiceServer) GetReplicationMessages(ctx context.Context, req *GetReplicationMessagesRequest) (*GetReplicationMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReplicationMessages not implemented")
 }
+func (*UnimplementedAdminServiceServer) StreamReplicationMessages(srv AdminService_StreamReplicationMessagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamReplicationMessages not implemented")
+}
 func (*UnimplementedAdminServiceServer) GetNamespaceReplicationMessages(ctx context.Context, req *GetNamespaceReplicationMessagesRequest) (*GetNamespaceReplicationMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceReplicationMessages not implemented")
 }
@@ -542,6 +867,12 @@ func (*UnimplementedAdminServiceServer) AddOrUpdateRemoteCluster(ctx context.Con
 func (*UnimplementedAdminServiceServer) RemoveRemoteCluster(ctx context.Context, req *RemoveRemoteClusterRequest) (*RemoveRemoteClusterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveRemoteCluster not implemented")
 }
+func (*UnimplementedAdminServiceServer) ListClusterMetadataHistory(ctx context.Context, req *ListClusterMetadataHistoryRequest) (*ListClusterMetadataHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClusterMetadataHistory not implemented")
+}
+func (*UnimplementedAdminServiceServer) RollbackClusterMetadata(ctx context.Context, req *RollbackClusterMetadataRequest) (*RollbackClusterMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackClusterMetadata not implemented")
+}
 func (*UnimplementedAdminServiceServer) GetDLQMessages(ctx context.Context, req *GetDLQMessagesRequest) (*GetDLQMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDLQMessages not implemented")
 }
@@ -554,12 +885,63 @@ func (*UnimplementedAdminServiceServer) MergeDLQMessages(ctx context.Context, re
 func (*UnimplementedAdminServiceServer) RefreshWorkflowTasks(ctx context.Context, req *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshWorkflowTasks not implemented")
 }
+func (*UnimplementedAdminServiceServer) UnpauseWorkflowExecution(ctx context.Context, req *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseWorkflowExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetReplicationLag(ctx context.Context, req *GetReplicationLagRequest) (*GetReplicationLagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplicationLag not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateNamespaceReplicationFilter(ctx context.Context, req *UpdateNamespaceReplicationFilterRequest) (*UpdateNamespaceReplicationFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamespaceReplicationFilter not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateNamespaceDataResidencyPolicy(ctx context.Context, req *UpdateNamespaceDataResidencyPolicyRequest) (*UpdateNamespaceDataResidencyPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamespaceDataResidencyPolicy not implemented")
+}
+func (*UnimplementedAdminServiceServer) RenameNamespace(ctx context.Context, req *RenameNamespaceRequest) (*RenameNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameNamespace not implemented")
+}
+func (*UnimplementedAdminServiceServer) CreateApiKey(ctx context.Context, req *CreateApiKeyRequest) (*CreateApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListApiKeys(ctx context.Context, req *ListApiKeysRequest) (*ListApiKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApiKeys not implemented")
+}
+func (*UnimplementedAdminServiceServer) RotateApiKey(ctx context.Context, req *RotateApiKeyRequest) (*RotateApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateApiKey not implemented")
+}
+func (*UnimplementedAdminServiceServer) RevokeApiKey(ctx context.Context, req *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApiKey not implemented")
+}
+func (*UnimplementedAdminServiceServer) StartNamespaceHandover(ctx context.Context, req *StartNamespaceHandoverRequest) (*StartNamespaceHandoverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartNamespaceHandover not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeNamespaceHandover(ctx context.Context, req *DescribeNamespaceHandoverRequest) (*DescribeNamespaceHandoverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeNamespaceHandover not implemented")
+}
+func (*UnimplementedAdminServiceServer) SyncSearchAttributes(ctx context.Context, req *SyncSearchAttributesRequest) (*SyncSearchAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncSearchAttributes not implemented")
+}
+func (*UnimplementedAdminServiceServer) PurgeDLQMessagesAllShards(ctx context.Context, req *PurgeDLQMessagesAllShardsRequest) (*PurgeDLQMessagesAllShardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDLQMessagesAllShards not implemented")
+}
+func (*UnimplementedAdminServiceServer) MergeDLQMessagesAllShards(ctx context.Context, req *MergeDLQMessagesAllShardsRequest) (*MergeDLQMessagesAllShardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeDLQMessagesAllShards not implemented")
+}
 func (*UnimplementedAdminServiceServer) ResendReplicationTasks(ctx context.Context, req *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResendReplicationTasks not implemented")
 }
+func (*UnimplementedAdminServiceServer) VerifyWorkflowReplication(ctx context.Context, req *VerifyWorkflowReplicationRequest) (*VerifyWorkflowReplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyWorkflowReplication not implemented")
+}
 func (*UnimplementedAdminServiceServer) GetTaskQueueTasks(ctx context.Context, req *GetTaskQueueTasksRequest) (*GetTaskQueueTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTaskQueueTasks not implemented")
 }
+func (*UnimplementedAdminServiceServer) ReportNamespaceQuotaUsage(ctx context.Context, req *ReportNamespaceQuotaUsageRequest) (*ReportNamespaceQuotaUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportNamespaceQuotaUsage not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListPollerCounts(ctx context.Context, req *ListPollerCountsRequest) (*ListPollerCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPollerCounts not implemented")
+}
 
 func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
 	s.RegisterService(&_AdminService_serviceDesc, srv)
@@ -727,6 +1109,32 @@ func _AdminService_GetReplicationMessages_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_StreamReplicationMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AdminServiceServer).StreamReplicationMessages(&adminServiceStreamReplicationMessagesServer{stream})
+}
+
+type AdminService_StreamReplicationMessagesServer interface {
+	Send(*StreamReplicationMessagesResponse) error
+	Recv() (*StreamReplicationMessagesRequest, error)
+	grpc.ServerStream
+}
+
+type adminServiceStreamReplicationMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminServiceStreamReplicationMessagesServer) Send(m *StreamReplicationMessagesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *adminServiceStreamReplicationMessagesServer) Recv() (*StreamReplicationMessagesRequest, error) {
+	m := new(StreamReplicationMessagesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _AdminService_GetNamespaceReplicationMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetNamespaceReplicationMessagesRequest)
 	if err := dec(in); err != nil {
@@ -925,6 +1333,42 @@ func _AdminService_RemoveRemoteCluster_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ListClusterMetadataHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClusterMetadataHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListClusterMetadataHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListClusterMetadataHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListClusterMetadataHistory(ctx, req.(*ListClusterMetadataHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RollbackClusterMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackClusterMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RollbackClusterMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RollbackClusterMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RollbackClusterMetadata(ctx, req.(*RollbackClusterMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_GetDLQMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDLQMessagesRequest)
 	if err := dec(in); err != nil {
@@ -997,6 +1441,258 @@ func _AdminService_RefreshWorkflowTasks_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_UnpauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnpauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UnpauseWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnpauseWorkflowExecution(ctx, req.(*UnpauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetReplicationLag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReplicationLagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetReplicationLag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetReplicationLag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetReplicationLag(ctx, req.(*GetReplicationLagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateNamespaceReplicationFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNamespaceReplicationFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateNamespaceReplicationFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateNamespaceReplicationFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateNamespaceReplicationFilter(ctx, req.(*UpdateNamespaceReplicationFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateNamespaceDataResidencyPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNamespaceDataResidencyPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateNamespaceDataResidencyPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateNamespaceDataResidencyPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateNamespaceDataResidencyPolicy(ctx, req.(*UpdateNamespaceDataResidencyPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RenameNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RenameNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RenameNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RenameNamespace(ctx, req.(*RenameNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/CreateApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListApiKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListApiKeys(ctx, req.(*ListApiKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RotateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RotateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RotateApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RotateApiKey(ctx, req.(*RotateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RevokeApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RevokeApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RevokeApiKey(ctx, req.(*RevokeApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StartNamespaceHandover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartNamespaceHandoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartNamespaceHandover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/StartNamespaceHandover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartNamespaceHandover(ctx, req.(*StartNamespaceHandoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeNamespaceHandover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeNamespaceHandoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeNamespaceHandover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeNamespaceHandover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeNamespaceHandover(ctx, req.(*DescribeNamespaceHandoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SyncSearchAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncSearchAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SyncSearchAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/SyncSearchAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SyncSearchAttributes(ctx, req.(*SyncSearchAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PurgeDLQMessagesAllShards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeDLQMessagesAllShardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PurgeDLQMessagesAllShards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQMessagesAllShards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PurgeDLQMessagesAllShards(ctx, req.(*PurgeDLQMessagesAllShardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_MergeDLQMessagesAllShards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeDLQMessagesAllShardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).MergeDLQMessagesAllShards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/MergeDLQMessagesAllShards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).MergeDLQMessagesAllShards(ctx, req.(*MergeDLQMessagesAllShardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ResendReplicationTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResendReplicationTasksRequest)
 	if err := dec(in); err != nil {
@@ -1015,6 +1711,24 @@ func _AdminService_ResendReplicationTasks_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_VerifyWorkflowReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyWorkflowReplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).VerifyWorkflowReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/VerifyWorkflowReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).VerifyWorkflowReplication(ctx, req.(*VerifyWorkflowReplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_GetTaskQueueTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTaskQueueTasksRequest)
 	if err := dec(in); err != nil {
@@ -1033,6 +1747,42 @@ func _AdminService_GetTaskQueueTasks_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ReportNamespaceQuotaUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportNamespaceQuotaUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReportNamespaceQuotaUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ReportNamespaceQuotaUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReportNamespaceQuotaUsage(ctx, req.(*ReportNamespaceQuotaUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListPollerCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPollerCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListPollerCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListPollerCounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListPollerCounts(ctx, req.(*ListPollerCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AdminService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.adminservice.v1.AdminService",
 	HandlerType: (*AdminServiceServer)(nil),
@@ -1117,6 +1867,14 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveRemoteCluster",
 			Handler:    _AdminService_RemoveRemoteCluster_Handler,
 		},
+		{
+			MethodName: "ListClusterMetadataHistory",
+			Handler:    _AdminService_ListClusterMetadataHistory_Handler,
+		},
+		{
+			MethodName: "RollbackClusterMetadata",
+			Handler:    _AdminService_RollbackClusterMetadata_Handler,
+		},
 		{
 			MethodName: "GetDLQMessages",
 			Handler:    _AdminService_GetDLQMessages_Handler,
@@ -1133,15 +1891,90 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshWorkflowTasks",
 			Handler:    _AdminService_RefreshWorkflowTasks_Handler,
 		},
+		{
+			MethodName: "UnpauseWorkflowExecution",
+			Handler:    _AdminService_UnpauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "GetReplicationLag",
+			Handler:    _AdminService_GetReplicationLag_Handler,
+		},
+		{
+			MethodName: "UpdateNamespaceReplicationFilter",
+			Handler:    _AdminService_UpdateNamespaceReplicationFilter_Handler,
+		},
+		{
+			MethodName: "UpdateNamespaceDataResidencyPolicy",
+			Handler:    _AdminService_UpdateNamespaceDataResidencyPolicy_Handler,
+		},
+		{
+			MethodName: "RenameNamespace",
+			Handler:    _AdminService_RenameNamespace_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _AdminService_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "ListApiKeys",
+			Handler:    _AdminService_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "RotateApiKey",
+			Handler:    _AdminService_RotateApiKey_Handler,
+		},
+		{
+			MethodName: "RevokeApiKey",
+			Handler:    _AdminService_RevokeApiKey_Handler,
+		},
+		{
+			MethodName: "StartNamespaceHandover",
+			Handler:    _AdminService_StartNamespaceHandover_Handler,
+		},
+		{
+			MethodName: "DescribeNamespaceHandover",
+			Handler:    _AdminService_DescribeNamespaceHandover_Handler,
+		},
+		{
+			MethodName: "SyncSearchAttributes",
+			Handler:    _AdminService_SyncSearchAttributes_Handler,
+		},
+		{
+			MethodName: "PurgeDLQMessagesAllShards",
+			Handler:    _AdminService_PurgeDLQMessagesAllShards_Handler,
+		},
+		{
+			MethodName: "MergeDLQMessagesAllShards",
+			Handler:    _AdminService_MergeDLQMessagesAllShards_Handler,
+		},
 		{
 			MethodName: "ResendReplicationTasks",
 			Handler:    _AdminService_ResendReplicationTasks_Handler,
 		},
+		{
+			MethodName: "VerifyWorkflowReplication",
+			Handler:    _AdminService_VerifyWorkflowReplication_Handler,
+		},
 		{
 			MethodName: "GetTaskQueueTasks",
 			Handler:    _AdminService_GetTaskQueueTasks_Handler,
 		},
+		{
+			MethodName: "ReportNamespaceQuotaUsage",
+			Handler:    _AdminService_ReportNamespaceQuotaUsage_Handler,
+		},
+		{
+			MethodName: "ListPollerCounts",
+			Handler:    _AdminService_ListPollerCounts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamReplicationMessages",
+			Handler:       _AdminService_StreamReplicationMessages_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/adminservice/v1/service.proto",
 }
@@ -35,6 +35,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	adminservice "go.temporal.io/server/api/adminservice/v1"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
 )
 
 // MockAdminServiceClient is a mock of AdminServiceClient interface.
@@ -120,6 +121,26 @@ func (mr *MockAdminServiceClientMockRecorder) CloseShard(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceClient)(nil).CloseShard), varargs...)
 }
 
+// CreateApiKey mocks base method.
+func (m *MockAdminServiceClient) CreateApiKey(ctx context.Context, in *adminservice.CreateApiKeyRequest, opts ...grpc.CallOption) (*adminservice.CreateApiKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateApiKey", varargs...)
+	ret0, _ := ret[0].(*adminservice.CreateApiKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateApiKey indicates an expected call of CreateApiKey.
+func (mr *MockAdminServiceClientMockRecorder) CreateApiKey(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApiKey", reflect.TypeOf((*MockAdminServiceClient)(nil).CreateApiKey), varargs...)
+}
+
 // DescribeCluster mocks base method.
 func (m *MockAdminServiceClient) DescribeCluster(ctx context.Context, in *adminservice.DescribeClusterRequest, opts ...grpc.CallOption) (*adminservice.DescribeClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -180,6 +201,26 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeNamespaceHandover mocks base method.
+func (m *MockAdminServiceClient) DescribeNamespaceHandover(ctx context.Context, in *adminservice.DescribeNamespaceHandoverRequest, opts ...grpc.CallOption) (*adminservice.DescribeNamespaceHandoverResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeNamespaceHandover", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeNamespaceHandoverResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNamespaceHandover indicates an expected call of DescribeNamespaceHandover.
+func (mr *MockAdminServiceClientMockRecorder) DescribeNamespaceHandover(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespaceHandover", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeNamespaceHandover), varargs...)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockAdminServiceClient) GetDLQMessages(ctx context.Context, in *adminservice.GetDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -240,6 +281,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetNamespaceReplicationMessages(ct
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetNamespaceReplicationMessages), varargs...)
 }
 
+// GetReplicationLag mocks base method.
+func (m *MockAdminServiceClient) GetReplicationLag(ctx context.Context, in *adminservice.GetReplicationLagRequest, opts ...grpc.CallOption) (*adminservice.GetReplicationLagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReplicationLag", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetReplicationLagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationLag indicates an expected call of GetReplicationLag.
+func (mr *MockAdminServiceClientMockRecorder) GetReplicationLag(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationLag", reflect.TypeOf((*MockAdminServiceClient)(nil).GetReplicationLag), varargs...)
+}
+
 // GetReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) GetReplicationMessages(ctx context.Context, in *adminservice.GetReplicationMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -340,6 +401,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetWorkflowExecutionRawHistoryV2(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceClient)(nil).GetWorkflowExecutionRawHistoryV2), varargs...)
 }
 
+// ListApiKeys mocks base method.
+func (m *MockAdminServiceClient) ListApiKeys(ctx context.Context, in *adminservice.ListApiKeysRequest, opts ...grpc.CallOption) (*adminservice.ListApiKeysResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListApiKeys", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListApiKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApiKeys indicates an expected call of ListApiKeys.
+func (mr *MockAdminServiceClientMockRecorder) ListApiKeys(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApiKeys", reflect.TypeOf((*MockAdminServiceClient)(nil).ListApiKeys), varargs...)
+}
+
 // ListClusterMembers mocks base method.
 func (m *MockAdminServiceClient) ListClusterMembers(ctx context.Context, in *adminservice.ListClusterMembersRequest, opts ...grpc.CallOption) (*adminservice.ListClusterMembersResponse, error) {
 	m.ctrl.T.Helper()
@@ -360,6 +441,26 @@ func (mr *MockAdminServiceClientMockRecorder) ListClusterMembers(ctx, in interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterMembers", reflect.TypeOf((*MockAdminServiceClient)(nil).ListClusterMembers), varargs...)
 }
 
+// ListClusterMetadataHistory mocks base method.
+func (m *MockAdminServiceClient) ListClusterMetadataHistory(ctx context.Context, in *adminservice.ListClusterMetadataHistoryRequest, opts ...grpc.CallOption) (*adminservice.ListClusterMetadataHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClusterMetadataHistory", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListClusterMetadataHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusterMetadataHistory indicates an expected call of ListClusterMetadataHistory.
+func (mr *MockAdminServiceClientMockRecorder) ListClusterMetadataHistory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterMetadataHistory", reflect.TypeOf((*MockAdminServiceClient)(nil).ListClusterMetadataHistory), varargs...)
+}
+
 // ListClusters mocks base method.
 func (m *MockAdminServiceClient) ListClusters(ctx context.Context, in *adminservice.ListClustersRequest, opts ...grpc.CallOption) (*adminservice.ListClustersResponse, error) {
 	m.ctrl.T.Helper()
@@ -400,6 +501,26 @@ func (mr *MockAdminServiceClientMockRecorder) ListHistoryTasks(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ListHistoryTasks), varargs...)
 }
 
+// ListPollerCounts mocks base method.
+func (m *MockAdminServiceClient) ListPollerCounts(ctx context.Context, in *adminservice.ListPollerCountsRequest, opts ...grpc.CallOption) (*adminservice.ListPollerCountsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPollerCounts", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListPollerCountsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPollerCounts indicates an expected call of ListPollerCounts.
+func (mr *MockAdminServiceClientMockRecorder) ListPollerCounts(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPollerCounts", reflect.TypeOf((*MockAdminServiceClient)(nil).ListPollerCounts), varargs...)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) MergeDLQMessages(ctx context.Context, in *adminservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -420,6 +541,26 @@ func (mr *MockAdminServiceClientMockRecorder) MergeDLQMessages(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).MergeDLQMessages), varargs...)
 }
 
+// MergeDLQMessagesAllShards mocks base method.
+func (m *MockAdminServiceClient) MergeDLQMessagesAllShards(ctx context.Context, in *adminservice.MergeDLQMessagesAllShardsRequest, opts ...grpc.CallOption) (*adminservice.MergeDLQMessagesAllShardsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MergeDLQMessagesAllShards", varargs...)
+	ret0, _ := ret[0].(*adminservice.MergeDLQMessagesAllShardsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeDLQMessagesAllShards indicates an expected call of MergeDLQMessagesAllShards.
+func (mr *MockAdminServiceClientMockRecorder) MergeDLQMessagesAllShards(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessagesAllShards", reflect.TypeOf((*MockAdminServiceClient)(nil).MergeDLQMessagesAllShards), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) PurgeDLQMessages(ctx context.Context, in *adminservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -440,6 +581,26 @@ func (mr *MockAdminServiceClientMockRecorder) PurgeDLQMessages(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).PurgeDLQMessages), varargs...)
 }
 
+// PurgeDLQMessagesAllShards mocks base method.
+func (m *MockAdminServiceClient) PurgeDLQMessagesAllShards(ctx context.Context, in *adminservice.PurgeDLQMessagesAllShardsRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesAllShardsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeDLQMessagesAllShards", varargs...)
+	ret0, _ := ret[0].(*adminservice.PurgeDLQMessagesAllShardsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDLQMessagesAllShards indicates an expected call of PurgeDLQMessagesAllShards.
+func (mr *MockAdminServiceClientMockRecorder) PurgeDLQMessagesAllShards(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQMessagesAllShards", reflect.TypeOf((*MockAdminServiceClient)(nil).PurgeDLQMessagesAllShards), varargs...)
+}
+
 // ReapplyEvents mocks base method.
 func (m *MockAdminServiceClient) ReapplyEvents(ctx context.Context, in *adminservice.ReapplyEventsRequest, opts ...grpc.CallOption) (*adminservice.ReapplyEventsResponse, error) {
 	m.ctrl.T.Helper()
@@ -560,182 +721,604 @@ func (mr *MockAdminServiceClientMockRecorder) RemoveTask(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockAdminServiceClient)(nil).RemoveTask), varargs...)
 }
 
-// ResendReplicationTasks mocks base method.
-func (m *MockAdminServiceClient) ResendReplicationTasks(ctx context.Context, in *adminservice.ResendReplicationTasksRequest, opts ...grpc.CallOption) (*adminservice.ResendReplicationTasksResponse, error) {
+// RenameNamespace mocks base method.
+func (m *MockAdminServiceClient) RenameNamespace(ctx context.Context, in *adminservice.RenameNamespaceRequest, opts ...grpc.CallOption) (*adminservice.RenameNamespaceResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ResendReplicationTasks", varargs...)
-	ret0, _ := ret[0].(*adminservice.ResendReplicationTasksResponse)
+	ret := m.ctrl.Call(m, "RenameNamespace", varargs...)
+	ret0, _ := ret[0].(*adminservice.RenameNamespaceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ResendReplicationTasks indicates an expected call of ResendReplicationTasks.
-func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// RenameNamespace indicates an expected call of RenameNamespace.
+func (mr *MockAdminServiceClientMockRecorder) RenameNamespace(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameNamespace", reflect.TypeOf((*MockAdminServiceClient)(nil).RenameNamespace), varargs...)
 }
 
-// MockAdminServiceServer is a mock of AdminServiceServer interface.
-type MockAdminServiceServer struct {
-	ctrl     *gomock.Controller
-	recorder *MockAdminServiceServerMockRecorder
+// ReportNamespaceQuotaUsage mocks base method.
+func (m *MockAdminServiceClient) ReportNamespaceQuotaUsage(ctx context.Context, in *adminservice.ReportNamespaceQuotaUsageRequest, opts ...grpc.CallOption) (*adminservice.ReportNamespaceQuotaUsageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReportNamespaceQuotaUsage", varargs...)
+	ret0, _ := ret[0].(*adminservice.ReportNamespaceQuotaUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockAdminServiceServerMockRecorder is the mock recorder for MockAdminServiceServer.
-type MockAdminServiceServerMockRecorder struct {
-	mock *MockAdminServiceServer
+// ReportNamespaceQuotaUsage indicates an expected call of ReportNamespaceQuotaUsage.
+func (mr *MockAdminServiceClientMockRecorder) ReportNamespaceQuotaUsage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportNamespaceQuotaUsage", reflect.TypeOf((*MockAdminServiceClient)(nil).ReportNamespaceQuotaUsage), varargs...)
 }
 
-// NewMockAdminServiceServer creates a new mock instance.
-func NewMockAdminServiceServer(ctrl *gomock.Controller) *MockAdminServiceServer {
-	mock := &MockAdminServiceServer{ctrl: ctrl}
-	mock.recorder = &MockAdminServiceServerMockRecorder{mock}
-	return mock
+// ResendReplicationTasks mocks base method.
+func (m *MockAdminServiceClient) ResendReplicationTasks(ctx context.Context, in *adminservice.ResendReplicationTasksRequest, opts ...grpc.CallOption) (*adminservice.ResendReplicationTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResendReplicationTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.ResendReplicationTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAdminServiceServer) EXPECT() *MockAdminServiceServerMockRecorder {
-	return m.recorder
+// ResendReplicationTasks indicates an expected call of ResendReplicationTasks.
+func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
-// AddOrUpdateRemoteCluster mocks base method.
-func (m *MockAdminServiceServer) AddOrUpdateRemoteCluster(arg0 context.Context, arg1 *adminservice.AddOrUpdateRemoteClusterRequest) (*adminservice.AddOrUpdateRemoteClusterResponse, error) {
+// RevokeApiKey mocks base method.
+func (m *MockAdminServiceClient) RevokeApiKey(ctx context.Context, in *adminservice.RevokeApiKeyRequest, opts ...grpc.CallOption) (*adminservice.RevokeApiKeyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddOrUpdateRemoteCluster", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.AddOrUpdateRemoteClusterResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeApiKey", varargs...)
+	ret0, _ := ret[0].(*adminservice.RevokeApiKeyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddOrUpdateRemoteCluster indicates an expected call of AddOrUpdateRemoteCluster.
-func (mr *MockAdminServiceServerMockRecorder) AddOrUpdateRemoteCluster(arg0, arg1 interface{}) *gomock.Call {
+// RevokeApiKey indicates an expected call of RevokeApiKey.
+func (mr *MockAdminServiceClientMockRecorder) RevokeApiKey(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrUpdateRemoteCluster", reflect.TypeOf((*MockAdminServiceServer)(nil).AddOrUpdateRemoteCluster), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeApiKey", reflect.TypeOf((*MockAdminServiceClient)(nil).RevokeApiKey), varargs...)
 }
 
-// AddSearchAttributes mocks base method.
-func (m *MockAdminServiceServer) AddSearchAttributes(arg0 context.Context, arg1 *adminservice.AddSearchAttributesRequest) (*adminservice.AddSearchAttributesResponse, error) {
+// RollbackClusterMetadata mocks base method.
+func (m *MockAdminServiceClient) RollbackClusterMetadata(ctx context.Context, in *adminservice.RollbackClusterMetadataRequest, opts ...grpc.CallOption) (*adminservice.RollbackClusterMetadataResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddSearchAttributes", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.AddSearchAttributesResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RollbackClusterMetadata", varargs...)
+	ret0, _ := ret[0].(*adminservice.RollbackClusterMetadataResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddSearchAttributes indicates an expected call of AddSearchAttributes.
-func (mr *MockAdminServiceServerMockRecorder) AddSearchAttributes(arg0, arg1 interface{}) *gomock.Call {
+// RollbackClusterMetadata indicates an expected call of RollbackClusterMetadata.
+func (mr *MockAdminServiceClientMockRecorder) RollbackClusterMetadata(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSearchAttributes", reflect.TypeOf((*MockAdminServiceServer)(nil).AddSearchAttributes), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackClusterMetadata", reflect.TypeOf((*MockAdminServiceClient)(nil).RollbackClusterMetadata), varargs...)
 }
 
-// CloseShard mocks base method.
-func (m *MockAdminServiceServer) CloseShard(arg0 context.Context, arg1 *adminservice.CloseShardRequest) (*adminservice.CloseShardResponse, error) {
+// RotateApiKey mocks base method.
+func (m *MockAdminServiceClient) RotateApiKey(ctx context.Context, in *adminservice.RotateApiKeyRequest, opts ...grpc.CallOption) (*adminservice.RotateApiKeyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CloseShard", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.CloseShardResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RotateApiKey", varargs...)
+	ret0, _ := ret[0].(*adminservice.RotateApiKeyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CloseShard indicates an expected call of CloseShard.
-func (mr *MockAdminServiceServerMockRecorder) CloseShard(arg0, arg1 interface{}) *gomock.Call {
+// RotateApiKey indicates an expected call of RotateApiKey.
+func (mr *MockAdminServiceClientMockRecorder) RotateApiKey(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceServer)(nil).CloseShard), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateApiKey", reflect.TypeOf((*MockAdminServiceClient)(nil).RotateApiKey), varargs...)
 }
 
-// DescribeCluster mocks base method.
-func (m *MockAdminServiceServer) DescribeCluster(arg0 context.Context, arg1 *adminservice.DescribeClusterRequest) (*adminservice.DescribeClusterResponse, error) {
+// StartNamespaceHandover mocks base method.
+func (m *MockAdminServiceClient) StartNamespaceHandover(ctx context.Context, in *adminservice.StartNamespaceHandoverRequest, opts ...grpc.CallOption) (*adminservice.StartNamespaceHandoverResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeCluster", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.DescribeClusterResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartNamespaceHandover", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartNamespaceHandoverResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeCluster indicates an expected call of DescribeCluster.
-func (mr *MockAdminServiceServerMockRecorder) DescribeCluster(arg0, arg1 interface{}) *gomock.Call {
+// StartNamespaceHandover indicates an expected call of StartNamespaceHandover.
+func (mr *MockAdminServiceClientMockRecorder) StartNamespaceHandover(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCluster", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeCluster), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartNamespaceHandover", reflect.TypeOf((*MockAdminServiceClient)(nil).StartNamespaceHandover), varargs...)
 }
 
-// DescribeHistoryHost mocks base method.
-func (m *MockAdminServiceServer) DescribeHistoryHost(arg0 context.Context, arg1 *adminservice.DescribeHistoryHostRequest) (*adminservice.DescribeHistoryHostResponse, error) {
+// StreamReplicationMessages mocks base method.
+func (m *MockAdminServiceClient) StreamReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamReplicationMessagesClient, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeHistoryHost", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.DescribeHistoryHostResponse)
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamReplicationMessages", varargs...)
+	ret0, _ := ret[0].(adminservice.AdminService_StreamReplicationMessagesClient)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeHistoryHost indicates an expected call of DescribeHistoryHost.
-func (mr *MockAdminServiceServerMockRecorder) DescribeHistoryHost(arg0, arg1 interface{}) *gomock.Call {
+// StreamReplicationMessages indicates an expected call of StreamReplicationMessages.
+func (mr *MockAdminServiceClientMockRecorder) StreamReplicationMessages(ctx interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHost", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeHistoryHost), arg0, arg1)
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).StreamReplicationMessages), varargs...)
 }
 
-// DescribeMutableState mocks base method.
-func (m *MockAdminServiceServer) DescribeMutableState(arg0 context.Context, arg1 *adminservice.DescribeMutableStateRequest) (*adminservice.DescribeMutableStateResponse, error) {
+// SyncSearchAttributes mocks base method.
+func (m *MockAdminServiceClient) SyncSearchAttributes(ctx context.Context, in *adminservice.SyncSearchAttributesRequest, opts ...grpc.CallOption) (*adminservice.SyncSearchAttributesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeMutableState", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.DescribeMutableStateResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SyncSearchAttributes", varargs...)
+	ret0, _ := ret[0].(*adminservice.SyncSearchAttributesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeMutableState indicates an expected call of DescribeMutableState.
-func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 interface{}) *gomock.Call {
+// SyncSearchAttributes indicates an expected call of SyncSearchAttributes.
+func (mr *MockAdminServiceClientMockRecorder) SyncSearchAttributes(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncSearchAttributes", reflect.TypeOf((*MockAdminServiceClient)(nil).SyncSearchAttributes), varargs...)
 }
 
-// GetDLQMessages mocks base method.
-func (m *MockAdminServiceServer) GetDLQMessages(arg0 context.Context, arg1 *adminservice.GetDLQMessagesRequest) (*adminservice.GetDLQMessagesResponse, error) {
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *adminservice.UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDLQMessages", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.GetDLQMessagesResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.UnpauseWorkflowExecutionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDLQMessages indicates an expected call of GetDLQMessages.
-func (mr *MockAdminServiceServerMockRecorder) GetDLQMessages(arg0, arg1 interface{}) *gomock.Call {
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) UnpauseWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDLQMessages), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).UnpauseWorkflowExecution), varargs...)
 }
 
-// GetDLQReplicationMessages mocks base method.
-func (m *MockAdminServiceServer) GetDLQReplicationMessages(arg0 context.Context, arg1 *adminservice.GetDLQReplicationMessagesRequest) (*adminservice.GetDLQReplicationMessagesResponse, error) {
+// UpdateNamespaceDataResidencyPolicy mocks base method.
+func (m *MockAdminServiceClient) UpdateNamespaceDataResidencyPolicy(ctx context.Context, in *adminservice.UpdateNamespaceDataResidencyPolicyRequest, opts ...grpc.CallOption) (*adminservice.UpdateNamespaceDataResidencyPolicyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDLQReplicationMessages", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.GetDLQReplicationMessagesResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateNamespaceDataResidencyPolicy", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateNamespaceDataResidencyPolicyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDLQReplicationMessages indicates an expected call of GetDLQReplicationMessages.
-func (mr *MockAdminServiceServerMockRecorder) GetDLQReplicationMessages(arg0, arg1 interface{}) *gomock.Call {
+// UpdateNamespaceDataResidencyPolicy indicates an expected call of UpdateNamespaceDataResidencyPolicy.
+func (mr *MockAdminServiceClientMockRecorder) UpdateNamespaceDataResidencyPolicy(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDLQReplicationMessages), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNamespaceDataResidencyPolicy", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateNamespaceDataResidencyPolicy), varargs...)
 }
 
-// GetNamespaceReplicationMessages mocks base method.
-func (m *MockAdminServiceServer) GetNamespaceReplicationMessages(arg0 context.Context, arg1 *adminservice.GetNamespaceReplicationMessagesRequest) (*adminservice.GetNamespaceReplicationMessagesResponse, error) {
+// UpdateNamespaceReplicationFilter mocks base method.
+func (m *MockAdminServiceClient) UpdateNamespaceReplicationFilter(ctx context.Context, in *adminservice.UpdateNamespaceReplicationFilterRequest, opts ...grpc.CallOption) (*adminservice.UpdateNamespaceReplicationFilterResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNamespaceReplicationMessages", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.GetNamespaceReplicationMessagesResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateNamespaceReplicationFilter", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateNamespaceReplicationFilterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetNamespaceReplicationMessages indicates an expected call of GetNamespaceReplicationMessages.
-func (mr *MockAdminServiceServerMockRecorder) GetNamespaceReplicationMessages(arg0, arg1 interface{}) *gomock.Call {
+// UpdateNamespaceReplicationFilter indicates an expected call of UpdateNamespaceReplicationFilter.
+func (mr *MockAdminServiceClientMockRecorder) UpdateNamespaceReplicationFilter(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetNamespaceReplicationMessages), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNamespaceReplicationFilter", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateNamespaceReplicationFilter), varargs...)
+}
+
+// VerifyWorkflowReplication mocks base method.
+func (m *MockAdminServiceClient) VerifyWorkflowReplication(ctx context.Context, in *adminservice.VerifyWorkflowReplicationRequest, opts ...grpc.CallOption) (*adminservice.VerifyWorkflowReplicationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyWorkflowReplication", varargs...)
+	ret0, _ := ret[0].(*adminservice.VerifyWorkflowReplicationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyWorkflowReplication indicates an expected call of VerifyWorkflowReplication.
+func (mr *MockAdminServiceClientMockRecorder) VerifyWorkflowReplication(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyWorkflowReplication", reflect.TypeOf((*MockAdminServiceClient)(nil).VerifyWorkflowReplication), varargs...)
+}
+
+// MockAdminService_StreamReplicationMessagesClient is a mock of AdminService_StreamReplicationMessagesClient interface.
+type MockAdminService_StreamReplicationMessagesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminService_StreamReplicationMessagesClientMockRecorder
+}
+
+// MockAdminService_StreamReplicationMessagesClientMockRecorder is the mock recorder for MockAdminService_StreamReplicationMessagesClient.
+type MockAdminService_StreamReplicationMessagesClientMockRecorder struct {
+	mock *MockAdminService_StreamReplicationMessagesClient
+}
+
+// NewMockAdminService_StreamReplicationMessagesClient creates a new mock instance.
+func NewMockAdminService_StreamReplicationMessagesClient(ctrl *gomock.Controller) *MockAdminService_StreamReplicationMessagesClient {
+	mock := &MockAdminService_StreamReplicationMessagesClient{ctrl: ctrl}
+	mock.recorder = &MockAdminService_StreamReplicationMessagesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService_StreamReplicationMessagesClient) EXPECT() *MockAdminService_StreamReplicationMessagesClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockAdminService_StreamReplicationMessagesClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockAdminService_StreamReplicationMessagesClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockAdminService_StreamReplicationMessagesClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesClient) Recv() (*adminservice.StreamReplicationMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*adminservice.StreamReplicationMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockAdminService_StreamReplicationMessagesClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockAdminService_StreamReplicationMessagesClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockAdminService_StreamReplicationMessagesClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesClient)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesClient) Send(arg0 *adminservice.StreamReplicationMessagesRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockAdminService_StreamReplicationMessagesClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesClient)(nil).Send), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockAdminService_StreamReplicationMessagesClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockAdminService_StreamReplicationMessagesClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockAdminService_StreamReplicationMessagesClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesClient)(nil).Trailer))
+}
+
+// MockAdminServiceServer is a mock of AdminServiceServer interface.
+type MockAdminServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminServiceServerMockRecorder
+}
+
+// MockAdminServiceServerMockRecorder is the mock recorder for MockAdminServiceServer.
+type MockAdminServiceServerMockRecorder struct {
+	mock *MockAdminServiceServer
+}
+
+// NewMockAdminServiceServer creates a new mock instance.
+func NewMockAdminServiceServer(ctrl *gomock.Controller) *MockAdminServiceServer {
+	mock := &MockAdminServiceServer{ctrl: ctrl}
+	mock.recorder = &MockAdminServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminServiceServer) EXPECT() *MockAdminServiceServerMockRecorder {
+	return m.recorder
+}
+
+// AddOrUpdateRemoteCluster mocks base method.
+func (m *MockAdminServiceServer) AddOrUpdateRemoteCluster(arg0 context.Context, arg1 *adminservice.AddOrUpdateRemoteClusterRequest) (*adminservice.AddOrUpdateRemoteClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOrUpdateRemoteCluster", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.AddOrUpdateRemoteClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddOrUpdateRemoteCluster indicates an expected call of AddOrUpdateRemoteCluster.
+func (mr *MockAdminServiceServerMockRecorder) AddOrUpdateRemoteCluster(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrUpdateRemoteCluster", reflect.TypeOf((*MockAdminServiceServer)(nil).AddOrUpdateRemoteCluster), arg0, arg1)
+}
+
+// AddSearchAttributes mocks base method.
+func (m *MockAdminServiceServer) AddSearchAttributes(arg0 context.Context, arg1 *adminservice.AddSearchAttributesRequest) (*adminservice.AddSearchAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSearchAttributes", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.AddSearchAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddSearchAttributes indicates an expected call of AddSearchAttributes.
+func (mr *MockAdminServiceServerMockRecorder) AddSearchAttributes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSearchAttributes", reflect.TypeOf((*MockAdminServiceServer)(nil).AddSearchAttributes), arg0, arg1)
+}
+
+// CloseShard mocks base method.
+func (m *MockAdminServiceServer) CloseShard(arg0 context.Context, arg1 *adminservice.CloseShardRequest) (*adminservice.CloseShardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseShard", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CloseShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseShard indicates an expected call of CloseShard.
+func (mr *MockAdminServiceServerMockRecorder) CloseShard(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceServer)(nil).CloseShard), arg0, arg1)
+}
+
+// CreateApiKey mocks base method.
+func (m *MockAdminServiceServer) CreateApiKey(arg0 context.Context, arg1 *adminservice.CreateApiKeyRequest) (*adminservice.CreateApiKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApiKey", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CreateApiKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateApiKey indicates an expected call of CreateApiKey.
+func (mr *MockAdminServiceServerMockRecorder) CreateApiKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApiKey", reflect.TypeOf((*MockAdminServiceServer)(nil).CreateApiKey), arg0, arg1)
+}
+
+// DescribeCluster mocks base method.
+func (m *MockAdminServiceServer) DescribeCluster(arg0 context.Context, arg1 *adminservice.DescribeClusterRequest) (*adminservice.DescribeClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCluster", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCluster indicates an expected call of DescribeCluster.
+func (mr *MockAdminServiceServerMockRecorder) DescribeCluster(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCluster", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeCluster), arg0, arg1)
+}
+
+// DescribeHistoryHost mocks base method.
+func (m *MockAdminServiceServer) DescribeHistoryHost(arg0 context.Context, arg1 *adminservice.DescribeHistoryHostRequest) (*adminservice.DescribeHistoryHostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeHistoryHost", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeHistoryHostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeHistoryHost indicates an expected call of DescribeHistoryHost.
+func (mr *MockAdminServiceServerMockRecorder) DescribeHistoryHost(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHost", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeHistoryHost), arg0, arg1)
+}
+
+// DescribeMutableState mocks base method.
+func (m *MockAdminServiceServer) DescribeMutableState(arg0 context.Context, arg1 *adminservice.DescribeMutableStateRequest) (*adminservice.DescribeMutableStateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMutableState", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeMutableStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMutableState indicates an expected call of DescribeMutableState.
+func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
+}
+
+// DescribeNamespaceHandover mocks base method.
+func (m *MockAdminServiceServer) DescribeNamespaceHandover(arg0 context.Context, arg1 *adminservice.DescribeNamespaceHandoverRequest) (*adminservice.DescribeNamespaceHandoverResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeNamespaceHandover", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeNamespaceHandoverResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNamespaceHandover indicates an expected call of DescribeNamespaceHandover.
+func (mr *MockAdminServiceServerMockRecorder) DescribeNamespaceHandover(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespaceHandover", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeNamespaceHandover), arg0, arg1)
+}
+
+// GetDLQMessages mocks base method.
+func (m *MockAdminServiceServer) GetDLQMessages(arg0 context.Context, arg1 *adminservice.GetDLQMessagesRequest) (*adminservice.GetDLQMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDLQMessages", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetDLQMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDLQMessages indicates an expected call of GetDLQMessages.
+func (mr *MockAdminServiceServerMockRecorder) GetDLQMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDLQMessages), arg0, arg1)
+}
+
+// GetDLQReplicationMessages mocks base method.
+func (m *MockAdminServiceServer) GetDLQReplicationMessages(arg0 context.Context, arg1 *adminservice.GetDLQReplicationMessagesRequest) (*adminservice.GetDLQReplicationMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDLQReplicationMessages", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetDLQReplicationMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDLQReplicationMessages indicates an expected call of GetDLQReplicationMessages.
+func (mr *MockAdminServiceServerMockRecorder) GetDLQReplicationMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDLQReplicationMessages), arg0, arg1)
+}
+
+// GetNamespaceReplicationMessages mocks base method.
+func (m *MockAdminServiceServer) GetNamespaceReplicationMessages(arg0 context.Context, arg1 *adminservice.GetNamespaceReplicationMessagesRequest) (*adminservice.GetNamespaceReplicationMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNamespaceReplicationMessages", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetNamespaceReplicationMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaceReplicationMessages indicates an expected call of GetNamespaceReplicationMessages.
+func (mr *MockAdminServiceServerMockRecorder) GetNamespaceReplicationMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetNamespaceReplicationMessages), arg0, arg1)
+}
+
+// GetReplicationLag mocks base method.
+func (m *MockAdminServiceServer) GetReplicationLag(arg0 context.Context, arg1 *adminservice.GetReplicationLagRequest) (*adminservice.GetReplicationLagResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationLag", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetReplicationLagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationLag indicates an expected call of GetReplicationLag.
+func (mr *MockAdminServiceServerMockRecorder) GetReplicationLag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationLag", reflect.TypeOf((*MockAdminServiceServer)(nil).GetReplicationLag), arg0, arg1)
 }
 
 // GetReplicationMessages mocks base method.
@@ -813,6 +1396,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetWorkflowExecutionRawHistoryV2(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceServer)(nil).GetWorkflowExecutionRawHistoryV2), arg0, arg1)
 }
 
+// ListApiKeys mocks base method.
+func (m *MockAdminServiceServer) ListApiKeys(arg0 context.Context, arg1 *adminservice.ListApiKeysRequest) (*adminservice.ListApiKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApiKeys", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListApiKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApiKeys indicates an expected call of ListApiKeys.
+func (mr *MockAdminServiceServerMockRecorder) ListApiKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApiKeys", reflect.TypeOf((*MockAdminServiceServer)(nil).ListApiKeys), arg0, arg1)
+}
+
 // ListClusterMembers mocks base method.
 func (m *MockAdminServiceServer) ListClusterMembers(arg0 context.Context, arg1 *adminservice.ListClusterMembersRequest) (*adminservice.ListClusterMembersResponse, error) {
 	m.ctrl.T.Helper()
@@ -828,6 +1426,21 @@ func (mr *MockAdminServiceServerMockRecorder) ListClusterMembers(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterMembers", reflect.TypeOf((*MockAdminServiceServer)(nil).ListClusterMembers), arg0, arg1)
 }
 
+// ListClusterMetadataHistory mocks base method.
+func (m *MockAdminServiceServer) ListClusterMetadataHistory(arg0 context.Context, arg1 *adminservice.ListClusterMetadataHistoryRequest) (*adminservice.ListClusterMetadataHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusterMetadataHistory", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListClusterMetadataHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusterMetadataHistory indicates an expected call of ListClusterMetadataHistory.
+func (mr *MockAdminServiceServerMockRecorder) ListClusterMetadataHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterMetadataHistory", reflect.TypeOf((*MockAdminServiceServer)(nil).ListClusterMetadataHistory), arg0, arg1)
+}
+
 // ListClusters mocks base method.
 func (m *MockAdminServiceServer) ListClusters(arg0 context.Context, arg1 *adminservice.ListClustersRequest) (*adminservice.ListClustersResponse, error) {
 	m.ctrl.T.Helper()
@@ -858,6 +1471,21 @@ func (mr *MockAdminServiceServerMockRecorder) ListHistoryTasks(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ListHistoryTasks), arg0, arg1)
 }
 
+// ListPollerCounts mocks base method.
+func (m *MockAdminServiceServer) ListPollerCounts(arg0 context.Context, arg1 *adminservice.ListPollerCountsRequest) (*adminservice.ListPollerCountsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPollerCounts", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListPollerCountsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPollerCounts indicates an expected call of ListPollerCounts.
+func (mr *MockAdminServiceServerMockRecorder) ListPollerCounts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPollerCounts", reflect.TypeOf((*MockAdminServiceServer)(nil).ListPollerCounts), arg0, arg1)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) MergeDLQMessages(arg0 context.Context, arg1 *adminservice.MergeDLQMessagesRequest) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -873,6 +1501,21 @@ func (mr *MockAdminServiceServerMockRecorder) MergeDLQMessages(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).MergeDLQMessages), arg0, arg1)
 }
 
+// MergeDLQMessagesAllShards mocks base method.
+func (m *MockAdminServiceServer) MergeDLQMessagesAllShards(arg0 context.Context, arg1 *adminservice.MergeDLQMessagesAllShardsRequest) (*adminservice.MergeDLQMessagesAllShardsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeDLQMessagesAllShards", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.MergeDLQMessagesAllShardsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeDLQMessagesAllShards indicates an expected call of MergeDLQMessagesAllShards.
+func (mr *MockAdminServiceServerMockRecorder) MergeDLQMessagesAllShards(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessagesAllShards", reflect.TypeOf((*MockAdminServiceServer)(nil).MergeDLQMessagesAllShards), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) PurgeDLQMessages(arg0 context.Context, arg1 *adminservice.PurgeDLQMessagesRequest) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -888,6 +1531,21 @@ func (mr *MockAdminServiceServerMockRecorder) PurgeDLQMessages(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).PurgeDLQMessages), arg0, arg1)
 }
 
+// PurgeDLQMessagesAllShards mocks base method.
+func (m *MockAdminServiceServer) PurgeDLQMessagesAllShards(arg0 context.Context, arg1 *adminservice.PurgeDLQMessagesAllShardsRequest) (*adminservice.PurgeDLQMessagesAllShardsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDLQMessagesAllShards", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PurgeDLQMessagesAllShardsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDLQMessagesAllShards indicates an expected call of PurgeDLQMessagesAllShards.
+func (mr *MockAdminServiceServerMockRecorder) PurgeDLQMessagesAllShards(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQMessagesAllShards", reflect.TypeOf((*MockAdminServiceServer)(nil).PurgeDLQMessagesAllShards), arg0, arg1)
+}
+
 // ReapplyEvents mocks base method.
 func (m *MockAdminServiceServer) ReapplyEvents(arg0 context.Context, arg1 *adminservice.ReapplyEventsRequest) (*adminservice.ReapplyEventsResponse, error) {
 	m.ctrl.T.Helper()
@@ -978,6 +1636,36 @@ func (mr *MockAdminServiceServerMockRecorder) RemoveTask(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockAdminServiceServer)(nil).RemoveTask), arg0, arg1)
 }
 
+// RenameNamespace mocks base method.
+func (m *MockAdminServiceServer) RenameNamespace(arg0 context.Context, arg1 *adminservice.RenameNamespaceRequest) (*adminservice.RenameNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameNamespace", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RenameNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameNamespace indicates an expected call of RenameNamespace.
+func (mr *MockAdminServiceServerMockRecorder) RenameNamespace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameNamespace", reflect.TypeOf((*MockAdminServiceServer)(nil).RenameNamespace), arg0, arg1)
+}
+
+// ReportNamespaceQuotaUsage mocks base method.
+func (m *MockAdminServiceServer) ReportNamespaceQuotaUsage(arg0 context.Context, arg1 *adminservice.ReportNamespaceQuotaUsageRequest) (*adminservice.ReportNamespaceQuotaUsageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportNamespaceQuotaUsage", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ReportNamespaceQuotaUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReportNamespaceQuotaUsage indicates an expected call of ReportNamespaceQuotaUsage.
+func (mr *MockAdminServiceServerMockRecorder) ReportNamespaceQuotaUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportNamespaceQuotaUsage", reflect.TypeOf((*MockAdminServiceServer)(nil).ReportNamespaceQuotaUsage), arg0, arg1)
+}
+
 // ResendReplicationTasks mocks base method.
 func (m *MockAdminServiceServer) ResendReplicationTasks(arg0 context.Context, arg1 *adminservice.ResendReplicationTasksRequest) (*adminservice.ResendReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -992,3 +1680,286 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
+
+// RevokeApiKey mocks base method.
+func (m *MockAdminServiceServer) RevokeApiKey(arg0 context.Context, arg1 *adminservice.RevokeApiKeyRequest) (*adminservice.RevokeApiKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeApiKey", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RevokeApiKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeApiKey indicates an expected call of RevokeApiKey.
+func (mr *MockAdminServiceServerMockRecorder) RevokeApiKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeApiKey", reflect.TypeOf((*MockAdminServiceServer)(nil).RevokeApiKey), arg0, arg1)
+}
+
+// RollbackClusterMetadata mocks base method.
+func (m *MockAdminServiceServer) RollbackClusterMetadata(arg0 context.Context, arg1 *adminservice.RollbackClusterMetadataRequest) (*adminservice.RollbackClusterMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackClusterMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RollbackClusterMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackClusterMetadata indicates an expected call of RollbackClusterMetadata.
+func (mr *MockAdminServiceServerMockRecorder) RollbackClusterMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackClusterMetadata", reflect.TypeOf((*MockAdminServiceServer)(nil).RollbackClusterMetadata), arg0, arg1)
+}
+
+// RotateApiKey mocks base method.
+func (m *MockAdminServiceServer) RotateApiKey(arg0 context.Context, arg1 *adminservice.RotateApiKeyRequest) (*adminservice.RotateApiKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateApiKey", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RotateApiKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateApiKey indicates an expected call of RotateApiKey.
+func (mr *MockAdminServiceServerMockRecorder) RotateApiKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateApiKey", reflect.TypeOf((*MockAdminServiceServer)(nil).RotateApiKey), arg0, arg1)
+}
+
+// StartNamespaceHandover mocks base method.
+func (m *MockAdminServiceServer) StartNamespaceHandover(arg0 context.Context, arg1 *adminservice.StartNamespaceHandoverRequest) (*adminservice.StartNamespaceHandoverResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartNamespaceHandover", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartNamespaceHandoverResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartNamespaceHandover indicates an expected call of StartNamespaceHandover.
+func (mr *MockAdminServiceServerMockRecorder) StartNamespaceHandover(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartNamespaceHandover", reflect.TypeOf((*MockAdminServiceServer)(nil).StartNamespaceHandover), arg0, arg1)
+}
+
+// StreamReplicationMessages mocks base method.
+func (m *MockAdminServiceServer) StreamReplicationMessages(arg0 adminservice.AdminService_StreamReplicationMessagesServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamReplicationMessages", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamReplicationMessages indicates an expected call of StreamReplicationMessages.
+func (mr *MockAdminServiceServerMockRecorder) StreamReplicationMessages(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).StreamReplicationMessages), arg0)
+}
+
+// SyncSearchAttributes mocks base method.
+func (m *MockAdminServiceServer) SyncSearchAttributes(arg0 context.Context, arg1 *adminservice.SyncSearchAttributesRequest) (*adminservice.SyncSearchAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncSearchAttributes", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SyncSearchAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncSearchAttributes indicates an expected call of SyncSearchAttributes.
+func (mr *MockAdminServiceServerMockRecorder) SyncSearchAttributes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncSearchAttributes", reflect.TypeOf((*MockAdminServiceServer)(nil).SyncSearchAttributes), arg0, arg1)
+}
+
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) UnpauseWorkflowExecution(arg0 context.Context, arg1 *adminservice.UnpauseWorkflowExecutionRequest) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) UnpauseWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).UnpauseWorkflowExecution), arg0, arg1)
+}
+
+// UpdateNamespaceDataResidencyPolicy mocks base method.
+func (m *MockAdminServiceServer) UpdateNamespaceDataResidencyPolicy(arg0 context.Context, arg1 *adminservice.UpdateNamespaceDataResidencyPolicyRequest) (*adminservice.UpdateNamespaceDataResidencyPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNamespaceDataResidencyPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateNamespaceDataResidencyPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNamespaceDataResidencyPolicy indicates an expected call of UpdateNamespaceDataResidencyPolicy.
+func (mr *MockAdminServiceServerMockRecorder) UpdateNamespaceDataResidencyPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNamespaceDataResidencyPolicy", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateNamespaceDataResidencyPolicy), arg0, arg1)
+}
+
+// UpdateNamespaceReplicationFilter mocks base method.
+func (m *MockAdminServiceServer) UpdateNamespaceReplicationFilter(arg0 context.Context, arg1 *adminservice.UpdateNamespaceReplicationFilterRequest) (*adminservice.UpdateNamespaceReplicationFilterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNamespaceReplicationFilter", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateNamespaceReplicationFilterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNamespaceReplicationFilter indicates an expected call of UpdateNamespaceReplicationFilter.
+func (mr *MockAdminServiceServerMockRecorder) UpdateNamespaceReplicationFilter(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNamespaceReplicationFilter", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateNamespaceReplicationFilter), arg0, arg1)
+}
+
+// VerifyWorkflowReplication mocks base method.
+func (m *MockAdminServiceServer) VerifyWorkflowReplication(arg0 context.Context, arg1 *adminservice.VerifyWorkflowReplicationRequest) (*adminservice.VerifyWorkflowReplicationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyWorkflowReplication", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.VerifyWorkflowReplicationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyWorkflowReplication indicates an expected call of VerifyWorkflowReplication.
+func (mr *MockAdminServiceServerMockRecorder) VerifyWorkflowReplication(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyWorkflowReplication", reflect.TypeOf((*MockAdminServiceServer)(nil).VerifyWorkflowReplication), arg0, arg1)
+}
+
+// MockAdminService_StreamReplicationMessagesServer is a mock of AdminService_StreamReplicationMessagesServer interface.
+type MockAdminService_StreamReplicationMessagesServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminService_StreamReplicationMessagesServerMockRecorder
+}
+
+// MockAdminService_StreamReplicationMessagesServerMockRecorder is the mock recorder for MockAdminService_StreamReplicationMessagesServer.
+type MockAdminService_StreamReplicationMessagesServerMockRecorder struct {
+	mock *MockAdminService_StreamReplicationMessagesServer
+}
+
+// NewMockAdminService_StreamReplicationMessagesServer creates a new mock instance.
+func NewMockAdminService_StreamReplicationMessagesServer(ctrl *gomock.Controller) *MockAdminService_StreamReplicationMessagesServer {
+	mock := &MockAdminService_StreamReplicationMessagesServer{ctrl: ctrl}
+	mock.recorder = &MockAdminService_StreamReplicationMessagesServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService_StreamReplicationMessagesServer) EXPECT() *MockAdminService_StreamReplicationMessagesServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockAdminService_StreamReplicationMessagesServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesServer)(nil).Context))
+}
+
+// Recv mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesServer) Recv() (*adminservice.StreamReplicationMessagesRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*adminservice.StreamReplicationMessagesRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockAdminService_StreamReplicationMessagesServerMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesServer)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockAdminService_StreamReplicationMessagesServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockAdminService_StreamReplicationMessagesServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesServer) Send(arg0 *adminservice.StreamReplicationMessagesResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockAdminService_StreamReplicationMessagesServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockAdminService_StreamReplicationMessagesServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockAdminService_StreamReplicationMessagesServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockAdminService_StreamReplicationMessagesServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockAdminService_StreamReplicationMessagesServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockAdminService_StreamReplicationMessagesServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesServer)(nil).SetTrailer), arg0)
+}
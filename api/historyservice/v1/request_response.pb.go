@@ -2621,11 +2621,13 @@ func (m *DescribeWorkflowExecutionRequest) GetRequest() *v1.DescribeWorkflowExec
 }
 
 type DescribeWorkflowExecutionResponse struct {
-	ExecutionConfig       *v110.WorkflowExecutionConfig     `protobuf:"bytes,1,opt,name=execution_config,json=executionConfig,proto3" json:"execution_config,omitempty"`
-	WorkflowExecutionInfo *v110.WorkflowExecutionInfo       `protobuf:"bytes,2,opt,name=workflow_execution_info,json=workflowExecutionInfo,proto3" json:"workflow_execution_info,omitempty"`
-	PendingActivities     []*v110.PendingActivityInfo       `protobuf:"bytes,3,rep,name=pending_activities,json=pendingActivities,proto3" json:"pending_activities,omitempty"`
-	PendingChildren       []*v110.PendingChildExecutionInfo `protobuf:"bytes,4,rep,name=pending_children,json=pendingChildren,proto3" json:"pending_children,omitempty"`
-	PendingWorkflowTask   *v110.PendingWorkflowTaskInfo     `protobuf:"bytes,5,opt,name=pending_workflow_task,json=pendingWorkflowTask,proto3" json:"pending_workflow_task,omitempty"`
+	ExecutionConfig           *v110.WorkflowExecutionConfig     `protobuf:"bytes,1,opt,name=execution_config,json=executionConfig,proto3" json:"execution_config,omitempty"`
+	WorkflowExecutionInfo     *v110.WorkflowExecutionInfo       `protobuf:"bytes,2,opt,name=workflow_execution_info,json=workflowExecutionInfo,proto3" json:"workflow_execution_info,omitempty"`
+	PendingActivities         []*v110.PendingActivityInfo       `protobuf:"bytes,3,rep,name=pending_activities,json=pendingActivities,proto3" json:"pending_activities,omitempty"`
+	PendingChildren           []*v110.PendingChildExecutionInfo `protobuf:"bytes,4,rep,name=pending_children,json=pendingChildren,proto3" json:"pending_children,omitempty"`
+	PendingWorkflowTask       *v110.PendingWorkflowTaskInfo     `protobuf:"bytes,5,opt,name=pending_workflow_task,json=pendingWorkflowTask,proto3" json:"pending_workflow_task,omitempty"`
+	SizeInfo                  *v11.ExecutionSizeInfo            `protobuf:"bytes,6,opt,name=size_info,json=sizeInfo,proto3" json:"size_info,omitempty"`
+	ConflictResolutionRecords []*v111.ConflictResolutionRecord  `protobuf:"bytes,7,rep,name=conflict_resolution_records,json=conflictResolutionRecords,proto3" json:"conflict_resolution_records,omitempty"`
 }
 
 func (m *DescribeWorkflowExecutionResponse) Reset()      { *m = DescribeWorkflowExecutionResponse{} }
@@ -2695,6 +2697,20 @@ func (m *DescribeWorkflowExecutionResponse) GetPendingWorkflowTask() *v110.Pendi
 	return nil
 }
 
+func (m *DescribeWorkflowExecutionResponse) GetSizeInfo() *v11.ExecutionSizeInfo {
+	if m != nil {
+		return m.SizeInfo
+	}
+	return nil
+}
+
+func (m *DescribeWorkflowExecutionResponse) GetConflictResolutionRecords() []*v111.ConflictResolutionRecord {
+	if m != nil {
+		return m.ConflictResolutionRecords
+	}
+	return nil
+}
+
 type ReplicateEventsV2Request struct {
 	NamespaceId         string                    `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowExecution   *v14.WorkflowExecution    `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -3136,6 +3152,7 @@ func (m *DescribeMutableStateRequest) GetExecution() *v14.WorkflowExecution {
 type DescribeMutableStateResponse struct {
 	CacheMutableState    *v111.WorkflowMutableState `protobuf:"bytes,1,opt,name=cache_mutable_state,json=cacheMutableState,proto3" json:"cache_mutable_state,omitempty"`
 	DatabaseMutableState *v111.WorkflowMutableState `protobuf:"bytes,2,opt,name=database_mutable_state,json=databaseMutableState,proto3" json:"database_mutable_state,omitempty"`
+	SizeInfo             *v11.ExecutionSizeInfo     `protobuf:"bytes,3,opt,name=size_info,json=sizeInfo,proto3" json:"size_info,omitempty"`
 }
 
 func (m *DescribeMutableStateResponse) Reset()      { *m = DescribeMutableStateResponse{} }
@@ -3184,6 +3201,13 @@ func (m *DescribeMutableStateResponse) GetDatabaseMutableState() *v111.WorkflowM
 	return nil
 }
 
+func (m *DescribeMutableStateResponse) GetSizeInfo() *v11.ExecutionSizeInfo {
+	if m != nil {
+		return m.SizeInfo
+	}
+	return nil
+}
+
 // At least one of the parameters needs to be provided.
 type DescribeHistoryHostRequest struct {
 	//ip:port
@@ -3492,6 +3516,101 @@ func (m *GetShardResponse) GetShardInfo() *v111.ShardInfo {
 	return nil
 }
 
+type CompleteShardHandoffRequest struct {
+	ShardId int32 `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// Identity of the host that released the shard.
+	PreviousOwner    string     `protobuf:"bytes,2,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
+	HandoffStartTime *time.Time `protobuf:"bytes,3,opt,name=handoff_start_time,json=handoffStartTime,proto3,stdtime" json:"handoff_start_time,omitempty"`
+}
+
+func (m *CompleteShardHandoffRequest) Reset()      { *m = CompleteShardHandoffRequest{} }
+func (*CompleteShardHandoffRequest) ProtoMessage() {}
+func (*CompleteShardHandoffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{58}
+}
+func (m *CompleteShardHandoffRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompleteShardHandoffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompleteShardHandoffRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompleteShardHandoffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompleteShardHandoffRequest.Merge(m, src)
+}
+func (m *CompleteShardHandoffRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompleteShardHandoffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompleteShardHandoffRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompleteShardHandoffRequest proto.InternalMessageInfo
+
+func (m *CompleteShardHandoffRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *CompleteShardHandoffRequest) GetPreviousOwner() string {
+	if m != nil {
+		return m.PreviousOwner
+	}
+	return ""
+}
+
+func (m *CompleteShardHandoffRequest) GetHandoffStartTime() *time.Time {
+	if m != nil {
+		return m.HandoffStartTime
+	}
+	return nil
+}
+
+type CompleteShardHandoffResponse struct {
+}
+
+func (m *CompleteShardHandoffResponse) Reset()      { *m = CompleteShardHandoffResponse{} }
+func (*CompleteShardHandoffResponse) ProtoMessage() {}
+func (*CompleteShardHandoffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{59}
+}
+func (m *CompleteShardHandoffResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompleteShardHandoffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompleteShardHandoffResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompleteShardHandoffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompleteShardHandoffResponse.Merge(m, src)
+}
+func (m *CompleteShardHandoffResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompleteShardHandoffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompleteShardHandoffResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompleteShardHandoffResponse proto.InternalMessageInfo
+
 type RemoveTaskRequest struct {
 	ShardId        int32            `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	Category       v16.TaskCategory `protobuf:"varint,2,opt,name=category,proto3,enum=temporal.server.api.enums.v1.TaskCategory" json:"category,omitempty"`
@@ -3502,7 +3621,7 @@ type RemoveTaskRequest struct {
 func (m *RemoveTaskRequest) Reset()      { *m = RemoveTaskRequest{} }
 func (*RemoveTaskRequest) ProtoMessage() {}
 func (*RemoveTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{58}
+	return fileDescriptor_b8c78c1d460a3711, []int{60}
 }
 func (m *RemoveTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3565,7 +3684,7 @@ type RemoveTaskResponse struct {
 func (m *RemoveTaskResponse) Reset()      { *m = RemoveTaskResponse{} }
 func (*RemoveTaskResponse) ProtoMessage() {}
 func (*RemoveTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{59}
+	return fileDescriptor_b8c78c1d460a3711, []int{61}
 }
 func (m *RemoveTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3597,12 +3716,14 @@ var xxx_messageInfo_RemoveTaskResponse proto.InternalMessageInfo
 type GetReplicationMessagesRequest struct {
 	Tokens      []*v113.ReplicationToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
 	ClusterName string                   `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// If set, wait up to this duration for new replication tasks of shards without tasks.
+	WaitForNewTasks *time.Duration `protobuf:"bytes,3,opt,name=wait_for_new_tasks,json=waitForNewTasks,proto3,stdduration" json:"wait_for_new_tasks,omitempty"`
 }
 
 func (m *GetReplicationMessagesRequest) Reset()      { *m = GetReplicationMessagesRequest{} }
 func (*GetReplicationMessagesRequest) ProtoMessage() {}
 func (*GetReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{60}
+	return fileDescriptor_b8c78c1d460a3711, []int{62}
 }
 func (m *GetReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3645,6 +3766,13 @@ func (m *GetReplicationMessagesRequest) GetClusterName() string {
 	return ""
 }
 
+func (m *GetReplicationMessagesRequest) GetWaitForNewTasks() *time.Duration {
+	if m != nil {
+		return m.WaitForNewTasks
+	}
+	return nil
+}
+
 type GetReplicationMessagesResponse struct {
 	ShardMessages map[int32]*v113.ReplicationMessages `protobuf:"bytes,1,rep,name=shard_messages,json=shardMessages,proto3" json:"shard_messages,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
@@ -3652,7 +3780,7 @@ type GetReplicationMessagesResponse struct {
 func (m *GetReplicationMessagesResponse) Reset()      { *m = GetReplicationMessagesResponse{} }
 func (*GetReplicationMessagesResponse) ProtoMessage() {}
 func (*GetReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{61}
+	return fileDescriptor_b8c78c1d460a3711, []int{63}
 }
 func (m *GetReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3690,12 +3818,14 @@ func (m *GetReplicationMessagesResponse) GetShardMessages() map[int32]*v113.Repl
 
 type GetDLQReplicationMessagesRequest struct {
 	TaskInfos []*v113.ReplicationTaskInfo `protobuf:"bytes,1,rep,name=task_infos,json=taskInfos,proto3" json:"task_infos,omitempty"`
+	// Name of the cluster fetching the messages, tasks it may not receive are skipped.
+	ClusterName string `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
 }
 
 func (m *GetDLQReplicationMessagesRequest) Reset()      { *m = GetDLQReplicationMessagesRequest{} }
 func (*GetDLQReplicationMessagesRequest) ProtoMessage() {}
 func (*GetDLQReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{62}
+	return fileDescriptor_b8c78c1d460a3711, []int{64}
 }
 func (m *GetDLQReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3731,6 +3861,13 @@ func (m *GetDLQReplicationMessagesRequest) GetTaskInfos() []*v113.ReplicationTas
 	return nil
 }
 
+func (m *GetDLQReplicationMessagesRequest) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
+	}
+	return ""
+}
+
 type GetDLQReplicationMessagesResponse struct {
 	ReplicationTasks []*v113.ReplicationTask `protobuf:"bytes,1,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
 }
@@ -3738,7 +3875,7 @@ type GetDLQReplicationMessagesResponse struct {
 func (m *GetDLQReplicationMessagesResponse) Reset()      { *m = GetDLQReplicationMessagesResponse{} }
 func (*GetDLQReplicationMessagesResponse) ProtoMessage() {}
 func (*GetDLQReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{63}
+	return fileDescriptor_b8c78c1d460a3711, []int{65}
 }
 func (m *GetDLQReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3782,7 +3919,7 @@ type QueryWorkflowRequest struct {
 func (m *QueryWorkflowRequest) Reset()      { *m = QueryWorkflowRequest{} }
 func (*QueryWorkflowRequest) ProtoMessage() {}
 func (*QueryWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{64}
+	return fileDescriptor_b8c78c1d460a3711, []int{66}
 }
 func (m *QueryWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3832,7 +3969,7 @@ type QueryWorkflowResponse struct {
 func (m *QueryWorkflowResponse) Reset()      { *m = QueryWorkflowResponse{} }
 func (*QueryWorkflowResponse) ProtoMessage() {}
 func (*QueryWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{65}
+	return fileDescriptor_b8c78c1d460a3711, []int{67}
 }
 func (m *QueryWorkflowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3876,7 +4013,7 @@ type ReapplyEventsRequest struct {
 func (m *ReapplyEventsRequest) Reset()      { *m = ReapplyEventsRequest{} }
 func (*ReapplyEventsRequest) ProtoMessage() {}
 func (*ReapplyEventsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{66}
+	return fileDescriptor_b8c78c1d460a3711, []int{68}
 }
 func (m *ReapplyEventsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3925,7 +4062,7 @@ type ReapplyEventsResponse struct {
 func (m *ReapplyEventsResponse) Reset()      { *m = ReapplyEventsResponse{} }
 func (*ReapplyEventsResponse) ProtoMessage() {}
 func (*ReapplyEventsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{67}
+	return fileDescriptor_b8c78c1d460a3711, []int{69}
 }
 func (m *ReapplyEventsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3966,7 +4103,7 @@ type GetDLQMessagesRequest struct {
 func (m *GetDLQMessagesRequest) Reset()      { *m = GetDLQMessagesRequest{} }
 func (*GetDLQMessagesRequest) ProtoMessage() {}
 func (*GetDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{68}
+	return fileDescriptor_b8c78c1d460a3711, []int{70}
 }
 func (m *GetDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4046,7 +4183,7 @@ type GetDLQMessagesResponse struct {
 func (m *GetDLQMessagesResponse) Reset()      { *m = GetDLQMessagesResponse{} }
 func (*GetDLQMessagesResponse) ProtoMessage() {}
 func (*GetDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{69}
+	return fileDescriptor_b8c78c1d460a3711, []int{71}
 }
 func (m *GetDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4101,12 +4238,17 @@ type PurgeDLQMessagesRequest struct {
 	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
 	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
+	// If filter is set or dry run, messages are purged one page at a time and ack level is not changed.
+	Filter          *v113.ReplicationDLQFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	DryRun          bool                       `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	MaximumPageSize int32                      `protobuf:"varint,7,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
+	NextPageToken   []byte                     `protobuf:"bytes,8,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (m *PurgeDLQMessagesRequest) Reset()      { *m = PurgeDLQMessagesRequest{} }
 func (*PurgeDLQMessagesRequest) ProtoMessage() {}
 func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{70}
+	return fileDescriptor_b8c78c1d460a3711, []int{72}
 }
 func (m *PurgeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4163,13 +4305,44 @@ func (m *PurgeDLQMessagesRequest) GetInclusiveEndMessageId() int64 {
 	return 0
 }
 
+func (m *PurgeDLQMessagesRequest) GetFilter() *v113.ReplicationDLQFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *PurgeDLQMessagesRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *PurgeDLQMessagesRequest) GetMaximumPageSize() int32 {
+	if m != nil {
+		return m.MaximumPageSize
+	}
+	return 0
+}
+
+func (m *PurgeDLQMessagesRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
 type PurgeDLQMessagesResponse struct {
+	// Number of purged messages, or messages which would be purged in dry run. Only set with filter or dry run.
+	MessageCount  int64  `protobuf:"varint,1,opt,name=message_count,json=messageCount,proto3" json:"message_count,omitempty"`
+	NextPageToken []byte `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (m *PurgeDLQMessagesResponse) Reset()      { *m = PurgeDLQMessagesResponse{} }
 func (*PurgeDLQMessagesResponse) ProtoMessage() {}
 func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{71}
+	return fileDescriptor_b8c78c1d460a3711, []int{73}
 }
 func (m *PurgeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4198,6 +4371,20 @@ func (m *PurgeDLQMessagesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PurgeDLQMessagesResponse proto.InternalMessageInfo
 
+func (m *PurgeDLQMessagesResponse) GetMessageCount() int64 {
+	if m != nil {
+		return m.MessageCount
+	}
+	return 0
+}
+
+func (m *PurgeDLQMessagesResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
 type MergeDLQMessagesRequest struct {
 	Type                  v16.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
 	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
@@ -4205,12 +4392,15 @@ type MergeDLQMessagesRequest struct {
 	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
 	MaximumPageSize       int32                   `protobuf:"varint,5,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
 	NextPageToken         []byte                  `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// If filter is set or dry run, only merged messages are deleted and ack level is not changed.
+	Filter *v113.ReplicationDLQFilter `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
+	DryRun bool                       `protobuf:"varint,8,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
 func (m *MergeDLQMessagesRequest) Reset()      { *m = MergeDLQMessagesRequest{} }
 func (*MergeDLQMessagesRequest) ProtoMessage() {}
 func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{72}
+	return fileDescriptor_b8c78c1d460a3711, []int{74}
 }
 func (m *MergeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4281,14 +4471,30 @@ func (m *MergeDLQMessagesRequest) GetNextPageToken() []byte {
 	return nil
 }
 
+func (m *MergeDLQMessagesRequest) GetFilter() *v113.ReplicationDLQFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *MergeDLQMessagesRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 type MergeDLQMessagesResponse struct {
 	NextPageToken []byte `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Number of merged messages, or messages which would be merged in dry run. Only set with filter or dry run.
+	MessageCount int64 `protobuf:"varint,2,opt,name=message_count,json=messageCount,proto3" json:"message_count,omitempty"`
 }
 
 func (m *MergeDLQMessagesResponse) Reset()      { *m = MergeDLQMessagesResponse{} }
 func (*MergeDLQMessagesResponse) ProtoMessage() {}
 func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{73}
+	return fileDescriptor_b8c78c1d460a3711, []int{75}
 }
 func (m *MergeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4324,6 +4530,13 @@ func (m *MergeDLQMessagesResponse) GetNextPageToken() []byte {
 	return nil
 }
 
+func (m *MergeDLQMessagesResponse) GetMessageCount() int64 {
+	if m != nil {
+		return m.MessageCount
+	}
+	return 0
+}
+
 type RefreshWorkflowTasksRequest struct {
 	NamespaceId string                            `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	Request     *v114.RefreshWorkflowTasksRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
@@ -4332,7 +4545,7 @@ type RefreshWorkflowTasksRequest struct {
 func (m *RefreshWorkflowTasksRequest) Reset()      { *m = RefreshWorkflowTasksRequest{} }
 func (*RefreshWorkflowTasksRequest) ProtoMessage() {}
 func (*RefreshWorkflowTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{74}
+	return fileDescriptor_b8c78c1d460a3711, []int{76}
 }
 func (m *RefreshWorkflowTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4381,7 +4594,7 @@ type RefreshWorkflowTasksResponse struct {
 func (m *RefreshWorkflowTasksResponse) Reset()      { *m = RefreshWorkflowTasksResponse{} }
 func (*RefreshWorkflowTasksResponse) ProtoMessage() {}
 func (*RefreshWorkflowTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{75}
+	return fileDescriptor_b8c78c1d460a3711, []int{77}
 }
 func (m *RefreshWorkflowTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4410,6 +4623,92 @@ func (m *RefreshWorkflowTasksResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RefreshWorkflowTasksResponse proto.InternalMessageInfo
 
+type UnpauseWorkflowExecutionRequest struct {
+	NamespaceId string                                `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Request     *v114.UnpauseWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *UnpauseWorkflowExecutionRequest) Reset()      { *m = UnpauseWorkflowExecutionRequest{} }
+func (*UnpauseWorkflowExecutionRequest) ProtoMessage() {}
+func (*UnpauseWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{78}
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpauseWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *UnpauseWorkflowExecutionRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *UnpauseWorkflowExecutionRequest) GetRequest() *v114.UnpauseWorkflowExecutionRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+type UnpauseWorkflowExecutionResponse struct {
+}
+
+func (m *UnpauseWorkflowExecutionResponse) Reset()      { *m = UnpauseWorkflowExecutionResponse{} }
+func (*UnpauseWorkflowExecutionResponse) ProtoMessage() {}
+func (*UnpauseWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{79}
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpauseWorkflowExecutionResponse proto.InternalMessageInfo
+
 type GenerateLastHistoryReplicationTasksRequest struct {
 	NamespaceId string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	Execution   *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
@@ -4420,7 +4719,7 @@ func (m *GenerateLastHistoryReplicationTasksRequest) Reset() {
 }
 func (*GenerateLastHistoryReplicationTasksRequest) ProtoMessage() {}
 func (*GenerateLastHistoryReplicationTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{76}
+	return fileDescriptor_b8c78c1d460a3711, []int{80}
 }
 func (m *GenerateLastHistoryReplicationTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4471,7 +4770,7 @@ func (m *GenerateLastHistoryReplicationTasksResponse) Reset() {
 }
 func (*GenerateLastHistoryReplicationTasksResponse) ProtoMessage() {}
 func (*GenerateLastHistoryReplicationTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{77}
+	return fileDescriptor_b8c78c1d460a3711, []int{81}
 }
 func (m *GenerateLastHistoryReplicationTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4503,12 +4802,14 @@ var xxx_messageInfo_GenerateLastHistoryReplicationTasksResponse proto.InternalMe
 type GetReplicationStatusRequest struct {
 	// Remote cluster names to query for. If omit, will return for all remote clusters.
 	RemoteClusters []string `protobuf:"bytes,1,rep,name=remote_clusters,json=remoteClusters,proto3" json:"remote_clusters,omitempty"`
+	// Scan pending replication tasks and replication DLQ to report per namespace lag and DLQ sizes.
+	IncludeDetails bool `protobuf:"varint,2,opt,name=include_details,json=includeDetails,proto3" json:"include_details,omitempty"`
 }
 
 func (m *GetReplicationStatusRequest) Reset()      { *m = GetReplicationStatusRequest{} }
 func (*GetReplicationStatusRequest) ProtoMessage() {}
 func (*GetReplicationStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{78}
+	return fileDescriptor_b8c78c1d460a3711, []int{82}
 }
 func (m *GetReplicationStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4544,6 +4845,13 @@ func (m *GetReplicationStatusRequest) GetRemoteClusters() []string {
 	return nil
 }
 
+func (m *GetReplicationStatusRequest) GetIncludeDetails() bool {
+	if m != nil {
+		return m.IncludeDetails
+	}
+	return false
+}
+
 type GetReplicationStatusResponse struct {
 	Shards []*ShardReplicationStatus `protobuf:"bytes,1,rep,name=shards,proto3" json:"shards,omitempty"`
 }
@@ -4551,7 +4859,7 @@ type GetReplicationStatusResponse struct {
 func (m *GetReplicationStatusResponse) Reset()      { *m = GetReplicationStatusResponse{} }
 func (*GetReplicationStatusResponse) ProtoMessage() {}
 func (*GetReplicationStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{79}
+	return fileDescriptor_b8c78c1d460a3711, []int{83}
 }
 func (m *GetReplicationStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4600,7 +4908,7 @@ type ShardReplicationStatus struct {
 func (m *ShardReplicationStatus) Reset()      { *m = ShardReplicationStatus{} }
 func (*ShardReplicationStatus) ProtoMessage() {}
 func (*ShardReplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{80}
+	return fileDescriptor_b8c78c1d460a3711, []int{84}
 }
 func (m *ShardReplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4672,7 +4980,7 @@ type HandoverNamespaceInfo struct {
 func (m *HandoverNamespaceInfo) Reset()      { *m = HandoverNamespaceInfo{} }
 func (*HandoverNamespaceInfo) ProtoMessage() {}
 func (*HandoverNamespaceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{81}
+	return fileDescriptor_b8c78c1d460a3711, []int{85}
 }
 func (m *HandoverNamespaceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4713,12 +5021,18 @@ type ShardReplicationStatusPerCluster struct {
 	AckedTaskId int64 `protobuf:"varint,1,opt,name=acked_task_id,json=ackedTaskId,proto3" json:"acked_task_id,omitempty"`
 	// Acked replication task creation time
 	AckedTaskVisibilityTime *time.Time `protobuf:"bytes,2,opt,name=acked_task_visibility_time,json=ackedTaskVisibilityTime,proto3,stdtime" json:"acked_task_visibility_time,omitempty"`
+	// Lag per namespace id, only set if details are requested.
+	Namespaces map[string]*v113.NamespaceReplicationLag `protobuf:"bytes,3,rep,name=namespaces,proto3" json:"namespaces,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Number of replication tasks from this cluster in the DLQ, only set if details are requested.
+	DlqSize int64 `protobuf:"varint,4,opt,name=dlq_size,json=dlqSize,proto3" json:"dlq_size,omitempty"`
+	// Set if pending task or DLQ scan reached its limit.
+	Truncated bool `protobuf:"varint,5,opt,name=truncated,proto3" json:"truncated,omitempty"`
 }
 
 func (m *ShardReplicationStatusPerCluster) Reset()      { *m = ShardReplicationStatusPerCluster{} }
 func (*ShardReplicationStatusPerCluster) ProtoMessage() {}
 func (*ShardReplicationStatusPerCluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{82}
+	return fileDescriptor_b8c78c1d460a3711, []int{86}
 }
 func (m *ShardReplicationStatusPerCluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4761,17 +5075,38 @@ func (m *ShardReplicationStatusPerCluster) GetAckedTaskVisibilityTime() *time.Ti
 	return nil
 }
 
-type RebuildMutableStateRequest struct {
-	NamespaceId string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	Execution   *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+func (m *ShardReplicationStatusPerCluster) GetNamespaces() map[string]*v113.NamespaceReplicationLag {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
 }
 
-func (m *RebuildMutableStateRequest) Reset()      { *m = RebuildMutableStateRequest{} }
-func (*RebuildMutableStateRequest) ProtoMessage() {}
-func (*RebuildMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{83}
-}
-func (m *RebuildMutableStateRequest) XXX_Unmarshal(b []byte) error {
+func (m *ShardReplicationStatusPerCluster) GetDlqSize() int64 {
+	if m != nil {
+		return m.DlqSize
+	}
+	return 0
+}
+
+func (m *ShardReplicationStatusPerCluster) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+type RebuildMutableStateRequest struct {
+	NamespaceId string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Execution   *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+}
+
+func (m *RebuildMutableStateRequest) Reset()      { *m = RebuildMutableStateRequest{} }
+func (*RebuildMutableStateRequest) ProtoMessage() {}
+func (*RebuildMutableStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{87}
+}
+func (m *RebuildMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 func (m *RebuildMutableStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
@@ -4818,7 +5153,7 @@ type RebuildMutableStateResponse struct {
 func (m *RebuildMutableStateResponse) Reset()      { *m = RebuildMutableStateResponse{} }
 func (*RebuildMutableStateResponse) ProtoMessage() {}
 func (*RebuildMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{84}
+	return fileDescriptor_b8c78c1d460a3711, []int{88}
 }
 func (m *RebuildMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4907,6 +5242,8 @@ func init() {
 	proto.RegisterType((*CloseShardResponse)(nil), "temporal.server.api.historyservice.v1.CloseShardResponse")
 	proto.RegisterType((*GetShardRequest)(nil), "temporal.server.api.historyservice.v1.GetShardRequest")
 	proto.RegisterType((*GetShardResponse)(nil), "temporal.server.api.historyservice.v1.GetShardResponse")
+	proto.RegisterType((*CompleteShardHandoffRequest)(nil), "temporal.server.api.historyservice.v1.CompleteShardHandoffRequest")
+	proto.RegisterType((*CompleteShardHandoffResponse)(nil), "temporal.server.api.historyservice.v1.CompleteShardHandoffResponse")
 	proto.RegisterType((*RemoveTaskRequest)(nil), "temporal.server.api.historyservice.v1.RemoveTaskRequest")
 	proto.RegisterType((*RemoveTaskResponse)(nil), "temporal.server.api.historyservice.v1.RemoveTaskResponse")
 	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesRequest")
@@ -4926,6 +5263,8 @@ func init() {
 	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.MergeDLQMessagesResponse")
 	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksRequest")
 	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksResponse")
+	proto.RegisterType((*UnpauseWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.UnpauseWorkflowExecutionRequest")
+	proto.RegisterType((*UnpauseWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.UnpauseWorkflowExecutionResponse")
 	proto.RegisterType((*GenerateLastHistoryReplicationTasksRequest)(nil), "temporal.server.api.historyservice.v1.GenerateLastHistoryReplicationTasksRequest")
 	proto.RegisterType((*GenerateLastHistoryReplicationTasksResponse)(nil), "temporal.server.api.historyservice.v1.GenerateLastHistoryReplicationTasksResponse")
 	proto.RegisterType((*GetReplicationStatusRequest)(nil), "temporal.server.api.historyservice.v1.GetReplicationStatusRequest")
@@ -4935,6 +5274,7 @@ func init() {
 	proto.RegisterMapType((map[string]*ShardReplicationStatusPerCluster)(nil), "temporal.server.api.historyservice.v1.ShardReplicationStatus.RemoteClustersEntry")
 	proto.RegisterType((*HandoverNamespaceInfo)(nil), "temporal.server.api.historyservice.v1.HandoverNamespaceInfo")
 	proto.RegisterType((*ShardReplicationStatusPerCluster)(nil), "temporal.server.api.historyservice.v1.ShardReplicationStatusPerCluster")
+	proto.RegisterMapType((map[string]*v113.NamespaceReplicationLag)(nil), "temporal.server.api.historyservice.v1.ShardReplicationStatusPerCluster.NamespacesEntry")
 	proto.RegisterType((*RebuildMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.RebuildMutableStateRequest")
 	proto.RegisterType((*RebuildMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.RebuildMutableStateResponse")
 }
@@ -4944,265 +5284,286 @@ func init() {
 }
 
 var fileDescriptor_b8c78c1d460a3711 = []byte{
-	// 4114 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x49, 0x6c, 0x1c, 0x57,
-	0x7a, 0x56, 0xb1, 0xd9, 0x64, 0xf3, 0xef, 0x66, 0x77, 0xb3, 0xb8, 0x35, 0x49, 0xa9, 0x45, 0x96,
-	0x2d, 0x8b, 0x5e, 0xd4, 0xb4, 0xa4, 0x19, 0xdb, 0xa3, 0x8c, 0xed, 0x48, 0xd4, 0xd6, 0x82, 0xa4,
-	0xa1, 0x8b, 0x1c, 0xd9, 0xf0, 0x8c, 0xa7, 0x5c, 0xec, 0x7a, 0x64, 0x57, 0x58, 0x5d, 0xd5, 0xae,
-	0x57, 0x4d, 0xb2, 0x9d, 0x43, 0x96, 0x41, 0x82, 0xcc, 0x04, 0x48, 0x04, 0xe4, 0x32, 0x87, 0xc9,
-	0x25, 0x40, 0x90, 0x20, 0x40, 0x90, 0x43, 0x4e, 0x73, 0xc8, 0x35, 0xc8, 0x29, 0x31, 0x02, 0x04,
-	0x19, 0x4c, 0x0e, 0x89, 0x65, 0x04, 0x48, 0x90, 0x1c, 0xe6, 0x90, 0x43, 0x8e, 0xc1, 0xdb, 0x6a,
-	0xef, 0x8d, 0x94, 0xa2, 0x59, 0x7c, 0x63, 0xbf, 0xf7, 0xff, 0xff, 0x7b, 0xff, 0xf6, 0xbd, 0xed,
-	0x2f, 0xc2, 0xd7, 0x3d, 0xd4, 0x6a, 0x3b, 0xae, 0x6e, 0x6d, 0x60, 0xe4, 0x1e, 0x22, 0x77, 0x43,
-	0x6f, 0x9b, 0x1b, 0x4d, 0x13, 0x7b, 0x8e, 0xdb, 0x25, 0x2d, 0x66, 0x03, 0x6d, 0x1c, 0x5e, 0xde,
-	0x70, 0xd1, 0x27, 0x1d, 0x84, 0x3d, 0xcd, 0x45, 0xb8, 0xed, 0xd8, 0x18, 0xd5, 0xda, 0xae, 0xe3,
-	0x39, 0xf2, 0x05, 0xc1, 0x5d, 0x63, 0xdc, 0x35, 0xbd, 0x6d, 0xd6, 0xa2, 0xdc, 0xb5, 0xc3, 0xcb,
-	0xcb, 0xd5, 0x7d, 0xc7, 0xd9, 0xb7, 0xd0, 0x06, 0x65, 0xda, 0xed, 0xec, 0x6d, 0x18, 0x1d, 0x57,
-	0xf7, 0x4c, 0xc7, 0x66, 0x62, 0x96, 0xcf, 0xc7, 0xfb, 0x3d, 0xb3, 0x85, 0xb0, 0xa7, 0xb7, 0xda,
-	0x9c, 0x60, 0xcd, 0x40, 0x6d, 0x64, 0x1b, 0xc8, 0x6e, 0x98, 0x08, 0x6f, 0xec, 0x3b, 0xfb, 0x0e,
-	0x6d, 0xa7, 0x7f, 0x71, 0x92, 0x17, 0x7d, 0x45, 0x88, 0x06, 0x0d, 0xa7, 0xd5, 0x72, 0x6c, 0x32,
-	0xf3, 0x16, 0xc2, 0x58, 0xdf, 0xe7, 0x13, 0x5e, 0xbe, 0x10, 0xa1, 0xe2, 0x33, 0x4d, 0x92, 0x5d,
-	0x8c, 0x90, 0x79, 0x3a, 0x3e, 0xf8, 0xa4, 0x83, 0x3a, 0x28, 0x49, 0x18, 0x1d, 0x15, 0xd9, 0x9d,
-	0x16, 0x26, 0x44, 0x47, 0x8e, 0x7b, 0xb0, 0x67, 0x39, 0x47, 0x9c, 0xea, 0xa5, 0x08, 0x95, 0xe8,
-	0x4c, 0x4a, 0x7b, 0x21, 0x42, 0xf7, 0x49, 0x07, 0xa5, 0xcd, 0x2d, 0xaa, 0xc2, 0x9e, 0x6e, 0x5a,
-	0x1d, 0x37, 0x65, 0x66, 0xaf, 0xf5, 0x71, 0x6c, 0x92, 0xfa, 0xe5, 0x34, 0x6a, 0x5f, 0x1d, 0x66,
-	0x4d, 0x4e, 0xfa, 0x6a, 0x5f, 0xd2, 0x98, 0xe6, 0x17, 0xfb, 0x12, 0x13, 0xc3, 0x72, 0xc2, 0x4b,
-	0x69, 0x84, 0xbd, 0x2d, 0x55, 0x4b, 0x23, 0xb7, 0xf5, 0x16, 0xc2, 0x6d, 0xbd, 0x91, 0x62, 0x8d,
-	0xd7, 0xd3, 0xe8, 0x5d, 0xd4, 0xb6, 0xcc, 0x06, 0x0d, 0xc4, 0x24, 0xc7, 0xd5, 0x34, 0x8e, 0x36,
-	0x72, 0xb1, 0x89, 0x3d, 0x64, 0xb3, 0x31, 0xd0, 0x31, 0x6a, 0x74, 0x08, 0x3b, 0xe6, 0x4c, 0xef,
-	0x0e, 0xc1, 0x24, 0x94, 0xd2, 0x5a, 0x1d, 0x4f, 0xdf, 0xb5, 0x90, 0x86, 0x3d, 0xdd, 0x13, 0xa3,
-	0xbe, 0x91, 0x1a, 0x29, 0x03, 0x13, 0x71, 0xf9, 0x5a, 0xda, 0xc0, 0xba, 0xd1, 0x32, 0xed, 0x81,
-	0xbc, 0xca, 0xef, 0x4f, 0xc0, 0xb9, 0x6d, 0x4f, 0x77, 0xbd, 0xf7, 0xf9, 0x70, 0xb7, 0x84, 0x5a,
-	0x2a, 0x63, 0x90, 0xd7, 0xa0, 0xe0, 0xdb, 0x56, 0x33, 0x8d, 0x8a, 0xb4, 0x2a, 0xad, 0x4f, 0xa9,
-	0x79, 0xbf, 0xad, 0x6e, 0xc8, 0x0d, 0x98, 0xc6, 0x44, 0x86, 0xc6, 0x07, 0xa9, 0x8c, 0xad, 0x4a,
-	0xeb, 0xf9, 0x2b, 0xef, 0xf8, 0x8e, 0xa2, 0xd0, 0x10, 0x53, 0xa8, 0x76, 0x78, 0xb9, 0xd6, 0x77,
-	0x64, 0xb5, 0x40, 0x85, 0x8a, 0x79, 0x34, 0x61, 0xbe, 0xad, 0xbb, 0xc8, 0xf6, 0x34, 0xdf, 0xf2,
-	0x9a, 0x69, 0xef, 0x39, 0x95, 0x0c, 0x1d, 0xec, 0x2b, 0xb5, 0x34, 0x38, 0xf2, 0x23, 0xf2, 0xf0,
-	0x72, 0x6d, 0x8b, 0x72, 0xfb, 0xa3, 0xd4, 0xed, 0x3d, 0x47, 0x9d, 0x6d, 0x27, 0x1b, 0xe5, 0x0a,
-	0x4c, 0xea, 0x1e, 0x91, 0xe6, 0x55, 0xc6, 0x57, 0xa5, 0xf5, 0xac, 0x2a, 0x7e, 0xca, 0x2d, 0x50,
-	0x7c, 0x0f, 0x06, 0xb3, 0x40, 0xc7, 0x6d, 0x93, 0x41, 0x9a, 0x46, 0xb0, 0xab, 0x92, 0xa5, 0x13,
-	0x5a, 0xae, 0x31, 0x60, 0xab, 0x09, 0x60, 0xab, 0xed, 0x08, 0x60, 0xbb, 0x31, 0xfe, 0xf8, 0x5f,
-	0xcf, 0x4b, 0xea, 0xf9, 0xa3, 0xb8, 0xe6, 0xb7, 0x7c, 0x49, 0x84, 0x56, 0x6e, 0xc2, 0x52, 0xc3,
-	0xb1, 0x3d, 0xd3, 0xee, 0x20, 0x4d, 0xc7, 0x9a, 0x8d, 0x8e, 0x34, 0xd3, 0x36, 0x3d, 0x53, 0xf7,
-	0x1c, 0xb7, 0x32, 0xb1, 0x2a, 0xad, 0x17, 0xaf, 0x5c, 0x8a, 0xda, 0x98, 0x66, 0x17, 0x51, 0x76,
-	0x93, 0xf3, 0x5d, 0xc7, 0x0f, 0xd1, 0x51, 0x5d, 0x30, 0xa9, 0x0b, 0x8d, 0xd4, 0x76, 0xf9, 0x01,
-	0xcc, 0x88, 0x1e, 0x43, 0xe3, 0xb0, 0x52, 0x99, 0xa4, 0x7a, 0xac, 0x46, 0x47, 0xe0, 0x9d, 0x64,
-	0x8c, 0xdb, 0xec, 0x4f, 0xb5, 0xec, 0xb3, 0xf2, 0x16, 0xf9, 0x11, 0x2c, 0x58, 0x3a, 0xf6, 0xb4,
-	0x86, 0xd3, 0x6a, 0x5b, 0x88, 0x5a, 0xc6, 0x45, 0xb8, 0x63, 0x79, 0x95, 0x5c, 0x9a, 0x4c, 0x0e,
-	0x31, 0xd4, 0x47, 0x5d, 0xcb, 0xd1, 0x0d, 0xac, 0xce, 0x11, 0xfe, 0x4d, 0x9f, 0x5d, 0xa5, 0xdc,
-	0xf2, 0x77, 0x60, 0x65, 0xcf, 0x74, 0xb1, 0xa7, 0xf9, 0x5e, 0x20, 0x28, 0xa2, 0xed, 0xea, 0x8d,
-	0x03, 0x67, 0x6f, 0xaf, 0x32, 0x45, 0x85, 0x2f, 0x25, 0x0c, 0x7f, 0x93, 0xaf, 0x38, 0x37, 0xc6,
-	0x7f, 0x40, 0xec, 0x5e, 0xa1, 0x32, 0x44, 0xd8, 0xed, 0xe8, 0xf8, 0xe0, 0x06, 0x13, 0xa0, 0xbc,
-	0x09, 0xd5, 0x5e, 0x21, 0xc9, 0xb2, 0x46, 0x9e, 0x87, 0x09, 0xb7, 0x63, 0x07, 0x79, 0x90, 0x75,
-	0x3b, 0x76, 0xdd, 0x50, 0xfe, 0x4b, 0x82, 0x85, 0x3b, 0xc8, 0x7b, 0xc0, 0xb2, 0x7a, 0x9b, 0x24,
-	0xf5, 0x08, 0xf9, 0x73, 0x07, 0xa6, 0xfc, 0x68, 0xe2, 0xb9, 0xf3, 0x72, 0x2f, 0x0b, 0x25, 0xa7,
-	0x16, 0xf0, 0xca, 0x57, 0x61, 0x01, 0x1d, 0xb7, 0x51, 0xc3, 0x43, 0x86, 0x66, 0xa3, 0x63, 0x4f,
-	0x43, 0x87, 0x24, 0x61, 0x4c, 0x83, 0x26, 0x49, 0x46, 0x9d, 0x15, 0xbd, 0x0f, 0xd1, 0xb1, 0x77,
-	0x8b, 0xf4, 0xd5, 0x0d, 0xf9, 0x75, 0x98, 0x6b, 0x74, 0x5c, 0x9a, 0x59, 0xbb, 0xae, 0x6e, 0x37,
-	0x9a, 0x9a, 0xe7, 0x1c, 0x20, 0x9b, 0xc6, 0x7e, 0x41, 0x95, 0x79, 0xdf, 0x0d, 0xda, 0xb5, 0x43,
-	0x7a, 0x94, 0xbf, 0xc8, 0xc1, 0x62, 0x42, 0x5b, 0x6e, 0xa0, 0x88, 0x2e, 0xd2, 0x29, 0x74, 0xa9,
-	0xc3, 0x74, 0xe0, 0xe5, 0x6e, 0x1b, 0x71, 0xc3, 0xbc, 0x38, 0x48, 0xd8, 0x4e, 0xb7, 0x8d, 0xd4,
-	0xc2, 0x51, 0xe8, 0x97, 0xac, 0xc0, 0x74, 0x9a, 0x35, 0xf2, 0x76, 0xc8, 0x0a, 0x5f, 0x83, 0xa5,
-	0xb6, 0x8b, 0x0e, 0x4d, 0xa7, 0x83, 0x35, 0x8a, 0x3b, 0xc8, 0x08, 0xe8, 0xc7, 0x29, 0xfd, 0x82,
-	0x20, 0xd8, 0x66, 0xfd, 0x82, 0xf5, 0x12, 0xcc, 0xd2, 0x68, 0x67, 0xa1, 0xe9, 0x33, 0x65, 0x29,
-	0x53, 0x99, 0x74, 0xdd, 0x26, 0x3d, 0x82, 0x7c, 0x13, 0x80, 0x46, 0x2d, 0xdd, 0x55, 0xd0, 0x34,
-	0x4e, 0x68, 0xe5, 0x6f, 0x3a, 0x88, 0x62, 0x24, 0x40, 0xdf, 0x23, 0x3f, 0xd4, 0x29, 0x4f, 0xfc,
-	0x29, 0x6f, 0xc1, 0x0c, 0xf6, 0xcc, 0xc6, 0x41, 0x57, 0x0b, 0xc9, 0x9a, 0x1c, 0x41, 0x56, 0x89,
-	0xb1, 0xfb, 0x0d, 0xf2, 0xaf, 0xc3, 0xab, 0x09, 0x89, 0x1a, 0x6e, 0x34, 0x91, 0xd1, 0xb1, 0x90,
-	0xe6, 0x39, 0xcc, 0x2a, 0x14, 0xe1, 0x9c, 0x8e, 0x57, 0xc9, 0x0f, 0x97, 0x6b, 0x17, 0x62, 0xc3,
-	0x6c, 0x73, 0x81, 0x3b, 0x0e, 0x35, 0xe2, 0x0e, 0x93, 0xd6, 0x33, 0x06, 0xa7, 0x7b, 0xc5, 0xa0,
-	0xfc, 0x2d, 0x28, 0xfa, 0xe1, 0x41, 0x17, 0xd1, 0x4a, 0x89, 0x02, 0x62, 0xfa, 0x3a, 0xe0, 0xe3,
-	0x62, 0x22, 0xe4, 0x58, 0xf4, 0xfa, 0xa1, 0x46, 0x7f, 0xca, 0xef, 0x43, 0x29, 0x22, 0xbc, 0x83,
-	0x2b, 0x65, 0x2a, 0xbd, 0xd6, 0x03, 0x6e, 0x53, 0xc5, 0x76, 0xb0, 0x5a, 0x0c, 0xcb, 0xed, 0x60,
-	0xf9, 0x23, 0x98, 0x39, 0x24, 0x3b, 0x02, 0xc7, 0xd6, 0xd8, 0x76, 0xcc, 0x44, 0xb8, 0x32, 0x43,
-	0x4d, 0xf9, 0x7a, 0xad, 0xcf, 0x7e, 0x9a, 0x8c, 0xf1, 0x88, 0x31, 0xde, 0x15, 0x7c, 0x6a, 0xf9,
-	0x30, 0xd6, 0x22, 0xbf, 0x03, 0x67, 0x4d, 0x12, 0xbe, 0x71, 0x37, 0x22, 0x9b, 0x24, 0xaa, 0x51,
-	0x91, 0x57, 0xa5, 0xf5, 0x9c, 0x5a, 0x31, 0xf1, 0x76, 0xd4, 0x2b, 0xb7, 0x58, 0xbf, 0xfc, 0x15,
-	0x58, 0x4c, 0x44, 0xb2, 0x77, 0x4c, 0xe1, 0x6e, 0x96, 0x01, 0x48, 0x34, 0x9a, 0x77, 0x8e, 0xed,
-	0xba, 0x71, 0x6f, 0x3c, 0x97, 0x2b, 0x4f, 0xdd, 0x1b, 0xcf, 0x4d, 0x95, 0xe1, 0xde, 0x78, 0x0e,
-	0xca, 0xf9, 0x7b, 0xe3, 0xb9, 0x42, 0x79, 0xfa, 0xde, 0x78, 0xae, 0x58, 0x2e, 0x29, 0xff, 0x2d,
-	0xc1, 0xe2, 0x96, 0x63, 0x59, 0xbf, 0x24, 0xd8, 0xf8, 0xef, 0x93, 0x50, 0x49, 0xaa, 0xfb, 0x25,
-	0x38, 0x7e, 0x09, 0x8e, 0x4f, 0x1d, 0x1c, 0x0b, 0x3d, 0xc1, 0x31, 0x15, 0x66, 0x8a, 0x4f, 0x0d,
-	0x66, 0x7e, 0x3e, 0xb1, 0xb7, 0x0f, 0xb8, 0xcd, 0x8c, 0x06, 0x6e, 0xd3, 0xe5, 0xa2, 0xf2, 0x3d,
-	0x09, 0x56, 0x54, 0x84, 0x91, 0x17, 0x83, 0xd2, 0xe7, 0x00, 0x6d, 0x4a, 0x15, 0xce, 0xa6, 0x4f,
-	0x85, 0xc1, 0x8e, 0xf2, 0x93, 0x31, 0x58, 0x55, 0x51, 0xc3, 0x71, 0x8d, 0xf0, 0xa6, 0x97, 0x27,
-	0xea, 0x08, 0x13, 0xfe, 0x00, 0xe4, 0xe4, 0xf1, 0x67, 0xf4, 0x99, 0xcf, 0x24, 0xce, 0x3d, 0xf2,
-	0x79, 0xc8, 0xfb, 0xd9, 0xe4, 0x43, 0x10, 0x88, 0xa6, 0xba, 0x21, 0x2f, 0xc2, 0x24, 0xcd, 0x3c,
-	0x1f, 0x6f, 0x26, 0xc8, 0xcf, 0xba, 0x21, 0x9f, 0x03, 0x10, 0x47, 0x5b, 0x0e, 0x2b, 0x53, 0xea,
-	0x14, 0x6f, 0xa9, 0x1b, 0xf2, 0xc7, 0x50, 0x68, 0x3b, 0x96, 0xe5, 0x9f, 0x4c, 0x19, 0xa2, 0xbc,
-	0x3d, 0xf0, 0x64, 0x4a, 0x20, 0x3c, 0x6c, 0xac, 0xb0, 0x6f, 0xd5, 0x3c, 0x11, 0xc9, 0x7f, 0x28,
-	0xff, 0x34, 0x09, 0x6b, 0x7d, 0x8c, 0xcb, 0x91, 0x3f, 0x01, 0xd8, 0xd2, 0x89, 0x01, 0xbb, 0x2f,
-	0x18, 0x8f, 0xf5, 0x05, 0xe3, 0xd7, 0x40, 0x16, 0x36, 0x35, 0xe2, 0x80, 0x5f, 0xf6, 0x7b, 0x04,
-	0xf5, 0x3a, 0x94, 0x7b, 0x80, 0x7d, 0x11, 0x47, 0xe5, 0x26, 0xd6, 0x90, 0x6c, 0x72, 0x0d, 0x09,
-	0x9d, 0xaa, 0x27, 0xa2, 0xa7, 0xea, 0xb7, 0xa0, 0xc2, 0xc1, 0x35, 0x74, 0xa6, 0xe6, 0x3b, 0x96,
-	0x49, 0xba, 0x63, 0x59, 0x60, 0xfd, 0xc1, 0x39, 0x99, 0xef, 0x57, 0xf6, 0x43, 0x01, 0xc9, 0xc2,
-	0xc3, 0xde, 0x73, 0xf8, 0x19, 0xf3, 0x6b, 0x83, 0x80, 0x6e, 0xc7, 0xd5, 0x6d, 0x6c, 0x22, 0x3b,
-	0x72, 0x12, 0xa4, 0xb7, 0x02, 0xe5, 0xa3, 0x58, 0x8b, 0xbc, 0x0f, 0xe7, 0x52, 0x0e, 0xfe, 0xa1,
-	0xd5, 0x65, 0x6a, 0x84, 0xd5, 0x65, 0x39, 0x11, 0xff, 0xc1, 0x42, 0xb3, 0x06, 0x85, 0x08, 0xc6,
-	0xe7, 0x29, 0xc6, 0xe7, 0x77, 0x43, 0xe0, 0x7e, 0x07, 0x8a, 0x81, 0x13, 0xe9, 0x85, 0x43, 0x61,
-	0xc8, 0x0b, 0x87, 0x69, 0x9f, 0x8f, 0x5e, 0x2f, 0x6c, 0x42, 0x41, 0xf8, 0x97, 0x8a, 0x99, 0x1e,
-	0x52, 0x4c, 0x9e, 0x73, 0x51, 0x21, 0x0e, 0x4c, 0x7e, 0xd2, 0x41, 0x7c, 0x81, 0xc9, 0xac, 0xe7,
-	0xaf, 0x7c, 0xb3, 0x36, 0xd4, 0xbd, 0x70, 0x6d, 0x60, 0xce, 0xd4, 0xde, 0x63, 0x72, 0x6f, 0xd9,
-	0x9e, 0xdb, 0x55, 0xc5, 0x28, 0xcb, 0x1f, 0x43, 0x21, 0xdc, 0x21, 0x97, 0x21, 0x73, 0x80, 0xba,
-	0x1c, 0xae, 0xc8, 0x9f, 0xf2, 0x35, 0xc8, 0x1e, 0xea, 0x56, 0xa7, 0xc7, 0xa6, 0x88, 0xde, 0xac,
-	0x86, 0x53, 0x8c, 0x48, 0xeb, 0xaa, 0x8c, 0xe5, 0xda, 0xd8, 0x5b, 0x12, 0x83, 0xf9, 0x10, 0x68,
-	0x5e, 0x6f, 0x78, 0xe6, 0xa1, 0xe9, 0x75, 0xbf, 0x04, 0xcd, 0x21, 0x40, 0x33, 0x6c, 0xac, 0xde,
-	0xa0, 0xf9, 0xdb, 0xe3, 0x02, 0x34, 0x53, 0x8d, 0xcb, 0x41, 0xf3, 0x21, 0x94, 0x62, 0x70, 0xc5,
-	0x61, 0xf3, 0x42, 0x74, 0x2a, 0xa1, 0xa4, 0x66, 0x9b, 0x94, 0x2e, 0x05, 0x1d, 0xb5, 0x18, 0x85,
-	0xb4, 0x44, 0xc0, 0x8f, 0x9d, 0x24, 0xe0, 0x43, 0x38, 0x96, 0x89, 0xe2, 0x18, 0x82, 0xaa, 0xd8,
-	0xa7, 0xf1, 0x26, 0x2d, 0x96, 0xa8, 0xe3, 0x43, 0x0e, 0xb8, 0xc2, 0xe5, 0x5c, 0x67, 0x62, 0xb6,
-	0x23, 0x69, 0xfb, 0x00, 0x66, 0x9a, 0x48, 0x77, 0xbd, 0x5d, 0xa4, 0x7b, 0x9a, 0x81, 0x3c, 0xdd,
-	0xb4, 0x30, 0xbf, 0x73, 0x1c, 0x7c, 0xaf, 0x56, 0xf6, 0x59, 0x6f, 0x32, 0xce, 0xe4, 0xca, 0x34,
-	0x71, 0xe2, 0x95, 0xe9, 0x52, 0x28, 0xd4, 0xfd, 0x14, 0xa0, 0x10, 0x3e, 0x15, 0xc4, 0xef, 0x43,
-	0xd1, 0xa1, 0xfc, 0x48, 0x82, 0x17, 0x98, 0xaf, 0x23, 0x30, 0xc0, 0x6f, 0xfd, 0x46, 0x4a, 0x32,
-	0x07, 0xca, 0xfc, 0xae, 0x11, 0xc5, 0x2e, 0xa1, 0x6f, 0x0e, 0x8c, 0xda, 0x21, 0xa6, 0xa0, 0x96,
-	0x84, 0x74, 0x3f, 0x80, 0xc7, 0xe0, 0xc5, 0xfe, 0x8c, 0x3c, 0x86, 0x71, 0xb0, 0x88, 0x8a, 0xab,
-	0x77, 0x1e, 0xc4, 0x77, 0x9f, 0x16, 0x50, 0x92, 0xe3, 0x4a, 0x34, 0x71, 0x10, 0x14, 0x75, 0x9e,
-	0x57, 0x74, 0x91, 0xc2, 0x95, 0x31, 0x8a, 0xcd, 0xef, 0x9c, 0x34, 0x85, 0xf9, 0x40, 0xd3, 0x7a,
-	0xa8, 0x0b, 0x2b, 0x7f, 0x25, 0x11, 0x88, 0x4c, 0x18, 0xe1, 0xb6, 0x6e, 0x5a, 0x23, 0x79, 0xaf,
-	0x09, 0xc5, 0x3d, 0xca, 0x13, 0xf3, 0xdd, 0xf5, 0x93, 0xf8, 0x2e, 0x32, 0xba, 0x3a, 0xbd, 0x17,
-	0xfe, 0xa9, 0xbc, 0x40, 0x60, 0xa7, 0x27, 0x0b, 0xdf, 0x2e, 0xff, 0x48, 0x02, 0x25, 0x09, 0x4e,
-	0x77, 0x45, 0xe2, 0x8c, 0xa0, 0x58, 0x3b, 0x9c, 0xaa, 0x51, 0xdd, 0x36, 0x87, 0xd0, 0x6d, 0xd0,
-	0x14, 0x42, 0xd9, 0x2c, 0x14, 0xdc, 0x22, 0x29, 0xd5, 0x87, 0x8f, 0x07, 0xc8, 0xcb, 0x50, 0x6e,
-	0xe8, 0x76, 0x03, 0xf9, 0x18, 0x8f, 0xd8, 0xfc, 0x73, 0x6a, 0x89, 0xb5, 0xab, 0xa2, 0x39, 0x9c,
-	0xa5, 0x61, 0x99, 0xcf, 0x29, 0x4b, 0xfb, 0x4d, 0x21, 0x99, 0xa5, 0x2f, 0xf9, 0x49, 0xda, 0x83,
-	0x8f, 0x7b, 0x3c, 0x14, 0xc8, 0x61, 0xc2, 0xff, 0xff, 0x40, 0xee, 0x39, 0x7a, 0xef, 0x40, 0x4e,
-	0x63, 0xe1, 0x6a, 0xfd, 0x35, 0x0d, 0xe4, 0xa4, 0xfe, 0xd4, 0xc3, 0x23, 0x29, 0xf6, 0x6b, 0x50,
-	0x8c, 0xc6, 0xcb, 0x08, 0x51, 0x3c, 0x68, 0x7c, 0x75, 0x3a, 0x12, 0x72, 0xca, 0x85, 0xf4, 0x78,
-	0xf3, 0x99, 0xb8, 0x72, 0x7f, 0x3b, 0x06, 0xd5, 0x6d, 0x73, 0xdf, 0xd6, 0xad, 0xd3, 0x3c, 0x5d,
-	0xee, 0x41, 0x11, 0x53, 0x21, 0x31, 0xc5, 0xde, 0x1d, 0xfc, 0x76, 0xd9, 0x77, 0x6c, 0x75, 0x9a,
-	0x89, 0x15, 0x53, 0x31, 0x61, 0x05, 0x1d, 0x7b, 0xc8, 0x25, 0x23, 0xa5, 0x6c, 0x07, 0x33, 0xa3,
-	0x6e, 0x07, 0x97, 0x84, 0xb4, 0x44, 0x97, 0x5c, 0x83, 0xd9, 0x46, 0xd3, 0xb4, 0x8c, 0x60, 0x1c,
-	0xc7, 0xb6, 0xba, 0x74, 0xef, 0x91, 0x53, 0x67, 0x68, 0x97, 0x60, 0xfa, 0x86, 0x6d, 0x75, 0x95,
-	0x35, 0x38, 0xdf, 0x53, 0x17, 0x6e, 0xeb, 0x7f, 0x94, 0xe0, 0x22, 0xa7, 0x31, 0xbd, 0xe6, 0xa9,
-	0xdf, 0x8b, 0xbf, 0x2b, 0xc1, 0x12, 0xb7, 0xfa, 0x91, 0xe9, 0x35, 0xb5, 0xb4, 0xc7, 0xe3, 0xbb,
-	0xc3, 0x3a, 0x60, 0xd0, 0x84, 0xd4, 0x05, 0x1c, 0x25, 0x14, 0x71, 0x76, 0x1d, 0xd6, 0x07, 0x8b,
-	0xe8, 0xff, 0xec, 0xf7, 0x37, 0x12, 0x9c, 0x57, 0x51, 0xcb, 0x39, 0x44, 0x4c, 0xd2, 0x09, 0xef,
-	0xb8, 0x9f, 0xdd, 0x11, 0x21, 0xba, 0xd1, 0xcf, 0xc4, 0x36, 0xfa, 0x8a, 0x42, 0x60, 0xaf, 0xd7,
-	0xf4, 0x85, 0xef, 0xc7, 0x60, 0x6d, 0x07, 0xb9, 0x2d, 0xd3, 0xd6, 0x3d, 0x74, 0x1a, 0xaf, 0x3b,
-	0x30, 0xe3, 0x09, 0x39, 0x31, 0x67, 0xdf, 0x18, 0xe8, 0xec, 0x81, 0x33, 0x50, 0xcb, 0xbe, 0xf0,
-	0x9f, 0x83, 0x9c, 0x7b, 0x11, 0x94, 0x7e, 0x1a, 0x71, 0xd3, 0xff, 0xb1, 0x04, 0xd5, 0x9b, 0x88,
-	0x2c, 0x55, 0xa7, 0xb1, 0xfb, 0x33, 0x8b, 0x2e, 0x82, 0x1c, 0x3d, 0xa7, 0xc7, 0x55, 0xf8, 0x33,
-	0x09, 0xce, 0xd1, 0xbb, 0xc9, 0x53, 0xd6, 0x97, 0xb8, 0x44, 0xc6, 0xc8, 0xf5, 0x25, 0x7d, 0x47,
-	0x56, 0x0b, 0x54, 0xa8, 0x80, 0x83, 0x37, 0xa1, 0xda, 0x8b, 0xbc, 0x3f, 0x08, 0xfc, 0x51, 0x06,
-	0x2e, 0x70, 0x21, 0x6c, 0x91, 0x3a, 0x8d, 0xaa, 0xad, 0x1e, 0x0b, 0xed, 0xed, 0x21, 0x74, 0x1d,
-	0x62, 0x0a, 0xb1, 0xb5, 0x56, 0x7e, 0x3b, 0x94, 0x22, 0xbc, 0xb4, 0x24, 0x79, 0x33, 0x58, 0x11,
-	0x24, 0x75, 0x41, 0x21, 0xee, 0xf4, 0x06, 0x64, 0xd8, 0xf8, 0xb3, 0xcf, 0xb0, 0x6c, 0xaf, 0x0c,
-	0x5b, 0x87, 0x97, 0x06, 0x59, 0x84, 0x87, 0xe8, 0x3f, 0x48, 0xb0, 0x22, 0x4e, 0xd8, 0xe1, 0x53,
-	0xc1, 0xcf, 0x04, 0x80, 0x5f, 0x85, 0x05, 0x13, 0x6b, 0x29, 0x45, 0x2f, 0xd4, 0x37, 0x39, 0x75,
-	0xd6, 0xc4, 0xb7, 0xe3, 0xd5, 0x2c, 0x4a, 0x15, 0xce, 0xa6, 0x2b, 0xc4, 0x35, 0xfe, 0x1f, 0x7a,
-	0x78, 0x25, 0xa7, 0x84, 0x4d, 0x62, 0x37, 0x7f, 0xb4, 0x93, 0xec, 0xe9, 0x9f, 0x9d, 0xea, 0x6b,
-	0x50, 0x08, 0x42, 0x32, 0x78, 0x97, 0xf4, 0xdb, 0xea, 0x86, 0xfc, 0x21, 0xcc, 0x8a, 0x2d, 0xbf,
-	0x71, 0x9a, 0xb8, 0x93, 0x7d, 0x29, 0xc1, 0xf0, 0x5b, 0xfe, 0x61, 0x85, 0xde, 0x47, 0xd3, 0xdb,
-	0xa7, 0xec, 0x28, 0xb7, 0x4f, 0xa5, 0x80, 0x9d, 0x36, 0x28, 0x17, 0x09, 0x4e, 0xf4, 0xb5, 0x3a,
-	0xf7, 0xcf, 0x9f, 0x48, 0xb0, 0x7a, 0x13, 0xe1, 0x86, 0x6b, 0xee, 0x9e, 0x0a, 0xf9, 0xbf, 0x05,
-	0x93, 0xa3, 0x9e, 0x43, 0x06, 0x0d, 0xab, 0x0a, 0x89, 0xca, 0x1f, 0x8e, 0xc3, 0x5a, 0x1f, 0x6a,
-	0x8e, 0x99, 0xdf, 0x86, 0x72, 0x70, 0x5f, 0xde, 0x70, 0xec, 0x3d, 0x73, 0x9f, 0x5f, 0x7f, 0x5c,
-	0x4e, 0x9f, 0x4b, 0xaa, 0x83, 0x36, 0x29, 0xa3, 0x5a, 0x42, 0xd1, 0x06, 0x79, 0x1f, 0x16, 0x53,
-	0xae, 0xe5, 0xe9, 0x23, 0x00, 0x53, 0x78, 0x63, 0x84, 0x41, 0xe8, 0xd5, 0xff, 0xfc, 0x51, 0x5a,
-	0xb3, 0xfc, 0x6d, 0x90, 0xdb, 0xc8, 0x36, 0x4c, 0x7b, 0x5f, 0xe3, 0x57, 0x20, 0x26, 0xc2, 0x95,
-	0x0c, 0xbd, 0x54, 0xb9, 0xd4, 0x7b, 0x8c, 0x2d, 0xc6, 0x23, 0xce, 0x31, 0x74, 0x84, 0x99, 0x76,
-	0xa4, 0xd1, 0x44, 0x58, 0xfe, 0x0e, 0x94, 0x85, 0x74, 0x0a, 0x64, 0x2e, 0xad, 0x30, 0x20, 0xb2,
-	0xaf, 0x0e, 0x94, 0x1d, 0x8d, 0x25, 0x3a, 0x42, 0xa9, 0x1d, 0xea, 0x72, 0x91, 0x2d, 0x23, 0x98,
-	0x17, 0xf2, 0xa3, 0x18, 0x92, 0x1d, 0xe4, 0x09, 0x3e, 0x48, 0xe2, 0x85, 0x64, 0xb6, 0x9d, 0xec,
-	0x50, 0x7e, 0x2b, 0x03, 0x15, 0x95, 0x97, 0xd5, 0x22, 0x1a, 0xf2, 0xf8, 0xd1, 0x95, 0x9f, 0x09,
-	0x28, 0xd9, 0x83, 0xf9, 0xe8, 0x7b, 0x78, 0x57, 0x33, 0x3d, 0xd4, 0x12, 0x1e, 0xbc, 0x32, 0xd2,
-	0x9b, 0x78, 0xb7, 0xee, 0xa1, 0x96, 0x3a, 0x7b, 0x98, 0x68, 0xc3, 0xf2, 0x5b, 0x30, 0x41, 0x81,
-	0x02, 0x73, 0x08, 0xea, 0x79, 0x1f, 0x7b, 0x53, 0xf7, 0xf4, 0x1b, 0x96, 0xb3, 0xab, 0x72, 0x7a,
-	0xf9, 0x36, 0x14, 0x6d, 0x74, 0xa4, 0x91, 0xfd, 0x05, 0x97, 0x90, 0x1d, 0x52, 0x42, 0xc1, 0x46,
-	0x47, 0x6a, 0x87, 0x41, 0x0c, 0x56, 0x56, 0x60, 0x29, 0xc5, 0x05, 0xc1, 0x7e, 0x72, 0x61, 0xbb,
-	0x6b, 0x37, 0xb6, 0x9b, 0xba, 0x6b, 0xf0, 0x57, 0x72, 0xee, 0x9e, 0x0b, 0x50, 0xc4, 0x4e, 0xc7,
-	0x6d, 0x20, 0xad, 0x61, 0x75, 0xb0, 0x87, 0x5c, 0xee, 0xa0, 0x69, 0xd6, 0xba, 0xc9, 0x1a, 0xe5,
-	0x25, 0xc8, 0x61, 0xc2, 0x2c, 0x9e, 0x1a, 0xb3, 0xea, 0x24, 0xfd, 0x5d, 0x37, 0xe4, 0xeb, 0x90,
-	0x67, 0xcf, 0xf5, 0xec, 0xaa, 0x3b, 0x33, 0xe4, 0x55, 0x37, 0x30, 0x26, 0xd2, 0xac, 0x2c, 0xc1,
-	0x62, 0x62, 0x7a, 0xe2, 0x14, 0x92, 0x85, 0x59, 0xd2, 0x27, 0x52, 0x69, 0x84, 0xb0, 0x3a, 0x0f,
-	0x79, 0x3f, 0xac, 0xf8, 0xb4, 0xa7, 0x54, 0x10, 0x4d, 0x75, 0x23, 0xb4, 0xaf, 0xcb, 0x84, 0xf6,
-	0x75, 0x72, 0x05, 0x26, 0xb9, 0x8f, 0xf9, 0xeb, 0x89, 0xf8, 0x49, 0x06, 0x0d, 0x2e, 0xf6, 0x83,
-	0xd7, 0x4e, 0xbf, 0x8d, 0xbe, 0xed, 0xc7, 0x1f, 0xe9, 0x26, 0x4e, 0xf6, 0x48, 0x77, 0x0e, 0x40,
-	0xdc, 0x1f, 0x9b, 0xec, 0x39, 0x34, 0xa3, 0x4e, 0xf1, 0x16, 0x5a, 0x2f, 0x13, 0x7d, 0xd2, 0xc8,
-	0x9d, 0xe4, 0x49, 0x63, 0x8b, 0xd7, 0xe8, 0x04, 0x77, 0x95, 0x54, 0xd6, 0xd4, 0x90, 0xb2, 0x66,
-	0x08, 0xb3, 0x7f, 0xc7, 0x48, 0x25, 0x5e, 0x83, 0x49, 0xf1, 0x32, 0x01, 0x43, 0xbe, 0x4c, 0x08,
-	0x86, 0xf0, 0x03, 0x4b, 0x3e, 0xfa, 0xc0, 0xb2, 0x09, 0x05, 0x56, 0xc1, 0xc1, 0x0b, 0x94, 0x0b,
-	0x43, 0x16, 0x28, 0xe7, 0x69, 0x61, 0x07, 0xaf, 0x4d, 0x7e, 0x1d, 0x68, 0x6d, 0x31, 0x45, 0x42,
-	0xe4, 0x6a, 0xa6, 0x81, 0x6c, 0xcf, 0xf4, 0xba, 0xf4, 0xf5, 0x73, 0x4a, 0x95, 0x49, 0xdf, 0xfb,
-	0xb4, 0xab, 0xce, 0x7b, 0xe4, 0xf7, 0xa1, 0x14, 0x43, 0x0f, 0x5e, 0x4b, 0x53, 0x1b, 0x0d, 0x37,
-	0xd4, 0x62, 0x14, 0x33, 0x94, 0x05, 0x98, 0x8b, 0xc6, 0x34, 0x0f, 0xf6, 0xef, 0x49, 0xb0, 0x22,
-	0x96, 0xd6, 0xe7, 0x5c, 0x36, 0xa7, 0xfc, 0xaf, 0x04, 0x67, 0xd3, 0xe7, 0xc2, 0x57, 0xf8, 0x26,
-	0xcc, 0x36, 0xf4, 0x46, 0x13, 0x45, 0x3f, 0x69, 0xe0, 0x8b, 0xfc, 0x5b, 0xa9, 0x16, 0x0a, 0x7d,
-	0x14, 0x11, 0x1e, 0x3f, 0x22, 0x7e, 0x86, 0x0a, 0x0d, 0x37, 0xc9, 0x36, 0x2c, 0x18, 0xba, 0xa7,
-	0xef, 0xea, 0x38, 0x3e, 0xd8, 0xd8, 0x29, 0x07, 0x9b, 0x13, 0x72, 0xc3, 0xad, 0xca, 0x3f, 0x4b,
-	0xb0, 0x2c, 0x54, 0xe7, 0x2e, 0xbb, 0xeb, 0xe0, 0xf0, 0xfd, 0x7f, 0xd3, 0xc1, 0x9e, 0xa6, 0x1b,
-	0x86, 0x8b, 0x30, 0x16, 0x5e, 0x20, 0x6d, 0xd7, 0x59, 0x53, 0x3f, 0xb8, 0x8c, 0xfb, 0x30, 0x33,
-	0xec, 0x7a, 0x38, 0xfe, 0x14, 0x0e, 0xee, 0x8f, 0xc7, 0x82, 0x00, 0x8b, 0x68, 0xc6, 0x7d, 0xfa,
-	0x02, 0x4c, 0xd3, 0x79, 0x62, 0xcd, 0xee, 0xb4, 0x76, 0xf9, 0x62, 0x90, 0x55, 0x0b, 0xac, 0xf1,
-	0x21, 0x6d, 0x93, 0x57, 0x60, 0x4a, 0x28, 0xc7, 0xde, 0x97, 0xb2, 0x6a, 0x8e, 0x6b, 0x87, 0xe5,
-	0x8f, 0xa0, 0x14, 0xa8, 0x47, 0x5d, 0xd9, 0xf7, 0x3b, 0x0d, 0x9f, 0x96, 0xa8, 0xe0, 0xbf, 0x10,
-	0x6e, 0x12, 0x3e, 0xba, 0xdf, 0x28, 0xda, 0x91, 0x36, 0xf9, 0x0d, 0x58, 0x64, 0x63, 0x37, 0x1c,
-	0xdb, 0x73, 0x1d, 0xcb, 0x42, 0xae, 0x28, 0x16, 0x1b, 0xa7, 0x86, 0x9c, 0xa7, 0xdd, 0x9b, 0x7e,
-	0x2f, 0xaf, 0x01, 0x23, 0xd8, 0xc2, 0xdd, 0xc5, 0x5e, 0xbd, 0xc5, 0x4f, 0xa5, 0x06, 0x33, 0x9b,
-	0x96, 0x83, 0x11, 0x5d, 0x7c, 0x84, 0x8b, 0xc3, 0xfe, 0x93, 0x22, 0xfe, 0x53, 0xe6, 0x40, 0x0e,
-	0xd3, 0xf3, 0xcc, 0x7d, 0x0d, 0x4a, 0x77, 0x90, 0x37, 0xac, 0x8c, 0x8f, 0xa1, 0x1c, 0x50, 0x73,
-	0xd3, 0xdf, 0x07, 0xe0, 0xe4, 0x64, 0x17, 0xcb, 0xb2, 0xe8, 0xd2, 0x30, 0x81, 0x4d, 0xc5, 0x50,
-	0x63, 0x31, 0xb7, 0x90, 0x3f, 0x95, 0x9f, 0x48, 0x30, 0xc3, 0x6e, 0xf8, 0xc2, 0x27, 0xda, 0xde,
-	0x53, 0x92, 0x6f, 0x43, 0x8e, 0x6c, 0x1d, 0xf6, 0x09, 0xc8, 0x8d, 0xd1, 0xb2, 0xbb, 0x57, 0xfa,
-	0x17, 0xf5, 0xb1, 0xbb, 0x79, 0xc6, 0xa1, 0xfa, 0xbc, 0xe1, 0xd2, 0x83, 0x4c, 0xa4, 0xf4, 0xa0,
-	0x0e, 0xa5, 0x43, 0x13, 0x9b, 0xbb, 0xa6, 0x45, 0x1f, 0x27, 0x47, 0x79, 0x15, 0x2f, 0x06, 0x8c,
-	0x74, 0xbb, 0x30, 0x07, 0x72, 0x58, 0x37, 0xee, 0x82, 0xc7, 0x12, 0x9c, 0xbb, 0x83, 0x3c, 0x35,
-	0xf8, 0xbe, 0xeb, 0x01, 0xfb, 0xb6, 0xcb, 0xdf, 0xeb, 0xdc, 0x87, 0x09, 0x5a, 0x5c, 0x43, 0x52,
-	0x36, 0xd3, 0x33, 0x24, 0x43, 0x1f, 0x88, 0xb1, 0xeb, 0x15, 0xff, 0x27, 0x2d, 0xc3, 0x51, 0xb9,
-	0x0c, 0x92, 0xc8, 0x7c, 0xcb, 0x44, 0xdf, 0xbc, 0xf9, 0xfe, 0x22, 0xcf, 0xdb, 0x48, 0x2c, 0x2b,
-	0x3f, 0x1c, 0x83, 0x6a, 0xaf, 0x29, 0x71, 0xb7, 0xff, 0x06, 0x14, 0x99, 0x4b, 0xf8, 0x87, 0x68,
-	0x62, 0x6e, 0x1f, 0x0c, 0xf9, 0x48, 0xdc, 0x5f, 0x3c, 0x0b, 0x0e, 0xd1, 0xca, 0x0a, 0x6a, 0x58,
-	0x86, 0x8b, 0xb6, 0xe5, 0x2e, 0xc8, 0x49, 0xa2, 0x70, 0x71, 0x4d, 0x96, 0x15, 0xd7, 0x3c, 0x88,
-	0x16, 0xd7, 0xbc, 0x39, 0xa2, 0xed, 0xfc, 0x99, 0x05, 0xf5, 0x36, 0xca, 0xa7, 0xb0, 0x7a, 0x07,
-	0x79, 0x37, 0xef, 0xbf, 0xd7, 0xc7, 0x67, 0x8f, 0x78, 0x5d, 0x30, 0xc9, 0x0a, 0x61, 0x9b, 0x51,
-	0xc7, 0xf6, 0x4f, 0x2f, 0xb4, 0x54, 0x98, 0xfc, 0x85, 0x95, 0xdf, 0x91, 0x60, 0xad, 0xcf, 0xe0,
-	0xdc, 0x3b, 0x1f, 0xc3, 0x4c, 0x48, 0x2c, 0x7f, 0x52, 0x97, 0xe2, 0x27, 0xb4, 0xa1, 0x27, 0xa1,
-	0x96, 0xdd, 0x68, 0x03, 0x56, 0xbe, 0x2f, 0xc1, 0x1c, 0x2d, 0x44, 0x12, 0xf8, 0x3d, 0xc2, 0x5a,
-	0xff, 0x8d, 0xf8, 0x31, 0xff, 0xab, 0x03, 0x8f, 0xf9, 0x69, 0x43, 0x05, 0x47, 0xfb, 0x03, 0x98,
-	0x8f, 0x11, 0x70, 0x3b, 0xa8, 0x90, 0x8b, 0x15, 0x31, 0xbc, 0x31, 0xea, 0x50, 0xbc, 0x92, 0xc0,
-	0x97, 0xa3, 0xfc, 0x81, 0x04, 0x73, 0x2a, 0xd2, 0xdb, 0x6d, 0x8b, 0xdd, 0x9b, 0xe0, 0x11, 0x34,
-	0xdf, 0x8e, 0x6b, 0x9e, 0x5e, 0xf4, 0x17, 0xfe, 0x16, 0x92, 0xb9, 0x23, 0x39, 0x5c, 0xa0, 0xfd,
-	0x22, 0xcc, 0xc7, 0x08, 0xf8, 0x4c, 0xff, 0x72, 0x0c, 0xe6, 0x59, 0xac, 0xc4, 0xa3, 0xf3, 0x16,
-	0x8c, 0xfb, 0x45, 0x9d, 0xc5, 0xf0, 0x79, 0x3a, 0x0d, 0x31, 0x6f, 0x22, 0xdd, 0xb8, 0x8f, 0x3c,
-	0x0f, 0xb9, 0xb4, 0xb8, 0x82, 0xd6, 0xd1, 0x50, 0xf6, 0x7e, 0xdb, 0x85, 0xe4, 0xf9, 0x2c, 0x93,
-	0x76, 0x3e, 0x7b, 0x13, 0x2a, 0xa6, 0x4d, 0x28, 0xcc, 0x43, 0xa4, 0x21, 0xdb, 0x87, 0x93, 0xa0,
-	0x04, 0x6c, 0xde, 0xef, 0xbf, 0x65, 0x8b, 0x64, 0xaf, 0x1b, 0xf2, 0x2b, 0x30, 0xd3, 0xd2, 0x8f,
-	0xcd, 0x56, 0xa7, 0xa5, 0xb5, 0x09, 0x3d, 0x36, 0x3f, 0x65, 0x1f, 0x32, 0x66, 0xd5, 0x12, 0xef,
-	0xd8, 0xd2, 0xf7, 0xd1, 0xb6, 0xf9, 0x29, 0x92, 0x5f, 0x82, 0x12, 0xad, 0xf6, 0xa4, 0x84, 0xac,
-	0x4c, 0x71, 0x82, 0x96, 0x29, 0xd2, 0x22, 0x50, 0x42, 0xc6, 0x3e, 0x85, 0xf8, 0x4f, 0xf6, 0x51,
-	0x5c, 0xc4, 0x5e, 0x3c, 0x90, 0x9e, 0x92, 0xc1, 0x52, 0xf3, 0x72, 0xec, 0x29, 0xe6, 0x65, 0x9a,
-	0xae, 0x99, 0x34, 0x5d, 0xff, 0x45, 0x82, 0xc5, 0xad, 0x8e, 0xbb, 0x8f, 0x7e, 0x11, 0xa3, 0x43,
-	0x59, 0x86, 0x4a, 0x52, 0x39, 0x51, 0x3b, 0x31, 0x06, 0x8b, 0x0f, 0xd0, 0x2f, 0xa8, 0xe6, 0xcf,
-	0x24, 0x2f, 0x6e, 0x40, 0x25, 0x69, 0x30, 0x9e, 0x18, 0x29, 0x32, 0xa4, 0x34, 0x19, 0x3f, 0xa4,
-	0x9f, 0x1f, 0xec, 0xb9, 0x08, 0x37, 0xc3, 0x77, 0x70, 0xa3, 0x80, 0xe7, 0x87, 0x71, 0xf0, 0xfc,
-	0xd5, 0x21, 0xc1, 0xb3, 0xe7, 0xa8, 0x01, 0x86, 0xd2, 0x2f, 0x12, 0xd2, 0xe8, 0x78, 0xd0, 0xfc,
-	0x40, 0x82, 0x57, 0xee, 0x20, 0x1b, 0xb9, 0xba, 0x87, 0xee, 0xeb, 0xd8, 0x13, 0x27, 0xe4, 0x58,
-	0xfa, 0x3d, 0x8f, 0x03, 0xef, 0x25, 0x78, 0x75, 0xa8, 0x99, 0x71, 0x4d, 0x6e, 0xc3, 0x4a, 0x74,
-	0xef, 0x15, 0xbd, 0x57, 0xbb, 0x08, 0x25, 0x17, 0xb5, 0x1c, 0xcf, 0x8f, 0x4f, 0xb6, 0x6f, 0x98,
-	0x52, 0x8b, 0xac, 0x99, 0x07, 0x28, 0x56, 0x3a, 0x70, 0x36, 0x5d, 0x0e, 0x0f, 0x8c, 0x6f, 0xc2,
-	0x04, 0x3b, 0x7d, 0xf1, 0x7d, 0xc7, 0xdb, 0x43, 0x6e, 0x0c, 0xf9, 0xe9, 0x22, 0x2e, 0x96, 0x0b,
-	0x53, 0xfe, 0x3e, 0x0b, 0x0b, 0xe9, 0x24, 0xfd, 0x4e, 0x09, 0x5f, 0x85, 0xc5, 0x96, 0x7e, 0xac,
-	0xc5, 0xb1, 0x37, 0xf8, 0x00, 0x61, 0xae, 0xa5, 0x1f, 0xc7, 0x77, 0x5e, 0x86, 0x7c, 0x0f, 0xca,
-	0x4c, 0xa2, 0xe5, 0x34, 0x74, 0x6b, 0xb4, 0x7b, 0x42, 0xb6, 0x3d, 0xbe, 0x4f, 0x18, 0xe9, 0x0d,
-	0xd3, 0xa7, 0x49, 0xc3, 0xb2, 0x2b, 0xf3, 0xf7, 0x4e, 0x65, 0x98, 0x9a, 0x1a, 0x71, 0x0b, 0xdb,
-	0x2a, 0xc7, 0x7c, 0x25, 0xff, 0xae, 0x04, 0xb3, 0x4d, 0xdd, 0x36, 0x9c, 0x43, 0xbe, 0xe9, 0xa7,
-	0x41, 0x48, 0x8e, 0x94, 0xa3, 0x14, 0xc0, 0xf7, 0x98, 0xc0, 0x5d, 0x2e, 0xd8, 0x3f, 0x05, 0xf3,
-	0x49, 0xc8, 0xcd, 0x44, 0xc7, 0xf2, 0xf7, 0x25, 0x98, 0x4d, 0x99, 0x70, 0x4a, 0x4d, 0xfc, 0x47,
-	0xd1, 0x6d, 0xfb, 0x9d, 0x53, 0xcd, 0x71, 0x0b, 0xb9, 0x7c, 0xbc, 0xd0, 0x36, 0x7e, 0xf9, 0xbb,
-	0x12, 0x2c, 0xf6, 0x98, 0x7c, 0xca, 0x84, 0xd4, 0xe8, 0x84, 0xbe, 0x3e, 0xe4, 0x84, 0x12, 0x03,
-	0xd0, 0x0d, 0x7d, 0xe8, 0x30, 0xf1, 0x01, 0xcc, 0xa7, 0xd2, 0xc8, 0xef, 0xc2, 0x59, 0xdf, 0x67,
-	0x69, 0x81, 0x2b, 0xd1, 0xc0, 0x5d, 0x12, 0x34, 0x89, 0xe8, 0x55, 0xfe, 0x54, 0x82, 0xd5, 0x41,
-	0xf6, 0x90, 0x15, 0x98, 0xd6, 0x1b, 0x07, 0xc8, 0x88, 0x89, 0xcd, 0xd3, 0x46, 0x9e, 0x06, 0x1f,
-	0xc1, 0x72, 0x88, 0x26, 0x7e, 0x1a, 0x1e, 0xb6, 0x28, 0x7d, 0xd1, 0x17, 0xf9, 0x28, 0x7a, 0x2c,
-	0xfe, 0x3d, 0x09, 0x96, 0x55, 0xb4, 0xdb, 0x31, 0x2d, 0xe3, 0x79, 0x5f, 0x1e, 0x9e, 0x23, 0x8b,
-	0x54, 0xca, 0x4c, 0x18, 0xa6, 0xdd, 0x68, 0x7f, 0xf6, 0x79, 0xf5, 0xcc, 0x8f, 0x3f, 0xaf, 0x9e,
-	0xf9, 0xe9, 0xe7, 0x55, 0xe9, 0x37, 0x9f, 0x54, 0xa5, 0x3f, 0x7f, 0x52, 0x95, 0xfe, 0xee, 0x49,
-	0x55, 0xfa, 0xec, 0x49, 0x55, 0xfa, 0xb7, 0x27, 0x55, 0xe9, 0x3f, 0x9e, 0x54, 0xcf, 0xfc, 0xf4,
-	0x49, 0x55, 0x7a, 0xfc, 0x45, 0xf5, 0xcc, 0x67, 0x5f, 0x54, 0xcf, 0xfc, 0xf8, 0x8b, 0xea, 0x99,
-	0x0f, 0xaf, 0xed, 0x3b, 0xc1, 0x64, 0x4c, 0xa7, 0xef, 0xff, 0x2e, 0xfa, 0x95, 0x68, 0xcb, 0xee,
-	0x04, 0x35, 0xe7, 0xd5, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x65, 0x1d, 0xeb, 0xfa, 0x48,
-	0x00, 0x00,
+	// 4456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x6f, 0x1c, 0x47,
+	0x7a, 0xea, 0x19, 0x0e, 0x39, 0xf3, 0x91, 0x9c, 0x19, 0x36, 0x45, 0x72, 0x48, 0x4a, 0x23, 0xaa,
+	0x2d, 0x59, 0xf2, 0x43, 0x43, 0x4b, 0xda, 0xb5, 0xbd, 0x5a, 0x7b, 0x1d, 0x89, 0xd4, 0x63, 0x04,
+	0x4a, 0xa6, 0x9a, 0xb4, 0x6c, 0x78, 0xd7, 0x6e, 0x37, 0xbb, 0x6b, 0xc8, 0x0e, 0x7b, 0xba, 0x47,
+	0x5d, 0x3d, 0x24, 0xc7, 0x09, 0x90, 0xc7, 0x22, 0x41, 0xb2, 0x01, 0x02, 0x03, 0xb9, 0x2c, 0x82,
+	0xcd, 0x25, 0x08, 0x90, 0x20, 0x40, 0x90, 0x43, 0x4e, 0x7b, 0xc8, 0x35, 0x48, 0x2e, 0x89, 0x91,
+	0x20, 0xc8, 0x22, 0x87, 0x4d, 0x2c, 0x23, 0x40, 0x82, 0xe4, 0xb0, 0x87, 0xfc, 0x80, 0xa0, 0x5e,
+	0x3d, 0xfd, 0x9a, 0x97, 0x28, 0x45, 0xfb, 0xf0, 0x8d, 0x53, 0xf5, 0x3d, 0xeb, 0x7b, 0x54, 0xd5,
+	0x57, 0x5f, 0x13, 0xde, 0xf2, 0x51, 0xb3, 0xe5, 0x7a, 0xba, 0xbd, 0x8a, 0x91, 0x77, 0x80, 0xbc,
+	0x55, 0xbd, 0x65, 0xad, 0xee, 0x59, 0xd8, 0x77, 0xbd, 0x0e, 0x19, 0xb1, 0x0c, 0xb4, 0x7a, 0x70,
+	0x79, 0xd5, 0x43, 0x8f, 0xda, 0x08, 0xfb, 0x9a, 0x87, 0x70, 0xcb, 0x75, 0x30, 0xaa, 0xb5, 0x3c,
+	0xd7, 0x77, 0xe5, 0xf3, 0x02, 0xbb, 0xc6, 0xb0, 0x6b, 0x7a, 0xcb, 0xaa, 0x45, 0xb1, 0x6b, 0x07,
+	0x97, 0x97, 0xaa, 0xbb, 0xae, 0xbb, 0x6b, 0xa3, 0x55, 0x8a, 0xb4, 0xd3, 0x6e, 0xac, 0x9a, 0x6d,
+	0x4f, 0xf7, 0x2d, 0xd7, 0x61, 0x64, 0x96, 0xce, 0xc4, 0xe7, 0x7d, 0xab, 0x89, 0xb0, 0xaf, 0x37,
+	0x5b, 0x1c, 0xe0, 0xac, 0x89, 0x5a, 0xc8, 0x31, 0x91, 0x63, 0x58, 0x08, 0xaf, 0xee, 0xba, 0xbb,
+	0x2e, 0x1d, 0xa7, 0x7f, 0x71, 0x90, 0x73, 0x81, 0x22, 0x44, 0x03, 0xc3, 0x6d, 0x36, 0x5d, 0x87,
+	0x48, 0xde, 0x44, 0x18, 0xeb, 0xbb, 0x5c, 0xe0, 0xa5, 0xf3, 0x11, 0x28, 0x2e, 0x69, 0x12, 0xec,
+	0x42, 0x04, 0xcc, 0xd7, 0xf1, 0xfe, 0xa3, 0x36, 0x6a, 0xa3, 0x24, 0x60, 0x94, 0x2b, 0x72, 0xda,
+	0x4d, 0x4c, 0x80, 0x0e, 0x5d, 0x6f, 0xbf, 0x61, 0xbb, 0x87, 0x1c, 0xea, 0xc5, 0x08, 0x94, 0x98,
+	0x4c, 0x52, 0x7b, 0x21, 0x02, 0xf7, 0xa8, 0x8d, 0xbc, 0xce, 0x20, 0x15, 0x1a, 0xba, 0x65, 0xb7,
+	0xbd, 0x14, 0xc9, 0x5e, 0xed, 0x63, 0xd8, 0x24, 0xf4, 0x4b, 0x69, 0xd0, 0x81, 0x3a, 0x6c, 0x35,
+	0x39, 0xe8, 0x2b, 0x7d, 0x41, 0x63, 0x9a, 0x5f, 0xe8, 0x0b, 0x4c, 0x16, 0x96, 0x03, 0x5e, 0x4a,
+	0x03, 0xec, 0xbd, 0x52, 0xb5, 0x34, 0x70, 0x47, 0x6f, 0x22, 0xdc, 0xd2, 0x8d, 0x94, 0xd5, 0x78,
+	0x2d, 0x0d, 0xde, 0x43, 0x2d, 0xdb, 0x32, 0xa8, 0x23, 0x26, 0x31, 0xae, 0xa6, 0x61, 0xb4, 0x90,
+	0x87, 0x2d, 0xec, 0x23, 0x87, 0xf1, 0x40, 0x47, 0xc8, 0x68, 0x13, 0x74, 0xcc, 0x91, 0xde, 0x19,
+	0x02, 0x49, 0x28, 0xa5, 0x35, 0xdb, 0xbe, 0xbe, 0x63, 0x23, 0x0d, 0xfb, 0xba, 0x2f, 0xb8, 0xbe,
+	0x9e, 0xea, 0x29, 0x03, 0x03, 0x71, 0xe9, 0x5a, 0x1a, 0x63, 0xdd, 0x6c, 0x5a, 0xce, 0x40, 0x5c,
+	0xe5, 0xf7, 0xc6, 0xe1, 0xf4, 0x96, 0xaf, 0x7b, 0xfe, 0xfb, 0x9c, 0xdd, 0x4d, 0xa1, 0x96, 0xca,
+	0x10, 0xe4, 0xb3, 0x30, 0x15, 0xac, 0xad, 0x66, 0x99, 0x15, 0x69, 0x45, 0xba, 0x58, 0x50, 0x27,
+	0x83, 0xb1, 0xba, 0x29, 0x1b, 0x30, 0x8d, 0x09, 0x0d, 0x8d, 0x33, 0xa9, 0x64, 0x56, 0xa4, 0x8b,
+	0x93, 0x57, 0xbe, 0x15, 0x18, 0x8a, 0xa6, 0x86, 0x98, 0x42, 0xb5, 0x83, 0xcb, 0xb5, 0xbe, 0x9c,
+	0xd5, 0x29, 0x4a, 0x54, 0xc8, 0xb1, 0x07, 0x73, 0x2d, 0xdd, 0x43, 0x8e, 0xaf, 0x05, 0x2b, 0xaf,
+	0x59, 0x4e, 0xc3, 0xad, 0x64, 0x29, 0xb3, 0xaf, 0xd5, 0xd2, 0xd2, 0x51, 0xe0, 0x91, 0x07, 0x97,
+	0x6b, 0x9b, 0x14, 0x3b, 0xe0, 0x52, 0x77, 0x1a, 0xae, 0x3a, 0xdb, 0x4a, 0x0e, 0xca, 0x15, 0x98,
+	0xd0, 0x7d, 0x42, 0xcd, 0xaf, 0x8c, 0xad, 0x48, 0x17, 0x73, 0xaa, 0xf8, 0x29, 0x37, 0x41, 0x09,
+	0x2c, 0xd8, 0x95, 0x02, 0x1d, 0xb5, 0x2c, 0x96, 0xd2, 0x34, 0x92, 0xbb, 0x2a, 0x39, 0x2a, 0xd0,
+	0x52, 0x8d, 0x25, 0xb6, 0x9a, 0x48, 0x6c, 0xb5, 0x6d, 0x91, 0xd8, 0x6e, 0x8c, 0x7d, 0xf6, 0x6f,
+	0x67, 0x24, 0xf5, 0xcc, 0x61, 0x5c, 0xf3, 0x9b, 0x01, 0x25, 0x02, 0x2b, 0xef, 0xc1, 0xa2, 0xe1,
+	0x3a, 0xbe, 0xe5, 0xb4, 0x91, 0xa6, 0x63, 0xcd, 0x41, 0x87, 0x9a, 0xe5, 0x58, 0xbe, 0xa5, 0xfb,
+	0xae, 0x57, 0x19, 0x5f, 0x91, 0x2e, 0x16, 0xaf, 0x5c, 0x8a, 0xae, 0x31, 0x8d, 0x2e, 0xa2, 0xec,
+	0x1a, 0xc7, 0xbb, 0x8e, 0xef, 0xa3, 0xc3, 0xba, 0x40, 0x52, 0xe7, 0x8d, 0xd4, 0x71, 0xf9, 0x1e,
+	0xcc, 0x88, 0x19, 0x53, 0xe3, 0x69, 0xa5, 0x32, 0x41, 0xf5, 0x58, 0x89, 0x72, 0xe0, 0x93, 0x84,
+	0xc7, 0x2d, 0xf6, 0xa7, 0x5a, 0x0e, 0x50, 0xf9, 0x88, 0xfc, 0x10, 0xe6, 0x6d, 0x1d, 0xfb, 0x9a,
+	0xe1, 0x36, 0x5b, 0x36, 0xa2, 0x2b, 0xe3, 0x21, 0xdc, 0xb6, 0xfd, 0x4a, 0x3e, 0x8d, 0x26, 0x4f,
+	0x31, 0xd4, 0x46, 0x1d, 0xdb, 0xd5, 0x4d, 0xac, 0x9e, 0x24, 0xf8, 0x6b, 0x01, 0xba, 0x4a, 0xb1,
+	0xe5, 0x8f, 0x61, 0xb9, 0x61, 0x79, 0xd8, 0xd7, 0x02, 0x2b, 0x90, 0x2c, 0xa2, 0xed, 0xe8, 0xc6,
+	0xbe, 0xdb, 0x68, 0x54, 0x0a, 0x94, 0xf8, 0x62, 0x62, 0xe1, 0xd7, 0xf9, 0x8e, 0x73, 0x63, 0xec,
+	0xfb, 0x64, 0xdd, 0x2b, 0x94, 0x86, 0x70, 0xbb, 0x6d, 0x1d, 0xef, 0xdf, 0x60, 0x04, 0x94, 0x37,
+	0xa0, 0xda, 0xcb, 0x25, 0x59, 0xd4, 0xc8, 0x73, 0x30, 0xee, 0xb5, 0x9d, 0x6e, 0x1c, 0xe4, 0xbc,
+	0xb6, 0x53, 0x37, 0x95, 0xff, 0x96, 0x60, 0xfe, 0x36, 0xf2, 0xef, 0xb1, 0xa8, 0xde, 0xf2, 0x75,
+	0x1f, 0x8d, 0x10, 0x3f, 0xb7, 0xa1, 0x10, 0x78, 0x13, 0x8f, 0x9d, 0x97, 0x7a, 0xad, 0x50, 0x52,
+	0xb4, 0x2e, 0xae, 0x7c, 0x15, 0xe6, 0xd1, 0x51, 0x0b, 0x19, 0x3e, 0x32, 0x35, 0x07, 0x1d, 0xf9,
+	0x1a, 0x3a, 0x20, 0x01, 0x63, 0x99, 0x34, 0x48, 0xb2, 0xea, 0xac, 0x98, 0xbd, 0x8f, 0x8e, 0xfc,
+	0x9b, 0x64, 0xae, 0x6e, 0xca, 0xaf, 0xc1, 0x49, 0xa3, 0xed, 0xd1, 0xc8, 0xda, 0xf1, 0x74, 0xc7,
+	0xd8, 0xd3, 0x7c, 0x77, 0x1f, 0x39, 0xd4, 0xf7, 0xa7, 0x54, 0x99, 0xcf, 0xdd, 0xa0, 0x53, 0xdb,
+	0x64, 0x46, 0xf9, 0xf3, 0x3c, 0x2c, 0x24, 0xb4, 0xe5, 0x0b, 0x14, 0xd1, 0x45, 0x3a, 0x86, 0x2e,
+	0x75, 0x98, 0xee, 0x5a, 0xb9, 0xd3, 0x42, 0x7c, 0x61, 0xce, 0x0d, 0x22, 0xb6, 0xdd, 0x69, 0x21,
+	0x75, 0xea, 0x30, 0xf4, 0x4b, 0x56, 0x60, 0x3a, 0x6d, 0x35, 0x26, 0x9d, 0xd0, 0x2a, 0x7c, 0x03,
+	0x16, 0x5b, 0x1e, 0x3a, 0xb0, 0xdc, 0x36, 0xd6, 0x68, 0xde, 0x41, 0x66, 0x17, 0x7e, 0x8c, 0xc2,
+	0xcf, 0x0b, 0x80, 0x2d, 0x36, 0x2f, 0x50, 0x2f, 0xc1, 0x2c, 0xf5, 0x76, 0xe6, 0x9a, 0x01, 0x52,
+	0x8e, 0x22, 0x95, 0xc9, 0xd4, 0x2d, 0x32, 0x23, 0xc0, 0xd7, 0x00, 0xa8, 0xd7, 0xd2, 0x53, 0x45,
+	0x65, 0x3c, 0x4d, 0xab, 0xe0, 0xd0, 0x41, 0x14, 0x23, 0x0e, 0xfa, 0x80, 0xfc, 0x50, 0x0b, 0xbe,
+	0xf8, 0x53, 0xde, 0x84, 0x19, 0xec, 0x5b, 0xc6, 0x7e, 0x47, 0x0b, 0xd1, 0x9a, 0x18, 0x81, 0x56,
+	0x89, 0xa1, 0x07, 0x03, 0xf2, 0xaf, 0xc0, 0x2b, 0x09, 0x8a, 0x1a, 0x36, 0xf6, 0x90, 0xd9, 0xb6,
+	0x91, 0xe6, 0xbb, 0x6c, 0x55, 0x68, 0x86, 0x73, 0xdb, 0x7e, 0x65, 0x72, 0xb8, 0x58, 0x3b, 0x1f,
+	0x63, 0xb3, 0xc5, 0x09, 0x6e, 0xbb, 0x74, 0x11, 0xb7, 0x19, 0xb5, 0x9e, 0x3e, 0x38, 0xdd, 0xcb,
+	0x07, 0xe5, 0x6f, 0x43, 0x31, 0x70, 0x0f, 0xba, 0x89, 0x56, 0x4a, 0x34, 0x21, 0xa6, 0xef, 0x03,
+	0x41, 0x5e, 0x4c, 0xb8, 0x1c, 0xf3, 0xde, 0xc0, 0xd5, 0xe8, 0x4f, 0xf9, 0x7d, 0x28, 0x45, 0x88,
+	0xb7, 0x71, 0xa5, 0x4c, 0xa9, 0xd7, 0x7a, 0xa4, 0xdb, 0x54, 0xb2, 0x6d, 0xac, 0x16, 0xc3, 0x74,
+	0xdb, 0x58, 0xfe, 0x08, 0x66, 0x0e, 0x90, 0x87, 0x49, 0x42, 0x64, 0xc7, 0x31, 0x0b, 0xe1, 0xca,
+	0x0c, 0x5d, 0xca, 0xd7, 0x6a, 0x7d, 0xce, 0xd3, 0x84, 0xc7, 0x43, 0x86, 0x78, 0x47, 0xe0, 0xa9,
+	0xe5, 0x83, 0xd8, 0x88, 0xfc, 0x2d, 0x38, 0x65, 0x61, 0x8d, 0x2d, 0x79, 0xd8, 0x8c, 0xc8, 0x21,
+	0x81, 0x6a, 0x56, 0xe4, 0x15, 0xe9, 0x62, 0x5e, 0xad, 0x58, 0x78, 0x2b, 0x6a, 0x95, 0x9b, 0x6c,
+	0x5e, 0xfe, 0x1a, 0x2c, 0x24, 0x3c, 0xd9, 0x3f, 0xa2, 0xe9, 0x6e, 0x96, 0x25, 0x90, 0xa8, 0x37,
+	0x6f, 0x1f, 0x39, 0x75, 0xf3, 0xee, 0x58, 0x3e, 0x5f, 0x2e, 0xdc, 0x1d, 0xcb, 0x17, 0xca, 0x70,
+	0x77, 0x2c, 0x0f, 0xe5, 0xc9, 0xbb, 0x63, 0xf9, 0xa9, 0xf2, 0xf4, 0xdd, 0xb1, 0x7c, 0xb1, 0x5c,
+	0x52, 0xfe, 0x47, 0x82, 0x85, 0x4d, 0xd7, 0xb6, 0x7f, 0x41, 0x72, 0xe3, 0x7f, 0x4c, 0x40, 0x25,
+	0xa9, 0xee, 0x57, 0xc9, 0xf1, 0xab, 0xe4, 0xf8, 0xd4, 0x93, 0xe3, 0x54, 0xcf, 0xe4, 0x98, 0x9a,
+	0x66, 0x8a, 0x4f, 0x2d, 0xcd, 0xfc, 0x6c, 0xe6, 0xde, 0x3e, 0xc9, 0x6d, 0x66, 0xb4, 0xe4, 0x36,
+	0x5d, 0x2e, 0x2a, 0xbf, 0x2b, 0xc1, 0xb2, 0x8a, 0x30, 0xf2, 0x63, 0xa9, 0xf4, 0x39, 0xa4, 0x36,
+	0xa5, 0x0a, 0xa7, 0xd2, 0x45, 0x61, 0x69, 0x47, 0xf9, 0xd7, 0x0c, 0xac, 0xa8, 0xc8, 0x70, 0x3d,
+	0x33, 0x7c, 0xe8, 0xe5, 0x81, 0x3a, 0x82, 0xc0, 0x1f, 0x80, 0x9c, 0xbc, 0xfe, 0x8c, 0x2e, 0xf9,
+	0x4c, 0xe2, 0xde, 0x23, 0x9f, 0x81, 0xc9, 0x20, 0x9a, 0x82, 0x14, 0x04, 0x62, 0xa8, 0x6e, 0xca,
+	0x0b, 0x30, 0x41, 0x23, 0x2f, 0xc8, 0x37, 0xe3, 0xe4, 0x67, 0xdd, 0x94, 0x4f, 0x03, 0x88, 0xab,
+	0x2d, 0x4f, 0x2b, 0x05, 0xb5, 0xc0, 0x47, 0xea, 0xa6, 0xfc, 0x09, 0x4c, 0xb5, 0x5c, 0xdb, 0x0e,
+	0x6e, 0xa6, 0x2c, 0xa3, 0xbc, 0x3d, 0xf0, 0x66, 0x4a, 0x52, 0x78, 0x78, 0xb1, 0xc2, 0xb6, 0x55,
+	0x27, 0x09, 0x49, 0xfe, 0x43, 0xf9, 0xe7, 0x09, 0x38, 0xdb, 0x67, 0x71, 0x79, 0xe6, 0x4f, 0x24,
+	0x6c, 0xe9, 0x89, 0x13, 0x76, 0xdf, 0x64, 0x9c, 0xe9, 0x9b, 0x8c, 0x5f, 0x05, 0x59, 0xac, 0xa9,
+	0x19, 0x4f, 0xf8, 0xe5, 0x60, 0x46, 0x40, 0x5f, 0x84, 0x72, 0x8f, 0x64, 0x5f, 0xc4, 0x51, 0xba,
+	0x89, 0x3d, 0x24, 0x97, 0xdc, 0x43, 0x42, 0xb7, 0xea, 0xf1, 0xe8, 0xad, 0xfa, 0x4d, 0xa8, 0xf0,
+	0xe4, 0x1a, 0xba, 0x53, 0xf3, 0x13, 0xcb, 0x04, 0x3d, 0xb1, 0xcc, 0xb3, 0xf9, 0xee, 0x3d, 0x99,
+	0xcd, 0xca, 0xbb, 0x21, 0x87, 0x64, 0xee, 0x41, 0x0a, 0x02, 0xec, 0x8e, 0xf9, 0x8d, 0x41, 0x89,
+	0x6e, 0xdb, 0xd3, 0x1d, 0x6c, 0x21, 0x27, 0x72, 0x13, 0xa4, 0x55, 0x81, 0xf2, 0x61, 0x6c, 0x44,
+	0xde, 0x85, 0xd3, 0x29, 0x17, 0xff, 0xd0, 0xee, 0x52, 0x18, 0x61, 0x77, 0x59, 0x4a, 0xf8, 0x7f,
+	0x30, 0x47, 0xa2, 0x30, 0x92, 0xe3, 0x27, 0x69, 0x8e, 0x9f, 0xdc, 0x09, 0x25, 0xf7, 0xdb, 0x50,
+	0xec, 0x1a, 0x91, 0x16, 0x1c, 0xa6, 0x86, 0x2c, 0x38, 0x4c, 0x07, 0x78, 0x64, 0x46, 0x5e, 0x83,
+	0x29, 0x61, 0x5f, 0x4a, 0x66, 0x7a, 0x48, 0x32, 0x93, 0x1c, 0x8b, 0x12, 0x71, 0x61, 0x82, 0xd4,
+	0x2a, 0xd9, 0x06, 0x93, 0xbd, 0x38, 0x79, 0xe5, 0xbd, 0xda, 0x50, 0x75, 0xe1, 0xda, 0xc0, 0x98,
+	0xa9, 0x3d, 0x60, 0x74, 0x6f, 0x3a, 0xbe, 0xd7, 0x51, 0x05, 0x97, 0xa5, 0x4f, 0x60, 0x2a, 0x3c,
+	0x21, 0x97, 0x21, 0xbb, 0x8f, 0x3a, 0x3c, 0x5d, 0x91, 0x3f, 0xe5, 0x6b, 0x90, 0x3b, 0xd0, 0xed,
+	0x76, 0x8f, 0x43, 0x11, 0xad, 0xac, 0x86, 0x43, 0x8c, 0x50, 0xeb, 0xa8, 0x0c, 0xe5, 0x5a, 0xe6,
+	0x4d, 0x89, 0xa5, 0xf9, 0x50, 0xd2, 0xbc, 0x6e, 0xf8, 0xd6, 0x81, 0xe5, 0x77, 0xbe, 0x4a, 0x9a,
+	0x43, 0x24, 0xcd, 0xf0, 0x62, 0xf5, 0x4e, 0x9a, 0xbf, 0x39, 0x26, 0x92, 0x66, 0xea, 0xe2, 0xf2,
+	0xa4, 0x79, 0x1f, 0x4a, 0xb1, 0x74, 0xc5, 0xd3, 0xe6, 0xf9, 0xa8, 0x28, 0xa1, 0xa0, 0x66, 0x87,
+	0x94, 0x0e, 0x4d, 0x3a, 0x6a, 0x31, 0x9a, 0xd2, 0x12, 0x0e, 0x9f, 0x79, 0x12, 0x87, 0x0f, 0xe5,
+	0xb1, 0x6c, 0x34, 0x8f, 0x21, 0xa8, 0x8a, 0x73, 0x1a, 0x1f, 0xd2, 0x62, 0x81, 0x3a, 0x36, 0x24,
+	0xc3, 0x65, 0x4e, 0xe7, 0x3a, 0x23, 0xb3, 0x15, 0x09, 0xdb, 0x7b, 0x30, 0xb3, 0x87, 0x74, 0xcf,
+	0xdf, 0x41, 0xba, 0xaf, 0x99, 0xc8, 0xd7, 0x2d, 0x1b, 0x57, 0x72, 0x43, 0xd6, 0xd5, 0xca, 0x01,
+	0xea, 0x3a, 0xc3, 0x4c, 0xee, 0x4c, 0xe3, 0x4f, 0xbc, 0x33, 0x5d, 0x0a, 0xb9, 0x7a, 0x10, 0x02,
+	0x34, 0x85, 0x17, 0xba, 0xfe, 0x7b, 0x5f, 0x4c, 0x28, 0x3f, 0x94, 0xe0, 0x05, 0x66, 0xeb, 0x48,
+	0x1a, 0xe0, 0x55, 0xbf, 0x91, 0x82, 0xcc, 0x85, 0x32, 0xaf, 0x35, 0xa2, 0x58, 0x11, 0x7a, 0x7d,
+	0xa0, 0xd7, 0x0e, 0x21, 0x82, 0x5a, 0x12, 0xd4, 0x03, 0x07, 0xce, 0xc0, 0xb9, 0xfe, 0x88, 0xdc,
+	0x87, 0x71, 0x77, 0x13, 0x15, 0xa5, 0x77, 0xee, 0xc4, 0x77, 0x9e, 0x56, 0xa2, 0x24, 0xd7, 0x95,
+	0x68, 0xe0, 0x20, 0x28, 0xea, 0x3c, 0xae, 0xe8, 0x26, 0x85, 0x2b, 0x99, 0x95, 0xec, 0x50, 0x15,
+	0xf9, 0x1e, 0x21, 0xcc, 0x19, 0x4d, 0xeb, 0xa1, 0x29, 0xac, 0xfc, 0xa5, 0x04, 0x2b, 0x6c, 0x2e,
+	0x22, 0x1e, 0xa9, 0x02, 0x8f, 0x64, 0xbd, 0x3d, 0x28, 0x36, 0x28, 0x4e, 0xcc, 0x76, 0xd7, 0x9f,
+	0xc4, 0x76, 0x11, 0xee, 0xea, 0x74, 0x23, 0xfc, 0x53, 0x79, 0x01, 0xce, 0xf6, 0x41, 0xe1, 0xc7,
+	0xe5, 0x1f, 0x4a, 0xa0, 0x24, 0x93, 0xd3, 0x1d, 0x11, 0x38, 0x23, 0x28, 0xd6, 0x0a, 0x87, 0x6a,
+	0x54, 0xb7, 0xb5, 0x21, 0x74, 0x1b, 0x24, 0x42, 0x28, 0x9a, 0x85, 0x82, 0x9b, 0xf0, 0x42, 0x5f,
+	0x3c, 0xee, 0x20, 0x2f, 0x41, 0xd9, 0xd0, 0x1d, 0x03, 0x05, 0x39, 0x1e, 0x31, 0xf9, 0xf3, 0x6a,
+	0x89, 0x8d, 0xab, 0x62, 0x38, 0x1c, 0xa5, 0x61, 0x9a, 0xcf, 0x29, 0x4a, 0xfb, 0x89, 0x90, 0x8c,
+	0xd2, 0x17, 0xe1, 0x5c, 0x7f, 0x3c, 0x6e, 0xf1, 0x90, 0x23, 0x87, 0x01, 0xff, 0xff, 0x1d, 0xb9,
+	0x27, 0xf7, 0xde, 0x8e, 0x9c, 0x86, 0xc2, 0xd5, 0xfa, 0x2b, 0xea, 0xc8, 0x49, 0xfd, 0xa9, 0x85,
+	0x47, 0x52, 0xec, 0x97, 0xa1, 0x18, 0xf5, 0x97, 0x11, 0xbc, 0x78, 0x10, 0x7f, 0x75, 0x3a, 0xe2,
+	0x72, 0xca, 0xf9, 0x74, 0x7f, 0x0b, 0x90, 0xb8, 0x72, 0x7f, 0x93, 0x81, 0xea, 0x96, 0xb5, 0xeb,
+	0xe8, 0xf6, 0x71, 0x9e, 0x2e, 0x1b, 0x50, 0xc4, 0x94, 0x48, 0x4c, 0xb1, 0x77, 0x06, 0xbf, 0x5d,
+	0xf6, 0xe5, 0xad, 0x4e, 0x33, 0xb2, 0x42, 0x14, 0x0b, 0x96, 0xd1, 0x91, 0x8f, 0x3c, 0xc2, 0x29,
+	0xe5, 0x38, 0x98, 0x1d, 0xf5, 0x38, 0xb8, 0x28, 0xa8, 0x25, 0xa6, 0xe4, 0x1a, 0xcc, 0x1a, 0x7b,
+	0x96, 0x6d, 0x76, 0xf9, 0xb8, 0x8e, 0xdd, 0xa1, 0x67, 0x8f, 0xbc, 0x3a, 0x43, 0xa7, 0x04, 0xd2,
+	0xbb, 0x8e, 0xdd, 0x51, 0xce, 0xc2, 0x99, 0x9e, 0xba, 0xf0, 0xb5, 0xfe, 0x47, 0x09, 0x2e, 0x70,
+	0x18, 0xcb, 0xdf, 0x3b, 0xf6, 0x7b, 0xf1, 0x77, 0x25, 0x58, 0xe4, 0xab, 0x7e, 0x68, 0xf9, 0x7b,
+	0x5a, 0xda, 0xe3, 0xf1, 0x9d, 0x61, 0x0d, 0x30, 0x48, 0x20, 0x75, 0x1e, 0x47, 0x01, 0x85, 0x9f,
+	0x5d, 0x87, 0x8b, 0x83, 0x49, 0xf4, 0x7f, 0xf6, 0xfb, 0x6b, 0x09, 0xce, 0xa8, 0xa8, 0xe9, 0x1e,
+	0x20, 0x46, 0xe9, 0x09, 0x6b, 0xdc, 0xcf, 0xee, 0x8a, 0x10, 0x3d, 0xe8, 0x67, 0x63, 0x07, 0x7d,
+	0x45, 0x81, 0x95, 0xde, 0xe2, 0x0b, 0xdb, 0x67, 0xe0, 0xec, 0x36, 0xf2, 0x9a, 0x96, 0xa3, 0xfb,
+	0xe8, 0x38, 0x56, 0x77, 0x61, 0xc6, 0x17, 0x74, 0x62, 0xc6, 0xbe, 0x31, 0xd0, 0xd8, 0x03, 0x25,
+	0x50, 0xcb, 0x01, 0xf1, 0x9f, 0x81, 0x98, 0x3b, 0x07, 0x4a, 0x3f, 0x8d, 0xf8, 0xd2, 0xff, 0x91,
+	0x04, 0xd5, 0x75, 0x64, 0xa3, 0xe3, 0xad, 0xfb, 0x33, 0xf3, 0x2e, 0x92, 0x39, 0x7a, 0x8a, 0xc7,
+	0x55, 0xf8, 0x53, 0x09, 0x4e, 0xd3, 0xda, 0xe4, 0x31, 0xfb, 0x4b, 0x3c, 0x42, 0x63, 0xe4, 0xfe,
+	0x92, 0xbe, 0x9c, 0xd5, 0x29, 0x4a, 0x54, 0xa4, 0x83, 0x37, 0xa0, 0xda, 0x0b, 0xbc, 0x7f, 0x12,
+	0xf8, 0x83, 0x2c, 0x9c, 0xe7, 0x44, 0xd8, 0x26, 0x75, 0x1c, 0x55, 0x9b, 0x3d, 0x36, 0xda, 0x5b,
+	0x43, 0xe8, 0x3a, 0x84, 0x08, 0xb1, 0xbd, 0x56, 0x7e, 0x3b, 0x14, 0x22, 0xbc, 0xb5, 0x24, 0x59,
+	0x19, 0xac, 0x08, 0x90, 0xba, 0x80, 0x10, 0x35, 0xbd, 0x01, 0x11, 0x36, 0xf6, 0xec, 0x23, 0x2c,
+	0xd7, 0x2b, 0xc2, 0x2e, 0xc2, 0x8b, 0x83, 0x56, 0x84, 0xbb, 0xe8, 0x3f, 0x48, 0xb0, 0x2c, 0x6e,
+	0xd8, 0xe1, 0x5b, 0xc1, 0x4f, 0x45, 0x02, 0xbf, 0x0a, 0xf3, 0x16, 0xd6, 0x52, 0x9a, 0x5e, 0xa8,
+	0x6d, 0xf2, 0xea, 0xac, 0x85, 0x6f, 0xc5, 0xbb, 0x59, 0xc8, 0x7b, 0x40, 0xba, 0x42, 0x5c, 0xe3,
+	0xff, 0xa5, 0x97, 0x57, 0x72, 0x4b, 0x58, 0x23, 0xeb, 0x16, 0x70, 0x7b, 0x92, 0x33, 0xfd, 0xb3,
+	0x53, 0xfd, 0x2c, 0x4c, 0x75, 0x5d, 0xb2, 0xfb, 0x2e, 0x19, 0x8c, 0xd5, 0x4d, 0xf9, 0x43, 0x98,
+	0x15, 0x47, 0x7e, 0xf3, 0x38, 0x7e, 0x27, 0x07, 0x54, 0xba, 0xec, 0x37, 0x83, 0xcb, 0x0a, 0xad,
+	0x47, 0xd3, 0xea, 0x53, 0x6e, 0x94, 0xea, 0x53, 0xa9, 0x8b, 0x4e, 0x07, 0x94, 0x0b, 0x70, 0x7e,
+	0xc0, 0xaa, 0x73, 0xfb, 0xfc, 0xb1, 0x04, 0x2b, 0xeb, 0x08, 0x1b, 0x9e, 0xb5, 0x73, 0xac, 0xcc,
+	0xff, 0x6d, 0x98, 0x18, 0xf5, 0x1e, 0x32, 0x88, 0xad, 0x2a, 0x28, 0x2a, 0x3f, 0xce, 0xc1, 0xd9,
+	0x3e, 0xd0, 0x3c, 0x67, 0x7e, 0x07, 0xca, 0xdd, 0x7a, 0xb9, 0xe1, 0x3a, 0x0d, 0x6b, 0x97, 0x97,
+	0x3f, 0x2e, 0xa7, 0xcb, 0x92, 0x6a, 0xa0, 0x35, 0x8a, 0xa8, 0x96, 0x50, 0x74, 0x40, 0xde, 0x85,
+	0x85, 0x94, 0xb2, 0x3c, 0x7d, 0x04, 0x60, 0x0a, 0xaf, 0x8e, 0xc0, 0x84, 0x96, 0xfe, 0xe7, 0x0e,
+	0xd3, 0x86, 0xe5, 0xef, 0x80, 0xdc, 0x42, 0x8e, 0x69, 0x39, 0xbb, 0x1a, 0x2f, 0x81, 0x58, 0x08,
+	0x57, 0xb2, 0xb4, 0xa8, 0x72, 0xa9, 0x37, 0x8f, 0x4d, 0x86, 0x23, 0xee, 0x31, 0x94, 0xc3, 0x4c,
+	0x2b, 0x32, 0x68, 0x21, 0x2c, 0x7f, 0x0c, 0x65, 0x41, 0x9d, 0x26, 0x32, 0x8f, 0x76, 0x18, 0x10,
+	0xda, 0x57, 0x07, 0xd2, 0x8e, 0xfa, 0x12, 0xe5, 0x50, 0x6a, 0x85, 0xa6, 0x3c, 0xe4, 0xc8, 0x08,
+	0xe6, 0x04, 0xfd, 0x68, 0x0e, 0xc9, 0x0d, 0xb2, 0x04, 0x67, 0x92, 0x78, 0x21, 0x99, 0x6d, 0x25,
+	0x27, 0xe4, 0x77, 0xa1, 0x80, 0xad, 0x4f, 0x11, 0x5b, 0x7f, 0x56, 0x45, 0xbc, 0x32, 0xb0, 0x2b,
+	0xb3, 0xfb, 0x6a, 0x6b, 0x7d, 0x8a, 0x28, 0xed, 0x3c, 0xe6, 0x7f, 0xc9, 0xbf, 0x0a, 0xcb, 0xc4,
+	0x65, 0x6c, 0xcb, 0xa0, 0x7d, 0xab, 0xae, 0xdd, 0xe6, 0xad, 0x84, 0x24, 0x88, 0x70, 0x65, 0x82,
+	0x2e, 0xd1, 0x5b, 0xa9, 0x2c, 0x42, 0x7d, 0xb7, 0xbc, 0x1d, 0x92, 0x92, 0x51, 0x03, 0x2a, 0x2c,
+	0x12, 0xd5, 0x45, 0xa3, 0xc7, 0x0c, 0x56, 0x7e, 0x23, 0x0b, 0x15, 0x95, 0x77, 0x09, 0x23, 0x1a,
+	0xc1, 0xf8, 0xe1, 0x95, 0x9f, 0x8a, 0xcc, 0xd8, 0x80, 0xb9, 0xe8, 0xf3, 0x7e, 0x47, 0xb3, 0x7c,
+	0xd4, 0x14, 0x0e, 0x79, 0x65, 0xa4, 0x27, 0xfe, 0x4e, 0xdd, 0x47, 0x4d, 0x75, 0xf6, 0x20, 0x31,
+	0x86, 0xe5, 0x37, 0x61, 0x9c, 0xe6, 0x3d, 0x5c, 0x19, 0xeb, 0x5f, 0x5e, 0x5e, 0xd7, 0x7d, 0xfd,
+	0x86, 0xed, 0xee, 0xa8, 0x1c, 0x5e, 0xbe, 0x05, 0x45, 0xd2, 0xad, 0x4a, 0x8e, 0x4b, 0x9c, 0x42,
+	0x6e, 0x48, 0x0a, 0x53, 0x0e, 0x3a, 0x54, 0xdb, 0x2c, 0x63, 0x62, 0x65, 0x19, 0x16, 0x53, 0x4c,
+	0xd0, 0x3d, 0x1e, 0xcf, 0x6f, 0x75, 0x1c, 0x63, 0x6b, 0x4f, 0xf7, 0x4c, 0xfe, 0xe8, 0xcf, 0xcd,
+	0x73, 0x1e, 0x8a, 0xd8, 0x6d, 0x7b, 0x06, 0xd2, 0x0c, 0xbb, 0x8d, 0x7d, 0xe4, 0x71, 0x03, 0x4d,
+	0xb3, 0xd1, 0x35, 0x36, 0x28, 0x2f, 0x42, 0x1e, 0x13, 0x64, 0xf1, 0x72, 0x9a, 0x53, 0x27, 0xe8,
+	0xef, 0xba, 0x29, 0x5f, 0x87, 0x49, 0xd6, 0x7d, 0xc0, 0x2a, 0xf7, 0xd9, 0x21, 0x2b, 0xf7, 0xc0,
+	0x90, 0xc8, 0xb0, 0xb2, 0x08, 0x0b, 0x09, 0xf1, 0xc4, 0xa5, 0x2a, 0x07, 0xb3, 0x64, 0x4e, 0x64,
+	0x86, 0x11, 0xdc, 0xea, 0x0c, 0x4c, 0x06, 0x6e, 0xc5, 0xc5, 0x2e, 0xa8, 0x20, 0x86, 0xea, 0x66,
+	0xe8, 0x98, 0x9a, 0x0d, 0x1d, 0x53, 0xc9, 0xbb, 0x05, 0xb7, 0x31, 0x7f, 0x0c, 0x12, 0x3f, 0x09,
+	0xd3, 0xee, 0x3b, 0x45, 0xf7, 0xf1, 0x36, 0x18, 0xa3, 0xad, 0x0a, 0xf1, 0x37, 0xc7, 0xf1, 0x27,
+	0x7b, 0x73, 0x3c, 0x0d, 0x20, 0xca, 0xe1, 0x16, 0x7b, 0xdd, 0xcd, 0xaa, 0x05, 0x3e, 0x52, 0x37,
+	0x13, 0x2f, 0x34, 0xf9, 0x27, 0x79, 0xa1, 0xd9, 0xe4, 0x2d, 0x47, 0xdd, 0xd2, 0x2b, 0xa5, 0x55,
+	0x18, 0x92, 0xd6, 0x0c, 0x41, 0x0e, 0x4a, 0xa6, 0x94, 0xe2, 0x35, 0x98, 0x10, 0x0f, 0x2d, 0x30,
+	0xe4, 0x43, 0x8b, 0x40, 0x08, 0xbf, 0x17, 0x4d, 0x46, 0xdf, 0x8b, 0xd6, 0x60, 0x8a, 0xca, 0x29,
+	0xfa, 0xad, 0xa7, 0x86, 0xec, 0xb7, 0x9e, 0xa4, 0x7d, 0x2a, 0xec, 0x07, 0x69, 0x0e, 0xa2, 0x44,
+	0x88, 0x03, 0x20, 0x4f, 0xb3, 0x4c, 0xe4, 0xf8, 0x96, 0xdf, 0xa1, 0x8f, 0xb9, 0x05, 0x55, 0x26,
+	0x73, 0xef, 0xd3, 0xa9, 0x3a, 0x9f, 0x21, 0x0d, 0x36, 0xb1, 0xec, 0xc1, 0x5b, 0x83, 0x6a, 0xa3,
+	0xe5, 0x0d, 0xb5, 0x18, 0xcd, 0x19, 0xca, 0x3c, 0x9c, 0x8c, 0xfa, 0x34, 0x77, 0x76, 0xd2, 0x2a,
+	0x23, 0x4e, 0x0a, 0xcf, 0xb9, 0x0b, 0x50, 0xf9, 0xbb, 0x0c, 0x9c, 0x4a, 0x97, 0x85, 0x1f, 0x58,
+	0xf6, 0x60, 0xd6, 0xd0, 0x8d, 0x3d, 0x14, 0xfd, 0x42, 0x83, 0x9f, 0x59, 0xde, 0x1c, 0x66, 0xaf,
+	0x11, 0xfc, 0x23, 0xe4, 0x67, 0x28, 0xd1, 0xf0, 0x90, 0xec, 0xc0, 0xbc, 0xa9, 0xfb, 0xfa, 0x8e,
+	0x8e, 0xe3, 0xcc, 0x32, 0xc7, 0x64, 0x76, 0x52, 0xd0, 0x8d, 0xf0, 0x8b, 0x6c, 0xcf, 0xd9, 0xe3,
+	0x6f, 0xcf, 0xca, 0xbf, 0x48, 0xb0, 0x24, 0xd6, 0x92, 0xfb, 0xc0, 0x1d, 0x17, 0x87, 0xdf, 0x47,
+	0xf6, 0x5c, 0xec, 0x6b, 0xba, 0x69, 0x7a, 0x08, 0x63, 0x61, 0x56, 0x32, 0x76, 0x9d, 0x0d, 0xf5,
+	0xcb, 0xbf, 0x71, 0xa7, 0xc8, 0x0e, 0xbb, 0xc1, 0x8e, 0x3d, 0x85, 0xc2, 0xc6, 0x67, 0x19, 0x58,
+	0x4e, 0xd5, 0x8c, 0x3b, 0xc9, 0x0b, 0x30, 0x4d, 0xe5, 0xc4, 0x9a, 0xd3, 0x6e, 0xee, 0xf0, 0xdd,
+	0x25, 0xa7, 0x4e, 0xb1, 0xc1, 0xfb, 0x74, 0x4c, 0x5e, 0x86, 0x82, 0x50, 0x8e, 0xbd, 0xbf, 0xe5,
+	0xd4, 0x3c, 0xd7, 0x8e, 0x34, 0x02, 0x97, 0xba, 0xea, 0x51, 0xdf, 0xe8, 0xfb, 0x1d, 0x4b, 0x00,
+	0x4b, 0x54, 0x08, 0x5e, 0x50, 0xd7, 0x08, 0x1e, 0x35, 0x4a, 0xd1, 0x89, 0x8c, 0xc9, 0xaf, 0xc3,
+	0x02, 0xe3, 0x6d, 0xb8, 0x8e, 0xef, 0xb9, 0xb6, 0x8d, 0x3c, 0xd1, 0x4c, 0x37, 0x46, 0x17, 0x72,
+	0x8e, 0x4e, 0xaf, 0x05, 0xb3, 0xbc, 0x47, 0x8e, 0x24, 0x2b, 0x6e, 0x2e, 0xd6, 0x15, 0x20, 0x7e,
+	0x2a, 0x35, 0x98, 0x59, 0xb3, 0x5d, 0x8c, 0xe8, 0x6e, 0x26, 0x4c, 0x1c, 0xb6, 0x9f, 0x14, 0xb1,
+	0x9f, 0x72, 0x12, 0xe4, 0x30, 0x3c, 0x4f, 0x05, 0xaf, 0x42, 0xe9, 0x36, 0xf2, 0x87, 0xa5, 0xf1,
+	0x09, 0x94, 0xbb, 0xd0, 0x7c, 0xe9, 0x37, 0x00, 0x38, 0x38, 0x71, 0x63, 0x16, 0x96, 0x97, 0x86,
+	0x89, 0x14, 0x4a, 0x86, 0x2e, 0x56, 0x01, 0x8b, 0x3f, 0xc9, 0xc3, 0xcf, 0xb2, 0xb8, 0x7f, 0x51,
+	0x80, 0x3b, 0xba, 0x63, 0xba, 0x8d, 0xc6, 0x60, 0xe1, 0xc8, 0x11, 0x23, 0x68, 0xc3, 0x72, 0x0f,
+	0x1d, 0xe4, 0xf1, 0xad, 0x78, 0x5a, 0x8c, 0xbe, 0x4b, 0x06, 0xe5, 0xfb, 0x20, 0xef, 0x31, 0x9a,
+	0xa1, 0x1e, 0xd1, 0xa1, 0x8f, 0x13, 0x65, 0x8e, 0x1b, 0xf4, 0x83, 0x92, 0xbb, 0x7d, 0xba, 0xc0,
+	0xa2, 0xd7, 0x4f, 0x82, 0x19, 0x56, 0xd3, 0x0d, 0xd7, 0x30, 0xfa, 0xe8, 0x71, 0x0b, 0xf2, 0x86,
+	0xee, 0xa3, 0x5d, 0xb2, 0x0f, 0x64, 0x68, 0xa3, 0xe5, 0xcb, 0xfd, 0xdb, 0x38, 0xd9, 0x6b, 0x0c,
+	0xc3, 0x50, 0x03, 0xdc, 0x70, 0xb3, 0x49, 0x36, 0xd2, 0x6c, 0x52, 0x87, 0xd2, 0x81, 0x85, 0xad,
+	0x1d, 0xcb, 0xa6, 0xcf, 0xd1, 0xa3, 0xf4, 0x41, 0x14, 0xbb, 0x88, 0x54, 0xf9, 0x93, 0x20, 0x87,
+	0x75, 0xe3, 0x2a, 0xff, 0x58, 0x82, 0xd3, 0xb7, 0x91, 0xaf, 0x76, 0xbf, 0xe8, 0xbb, 0xc7, 0xbe,
+	0xe6, 0x0b, 0x8e, 0x83, 0x1b, 0x30, 0x4e, 0xdb, 0xa9, 0x48, 0x12, 0xca, 0xf6, 0x0c, 0xb2, 0xd0,
+	0x27, 0x81, 0xac, 0xa0, 0x16, 0xfc, 0xa4, 0x8d, 0x57, 0x2a, 0xa7, 0x41, 0x52, 0x13, 0x3f, 0x55,
+	0xd2, 0x2e, 0x07, 0x6e, 0xf7, 0x49, 0x3e, 0x46, 0xa2, 0x53, 0xde, 0x00, 0xf9, 0x50, 0xb7, 0x7c,
+	0xad, 0xe1, 0x7a, 0xf4, 0xb3, 0x2d, 0xf6, 0x08, 0x9f, 0x1d, 0xae, 0x2d, 0xb8, 0x44, 0x50, 0x6f,
+	0xb9, 0xde, 0x7d, 0x74, 0xc8, 0xde, 0xd9, 0x7f, 0x90, 0x81, 0x6a, 0x2f, 0x05, 0x79, 0x58, 0xfc,
+	0x1a, 0x14, 0x99, 0x81, 0xf9, 0x87, 0x8c, 0x42, 0xd3, 0x0f, 0x86, 0x6c, 0x32, 0xe8, 0x4f, 0x9e,
+	0x05, 0x8f, 0x18, 0x65, 0x0d, 0x59, 0xd3, 0x38, 0x3c, 0xb6, 0xd4, 0x01, 0x39, 0x09, 0x14, 0x6e,
+	0xce, 0xca, 0xb1, 0xe6, 0xac, 0x7b, 0xd1, 0xe6, 0xac, 0x37, 0x46, 0xb4, 0x44, 0x20, 0x59, 0xb7,
+	0x5f, 0x8b, 0xdc, 0x03, 0x56, 0x6e, 0x23, 0x7f, 0x7d, 0xe3, 0x41, 0x1f, 0x17, 0x78, 0xc8, 0x1b,
+	0xcb, 0x49, 0xda, 0x10, 0x8b, 0x33, 0x2a, 0xf3, 0xe0, 0xfa, 0x5b, 0xf0, 0xf9, 0x5f, 0xc3, 0x38,
+	0x83, 0xf2, 0x5b, 0x12, 0x9c, 0xed, 0x23, 0x1f, 0xb7, 0xe0, 0x27, 0x30, 0x13, 0xe2, 0xcc, 0x3d,
+	0x46, 0x8a, 0x57, 0x01, 0x86, 0x96, 0x53, 0x2d, 0x7b, 0xd1, 0x01, 0xac, 0x7c, 0x4f, 0x82, 0x93,
+	0xb4, 0xd9, 0x4d, 0xec, 0x81, 0x23, 0x1c, 0xc0, 0xde, 0x8d, 0x97, 0x92, 0xbe, 0x3e, 0xb0, 0x94,
+	0x94, 0xc6, 0xaa, 0x5b, 0x3e, 0xda, 0x87, 0xb9, 0x18, 0x00, 0x5f, 0x07, 0x15, 0xf2, 0xb1, 0x46,
+	0x99, 0xd7, 0x47, 0x65, 0xc5, 0xb0, 0xd5, 0x80, 0x8e, 0xf2, 0xfb, 0x12, 0x9c, 0x54, 0x91, 0xde,
+	0x6a, 0xd9, 0xac, 0x36, 0x87, 0x47, 0xd0, 0x7c, 0x2b, 0xae, 0x79, 0x7a, 0x63, 0x69, 0xf8, 0x7b,
+	0x5b, 0x66, 0x8e, 0x24, 0xbb, 0xae, 0xf6, 0x0b, 0x30, 0x17, 0x03, 0xe0, 0x92, 0xfe, 0x45, 0x06,
+	0xe6, 0x98, 0xaf, 0xc4, 0x1d, 0xf8, 0x26, 0x8c, 0x05, 0x8d, 0xc3, 0xc5, 0x70, 0xcd, 0x26, 0x2d,
+	0x47, 0xaf, 0x23, 0xdd, 0xdc, 0x40, 0xbe, 0x8f, 0x3c, 0xda, 0xc0, 0x43, 0x7b, 0xb5, 0x28, 0x7a,
+	0xbf, 0x23, 0x57, 0xf2, 0xd2, 0x9c, 0x4d, 0xbb, 0x34, 0xbf, 0x01, 0x15, 0xcb, 0x21, 0x10, 0xd6,
+	0x01, 0xd2, 0x90, 0x13, 0xa4, 0x9c, 0x6e, 0x9b, 0xe1, 0x5c, 0x30, 0x7f, 0xd3, 0x11, 0x09, 0xa1,
+	0x6e, 0xca, 0x2f, 0xc3, 0x4c, 0x53, 0x3f, 0xb2, 0x9a, 0xed, 0xa6, 0xd6, 0x22, 0xf0, 0xe4, 0x20,
+	0x49, 0x8f, 0x19, 0x39, 0xb5, 0xc4, 0x27, 0x36, 0xf5, 0x5d, 0x44, 0x4e, 0x9a, 0xf2, 0x8b, 0x50,
+	0xa2, 0x1d, 0xc5, 0x14, 0x90, 0xb5, 0xc2, 0x8e, 0xd3, 0x56, 0x58, 0xda, 0x68, 0x4c, 0xc0, 0xd8,
+	0xe7, 0x36, 0xff, 0xc5, 0x3e, 0xbc, 0x8c, 0xac, 0x17, 0x77, 0xa4, 0xa7, 0xb4, 0x60, 0xa9, 0x71,
+	0x99, 0x79, 0x8a, 0x71, 0x99, 0xa6, 0x6b, 0x36, 0x4d, 0xd7, 0x3f, 0xcc, 0xc2, 0xc2, 0x66, 0xdb,
+	0xdb, 0x45, 0x3f, 0x97, 0xde, 0xb1, 0x09, 0xe3, 0x0d, 0xcb, 0x26, 0x74, 0x73, 0x7d, 0xae, 0x3f,
+	0xbd, 0x17, 0x77, 0x7d, 0xe3, 0xc1, 0x2d, 0x8a, 0xaf, 0x72, 0x3a, 0xe4, 0x44, 0x62, 0x7a, 0x1d,
+	0x52, 0x84, 0xa2, 0xbe, 0x93, 0x57, 0xc7, 0x4d, 0xaf, 0xa3, 0xb6, 0x9d, 0x74, 0x47, 0x9c, 0x18,
+	0xda, 0x11, 0xf3, 0x69, 0xc6, 0xd9, 0x85, 0x4a, 0xd2, 0x36, 0xdd, 0xeb, 0x82, 0x58, 0x05, 0xc3,
+	0x6d, 0xf3, 0x2e, 0xd6, 0xac, 0x3a, 0xc5, 0x07, 0xd7, 0xc8, 0x58, 0x1a, 0xa3, 0x4c, 0x2f, 0x2f,
+	0xb8, 0x87, 0x7e, 0x5e, 0xbd, 0xe0, 0x19, 0xe4, 0x88, 0x90, 0x67, 0x4d, 0x3c, 0x7d, 0xcf, 0xca,
+	0x87, 0x3d, 0x8b, 0x78, 0xc1, 0x3d, 0xd4, 0xc3, 0x0b, 0x52, 0xc4, 0x95, 0xd2, 0xc4, 0x4d, 0x78,
+	0x4b, 0x26, 0xe9, 0x2d, 0xca, 0x0f, 0xe8, 0xe7, 0x47, 0x0d, 0x0f, 0xe1, 0xbd, 0x70, 0x0d, 0x7e,
+	0x94, 0x8d, 0xed, 0xc3, 0xf8, 0xc6, 0xf6, 0x4b, 0x43, 0x6e, 0x6c, 0x3d, 0xb9, 0x76, 0xf7, 0x37,
+	0xfa, 0x45, 0x52, 0x1a, 0x1c, 0xdf, 0xe6, 0xfe, 0x44, 0x82, 0x33, 0xef, 0x39, 0x2d, 0xbd, 0x8d,
+	0x8f, 0xf5, 0xc0, 0xf5, 0x31, 0x4c, 0xf4, 0xec, 0x23, 0xec, 0xa3, 0xc2, 0x00, 0xce, 0x5d, 0x35,
+	0x14, 0x58, 0xe9, 0x0d, 0xcb, 0x55, 0xf9, 0xbe, 0x04, 0x2f, 0xdf, 0x46, 0x0e, 0xf2, 0x74, 0x1f,
+	0x6d, 0x90, 0xca, 0x21, 0xaf, 0x8e, 0xc5, 0xb2, 0xfc, 0xf3, 0x28, 0x76, 0x5d, 0x82, 0x57, 0x86,
+	0x92, 0x8c, 0x6b, 0xe2, 0xc2, 0x72, 0xf4, 0x1a, 0x10, 0xad, 0xa9, 0x5f, 0x80, 0x92, 0x87, 0x9a,
+	0xae, 0x1f, 0x84, 0x3e, 0x3b, 0x9e, 0x16, 0xd4, 0x22, 0x1b, 0xe6, 0xb1, 0x8f, 0x09, 0x20, 0x0d,
+	0x6e, 0x13, 0x05, 0xed, 0xe9, 0x19, 0x1a, 0x25, 0x45, 0x3e, 0xcc, 0x5b, 0xcf, 0x95, 0x36, 0x9c,
+	0x4a, 0x67, 0xc8, 0x23, 0xe6, 0x3d, 0x18, 0x67, 0x15, 0x15, 0x7e, 0x0e, 0x7e, 0x7b, 0xc8, 0xcb,
+	0x0c, 0xaf, 0x18, 0xc4, 0xc9, 0x72, 0x62, 0xca, 0xdf, 0xe7, 0x60, 0x3e, 0x1d, 0xa4, 0xdf, 0x3d,
+	0xf9, 0xeb, 0xb0, 0xd0, 0xd4, 0x8f, 0xb4, 0xf8, 0x59, 0xa0, 0xfb, 0xd1, 0xd5, 0xc9, 0xa6, 0x7e,
+	0x14, 0xbf, 0x2c, 0x98, 0xf2, 0x5d, 0x28, 0x33, 0x8a, 0xb6, 0x6b, 0xe8, 0xf6, 0x68, 0xb7, 0x7f,
+	0x76, 0xa5, 0xdb, 0x20, 0x88, 0x64, 0x4a, 0xfe, 0x34, 0x69, 0x01, 0xf6, 0x4c, 0xf8, 0xe0, 0x58,
+	0x0b, 0x53, 0x53, 0x23, 0xf6, 0x63, 0xd7, 0xbb, 0xb8, 0x51, 0x7f, 0x5b, 0x82, 0x59, 0x5a, 0x8c,
+	0x38, 0xe0, 0x37, 0x1d, 0xea, 0xad, 0xa4, 0x4c, 0x34, 0xca, 0x47, 0x3f, 0x3d, 0x04, 0xb8, 0xc3,
+	0x09, 0x07, 0x95, 0x2d, 0x2e, 0x84, 0xbc, 0x97, 0x98, 0x58, 0xfa, 0x9e, 0x04, 0xb3, 0x29, 0x02,
+	0xa7, 0x7c, 0x07, 0xf4, 0x51, 0xf4, 0xaa, 0x79, 0xfb, 0x58, 0x32, 0x6e, 0x22, 0x8f, 0xf3, 0x0b,
+	0x5d, 0x3d, 0x97, 0xbe, 0x2b, 0xc1, 0x42, 0x0f, 0xe1, 0x53, 0x04, 0x52, 0xa3, 0x02, 0xbd, 0x35,
+	0xa4, 0x40, 0x09, 0x06, 0xf4, 0x0e, 0x1a, 0xba, 0x00, 0x7f, 0x00, 0x73, 0xa9, 0x30, 0xf2, 0x3b,
+	0x70, 0x2a, 0xb0, 0x59, 0x9a, 0xe3, 0xb2, 0x73, 0xc8, 0xa2, 0x80, 0x49, 0x78, 0xaf, 0xf2, 0x4f,
+	0x59, 0x58, 0x19, 0xb4, 0x1e, 0xe4, 0xeb, 0x3f, 0xdd, 0xd8, 0x47, 0x66, 0x8c, 0xec, 0x24, 0x1d,
+	0xe4, 0x61, 0xf0, 0x11, 0x2c, 0x85, 0x60, 0xe2, 0xf5, 0xa0, 0x61, 0x3f, 0xc4, 0x59, 0x08, 0x48,
+	0x3e, 0x8c, 0x14, 0x86, 0xe4, 0x43, 0x80, 0x90, 0x4f, 0xb2, 0x67, 0xd0, 0xf7, 0x9f, 0x92, 0xbd,
+	0x6b, 0x71, 0xaf, 0x0c, 0xb1, 0x22, 0x09, 0xc3, 0xb4, 0x1f, 0xb1, 0x63, 0x0a, 0x7f, 0x56, 0x33,
+	0xed, 0x47, 0xf4, 0x78, 0x72, 0x0a, 0x0a, 0xbe, 0xd7, 0x76, 0x0c, 0xdd, 0x47, 0x26, 0xef, 0x53,
+	0xea, 0x0e, 0x2c, 0x7d, 0x0a, 0xa5, 0xc1, 0x0e, 0xf3, 0x20, 0xea, 0x30, 0xdf, 0x1c, 0xe6, 0xe0,
+	0x12, 0x50, 0x0d, 0xa9, 0xb4, 0xa1, 0xef, 0x86, 0xfd, 0xe5, 0x77, 0x24, 0x58, 0x52, 0xd1, 0x4e,
+	0xdb, 0xb2, 0xcd, 0xe7, 0xfd, 0x1e, 0x73, 0x1a, 0x96, 0x53, 0x25, 0x61, 0x3b, 0xc0, 0x8d, 0xd6,
+	0xe7, 0x5f, 0x54, 0x4f, 0xfc, 0xe8, 0x8b, 0xea, 0x89, 0x9f, 0x7c, 0x51, 0x95, 0x7e, 0xfd, 0x71,
+	0x55, 0xfa, 0xb3, 0xc7, 0x55, 0xe9, 0x6f, 0x1f, 0x57, 0xa5, 0xcf, 0x1f, 0x57, 0xa5, 0x7f, 0x7f,
+	0x5c, 0x95, 0xfe, 0xf3, 0x71, 0xf5, 0xc4, 0x4f, 0x1e, 0x57, 0xa5, 0xcf, 0xbe, 0xac, 0x9e, 0xf8,
+	0xfc, 0xcb, 0xea, 0x89, 0x1f, 0x7d, 0x59, 0x3d, 0xf1, 0xe1, 0xb5, 0x5d, 0xb7, 0x2b, 0x8c, 0xe5,
+	0xf6, 0xfd, 0xef, 0x76, 0xdf, 0x8c, 0x8e, 0xec, 0x8c, 0x53, 0xe7, 0xbb, 0xfa, 0x7f, 0x03, 0x00,
+	0x93, 0x6a, 0xfd, 0x24, 0x1c, 0x4f, 0x00, 0x00,
 }
 
 func (this *StartWorkflowExecutionRequest) Equal(that interface{}) bool {
@@ -6584,6 +6945,17 @@ func (this *DescribeWorkflowExecutionResponse) Equal(that interface{}) bool {
 	if !this.PendingWorkflowTask.Equal(that1.PendingWorkflowTask) {
 		return false
 	}
+	if !this.SizeInfo.Equal(that1.SizeInfo) {
+		return false
+	}
+	if len(this.ConflictResolutionRecords) != len(that1.ConflictResolutionRecords) {
+		return false
+	}
+	for i := range this.ConflictResolutionRecords {
+		if !this.ConflictResolutionRecords[i].Equal(that1.ConflictResolutionRecords[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ReplicateEventsV2Request) Equal(that interface{}) bool {
@@ -6851,6 +7223,9 @@ func (this *DescribeMutableStateResponse) Equal(that interface{}) bool {
 	if !this.DatabaseMutableState.Equal(that1.DatabaseMutableState) {
 		return false
 	}
+	if !this.SizeInfo.Equal(that1.SizeInfo) {
+		return false
+	}
 	return true
 }
 func (this *DescribeHistoryHostRequest) Equal(that interface{}) bool {
@@ -7020,6 +7395,61 @@ func (this *GetShardResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *CompleteShardHandoffRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CompleteShardHandoffRequest)
+	if !ok {
+		that2, ok := that.(CompleteShardHandoffRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.PreviousOwner != that1.PreviousOwner {
+		return false
+	}
+	if that1.HandoffStartTime == nil {
+		if this.HandoffStartTime != nil {
+			return false
+		}
+	} else if !this.HandoffStartTime.Equal(*that1.HandoffStartTime) {
+		return false
+	}
+	return true
+}
+func (this *CompleteShardHandoffResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CompleteShardHandoffResponse)
+	if !ok {
+		that2, ok := that.(CompleteShardHandoffResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 func (this *RemoveTaskRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -7108,6 +7538,15 @@ func (this *GetReplicationMessagesRequest) Equal(that interface{}) bool {
 	if this.ClusterName != that1.ClusterName {
 		return false
 	}
+	if this.WaitForNewTasks != nil && that1.WaitForNewTasks != nil {
+		if *this.WaitForNewTasks != *that1.WaitForNewTasks {
+			return false
+		}
+	} else if this.WaitForNewTasks != nil {
+		return false
+	} else if that1.WaitForNewTasks != nil {
+		return false
+	}
 	return true
 }
 func (this *GetReplicationMessagesResponse) Equal(that interface{}) bool {
@@ -7166,6 +7605,9 @@ func (this *GetDLQReplicationMessagesRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.ClusterName != that1.ClusterName {
+		return false
+	}
 	return true
 }
 func (this *GetDLQReplicationMessagesResponse) Equal(that interface{}) bool {
@@ -7401,6 +7843,18 @@ func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
 	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
 		return false
 	}
+	if !this.Filter.Equal(that1.Filter) {
+		return false
+	}
+	if this.DryRun != that1.DryRun {
+		return false
+	}
+	if this.MaximumPageSize != that1.MaximumPageSize {
+		return false
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
 	return true
 }
 func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
@@ -7422,6 +7876,12 @@ func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.MessageCount != that1.MessageCount {
+		return false
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
 	return true
 }
 func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
@@ -7461,6 +7921,12 @@ func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
 	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
 		return false
 	}
+	if !this.Filter.Equal(that1.Filter) {
+		return false
+	}
+	if this.DryRun != that1.DryRun {
+		return false
+	}
 	return true
 }
 func (this *MergeDLQMessagesResponse) Equal(that interface{}) bool {
@@ -7485,6 +7951,9 @@ func (this *MergeDLQMessagesResponse) Equal(that interface{}) bool {
 	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
 		return false
 	}
+	if this.MessageCount != that1.MessageCount {
+		return false
+	}
 	return true
 }
 func (this *RefreshWorkflowTasksRequest) Equal(that interface{}) bool {
@@ -7535,6 +8004,54 @@ func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *UnpauseWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnpauseWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(UnpauseWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.Request.Equal(that1.Request) {
+		return false
+	}
+	return true
+}
+func (this *UnpauseWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnpauseWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(UnpauseWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 func (this *GenerateLastHistoryReplicationTasksRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -7610,6 +8127,9 @@ func (this *GetReplicationStatusRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.IncludeDetails != that1.IncludeDetails {
+		return false
+	}
 	return true
 }
 func (this *GetReplicationStatusResponse) Equal(that interface{}) bool {
@@ -7744,6 +8264,20 @@ func (this *ShardReplicationStatusPerCluster) Equal(that interface{}) bool {
 	} else if !this.AckedTaskVisibilityTime.Equal(*that1.AckedTaskVisibilityTime) {
 		return false
 	}
+	if len(this.Namespaces) != len(that1.Namespaces) {
+		return false
+	}
+	for i := range this.Namespaces {
+		if !this.Namespaces[i].Equal(that1.Namespaces[i]) {
+			return false
+		}
+	}
+	if this.DlqSize != that1.DlqSize {
+		return false
+	}
+	if this.Truncated != that1.Truncated {
+		return false
+	}
 	return true
 }
 func (this *RebuildMutableStateRequest) Equal(that interface{}) bool {
@@ -8425,7 +8959,7 @@ func (this *DescribeWorkflowExecutionResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 11)
 	s = append(s, "&historyservice.DescribeWorkflowExecutionResponse{")
 	if this.ExecutionConfig != nil {
 		s = append(s, "ExecutionConfig: "+fmt.Sprintf("%#v", this.ExecutionConfig)+",\n")
@@ -8442,6 +8976,12 @@ func (this *DescribeWorkflowExecutionResponse) GoString() string {
 	if this.PendingWorkflowTask != nil {
 		s = append(s, "PendingWorkflowTask: "+fmt.Sprintf("%#v", this.PendingWorkflowTask)+",\n")
 	}
+	if this.SizeInfo != nil {
+		s = append(s, "SizeInfo: "+fmt.Sprintf("%#v", this.SizeInfo)+",\n")
+	}
+	if this.ConflictResolutionRecords != nil {
+		s = append(s, "ConflictResolutionRecords: "+fmt.Sprintf("%#v", this.ConflictResolutionRecords)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -8552,7 +9092,7 @@ func (this *DescribeMutableStateResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&historyservice.DescribeMutableStateResponse{")
 	if this.CacheMutableState != nil {
 		s = append(s, "CacheMutableState: "+fmt.Sprintf("%#v", this.CacheMutableState)+",\n")
@@ -8560,6 +9100,9 @@ func (this *DescribeMutableStateResponse) GoString() string {
 	if this.DatabaseMutableState != nil {
 		s = append(s, "DatabaseMutableState: "+fmt.Sprintf("%#v", this.DatabaseMutableState)+",\n")
 	}
+	if this.SizeInfo != nil {
+		s = append(s, "SizeInfo: "+fmt.Sprintf("%#v", this.SizeInfo)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -8635,6 +9178,27 @@ func (this *GetShardResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *CompleteShardHandoffRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&historyservice.CompleteShardHandoffRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "PreviousOwner: "+fmt.Sprintf("%#v", this.PreviousOwner)+",\n")
+	s = append(s, "HandoffStartTime: "+fmt.Sprintf("%#v", this.HandoffStartTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CompleteShardHandoffResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.CompleteShardHandoffResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *RemoveTaskRequest) GoString() string {
 	if this == nil {
 		return "nil"
@@ -8661,12 +9225,13 @@ func (this *GetReplicationMessagesRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&historyservice.GetReplicationMessagesRequest{")
 	if this.Tokens != nil {
 		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
 	}
 	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
+	s = append(s, "WaitForNewTasks: "+fmt.Sprintf("%#v", this.WaitForNewTasks)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -8696,11 +9261,12 @@ func (this *GetDLQReplicationMessagesRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 6)
 	s = append(s, "&historyservice.GetDLQReplicationMessagesRequest{")
 	if this.TaskInfos != nil {
 		s = append(s, "TaskInfos: "+fmt.Sprintf("%#v", this.TaskInfos)+",\n")
 	}
+	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -8796,12 +9362,18 @@ func (this *PurgeDLQMessagesRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 12)
 	s = append(s, "&historyservice.PurgeDLQMessagesRequest{")
 	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
 	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
 	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
 	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
+	if this.Filter != nil {
+		s = append(s, "Filter: "+fmt.Sprintf("%#v", this.Filter)+",\n")
+	}
+	s = append(s, "DryRun: "+fmt.Sprintf("%#v", this.DryRun)+",\n")
+	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -8809,8 +9381,10 @@ func (this *PurgeDLQMessagesResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 4)
+	s := make([]string, 0, 6)
 	s = append(s, "&historyservice.PurgeDLQMessagesResponse{")
+	s = append(s, "MessageCount: "+fmt.Sprintf("%#v", this.MessageCount)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -8818,7 +9392,7 @@ func (this *MergeDLQMessagesRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 10)
+	s := make([]string, 0, 12)
 	s = append(s, "&historyservice.MergeDLQMessagesRequest{")
 	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
 	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
@@ -8826,6 +9400,10 @@ func (this *MergeDLQMessagesRequest) GoString() string {
 	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
 	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
 	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	if this.Filter != nil {
+		s = append(s, "Filter: "+fmt.Sprintf("%#v", this.Filter)+",\n")
+	}
+	s = append(s, "DryRun: "+fmt.Sprintf("%#v", this.DryRun)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -8833,9 +9411,10 @@ func (this *MergeDLQMessagesResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 6)
 	s = append(s, "&historyservice.MergeDLQMessagesResponse{")
 	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "MessageCount: "+fmt.Sprintf("%#v", this.MessageCount)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -8861,6 +9440,28 @@ func (this *RefreshWorkflowTasksResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *UnpauseWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.UnpauseWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UnpauseWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.UnpauseWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *GenerateLastHistoryReplicationTasksRequest) GoString() string {
 	if this == nil {
 		return "nil"
@@ -8887,9 +9488,10 @@ func (this *GetReplicationStatusRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 6)
 	s = append(s, "&historyservice.GetReplicationStatusRequest{")
 	s = append(s, "RemoteClusters: "+fmt.Sprintf("%#v", this.RemoteClusters)+",\n")
+	s = append(s, "IncludeDetails: "+fmt.Sprintf("%#v", this.IncludeDetails)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -8957,10 +9559,25 @@ func (this *ShardReplicationStatusPerCluster) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 9)
 	s = append(s, "&historyservice.ShardReplicationStatusPerCluster{")
 	s = append(s, "AckedTaskId: "+fmt.Sprintf("%#v", this.AckedTaskId)+",\n")
 	s = append(s, "AckedTaskVisibilityTime: "+fmt.Sprintf("%#v", this.AckedTaskVisibilityTime)+",\n")
+	keysForNamespaces := make([]string, 0, len(this.Namespaces))
+	for k, _ := range this.Namespaces {
+		keysForNamespaces = append(keysForNamespaces, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNamespaces)
+	mapStringForNamespaces := "map[string]*v113.NamespaceReplicationLag{"
+	for _, k := range keysForNamespaces {
+		mapStringForNamespaces += fmt.Sprintf("%#v: %#v,", k, this.Namespaces[k])
+	}
+	mapStringForNamespaces += "}"
+	if this.Namespaces != nil {
+		s = append(s, "Namespaces: "+mapStringForNamespaces+",\n")
+	}
+	s = append(s, "DlqSize: "+fmt.Sprintf("%#v", this.DlqSize)+",\n")
+	s = append(s, "Truncated: "+fmt.Sprintf("%#v", this.Truncated)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -11171,6 +11788,32 @@ func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConflictResolutionRecords) > 0 {
+		for iNdEx := len(m.ConflictResolutionRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConflictResolutionRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.SizeInfo != nil {
+		{
+			size, err := m.SizeInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.PendingWorkflowTask != nil {
 		{
 			size, err := m.PendingWorkflowTask.MarshalToSizedBuffer(dAtA[:i])
@@ -11362,12 +12005,12 @@ func (m *SyncShardStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if m.StatusTime != nil {
-		n65, err65 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
-		if err65 != nil {
-			return 0, err65
+		n66, err66 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
+		if err66 != nil {
+			return 0, err66
 		}
-		i -= n65
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n65))
+		i -= n66
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n66))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -11478,22 +12121,22 @@ func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.LastHeartbeatTime != nil {
-		n69, err69 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
-		if err69 != nil {
-			return 0, err69
+		n70, err70 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
+		if err70 != nil {
+			return 0, err70
 		}
-		i -= n69
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n69))
+		i -= n70
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n70))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.StartedTime != nil {
-		n70, err70 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err70 != nil {
-			return 0, err70
+		n71, err71 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err71 != nil {
+			return 0, err71
 		}
-		i -= n70
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n70))
+		i -= n71
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n71))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -11503,12 +12146,12 @@ func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x38
 	}
 	if m.ScheduledTime != nil {
-		n71, err71 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err71 != nil {
-			return 0, err71
+		n72, err72 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err72 != nil {
+			return 0, err72
 		}
-		i -= n71
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n71))
+		i -= n72
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n72))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -11631,6 +12274,18 @@ func (m *DescribeMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.SizeInfo != nil {
+		{
+			size, err := m.SizeInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.DatabaseMutableState != nil {
 		{
 			size, err := m.DatabaseMutableState.MarshalToSizedBuffer(dAtA[:i])
@@ -11759,21 +12414,21 @@ func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		dAtA[i] = 0x1a
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA78 := make([]byte, len(m.ShardIds)*10)
-		var j77 int
+		dAtA80 := make([]byte, len(m.ShardIds)*10)
+		var j79 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA78[j77] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA80[j79] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j77++
+				j79++
 			}
-			dAtA78[j77] = uint8(num)
-			j77++
+			dAtA80[j79] = uint8(num)
+			j79++
 		}
-		i -= j77
-		copy(dAtA[i:], dAtA78[:j77])
-		i = encodeVarintRequestResponse(dAtA, i, uint64(j77))
+		i -= j79
+		copy(dAtA[i:], dAtA80[:j79])
+		i = encodeVarintRequestResponse(dAtA, i, uint64(j79))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -11899,6 +12554,74 @@ func (m *GetShardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CompleteShardHandoffRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompleteShardHandoffRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompleteShardHandoffRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HandoffStartTime != nil {
+		n82, err82 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.HandoffStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.HandoffStartTime):])
+		if err82 != nil {
+			return 0, err82
+		}
+		i -= n82
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n82))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PreviousOwner) > 0 {
+		i -= len(m.PreviousOwner)
+		copy(dAtA[i:], m.PreviousOwner)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.PreviousOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompleteShardHandoffResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompleteShardHandoffResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompleteShardHandoffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *RemoveTaskRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11920,12 +12643,12 @@ func (m *RemoveTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VisibilityTime != nil {
-		n80, err80 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err80 != nil {
-			return 0, err80
+		n83, err83 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err83 != nil {
+			return 0, err83
 		}
-		i -= n80
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n80))
+		i -= n83
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n83))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -11990,6 +12713,16 @@ func (m *GetReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.WaitForNewTasks != nil {
+		n84, err84 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WaitForNewTasks, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WaitForNewTasks):])
+		if err84 != nil {
+			return 0, err84
+		}
+		i -= n84
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n84))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.ClusterName) > 0 {
 		i -= len(m.ClusterName)
 		copy(dAtA[i:], m.ClusterName)
@@ -12081,6 +12814,13 @@ func (m *GetDLQReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.TaskInfos) > 0 {
 		for iNdEx := len(m.TaskInfos) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -12403,6 +13143,40 @@ func (m *PurgeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MaximumPageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.InclusiveEndMessageId != 0 {
 		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
 		i--
@@ -12448,6 +13222,18 @@ func (m *PurgeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MessageCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MessageCount))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -12471,6 +13257,28 @@ func (m *MergeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.NextPageToken) > 0 {
 		i -= len(m.NextPageToken)
 		copy(dAtA[i:], m.NextPageToken)
@@ -12528,6 +13336,11 @@ func (m *MergeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.MessageCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MessageCount))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.NextPageToken) > 0 {
 		i -= len(m.NextPageToken)
 		copy(dAtA[i:], m.NextPageToken)
@@ -12603,6 +13416,71 @@ func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *UnpauseWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpauseWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *GenerateLastHistoryReplicationTasksRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12688,6 +13566,16 @@ func (m *GetReplicationStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.IncludeDetails {
+		i--
+		if m.IncludeDetails {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.RemoteClusters) > 0 {
 		for iNdEx := len(m.RemoteClusters) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.RemoteClusters[iNdEx])
@@ -12810,12 +13698,12 @@ func (m *ShardReplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		}
 	}
 	if m.ShardLocalTime != nil {
-		n89, err89 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ShardLocalTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ShardLocalTime):])
-		if err89 != nil {
-			return 0, err89
+		n96, err96 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ShardLocalTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ShardLocalTime):])
+		if err96 != nil {
+			return 0, err96
 		}
-		i -= n89
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n89))
+		i -= n96
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n96))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -12880,13 +13768,54 @@ func (m *ShardReplicationStatusPerCluster) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DlqSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.DlqSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Namespaces) > 0 {
+		for k := range m.Namespaces {
+			v := m.Namespaces[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.AckedTaskVisibilityTime != nil {
-		n90, err90 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AckedTaskVisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AckedTaskVisibilityTime):])
-		if err90 != nil {
-			return 0, err90
+		n98, err98 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AckedTaskVisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AckedTaskVisibilityTime):])
+		if err98 != nil {
+			return 0, err98
 		}
-		i -= n90
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n90))
+		i -= n98
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n98))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -13879,6 +14808,16 @@ func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
 		l = m.PendingWorkflowTask.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.SizeInfo != nil {
+		l = m.SizeInfo.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.ConflictResolutionRecords) > 0 {
+		for _, e := range m.ConflictResolutionRecords {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -14052,6 +14991,10 @@ func (m *DescribeMutableStateResponse) Size() (n int) {
 		l = m.DatabaseMutableState.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.SizeInfo != nil {
+		l = m.SizeInfo.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -14156,7 +15099,7 @@ func (m *GetShardResponse) Size() (n int) {
 	return n
 }
 
-func (m *RemoveTaskRequest) Size() (n int) {
+func (m *CompleteShardHandoffRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -14165,20 +15108,18 @@ func (m *RemoveTaskRequest) Size() (n int) {
 	if m.ShardId != 0 {
 		n += 1 + sovRequestResponse(uint64(m.ShardId))
 	}
-	if m.Category != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Category))
-	}
-	if m.TaskId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.TaskId))
+	l = len(m.PreviousOwner)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.VisibilityTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime)
+	if m.HandoffStartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.HandoffStartTime)
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *RemoveTaskResponse) Size() (n int) {
+func (m *CompleteShardHandoffResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -14187,13 +15128,44 @@ func (m *RemoveTaskResponse) Size() (n int) {
 	return n
 }
 
-func (m *GetReplicationMessagesRequest) Size() (n int) {
+func (m *RemoveTaskRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Tokens) > 0 {
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	if m.Category != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Category))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskId))
+	}
+	if m.VisibilityTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetReplicationMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
 		for _, e := range m.Tokens {
 			l = e.Size()
 			n += 1 + l + sovRequestResponse(uint64(l))
@@ -14203,6 +15175,10 @@ func (m *GetReplicationMessagesRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.WaitForNewTasks != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WaitForNewTasks)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -14240,6 +15216,10 @@ func (m *GetDLQReplicationMessagesRequest) Size() (n int) {
 			n += 1 + l + sovRequestResponse(uint64(l))
 		}
 	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -14384,6 +15364,20 @@ func (m *PurgeDLQMessagesRequest) Size() (n int) {
 	if m.InclusiveEndMessageId != 0 {
 		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
 	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if m.MaximumPageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -14393,6 +15387,13 @@ func (m *PurgeDLQMessagesResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MessageCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MessageCount))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -14422,6 +15423,13 @@ func (m *MergeDLQMessagesRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.DryRun {
+		n += 2
+	}
 	return n
 }
 
@@ -14435,6 +15443,9 @@ func (m *MergeDLQMessagesResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.MessageCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MessageCount))
+	}
 	return n
 }
 
@@ -14464,6 +15475,32 @@ func (m *RefreshWorkflowTasksResponse) Size() (n int) {
 	return n
 }
 
+func (m *UnpauseWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UnpauseWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *GenerateLastHistoryReplicationTasksRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -14502,6 +15539,9 @@ func (m *GetReplicationStatusRequest) Size() (n int) {
 			n += 1 + l + sovRequestResponse(uint64(l))
 		}
 	}
+	if m.IncludeDetails {
+		n += 2
+	}
 	return n
 }
 
@@ -14590,6 +15630,25 @@ func (m *ShardReplicationStatusPerCluster) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.AckedTaskVisibilityTime)
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if len(m.Namespaces) > 0 {
+		for k, v := range m.Namespaces {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRequestResponse(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	if m.DlqSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.DlqSize))
+	}
+	if m.Truncated {
+		n += 2
+	}
 	return n
 }
 
@@ -15169,12 +16228,19 @@ func (this *DescribeWorkflowExecutionResponse) String() string {
 		repeatedStringForPendingChildren += strings.Replace(fmt.Sprintf("%v", f), "PendingChildExecutionInfo", "v110.PendingChildExecutionInfo", 1) + ","
 	}
 	repeatedStringForPendingChildren += "}"
+	repeatedStringForConflictResolutionRecords := "[]*ConflictResolutionRecord{"
+	for _, f := range this.ConflictResolutionRecords {
+		repeatedStringForConflictResolutionRecords += strings.Replace(fmt.Sprintf("%v", f), "ConflictResolutionRecord", "v111.ConflictResolutionRecord", 1) + ","
+	}
+	repeatedStringForConflictResolutionRecords += "}"
 	s := strings.Join([]string{`&DescribeWorkflowExecutionResponse{`,
 		`ExecutionConfig:` + strings.Replace(fmt.Sprintf("%v", this.ExecutionConfig), "WorkflowExecutionConfig", "v110.WorkflowExecutionConfig", 1) + `,`,
 		`WorkflowExecutionInfo:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionInfo), "WorkflowExecutionInfo", "v110.WorkflowExecutionInfo", 1) + `,`,
 		`PendingActivities:` + repeatedStringForPendingActivities + `,`,
 		`PendingChildren:` + repeatedStringForPendingChildren + `,`,
 		`PendingWorkflowTask:` + strings.Replace(fmt.Sprintf("%v", this.PendingWorkflowTask), "PendingWorkflowTaskInfo", "v110.PendingWorkflowTaskInfo", 1) + `,`,
+		`SizeInfo:` + strings.Replace(fmt.Sprintf("%v", this.SizeInfo), "ExecutionSizeInfo", "v11.ExecutionSizeInfo", 1) + `,`,
+		`ConflictResolutionRecords:` + repeatedStringForConflictResolutionRecords + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15278,6 +16344,7 @@ func (this *DescribeMutableStateResponse) String() string {
 	s := strings.Join([]string{`&DescribeMutableStateResponse{`,
 		`CacheMutableState:` + strings.Replace(fmt.Sprintf("%v", this.CacheMutableState), "WorkflowMutableState", "v111.WorkflowMutableState", 1) + `,`,
 		`DatabaseMutableState:` + strings.Replace(fmt.Sprintf("%v", this.DatabaseMutableState), "WorkflowMutableState", "v111.WorkflowMutableState", 1) + `,`,
+		`SizeInfo:` + strings.Replace(fmt.Sprintf("%v", this.SizeInfo), "ExecutionSizeInfo", "v11.ExecutionSizeInfo", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15348,6 +16415,27 @@ func (this *GetShardResponse) String() string {
 	}, "")
 	return s
 }
+func (this *CompleteShardHandoffRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CompleteShardHandoffRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`PreviousOwner:` + fmt.Sprintf("%v", this.PreviousOwner) + `,`,
+		`HandoffStartTime:` + strings.Replace(fmt.Sprintf("%v", this.HandoffStartTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CompleteShardHandoffResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CompleteShardHandoffResponse{`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RemoveTaskRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -15382,6 +16470,7 @@ func (this *GetReplicationMessagesRequest) String() string {
 	s := strings.Join([]string{`&GetReplicationMessagesRequest{`,
 		`Tokens:` + repeatedStringForTokens + `,`,
 		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`WaitForNewTasks:` + strings.Replace(fmt.Sprintf("%v", this.WaitForNewTasks), "Duration", "types.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15417,6 +16506,7 @@ func (this *GetDLQReplicationMessagesRequest) String() string {
 	repeatedStringForTaskInfos += "}"
 	s := strings.Join([]string{`&GetDLQReplicationMessagesRequest{`,
 		`TaskInfos:` + repeatedStringForTaskInfos + `,`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15518,6 +16608,10 @@ func (this *PurgeDLQMessagesRequest) String() string {
 		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
 		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
 		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
+		`Filter:` + strings.Replace(fmt.Sprintf("%v", this.Filter), "ReplicationDLQFilter", "v113.ReplicationDLQFilter", 1) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15527,6 +16621,8 @@ func (this *PurgeDLQMessagesResponse) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&PurgeDLQMessagesResponse{`,
+		`MessageCount:` + fmt.Sprintf("%v", this.MessageCount) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15542,6 +16638,8 @@ func (this *MergeDLQMessagesRequest) String() string {
 		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
 		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
 		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`Filter:` + strings.Replace(fmt.Sprintf("%v", this.Filter), "ReplicationDLQFilter", "v113.ReplicationDLQFilter", 1) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15552,6 +16650,7 @@ func (this *MergeDLQMessagesResponse) String() string {
 	}
 	s := strings.Join([]string{`&MergeDLQMessagesResponse{`,
 		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`MessageCount:` + fmt.Sprintf("%v", this.MessageCount) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15576,6 +16675,26 @@ func (this *RefreshWorkflowTasksResponse) String() string {
 	}, "")
 	return s
 }
+func (this *UnpauseWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnpauseWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "UnpauseWorkflowExecutionRequest", "v114.UnpauseWorkflowExecutionRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnpauseWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnpauseWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GenerateLastHistoryReplicationTasksRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -15602,6 +16721,7 @@ func (this *GetReplicationStatusRequest) String() string {
 	}
 	s := strings.Join([]string{`&GetReplicationStatusRequest{`,
 		`RemoteClusters:` + fmt.Sprintf("%v", this.RemoteClusters) + `,`,
+		`IncludeDetails:` + fmt.Sprintf("%v", this.IncludeDetails) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15669,9 +16789,22 @@ func (this *ShardReplicationStatusPerCluster) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForNamespaces := make([]string, 0, len(this.Namespaces))
+	for k, _ := range this.Namespaces {
+		keysForNamespaces = append(keysForNamespaces, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNamespaces)
+	mapStringForNamespaces := "map[string]*v113.NamespaceReplicationLag{"
+	for _, k := range keysForNamespaces {
+		mapStringForNamespaces += fmt.Sprintf("%v: %v,", k, this.Namespaces[k])
+	}
+	mapStringForNamespaces += "}"
 	s := strings.Join([]string{`&ShardReplicationStatusPerCluster{`,
 		`AckedTaskId:` + fmt.Sprintf("%v", this.AckedTaskId) + `,`,
 		`AckedTaskVisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.AckedTaskVisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Namespaces:` + mapStringForNamespaces + `,`,
+		`DlqSize:` + fmt.Sprintf("%v", this.DlqSize) + `,`,
+		`Truncated:` + fmt.Sprintf("%v", this.Truncated) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22173,6 +23306,76 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SizeInfo == nil {
+				m.SizeInfo = &v11.ExecutionSizeInfo{}
+			}
+			if err := m.SizeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictResolutionRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConflictResolutionRecords = append(m.ConflictResolutionRecords, &v111.ConflictResolutionRecord{})
+			if err := m.ConflictResolutionRecords[len(m.ConflictResolutionRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -23418,6 +24621,42 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SizeInfo == nil {
+				m.SizeInfo = &v11.ExecutionSizeInfo{}
+			}
+			if err := m.SizeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -24148,7 +25387,7 @@ func (m *GetShardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *CompleteShardHandoffRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24171,10 +25410,10 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompleteShardHandoffRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompleteShardHandoffRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -24197,10 +25436,10 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOwner", wireType)
 			}
-			m.Category = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -24210,14 +25449,207 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Category |= v16.TaskCategory(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandoffStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HandoffStartTime == nil {
+				m.HandoffStartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.HandoffStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompleteShardHandoffResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompleteShardHandoffResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompleteShardHandoffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			m.Category = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Category |= v16.TaskCategory(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
 			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
@@ -24442,6 +25874,42 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ClusterName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitForNewTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WaitForNewTasks == nil {
+				m.WaitForNewTasks = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.WaitForNewTasks, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -24697,6 +26165,38 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -25645,23 +27145,132 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			iNdEx += skippy
-		}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &v113.ReplicationDLQFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+			}
+			m.MaximumPageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
 
 	if iNdEx > l {
@@ -25698,6 +27307,59 @@ func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: PurgeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageCount", wireType)
+			}
+			m.MessageCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessageCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -25859,11 +27521,292 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &v113.ReplicationDLQFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageCount", wireType)
+			}
+			m.MessageCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessageCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -25873,24 +27816,26 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
+			if m.Request == nil {
+				m.Request = &v114.RefreshWorkflowTasksRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -25917,7 +27862,7 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25940,46 +27885,12 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -26004,7 +27915,7 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *UnpauseWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26027,10 +27938,10 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -26095,7 +28006,7 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v114.RefreshWorkflowTasksRequest{}
+				m.Request = &v114.UnpauseWorkflowExecutionRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -26125,7 +28036,7 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
+func (m *UnpauseWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26148,10 +28059,10 @@ func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -26413,6 +28324,26 @@ func (m *GetReplicationStatusRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.RemoteClusters = append(m.RemoteClusters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeDetails", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeDetails = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -27065,6 +28996,174 @@ func (m *ShardReplicationStatusPerCluster) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Namespaces == nil {
+				m.Namespaces = make(map[string]*v113.NamespaceReplicationLag)
+			}
+			var mapkey string
+			var mapvalue *v113.NamespaceReplicationLag
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v113.NamespaceReplicationLag{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Namespaces[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DlqSize", wireType)
+			}
+			m.DlqSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DlqSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
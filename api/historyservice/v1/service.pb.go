@@ -54,79 +54,81 @@ func init() {
 }
 
 var fileDescriptor_655983da427ae822 = []byte{
-	// 1142 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcd, 0x8b, 0x23, 0xc5,
-	0x1b, 0xc7, 0x53, 0x97, 0x1f, 0x3f, 0x0a, 0x5d, 0xb5, 0x15, 0x5f, 0x46, 0x6d, 0x44, 0xf1, 0x9a,
-	0xb0, 0xbb, 0xa8, 0xfb, 0x32, 0xeb, 0x3a, 0xc9, 0xcc, 0x64, 0x66, 0x77, 0x46, 0x9d, 0x64, 0x51,
-	0xf0, 0x22, 0x95, 0xce, 0xb3, 0x93, 0x66, 0x7a, 0xd2, 0x6d, 0x55, 0x75, 0x34, 0x07, 0x41, 0xf0,
-	0x24, 0x08, 0x8a, 0x20, 0x78, 0x12, 0x3c, 0x29, 0x82, 0x20, 0x08, 0x82, 0x20, 0x78, 0x12, 0x3c,
-	0x2d, 0x73, 0xdc, 0xa3, 0x93, 0xb9, 0x78, 0xdc, 0x3f, 0x41, 0x92, 0x4e, 0xd5, 0xa4, 0xba, 0xab,
-	0x63, 0x55, 0x75, 0x6e, 0xbb, 0x99, 0xfe, 0x7e, 0xfa, 0xd3, 0x55, 0x4f, 0x57, 0x3d, 0xa9, 0xe0,
-	0xcb, 0x1c, 0x8e, 0x93, 0x98, 0x92, 0xa8, 0xc1, 0x80, 0x8e, 0x80, 0x36, 0x48, 0x12, 0x36, 0x06,
-	0x21, 0xe3, 0x31, 0x1d, 0x4f, 0x3f, 0x09, 0x03, 0x68, 0x8c, 0x2e, 0x36, 0xe6, 0xff, 0xac, 0x27,
-	0x34, 0xe6, 0xb1, 0xf7, 0xb2, 0x08, 0xd5, 0xb3, 0x50, 0x9d, 0x24, 0x61, 0x5d, 0x0d, 0xd5, 0x47,
-	0x17, 0xd7, 0xd6, 0xcd, 0xd8, 0x14, 0x3e, 0x48, 0x81, 0xf1, 0xf7, 0x29, 0xb0, 0x24, 0x1e, 0xb2,
-	0xf9, 0x4d, 0x2e, 0xdd, 0x7b, 0x05, 0x5f, 0xd8, 0xc9, 0x2e, 0xee, 0x66, 0x17, 0x7b, 0xdf, 0x23,
-	0xfc, 0x64, 0x97, 0x13, 0xca, 0xdf, 0x8d, 0xe9, 0xd1, 0xdd, 0x28, 0xfe, 0x70, 0xeb, 0x23, 0x08,
-	0x52, 0x1e, 0xc6, 0x43, 0x6f, 0xb3, 0x6e, 0xe4, 0x54, 0xd7, 0xc7, 0x3b, 0x99, 0xc2, 0xda, 0x56,
-	0x45, 0x4a, 0xf6, 0x00, 0x2f, 0xd6, 0xbc, 0xaf, 0x10, 0x7e, 0xa4, 0x0d, 0x7c, 0x3f, 0xe5, 0xa4,
-	0x17, 0x41, 0x97, 0x13, 0x0e, 0xde, 0x0d, 0x43, 0x78, 0x2e, 0x27, 0xdc, 0x5e, 0x77, 0x8d, 0x4b,
-	0xa9, 0xaf, 0x11, 0x7e, 0xf4, 0xed, 0x38, 0x8a, 0x14, 0x2b, 0x53, 0x6c, 0x3e, 0x28, 0xb4, 0x6e,
-	0x3a, 0xe7, 0xa5, 0xd7, 0x77, 0x08, 0x3f, 0xd1, 0x01, 0x06, 0xbc, 0xcb, 0xc3, 0xe0, 0x68, 0x7c,
-	0x87, 0xb0, 0xa3, 0x83, 0x14, 0x52, 0xf0, 0x9a, 0x86, 0x6c, 0x5d, 0x58, 0xf8, 0xb5, 0x2a, 0x31,
-	0xa4, 0xe3, 0xcf, 0x08, 0x3f, 0xd3, 0x81, 0x20, 0xa6, 0x7d, 0x31, 0xed, 0xd3, 0xab, 0x66, 0x75,
-	0x00, 0x7d, 0xaf, 0x6d, 0x7c, 0x93, 0x12, 0x82, 0xb0, 0xdd, 0xa9, 0x0e, 0xd2, 0x28, 0x6f, 0x04,
-	0x3c, 0x1c, 0x85, 0x7c, 0xec, 0xae, 0xac, 0x21, 0xb8, 0x29, 0x6b, 0x41, 0x52, 0xf9, 0x37, 0x84,
-	0x9f, 0xcb, 0xfe, 0xab, 0x3c, 0x5b, 0x2b, 0x3e, 0x4e, 0x22, 0x98, 0x5a, 0xdf, 0x32, 0x9f, 0xcd,
-	0x52, 0x88, 0x10, 0xbf, 0xbd, 0x12, 0x56, 0x6e, 0xb8, 0x0b, 0x97, 0x6e, 0x93, 0x30, 0xb2, 0x1a,
-	0xee, 0x12, 0x82, 0xfd, 0x70, 0x97, 0x82, 0xa4, 0xf2, 0xaf, 0x08, 0x3f, 0x5b, 0x9c, 0x96, 0x1d,
-	0x20, 0x94, 0xf7, 0x80, 0x70, 0x6f, 0xd7, 0x79, 0x6a, 0x25, 0x43, 0x68, 0xdf, 0x5a, 0x05, 0x4a,
-	0x57, 0x27, 0x8b, 0x97, 0x3a, 0xd7, 0x89, 0x16, 0xe2, 0x58, 0x27, 0x25, 0x2c, 0x5d, 0x9d, 0x2c,
-	0x5e, 0xea, 0x56, 0x27, 0x45, 0x82, 0x63, 0x9d, 0xe8, 0x40, 0xb9, 0x3a, 0x29, 0x3e, 0x1d, 0x19,
-	0x06, 0x30, 0x95, 0xde, 0xad, 0x30, 0x42, 0x73, 0x86, 0x7d, 0x9d, 0x2c, 0x41, 0x49, 0xf1, 0x1f,
-	0x11, 0x7e, 0xaa, 0x1b, 0x1e, 0x0e, 0x49, 0x54, 0xec, 0x18, 0x8c, 0xf7, 0x7a, 0x7d, 0x5e, 0x08,
-	0x6f, 0x57, 0xc5, 0x48, 0xd9, 0x3f, 0x11, 0x7e, 0x61, 0x7e, 0x55, 0xc8, 0x07, 0x25, 0x7d, 0xce,
-	0x9b, 0x76, 0xb7, 0x2b, 0x05, 0x09, 0xfd, 0xb7, 0x56, 0xc6, 0x93, 0xcf, 0xf1, 0x13, 0xc2, 0x4f,
-	0x77, 0xe0, 0x38, 0x1e, 0x41, 0x16, 0x52, 0xda, 0x8d, 0x6d, 0xe3, 0xf9, 0xd5, 0x03, 0x84, 0x77,
-	0xbb, 0x32, 0x47, 0xfa, 0xfe, 0x82, 0xf0, 0xda, 0x1d, 0xa0, 0xc7, 0xe1, 0x90, 0x70, 0x28, 0x8e,
-	0xb8, 0xe9, 0x8b, 0x54, 0x8e, 0x10, 0xce, 0xbb, 0x2b, 0x20, 0x29, 0xa5, 0xbd, 0x09, 0xd3, 0xc5,
-	0xc5, 0xbd, 0xb4, 0x4b, 0xf2, 0xb6, 0xa5, 0x5d, 0x8a, 0x91, 0xb2, 0xd3, 0xc6, 0x7d, 0xd6, 0x60,
-	0xb9, 0x37, 0xee, 0xfa, 0xb8, 0x6d, 0xe3, 0x5e, 0x46, 0x91, 0xa6, 0x7f, 0x20, 0xec, 0xcf, 0xa1,
-	0xd9, 0x7a, 0x52, 0x34, 0xde, 0x33, 0xbe, 0xd7, 0x32, 0x8c, 0x30, 0xdf, 0x5f, 0x11, 0x4d, 0xe9,
-	0xa6, 0xbb, 0xc1, 0x00, 0xfa, 0x69, 0x04, 0x8b, 0xbb, 0xbf, 0x71, 0x37, 0xad, 0x0b, 0xdb, 0x76,
-	0xd3, 0x7a, 0x86, 0x74, 0xfc, 0x1d, 0xe1, 0xe7, 0xb3, 0x9d, 0xbe, 0x35, 0x08, 0xa3, 0xbe, 0x7c,
-	0x8c, 0xf3, 0x0d, 0xfc, 0xb6, 0x55, 0xbf, 0x50, 0x42, 0x11, 0xd6, 0x7b, 0xab, 0x81, 0x29, 0x5b,
-	0xf8, 0x26, 0xb0, 0x80, 0x86, 0x3d, 0xcd, 0xdb, 0xd7, 0x36, 0x7e, 0x6d, 0x4a, 0x08, 0xb6, 0x5b,
-	0xf8, 0x12, 0x90, 0x54, 0xfe, 0x06, 0xe1, 0xc7, 0x3a, 0x90, 0x44, 0x61, 0x40, 0x38, 0x6c, 0x8d,
-	0x60, 0xc8, 0xd9, 0x3b, 0x97, 0xbc, 0x9b, 0xc6, 0x03, 0x93, 0x4b, 0x0a, 0xc5, 0x37, 0xdc, 0x01,
-	0xca, 0x77, 0xe5, 0xee, 0x78, 0x18, 0x74, 0x07, 0x84, 0xf6, 0xa7, 0x8b, 0x73, 0xca, 0x8c, 0xbf,
-	0x2b, 0xe7, 0x72, 0xb6, 0xdf, 0x95, 0x0b, 0x71, 0x29, 0xf5, 0x19, 0xc2, 0x0f, 0x4d, 0xff, 0x2a,
-	0x1a, 0x0c, 0xef, 0x9a, 0x05, 0x52, 0x84, 0x84, 0xce, 0x75, 0xa7, 0xac, 0xf2, 0x46, 0x8b, 0x39,
-	0x56, 0x36, 0xd3, 0xa6, 0x65, 0x81, 0xe8, 0x36, 0xd2, 0x56, 0x25, 0x86, 0x74, 0xfc, 0x16, 0xe1,
-	0xc7, 0xc5, 0x25, 0xf3, 0x53, 0x9b, 0x9d, 0x98, 0x71, 0x6f, 0xc3, 0x12, 0xbf, 0x90, 0x15, 0x86,
-	0xcd, 0x2a, 0x08, 0x29, 0xf8, 0x29, 0xc2, 0xb8, 0x15, 0xc5, 0x0c, 0x66, 0xf3, 0xed, 0x5d, 0x31,
-	0x84, 0x9e, 0x47, 0x84, 0xce, 0x55, 0x87, 0xa4, 0xb4, 0xf8, 0x18, 0xff, 0xbf, 0x0d, 0x3c, 0x53,
-	0x78, 0xd5, 0xfc, 0x40, 0x47, 0x11, 0x78, 0xcd, 0x3a, 0xa7, 0x0c, 0x42, 0xd6, 0x11, 0xcd, 0x76,
-	0x84, 0x2b, 0x56, 0x4d, 0xd4, 0xe2, 0x3e, 0x70, 0xd5, 0x21, 0xa9, 0x74, 0x03, 0x6d, 0xe0, 0x62,
-	0x4d, 0x08, 0xe3, 0xe1, 0x3e, 0x30, 0x46, 0x0e, 0x81, 0x19, 0x77, 0x03, 0xfa, 0xb8, 0x6d, 0x37,
-	0x50, 0x46, 0x51, 0x16, 0xfa, 0x36, 0xf0, 0xcd, 0xbd, 0x03, 0x9d, 0x6c, 0xdb, 0xfc, 0x36, 0x7a,
-	0x82, 0xed, 0x42, 0xbf, 0x04, 0x24, 0x95, 0x3f, 0x47, 0xf8, 0xe1, 0x83, 0x14, 0xe8, 0x58, 0xec,
-	0x06, 0x9e, 0xe9, 0xea, 0xa3, 0xa4, 0x84, 0xda, 0xba, 0x5b, 0x58, 0xd1, 0xe9, 0x00, 0x49, 0x92,
-	0x68, 0x9c, 0x2d, 0xfd, 0xc6, 0x3a, 0x4a, 0xca, 0x56, 0x27, 0x17, 0x96, 0x3a, 0x5f, 0x20, 0x7c,
-	0x21, 0x1b, 0x45, 0x39, 0x8b, 0xeb, 0x56, 0x83, 0x9f, 0x9f, 0xba, 0x1b, 0x8e, 0x69, 0xf5, 0x50,
-	0x36, 0xa5, 0x87, 0xb0, 0xe8, 0x64, 0x7c, 0x28, 0x9b, 0x0b, 0x5a, 0x1f, 0xca, 0x16, 0xf2, 0x8a,
-	0xd7, 0x3e, 0x38, 0x7a, 0xe5, 0x83, 0xb6, 0x5e, 0xc5, 0x7c, 0xee, 0xb0, 0xf8, 0x2e, 0x05, 0x36,
-	0x58, 0x6c, 0x2e, 0x99, 0xc5, 0x61, 0x71, 0x31, 0x6c, 0x7f, 0x58, 0xac, 0x63, 0x48, 0xc7, 0x7b,
-	0x08, 0xbf, 0xd4, 0x86, 0x21, 0x50, 0xc2, 0x61, 0x8f, 0x30, 0x3e, 0xdf, 0x91, 0x16, 0x5e, 0xdc,
-	0x4c, 0xf9, 0xc0, 0xb8, 0x78, 0xfe, 0x93, 0x25, 0x9e, 0xa0, 0xb3, 0x4a, 0xa4, 0x32, 0xe8, 0xea,
-	0x62, 0x39, 0xef, 0xd3, 0x9a, 0x4e, 0x2b, 0xad, 0xda, 0xac, 0xb5, 0x2a, 0x31, 0x94, 0x0e, 0xa4,
-	0x03, 0xbd, 0x34, 0x8c, 0xfa, 0x4a, 0x93, 0xb4, 0x61, 0x3c, 0xa7, 0x85, 0xac, 0x6d, 0x07, 0xa2,
-	0x45, 0x08, 0xc1, 0x66, 0x72, 0x72, 0xea, 0xd7, 0xee, 0x9f, 0xfa, 0xb5, 0x07, 0xa7, 0x3e, 0xfa,
-	0x64, 0xe2, 0xa3, 0x1f, 0x26, 0x3e, 0xfa, 0x6b, 0xe2, 0xa3, 0x93, 0x89, 0x8f, 0xfe, 0x9e, 0xf8,
-	0xe8, 0x9f, 0x89, 0x5f, 0x7b, 0x30, 0xf1, 0xd1, 0x97, 0x67, 0x7e, 0xed, 0xe4, 0xcc, 0xaf, 0xdd,
-	0x3f, 0xf3, 0x6b, 0xef, 0x5d, 0x3b, 0x8c, 0xcf, 0xef, 0x1e, 0xc6, 0x4b, 0x7f, 0x4a, 0xbb, 0xae,
-	0x7e, 0xd2, 0xfb, 0xdf, 0xec, 0x97, 0xb4, 0xcb, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xba, 0x14,
-	0x63, 0x98, 0xe5, 0x1b, 0x00, 0x00,
+	// 1181 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcf, 0x8b, 0x23, 0x45,
+	0x14, 0xc7, 0x53, 0x17, 0x91, 0x42, 0x57, 0x6d, 0xc5, 0x1f, 0xa3, 0x36, 0xa2, 0x78, 0x4d, 0xd8,
+	0x5d, 0xd0, 0xd9, 0xdd, 0x59, 0xd7, 0x49, 0x66, 0x26, 0x99, 0xdd, 0x89, 0x3a, 0xc9, 0xaa, 0xe0,
+	0x45, 0x2a, 0x9d, 0x37, 0x93, 0x66, 0x7a, 0xba, 0xdb, 0xae, 0xea, 0x68, 0x0e, 0x82, 0xe0, 0x49,
+	0x10, 0x14, 0x41, 0xf0, 0x24, 0xe8, 0x45, 0x11, 0x04, 0x41, 0x10, 0x04, 0xc1, 0x93, 0xe0, 0x49,
+	0xe6, 0xb8, 0x47, 0x27, 0x73, 0xf1, 0x38, 0x7f, 0x82, 0x24, 0x9d, 0xaa, 0x49, 0xa5, 0xab, 0xb3,
+	0x55, 0xd5, 0xb9, 0xed, 0xce, 0xf4, 0xf7, 0xd3, 0x9f, 0xae, 0x7a, 0xdd, 0xef, 0x75, 0x0f, 0xbe,
+	0xca, 0xe0, 0x38, 0x8e, 0x12, 0x12, 0xd4, 0x28, 0x24, 0x43, 0x48, 0x6a, 0x24, 0xf6, 0x6b, 0x03,
+	0x9f, 0xb2, 0x28, 0x19, 0x4d, 0x7e, 0xe2, 0x7b, 0x50, 0x1b, 0x5e, 0xae, 0xcd, 0xfe, 0x59, 0x8d,
+	0x93, 0x88, 0x45, 0xce, 0xcb, 0x3c, 0x54, 0xcd, 0x42, 0x55, 0x12, 0xfb, 0x55, 0x39, 0x54, 0x1d,
+	0x5e, 0x5e, 0xdb, 0xd0, 0x63, 0x27, 0xf0, 0x41, 0x0a, 0x94, 0xbd, 0x9f, 0x00, 0x8d, 0xa3, 0x90,
+	0xce, 0x4e, 0x72, 0xe5, 0xfb, 0x75, 0x7c, 0xa9, 0x95, 0x1d, 0xdc, 0xcd, 0x0e, 0x76, 0x7e, 0x40,
+	0xf8, 0xc9, 0x2e, 0x23, 0x09, 0x7b, 0x37, 0x4a, 0x8e, 0x0e, 0x82, 0xe8, 0xc3, 0xed, 0x8f, 0xc0,
+	0x4b, 0x99, 0x1f, 0x85, 0xce, 0x56, 0x55, 0xcb, 0xa9, 0xaa, 0x8e, 0x77, 0x32, 0x85, 0xb5, 0xed,
+	0x92, 0x94, 0xec, 0x02, 0x5e, 0xac, 0x38, 0x5f, 0x21, 0xfc, 0x48, 0x13, 0x58, 0x3b, 0x65, 0xa4,
+	0x17, 0x40, 0x97, 0x11, 0x06, 0xce, 0x4d, 0x4d, 0xf8, 0x42, 0x8e, 0xbb, 0xbd, 0x66, 0x1b, 0x17,
+	0x52, 0x5f, 0x23, 0xfc, 0xe8, 0x5b, 0x51, 0x10, 0x48, 0x56, 0xba, 0xd8, 0xc5, 0x20, 0xd7, 0xba,
+	0x65, 0x9d, 0x17, 0x5e, 0xdf, 0x21, 0xfc, 0x44, 0x07, 0x28, 0xb0, 0x2e, 0xf3, 0xbd, 0xa3, 0xd1,
+	0x5d, 0x42, 0x8f, 0xf6, 0x53, 0x48, 0xc1, 0xa9, 0x6b, 0xb2, 0x55, 0x61, 0xee, 0xd7, 0x28, 0xc5,
+	0x10, 0x8e, 0xbf, 0x20, 0xfc, 0x4c, 0x07, 0xbc, 0x28, 0xe9, 0xf3, 0x6d, 0x9f, 0x1c, 0x35, 0xad,
+	0x03, 0xe8, 0x3b, 0x4d, 0xed, 0x93, 0x14, 0x10, 0xb8, 0x6d, 0xab, 0x3c, 0x48, 0xa1, 0xbc, 0xe9,
+	0x31, 0x7f, 0xe8, 0xb3, 0x91, 0xbd, 0xb2, 0x82, 0x60, 0xa7, 0xac, 0x04, 0x09, 0xe5, 0xdf, 0x11,
+	0x7e, 0x2e, 0xfb, 0xaf, 0x74, 0x6d, 0x8d, 0xe8, 0x38, 0x0e, 0x60, 0x62, 0x7d, 0x5b, 0x7f, 0x37,
+	0x0b, 0x21, 0x5c, 0xfc, 0xce, 0x4a, 0x58, 0x0b, 0xcb, 0x9d, 0x3b, 0x74, 0x87, 0xf8, 0x81, 0xd1,
+	0x72, 0x17, 0x10, 0xcc, 0x97, 0xbb, 0x10, 0x24, 0x94, 0x7f, 0x43, 0xf8, 0xd9, 0xfc, 0xb6, 0xb4,
+	0x80, 0x24, 0xac, 0x07, 0x84, 0x39, 0xbb, 0xd6, 0x5b, 0x2b, 0x18, 0x5c, 0xfb, 0xf6, 0x2a, 0x50,
+	0xaa, 0x3a, 0x99, 0x3f, 0xd4, 0xba, 0x4e, 0x94, 0x10, 0xcb, 0x3a, 0x29, 0x60, 0xa9, 0xea, 0x64,
+	0xfe, 0x50, 0xbb, 0x3a, 0xc9, 0x13, 0x2c, 0xeb, 0x44, 0x05, 0x5a, 0xa8, 0x93, 0xfc, 0xd5, 0x91,
+	0xd0, 0x83, 0x89, 0xf4, 0x6e, 0x89, 0x15, 0x9a, 0x31, 0xcc, 0xeb, 0x64, 0x09, 0x4a, 0x88, 0xff,
+	0x84, 0xf0, 0x53, 0x5d, 0xff, 0x30, 0x24, 0x41, 0x7e, 0x62, 0xd0, 0xee, 0xf5, 0xea, 0x3c, 0x17,
+	0xde, 0x29, 0x8b, 0x11, 0xb2, 0x7f, 0x21, 0xfc, 0xc2, 0xec, 0x28, 0x9f, 0x0d, 0x0a, 0xe6, 0x9c,
+	0x37, 0xcc, 0x4e, 0x57, 0x08, 0xe2, 0xfa, 0x6f, 0xae, 0x8c, 0x27, 0xae, 0xe3, 0x67, 0x84, 0x9f,
+	0xee, 0xc0, 0x71, 0x34, 0x84, 0x2c, 0x24, 0x8d, 0x1b, 0x3b, 0xda, 0xfb, 0xab, 0x06, 0x70, 0xef,
+	0x66, 0x69, 0x8e, 0xf0, 0xfd, 0x15, 0xe1, 0xb5, 0xbb, 0x90, 0x1c, 0xfb, 0x21, 0x61, 0x90, 0x5f,
+	0x71, 0xdd, 0x1b, 0xa9, 0x18, 0xc1, 0x9d, 0x77, 0x57, 0x40, 0x92, 0x4a, 0x7b, 0x0b, 0x02, 0x60,
+	0x60, 0x5f, 0xda, 0x05, 0x79, 0xd3, 0xd2, 0x2e, 0xc4, 0x08, 0xd9, 0xc9, 0xe0, 0x3e, 0x1d, 0xb0,
+	0xec, 0x07, 0x77, 0x75, 0xdc, 0x74, 0x70, 0x2f, 0xa2, 0x08, 0xd3, 0x3f, 0x11, 0x76, 0x67, 0xd0,
+	0xec, 0x79, 0x92, 0x37, 0xde, 0xd3, 0x3e, 0xd7, 0x32, 0x0c, 0x37, 0x6f, 0xaf, 0x88, 0x26, 0x4d,
+	0xd3, 0x5d, 0x6f, 0x00, 0xfd, 0x34, 0x80, 0xf9, 0xee, 0xaf, 0x3d, 0x4d, 0xab, 0xc2, 0xa6, 0xd3,
+	0xb4, 0x9a, 0x21, 0x1c, 0xff, 0x40, 0xf8, 0xf9, 0xac, 0xd3, 0x37, 0x06, 0x7e, 0xd0, 0x17, 0x97,
+	0x71, 0xd1, 0xc0, 0xef, 0x18, 0xcd, 0x0b, 0x05, 0x14, 0x6e, 0xbd, 0xb7, 0x1a, 0x98, 0xd4, 0xc2,
+	0xb7, 0x80, 0x7a, 0x89, 0xdf, 0x53, 0xdc, 0x7d, 0x4d, 0xed, 0xdb, 0xa6, 0x80, 0x60, 0xda, 0xc2,
+	0x97, 0x80, 0x84, 0xf2, 0x37, 0x08, 0x3f, 0xd6, 0x81, 0x38, 0xf0, 0x3d, 0xc2, 0x60, 0x7b, 0x08,
+	0x21, 0xa3, 0xef, 0x5c, 0x71, 0x6e, 0x69, 0x2f, 0xcc, 0x42, 0x92, 0x2b, 0xbe, 0x6e, 0x0f, 0x90,
+	0xde, 0x95, 0xbb, 0xa3, 0xd0, 0xeb, 0x0e, 0x48, 0xd2, 0x9f, 0x3c, 0x9c, 0x53, 0xaa, 0xfd, 0xae,
+	0xbc, 0x90, 0x33, 0x7d, 0x57, 0xce, 0xc5, 0x85, 0xd4, 0x67, 0x08, 0x3f, 0x34, 0xf9, 0x2d, 0x1f,
+	0x30, 0x9c, 0xeb, 0x06, 0x48, 0x1e, 0xe2, 0x3a, 0x37, 0xac, 0xb2, 0xd2, 0x1d, 0xcd, 0xf7, 0x58,
+	0x6a, 0xa6, 0x75, 0xc3, 0x02, 0x51, 0x35, 0xd2, 0x46, 0x29, 0x86, 0x70, 0xfc, 0x16, 0xe1, 0xc7,
+	0xf9, 0x21, 0xb3, 0xaf, 0x36, 0xad, 0x88, 0x32, 0x67, 0xd3, 0x10, 0x3f, 0x97, 0xe5, 0x86, 0xf5,
+	0x32, 0x08, 0x21, 0xf8, 0x29, 0xc2, 0xb8, 0x11, 0x44, 0x14, 0xa6, 0xfb, 0xed, 0xac, 0x6b, 0x42,
+	0x2f, 0x22, 0x5c, 0xe7, 0x9a, 0x45, 0x52, 0x58, 0x7c, 0x8c, 0x1f, 0x6c, 0x02, 0xcb, 0x14, 0x5e,
+	0xd1, 0xff, 0xa0, 0x23, 0x09, 0xbc, 0x6a, 0x9c, 0x93, 0x2a, 0x89, 0x3f, 0xd0, 0xa6, 0xbf, 0x6b,
+	0x91, 0xb0, 0x1f, 0x1d, 0x1c, 0x68, 0x57, 0x92, 0x2a, 0x6c, 0x5a, 0x49, 0x6a, 0x86, 0xb4, 0x51,
+	0xd9, 0xd4, 0x36, 0xed, 0x5a, 0xeb, 0x46, 0x83, 0xde, 0x7c, 0xaf, 0xba, 0x66, 0x91, 0x94, 0x26,
+	0x96, 0x26, 0x30, 0xfe, 0xdc, 0xf2, 0xa3, 0xb0, 0x0d, 0x94, 0x92, 0x43, 0xa0, 0xda, 0x13, 0x8b,
+	0x3a, 0x6e, 0x3a, 0xb1, 0x14, 0x51, 0xa4, 0x66, 0xd4, 0x04, 0xb6, 0xb5, 0xb7, 0xaf, 0x92, 0x6d,
+	0xea, 0x9f, 0x46, 0x4d, 0x30, 0x6d, 0x46, 0x4b, 0x40, 0x42, 0xf9, 0x73, 0x84, 0x1f, 0xde, 0x4f,
+	0x21, 0x19, 0xf1, 0x8e, 0xe5, 0xe8, 0x3e, 0x21, 0xa5, 0x14, 0x57, 0xdb, 0xb0, 0x0b, 0x4b, 0x3a,
+	0x1d, 0x20, 0x71, 0x1c, 0x8c, 0xb2, 0xf6, 0xa4, 0xad, 0x23, 0xa5, 0x4c, 0x75, 0x16, 0xc2, 0x42,
+	0xe7, 0x0b, 0x84, 0x2f, 0x65, 0xab, 0x28, 0x76, 0x71, 0xc3, 0x68, 0xf1, 0x17, 0xb7, 0xee, 0xa6,
+	0x65, 0x5a, 0xfe, 0x70, 0x9c, 0x26, 0x87, 0x30, 0xef, 0xa4, 0xfd, 0xe1, 0x78, 0x21, 0x68, 0xfc,
+	0xe1, 0x38, 0x97, 0x97, 0xbc, 0xda, 0x60, 0xe9, 0xd5, 0x86, 0x72, 0x5e, 0x6d, 0x28, 0xf4, 0xca,
+	0x3e, 0x68, 0x1f, 0x24, 0x40, 0x07, 0xf3, 0x03, 0x30, 0x35, 0xf8, 0xa0, 0x9d, 0x0f, 0x9b, 0x7f,
+	0xd0, 0x56, 0x31, 0xa4, 0xb7, 0xf4, 0xb7, 0xc3, 0x98, 0xa4, 0x54, 0x31, 0xc2, 0xea, 0xbe, 0xf9,
+	0x15, 0x01, 0x4c, 0xdf, 0xd2, 0x8b, 0x39, 0xc2, 0xf7, 0x1f, 0x84, 0x5f, 0x6a, 0x42, 0x08, 0x09,
+	0x61, 0xb0, 0x47, 0x28, 0x9b, 0x75, 0xf9, 0xb9, 0x07, 0x4d, 0xb6, 0xc4, 0xfb, 0xda, 0xc5, 0x7e,
+	0x5f, 0x16, 0xbf, 0x8a, 0xce, 0x2a, 0x91, 0x52, 0x91, 0xc8, 0x0f, 0xf7, 0xd9, 0xec, 0x5b, 0xb7,
+	0xea, 0x0c, 0xf2, 0x00, 0xdc, 0x28, 0xc5, 0x90, 0xa6, 0xba, 0x0e, 0xf4, 0x52, 0x3f, 0xe8, 0x4b,
+	0x83, 0xe7, 0xa6, 0x76, 0x0d, 0xe6, 0xb2, 0xa6, 0x53, 0x9d, 0x12, 0xc1, 0x05, 0xeb, 0xf1, 0xc9,
+	0xa9, 0x5b, 0xb9, 0x77, 0xea, 0x56, 0xce, 0x4f, 0x5d, 0xf4, 0xc9, 0xd8, 0x45, 0x3f, 0x8e, 0x5d,
+	0xf4, 0xf7, 0xd8, 0x45, 0x27, 0x63, 0x17, 0xfd, 0x3b, 0x76, 0xd1, 0x7f, 0x63, 0xb7, 0x72, 0x3e,
+	0x76, 0xd1, 0x97, 0x67, 0x6e, 0xe5, 0xe4, 0xcc, 0xad, 0xdc, 0x3b, 0x73, 0x2b, 0xef, 0x5d, 0x3f,
+	0x8c, 0x2e, 0xce, 0xee, 0x47, 0x4b, 0xff, 0x3c, 0x79, 0x43, 0xfe, 0x49, 0xef, 0x81, 0xe9, 0x5f,
+	0x27, 0xaf, 0xfe, 0x3f, 0x00, 0x1c, 0x0f, 0x1f, 0x7a, 0x39, 0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -254,6 +256,8 @@ type HistoryServiceClient interface {
 	CloseShard(ctx context.Context, in *CloseShardRequest, opts ...grpc.CallOption) (*CloseShardResponse, error)
 	// GetShard gets the ShardInfo
 	GetShard(ctx context.Context, in *GetShardRequest, opts ...grpc.CallOption) (*GetShardResponse, error)
+	// CompleteShardHandoff notifies the new owner of a shard that the previous owner has drained and released it.
+	CompleteShardHandoff(ctx context.Context, in *CompleteShardHandoffRequest, opts ...grpc.CallOption) (*CompleteShardHandoffResponse, error)
 	// RemoveTask remove task based on type, taskid, shardid.
 	RemoveTask(ctx context.Context, in *RemoveTaskRequest, opts ...grpc.CallOption) (*RemoveTaskResponse, error)
 	// GetReplicationMessages return replication messages based on the read level
@@ -274,6 +278,8 @@ type HistoryServiceClient interface {
 	MergeDLQMessages(ctx context.Context, in *MergeDLQMessagesRequest, opts ...grpc.CallOption) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(ctx context.Context, in *RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*RefreshWorkflowTasksResponse, error)
+	// UnpauseWorkflowExecution resumes workflow task scheduling of a workflow paused after repeated workflow task failures.
+	UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error)
 	// GenerateLastHistoryReplicationTasks generate a replication task for last history event for requested workflow execution
 	GenerateLastHistoryReplicationTasks(ctx context.Context, in *GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*GenerateLastHistoryReplicationTasksResponse, error)
 	GetReplicationStatus(ctx context.Context, in *GetReplicationStatusRequest, opts ...grpc.CallOption) (*GetReplicationStatusResponse, error)
@@ -550,6 +556,15 @@ func (c *historyServiceClient) GetShard(ctx context.Context, in *GetShardRequest
 	return out, nil
 }
 
+func (c *historyServiceClient) CompleteShardHandoff(ctx context.Context, in *CompleteShardHandoffRequest, opts ...grpc.CallOption) (*CompleteShardHandoffResponse, error) {
+	out := new(CompleteShardHandoffResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/CompleteShardHandoff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) RemoveTask(ctx context.Context, in *RemoveTaskRequest, opts ...grpc.CallOption) (*RemoveTaskResponse, error) {
 	out := new(RemoveTaskResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/RemoveTask", in, out, opts...)
@@ -631,6 +646,15 @@ func (c *historyServiceClient) RefreshWorkflowTasks(ctx context.Context, in *Ref
 	return out, nil
 }
 
+func (c *historyServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error) {
+	out := new(UnpauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/UnpauseWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) GenerateLastHistoryReplicationTasks(ctx context.Context, in *GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*GenerateLastHistoryReplicationTasksResponse, error) {
 	out := new(GenerateLastHistoryReplicationTasksResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/GenerateLastHistoryReplicationTasks", in, out, opts...)
@@ -773,6 +797,8 @@ type HistoryServiceServer interface {
 	CloseShard(context.Context, *CloseShardRequest) (*CloseShardResponse, error)
 	// GetShard gets the ShardInfo
 	GetShard(context.Context, *GetShardRequest) (*GetShardResponse, error)
+	// CompleteShardHandoff notifies the new owner of a shard that the previous owner has drained and released it.
+	CompleteShardHandoff(context.Context, *CompleteShardHandoffRequest) (*CompleteShardHandoffResponse, error)
 	// RemoveTask remove task based on type, taskid, shardid.
 	RemoveTask(context.Context, *RemoveTaskRequest) (*RemoveTaskResponse, error)
 	// GetReplicationMessages return replication messages based on the read level
@@ -793,6 +819,8 @@ type HistoryServiceServer interface {
 	MergeDLQMessages(context.Context, *MergeDLQMessagesRequest) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
+	// UnpauseWorkflowExecution resumes workflow task scheduling of a workflow paused after repeated workflow task failures.
+	UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error)
 	// GenerateLastHistoryReplicationTasks generate a replication task for last history event for requested workflow execution
 	GenerateLastHistoryReplicationTasks(context.Context, *GenerateLastHistoryReplicationTasksRequest) (*GenerateLastHistoryReplicationTasksResponse, error)
 	GetReplicationStatus(context.Context, *GetReplicationStatusRequest) (*GetReplicationStatusResponse, error)
@@ -891,6 +919,9 @@ func (*UnimplementedHistoryServiceServer) CloseShard(ctx context.Context, req *C
 func (*UnimplementedHistoryServiceServer) GetShard(ctx context.Context, req *GetShardRequest) (*GetShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetShard not implemented")
 }
+func (*UnimplementedHistoryServiceServer) CompleteShardHandoff(ctx context.Context, req *CompleteShardHandoffRequest) (*CompleteShardHandoffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteShardHandoff not implemented")
+}
 func (*UnimplementedHistoryServiceServer) RemoveTask(ctx context.Context, req *RemoveTaskRequest) (*RemoveTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveTask not implemented")
 }
@@ -918,6 +949,9 @@ func (*UnimplementedHistoryServiceServer) MergeDLQMessages(ctx context.Context,
 func (*UnimplementedHistoryServiceServer) RefreshWorkflowTasks(ctx context.Context, req *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshWorkflowTasks not implemented")
 }
+func (*UnimplementedHistoryServiceServer) UnpauseWorkflowExecution(ctx context.Context, req *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseWorkflowExecution not implemented")
+}
 func (*UnimplementedHistoryServiceServer) GenerateLastHistoryReplicationTasks(ctx context.Context, req *GenerateLastHistoryReplicationTasksRequest) (*GenerateLastHistoryReplicationTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateLastHistoryReplicationTasks not implemented")
 }
@@ -1454,6 +1488,24 @@ func _HistoryService_GetShard_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_CompleteShardHandoff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteShardHandoffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).CompleteShardHandoff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/CompleteShardHandoff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).CompleteShardHandoff(ctx, req.(*CompleteShardHandoffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_RemoveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveTaskRequest)
 	if err := dec(in); err != nil {
@@ -1616,6 +1668,24 @@ func _HistoryService_RefreshWorkflowTasks_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_UnpauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UnpauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/UnpauseWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UnpauseWorkflowExecution(ctx, req.(*UnpauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_GenerateLastHistoryReplicationTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GenerateLastHistoryReplicationTasksRequest)
 	if err := dec(in); err != nil {
@@ -1790,6 +1860,10 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetShard",
 			Handler:    _HistoryService_GetShard_Handler,
 		},
+		{
+			MethodName: "CompleteShardHandoff",
+			Handler:    _HistoryService_CompleteShardHandoff_Handler,
+		},
 		{
 			MethodName: "RemoveTask",
 			Handler:    _HistoryService_RemoveTask_Handler,
@@ -1826,6 +1900,10 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshWorkflowTasks",
 			Handler:    _HistoryService_RefreshWorkflowTasks_Handler,
 		},
+		{
+			MethodName: "UnpauseWorkflowExecution",
+			Handler:    _HistoryService_UnpauseWorkflowExecution_Handler,
+		},
 		{
 			MethodName: "GenerateLastHistoryReplicationTasks",
 			Handler:    _HistoryService_GenerateLastHistoryReplicationTasks_Handler,
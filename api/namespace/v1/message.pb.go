@@ -34,14 +34,19 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -104,8 +109,94 @@ func (m *NamespaceCacheInfo) GetItemsInCacheByNameCount() int64 {
 	return 0
 }
 
+// ApiKeyInfo describes an API key of a namespace, without its secret.
+type ApiKeyInfo struct {
+	Id         string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role       string     `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	CreateTime *time.Time `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3,stdtime" json:"create_time,omitempty"`
+	RotateTime *time.Time `protobuf:"bytes,5,opt,name=rotate_time,json=rotateTime,proto3,stdtime" json:"rotate_time,omitempty"`
+	// Set while the secret before the last rotation is still valid.
+	PreviousSecretExpirationTime *time.Time `protobuf:"bytes,6,opt,name=previous_secret_expiration_time,json=previousSecretExpirationTime,proto3,stdtime" json:"previous_secret_expiration_time,omitempty"`
+}
+
+func (m *ApiKeyInfo) Reset()      { *m = ApiKeyInfo{} }
+func (*ApiKeyInfo) ProtoMessage() {}
+func (*ApiKeyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd829fe44a7a2771, []int{1}
+}
+func (m *ApiKeyInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApiKeyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApiKeyInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApiKeyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiKeyInfo.Merge(m, src)
+}
+func (m *ApiKeyInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApiKeyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiKeyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiKeyInfo proto.InternalMessageInfo
+
+func (m *ApiKeyInfo) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ApiKeyInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApiKeyInfo) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *ApiKeyInfo) GetCreateTime() *time.Time {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *ApiKeyInfo) GetRotateTime() *time.Time {
+	if m != nil {
+		return m.RotateTime
+	}
+	return nil
+}
+
+func (m *ApiKeyInfo) GetPreviousSecretExpirationTime() *time.Time {
+	if m != nil {
+		return m.PreviousSecretExpirationTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*NamespaceCacheInfo)(nil), "temporal.server.api.namespace.v1.NamespaceCacheInfo")
+	proto.RegisterType((*ApiKeyInfo)(nil), "temporal.server.api.namespace.v1.ApiKeyInfo")
 }
 
 func init() {
@@ -113,23 +204,34 @@ func init() {
 }
 
 var fileDescriptor_bd829fe44a7a2771 = []byte{
-	// 253 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2b, 0x49, 0xcd, 0x2d,
-	0xc8, 0x2f, 0x4a, 0xcc, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0x4b, 0x2d, 0xd2, 0x4f, 0x2c, 0xc8, 0xd4,
-	0xcf, 0x4b, 0xcc, 0x4d, 0x2d, 0x2e, 0x48, 0x4c, 0x4e, 0xd5, 0x2f, 0x33, 0xd4, 0xcf, 0x4d, 0x2d,
-	0x2e, 0x4e, 0x4c, 0x4f, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0x80, 0xa9, 0xd7, 0x83,
-	0xa8, 0xd7, 0x4b, 0x2c, 0xc8, 0xd4, 0x83, 0xab, 0xd7, 0x2b, 0x33, 0x54, 0xea, 0x63, 0xe4, 0x12,
-	0xf2, 0x83, 0x09, 0x38, 0x27, 0x26, 0x67, 0xa4, 0x7a, 0xe6, 0xa5, 0xe5, 0x0b, 0x59, 0x72, 0x49,
-	0x65, 0x96, 0xa4, 0xe6, 0x16, 0xc7, 0x67, 0xe6, 0xc5, 0x27, 0x83, 0x44, 0xe3, 0x93, 0x2a, 0xe3,
-	0x33, 0x53, 0xe2, 0x93, 0xf3, 0x4b, 0xf3, 0x4a, 0x24, 0x18, 0x15, 0x18, 0x35, 0x98, 0x83, 0x44,
-	0xc1, 0x2a, 0x3c, 0xf3, 0xc0, 0xba, 0x9c, 0x2a, 0x3d, 0x53, 0x9c, 0x41, 0x92, 0x42, 0xb6, 0x5c,
-	0x32, 0x98, 0x5a, 0x41, 0x76, 0x42, 0x35, 0x33, 0x81, 0x35, 0x8b, 0xa3, 0x6a, 0x06, 0x39, 0x01,
-	0xac, 0xdd, 0x29, 0xed, 0xc2, 0x43, 0x39, 0x86, 0x1b, 0x0f, 0xe5, 0x18, 0x3e, 0x3c, 0x94, 0x63,
-	0x6c, 0x78, 0x24, 0xc7, 0xb8, 0xe2, 0x91, 0x1c, 0xe3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9,
-	0x31, 0x3e, 0x78, 0x24, 0xc7, 0xf8, 0xe2, 0x91, 0x1c, 0xc3, 0x87, 0x47, 0x72, 0x8c, 0x13, 0x1e,
-	0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x41, 0x7a, 0x3e, 0x3c,
-	0x6c, 0xf4, 0x32, 0xf3, 0x71, 0x05, 0x8f, 0x35, 0x9c, 0x93, 0xc4, 0x06, 0x0e, 0x21, 0x63, 0x40,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x31, 0xe9, 0x71, 0x89, 0x53, 0x01, 0x00, 0x00,
+	// 427 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0x8b, 0x14, 0x31,
+	0x18, 0x86, 0x27, 0x7b, 0xeb, 0xc1, 0xe5, 0xc0, 0x62, 0x40, 0x5c, 0x96, 0x23, 0xbb, 0x5e, 0x75,
+	0x55, 0xe2, 0x69, 0x25, 0x62, 0x71, 0x7b, 0x58, 0x2c, 0x82, 0xc5, 0x6a, 0x65, 0x13, 0xb2, 0x33,
+	0xdf, 0x8e, 0x81, 0x9d, 0x24, 0x24, 0xd9, 0xc1, 0xed, 0xfc, 0x05, 0x72, 0x3f, 0xc3, 0xde, 0x3f,
+	0x61, 0xb9, 0xe5, 0x75, 0xba, 0xb3, 0x8d, 0xe5, 0xfd, 0x04, 0x49, 0xe2, 0x0c, 0x88, 0x08, 0xd7,
+	0x7d, 0xf3, 0xce, 0xfb, 0x3e, 0xf9, 0x78, 0xf9, 0x30, 0xf5, 0x50, 0x1b, 0x6d, 0xc5, 0x9a, 0x39,
+	0xb0, 0x0d, 0x58, 0x26, 0x8c, 0x64, 0x4a, 0xd4, 0xe0, 0x8c, 0x28, 0x80, 0x35, 0x97, 0xac, 0x06,
+	0xe7, 0x44, 0x05, 0xd4, 0x58, 0xed, 0x75, 0x3e, 0xed, 0xfc, 0x34, 0xf9, 0xa9, 0x30, 0x92, 0xf6,
+	0x7e, 0xda, 0x5c, 0x8e, 0x27, 0x95, 0xd6, 0xd5, 0x1a, 0x58, 0xf4, 0x2f, 0x37, 0x2b, 0xe6, 0x65,
+	0x0d, 0xce, 0x8b, 0xda, 0x24, 0xc4, 0xf8, 0x49, 0x09, 0x06, 0x54, 0x09, 0xaa, 0x90, 0xe0, 0x58,
+	0xa5, 0x2b, 0x1d, 0xf5, 0x38, 0x25, 0xcb, 0xf9, 0x17, 0x84, 0xf3, 0xb7, 0x1d, 0xf4, 0x5a, 0x14,
+	0x1f, 0x61, 0xae, 0x56, 0x3a, 0x7f, 0x81, 0xc7, 0xd2, 0x43, 0xed, 0xb8, 0x54, 0xbc, 0x08, 0x2a,
+	0x5f, 0x6e, 0xb9, 0x2c, 0x79, 0xa1, 0x37, 0xca, 0x8f, 0xd0, 0x14, 0x5d, 0x1c, 0x2d, 0x1e, 0x45,
+	0xc7, 0x5c, 0xc5, 0xd4, 0x6c, 0x3b, 0x2f, 0xaf, 0xc3, 0xcf, 0xfc, 0x15, 0x3e, 0xfb, 0x37, 0x1a,
+	0xf6, 0xfe, 0x13, 0x1e, 0xc4, 0xf0, 0xe3, 0xbf, 0xc3, 0x61, 0x85, 0x18, 0x3f, 0xff, 0x36, 0xc0,
+	0xf8, 0xca, 0xc8, 0x37, 0xb0, 0x8d, 0x8b, 0x3c, 0xc4, 0x03, 0x59, 0xc6, 0x07, 0x4f, 0x16, 0x03,
+	0x59, 0xe6, 0x39, 0x1e, 0x06, 0x56, 0xa4, 0x9c, 0x2c, 0xe2, 0x1c, 0x34, 0xab, 0xd7, 0x30, 0x3a,
+	0x4a, 0x5a, 0x98, 0xf3, 0x2b, 0x7c, 0x5a, 0x58, 0x10, 0x1e, 0x78, 0x28, 0x65, 0x34, 0x9c, 0xa2,
+	0x8b, 0xd3, 0x67, 0x63, 0x9a, 0x1a, 0xa3, 0x5d, 0x63, 0xf4, 0x7d, 0xd7, 0xd8, 0x6c, 0x78, 0xf3,
+	0x63, 0x82, 0x16, 0x38, 0x85, 0x82, 0x1c, 0x10, 0x56, 0xfb, 0x1e, 0xf1, 0xe0, 0xbe, 0x88, 0x14,
+	0x8a, 0x88, 0x0a, 0x4f, 0x8c, 0x85, 0x46, 0xea, 0x8d, 0xe3, 0x0e, 0x0a, 0x0b, 0x9e, 0xc3, 0x27,
+	0x23, 0xad, 0xf0, 0x52, 0xab, 0x84, 0x3d, 0xbe, 0x27, 0xf6, 0xac, 0x03, 0xbd, 0x8b, 0x9c, 0xd7,
+	0x3d, 0x26, 0x18, 0x67, 0xab, 0xdd, 0x9e, 0x64, 0xb7, 0x7b, 0x92, 0xdd, 0xed, 0x09, 0xfa, 0xdc,
+	0x12, 0xf4, 0xb5, 0x25, 0xe8, 0x7b, 0x4b, 0xd0, 0xae, 0x25, 0xe8, 0x67, 0x4b, 0xd0, 0xaf, 0x96,
+	0x64, 0x77, 0x2d, 0x41, 0x37, 0x07, 0x92, 0xed, 0x0e, 0x24, 0xbb, 0x3d, 0x90, 0xec, 0xc3, 0xd3,
+	0x4a, 0xf7, 0x57, 0x49, 0xa5, 0xfe, 0xdf, 0x61, 0xbe, 0xec, 0x3f, 0x96, 0xc7, 0x71, 0xbf, 0xe7,
+	0xbf, 0x07, 0x00, 0xf4, 0x06, 0x90, 0x56, 0xcd, 0x02, 0x00, 0x00,
 }
 
 func (this *NamespaceCacheInfo) Equal(that interface{}) bool {
@@ -159,6 +261,57 @@ func (this *NamespaceCacheInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ApiKeyInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApiKeyInfo)
+	if !ok {
+		that2, ok := that.(ApiKeyInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if that1.CreateTime == nil {
+		if this.CreateTime != nil {
+			return false
+		}
+	} else if !this.CreateTime.Equal(*that1.CreateTime) {
+		return false
+	}
+	if that1.RotateTime == nil {
+		if this.RotateTime != nil {
+			return false
+		}
+	} else if !this.RotateTime.Equal(*that1.RotateTime) {
+		return false
+	}
+	if that1.PreviousSecretExpirationTime == nil {
+		if this.PreviousSecretExpirationTime != nil {
+			return false
+		}
+	} else if !this.PreviousSecretExpirationTime.Equal(*that1.PreviousSecretExpirationTime) {
+		return false
+	}
+	return true
+}
 func (this *NamespaceCacheInfo) GoString() string {
 	if this == nil {
 		return "nil"
@@ -170,6 +323,21 @@ func (this *NamespaceCacheInfo) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ApiKeyInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&namespace.ApiKeyInfo{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
+	s = append(s, "CreateTime: "+fmt.Sprintf("%#v", this.CreateTime)+",\n")
+	s = append(s, "RotateTime: "+fmt.Sprintf("%#v", this.RotateTime)+",\n")
+	s = append(s, "PreviousSecretExpirationTime: "+fmt.Sprintf("%#v", this.PreviousSecretExpirationTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringMessage(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -211,6 +379,80 @@ func (m *NamespaceCacheInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ApiKeyInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApiKeyInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApiKeyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PreviousSecretExpirationTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.PreviousSecretExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.PreviousSecretExpirationTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintMessage(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RotateTime != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RotateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RotateTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintMessage(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CreateTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreateTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintMessage(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessage(v)
 	base := offset
@@ -237,6 +479,39 @@ func (m *NamespaceCacheInfo) Size() (n int) {
 	return n
 }
 
+func (m *ApiKeyInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.CreateTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreateTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.RotateTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.RotateTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.PreviousSecretExpirationTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.PreviousSecretExpirationTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
 func sovMessage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -254,6 +529,21 @@ func (this *NamespaceCacheInfo) String() string {
 	}, "")
 	return s
 }
+func (this *ApiKeyInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApiKeyInfo{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`CreateTime:` + strings.Replace(fmt.Sprintf("%v", this.CreateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`RotateTime:` + strings.Replace(fmt.Sprintf("%v", this.RotateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`PreviousSecretExpirationTime:` + strings.Replace(fmt.Sprintf("%v", this.PreviousSecretExpirationTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringMessage(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -353,6 +643,263 @@ func (m *NamespaceCacheInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApiKeyInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApiKeyInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApiKeyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateTime == nil {
+				m.CreateTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RotateTime == nil {
+				m.RotateTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.RotateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousSecretExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousSecretExpirationTime == nil {
+				m.PreviousSecretExpirationTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.PreviousSecretExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
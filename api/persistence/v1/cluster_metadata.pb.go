@@ -34,9 +34,13 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v11 "go.temporal.io/api/enums/v1"
 	v1 "go.temporal.io/api/version/v1"
 )
@@ -45,6 +49,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -64,6 +69,9 @@ type ClusterMetadata struct {
 	InitialFailoverVersion   int64                             `protobuf:"varint,8,opt,name=initial_failover_version,json=initialFailoverVersion,proto3" json:"initial_failover_version,omitempty"`
 	IsGlobalNamespaceEnabled bool                              `protobuf:"varint,9,opt,name=is_global_namespace_enabled,json=isGlobalNamespaceEnabled,proto3" json:"is_global_namespace_enabled,omitempty"`
 	IsConnectionEnabled      bool                              `protobuf:"varint,10,opt,name=is_connection_enabled,json=isConnectionEnabled,proto3" json:"is_connection_enabled,omitempty"`
+	// Versioned audit log of changes of this cluster made through admin APIs. The record of the current
+	// cluster also keeps the changes of removed clusters.
+	ChangeHistory []*ClusterMetadataChange `protobuf:"bytes,11,rep,name=change_history,json=changeHistory,proto3" json:"change_history,omitempty"`
 }
 
 func (m *ClusterMetadata) Reset()      { *m = ClusterMetadata{} }
@@ -168,6 +176,108 @@ func (m *ClusterMetadata) GetIsConnectionEnabled() bool {
 	return false
 }
 
+func (m *ClusterMetadata) GetChangeHistory() []*ClusterMetadataChange {
+	if m != nil {
+		return m.ChangeHistory
+	}
+	return nil
+}
+
+type ClusterMetadataChange struct {
+	// Monotonically increasing version of the change within the changed cluster.
+	Version    int64      `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ChangeTime *time.Time `protobuf:"bytes,2,opt,name=change_time,json=changeTime,proto3,stdtime" json:"change_time,omitempty"`
+	Identity   string     `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	// Name of the admin API which made the change.
+	Operation   string `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`
+	ClusterName string `protobuf:"bytes,5,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// Cluster metadata before the change, unset if the cluster did not exist.
+	PreviousMetadata *ClusterMetadata `protobuf:"bytes,6,opt,name=previous_metadata,json=previousMetadata,proto3" json:"previous_metadata,omitempty"`
+	// Cluster metadata after the change, unset if the cluster was removed.
+	NewMetadata *ClusterMetadata `protobuf:"bytes,7,opt,name=new_metadata,json=newMetadata,proto3" json:"new_metadata,omitempty"`
+}
+
+func (m *ClusterMetadataChange) Reset()      { *m = ClusterMetadataChange{} }
+func (*ClusterMetadataChange) ProtoMessage() {}
+func (*ClusterMetadataChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f4771d63f405884, []int{1}
+}
+func (m *ClusterMetadataChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterMetadataChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterMetadataChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterMetadataChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterMetadataChange.Merge(m, src)
+}
+func (m *ClusterMetadataChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterMetadataChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterMetadataChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterMetadataChange proto.InternalMessageInfo
+
+func (m *ClusterMetadataChange) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ClusterMetadataChange) GetChangeTime() *time.Time {
+	if m != nil {
+		return m.ChangeTime
+	}
+	return nil
+}
+
+func (m *ClusterMetadataChange) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *ClusterMetadataChange) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *ClusterMetadataChange) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
+	}
+	return ""
+}
+
+func (m *ClusterMetadataChange) GetPreviousMetadata() *ClusterMetadata {
+	if m != nil {
+		return m.PreviousMetadata
+	}
+	return nil
+}
+
+func (m *ClusterMetadataChange) GetNewMetadata() *ClusterMetadata {
+	if m != nil {
+		return m.NewMetadata
+	}
+	return nil
+}
+
 type IndexSearchAttributes struct {
 	CustomSearchAttributes map[string]v11.IndexedValueType `protobuf:"bytes,1,rep,name=custom_search_attributes,json=customSearchAttributes,proto3" json:"custom_search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=temporal.api.enums.v1.IndexedValueType"`
 }
@@ -175,7 +285,7 @@ type IndexSearchAttributes struct {
 func (m *IndexSearchAttributes) Reset()      { *m = IndexSearchAttributes{} }
 func (*IndexSearchAttributes) ProtoMessage() {}
 func (*IndexSearchAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f4771d63f405884, []int{1}
+	return fileDescriptor_1f4771d63f405884, []int{2}
 }
 func (m *IndexSearchAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -214,6 +324,7 @@ func (m *IndexSearchAttributes) GetCustomSearchAttributes() map[string]v11.Index
 func init() {
 	proto.RegisterType((*ClusterMetadata)(nil), "temporal.server.api.persistence.v1.ClusterMetadata")
 	proto.RegisterMapType((map[string]*IndexSearchAttributes)(nil), "temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry")
+	proto.RegisterType((*ClusterMetadataChange)(nil), "temporal.server.api.persistence.v1.ClusterMetadataChange")
 	proto.RegisterType((*IndexSearchAttributes)(nil), "temporal.server.api.persistence.v1.IndexSearchAttributes")
 	proto.RegisterMapType((map[string]v11.IndexedValueType)(nil), "temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry")
 }
@@ -223,47 +334,59 @@ func init() {
 }
 
 var fileDescriptor_1f4771d63f405884 = []byte{
-	// 641 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0xd4, 0x40,
-	0x14, 0xdf, 0x61, 0x5d, 0x84, 0x59, 0x02, 0x3a, 0x04, 0x6c, 0x96, 0xd8, 0xac, 0x44, 0xc3, 0x9e,
-	0xba, 0x61, 0xf5, 0x00, 0x2a, 0x07, 0xdc, 0x20, 0xe1, 0x20, 0x26, 0x8b, 0x72, 0xf0, 0xd2, 0xcc,
-	0xb6, 0x0f, 0x18, 0x6d, 0x67, 0x9a, 0x99, 0xd9, 0xc6, 0xbd, 0x99, 0x98, 0x78, 0xd5, 0x2f, 0xe0,
-	0xdd, 0x8f, 0xe2, 0x91, 0x23, 0x47, 0x29, 0x17, 0x6f, 0xf2, 0x11, 0x4c, 0xa7, 0xed, 0xb2, 0x90,
-	0xa2, 0xc6, 0x5b, 0xe7, 0xfd, 0xfe, 0xcc, 0x9b, 0xdf, 0xbc, 0x0e, 0x5e, 0xd7, 0x10, 0x46, 0x42,
-	0xd2, 0xa0, 0xad, 0x40, 0xc6, 0x20, 0xdb, 0x34, 0x62, 0xed, 0x08, 0xa4, 0x62, 0x4a, 0x03, 0xf7,
-	0xa0, 0x1d, 0xaf, 0xb6, 0xbd, 0x60, 0xa0, 0x34, 0x48, 0x37, 0x04, 0x4d, 0x7d, 0xaa, 0xa9, 0x13,
-	0x49, 0xa1, 0x05, 0x59, 0x2e, 0xa4, 0x4e, 0x26, 0x75, 0x68, 0xc4, 0x9c, 0x31, 0xa9, 0x13, 0xaf,
-	0x36, 0x46, 0x1c, 0xe3, 0x0b, 0x7c, 0x10, 0x2a, 0xe3, 0x28, 0xc2, 0x50, 0xf0, 0xcc, 0xa7, 0xf1,
-	0xe0, 0x12, 0x27, 0x4e, 0x0d, 0x04, 0x4f, 0x59, 0x21, 0x28, 0x45, 0x0f, 0x21, 0xa3, 0x2d, 0xff,
-	0xaa, 0xe1, 0xb9, 0x6e, 0xd6, 0xc9, 0x8b, 0xbc, 0x11, 0x72, 0x0f, 0xcf, 0x14, 0xcd, 0x71, 0x1a,
-	0x82, 0x85, 0x9a, 0xa8, 0x35, 0xdd, 0xab, 0xe7, 0xb5, 0x5d, 0x1a, 0x02, 0x71, 0xf0, 0xfc, 0x11,
-	0x53, 0x5a, 0xc8, 0xa1, 0xab, 0x8e, 0xa8, 0xf4, 0x5d, 0x4f, 0x0c, 0xb8, 0xb6, 0x26, 0x9a, 0xa8,
-	0x55, 0xeb, 0xdd, 0xce, 0xa1, 0xbd, 0x14, 0xe9, 0xa6, 0x00, 0xb9, 0x8b, 0x71, 0x61, 0xc9, 0x7c,
-	0xab, 0x6a, 0x0c, 0xa7, 0xf3, 0xca, 0x8e, 0x4f, 0xb6, 0xf1, 0x4c, 0xde, 0xa1, 0xcb, 0xf8, 0x81,
-	0xb0, 0x6e, 0x34, 0x51, 0xab, 0xde, 0xb9, 0xef, 0x8c, 0xb2, 0x48, 0x43, 0xc8, 0x19, 0x4e, 0xbc,
-	0xea, 0xec, 0x67, 0x9f, 0x3b, 0xfc, 0x40, 0xf4, 0xea, 0xf1, 0xc5, 0x82, 0x7c, 0x42, 0xf8, 0x0e,
-	0xe3, 0x3e, 0xbc, 0x77, 0x15, 0x50, 0xe9, 0x1d, 0xb9, 0x54, 0x6b, 0xc9, 0xfa, 0x03, 0x0d, 0xca,
-	0xaa, 0x35, 0xab, 0xad, 0x7a, 0x67, 0xd7, 0xf9, 0x7b, 0xc0, 0xce, 0x95, 0x44, 0x9c, 0x9d, 0xd4,
-	0x72, 0xcf, 0x38, 0x6e, 0x8e, 0x0c, 0xb7, 0xb8, 0x96, 0xc3, 0xde, 0x02, 0x2b, 0xc3, 0xc8, 0x0a,
-	0x9e, 0x2b, 0x0e, 0x4c, 0x7d, 0x5f, 0x82, 0x52, 0xd6, 0xa4, 0x39, 0xf5, 0x6c, 0x5e, 0xde, 0xcc,
-	0xaa, 0xe4, 0x29, 0x6e, 0x1c, 0x50, 0x16, 0x88, 0x18, 0xa4, 0x7b, 0x91, 0x81, 0x27, 0x21, 0x04,
-	0xae, 0xad, 0x9b, 0x4d, 0xd4, 0xaa, 0xf6, 0xac, 0x82, 0x31, 0x3a, 0x77, 0x8e, 0x93, 0x35, 0x6c,
-	0x31, 0xce, 0x34, 0xa3, 0x81, 0x7b, 0xd5, 0xc5, 0x9a, 0x32, 0xda, 0xc5, 0x1c, 0x7f, 0x7e, 0xd9,
-	0x82, 0x6c, 0xe0, 0x25, 0xa6, 0xdc, 0xc3, 0x40, 0xf4, 0x69, 0x60, 0xae, 0x59, 0x45, 0xd4, 0x03,
-	0x17, 0x38, 0xed, 0x07, 0xe0, 0x5b, 0xd3, 0x4d, 0xd4, 0x9a, 0xea, 0x59, 0x4c, 0x6d, 0x1b, 0xc6,
-	0x6e, 0x41, 0xd8, 0xca, 0x70, 0xd2, 0xc1, 0x0b, 0x4c, 0xb9, 0x9e, 0xe0, 0x1c, 0x3c, 0x9d, 0xf6,
-	0x5c, 0x08, 0xb1, 0x11, 0xce, 0x33, 0xd5, 0x1d, 0x61, 0xb9, 0xa6, 0xf1, 0x11, 0xe1, 0xc6, 0xf5,
-	0x49, 0x92, 0x5b, 0xb8, 0xfa, 0x0e, 0x86, 0xf9, 0xb4, 0xa5, 0x9f, 0xe4, 0x25, 0xae, 0xc5, 0x34,
-	0x18, 0x80, 0x99, 0xab, 0x7a, 0x67, 0xfd, 0x5f, 0xae, 0xae, 0x74, 0x83, 0x5e, 0xe6, 0xf3, 0x78,
-	0x62, 0x0d, 0x2d, 0x7f, 0x9d, 0xc0, 0x0b, 0xa5, 0x24, 0xf2, 0x19, 0x61, 0xcb, 0x1b, 0x28, 0x2d,
-	0xc2, 0x92, 0xe9, 0x41, 0x66, 0x7a, 0x5e, 0xff, 0x77, 0x0b, 0x4e, 0xd7, 0x38, 0x97, 0x0f, 0xd1,
-	0xa2, 0x57, 0x0a, 0x36, 0x24, 0x5e, 0xfa, 0x83, 0xac, 0x24, 0xb1, 0x8d, 0xf1, 0xc4, 0x66, 0x3b,
-	0x2b, 0x97, 0xff, 0x20, 0xf3, 0x52, 0x8c, 0x3a, 0x04, 0x7f, 0x3f, 0xa5, 0xbe, 0x1a, 0x46, 0x30,
-	0x96, 0xcf, 0xb3, 0xb7, 0xc7, 0xa7, 0x76, 0xe5, 0xe4, 0xd4, 0xae, 0x9c, 0x9f, 0xda, 0xe8, 0x43,
-	0x62, 0xa3, 0x6f, 0x89, 0x8d, 0xbe, 0x27, 0x36, 0x3a, 0x4e, 0x6c, 0xf4, 0x23, 0xb1, 0xd1, 0xcf,
-	0xc4, 0xae, 0x9c, 0x27, 0x36, 0xfa, 0x72, 0x66, 0x57, 0x8e, 0xcf, 0xec, 0xca, 0xc9, 0x99, 0x5d,
-	0x79, 0xf3, 0xe8, 0x50, 0x5c, 0xec, 0xc5, 0xc4, 0xf5, 0xef, 0xde, 0x93, 0xb1, 0x65, 0x7f, 0xd2,
-	0x3c, 0x42, 0x0f, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xd6, 0xa5, 0x06, 0xa7, 0x30, 0x05, 0x00,
+	// 833 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x3f, 0x73, 0x1b, 0x45,
+	0x14, 0xd7, 0x5a, 0xf1, 0x1f, 0xad, 0x8c, 0x93, 0x6c, 0xc6, 0xe1, 0x46, 0x81, 0x8b, 0xe2, 0x81,
+	0x89, 0xaa, 0xd5, 0x58, 0xa1, 0x48, 0x80, 0x14, 0x8e, 0x26, 0x04, 0x17, 0x98, 0x99, 0x4b, 0x70,
+	0x41, 0x73, 0x59, 0xdd, 0x3d, 0xcb, 0x0b, 0x77, 0xbb, 0x37, 0xbb, 0xab, 0x0b, 0xea, 0x98, 0x61,
+	0x86, 0x86, 0x82, 0x7c, 0x01, 0x7a, 0x3e, 0x0a, 0xa5, 0xe9, 0xd2, 0x81, 0xe5, 0x86, 0x32, 0x1f,
+	0x81, 0xb9, 0xbd, 0x3d, 0xc9, 0x4e, 0xce, 0x90, 0x51, 0x77, 0xfb, 0xfe, 0xfc, 0xf6, 0xbd, 0xf7,
+	0xfb, 0xed, 0x3b, 0xfc, 0xc0, 0x40, 0x9a, 0x49, 0xc5, 0x92, 0xbe, 0x06, 0x95, 0x83, 0xea, 0xb3,
+	0x8c, 0xf7, 0x33, 0x50, 0x9a, 0x6b, 0x03, 0x22, 0x82, 0x7e, 0xbe, 0xdb, 0x8f, 0x92, 0x89, 0x36,
+	0xa0, 0xc2, 0x14, 0x0c, 0x8b, 0x99, 0x61, 0x34, 0x53, 0xd2, 0x48, 0xb2, 0x53, 0xa5, 0xd2, 0x32,
+	0x95, 0xb2, 0x8c, 0xd3, 0x73, 0xa9, 0x34, 0xdf, 0xed, 0xdc, 0x1e, 0x4b, 0x39, 0x4e, 0xa0, 0x6f,
+	0x33, 0x46, 0x93, 0xa3, 0xbe, 0xe1, 0x29, 0x68, 0xc3, 0xd2, 0xac, 0x04, 0xe9, 0xdc, 0x89, 0x21,
+	0x03, 0x11, 0x83, 0x88, 0x38, 0xe8, 0xfe, 0x58, 0x8e, 0xa5, 0xb5, 0xdb, 0x2f, 0x17, 0x32, 0xbf,
+	0xc7, 0xd6, 0x06, 0x62, 0x92, 0x6a, 0x5b, 0x95, 0x4c, 0x53, 0x29, 0x5c, 0xcc, 0xc7, 0x17, 0x62,
+	0xf2, 0xa2, 0x08, 0x29, 0x8a, 0xa8, 0x14, 0xb4, 0x66, 0x63, 0x28, 0xc3, 0x76, 0xfe, 0x5c, 0xc3,
+	0x57, 0x87, 0x65, 0x37, 0x5f, 0xb9, 0x66, 0xc8, 0x1d, 0xbc, 0x59, 0x35, 0x28, 0x58, 0x0a, 0x1e,
+	0xea, 0xa2, 0x5e, 0x2b, 0x68, 0x3b, 0xdb, 0x01, 0x4b, 0x81, 0x50, 0x7c, 0xe3, 0x98, 0x6b, 0x23,
+	0xd5, 0x34, 0xd4, 0xc7, 0x4c, 0xc5, 0x61, 0x24, 0x27, 0xc2, 0x78, 0x2b, 0x5d, 0xd4, 0x5b, 0x0d,
+	0xae, 0x3b, 0xd7, 0xd3, 0xc2, 0x33, 0x2c, 0x1c, 0xe4, 0x43, 0x8c, 0x2b, 0x48, 0x1e, 0x7b, 0x4d,
+	0x0b, 0xd8, 0x72, 0x96, 0xfd, 0x98, 0x3c, 0xc1, 0x9b, 0xae, 0xc2, 0x90, 0x8b, 0x23, 0xe9, 0x5d,
+	0xe9, 0xa2, 0x5e, 0x7b, 0xf0, 0x11, 0x9d, 0xcf, 0xb3, 0x18, 0xa4, 0x8b, 0xa0, 0xf9, 0x2e, 0x3d,
+	0x2c, 0x3f, 0xf7, 0xc5, 0x91, 0x0c, 0xda, 0xf9, 0xe2, 0x40, 0x7e, 0x46, 0xf8, 0x7d, 0x2e, 0x62,
+	0xf8, 0x21, 0xd4, 0xc0, 0x54, 0x74, 0x1c, 0x32, 0x63, 0x14, 0x1f, 0x4d, 0x0c, 0x68, 0x6f, 0xb5,
+	0xdb, 0xec, 0xb5, 0x07, 0x07, 0xf4, 0xff, 0x49, 0xa2, 0x6f, 0x4c, 0x84, 0xee, 0x17, 0x90, 0x4f,
+	0x2d, 0xe2, 0xde, 0x1c, 0xf0, 0xb1, 0x30, 0x6a, 0x1a, 0x6c, 0xf3, 0x3a, 0x1f, 0xb9, 0x8b, 0xaf,
+	0x56, 0x0d, 0xb3, 0x38, 0x56, 0xa0, 0xb5, 0xb7, 0x66, 0xbb, 0xde, 0x72, 0xe6, 0xbd, 0xd2, 0x4a,
+	0x3e, 0xc7, 0x9d, 0x23, 0xc6, 0x13, 0x99, 0x83, 0x0a, 0x17, 0x33, 0x88, 0x14, 0xa4, 0x20, 0x8c,
+	0xb7, 0xde, 0x45, 0xbd, 0x66, 0xe0, 0x55, 0x11, 0xf3, 0xbe, 0x9d, 0x9f, 0xdc, 0xc7, 0x1e, 0x17,
+	0xdc, 0x70, 0x96, 0x84, 0x6f, 0xa2, 0x78, 0x1b, 0x36, 0xf7, 0xa6, 0xf3, 0x7f, 0x71, 0x11, 0x82,
+	0x3c, 0xc4, 0xb7, 0xb8, 0x0e, 0xc7, 0x89, 0x1c, 0xb1, 0xc4, 0xd2, 0xac, 0x33, 0x16, 0x41, 0x08,
+	0x82, 0x8d, 0x12, 0x88, 0xbd, 0x56, 0x17, 0xf5, 0x36, 0x02, 0x8f, 0xeb, 0x27, 0x36, 0xe2, 0xa0,
+	0x0a, 0x78, 0x5c, 0xfa, 0xc9, 0x00, 0x6f, 0x73, 0x1d, 0x46, 0x52, 0x08, 0x88, 0x4c, 0x51, 0x73,
+	0x95, 0x88, 0x6d, 0xe2, 0x0d, 0xae, 0x87, 0x73, 0x5f, 0x95, 0xf3, 0x1c, 0x6f, 0x45, 0xc7, 0x4c,
+	0x8c, 0x21, 0x74, 0x02, 0xf1, 0xda, 0x96, 0x92, 0x07, 0x4b, 0x50, 0x32, 0xb4, 0x40, 0xc1, 0x7b,
+	0x25, 0xe0, 0x97, 0x25, 0x5e, 0xe7, 0x27, 0x84, 0x3b, 0x97, 0x73, 0x45, 0xae, 0xe1, 0xe6, 0xf7,
+	0x30, 0x75, 0x7a, 0x2e, 0x3e, 0xc9, 0xd7, 0x78, 0x35, 0x67, 0xc9, 0x04, 0xac, 0x72, 0xdf, 0xb1,
+	0x92, 0xda, 0x0b, 0x82, 0x12, 0xe7, 0xd3, 0x95, 0xfb, 0x68, 0xe7, 0x97, 0x26, 0xde, 0xae, 0x2d,
+	0x97, 0x78, 0x78, 0xbd, 0x62, 0x07, 0x59, 0x76, 0xaa, 0x23, 0xd9, 0xc3, 0x6d, 0x37, 0x9b, 0x62,
+	0x1f, 0xb8, 0x72, 0x3a, 0xb4, 0x5c, 0x16, 0xb4, 0x5a, 0x16, 0xf4, 0x59, 0xb5, 0x2c, 0x1e, 0x5d,
+	0x79, 0xf9, 0xd7, 0x6d, 0x14, 0xe0, 0x32, 0xa9, 0x30, 0x93, 0x0e, 0xde, 0xe0, 0x31, 0x08, 0xc3,
+	0xcd, 0xd4, 0xbd, 0xb0, 0xf9, 0x99, 0x7c, 0x80, 0x5b, 0x32, 0x03, 0xc5, 0x0a, 0x3a, 0xec, 0xeb,
+	0x6a, 0x05, 0x0b, 0xc3, 0x5b, 0x0f, 0x7e, 0xf5, 0xed, 0x07, 0xff, 0x1c, 0x5f, 0xcf, 0x14, 0xe4,
+	0x5c, 0x4e, 0xf4, 0x7c, 0xeb, 0x59, 0x45, 0xb7, 0x07, 0xf7, 0x96, 0xa0, 0x2f, 0xb8, 0x56, 0xa1,
+	0x55, 0x16, 0x72, 0x88, 0x37, 0x05, 0xbc, 0x58, 0x80, 0xaf, 0x2f, 0x0f, 0xde, 0x16, 0xf0, 0xa2,
+	0x3a, 0xec, 0xfc, 0xb6, 0x82, 0xb7, 0x6b, 0x29, 0x23, 0xbf, 0x22, 0xec, 0x45, 0x13, 0x6d, 0x64,
+	0x5a, 0xb3, 0x2d, 0x90, 0x95, 0xe6, 0x37, 0x4b, 0x0b, 0x82, 0x0e, 0x2d, 0x72, 0xfd, 0xd2, 0xb8,
+	0x19, 0xd5, 0x3a, 0x3b, 0x0a, 0xdf, 0xfa, 0x8f, 0xb4, 0x1a, 0xfd, 0x3e, 0x3c, 0xaf, 0xdf, 0xad,
+	0xc1, 0xdd, 0x8b, 0x1b, 0xd3, 0xfe, 0x19, 0xe6, 0x15, 0x42, 0x7c, 0x58, 0x84, 0x3e, 0x9b, 0x66,
+	0x70, 0x4e, 0xad, 0x8f, 0xbe, 0x3b, 0x39, 0xf5, 0x1b, 0xaf, 0x4e, 0xfd, 0xc6, 0xeb, 0x53, 0x1f,
+	0xfd, 0x38, 0xf3, 0xd1, 0xef, 0x33, 0x1f, 0xfd, 0x31, 0xf3, 0xd1, 0xc9, 0xcc, 0x47, 0x7f, 0xcf,
+	0x7c, 0xf4, 0xcf, 0xcc, 0x6f, 0xbc, 0x9e, 0xf9, 0xe8, 0xe5, 0x99, 0xdf, 0x38, 0x39, 0xf3, 0x1b,
+	0xaf, 0xce, 0xfc, 0xc6, 0xb7, 0x9f, 0x8c, 0xe5, 0xe2, 0x2e, 0x2e, 0x2f, 0xff, 0x57, 0x7e, 0x76,
+	0xee, 0x38, 0x5a, 0xb3, 0x42, 0xbe, 0xf7, 0xef, 0x00, 0x52, 0x4a, 0x26, 0xbc, 0x64, 0x07, 0x00,
 	0x00,
 }
 
@@ -321,6 +444,60 @@ func (this *ClusterMetadata) Equal(that interface{}) bool {
 	if this.IsConnectionEnabled != that1.IsConnectionEnabled {
 		return false
 	}
+	if len(this.ChangeHistory) != len(that1.ChangeHistory) {
+		return false
+	}
+	for i := range this.ChangeHistory {
+		if !this.ChangeHistory[i].Equal(that1.ChangeHistory[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ClusterMetadataChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ClusterMetadataChange)
+	if !ok {
+		that2, ok := that.(ClusterMetadataChange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if that1.ChangeTime == nil {
+		if this.ChangeTime != nil {
+			return false
+		}
+	} else if !this.ChangeTime.Equal(*that1.ChangeTime) {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	if this.Operation != that1.Operation {
+		return false
+	}
+	if this.ClusterName != that1.ClusterName {
+		return false
+	}
+	if !this.PreviousMetadata.Equal(that1.PreviousMetadata) {
+		return false
+	}
+	if !this.NewMetadata.Equal(that1.NewMetadata) {
+		return false
+	}
 	return true
 }
 func (this *IndexSearchAttributes) Equal(that interface{}) bool {
@@ -356,7 +533,7 @@ func (this *ClusterMetadata) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 14)
+	s := make([]string, 0, 15)
 	s = append(s, "&persistence.ClusterMetadata{")
 	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
 	s = append(s, "HistoryShardCount: "+fmt.Sprintf("%#v", this.HistoryShardCount)+",\n")
@@ -382,6 +559,29 @@ func (this *ClusterMetadata) GoString() string {
 	s = append(s, "InitialFailoverVersion: "+fmt.Sprintf("%#v", this.InitialFailoverVersion)+",\n")
 	s = append(s, "IsGlobalNamespaceEnabled: "+fmt.Sprintf("%#v", this.IsGlobalNamespaceEnabled)+",\n")
 	s = append(s, "IsConnectionEnabled: "+fmt.Sprintf("%#v", this.IsConnectionEnabled)+",\n")
+	if this.ChangeHistory != nil {
+		s = append(s, "ChangeHistory: "+fmt.Sprintf("%#v", this.ChangeHistory)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ClusterMetadataChange) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&persistence.ClusterMetadataChange{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "ChangeTime: "+fmt.Sprintf("%#v", this.ChangeTime)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "Operation: "+fmt.Sprintf("%#v", this.Operation)+",\n")
+	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
+	if this.PreviousMetadata != nil {
+		s = append(s, "PreviousMetadata: "+fmt.Sprintf("%#v", this.PreviousMetadata)+",\n")
+	}
+	if this.NewMetadata != nil {
+		s = append(s, "NewMetadata: "+fmt.Sprintf("%#v", this.NewMetadata)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -435,6 +635,20 @@ func (m *ClusterMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChangeHistory) > 0 {
+		for iNdEx := len(m.ChangeHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChangeHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClusterMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if m.IsConnectionEnabled {
 		i--
 		if m.IsConnectionEnabled {
@@ -532,6 +746,89 @@ func (m *ClusterMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClusterMetadataChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterMetadataChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterMetadataChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewMetadata != nil {
+		{
+			size, err := m.NewMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PreviousMetadata != nil {
+		{
+			size, err := m.PreviousMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Operation) > 0 {
+		i -= len(m.Operation)
+		copy(dAtA[i:], m.Operation)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.Operation)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChangeTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ChangeTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ChangeTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *IndexSearchAttributes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -633,6 +930,48 @@ func (m *ClusterMetadata) Size() (n int) {
 	if m.IsConnectionEnabled {
 		n += 2
 	}
+	if len(m.ChangeHistory) > 0 {
+		for _, e := range m.ChangeHistory {
+			l = e.Size()
+			n += 1 + l + sovClusterMetadata(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClusterMetadataChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovClusterMetadata(uint64(m.Version))
+	}
+	if m.ChangeTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ChangeTime)
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	l = len(m.Operation)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	if m.PreviousMetadata != nil {
+		l = m.PreviousMetadata.Size()
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	if m.NewMetadata != nil {
+		l = m.NewMetadata.Size()
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
 	return n
 }
 
@@ -663,6 +1002,11 @@ func (this *ClusterMetadata) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForChangeHistory := "[]*ClusterMetadataChange{"
+	for _, f := range this.ChangeHistory {
+		repeatedStringForChangeHistory += strings.Replace(f.String(), "ClusterMetadataChange", "ClusterMetadataChange", 1) + ","
+	}
+	repeatedStringForChangeHistory += "}"
 	keysForIndexSearchAttributes := make([]string, 0, len(this.IndexSearchAttributes))
 	for k, _ := range this.IndexSearchAttributes {
 		keysForIndexSearchAttributes = append(keysForIndexSearchAttributes, k)
@@ -684,6 +1028,23 @@ func (this *ClusterMetadata) String() string {
 		`InitialFailoverVersion:` + fmt.Sprintf("%v", this.InitialFailoverVersion) + `,`,
 		`IsGlobalNamespaceEnabled:` + fmt.Sprintf("%v", this.IsGlobalNamespaceEnabled) + `,`,
 		`IsConnectionEnabled:` + fmt.Sprintf("%v", this.IsConnectionEnabled) + `,`,
+		`ChangeHistory:` + repeatedStringForChangeHistory + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterMetadataChange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterMetadataChange{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`ChangeTime:` + strings.Replace(fmt.Sprintf("%v", this.ChangeTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`Operation:` + fmt.Sprintf("%v", this.Operation) + `,`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`PreviousMetadata:` + strings.Replace(this.PreviousMetadata.String(), "ClusterMetadata", "ClusterMetadata", 1) + `,`,
+		`NewMetadata:` + strings.Replace(this.NewMetadata.String(), "ClusterMetadata", "ClusterMetadata", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1103,6 +1464,316 @@ func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsConnectionEnabled = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeHistory = append(m.ChangeHistory, &ClusterMetadataChange{})
+			if err := m.ChangeHistory[len(m.ChangeHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterMetadataChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterMetadataChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterMetadataChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangeTime == nil {
+				m.ChangeTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ChangeTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousMetadata == nil {
+				m.PreviousMetadata = &ClusterMetadata{}
+			}
+			if err := m.PreviousMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewMetadata == nil {
+				m.NewMetadata = &ClusterMetadata{}
+			}
+			if err := m.NewMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
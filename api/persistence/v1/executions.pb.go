@@ -44,11 +44,11 @@ import (
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v11 "go.temporal.io/api/common/v1"
-	v14 "go.temporal.io/api/enums/v1"
+	v13 "go.temporal.io/api/enums/v1"
 	v15 "go.temporal.io/api/failure/v1"
 	_ "go.temporal.io/api/history/v1"
 	v1 "go.temporal.io/api/workflow/v1"
-	v13 "go.temporal.io/server/api/enums/v1"
+	v14 "go.temporal.io/server/api/enums/v1"
 	v12 "go.temporal.io/server/api/history/v1"
 )
 
@@ -287,6 +287,10 @@ type WorkflowExecutionInfo struct {
 	ExecutionTime        *time.Time `protobuf:"bytes,60,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time,omitempty"`
 	// If continued-as-new, or retried, or cron, holds the new run id.
 	NewExecutionRunId string `protobuf:"bytes,61,opt,name=new_execution_run_id,json=newExecutionRunId,proto3" json:"new_execution_run_id,omitempty"`
+	// Set when workflow tasks are no longer scheduled because of repeated workflow task failures.
+	WorkflowTaskPaused bool `protobuf:"varint,62,opt,name=workflow_task_paused,json=workflowTaskPaused,proto3" json:"workflow_task_paused,omitempty"`
+	// Most recent conflict resolutions which reapplied events of a losing branch to this workflow.
+	ConflictResolutionRecords []*ConflictResolutionRecord `protobuf:"bytes,63,rep,name=conflict_resolution_records,json=conflictResolutionRecords,proto3" json:"conflict_resolution_records,omitempty"`
 }
 
 func (m *WorkflowExecutionInfo) Reset()      { *m = WorkflowExecutionInfo{} }
@@ -678,6 +682,173 @@ func (m *WorkflowExecutionInfo) GetNewExecutionRunId() string {
 	return ""
 }
 
+func (m *WorkflowExecutionInfo) GetWorkflowTaskPaused() bool {
+	if m != nil {
+		return m.WorkflowTaskPaused
+	}
+	return false
+}
+
+func (m *WorkflowExecutionInfo) GetConflictResolutionRecords() []*ConflictResolutionRecord {
+	if m != nil {
+		return m.ConflictResolutionRecords
+	}
+	return nil
+}
+
+// Records the reapplication of events from a branch which lost nDC conflict resolution.
+type ConflictResolutionRecord struct {
+	ResolveTime *time.Time `protobuf:"bytes,1,opt,name=resolve_time,json=resolveTime,proto3,stdtime" json:"resolve_time,omitempty"`
+	// Run id of the losing branch events.
+	SourceRunId     string                     `protobuf:"bytes,2,opt,name=source_run_id,json=sourceRunId,proto3" json:"source_run_id,omitempty"`
+	LosingVersion   int64                      `protobuf:"varint,3,opt,name=losing_version,json=losingVersion,proto3" json:"losing_version,omitempty"`
+	WinningVersion  int64                      `protobuf:"varint,4,opt,name=winning_version,json=winningVersion,proto3" json:"winning_version,omitempty"`
+	ReappliedEvents []*ConflictResolutionEvent `protobuf:"bytes,5,rep,name=reapplied_events,json=reappliedEvents,proto3" json:"reapplied_events,omitempty"`
+	// At most the first 100 dropped events are kept, dropped_event_count is the number of all dropped events.
+	DroppedEvents     []*ConflictResolutionEvent `protobuf:"bytes,6,rep,name=dropped_events,json=droppedEvents,proto3" json:"dropped_events,omitempty"`
+	DroppedEventCount int64                      `protobuf:"varint,7,opt,name=dropped_event_count,json=droppedEventCount,proto3" json:"dropped_event_count,omitempty"`
+}
+
+func (m *ConflictResolutionRecord) Reset()      { *m = ConflictResolutionRecord{} }
+func (*ConflictResolutionRecord) ProtoMessage() {}
+func (*ConflictResolutionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67a714d0e7ba9f37, []int{2}
+}
+func (m *ConflictResolutionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConflictResolutionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConflictResolutionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConflictResolutionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConflictResolutionRecord.Merge(m, src)
+}
+func (m *ConflictResolutionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConflictResolutionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConflictResolutionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConflictResolutionRecord proto.InternalMessageInfo
+
+func (m *ConflictResolutionRecord) GetResolveTime() *time.Time {
+	if m != nil {
+		return m.ResolveTime
+	}
+	return nil
+}
+
+func (m *ConflictResolutionRecord) GetSourceRunId() string {
+	if m != nil {
+		return m.SourceRunId
+	}
+	return ""
+}
+
+func (m *ConflictResolutionRecord) GetLosingVersion() int64 {
+	if m != nil {
+		return m.LosingVersion
+	}
+	return 0
+}
+
+func (m *ConflictResolutionRecord) GetWinningVersion() int64 {
+	if m != nil {
+		return m.WinningVersion
+	}
+	return 0
+}
+
+func (m *ConflictResolutionRecord) GetReappliedEvents() []*ConflictResolutionEvent {
+	if m != nil {
+		return m.ReappliedEvents
+	}
+	return nil
+}
+
+func (m *ConflictResolutionRecord) GetDroppedEvents() []*ConflictResolutionEvent {
+	if m != nil {
+		return m.DroppedEvents
+	}
+	return nil
+}
+
+func (m *ConflictResolutionRecord) GetDroppedEventCount() int64 {
+	if m != nil {
+		return m.DroppedEventCount
+	}
+	return 0
+}
+
+type ConflictResolutionEvent struct {
+	EventId   int64         `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Version   int64         `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	EventType v13.EventType `protobuf:"varint,3,opt,name=event_type,json=eventType,proto3,enum=temporal.api.enums.v1.EventType" json:"event_type,omitempty"`
+}
+
+func (m *ConflictResolutionEvent) Reset()      { *m = ConflictResolutionEvent{} }
+func (*ConflictResolutionEvent) ProtoMessage() {}
+func (*ConflictResolutionEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67a714d0e7ba9f37, []int{3}
+}
+func (m *ConflictResolutionEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConflictResolutionEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConflictResolutionEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConflictResolutionEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConflictResolutionEvent.Merge(m, src)
+}
+func (m *ConflictResolutionEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConflictResolutionEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConflictResolutionEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConflictResolutionEvent proto.InternalMessageInfo
+
+func (m *ConflictResolutionEvent) GetEventId() int64 {
+	if m != nil {
+		return m.EventId
+	}
+	return 0
+}
+
+func (m *ConflictResolutionEvent) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ConflictResolutionEvent) GetEventType() v13.EventType {
+	if m != nil {
+		return m.EventType
+	}
+	return v13.EVENT_TYPE_UNSPECIFIED
+}
+
 type ExecutionStats struct {
 	HistorySize int64 `protobuf:"varint,1,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
 }
@@ -685,7 +856,7 @@ type ExecutionStats struct {
 func (m *ExecutionStats) Reset()      { *m = ExecutionStats{} }
 func (*ExecutionStats) ProtoMessage() {}
 func (*ExecutionStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{2}
+	return fileDescriptor_67a714d0e7ba9f37, []int{4}
 }
 func (m *ExecutionStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -725,14 +896,14 @@ func (m *ExecutionStats) GetHistorySize() int64 {
 type WorkflowExecutionState struct {
 	CreateRequestId string                      `protobuf:"bytes,1,opt,name=create_request_id,json=createRequestId,proto3" json:"create_request_id,omitempty"`
 	RunId           string                      `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	State           v13.WorkflowExecutionState  `protobuf:"varint,3,opt,name=state,proto3,enum=temporal.server.api.enums.v1.WorkflowExecutionState" json:"state,omitempty"`
-	Status          v14.WorkflowExecutionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"status,omitempty"`
+	State           v14.WorkflowExecutionState  `protobuf:"varint,3,opt,name=state,proto3,enum=temporal.server.api.enums.v1.WorkflowExecutionState" json:"state,omitempty"`
+	Status          v13.WorkflowExecutionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"status,omitempty"`
 }
 
 func (m *WorkflowExecutionState) Reset()      { *m = WorkflowExecutionState{} }
 func (*WorkflowExecutionState) ProtoMessage() {}
 func (*WorkflowExecutionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{3}
+	return fileDescriptor_67a714d0e7ba9f37, []int{5}
 }
 func (m *WorkflowExecutionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,18 +946,18 @@ func (m *WorkflowExecutionState) GetRunId() string {
 	return ""
 }
 
-func (m *WorkflowExecutionState) GetState() v13.WorkflowExecutionState {
+func (m *WorkflowExecutionState) GetState() v14.WorkflowExecutionState {
 	if m != nil {
 		return m.State
 	}
-	return v13.WORKFLOW_EXECUTION_STATE_UNSPECIFIED
+	return v14.WORKFLOW_EXECUTION_STATE_UNSPECIFIED
 }
 
-func (m *WorkflowExecutionState) GetStatus() v14.WorkflowExecutionStatus {
+func (m *WorkflowExecutionState) GetStatus() v13.WorkflowExecutionStatus {
 	if m != nil {
 		return m.Status
 	}
-	return v14.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED
+	return v13.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED
 }
 
 // transfer column
@@ -794,7 +965,7 @@ type TransferTaskInfo struct {
 	NamespaceId             string       `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowId              string       `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
 	RunId                   string       `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	TaskType                v13.TaskType `protobuf:"varint,4,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
+	TaskType                v14.TaskType `protobuf:"varint,4,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
 	TargetNamespaceId       string       `protobuf:"bytes,5,opt,name=target_namespace_id,json=targetNamespaceId,proto3" json:"target_namespace_id,omitempty"`
 	TargetWorkflowId        string       `protobuf:"bytes,6,opt,name=target_workflow_id,json=targetWorkflowId,proto3" json:"target_workflow_id,omitempty"`
 	TargetRunId             string       `protobuf:"bytes,7,opt,name=target_run_id,json=targetRunId,proto3" json:"target_run_id,omitempty"`
@@ -809,7 +980,7 @@ type TransferTaskInfo struct {
 func (m *TransferTaskInfo) Reset()      { *m = TransferTaskInfo{} }
 func (*TransferTaskInfo) ProtoMessage() {}
 func (*TransferTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{4}
+	return fileDescriptor_67a714d0e7ba9f37, []int{6}
 }
 func (m *TransferTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,11 +1030,11 @@ func (m *TransferTaskInfo) GetRunId() string {
 	return ""
 }
 
-func (m *TransferTaskInfo) GetTaskType() v13.TaskType {
+func (m *TransferTaskInfo) GetTaskType() v14.TaskType {
 	if m != nil {
 		return m.TaskType
 	}
-	return v13.TASK_TYPE_UNSPECIFIED
+	return v14.TASK_TYPE_UNSPECIFIED
 }
 
 func (m *TransferTaskInfo) GetTargetNamespaceId() string {
@@ -934,7 +1105,7 @@ type ReplicationTaskInfo struct {
 	NamespaceId       string       `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowId        string       `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
 	RunId             string       `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	TaskType          v13.TaskType `protobuf:"varint,4,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
+	TaskType          v14.TaskType `protobuf:"varint,4,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
 	Version           int64        `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
 	FirstEventId      int64        `protobuf:"varint,6,opt,name=first_event_id,json=firstEventId,proto3" json:"first_event_id,omitempty"`
 	NextEventId       int64        `protobuf:"varint,7,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
@@ -948,7 +1119,7 @@ type ReplicationTaskInfo struct {
 func (m *ReplicationTaskInfo) Reset()      { *m = ReplicationTaskInfo{} }
 func (*ReplicationTaskInfo) ProtoMessage() {}
 func (*ReplicationTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{5}
+	return fileDescriptor_67a714d0e7ba9f37, []int{7}
 }
 func (m *ReplicationTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -998,11 +1169,11 @@ func (m *ReplicationTaskInfo) GetRunId() string {
 	return ""
 }
 
-func (m *ReplicationTaskInfo) GetTaskType() v13.TaskType {
+func (m *ReplicationTaskInfo) GetTaskType() v14.TaskType {
 	if m != nil {
 		return m.TaskType
 	}
-	return v13.TASK_TYPE_UNSPECIFIED
+	return v14.TASK_TYPE_UNSPECIFIED
 }
 
 func (m *ReplicationTaskInfo) GetVersion() int64 {
@@ -1066,7 +1237,7 @@ type VisibilityTaskInfo struct {
 	NamespaceId    string       `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowId     string       `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
 	RunId          string       `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	TaskType       v13.TaskType `protobuf:"varint,4,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
+	TaskType       v14.TaskType `protobuf:"varint,4,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
 	Version        int64        `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
 	TaskId         int64        `protobuf:"varint,6,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	VisibilityTime *time.Time   `protobuf:"bytes,7,opt,name=visibility_time,json=visibilityTime,proto3,stdtime" json:"visibility_time,omitempty"`
@@ -1077,7 +1248,7 @@ type VisibilityTaskInfo struct {
 func (m *VisibilityTaskInfo) Reset()      { *m = VisibilityTaskInfo{} }
 func (*VisibilityTaskInfo) ProtoMessage() {}
 func (*VisibilityTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{6}
+	return fileDescriptor_67a714d0e7ba9f37, []int{8}
 }
 func (m *VisibilityTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1127,11 +1298,11 @@ func (m *VisibilityTaskInfo) GetRunId() string {
 	return ""
 }
 
-func (m *VisibilityTaskInfo) GetTaskType() v13.TaskType {
+func (m *VisibilityTaskInfo) GetTaskType() v14.TaskType {
 	if m != nil {
 		return m.TaskType
 	}
-	return v13.TASK_TYPE_UNSPECIFIED
+	return v14.TASK_TYPE_UNSPECIFIED
 }
 
 func (m *VisibilityTaskInfo) GetVersion() int64 {
@@ -1174,9 +1345,9 @@ type TimerTaskInfo struct {
 	NamespaceId         string                  `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowId          string                  `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
 	RunId               string                  `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	TaskType            v13.TaskType            `protobuf:"varint,4,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
-	TimeoutType         v14.TimeoutType         `protobuf:"varint,5,opt,name=timeout_type,json=timeoutType,proto3,enum=temporal.api.enums.v1.TimeoutType" json:"timeout_type,omitempty"`
-	WorkflowBackoffType v13.WorkflowBackoffType `protobuf:"varint,6,opt,name=workflow_backoff_type,json=workflowBackoffType,proto3,enum=temporal.server.api.enums.v1.WorkflowBackoffType" json:"workflow_backoff_type,omitempty"`
+	TaskType            v14.TaskType            `protobuf:"varint,4,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
+	TimeoutType         v13.TimeoutType         `protobuf:"varint,5,opt,name=timeout_type,json=timeoutType,proto3,enum=temporal.api.enums.v1.TimeoutType" json:"timeout_type,omitempty"`
+	WorkflowBackoffType v14.WorkflowBackoffType `protobuf:"varint,6,opt,name=workflow_backoff_type,json=workflowBackoffType,proto3,enum=temporal.server.api.enums.v1.WorkflowBackoffType" json:"workflow_backoff_type,omitempty"`
 	Version             int64                   `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
 	ScheduleAttempt     int32                   `protobuf:"varint,8,opt,name=schedule_attempt,json=scheduleAttempt,proto3" json:"schedule_attempt,omitempty"`
 	EventId             int64                   `protobuf:"varint,9,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
@@ -1188,7 +1359,7 @@ type TimerTaskInfo struct {
 func (m *TimerTaskInfo) Reset()      { *m = TimerTaskInfo{} }
 func (*TimerTaskInfo) ProtoMessage() {}
 func (*TimerTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{7}
+	return fileDescriptor_67a714d0e7ba9f37, []int{9}
 }
 func (m *TimerTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1238,25 +1409,25 @@ func (m *TimerTaskInfo) GetRunId() string {
 	return ""
 }
 
-func (m *TimerTaskInfo) GetTaskType() v13.TaskType {
+func (m *TimerTaskInfo) GetTaskType() v14.TaskType {
 	if m != nil {
 		return m.TaskType
 	}
-	return v13.TASK_TYPE_UNSPECIFIED
+	return v14.TASK_TYPE_UNSPECIFIED
 }
 
-func (m *TimerTaskInfo) GetTimeoutType() v14.TimeoutType {
+func (m *TimerTaskInfo) GetTimeoutType() v13.TimeoutType {
 	if m != nil {
 		return m.TimeoutType
 	}
-	return v14.TIMEOUT_TYPE_UNSPECIFIED
+	return v13.TIMEOUT_TYPE_UNSPECIFIED
 }
 
-func (m *TimerTaskInfo) GetWorkflowBackoffType() v13.WorkflowBackoffType {
+func (m *TimerTaskInfo) GetWorkflowBackoffType() v14.WorkflowBackoffType {
 	if m != nil {
 		return m.WorkflowBackoffType
 	}
-	return v13.WORKFLOW_BACKOFF_TYPE_UNSPECIFIED
+	return v14.WORKFLOW_BACKOFF_TYPE_UNSPECIFIED
 }
 
 func (m *TimerTaskInfo) GetVersion() int64 {
@@ -1344,7 +1515,7 @@ type ActivityInfo struct {
 func (m *ActivityInfo) Reset()      { *m = ActivityInfo{} }
 func (*ActivityInfo) ProtoMessage() {}
 func (*ActivityInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{8}
+	return fileDescriptor_67a714d0e7ba9f37, []int{10}
 }
 func (m *ActivityInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1596,7 +1767,7 @@ type TimerInfo struct {
 func (m *TimerInfo) Reset()      { *m = TimerInfo{} }
 func (*TimerInfo) ProtoMessage() {}
 func (*TimerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{9}
+	return fileDescriptor_67a714d0e7ba9f37, []int{11}
 }
 func (m *TimerInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1670,14 +1841,14 @@ type ChildExecutionInfo struct {
 	CreateRequestId       string                `protobuf:"bytes,8,opt,name=create_request_id,json=createRequestId,proto3" json:"create_request_id,omitempty"`
 	Namespace             string                `protobuf:"bytes,9,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	WorkflowTypeName      string                `protobuf:"bytes,10,opt,name=workflow_type_name,json=workflowTypeName,proto3" json:"workflow_type_name,omitempty"`
-	ParentClosePolicy     v14.ParentClosePolicy `protobuf:"varint,11,opt,name=parent_close_policy,json=parentClosePolicy,proto3,enum=temporal.api.enums.v1.ParentClosePolicy" json:"parent_close_policy,omitempty"`
+	ParentClosePolicy     v13.ParentClosePolicy `protobuf:"varint,11,opt,name=parent_close_policy,json=parentClosePolicy,proto3,enum=temporal.api.enums.v1.ParentClosePolicy" json:"parent_close_policy,omitempty"`
 	InitiatedId           int64                 `protobuf:"varint,12,opt,name=initiated_id,json=initiatedId,proto3" json:"initiated_id,omitempty"`
 }
 
 func (m *ChildExecutionInfo) Reset()      { *m = ChildExecutionInfo{} }
 func (*ChildExecutionInfo) ProtoMessage() {}
 func (*ChildExecutionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{10}
+	return fileDescriptor_67a714d0e7ba9f37, []int{12}
 }
 func (m *ChildExecutionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1762,11 +1933,11 @@ func (m *ChildExecutionInfo) GetWorkflowTypeName() string {
 	return ""
 }
 
-func (m *ChildExecutionInfo) GetParentClosePolicy() v14.ParentClosePolicy {
+func (m *ChildExecutionInfo) GetParentClosePolicy() v13.ParentClosePolicy {
 	if m != nil {
 		return m.ParentClosePolicy
 	}
-	return v14.PARENT_CLOSE_POLICY_UNSPECIFIED
+	return v13.PARENT_CLOSE_POLICY_UNSPECIFIED
 }
 
 func (m *ChildExecutionInfo) GetInitiatedId() int64 {
@@ -1787,7 +1958,7 @@ type RequestCancelInfo struct {
 func (m *RequestCancelInfo) Reset()      { *m = RequestCancelInfo{} }
 func (*RequestCancelInfo) ProtoMessage() {}
 func (*RequestCancelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{11}
+	return fileDescriptor_67a714d0e7ba9f37, []int{13}
 }
 func (m *RequestCancelInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1855,7 +2026,7 @@ type SignalInfo struct {
 func (m *SignalInfo) Reset()      { *m = SignalInfo{} }
 func (*SignalInfo) ProtoMessage() {}
 func (*SignalInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{12}
+	return fileDescriptor_67a714d0e7ba9f37, []int{14}
 }
 func (m *SignalInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1915,14 +2086,14 @@ func (m *SignalInfo) GetInitiatedId() int64 {
 // checksum column
 type Checksum struct {
 	Version int32              `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	Flavor  v13.ChecksumFlavor `protobuf:"varint,2,opt,name=flavor,proto3,enum=temporal.server.api.enums.v1.ChecksumFlavor" json:"flavor,omitempty"`
+	Flavor  v14.ChecksumFlavor `protobuf:"varint,2,opt,name=flavor,proto3,enum=temporal.server.api.enums.v1.ChecksumFlavor" json:"flavor,omitempty"`
 	Value   []byte             `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
 func (m *Checksum) Reset()      { *m = Checksum{} }
 func (*Checksum) ProtoMessage() {}
 func (*Checksum) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{13}
+	return fileDescriptor_67a714d0e7ba9f37, []int{15}
 }
 func (m *Checksum) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1958,11 +2129,11 @@ func (m *Checksum) GetVersion() int32 {
 	return 0
 }
 
-func (m *Checksum) GetFlavor() v13.ChecksumFlavor {
+func (m *Checksum) GetFlavor() v14.ChecksumFlavor {
 	if m != nil {
 		return m.Flavor
 	}
-	return v13.CHECKSUM_FLAVOR_UNSPECIFIED
+	return v14.CHECKSUM_FLAVOR_UNSPECIFIED
 }
 
 func (m *Checksum) GetValue() []byte {
@@ -1980,7 +2151,7 @@ type QueueAckLevel struct {
 func (m *QueueAckLevel) Reset()      { *m = QueueAckLevel{} }
 func (*QueueAckLevel) ProtoMessage() {}
 func (*QueueAckLevel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{14}
+	return fileDescriptor_67a714d0e7ba9f37, []int{16}
 }
 func (m *QueueAckLevel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2033,6 +2204,8 @@ func init() {
 	proto.RegisterType((*WorkflowExecutionInfo)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionInfo")
 	proto.RegisterMapType((map[string]*v11.Payload)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionInfo.MemoEntry")
 	proto.RegisterMapType((map[string]*v11.Payload)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionInfo.SearchAttributesEntry")
+	proto.RegisterType((*ConflictResolutionRecord)(nil), "temporal.server.api.persistence.v1.ConflictResolutionRecord")
+	proto.RegisterType((*ConflictResolutionEvent)(nil), "temporal.server.api.persistence.v1.ConflictResolutionEvent")
 	proto.RegisterType((*ExecutionStats)(nil), "temporal.server.api.persistence.v1.ExecutionStats")
 	proto.RegisterType((*WorkflowExecutionState)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionState")
 	proto.RegisterType((*TransferTaskInfo)(nil), "temporal.server.api.persistence.v1.TransferTaskInfo")
@@ -2054,213 +2227,228 @@ func init() {
 }
 
 var fileDescriptor_67a714d0e7ba9f37 = []byte{
-	// 3296 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x4d, 0x73, 0xdb, 0x46,
-	0xb2, 0x86, 0x45, 0x89, 0x64, 0x53, 0xa2, 0x20, 0xe8, 0x0b, 0x92, 0x65, 0x4a, 0x66, 0xec, 0x44,
-	0x4e, 0x1c, 0xca, 0x92, 0x9d, 0xef, 0xbc, 0x4a, 0x59, 0xf2, 0x47, 0xc8, 0x4a, 0x1c, 0x07, 0x52,
-	0xe2, 0x54, 0x5e, 0xa5, 0x58, 0x10, 0x30, 0x92, 0xf0, 0x04, 0x02, 0x34, 0x00, 0x4a, 0x66, 0xea,
-	0x1d, 0x72, 0x78, 0xf5, 0x0e, 0x9b, 0x3d, 0xa4, 0xf6, 0xb4, 0x3f, 0x61, 0x7f, 0xc0, 0xe6, 0xb0,
-	0xe7, 0xdd, 0xc3, 0x1e, 0x73, 0xcc, 0x65, 0x2b, 0x1b, 0x67, 0x0f, 0x7b, 0xdb, 0xfc, 0x84, 0xad,
-	0xe9, 0x99, 0x01, 0x06, 0x20, 0x24, 0x53, 0xde, 0xf8, 0x90, 0x1b, 0x30, 0xfd, 0x31, 0xdd, 0x3d,
-	0x3d, 0xfd, 0x05, 0xc0, 0x8d, 0x88, 0x74, 0xba, 0x7e, 0x60, 0xba, 0x6b, 0x21, 0x09, 0x8e, 0x48,
-	0xb0, 0x66, 0x76, 0x9d, 0xb5, 0x2e, 0x09, 0x42, 0x27, 0x8c, 0x88, 0x67, 0x91, 0xb5, 0xa3, 0xf5,
-	0x35, 0xf2, 0x98, 0x58, 0xbd, 0xc8, 0xf1, 0xbd, 0xb0, 0xd1, 0x0d, 0xfc, 0xc8, 0xd7, 0xea, 0x82,
-	0xa8, 0xc1, 0x88, 0x1a, 0x66, 0xd7, 0x69, 0x48, 0x44, 0x8d, 0xa3, 0xf5, 0xc5, 0xda, 0xbe, 0xef,
-	0xef, 0xbb, 0x64, 0x0d, 0x29, 0x76, 0x7b, 0x7b, 0x6b, 0x76, 0x2f, 0x30, 0x29, 0x13, 0xc6, 0x63,
-	0x71, 0x39, 0x0b, 0x8f, 0x9c, 0x0e, 0x09, 0x23, 0xb3, 0xd3, 0xe5, 0x08, 0x97, 0x6c, 0xd2, 0x25,
-	0x9e, 0x4d, 0x3c, 0xcb, 0x21, 0xe1, 0xda, 0xbe, 0xbf, 0xef, 0xe3, 0x3a, 0x3e, 0x71, 0x94, 0xcb,
-	0xb1, 0xf0, 0x54, 0x6a, 0xcb, 0xef, 0x74, 0x7c, 0x8f, 0x0a, 0xdc, 0x21, 0x61, 0x68, 0xee, 0x93,
-	0x5c, 0x2c, 0xe2, 0xf5, 0x3a, 0x21, 0x45, 0x3a, 0xf6, 0x83, 0xc3, 0x3d, 0xd7, 0x3f, 0xe6, 0x58,
-	0x57, 0x52, 0x58, 0x7b, 0xa6, 0xe3, 0xf6, 0x02, 0x32, 0xc8, 0x2c, 0x8d, 0x76, 0xe0, 0x84, 0x91,
-	0x1f, 0xf4, 0x07, 0xd1, 0x5e, 0x4c, 0xa1, 0x89, 0xad, 0x06, 0xf1, 0xae, 0xe6, 0x99, 0x3f, 0x16,
-	0x91, 0x69, 0xc4, 0x51, 0x5f, 0x39, 0x15, 0x35, 0xa3, 0xcd, 0x4b, 0xa7, 0x22, 0x47, 0x66, 0x78,
-	0xc8, 0x11, 0xaf, 0xe5, 0x21, 0x9e, 0xa4, 0x56, 0xfd, 0x87, 0x71, 0x28, 0x6f, 0x1f, 0x98, 0x81,
-	0xdd, 0xf4, 0xf6, 0x7c, 0x6d, 0x01, 0x4a, 0x21, 0x7d, 0x69, 0x3b, 0xb6, 0xae, 0xac, 0x28, 0xab,
-	0xa3, 0x46, 0x11, 0xdf, 0x9b, 0x36, 0x05, 0x05, 0xa6, 0xb7, 0x4f, 0x28, 0xe8, 0xfc, 0x8a, 0xb2,
-	0x3a, 0x62, 0x14, 0xf1, 0xbd, 0x69, 0x6b, 0x33, 0x30, 0xea, 0x1f, 0x7b, 0x24, 0xd0, 0x47, 0x56,
-	0x94, 0xd5, 0xb2, 0xc1, 0x5e, 0xb4, 0xd7, 0x61, 0x36, 0x20, 0x5d, 0xd7, 0xb1, 0xd0, 0x47, 0xda,
-	0xa6, 0x75, 0xd8, 0x76, 0xc9, 0x11, 0x71, 0xf5, 0x02, 0xa5, 0xde, 0x3c, 0xaf, 0x2b, 0xc6, 0xb4,
-	0x84, 0x70, 0xcb, 0x3a, 0xfc, 0x80, 0x82, 0xb5, 0xeb, 0xa0, 0x45, 0x81, 0xe9, 0x85, 0x7b, 0x24,
-	0x90, 0x88, 0x46, 0x63, 0x22, 0x55, 0x40, 0x63, 0x8a, 0x6b, 0xa0, 0x85, 0x91, 0xef, 0x12, 0xaf,
-	0x1d, 0x3a, 0x9e, 0x45, 0xda, 0x01, 0xf1, 0xc8, 0xb1, 0x3e, 0x86, 0xf2, 0xab, 0x0c, 0xb2, 0x4d,
-	0x01, 0x06, 0x5d, 0xd7, 0x6e, 0x41, 0xa5, 0xd7, 0xb5, 0xcd, 0x88, 0xb4, 0xa9, 0x7f, 0xea, 0xc5,
-	0x15, 0x65, 0xb5, 0xb2, 0xb1, 0xd8, 0x60, 0xce, 0xdb, 0x10, 0xce, 0xdb, 0xd8, 0x11, 0xce, 0xbb,
-	0x59, 0xf8, 0xe6, 0x87, 0x65, 0xc5, 0x00, 0x46, 0x44, 0x97, 0xb5, 0x6d, 0x98, 0xa1, 0xb4, 0x92,
-	0x7c, 0x8c, 0x57, 0xe9, 0xa9, 0xbc, 0xc6, 0x28, 0x2f, 0x5d, 0x31, 0xa6, 0x90, 0x5e, 0x68, 0x80,
-	0x4c, 0x6f, 0x43, 0xcd, 0x33, 0x3b, 0x24, 0xec, 0x9a, 0x16, 0x69, 0x7b, 0x7e, 0xe4, 0xec, 0x09,
-	0xd3, 0x1d, 0xd1, 0x7b, 0xe8, 0x7b, 0x7a, 0x19, 0xcd, 0xbe, 0x14, 0x63, 0xdd, 0x97, 0x90, 0x3e,
-	0x65, 0x38, 0xda, 0xd7, 0x0a, 0x2c, 0x5a, 0x6e, 0x2f, 0x8c, 0x48, 0xd0, 0xce, 0x31, 0x23, 0xac,
-	0x8c, 0xac, 0x56, 0x36, 0x5a, 0x8d, 0xa7, 0x5f, 0xf7, 0x46, 0xec, 0x15, 0x8d, 0x2d, 0xc6, 0x6f,
-	0x27, 0x63, 0xf7, 0x3b, 0x5e, 0x14, 0xf4, 0xf1, 0x48, 0xe6, 0xad, 0x7c, 0x0c, 0xed, 0xff, 0x15,
-	0x98, 0x8f, 0xa5, 0x49, 0x5b, 0x4c, 0xaf, 0xa0, 0x28, 0xf7, 0x9e, 0x4d, 0x14, 0xd9, 0x7a, 0x4c,
-	0x0e, 0x61, 0xd9, 0x19, 0x2b, 0x07, 0x45, 0xfb, 0x8d, 0x02, 0x0b, 0x42, 0x10, 0xd9, 0x2b, 0x99,
-	0x28, 0xe3, 0xff, 0x81, 0x55, 0x8c, 0x84, 0xdb, 0x09, 0x56, 0xc9, 0x62, 0x68, 0xff, 0xa7, 0xc0,
-	0x82, 0x2c, 0x84, 0xed, 0x3e, 0x92, 0xec, 0x32, 0x81, 0xc2, 0x34, 0xcf, 0x26, 0x8c, 0xb4, 0xc7,
-	0x6d, 0xf7, 0x51, 0xca, 0x32, 0xc6, 0x5c, 0x90, 0x0b, 0xd4, 0x6e, 0xc2, 0xcc, 0x91, 0x13, 0x3a,
-	0xbb, 0x8e, 0xeb, 0x44, 0x7d, 0x49, 0x80, 0x6a, 0x7c, 0xd5, 0xb4, 0x04, 0x1e, 0x53, 0x1d, 0x82,
-	0xfa, 0xa8, 0x47, 0x7a, 0x24, 0x21, 0x08, 0x75, 0x15, 0x45, 0xbe, 0x75, 0x36, 0x91, 0x3f, 0xa6,
-	0x5c, 0x04, 0xdb, 0x90, 0x89, 0x5a, 0x7d, 0x94, 0x5a, 0x5c, 0x6c, 0xc1, 0xd2, 0x69, 0xce, 0xa7,
-	0xa9, 0x30, 0x72, 0x48, 0xfa, 0x18, 0xaa, 0xca, 0x06, 0x7d, 0xa4, 0xb1, 0xe8, 0xc8, 0x74, 0x7b,
-	0x84, 0xc7, 0x28, 0xf6, 0xf2, 0xf6, 0xf9, 0x37, 0x95, 0x45, 0x0b, 0x16, 0x4e, 0xf4, 0x9e, 0x1c,
-	0x46, 0xd7, 0x65, 0x46, 0xa7, 0x5e, 0x6a, 0x79, 0x93, 0x44, 0xe0, 0x5c, 0xbf, 0x38, 0x93, 0xc0,
-	0x4d, 0xb8, 0x70, 0xca, 0xb1, 0x9e, 0x89, 0x55, 0x04, 0xd3, 0x39, 0xe6, 0x96, 0x59, 0x8c, 0x32,
-	0x16, 0xf7, 0xd2, 0x5a, 0xaf, 0x0f, 0x73, 0xa4, 0x29, 0xce, 0xd2, 0xae, 0xad, 0x42, 0x69, 0x52,
-	0x55, 0xeb, 0xbf, 0x5b, 0x82, 0xd9, 0x87, 0x3c, 0x97, 0xdd, 0x11, 0x75, 0x07, 0x66, 0x9b, 0x4b,
-	0x30, 0x9e, 0x44, 0x3c, 0x9e, 0x71, 0xca, 0x46, 0x25, 0x5e, 0x6b, 0xda, 0xda, 0x32, 0x54, 0x44,
-	0x1e, 0x14, 0x89, 0xa7, 0x6c, 0x80, 0x58, 0x6a, 0xda, 0x5a, 0x03, 0xa6, 0xbb, 0x66, 0x40, 0xbc,
-	0xa8, 0x9d, 0x62, 0xc5, 0x32, 0xd1, 0x14, 0x03, 0xdd, 0x97, 0x18, 0x5e, 0x03, 0x8d, 0xe3, 0xcb,
-	0x7c, 0x0b, 0x88, 0xae, 0x32, 0xc8, 0xc3, 0x84, 0x7b, 0x1d, 0x26, 0x38, 0x76, 0xd0, 0xf3, 0x28,
-	0xe2, 0x28, 0x13, 0x91, 0x2d, 0x1a, 0x3d, 0xaf, 0x69, 0x53, 0x2d, 0x1c, 0xcf, 0x89, 0x1c, 0x33,
-	0x22, 0x98, 0x37, 0xc7, 0xd0, 0xf8, 0x95, 0x78, 0xad, 0x69, 0x6b, 0x6f, 0xc1, 0x82, 0xe5, 0x77,
-	0xba, 0x2e, 0xc1, 0xeb, 0x4e, 0x8e, 0x28, 0xc3, 0x5d, 0x33, 0xb2, 0x0e, 0x28, 0x7e, 0x11, 0xf1,
-	0xe7, 0x12, 0x84, 0x3b, 0x14, 0xbe, 0x49, 0xc1, 0x4d, 0x5b, 0xbb, 0x08, 0x40, 0x73, 0x7b, 0x1b,
-	0x2f, 0x06, 0x66, 0x80, 0xb2, 0x51, 0xa6, 0x2b, 0x68, 0x75, 0xaa, 0x4e, 0xac, 0x47, 0xd4, 0xef,
-	0x12, 0xb4, 0x82, 0x0e, 0x4c, 0x1d, 0x01, 0xd9, 0xe9, 0x77, 0x09, 0xb5, 0x81, 0xf6, 0x05, 0x2c,
-	0xc6, 0xd8, 0x71, 0x09, 0x88, 0x81, 0xd9, 0xef, 0x45, 0x7a, 0x05, 0x8f, 0x7c, 0x61, 0xc0, 0xd1,
-	0x6f, 0xf3, 0x32, 0x6f, 0xb3, 0xf0, 0x7b, 0x9a, 0x08, 0xf5, 0xe3, 0xec, 0x61, 0xee, 0x30, 0x06,
-	0xda, 0xc7, 0x30, 0x13, 0xb3, 0xa7, 0xf6, 0x12, 0x8c, 0xc7, 0x87, 0x63, 0x1c, 0x6b, 0x62, 0xf4,
-	0x62, 0x96, 0xbb, 0x70, 0xd1, 0x26, 0x7b, 0x66, 0xcf, 0x95, 0xce, 0x0b, 0xed, 0x21, 0x78, 0x4f,
-	0x0c, 0xc7, 0x7b, 0x91, 0x73, 0x11, 0x67, 0xbb, 0x63, 0x86, 0x87, 0x62, 0x8f, 0x57, 0x40, 0x73,
-	0xcd, 0x30, 0xe2, 0xe7, 0x82, 0xdc, 0x1d, 0x5b, 0x9f, 0xc2, 0x63, 0x99, 0xa4, 0x10, 0x3c, 0x10,
-	0x4a, 0xd1, 0xb4, 0xb5, 0x57, 0x61, 0x1a, 0x91, 0xf7, 0x9c, 0x20, 0x26, 0x71, 0x6c, 0x5d, 0x43,
-	0x6c, 0x95, 0x82, 0xee, 0x52, 0x08, 0x92, 0x34, 0x6d, 0xed, 0x5d, 0xb8, 0x80, 0xe8, 0x69, 0xe1,
-	0xc3, 0xc8, 0x0c, 0x90, 0x6c, 0x1a, 0xc9, 0xe6, 0x29, 0x8a, 0x2c, 0xd9, 0x36, 0x85, 0x37, 0x6d,
-	0xed, 0x3d, 0x00, 0x86, 0x8a, 0xd5, 0xc5, 0xcc, 0x90, 0x95, 0x4a, 0x19, 0x69, 0xb0, 0xa6, 0x68,
-	0x01, 0x8a, 0xd4, 0x96, 0x0b, 0x9e, 0xd9, 0x21, 0xd9, 0x54, 0x29, 0xe5, 0x27, 0x49, 0xd1, 0xb3,
-	0x01, 0xb3, 0x69, 0x2d, 0x44, 0x59, 0x32, 0x87, 0x4a, 0x4c, 0x1f, 0x4b, 0x0a, 0x88, 0x6a, 0xe4,
-	0x2d, 0x58, 0xc8, 0x68, 0x6e, 0x1d, 0x10, 0xbb, 0xe7, 0xe2, 0x1d, 0x9d, 0x67, 0x8e, 0x2f, 0xd3,
-	0x6d, 0x73, 0x70, 0xd3, 0xd6, 0xde, 0x00, 0x3d, 0xc7, 0x68, 0xec, 0x8a, 0xe9, 0x48, 0x39, 0x7b,
-	0x9c, 0x35, 0x19, 0x5e, 0xb6, 0xed, 0xac, 0x9c, 0xc2, 0x55, 0x16, 0x86, 0x73, 0x95, 0x94, 0x22,
-	0xc2, 0x47, 0x06, 0x94, 0x37, 0x23, 0x1a, 0x19, 0x23, 0x7d, 0x11, 0x63, 0x67, 0x8a, 0xe6, 0x16,
-	0x03, 0xa5, 0x6e, 0x5b, 0x4a, 0x03, 0x3c, 0x86, 0x0b, 0x43, 0x1e, 0xc3, 0x7c, 0x8e, 0x96, 0x78,
-	0x1e, 0x26, 0x2c, 0xe5, 0xdb, 0x96, 0x6f, 0xb0, 0x34, 0xe4, 0x06, 0x0b, 0x79, 0x07, 0xc0, 0xb6,
-	0xb8, 0x0a, 0xaa, 0x65, 0x7a, 0x16, 0x71, 0xdb, 0x01, 0x79, 0xd4, 0x23, 0x61, 0x44, 0x6c, 0xfd,
-	0xe2, 0x8a, 0xb2, 0x5a, 0x32, 0x26, 0xd9, 0xba, 0x21, 0x96, 0xb5, 0x00, 0xae, 0xa4, 0xa5, 0xf1,
-	0x03, 0x67, 0xdf, 0xf1, 0x4c, 0x37, 0x2b, 0x56, 0x6d, 0x48, 0xb1, 0x2e, 0xc9, 0x62, 0x7d, 0xc4,
-	0x99, 0xa5, 0xc5, 0x1b, 0x70, 0x11, 0x2e, 0x25, 0x75, 0x91, 0x65, 0x0c, 0x81, 0x29, 0x17, 0xe1,
-	0xc2, 0x36, 0x6d, 0xed, 0x65, 0x98, 0x4a, 0xeb, 0x45, 0x29, 0x56, 0x90, 0x22, 0xad, 0x18, 0xc3,
-	0x0d, 0x23, 0xc7, 0x3a, 0xec, 0xb7, 0xa5, 0x38, 0x7c, 0x89, 0xe1, 0x32, 0xc0, 0x4e, 0x1c, 0x8d,
-	0xf7, 0x61, 0x85, 0xe3, 0xc6, 0x7e, 0x1e, 0xf9, 0xed, 0xe4, 0x0a, 0x53, 0x2f, 0xac, 0x0f, 0xe7,
-	0x85, 0x4b, 0x8c, 0x91, 0x50, 0x78, 0xc7, 0xdf, 0x16, 0x97, 0x9a, 0xba, 0xa3, 0x0e, 0x45, 0xe1,
-	0x80, 0x2f, 0xb0, 0x36, 0x8d, 0xbf, 0x6a, 0x9f, 0xc0, 0x5c, 0x40, 0xa2, 0xa0, 0xdf, 0x66, 0xf9,
-	0xc7, 0x6d, 0x3b, 0x5e, 0x44, 0x82, 0x23, 0xd3, 0xd5, 0x2f, 0x0f, 0xb7, 0xf1, 0x0c, 0x92, 0x37,
-	0x19, 0x75, 0x93, 0x13, 0x27, 0x6c, 0x3b, 0xe6, 0x63, 0xa7, 0xd3, 0xeb, 0x24, 0x6c, 0xaf, 0x9c,
-	0x85, 0xed, 0x87, 0x8c, 0x3a, 0x66, 0x7b, 0x33, 0xcb, 0x96, 0xab, 0x11, 0xea, 0x2f, 0xa2, 0x5a,
-	0x29, 0x2a, 0x7e, 0xaf, 0x42, 0xed, 0x6d, 0x5a, 0x3e, 0x53, 0xaa, 0x5d, 0xd3, 0x3a, 0xf4, 0xf7,
-	0xf6, 0xda, 0x96, 0x4f, 0xf6, 0xf6, 0x1c, 0xcb, 0x21, 0x5e, 0xa4, 0xbf, 0xb4, 0xa2, 0xac, 0x2a,
-	0xc6, 0x3c, 0x22, 0x6c, 0x32, 0xf8, 0x56, 0x02, 0xd6, 0x3a, 0x50, 0xcf, 0x49, 0x81, 0xe4, 0x71,
-	0xd7, 0x61, 0xe2, 0x32, 0x27, 0x5d, 0x1d, 0xd2, 0x49, 0x97, 0x07, 0x72, 0xe1, 0x9d, 0x98, 0x13,
-	0x6f, 0xea, 0x96, 0x99, 0xa8, 0x9e, 0xef, 0xb5, 0xf1, 0xc9, 0xdc, 0x75, 0x49, 0x9b, 0x04, 0x81,
-	0x1f, 0x60, 0xc2, 0x0e, 0xf5, 0xab, 0x2b, 0x23, 0xab, 0x65, 0xe3, 0x02, 0x02, 0xef, 0xfb, 0x9e,
-	0x21, 0x90, 0xee, 0x50, 0x1c, 0x9a, 0xba, 0x43, 0x6d, 0x15, 0xd4, 0x03, 0x33, 0x64, 0xf4, 0xed,
-	0xae, 0xef, 0x3a, 0x56, 0x5f, 0x7f, 0x19, 0xef, 0x61, 0xf5, 0xc0, 0x0c, 0x91, 0xe2, 0x01, 0xae,
-	0x6a, 0x2f, 0xc0, 0x84, 0x15, 0xf8, 0x5e, 0xec, 0x7f, 0xfa, 0x2b, 0xe8, 0xa9, 0xe3, 0x74, 0x51,
-	0xf8, 0x12, 0xad, 0x58, 0x42, 0x67, 0x9f, 0xde, 0x4d, 0xcb, 0xef, 0x79, 0x91, 0xde, 0x60, 0x15,
-	0x0b, 0x5b, 0xdb, 0xa2, 0x4b, 0xda, 0xc7, 0x30, 0x65, 0xf6, 0x22, 0xbf, 0x1d, 0x90, 0x90, 0x44,
-	0xed, 0xae, 0xef, 0x78, 0x51, 0xa8, 0xdf, 0x40, 0xab, 0x5c, 0x49, 0x6a, 0x42, 0x5a, 0x0c, 0xc6,
-	0x63, 0x8a, 0xa3, 0xf5, 0x86, 0x41, 0xb1, 0x1f, 0x20, 0xb2, 0x31, 0x49, 0xe9, 0xa5, 0x05, 0xed,
-	0x7f, 0x61, 0x2a, 0x24, 0x66, 0x60, 0x1d, 0xd0, 0x43, 0x0e, 0x9c, 0xdd, 0x5e, 0x44, 0x42, 0xfd,
-	0x26, 0x76, 0x0e, 0x1f, 0x0d, 0x53, 0x66, 0xe6, 0xd6, 0x90, 0x8d, 0x6d, 0x64, 0x79, 0x2b, 0xe6,
-	0xc8, 0xfa, 0x08, 0x35, 0xcc, 0x2c, 0x6b, 0x0f, 0xa1, 0xd0, 0x21, 0x1d, 0x5f, 0x7f, 0x0d, 0x37,
-	0xdc, 0x7a, 0xf6, 0x0d, 0x3f, 0x24, 0x1d, 0x9f, 0x6d, 0x82, 0x0c, 0xb5, 0x2f, 0x60, 0x8a, 0x27,
-	0xc2, 0x36, 0x1b, 0xb2, 0x38, 0x24, 0xd4, 0x5f, 0x47, 0x4b, 0x5d, 0xcf, 0xdd, 0x85, 0x8f, 0x62,
-	0xe8, 0x0e, 0x3c, 0x4d, 0xbe, 0x2f, 0xe8, 0x0c, 0xf5, 0x28, 0xb3, 0xa2, 0xdd, 0x80, 0x39, 0x5e,
-	0x6a, 0xc4, 0xce, 0xca, 0x4b, 0xd1, 0x37, 0xf0, 0x64, 0xa7, 0x11, 0x1a, 0x8b, 0xc8, 0x4a, 0xd2,
-	0xff, 0x86, 0xc9, 0x04, 0x3d, 0x8c, 0xcc, 0x28, 0xd4, 0xdf, 0x44, 0x89, 0x36, 0x86, 0xd1, 0x3b,
-	0x66, 0xb6, 0x4d, 0x29, 0x8d, 0x2a, 0x49, 0xbd, 0xa7, 0xf2, 0x0e, 0x15, 0x25, 0x7b, 0x77, 0xde,
-	0x3a, 0x6b, 0xde, 0x31, 0x7a, 0xd9, 0x5b, 0x73, 0x13, 0xe6, 0x07, 0x8a, 0xac, 0xe8, 0x31, 0x6a,
-	0xfd, 0x36, 0x2b, 0x36, 0xd2, 0x85, 0xd6, 0xce, 0x63, 0xaa, 0xf5, 0x4d, 0x98, 0xa3, 0xba, 0x12,
-	0x36, 0xf7, 0x70, 0x50, 0x22, 0xe6, 0xe0, 0xef, 0x20, 0xd1, 0x0c, 0x42, 0x77, 0x62, 0x20, 0xf3,
-	0xf4, 0x7b, 0x50, 0x4d, 0x97, 0xc2, 0xfa, 0xbb, 0x43, 0x2a, 0x30, 0x41, 0xe4, 0x02, 0x58, 0x5b,
-	0x83, 0x19, 0x8f, 0x1c, 0x0f, 0x9e, 0xd3, 0x7f, 0xb1, 0x56, 0xc4, 0x23, 0xc7, 0xe9, 0x53, 0x5a,
-	0xb4, 0x61, 0x36, 0xd7, 0x7b, 0x73, 0x3a, 0xbb, 0xd7, 0xd2, 0x6d, 0xd9, 0x72, 0xfa, 0x0a, 0xf2,
-	0xa1, 0xe2, 0xd1, 0x7a, 0xe3, 0x81, 0xd9, 0x77, 0x7d, 0xd3, 0x96, 0x5b, 0xbf, 0xcf, 0xa0, 0x1c,
-	0xbb, 0xec, 0x2f, 0xca, 0xb9, 0x55, 0x28, 0x95, 0xd4, 0x72, 0xab, 0x50, 0xaa, 0xaa, 0x93, 0xac,
-	0xd5, 0x6b, 0x15, 0x4a, 0xaa, 0x3a, 0xd5, 0x2a, 0x94, 0xae, 0xa9, 0xaf, 0xb6, 0x0a, 0xa5, 0x57,
-	0xd5, 0x46, 0xab, 0x50, 0x5a, 0x53, 0xaf, 0xb7, 0x0a, 0xa5, 0xeb, 0xea, 0x7a, 0xab, 0x50, 0x5a,
-	0x57, 0x37, 0x5a, 0x85, 0xd2, 0x86, 0x7a, 0xa3, 0x7e, 0x03, 0xaa, 0x69, 0x37, 0xa3, 0x41, 0x89,
-	0xdf, 0x8c, 0x76, 0xe8, 0x7c, 0x49, 0x50, 0xc6, 0x11, 0xa3, 0xc2, 0xd7, 0xb6, 0x9d, 0x2f, 0x49,
-	0xfd, 0x5f, 0x0a, 0xcc, 0x0d, 0x5c, 0x4a, 0x4a, 0x4d, 0x30, 0xa3, 0x07, 0x84, 0x1e, 0xbe, 0x94,
-	0xd1, 0x15, 0x9e, 0xd1, 0x11, 0x90, 0x64, 0xf4, 0x59, 0x18, 0xe3, 0x47, 0xc3, 0xda, 0xc9, 0xd1,
-	0x00, 0x2f, 0x4d, 0x0b, 0x46, 0xd1, 0x41, 0xb0, 0x77, 0xac, 0x6e, 0xdc, 0xcc, 0xbd, 0x2a, 0x38,
-	0x70, 0xcd, 0x0d, 0x0e, 0x28, 0x87, 0xc1, 0x58, 0x68, 0x77, 0x61, 0x8c, 0x3e, 0xf4, 0x42, 0xec,
-	0x2c, 0xab, 0x1b, 0x8d, 0xb4, 0x59, 0x4f, 0xe7, 0xd2, 0x0b, 0x0d, 0x4e, 0x5d, 0xff, 0xb6, 0x00,
-	0xaa, 0x98, 0x7c, 0x60, 0x03, 0xf2, 0x4b, 0xb5, 0xcd, 0x89, 0x0d, 0x46, 0x64, 0x1b, 0x6c, 0x41,
-	0x99, 0x95, 0xcc, 0xfd, 0x2e, 0xe1, 0xa2, 0xbf, 0x78, 0xba, 0x1d, 0xb0, 0x48, 0xee, 0x77, 0x89,
-	0x51, 0x8a, 0xf8, 0x13, 0x6d, 0xc9, 0x23, 0x33, 0xd8, 0x27, 0x99, 0x96, 0x9c, 0xb5, 0xce, 0x53,
-	0x0c, 0x94, 0x69, 0xc9, 0x39, 0xbe, 0x2c, 0xf3, 0x18, 0xeb, 0x61, 0x19, 0x24, 0xdd, 0x92, 0x73,
-	0x6c, 0xae, 0x40, 0x91, 0xa9, 0xcf, 0x16, 0x59, 0xfc, 0x4b, 0x37, 0xcd, 0xa5, 0x6c, 0xd3, 0xfc,
-	0x0e, 0x2c, 0x72, 0x16, 0xd6, 0x81, 0xe3, 0xda, 0xc9, 0xb6, 0xbe, 0xe7, 0xf6, 0xb1, 0xc7, 0x2e,
-	0x19, 0xf3, 0x0c, 0x63, 0x8b, 0x22, 0x88, 0xdd, 0x3f, 0xf2, 0xdc, 0x3e, 0x35, 0xad, 0xdc, 0xc4,
-	0x00, 0xba, 0x29, 0x84, 0x49, 0xe3, 0xa2, 0x43, 0x51, 0x74, 0x46, 0x15, 0x36, 0x27, 0xe7, 0xaf,
-	0xda, 0x3c, 0x14, 0x45, 0x77, 0x39, 0x8e, 0x90, 0xb1, 0x88, 0x35, 0x95, 0x4d, 0x98, 0x94, 0x26,
-	0x71, 0x18, 0x84, 0x26, 0x86, 0xed, 0xd2, 0x12, 0x42, 0x0a, 0x62, 0xd7, 0xb1, 0xfe, 0xdb, 0x02,
-	0x4c, 0x4b, 0xb3, 0xa3, 0x5f, 0x8d, 0xeb, 0x48, 0xb6, 0x1b, 0x4d, 0xdb, 0xee, 0x32, 0x54, 0x33,
-	0x2d, 0x37, 0x9b, 0xb3, 0x8c, 0xef, 0xc9, 0xed, 0x76, 0x1d, 0x26, 0x3c, 0xf2, 0x58, 0x42, 0x62,
-	0xc3, 0x95, 0x0a, 0x5d, 0x14, 0x38, 0xb4, 0xfa, 0x89, 0x5b, 0x12, 0xc7, 0x46, 0xf7, 0xa0, 0xd5,
-	0x8f, 0x58, 0x63, 0x28, 0xbb, 0x81, 0xe9, 0x59, 0x07, 0xed, 0xc8, 0x3f, 0x24, 0xec, 0x1c, 0xc7,
-	0x8d, 0x0a, 0x5b, 0xdb, 0xa1, 0x4b, 0x22, 0xda, 0x53, 0x4b, 0xa4, 0x50, 0x27, 0x10, 0x95, 0x46,
-	0x7b, 0xa3, 0xe7, 0x6d, 0x4a, 0x04, 0xd2, 0xe1, 0x4f, 0x3e, 0xed, 0xf0, 0xd5, 0x67, 0x3e, 0xfc,
-	0xb2, 0x0a, 0xad, 0x42, 0x09, 0xd4, 0x4a, 0xab, 0x50, 0x1a, 0x57, 0x27, 0xb8, 0x3b, 0xfc, 0x71,
-	0x04, 0xb4, 0x4f, 0x13, 0xd4, 0x5f, 0xbf, 0x37, 0x48, 0xc6, 0x1c, 0x7b, 0x9a, 0x31, 0x8b, 0xcf,
-	0x66, 0x4c, 0xed, 0x3d, 0x00, 0xcb, 0xf5, 0x43, 0x32, 0xec, 0xa7, 0x1d, 0x3e, 0x7c, 0x41, 0x1a,
-	0xc1, 0x40, 0x9a, 0xde, 0x94, 0xcf, 0x3c, 0xbd, 0xa9, 0xff, 0xa5, 0x00, 0x13, 0x38, 0xab, 0xfe,
-	0xd5, 0x9c, 0xd8, 0x1d, 0x18, 0xe7, 0x7d, 0x2e, 0xe3, 0x33, 0x8a, 0x7c, 0xea, 0x27, 0x64, 0x3f,
-	0xde, 0xcd, 0x22, 0x8f, 0x4a, 0x94, 0xbc, 0x68, 0x44, 0x9a, 0xb6, 0x88, 0x1e, 0x0f, 0xf9, 0x8d,
-	0x21, 0xbf, 0xf5, 0xe1, 0x52, 0x33, 0xef, 0xfe, 0x90, 0x7d, 0x3c, 0xa0, 0x91, 0x16, 0x65, 0xff,
-	0x2a, 0xa6, 0xfd, 0xeb, 0x2a, 0xa8, 0x71, 0x90, 0x17, 0x8d, 0x76, 0x09, 0x3b, 0xd2, 0x49, 0xb1,
-	0x2e, 0xa6, 0x3c, 0x0b, 0x50, 0x8a, 0xa3, 0x0d, 0xfb, 0x40, 0x57, 0x24, 0x3c, 0xd2, 0x48, 0x5e,
-	0x0a, 0x4f, 0xf3, 0xd2, 0xca, 0x33, 0x7a, 0x69, 0x36, 0x54, 0x8d, 0x0f, 0x84, 0xaa, 0xfa, 0xd7,
-	0x55, 0x18, 0xbf, 0x65, 0x45, 0xce, 0x91, 0x13, 0xf5, 0xd1, 0x8b, 0x24, 0xbd, 0x95, 0xb4, 0xde,
-	0x6f, 0x80, 0x9e, 0xc4, 0xc6, 0xcc, 0x9c, 0x9a, 0x7d, 0x54, 0x98, 0x8d, 0xe1, 0xa9, 0x31, 0xf5,
-	0x3d, 0xa8, 0x66, 0xe6, 0x3c, 0x85, 0x61, 0xab, 0xe8, 0x30, 0x35, 0xd3, 0xb9, 0xc8, 0x2f, 0x0d,
-	0x8b, 0xcd, 0xec, 0xda, 0x97, 0xc3, 0x78, 0xb8, 0xb7, 0x05, 0xe3, 0xa9, 0x29, 0xda, 0xb0, 0x97,
-	0xbb, 0x12, 0x4a, 0x93, 0xb3, 0x65, 0xa8, 0x98, 0xdc, 0x1e, 0x22, 0x01, 0x94, 0x0d, 0x10, 0x4b,
-	0xac, 0x7e, 0x90, 0xca, 0x48, 0x3e, 0x74, 0x0f, 0xe2, 0x02, 0xf2, 0x73, 0x58, 0x38, 0x79, 0xbe,
-	0x03, 0xc3, 0xcd, 0x43, 0xe6, 0xc2, 0xfc, 0xc9, 0x4e, 0x86, 0x77, 0x12, 0x81, 0xce, 0x30, 0xa1,
-	0x97, 0x78, 0x6f, 0x89, 0x68, 0x44, 0x79, 0xef, 0x60, 0x83, 0x44, 0x65, 0xcd, 0x32, 0x1e, 0x72,
-	0x42, 0x3f, 0xcd, 0x62, 0x53, 0x9a, 0xeb, 0x07, 0x30, 0x75, 0x40, 0xcc, 0x20, 0xda, 0x25, 0x66,
-	0x74, 0xd6, 0xb1, 0xbc, 0x1a, 0x53, 0x0a, 0x6e, 0x79, 0x23, 0xc7, 0x6a, 0xfe, 0xc8, 0x31, 0x77,
-	0x8a, 0xc7, 0x72, 0x6b, 0xde, 0x14, 0x8f, 0x7d, 0x7f, 0x16, 0x83, 0x58, 0x5a, 0x9b, 0xab, 0xec,
-	0x46, 0x47, 0x22, 0xc4, 0xb2, 0xe2, 0x5b, 0x1e, 0xae, 0x4d, 0xa5, 0x87, 0x6b, 0xe9, 0xba, 0x52,
-	0xcb, 0xd6, 0x95, 0x34, 0x6a, 0xc4, 0xbe, 0x4b, 0xbc, 0xc8, 0x89, 0xfa, 0x38, 0xe1, 0xc7, 0x49,
-	0x21, 0xf7, 0x60, 0xb6, 0x9c, 0x3b, 0xd1, 0x99, 0xc9, 0x9d, 0xe8, 0x9c, 0x3c, 0xd0, 0x9b, 0x7d,
-	0x3e, 0x03, 0xbd, 0xb9, 0xe7, 0x33, 0xd0, 0x9b, 0x3f, 0x65, 0xa0, 0xb7, 0x03, 0xb3, 0x8c, 0x2a,
-	0x3b, 0x4b, 0xd0, 0x87, 0xbc, 0xde, 0xd3, 0x48, 0x9e, 0x99, 0x22, 0x9c, 0x3a, 0x26, 0x5c, 0x38,
-	0x7d, 0x4c, 0x38, 0xc4, 0xdc, 0x6e, 0xf1, 0xe9, 0x73, 0xbb, 0xfb, 0xa0, 0x31, 0x2e, 0x6c, 0x9a,
-	0xc1, 0xfe, 0x40, 0xe2, 0x93, 0xff, 0x95, 0x74, 0x52, 0xe4, 0x40, 0x9a, 0xbf, 0xee, 0xb2, 0x47,
-	0x43, 0x45, 0xda, 0x0f, 0xcc, 0x30, 0xe2, 0x2b, 0xb4, 0x71, 0x91, 0xf8, 0xd1, 0x94, 0x46, 0x82,
-	0xc4, 0xd5, 0x96, 0xd0, 0xd5, 0xe6, 0x63, 0xaa, 0x87, 0x08, 0x8f, 0x5d, 0x2e, 0x5b, 0x3b, 0x5c,
-	0xcc, 0xad, 0x1d, 0xe4, 0xde, 0xa6, 0x36, 0xd0, 0xdb, 0x7c, 0x0a, 0x73, 0xb8, 0x75, 0x72, 0xe1,
-	0x6d, 0x12, 0x99, 0x8e, 0x1b, 0xe2, 0xbc, 0x7d, 0x40, 0xa9, 0x81, 0xf1, 0x41, 0x68, 0xcc, 0x50,
-	0xfa, 0xf7, 0x05, 0xf9, 0x6d, 0x46, 0xad, 0x7d, 0x01, 0x8b, 0x19, 0xbe, 0xf2, 0x17, 0xab, 0x95,
-	0x61, 0x3f, 0x95, 0xa4, 0x78, 0x27, 0x9f, 0xae, 0x5a, 0x85, 0xd2, 0x88, 0x5a, 0x68, 0x15, 0x4a,
-	0x63, 0x6a, 0xb1, 0xfe, 0x67, 0x05, 0xca, 0x58, 0x54, 0x3d, 0x25, 0x15, 0xa6, 0x13, 0xd1, 0xf9,
-	0x6c, 0x22, 0xba, 0x05, 0x15, 0x74, 0x56, 0x9e, 0xbe, 0x47, 0x86, 0xfd, 0x8b, 0x88, 0x11, 0x89,
-	0x34, 0x24, 0x47, 0xa3, 0x02, 0xb3, 0x76, 0x94, 0x04, 0xa2, 0x05, 0x28, 0xb1, 0xa0, 0x15, 0x77,
-	0xcf, 0x45, 0x7c, 0x6f, 0xda, 0xf5, 0xbf, 0x8d, 0x80, 0x86, 0xbd, 0x69, 0xfa, 0x8b, 0xfa, 0xa9,
-	0x99, 0x3d, 0xf9, 0x4a, 0x9d, 0x9f, 0xd9, 0x63, 0x78, 0xf6, 0x03, 0xb4, 0x64, 0x87, 0x91, 0xac,
-	0x1d, 0x1a, 0x30, 0x2d, 0xc0, 0x72, 0xd9, 0xc9, 0x9b, 0x7d, 0x0e, 0x92, 0xda, 0xf7, 0xcb, 0x50,
-	0x15, 0xf8, 0xbc, 0x0a, 0x65, 0x8d, 0xbe, 0x48, 0xeb, 0xac, 0x81, 0xcf, 0x1d, 0xe7, 0x94, 0xf2,
-	0xc7, 0x39, 0x4b, 0x50, 0x8e, 0x7d, 0x58, 0xe4, 0xea, 0x78, 0xe1, 0x8c, 0x1f, 0xc8, 0x3f, 0x8b,
-	0xff, 0x26, 0x60, 0xf9, 0x91, 0x47, 0xe6, 0x0a, 0x96, 0x9d, 0xab, 0x27, 0x94, 0xb1, 0x0f, 0x90,
-	0x02, 0x73, 0x22, 0x8b, 0xd9, 0xe2, 0xbf, 0x03, 0x69, 0x69, 0xe0, 0x2f, 0x81, 0xf1, 0x81, 0xbf,
-	0x04, 0x5a, 0x85, 0x52, 0x41, 0x1d, 0x6d, 0x15, 0x4a, 0x45, 0xb5, 0x54, 0xff, 0x56, 0x81, 0x29,
-	0xae, 0xe2, 0x16, 0xa6, 0xb2, 0xe7, 0x75, 0xbc, 0xb9, 0x49, 0x74, 0x24, 0xff, 0x53, 0x58, 0x56,
-	0x87, 0xc2, 0x80, 0x0e, 0xf5, 0x3f, 0x29, 0x00, 0xdb, 0xf8, 0x1d, 0xe1, 0x39, 0xfa, 0xe3, 0x80,
-	0xa4, 0x52, 0x6d, 0x96, 0x95, 0xb1, 0x78, 0xb2, 0x9d, 0x47, 0xd5, 0x31, 0x16, 0x13, 0xd8, 0x24,
-	0xb3, 0xfe, 0x95, 0x02, 0xa5, 0xad, 0x03, 0x62, 0x1d, 0x86, 0xbd, 0x4e, 0x56, 0xf2, 0xd1, 0x44,
-	0xf2, 0xdb, 0x30, 0xb6, 0xe7, 0x9a, 0x47, 0x7e, 0x80, 0x72, 0x56, 0x37, 0xae, 0x9d, 0xde, 0x8d,
-	0x08, 0x8e, 0x77, 0x91, 0xc6, 0xe0, 0xb4, 0xc9, 0xbf, 0x3a, 0x23, 0x58, 0xb0, 0xb3, 0x97, 0xfa,
-	0x3f, 0x14, 0x98, 0x48, 0xfd, 0x4e, 0xa3, 0x5d, 0x80, 0x72, 0xf2, 0x67, 0x16, 0xb3, 0x61, 0xc9,
-	0x14, 0xc0, 0x00, 0xa6, 0xc4, 0x4f, 0x6d, 0x09, 0xd2, 0x79, 0xfc, 0xc2, 0x71, 0xf7, 0xcc, 0x7f,
-	0xee, 0x88, 0x1f, 0xda, 0xd2, 0x3f, 0x8f, 0x4d, 0x5a, 0xe9, 0xd5, 0xc5, 0x4d, 0x98, 0xc9, 0x43,
-	0x3c, 0xcb, 0xef, 0x48, 0x9b, 0xff, 0xf3, 0xdd, 0x8f, 0xb5, 0x73, 0xdf, 0xff, 0x58, 0x3b, 0xf7,
-	0xf3, 0x8f, 0x35, 0xe5, 0xab, 0x27, 0x35, 0xe5, 0x0f, 0x4f, 0x6a, 0xca, 0x5f, 0x9f, 0xd4, 0x94,
-	0xef, 0x9e, 0xd4, 0x94, 0xbf, 0x3f, 0xa9, 0x29, 0xff, 0x7c, 0x52, 0x3b, 0xf7, 0xf3, 0x93, 0x9a,
-	0xf2, 0xcd, 0x4f, 0xb5, 0x73, 0xdf, 0xfd, 0x54, 0x3b, 0xf7, 0xfd, 0x4f, 0xb5, 0x73, 0x9f, 0xdf,
-	0xdc, 0xf7, 0x13, 0xa5, 0x1c, 0xff, 0xe4, 0xdf, 0x9b, 0xdf, 0x91, 0x5e, 0x77, 0xc7, 0x30, 0x16,
-	0xdf, 0xf8, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa3, 0xf0, 0xd5, 0x6f, 0x17, 0x2d, 0x00, 0x00,
+	// 3528 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3b, 0x4b, 0x73, 0xdc, 0x46,
+	0x73, 0x82, 0xb8, 0x24, 0x77, 0x7b, 0xc9, 0x25, 0x08, 0xbe, 0x40, 0x4a, 0x5a, 0x52, 0x6b, 0xc9,
+	0xa6, 0x6c, 0x79, 0x29, 0x52, 0xf2, 0x53, 0x4e, 0x5c, 0x22, 0xf5, 0xf0, 0x6e, 0xd9, 0xb2, 0x0c,
+	0xd2, 0x96, 0xcb, 0x29, 0xd7, 0x16, 0x08, 0xcc, 0x92, 0x08, 0xb1, 0xc0, 0x0a, 0xc0, 0x92, 0x5a,
+	0x97, 0x0f, 0x3e, 0xa4, 0x72, 0x88, 0x53, 0x15, 0x1f, 0xf3, 0x13, 0xf2, 0x03, 0xe2, 0x43, 0xae,
+	0x49, 0x0e, 0xb9, 0xc5, 0x47, 0x5f, 0x52, 0x8e, 0xe5, 0xef, 0xf0, 0xdd, 0x3e, 0xff, 0x84, 0xaf,
+	0xa6, 0x67, 0x06, 0x18, 0x60, 0x41, 0x72, 0x29, 0x5b, 0x07, 0xdf, 0x16, 0xfd, 0x9a, 0xee, 0x9e,
+	0x9e, 0xee, 0x9e, 0x06, 0x16, 0x6e, 0x46, 0xa4, 0xd3, 0xf5, 0x03, 0xd3, 0x5d, 0x0b, 0x49, 0x70,
+	0x48, 0x82, 0x35, 0xb3, 0xeb, 0xac, 0x75, 0x49, 0x10, 0x3a, 0x61, 0x44, 0x3c, 0x8b, 0xac, 0x1d,
+	0xae, 0xaf, 0x91, 0xa7, 0xc4, 0xea, 0x45, 0x8e, 0xef, 0x85, 0xf5, 0x6e, 0xe0, 0x47, 0xbe, 0x56,
+	0x13, 0x4c, 0x75, 0xc6, 0x54, 0x37, 0xbb, 0x4e, 0x5d, 0x62, 0xaa, 0x1f, 0xae, 0x2f, 0x55, 0xf7,
+	0x7c, 0x7f, 0xcf, 0x25, 0x6b, 0xc8, 0xb1, 0xdb, 0x6b, 0xaf, 0xd9, 0xbd, 0xc0, 0xa4, 0x42, 0x98,
+	0x8c, 0xa5, 0xe5, 0x2c, 0x3e, 0x72, 0x3a, 0x24, 0x8c, 0xcc, 0x4e, 0x97, 0x13, 0x5c, 0xb6, 0x49,
+	0x97, 0x78, 0x36, 0xf1, 0x2c, 0x87, 0x84, 0x6b, 0x7b, 0xfe, 0x9e, 0x8f, 0x70, 0xfc, 0xc5, 0x49,
+	0xae, 0xc4, 0xca, 0x53, 0xad, 0x2d, 0xbf, 0xd3, 0xf1, 0x3d, 0xaa, 0x70, 0x87, 0x84, 0xa1, 0xb9,
+	0x47, 0x38, 0xd5, 0xcb, 0x29, 0x2a, 0xe2, 0xf5, 0x3a, 0x21, 0x5a, 0x75, 0x48, 0xbc, 0xa8, 0x15,
+	0xf5, 0xbb, 0x24, 0x57, 0x5a, 0x4c, 0x77, 0xe4, 0x07, 0x07, 0x6d, 0xd7, 0x3f, 0xe2, 0x54, 0x57,
+	0x53, 0x54, 0x6d, 0xd3, 0x71, 0x7b, 0x01, 0x19, 0x5c, 0x34, 0x4d, 0xb6, 0xef, 0x84, 0x91, 0x1f,
+	0xf4, 0x4f, 0xd3, 0x4d, 0x2c, 0x35, 0x48, 0x77, 0x2d, 0x6f, 0x9b, 0x62, 0x15, 0x99, 0xe5, 0x9c,
+	0xf4, 0xb5, 0x13, 0x49, 0x33, 0xd6, 0xbc, 0x72, 0x22, 0x71, 0x64, 0x86, 0x07, 0x9c, 0xf0, 0x7a,
+	0x1e, 0xe1, 0x71, 0x66, 0xd5, 0x7e, 0x9a, 0x80, 0xd2, 0xf6, 0xbe, 0x19, 0xd8, 0x0d, 0xaf, 0xed,
+	0x6b, 0x8b, 0x50, 0x0c, 0xe9, 0x43, 0xcb, 0xb1, 0x75, 0x65, 0x45, 0x59, 0x1d, 0x35, 0xc6, 0xf1,
+	0xb9, 0x61, 0x53, 0x54, 0x60, 0x7a, 0x7b, 0x84, 0xa2, 0xce, 0xaf, 0x28, 0xab, 0x23, 0xc6, 0x38,
+	0x3e, 0x37, 0x6c, 0x6d, 0x16, 0x46, 0xfd, 0x23, 0x8f, 0x04, 0xfa, 0xc8, 0x8a, 0xb2, 0x5a, 0x32,
+	0xd8, 0x83, 0xf6, 0x26, 0xcc, 0x05, 0xa4, 0xeb, 0x3a, 0x16, 0xc6, 0x52, 0xcb, 0xb4, 0x0e, 0x5a,
+	0x2e, 0x39, 0x24, 0xae, 0x5e, 0xa0, 0xdc, 0x9b, 0xe7, 0x75, 0xc5, 0x98, 0x91, 0x08, 0xee, 0x58,
+	0x07, 0x1f, 0x52, 0xb4, 0x76, 0x03, 0xb4, 0x28, 0x30, 0xbd, 0xb0, 0x4d, 0x02, 0x89, 0x69, 0x34,
+	0x66, 0x52, 0x05, 0x36, 0xe6, 0xb8, 0x0e, 0x5a, 0x18, 0xf9, 0x2e, 0xf1, 0x5a, 0xa1, 0xe3, 0x59,
+	0xa4, 0x15, 0x10, 0x8f, 0x1c, 0xe9, 0x63, 0xa8, 0xbf, 0xca, 0x30, 0xdb, 0x14, 0x61, 0x50, 0xb8,
+	0x76, 0x07, 0xca, 0xbd, 0xae, 0x6d, 0x46, 0xa4, 0x45, 0xe3, 0x58, 0x1f, 0x5f, 0x51, 0x56, 0xcb,
+	0x1b, 0x4b, 0x75, 0x16, 0xe4, 0x75, 0x11, 0xe4, 0xf5, 0x1d, 0x11, 0xe4, 0x9b, 0x85, 0xef, 0x7e,
+	0x5a, 0x56, 0x0c, 0x60, 0x4c, 0x14, 0xac, 0x6d, 0xc3, 0x2c, 0xe5, 0x95, 0xf4, 0x63, 0xb2, 0x8a,
+	0xa7, 0xca, 0x1a, 0xa3, 0xb2, 0x74, 0xc5, 0x98, 0x46, 0x7e, 0x61, 0x01, 0x0a, 0xbd, 0x0b, 0x55,
+	0xcf, 0xec, 0x90, 0xb0, 0x6b, 0x5a, 0xa4, 0xe5, 0xf9, 0x91, 0xd3, 0x16, 0xae, 0x3b, 0xa4, 0xe7,
+	0xd5, 0xf7, 0xf4, 0x12, 0xba, 0xfd, 0x62, 0x4c, 0xf5, 0x50, 0x22, 0xfa, 0x8c, 0xd1, 0x68, 0xdf,
+	0x2a, 0xb0, 0x64, 0xb9, 0xbd, 0x30, 0x22, 0x41, 0x2b, 0xc7, 0x8d, 0xb0, 0x32, 0xb2, 0x5a, 0xde,
+	0x68, 0xd6, 0x4f, 0x4f, 0x0b, 0xf5, 0x38, 0x2a, 0xea, 0x5b, 0x4c, 0xde, 0x4e, 0xc6, 0xef, 0xf7,
+	0xbc, 0x28, 0xe8, 0xe3, 0x96, 0x2c, 0x58, 0xf9, 0x14, 0xda, 0x3f, 0x2a, 0xb0, 0x10, 0x6b, 0x93,
+	0xf6, 0x98, 0x5e, 0x46, 0x55, 0x1e, 0x3c, 0x9f, 0x2a, 0x4e, 0x27, 0xab, 0x87, 0xf0, 0xec, 0xac,
+	0x95, 0x43, 0xa2, 0xfd, 0x93, 0x02, 0x8b, 0x42, 0x11, 0x39, 0x2a, 0x99, 0x2a, 0x13, 0xbf, 0xc1,
+	0x2b, 0x46, 0x22, 0xed, 0x18, 0xaf, 0x64, 0x29, 0xb4, 0x7f, 0x50, 0x60, 0x51, 0x56, 0xc2, 0x76,
+	0x9f, 0x48, 0x7e, 0x99, 0x44, 0x65, 0x1a, 0x67, 0x53, 0x46, 0x5a, 0xe3, 0xae, 0xfb, 0x24, 0xe5,
+	0x19, 0x63, 0x3e, 0xc8, 0x45, 0x6a, 0xb7, 0x60, 0xf6, 0xd0, 0x09, 0x9d, 0x5d, 0xc7, 0x75, 0xa2,
+	0xbe, 0xa4, 0x40, 0x25, 0x3e, 0x6a, 0x5a, 0x82, 0x8f, 0xb9, 0x0e, 0x40, 0x7d, 0xd2, 0x23, 0x3d,
+	0x92, 0x30, 0x84, 0xba, 0x8a, 0x2a, 0xdf, 0x39, 0x9b, 0xca, 0x9f, 0x50, 0x29, 0x42, 0x6c, 0xc8,
+	0x54, 0xad, 0x3c, 0x49, 0x01, 0x97, 0x9a, 0x70, 0xf1, 0xa4, 0xe0, 0xd3, 0x54, 0x18, 0x39, 0x20,
+	0x7d, 0x4c, 0x55, 0x25, 0x83, 0xfe, 0xa4, 0xb9, 0xe8, 0xd0, 0x74, 0x7b, 0x84, 0xe7, 0x28, 0xf6,
+	0xf0, 0xee, 0xf9, 0xb7, 0x95, 0x25, 0x0b, 0x16, 0x8f, 0x8d, 0x9e, 0x1c, 0x41, 0x37, 0x64, 0x41,
+	0x27, 0x1e, 0x6a, 0x79, 0x91, 0x44, 0xe1, 0xdc, 0xb8, 0x38, 0x93, 0xc2, 0x0d, 0xb8, 0x70, 0xc2,
+	0xb6, 0x9e, 0x49, 0x54, 0x04, 0x33, 0x39, 0xee, 0x96, 0x45, 0x8c, 0x32, 0x11, 0x0f, 0xd2, 0x56,
+	0xaf, 0x0f, 0xb3, 0xa5, 0x29, 0xc9, 0xd2, 0xaa, 0xcd, 0x42, 0x71, 0x4a, 0x55, 0x6b, 0xff, 0x79,
+	0x09, 0xe6, 0x1e, 0xf3, 0x5a, 0x76, 0x4f, 0xf4, 0x27, 0x58, 0x6d, 0x2e, 0xc3, 0x44, 0x92, 0xf1,
+	0x78, 0xc5, 0x29, 0x19, 0xe5, 0x18, 0xd6, 0xb0, 0xb5, 0x65, 0x28, 0x8b, 0x3a, 0x28, 0x0a, 0x4f,
+	0xc9, 0x00, 0x01, 0x6a, 0xd8, 0x5a, 0x1d, 0x66, 0xba, 0x66, 0x40, 0xfb, 0x83, 0x94, 0x28, 0x56,
+	0x89, 0xa6, 0x19, 0xea, 0xa1, 0x24, 0xf0, 0x3a, 0x68, 0x9c, 0x5e, 0x96, 0x5b, 0x40, 0x72, 0x95,
+	0x61, 0x1e, 0x27, 0xd2, 0x6b, 0x30, 0xc9, 0xa9, 0x83, 0x9e, 0x47, 0x09, 0x47, 0x99, 0x8a, 0x0c,
+	0x68, 0xf4, 0xbc, 0x86, 0x4d, 0xad, 0x70, 0x3c, 0x27, 0x72, 0xcc, 0x88, 0x60, 0xdd, 0x1c, 0x43,
+	0xe7, 0x97, 0x63, 0x58, 0xc3, 0xd6, 0xde, 0x81, 0x45, 0xcb, 0xef, 0x74, 0x5d, 0x82, 0xc7, 0x9d,
+	0xb5, 0x33, 0xbb, 0x66, 0x64, 0xed, 0x53, 0xfa, 0x71, 0xa4, 0x9f, 0x4f, 0x08, 0xee, 0x51, 0xfc,
+	0x26, 0x45, 0x37, 0x6c, 0xed, 0x12, 0x00, 0xad, 0xed, 0x2d, 0x3c, 0x18, 0x58, 0x01, 0x4a, 0x46,
+	0x89, 0x42, 0xd0, 0xeb, 0xd4, 0x9c, 0xd8, 0x0e, 0xda, 0x20, 0xa1, 0x17, 0x74, 0x60, 0xe6, 0x08,
+	0xcc, 0x4e, 0xbf, 0x4b, 0xa8, 0x0f, 0xb4, 0x2f, 0x61, 0x29, 0xa6, 0x8e, 0x5b, 0x45, 0x4c, 0xcc,
+	0x7e, 0x2f, 0xd2, 0xcb, 0xb8, 0xe5, 0x8b, 0x03, 0x81, 0x7e, 0x97, 0xb7, 0x83, 0x9b, 0x85, 0x7f,
+	0xa5, 0x85, 0x50, 0x3f, 0xca, 0x6e, 0xe6, 0x0e, 0x13, 0xa0, 0x7d, 0x02, 0xb3, 0xb1, 0xf8, 0xa0,
+	0x97, 0x08, 0x9e, 0x18, 0x4e, 0x70, 0x6c, 0x89, 0xd1, 0x8b, 0x45, 0xee, 0xc2, 0x25, 0x9b, 0xb4,
+	0xcd, 0x9e, 0x2b, 0xed, 0x17, 0xfa, 0x43, 0xc8, 0x9e, 0x1c, 0x4e, 0xf6, 0x12, 0x97, 0x22, 0xf6,
+	0x76, 0xc7, 0x0c, 0x0f, 0xc4, 0x1a, 0xaf, 0x81, 0xe6, 0x9a, 0x61, 0xc4, 0xf7, 0x05, 0xa5, 0x3b,
+	0xb6, 0x3e, 0x8d, 0xdb, 0x32, 0x45, 0x31, 0xb8, 0x21, 0x94, 0xa3, 0x61, 0x6b, 0xaf, 0xc3, 0x0c,
+	0x12, 0xb7, 0x9d, 0x20, 0x66, 0x71, 0x6c, 0x5d, 0x43, 0x6a, 0x95, 0xa2, 0xee, 0x3b, 0x01, 0x67,
+	0x69, 0xd8, 0xda, 0x7b, 0x70, 0x01, 0xc9, 0xd3, 0xca, 0x87, 0x91, 0x19, 0x20, 0xdb, 0x0c, 0xb2,
+	0x2d, 0x50, 0x12, 0x59, 0xb3, 0x6d, 0x8a, 0x6f, 0xd8, 0xda, 0xfb, 0x00, 0x8c, 0x14, 0xbb, 0x8b,
+	0xd9, 0x21, 0x3b, 0x95, 0x12, 0xf2, 0x50, 0xa8, 0xd6, 0x04, 0x54, 0xa9, 0x25, 0x37, 0x3c, 0x73,
+	0x43, 0x8a, 0xa9, 0x50, 0xce, 0x4f, 0x93, 0xa6, 0x67, 0x03, 0xe6, 0xd2, 0x56, 0x88, 0xb6, 0x64,
+	0x1e, 0x8d, 0x98, 0x39, 0x92, 0x0c, 0x10, 0xdd, 0xc8, 0x3b, 0xb0, 0x98, 0xb1, 0xdc, 0xda, 0x27,
+	0x76, 0xcf, 0xc5, 0x33, 0xba, 0xc0, 0x02, 0x5f, 0xe6, 0xdb, 0xe6, 0xe8, 0x86, 0xad, 0xbd, 0x05,
+	0x7a, 0x8e, 0xd3, 0xd8, 0x11, 0xd3, 0x91, 0x73, 0xee, 0x28, 0xeb, 0x32, 0x3c, 0x6c, 0xdb, 0x59,
+	0x3d, 0x45, 0xa8, 0x2c, 0x0e, 0x17, 0x2a, 0x29, 0x43, 0x44, 0x8c, 0x0c, 0x18, 0x6f, 0x46, 0x34,
+	0x33, 0x46, 0xfa, 0x12, 0xe6, 0xce, 0x14, 0xcf, 0x1d, 0x86, 0x4a, 0x9d, 0xb6, 0x94, 0x05, 0xb8,
+	0x0d, 0x17, 0x86, 0xdc, 0x86, 0x85, 0x1c, 0x2b, 0x71, 0x3f, 0x4c, 0xb8, 0x98, 0xef, 0x5b, 0xbe,
+	0xc0, 0xc5, 0x21, 0x17, 0x58, 0xcc, 0xdb, 0x00, 0xb6, 0xc4, 0x35, 0x50, 0x2d, 0xd3, 0xb3, 0x88,
+	0xdb, 0x0a, 0xc8, 0x93, 0x1e, 0x09, 0x23, 0x62, 0xeb, 0x97, 0x56, 0x94, 0xd5, 0xa2, 0x31, 0xc5,
+	0xe0, 0x86, 0x00, 0x6b, 0x01, 0x5c, 0x4d, 0x6b, 0xe3, 0x07, 0xce, 0x9e, 0xe3, 0x99, 0x6e, 0x56,
+	0xad, 0xea, 0x90, 0x6a, 0x5d, 0x96, 0xd5, 0xfa, 0x98, 0x0b, 0x4b, 0xab, 0x37, 0x10, 0x22, 0x5c,
+	0x4b, 0x1a, 0x22, 0xcb, 0x98, 0x02, 0x53, 0x21, 0xc2, 0x95, 0x6d, 0xd8, 0xda, 0xab, 0x30, 0x9d,
+	0xb6, 0x8b, 0x72, 0xac, 0x20, 0x47, 0xda, 0x30, 0x46, 0x1b, 0x46, 0x8e, 0x75, 0xd0, 0x6f, 0x49,
+	0x79, 0xf8, 0x32, 0xa3, 0x65, 0x88, 0x9d, 0x38, 0x1b, 0xef, 0xc1, 0x0a, 0xa7, 0x8d, 0xe3, 0x3c,
+	0xf2, 0x5b, 0xc9, 0x11, 0xa6, 0x51, 0x58, 0x1b, 0x2e, 0x0a, 0x2f, 0x32, 0x41, 0xc2, 0xe0, 0x1d,
+	0x7f, 0x5b, 0x1c, 0x6a, 0x1a, 0x8e, 0x3a, 0x8c, 0x8b, 0x00, 0x7c, 0x89, 0x5d, 0xd3, 0xf8, 0xa3,
+	0xf6, 0x29, 0xcc, 0x07, 0x24, 0x0a, 0xfa, 0x2d, 0x56, 0x7f, 0xdc, 0x96, 0xe3, 0x45, 0x24, 0x38,
+	0x34, 0x5d, 0xfd, 0xca, 0x70, 0x0b, 0xcf, 0x22, 0x7b, 0x83, 0x71, 0x37, 0x38, 0x73, 0x22, 0xb6,
+	0x63, 0x3e, 0x75, 0x3a, 0xbd, 0x4e, 0x22, 0xf6, 0xea, 0x59, 0xc4, 0x7e, 0xc4, 0xb8, 0x63, 0xb1,
+	0xb7, 0xb2, 0x62, 0xb9, 0x19, 0xa1, 0xfe, 0x32, 0x9a, 0x95, 0xe2, 0xe2, 0xe7, 0x2a, 0xd4, 0xde,
+	0x85, 0x45, 0xc6, 0xb5, 0x6b, 0x5a, 0x07, 0x7e, 0xbb, 0xdd, 0xb2, 0x7c, 0xd2, 0x6e, 0x3b, 0x96,
+	0x43, 0xbc, 0x48, 0x7f, 0x65, 0x45, 0x59, 0x55, 0x8c, 0x05, 0x24, 0xd8, 0x64, 0xf8, 0xad, 0x04,
+	0xad, 0x75, 0xa0, 0x96, 0x53, 0x02, 0xc9, 0xd3, 0xae, 0xc3, 0xd4, 0x65, 0x41, 0xba, 0x3a, 0x64,
+	0x90, 0x2e, 0x0f, 0xd4, 0xc2, 0x7b, 0xb1, 0x24, 0x7e, 0xa9, 0x5b, 0x66, 0xaa, 0x7a, 0xbe, 0xd7,
+	0xc2, 0x5f, 0xe6, 0xae, 0x4b, 0x5a, 0x24, 0x08, 0xfc, 0x00, 0x0b, 0x76, 0xa8, 0x5f, 0x5b, 0x19,
+	0x59, 0x2d, 0x19, 0x17, 0x10, 0xf9, 0xd0, 0xf7, 0x0c, 0x41, 0x74, 0x8f, 0xd2, 0xd0, 0xd2, 0x1d,
+	0x6a, 0xab, 0xa0, 0xee, 0x9b, 0x21, 0xe3, 0x6f, 0x75, 0x7d, 0xd7, 0xb1, 0xfa, 0xfa, 0xab, 0x78,
+	0x0e, 0x2b, 0xfb, 0x66, 0x88, 0x1c, 0x8f, 0x10, 0xaa, 0xbd, 0x04, 0x93, 0x56, 0xe0, 0x7b, 0x71,
+	0xfc, 0xe9, 0xaf, 0x61, 0xa4, 0x4e, 0x50, 0xa0, 0x88, 0x25, 0xda, 0xb1, 0x84, 0xce, 0x1e, 0x3d,
+	0x9b, 0x96, 0xdf, 0xf3, 0x22, 0xbd, 0xce, 0x3a, 0x16, 0x06, 0xdb, 0xa2, 0x20, 0xed, 0x13, 0x98,
+	0x36, 0x7b, 0x91, 0xdf, 0x0a, 0x48, 0x48, 0xa2, 0x56, 0xd7, 0x77, 0xbc, 0x28, 0xd4, 0x6f, 0xa2,
+	0x57, 0xae, 0x26, 0x3d, 0x21, 0x6d, 0x06, 0xe3, 0x31, 0xc5, 0xe1, 0x7a, 0xdd, 0xa0, 0xd4, 0x8f,
+	0x90, 0xd8, 0x98, 0xa2, 0xfc, 0x12, 0x40, 0xfb, 0x1a, 0xa6, 0x43, 0x62, 0x06, 0xd6, 0x3e, 0xdd,
+	0xe4, 0xc0, 0xd9, 0xed, 0x45, 0x24, 0xd4, 0x6f, 0xe1, 0xcd, 0xe1, 0xe3, 0x61, 0xda, 0xcc, 0xdc,
+	0x1e, 0xb2, 0xbe, 0x8d, 0x22, 0xef, 0xc4, 0x12, 0xd9, 0x3d, 0x42, 0x0d, 0x33, 0x60, 0xed, 0x31,
+	0x14, 0x3a, 0xa4, 0xe3, 0xeb, 0x6f, 0xe0, 0x82, 0x5b, 0xcf, 0xbf, 0xe0, 0x47, 0xa4, 0xe3, 0xb3,
+	0x45, 0x50, 0xa0, 0xf6, 0x25, 0x4c, 0xf3, 0x42, 0xd8, 0x62, 0x43, 0x16, 0x87, 0x84, 0xfa, 0x9b,
+	0xe8, 0xa9, 0x1b, 0xb9, 0xab, 0x30, 0xaa, 0x3e, 0x5d, 0x81, 0x97, 0xc9, 0x0f, 0x04, 0x9f, 0xa1,
+	0x1e, 0x66, 0x20, 0xda, 0x4d, 0x98, 0xe7, 0xad, 0x46, 0x1c, 0xac, 0xbc, 0x15, 0x7d, 0x0b, 0x77,
+	0x76, 0x06, 0xb1, 0xb1, 0x8a, 0xac, 0x25, 0xfd, 0x3b, 0x98, 0x4a, 0xc8, 0xc3, 0xc8, 0x8c, 0x42,
+	0xfd, 0x6d, 0xd4, 0x68, 0x63, 0x18, 0xbb, 0x63, 0x61, 0xdb, 0x94, 0xd3, 0xa8, 0x90, 0xd4, 0x73,
+	0xaa, 0xee, 0x04, 0xbd, 0xc1, 0xb3, 0xf3, 0xce, 0x59, 0xeb, 0x8e, 0xd1, 0xcb, 0x9e, 0x9a, 0x5b,
+	0xb0, 0x30, 0xd0, 0x64, 0x45, 0x4f, 0xd1, 0xea, 0x77, 0x59, 0xb3, 0x91, 0x6e, 0xb4, 0x76, 0x9e,
+	0x52, 0xab, 0x6f, 0xc1, 0x3c, 0xb5, 0x95, 0xb0, 0xb9, 0x87, 0x83, 0x1a, 0xb1, 0x00, 0xbf, 0x8d,
+	0x4c, 0xb3, 0x88, 0xdd, 0x89, 0x91, 0x2c, 0xd2, 0x1f, 0x40, 0x25, 0xdd, 0x0a, 0xeb, 0xef, 0x0d,
+	0x69, 0xc0, 0x24, 0x91, 0x1b, 0x60, 0x6d, 0x0d, 0x66, 0x3d, 0x72, 0x34, 0xb8, 0x4f, 0x7f, 0xc3,
+	0xae, 0x22, 0x1e, 0x39, 0xca, 0xec, 0xd2, 0x0d, 0xa9, 0x5d, 0xc6, 0xda, 0xd2, 0x35, 0x7b, 0x21,
+	0xb1, 0xf5, 0xbf, 0xc5, 0x93, 0xad, 0xc9, 0xa5, 0xeb, 0x11, 0x62, 0xb4, 0xaf, 0xe1, 0x82, 0xe5,
+	0x7b, 0x6d, 0xd7, 0xb1, 0x22, 0x7a, 0x32, 0x7d, 0x97, 0x2f, 0x44, 0x2c, 0x3f, 0xb0, 0x43, 0xfd,
+	0x7d, 0x8c, 0xed, 0xf7, 0x86, 0xd9, 0xe3, 0x2d, 0x2e, 0xc6, 0x88, 0xa5, 0x18, 0x28, 0xc4, 0x58,
+	0xb4, 0x8e, 0xc1, 0x84, 0x4b, 0x36, 0xcc, 0xe5, 0x9e, 0xb6, 0x9c, 0x9b, 0xe8, 0x1b, 0xe9, 0x6b,
+	0xe4, 0x72, 0x3a, 0x65, 0xf0, 0x21, 0xe8, 0xe1, 0x7a, 0xfd, 0x91, 0xd9, 0x77, 0x7d, 0xd3, 0x96,
+	0xaf, 0xaa, 0x9f, 0x43, 0x29, 0x3e, 0x62, 0xbf, 0xab, 0xe4, 0x66, 0xa1, 0x58, 0x54, 0x4b, 0xcd,
+	0x42, 0xb1, 0xa2, 0x4e, 0xb1, 0xab, 0x69, 0xb3, 0x50, 0x54, 0xd5, 0xe9, 0x66, 0xa1, 0x78, 0x5d,
+	0x7d, 0xbd, 0x59, 0x28, 0xbe, 0xae, 0xd6, 0x9b, 0x85, 0xe2, 0x9a, 0x7a, 0xa3, 0x59, 0x28, 0xde,
+	0x50, 0xd7, 0x9b, 0x85, 0xe2, 0xba, 0xba, 0xd1, 0x2c, 0x14, 0x37, 0xd4, 0x9b, 0xb5, 0xff, 0x1d,
+	0x01, 0xfd, 0x38, 0x9f, 0x69, 0x5b, 0x30, 0x81, 0xbb, 0x71, 0xc8, 0x3b, 0x6c, 0x65, 0xc8, 0x00,
+	0x2a, 0x73, 0x2e, 0x0c, 0x9f, 0x1a, 0x4c, 0x86, 0x7e, 0x2f, 0xb0, 0x88, 0x88, 0x1b, 0x76, 0xd7,
+	0x2d, 0x33, 0x20, 0x8b, 0x98, 0xab, 0x50, 0x71, 0xfd, 0xd0, 0xf1, 0xf6, 0xe2, 0xde, 0x7b, 0x04,
+	0x23, 0x7b, 0x92, 0x41, 0x45, 0xd7, 0xfd, 0x0a, 0x4c, 0x1d, 0x39, 0x9e, 0x27, 0xd3, 0xe1, 0xcc,
+	0xd5, 0xa8, 0x70, 0xb0, 0x20, 0x6c, 0x83, 0x1a, 0x10, 0xb3, 0xdb, 0x75, 0x1d, 0x62, 0xb3, 0x63,
+	0x16, 0xea, 0xa3, 0x18, 0x44, 0xb7, 0x9f, 0x2f, 0x88, 0xf0, 0x34, 0x1a, 0x53, 0xb1, 0x50, 0x7c,
+	0x0e, 0xb5, 0x5d, 0xa8, 0xd8, 0x81, 0xdf, 0xed, 0x26, 0xab, 0x8c, 0xfd, 0xf6, 0x55, 0x26, 0xb9,
+	0x48, 0xbe, 0x46, 0x1d, 0x66, 0x52, 0x6b, 0xf0, 0xa3, 0xcf, 0x6e, 0xd7, 0xd3, 0x32, 0x2d, 0x9e,
+	0xfb, 0xda, 0xbf, 0x28, 0xb0, 0x70, 0x8c, 0x68, 0x3a, 0xeb, 0x8e, 0x6f, 0x76, 0x0a, 0x9b, 0x75,
+	0x13, 0x7e, 0xa1, 0xd3, 0x61, 0x5c, 0xf8, 0x94, 0x4f, 0xc1, 0xf9, 0x23, 0xbd, 0xac, 0x25, 0x2f,
+	0x2a, 0x70, 0x63, 0x2a, 0x1b, 0x2b, 0xe9, 0xf0, 0xc4, 0x71, 0x3d, 0xa6, 0x58, 0xcc, 0x5a, 0xfd,
+	0x2e, 0x31, 0x4a, 0x44, 0xfc, 0xac, 0xdd, 0x84, 0x4a, 0x3a, 0xf5, 0xd2, 0x42, 0xcd, 0xab, 0x45,
+	0x2b, 0x74, 0xbe, 0x22, 0x5c, 0x97, 0x32, 0x87, 0x6d, 0x3b, 0x5f, 0x91, 0xda, 0x5f, 0x14, 0x98,
+	0x1f, 0x28, 0x54, 0x94, 0x9b, 0x60, 0x97, 0x1b, 0x10, 0x9a, 0x10, 0xa5, 0x2e, 0x57, 0xe1, 0x5d,
+	0x2e, 0x22, 0x92, 0x2e, 0x77, 0x0e, 0xc6, 0x52, 0x61, 0x37, 0x1a, 0x60, 0xc0, 0x35, 0x61, 0x14,
+	0x93, 0x26, 0x37, 0xe7, 0x56, 0xee, 0x7e, 0xc5, 0x56, 0xe5, 0xeb, 0x61, 0x30, 0x11, 0xda, 0x7d,
+	0x18, 0xa3, 0x3f, 0x7a, 0x21, 0x06, 0x63, 0x65, 0xa3, 0x7e, 0x8c, 0x6f, 0x72, 0xa5, 0xf4, 0x42,
+	0x83, 0x73, 0xd7, 0xbe, 0x2f, 0x80, 0x2a, 0xa6, 0x81, 0x78, 0x29, 0xff, 0xbd, 0x46, 0x49, 0x89,
+	0x0f, 0x46, 0x64, 0x1f, 0x6c, 0x41, 0x89, 0x5d, 0x23, 0xe9, 0xb6, 0x32, 0xd5, 0x5f, 0x3e, 0xd9,
+	0x0f, 0x78, 0x71, 0xa4, 0x9b, 0x5b, 0x8c, 0xf8, 0x2f, 0x1a, 0x9d, 0x91, 0x19, 0xec, 0x91, 0xcc,
+	0x98, 0x8a, 0x8d, 0x93, 0xa6, 0x19, 0x2a, 0x33, 0xa6, 0xe2, 0xf4, 0xb2, 0xce, 0x63, 0x6c, 0xae,
+	0xc3, 0x30, 0xe9, 0x31, 0x15, 0xa7, 0xe6, 0x06, 0x8c, 0x33, 0xf3, 0x19, 0x90, 0xe5, 0x8e, 0xf4,
+	0x20, 0xa9, 0x98, 0x1d, 0x24, 0xdd, 0x86, 0x25, 0x2e, 0xc2, 0xda, 0x77, 0x5c, 0x3b, 0x59, 0xd6,
+	0xf7, 0xdc, 0x3e, 0xce, 0x9d, 0x8a, 0xc6, 0x02, 0xa3, 0xd8, 0xa2, 0x04, 0x62, 0xf5, 0x8f, 0x3d,
+	0xb7, 0x4f, 0x5d, 0x2b, 0x5f, 0xec, 0x01, 0xc3, 0x14, 0xc2, 0xe4, 0x32, 0x2f, 0x9d, 0x9a, 0x72,
+	0xfa, 0xd4, 0x2c, 0xc0, 0xb8, 0x98, 0xb8, 0x4c, 0x20, 0x66, 0x2c, 0x62, 0x83, 0x96, 0x06, 0x4c,
+	0x49, 0xd3, 0x69, 0xcc, 0xab, 0x93, 0xc3, 0x4e, 0x2e, 0x12, 0x46, 0x8a, 0x62, 0x29, 0xbf, 0xf6,
+	0xcf, 0x05, 0x98, 0x91, 0xe6, 0xa9, 0x7f, 0x98, 0xd0, 0x91, 0x7c, 0x37, 0x9a, 0xf6, 0xdd, 0x15,
+	0xa8, 0x64, 0xc6, 0x50, 0x6c, 0xf6, 0x38, 0xd1, 0x96, 0x47, 0x50, 0x35, 0x98, 0xf4, 0xc8, 0x53,
+	0x89, 0x88, 0xa5, 0xc4, 0x32, 0x05, 0x0a, 0x1a, 0x7a, 0x23, 0x88, 0xaf, 0xe9, 0x8e, 0xad, 0x17,
+	0xf9, 0x8d, 0x40, 0xc0, 0x18, 0xc9, 0x6e, 0x60, 0x7a, 0xd6, 0x7e, 0x2b, 0xf2, 0x0f, 0x08, 0xdb,
+	0xc7, 0x09, 0xa3, 0xcc, 0x60, 0x3b, 0x14, 0x24, 0x3a, 0x20, 0xea, 0x89, 0x14, 0xe9, 0x24, 0x92,
+	0xd2, 0x0e, 0xc8, 0xe8, 0x79, 0x9b, 0x12, 0x83, 0xb4, 0xf9, 0x53, 0xa7, 0x6d, 0xbe, 0xfa, 0xdc,
+	0x9b, 0x5f, 0x52, 0xa1, 0x59, 0x28, 0x82, 0x5a, 0x6e, 0x16, 0x8a, 0x13, 0xea, 0x24, 0x0f, 0x87,
+	0x7f, 0x1f, 0x01, 0xed, 0xb3, 0x84, 0xf4, 0x8f, 0x1f, 0x0d, 0x92, 0x33, 0xc7, 0x4e, 0x73, 0xe6,
+	0xf8, 0xf3, 0x39, 0x93, 0xd6, 0x38, 0xcb, 0xf5, 0x43, 0x32, 0xec, 0xeb, 0x4e, 0x3e, 0x90, 0x44,
+	0x1e, 0x21, 0x40, 0x9a, 0x68, 0x96, 0xce, 0x3c, 0xd1, 0xac, 0xfd, 0x77, 0x01, 0x26, 0xe9, 0x8f,
+	0x3f, 0x4e, 0xea, 0xbf, 0x07, 0x13, 0x7c, 0xf6, 0xc3, 0xe4, 0x8c, 0xa2, 0x9c, 0xda, 0x31, 0xd5,
+	0x8f, 0x4f, 0x78, 0x50, 0x46, 0x39, 0x4a, 0x1e, 0x34, 0x22, 0x4d, 0x20, 0xc5, 0xdc, 0x03, 0xe5,
+	0x8d, 0xa1, 0xbc, 0xf5, 0xe1, 0x4a, 0x33, 0x9f, 0x88, 0xa0, 0xf8, 0x99, 0xa3, 0x41, 0xa0, 0x1c,
+	0x5f, 0xe3, 0xe9, 0xf8, 0xba, 0x06, 0x6a, 0x9c, 0xe4, 0xc5, 0xf0, 0xa9, 0x88, 0x53, 0x9a, 0x29,
+	0x01, 0x17, 0x93, 0x4f, 0xb9, 0x7f, 0x2a, 0xa5, 0xfb, 0x27, 0x29, 0x4a, 0xe1, 0xb4, 0x28, 0x2d,
+	0x3f, 0x67, 0x94, 0x66, 0x53, 0xd5, 0xc4, 0x40, 0xaa, 0xaa, 0x7d, 0x5b, 0x81, 0x89, 0x3b, 0x56,
+	0xe4, 0x1c, 0x3a, 0x51, 0x1f, 0xa3, 0x48, 0xb2, 0x5b, 0x49, 0xdb, 0xfd, 0x16, 0xe8, 0x49, 0x6e,
+	0xcc, 0xbc, 0xbb, 0x61, 0x2d, 0xe0, 0x5c, 0x8c, 0x4f, 0xbd, 0xba, 0x79, 0x00, 0x95, 0xcc, 0xec,
+	0xb3, 0x30, 0xec, 0xcd, 0x32, 0x4c, 0xcd, 0x39, 0x2f, 0xf1, 0x43, 0xc3, 0x72, 0x33, 0x3b, 0xf6,
+	0xa5, 0x30, 0x1e, 0x78, 0x6f, 0xc1, 0x44, 0x6a, 0xb2, 0x3c, 0xec, 0xe1, 0x2e, 0x87, 0xd2, 0x34,
+	0x79, 0x19, 0xca, 0x26, 0xf7, 0x87, 0x28, 0x00, 0x25, 0x03, 0x04, 0x88, 0xf5, 0x0f, 0x52, 0x1b,
+	0xc9, 0x5f, 0x44, 0x05, 0x71, 0x03, 0xf9, 0x05, 0x2c, 0x1e, 0x3f, 0xf3, 0x84, 0xe1, 0x66, 0x84,
+	0xf3, 0x61, 0xfe, 0xb4, 0x33, 0x23, 0x3b, 0xc9, 0x40, 0x67, 0x78, 0x6b, 0x25, 0xc9, 0xde, 0x12,
+	0xd9, 0x88, 0xca, 0xde, 0x81, 0x79, 0xae, 0x6b, 0x56, 0xf0, 0x90, 0x6f, 0xad, 0x66, 0x58, 0x6e,
+	0x4a, 0x4b, 0xfd, 0x10, 0xa6, 0xf7, 0x89, 0x19, 0x44, 0xbb, 0xc4, 0x8c, 0xce, 0xfa, 0xaa, 0x4a,
+	0x8d, 0x39, 0x85, 0xb4, 0xbc, 0x31, 0x7c, 0x25, 0x7f, 0x0c, 0x9f, 0x3b, 0xd9, 0x66, 0xb5, 0x35,
+	0x6f, 0xb2, 0xcd, 0xbe, 0xc9, 0x10, 0x2f, 0x27, 0x68, 0x6f, 0xae, 0xb2, 0x13, 0x1d, 0x89, 0x14,
+	0xcb, 0x9a, 0x6f, 0x79, 0xe0, 0x3c, 0x9d, 0x1e, 0x38, 0xa7, 0xfb, 0x4a, 0x2d, 0xdb, 0x57, 0xd2,
+	0xac, 0x11, 0xc7, 0x2e, 0xf1, 0x22, 0x27, 0xea, 0xeb, 0x33, 0x62, 0x7a, 0xce, 0x23, 0x98, 0x81,
+	0x73, 0xa7, 0x9c, 0xb3, 0xb9, 0x53, 0xce, 0xe3, 0x87, 0xdc, 0x73, 0x2f, 0x66, 0xc8, 0x3d, 0xff,
+	0x62, 0x86, 0xdc, 0x0b, 0x27, 0x0c, 0xb9, 0x77, 0x60, 0x8e, 0x71, 0x65, 0xe7, 0x6b, 0xfa, 0x90,
+	0xc7, 0x7b, 0x06, 0xd9, 0x33, 0x93, 0xb5, 0x13, 0x47, 0xe7, 0x8b, 0x27, 0x8f, 0xce, 0x87, 0x98,
+	0x65, 0x2f, 0x9d, 0x3e, 0xcb, 0x7e, 0x08, 0x1a, 0x93, 0xc2, 0x26, 0x7c, 0xec, 0xab, 0x3c, 0xfe,
+	0x36, 0x2c, 0x73, 0x5d, 0xe6, 0x48, 0x5a, 0xbf, 0xee, 0xb3, 0x9f, 0x86, 0x8a, 0xbc, 0x1f, 0xd2,
+	0xe9, 0x1f, 0x83, 0xd0, 0x8b, 0x8b, 0x24, 0x8f, 0x96, 0x34, 0x12, 0x24, 0xa1, 0x76, 0x11, 0x43,
+	0x6d, 0x21, 0xe6, 0x7a, 0x8c, 0xf8, 0x38, 0xe4, 0xb2, 0xbd, 0xc3, 0xa5, 0xdc, 0xde, 0x41, 0xbe,
+	0xdb, 0x54, 0x07, 0xee, 0x36, 0x9f, 0xc1, 0x3c, 0x2e, 0x9d, 0x1c, 0x78, 0x9b, 0x44, 0xa6, 0xe3,
+	0x86, 0xfa, 0x72, 0x9e, 0x51, 0x03, 0x23, 0xaa, 0xd0, 0x98, 0xa5, 0xfc, 0x1f, 0x08, 0xf6, 0xbb,
+	0x8c, 0x9b, 0xbe, 0x3e, 0xcc, 0xc8, 0x95, 0xdf, 0xe2, 0xae, 0x0c, 0xfb, 0xfa, 0x30, 0x25, 0x3b,
+	0x79, 0x9d, 0xdb, 0x2c, 0x14, 0x47, 0xd4, 0x42, 0xb3, 0x50, 0x1c, 0x53, 0xc7, 0x6b, 0xff, 0xa5,
+	0x40, 0x89, 0x02, 0x83, 0x53, 0x4a, 0x61, 0xba, 0x10, 0x9d, 0xcf, 0x16, 0xa2, 0x3b, 0x50, 0xc6,
+	0x60, 0xe5, 0xe5, 0x7b, 0x64, 0x48, 0x15, 0x81, 0x31, 0x89, 0x32, 0x24, 0x67, 0x23, 0x36, 0xb6,
+	0x82, 0x28, 0x49, 0x44, 0x8b, 0x50, 0x64, 0x49, 0x2b, 0xbe, 0x3d, 0x8f, 0xe3, 0x73, 0xc3, 0xae,
+	0xfd, 0xdf, 0x08, 0x68, 0x78, 0x37, 0x4d, 0x7f, 0x65, 0x72, 0x62, 0x65, 0x4f, 0xbe, 0xdc, 0xc8,
+	0xaf, 0xec, 0x31, 0x3e, 0xfb, 0x51, 0x86, 0xe4, 0x87, 0x91, 0xac, 0x1f, 0xea, 0x30, 0x23, 0xd0,
+	0x72, 0xdb, 0xc9, 0x2f, 0xfb, 0x1c, 0x25, 0x5d, 0xdf, 0xaf, 0x40, 0x45, 0xd0, 0xf3, 0x2e, 0x94,
+	0x5d, 0xf4, 0x45, 0x59, 0x67, 0x17, 0xf8, 0xdc, 0x71, 0x4e, 0x31, 0x7f, 0x9c, 0x73, 0x11, 0x4a,
+	0x71, 0x0c, 0x8b, 0x5a, 0x1d, 0x03, 0xce, 0xf8, 0xd1, 0xc8, 0xe7, 0xf1, 0x17, 0x36, 0xac, 0x3e,
+	0xf2, 0xcc, 0x5c, 0xc6, 0xb6, 0x73, 0xf5, 0x98, 0x36, 0xf6, 0x11, 0x72, 0x60, 0x4d, 0x64, 0x39,
+	0x5b, 0x7c, 0x8b, 0x23, 0x81, 0x06, 0xbe, 0x9c, 0x99, 0x18, 0xf8, 0x72, 0xa6, 0x59, 0x28, 0x16,
+	0xd4, 0xd1, 0x66, 0xa1, 0x38, 0xae, 0x16, 0x6b, 0xdf, 0x2b, 0x30, 0xcd, 0x4d, 0xdc, 0xc2, 0x52,
+	0xf6, 0xa2, 0xb6, 0x37, 0xb7, 0x88, 0x8e, 0xe4, 0xbf, 0x1e, 0xce, 0xda, 0x50, 0x18, 0xb0, 0xa1,
+	0xf6, 0x1f, 0x0a, 0xc0, 0x36, 0xbe, 0x5b, 0x7b, 0x81, 0xf1, 0x38, 0xa0, 0x69, 0x29, 0x38, 0x56,
+	0xc7, 0xf1, 0xe3, 0xfd, 0x3c, 0xaa, 0x8e, 0xb1, 0x9c, 0xc0, 0xa6, 0xe5, 0xb5, 0x6f, 0x14, 0x28,
+	0x6e, 0xed, 0x13, 0xeb, 0x20, 0xec, 0x75, 0xb2, 0x9a, 0x8f, 0x26, 0x9a, 0xdf, 0x85, 0xb1, 0xb6,
+	0x6b, 0x1e, 0xfa, 0x01, 0xea, 0x59, 0xd9, 0xb8, 0x7e, 0xf2, 0x6d, 0x44, 0x48, 0xbc, 0x8f, 0x3c,
+	0x06, 0xe7, 0x4d, 0xbe, 0x5f, 0x1b, 0xc1, 0x86, 0x9d, 0x3d, 0xd4, 0xfe, 0xa4, 0xc0, 0x64, 0xea,
+	0x13, 0x33, 0xed, 0x02, 0x94, 0x92, 0xaf, 0x15, 0x99, 0x0f, 0x8b, 0xa6, 0x40, 0x06, 0x30, 0x2d,
+	0x3e, 0xf4, 0x4c, 0x88, 0xce, 0xe3, 0xb8, 0xf9, 0xfe, 0x99, 0xbf, 0x66, 0x13, 0x1f, 0x79, 0xa6,
+	0x3f, 0xa8, 0x9c, 0xb2, 0xd2, 0xd0, 0xa5, 0x4d, 0x98, 0xcd, 0x23, 0x3c, 0xcb, 0x27, 0x7a, 0x9b,
+	0x7f, 0xff, 0xc3, 0xcf, 0xd5, 0x73, 0x3f, 0xfe, 0x5c, 0x3d, 0xf7, 0xeb, 0xcf, 0x55, 0xe5, 0x9b,
+	0x67, 0x55, 0xe5, 0xdf, 0x9e, 0x55, 0x95, 0xff, 0x79, 0x56, 0x55, 0x7e, 0x78, 0x56, 0x55, 0xfe,
+	0xff, 0x59, 0x55, 0xf9, 0xf3, 0xb3, 0xea, 0xb9, 0x5f, 0x9f, 0x55, 0x95, 0xef, 0x7e, 0xa9, 0x9e,
+	0xfb, 0xe1, 0x97, 0xea, 0xb9, 0x1f, 0x7f, 0xa9, 0x9e, 0xfb, 0xe2, 0xd6, 0x9e, 0x9f, 0x18, 0xe5,
+	0xf8, 0xc7, 0xff, 0x35, 0xe0, 0xb6, 0xf4, 0xb8, 0x3b, 0x86, 0xb9, 0xf8, 0xe6, 0x5f, 0x07, 0x00,
+	0x47, 0x97, 0x28, 0xbf, 0x53, 0x30, 0x00, 0x00,
 }
 
 func (this *ShardInfo) Equal(that interface{}) bool {
@@ -2623,6 +2811,103 @@ func (this *WorkflowExecutionInfo) Equal(that interface{}) bool {
 	if this.NewExecutionRunId != that1.NewExecutionRunId {
 		return false
 	}
+	if this.WorkflowTaskPaused != that1.WorkflowTaskPaused {
+		return false
+	}
+	if len(this.ConflictResolutionRecords) != len(that1.ConflictResolutionRecords) {
+		return false
+	}
+	for i := range this.ConflictResolutionRecords {
+		if !this.ConflictResolutionRecords[i].Equal(that1.ConflictResolutionRecords[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ConflictResolutionRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConflictResolutionRecord)
+	if !ok {
+		that2, ok := that.(ConflictResolutionRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.ResolveTime == nil {
+		if this.ResolveTime != nil {
+			return false
+		}
+	} else if !this.ResolveTime.Equal(*that1.ResolveTime) {
+		return false
+	}
+	if this.SourceRunId != that1.SourceRunId {
+		return false
+	}
+	if this.LosingVersion != that1.LosingVersion {
+		return false
+	}
+	if this.WinningVersion != that1.WinningVersion {
+		return false
+	}
+	if len(this.ReappliedEvents) != len(that1.ReappliedEvents) {
+		return false
+	}
+	for i := range this.ReappliedEvents {
+		if !this.ReappliedEvents[i].Equal(that1.ReappliedEvents[i]) {
+			return false
+		}
+	}
+	if len(this.DroppedEvents) != len(that1.DroppedEvents) {
+		return false
+	}
+	for i := range this.DroppedEvents {
+		if !this.DroppedEvents[i].Equal(that1.DroppedEvents[i]) {
+			return false
+		}
+	}
+	if this.DroppedEventCount != that1.DroppedEventCount {
+		return false
+	}
+	return true
+}
+func (this *ConflictResolutionEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConflictResolutionEvent)
+	if !ok {
+		that2, ok := that.(ConflictResolutionEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EventId != that1.EventId {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.EventType != that1.EventType {
+		return false
+	}
 	return true
 }
 func (this *ExecutionStats) Equal(that interface{}) bool {
@@ -3403,7 +3688,7 @@ func (this *WorkflowExecutionInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 55)
+	s := make([]string, 0, 57)
 	s = append(s, "&persistence.WorkflowExecutionInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -3486,6 +3771,42 @@ func (this *WorkflowExecutionInfo) GoString() string {
 	s = append(s, "StateTransitionCount: "+fmt.Sprintf("%#v", this.StateTransitionCount)+",\n")
 	s = append(s, "ExecutionTime: "+fmt.Sprintf("%#v", this.ExecutionTime)+",\n")
 	s = append(s, "NewExecutionRunId: "+fmt.Sprintf("%#v", this.NewExecutionRunId)+",\n")
+	s = append(s, "WorkflowTaskPaused: "+fmt.Sprintf("%#v", this.WorkflowTaskPaused)+",\n")
+	if this.ConflictResolutionRecords != nil {
+		s = append(s, "ConflictResolutionRecords: "+fmt.Sprintf("%#v", this.ConflictResolutionRecords)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ConflictResolutionRecord) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&persistence.ConflictResolutionRecord{")
+	s = append(s, "ResolveTime: "+fmt.Sprintf("%#v", this.ResolveTime)+",\n")
+	s = append(s, "SourceRunId: "+fmt.Sprintf("%#v", this.SourceRunId)+",\n")
+	s = append(s, "LosingVersion: "+fmt.Sprintf("%#v", this.LosingVersion)+",\n")
+	s = append(s, "WinningVersion: "+fmt.Sprintf("%#v", this.WinningVersion)+",\n")
+	if this.ReappliedEvents != nil {
+		s = append(s, "ReappliedEvents: "+fmt.Sprintf("%#v", this.ReappliedEvents)+",\n")
+	}
+	if this.DroppedEvents != nil {
+		s = append(s, "DroppedEvents: "+fmt.Sprintf("%#v", this.DroppedEvents)+",\n")
+	}
+	s = append(s, "DroppedEventCount: "+fmt.Sprintf("%#v", this.DroppedEventCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ConflictResolutionEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&persistence.ConflictResolutionEvent{")
+	s = append(s, "EventId: "+fmt.Sprintf("%#v", this.EventId)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "EventType: "+fmt.Sprintf("%#v", this.EventType)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3945,6 +4266,34 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConflictResolutionRecords) > 0 {
+		for iNdEx := len(m.ConflictResolutionRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConflictResolutionRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExecutions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if m.WorkflowTaskPaused {
+		i--
+		if m.WorkflowTaskPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xf0
+	}
 	if len(m.NewExecutionRunId) > 0 {
 		i -= len(m.NewExecutionRunId)
 		copy(dAtA[i:], m.NewExecutionRunId)
@@ -4460,7 +4809,7 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ExecutionStats) Marshal() (dAtA []byte, err error) {
+func (m *ConflictResolutionRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4470,33 +4819,154 @@ func (m *ExecutionStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExecutionStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConflictResolutionRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExecutionStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConflictResolutionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.HistorySize != 0 {
-		i = encodeVarintExecutions(dAtA, i, uint64(m.HistorySize))
+	if m.DroppedEventCount != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.DroppedEventCount))
 		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *WorkflowExecutionState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x38
 	}
-	return dAtA[:n], nil
-}
+	if len(m.DroppedEvents) > 0 {
+		for iNdEx := len(m.DroppedEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DroppedEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExecutions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ReappliedEvents) > 0 {
+		for iNdEx := len(m.ReappliedEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReappliedEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExecutions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.WinningVersion != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.WinningVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LosingVersion != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.LosingVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SourceRunId) > 0 {
+		i -= len(m.SourceRunId)
+		copy(dAtA[i:], m.SourceRunId)
+		i = encodeVarintExecutions(dAtA, i, uint64(len(m.SourceRunId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ResolveTime != nil {
+		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ResolveTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ResolveTime):])
+		if err25 != nil {
+			return 0, err25
+		}
+		i -= n25
+		i = encodeVarintExecutions(dAtA, i, uint64(n25))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConflictResolutionEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConflictResolutionEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConflictResolutionEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EventType != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.EventType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EventId != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.EventId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HistorySize != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.HistorySize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowExecutionState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
 
 func (m *WorkflowExecutionState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
@@ -4556,12 +5026,12 @@ func (m *TransferTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VisibilityTime != nil {
-		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err25 != nil {
-			return 0, err25
+		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err26 != nil {
+			return 0, err26
 		}
-		i -= n25
-		i = encodeVarintExecutions(dAtA, i, uint64(n25))
+		i -= n26
+		i = encodeVarintExecutions(dAtA, i, uint64(n26))
 		i--
 		dAtA[i] = 0x6a
 	}
@@ -4668,12 +5138,12 @@ func (m *ReplicationTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VisibilityTime != nil {
-		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err26 != nil {
-			return 0, err26
+		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err27 != nil {
+			return 0, err27
 		}
-		i -= n26
-		i = encodeVarintExecutions(dAtA, i, uint64(n26))
+		i -= n27
+		i = encodeVarintExecutions(dAtA, i, uint64(n27))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4768,33 +5238,33 @@ func (m *VisibilityTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.StartTime != nil {
-		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
-		if err27 != nil {
-			return 0, err27
-		}
-		i -= n27
-		i = encodeVarintExecutions(dAtA, i, uint64(n27))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.CloseTime != nil {
-		n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime):])
+		n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
 		if err28 != nil {
 			return 0, err28
 		}
 		i -= n28
 		i = encodeVarintExecutions(dAtA, i, uint64(n28))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x4a
 	}
-	if m.VisibilityTime != nil {
-		n29, err29 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+	if m.CloseTime != nil {
+		n29, err29 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime):])
 		if err29 != nil {
 			return 0, err29
 		}
 		i -= n29
 		i = encodeVarintExecutions(dAtA, i, uint64(n29))
 		i--
+		dAtA[i] = 0x42
+	}
+	if m.VisibilityTime != nil {
+		n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err30 != nil {
+			return 0, err30
+		}
+		i -= n30
+		i = encodeVarintExecutions(dAtA, i, uint64(n30))
+		i--
 		dAtA[i] = 0x3a
 	}
 	if m.TaskId != 0 {
@@ -4864,12 +5334,12 @@ func (m *TimerTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x62
 	}
 	if m.VisibilityTime != nil {
-		n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err30 != nil {
-			return 0, err30
+		n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err31 != nil {
+			return 0, err31
 		}
-		i -= n30
-		i = encodeVarintExecutions(dAtA, i, uint64(n30))
+		i -= n31
+		i = encodeVarintExecutions(dAtA, i, uint64(n31))
 		i--
 		dAtA[i] = 0x5a
 	}
@@ -4953,12 +5423,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.LastHeartbeatUpdateTime != nil {
-		n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime):])
-		if err31 != nil {
-			return 0, err31
+		n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime):])
+		if err32 != nil {
+			return 0, err32
 		}
-		i -= n31
-		i = encodeVarintExecutions(dAtA, i, uint64(n31))
+		i -= n32
+		i = encodeVarintExecutions(dAtA, i, uint64(n32))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -5037,12 +5507,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc9
 	}
 	if m.RetryExpirationTime != nil {
-		n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
-		if err34 != nil {
-			return 0, err34
+		n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
+		if err35 != nil {
+			return 0, err35
 		}
-		i -= n34
-		i = encodeVarintExecutions(dAtA, i, uint64(n34))
+		i -= n35
+		i = encodeVarintExecutions(dAtA, i, uint64(n35))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -5056,24 +5526,24 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb8
 	}
 	if m.RetryMaximumInterval != nil {
-		n35, err35 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
-		if err35 != nil {
-			return 0, err35
+		n36, err36 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
+		if err36 != nil {
+			return 0, err36
 		}
-		i -= n35
-		i = encodeVarintExecutions(dAtA, i, uint64(n35))
+		i -= n36
+		i = encodeVarintExecutions(dAtA, i, uint64(n36))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xb2
 	}
 	if m.RetryInitialInterval != nil {
-		n36, err36 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
-		if err36 != nil {
-			return 0, err36
+		n37, err37 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
+		if err37 != nil {
+			return 0, err37
 		}
-		i -= n36
-		i = encodeVarintExecutions(dAtA, i, uint64(n36))
+		i -= n37
+		i = encodeVarintExecutions(dAtA, i, uint64(n37))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -5139,43 +5609,43 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x70
 	}
 	if m.HeartbeatTimeout != nil {
-		n37, err37 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
-		if err37 != nil {
-			return 0, err37
-		}
-		i -= n37
-		i = encodeVarintExecutions(dAtA, i, uint64(n37))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if m.StartToCloseTimeout != nil {
-		n38, err38 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
+		n38, err38 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
 		if err38 != nil {
 			return 0, err38
 		}
 		i -= n38
 		i = encodeVarintExecutions(dAtA, i, uint64(n38))
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x6a
 	}
-	if m.ScheduleToCloseTimeout != nil {
-		n39, err39 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
+	if m.StartToCloseTimeout != nil {
+		n39, err39 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
 		if err39 != nil {
 			return 0, err39
 		}
 		i -= n39
 		i = encodeVarintExecutions(dAtA, i, uint64(n39))
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x62
 	}
-	if m.ScheduleToStartTimeout != nil {
-		n40, err40 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
+	if m.ScheduleToCloseTimeout != nil {
+		n40, err40 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
 		if err40 != nil {
 			return 0, err40
 		}
 		i -= n40
 		i = encodeVarintExecutions(dAtA, i, uint64(n40))
 		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ScheduleToStartTimeout != nil {
+		n41, err41 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
+		if err41 != nil {
+			return 0, err41
+		}
+		i -= n41
+		i = encodeVarintExecutions(dAtA, i, uint64(n41))
+		i--
 		dAtA[i] = 0x52
 	}
 	if len(m.RequestId) > 0 {
@@ -5193,12 +5663,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if m.StartedTime != nil {
-		n41, err41 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err41 != nil {
-			return 0, err41
+		n42, err42 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err42 != nil {
+			return 0, err42
 		}
-		i -= n41
-		i = encodeVarintExecutions(dAtA, i, uint64(n41))
+		i -= n42
+		i = encodeVarintExecutions(dAtA, i, uint64(n42))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -5208,12 +5678,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x28
 	}
 	if m.ScheduledTime != nil {
-		n42, err42 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err42 != nil {
-			return 0, err42
+		n43, err43 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err43 != nil {
+			return 0, err43
 		}
-		i -= n42
-		i = encodeVarintExecutions(dAtA, i, uint64(n42))
+		i -= n43
+		i = encodeVarintExecutions(dAtA, i, uint64(n43))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -5263,12 +5733,12 @@ func (m *TimerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x20
 	}
 	if m.ExpiryTime != nil {
-		n43, err43 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
-		if err43 != nil {
-			return 0, err43
+		n44, err44 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err44 != nil {
+			return 0, err44
 		}
-		i -= n43
-		i = encodeVarintExecutions(dAtA, i, uint64(n43))
+		i -= n44
+		i = encodeVarintExecutions(dAtA, i, uint64(n44))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5859,57 +6329,122 @@ func (m *WorkflowExecutionInfo) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovExecutions(uint64(l))
 	}
-	return n
-}
-
-func (m *ExecutionStats) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.WorkflowTaskPaused {
+		n += 3
 	}
-	var l int
-	_ = l
-	if m.HistorySize != 0 {
-		n += 1 + sovExecutions(uint64(m.HistorySize))
+	if len(m.ConflictResolutionRecords) > 0 {
+		for _, e := range m.ConflictResolutionRecords {
+			l = e.Size()
+			n += 2 + l + sovExecutions(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *WorkflowExecutionState) Size() (n int) {
+func (m *ConflictResolutionRecord) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.CreateRequestId)
-	if l > 0 {
+	if m.ResolveTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ResolveTime)
 		n += 1 + l + sovExecutions(uint64(l))
 	}
-	l = len(m.RunId)
+	l = len(m.SourceRunId)
 	if l > 0 {
 		n += 1 + l + sovExecutions(uint64(l))
 	}
-	if m.State != 0 {
-		n += 1 + sovExecutions(uint64(m.State))
+	if m.LosingVersion != 0 {
+		n += 1 + sovExecutions(uint64(m.LosingVersion))
 	}
-	if m.Status != 0 {
-		n += 1 + sovExecutions(uint64(m.Status))
+	if m.WinningVersion != 0 {
+		n += 1 + sovExecutions(uint64(m.WinningVersion))
+	}
+	if len(m.ReappliedEvents) > 0 {
+		for _, e := range m.ReappliedEvents {
+			l = e.Size()
+			n += 1 + l + sovExecutions(uint64(l))
+		}
+	}
+	if len(m.DroppedEvents) > 0 {
+		for _, e := range m.DroppedEvents {
+			l = e.Size()
+			n += 1 + l + sovExecutions(uint64(l))
+		}
+	}
+	if m.DroppedEventCount != 0 {
+		n += 1 + sovExecutions(uint64(m.DroppedEventCount))
 	}
 	return n
 }
 
-func (m *TransferTaskInfo) Size() (n int) {
+func (m *ConflictResolutionEvent) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovExecutions(uint64(l))
-	}
-	l = len(m.WorkflowId)
-	if l > 0 {
-		n += 1 + l + sovExecutions(uint64(l))
+	if m.EventId != 0 {
+		n += 1 + sovExecutions(uint64(m.EventId))
+	}
+	if m.Version != 0 {
+		n += 1 + sovExecutions(uint64(m.Version))
+	}
+	if m.EventType != 0 {
+		n += 1 + sovExecutions(uint64(m.EventType))
+	}
+	return n
+}
+
+func (m *ExecutionStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HistorySize != 0 {
+		n += 1 + sovExecutions(uint64(m.HistorySize))
+	}
+	return n
+}
+
+func (m *WorkflowExecutionState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CreateRequestId)
+	if l > 0 {
+		n += 1 + l + sovExecutions(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovExecutions(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovExecutions(uint64(m.State))
+	}
+	if m.Status != 0 {
+		n += 1 + sovExecutions(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *TransferTaskInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovExecutions(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovExecutions(uint64(l))
 	}
 	l = len(m.RunId)
 	if l > 0 {
@@ -6453,6 +6988,11 @@ func (this *WorkflowExecutionInfo) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForConflictResolutionRecords := "[]*ConflictResolutionRecord{"
+	for _, f := range this.ConflictResolutionRecords {
+		repeatedStringForConflictResolutionRecords += strings.Replace(f.String(), "ConflictResolutionRecord", "ConflictResolutionRecord", 1) + ","
+	}
+	repeatedStringForConflictResolutionRecords += "}"
 	keysForSearchAttributes := make([]string, 0, len(this.SearchAttributes))
 	for k, _ := range this.SearchAttributes {
 		keysForSearchAttributes = append(keysForSearchAttributes, k)
@@ -6525,6 +7065,46 @@ func (this *WorkflowExecutionInfo) String() string {
 		`StateTransitionCount:` + fmt.Sprintf("%v", this.StateTransitionCount) + `,`,
 		`ExecutionTime:` + strings.Replace(fmt.Sprintf("%v", this.ExecutionTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`NewExecutionRunId:` + fmt.Sprintf("%v", this.NewExecutionRunId) + `,`,
+		`WorkflowTaskPaused:` + fmt.Sprintf("%v", this.WorkflowTaskPaused) + `,`,
+		`ConflictResolutionRecords:` + repeatedStringForConflictResolutionRecords + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConflictResolutionRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForReappliedEvents := "[]*ConflictResolutionEvent{"
+	for _, f := range this.ReappliedEvents {
+		repeatedStringForReappliedEvents += strings.Replace(f.String(), "ConflictResolutionEvent", "ConflictResolutionEvent", 1) + ","
+	}
+	repeatedStringForReappliedEvents += "}"
+	repeatedStringForDroppedEvents := "[]*ConflictResolutionEvent{"
+	for _, f := range this.DroppedEvents {
+		repeatedStringForDroppedEvents += strings.Replace(f.String(), "ConflictResolutionEvent", "ConflictResolutionEvent", 1) + ","
+	}
+	repeatedStringForDroppedEvents += "}"
+	s := strings.Join([]string{`&ConflictResolutionRecord{`,
+		`ResolveTime:` + strings.Replace(fmt.Sprintf("%v", this.ResolveTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`SourceRunId:` + fmt.Sprintf("%v", this.SourceRunId) + `,`,
+		`LosingVersion:` + fmt.Sprintf("%v", this.LosingVersion) + `,`,
+		`WinningVersion:` + fmt.Sprintf("%v", this.WinningVersion) + `,`,
+		`ReappliedEvents:` + repeatedStringForReappliedEvents + `,`,
+		`DroppedEvents:` + repeatedStringForDroppedEvents + `,`,
+		`DroppedEventCount:` + fmt.Sprintf("%v", this.DroppedEventCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConflictResolutionEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConflictResolutionEvent{`,
+		`EventId:` + fmt.Sprintf("%v", this.EventId) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`EventType:` + fmt.Sprintf("%v", this.EventType) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -9345,6 +9925,416 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.NewExecutionRunId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 62:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WorkflowTaskPaused = bool(v != 0)
+		case 63:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictResolutionRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConflictResolutionRecords = append(m.ConflictResolutionRecords, &ConflictResolutionRecord{})
+			if err := m.ConflictResolutionRecords[len(m.ConflictResolutionRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConflictResolutionRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConflictResolutionRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConflictResolutionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResolveTime == nil {
+				m.ResolveTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ResolveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceRunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceRunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LosingVersion", wireType)
+			}
+			m.LosingVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LosingVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningVersion", wireType)
+			}
+			m.WinningVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WinningVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReappliedEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReappliedEvents = append(m.ReappliedEvents, &ConflictResolutionEvent{})
+			if err := m.ReappliedEvents[len(m.ReappliedEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DroppedEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DroppedEvents = append(m.DroppedEvents, &ConflictResolutionEvent{})
+			if err := m.DroppedEvents[len(m.DroppedEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DroppedEventCount", wireType)
+			}
+			m.DroppedEventCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DroppedEventCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConflictResolutionEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConflictResolutionEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConflictResolutionEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventId", wireType)
+			}
+			m.EventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			m.EventType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventType |= v13.EventType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
@@ -9548,7 +10538,7 @@ func (m *WorkflowExecutionState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= v13.WorkflowExecutionState(b&0x7F) << shift
+				m.State |= v14.WorkflowExecutionState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9567,7 +10557,7 @@ func (m *WorkflowExecutionState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= v14.WorkflowExecutionStatus(b&0x7F) << shift
+				m.Status |= v13.WorkflowExecutionStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9735,7 +10725,7 @@ func (m *TransferTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskType |= v13.TaskType(b&0x7F) << shift
+				m.TaskType |= v14.TaskType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10144,7 +11134,7 @@ func (m *ReplicationTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskType |= v13.TaskType(b&0x7F) << shift
+				m.TaskType |= v14.TaskType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10511,7 +11501,7 @@ func (m *VisibilityTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskType |= v13.TaskType(b&0x7F) << shift
+				m.TaskType |= v14.TaskType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10825,7 +11815,7 @@ func (m *TimerTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskType |= v13.TaskType(b&0x7F) << shift
+				m.TaskType |= v14.TaskType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10844,7 +11834,7 @@ func (m *TimerTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeoutType |= v14.TimeoutType(b&0x7F) << shift
+				m.TimeoutType |= v13.TimeoutType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10863,7 +11853,7 @@ func (m *TimerTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkflowBackoffType |= v13.WorkflowBackoffType(b&0x7F) << shift
+				m.WorkflowBackoffType |= v14.WorkflowBackoffType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12388,7 +13378,7 @@ func (m *ChildExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ParentClosePolicy |= v14.ParentClosePolicy(b&0x7F) << shift
+				m.ParentClosePolicy |= v13.ParentClosePolicy(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12782,7 +13772,7 @@ func (m *Checksum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Flavor |= v13.ChecksumFlavor(b&0x7F) << shift
+				m.Flavor |= v14.ChecksumFlavor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
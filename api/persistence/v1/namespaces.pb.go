@@ -156,6 +156,10 @@ type NamespaceInfo struct {
 	Description string            `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	Owner       string            `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
 	Data        map[string]string `protobuf:"bytes,6,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Previous names of a renamed namespace, which still resolve to it until they expire.
+	NameAliases []*NamespaceNameAlias `protobuf:"bytes,7,rep,name=name_aliases,json=nameAliases,proto3" json:"name_aliases,omitempty"`
+	// API keys which authenticate callers to this namespace.
+	ApiKeys []*NamespaceApiKey `protobuf:"bytes,8,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
 }
 
 func (m *NamespaceInfo) Reset()      { *m = NamespaceInfo{} }
@@ -232,6 +236,173 @@ func (m *NamespaceInfo) GetData() map[string]string {
 	return nil
 }
 
+func (m *NamespaceInfo) GetNameAliases() []*NamespaceNameAlias {
+	if m != nil {
+		return m.NameAliases
+	}
+	return nil
+}
+
+func (m *NamespaceInfo) GetApiKeys() []*NamespaceApiKey {
+	if m != nil {
+		return m.ApiKeys
+	}
+	return nil
+}
+
+type NamespaceNameAlias struct {
+	Name           string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExpirationTime *time.Time `protobuf:"bytes,2,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
+}
+
+func (m *NamespaceNameAlias) Reset()      { *m = NamespaceNameAlias{} }
+func (*NamespaceNameAlias) ProtoMessage() {}
+func (*NamespaceNameAlias) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0486d93c2107d6bc, []int{2}
+}
+func (m *NamespaceNameAlias) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceNameAlias) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceNameAlias.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceNameAlias) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceNameAlias.Merge(m, src)
+}
+func (m *NamespaceNameAlias) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceNameAlias) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceNameAlias.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceNameAlias proto.InternalMessageInfo
+
+func (m *NamespaceNameAlias) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NamespaceNameAlias) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return nil
+}
+
+type NamespaceApiKey struct {
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Namespace permission granted to the key: read, write, worker or admin.
+	Role string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	// Hex encoded SHA-256 of the key secret, the secret itself is never stored.
+	SecretHash string     `protobuf:"bytes,4,opt,name=secret_hash,json=secretHash,proto3" json:"secret_hash,omitempty"`
+	CreateTime *time.Time `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3,stdtime" json:"create_time,omitempty"`
+	RotateTime *time.Time `protobuf:"bytes,6,opt,name=rotate_time,json=rotateTime,proto3,stdtime" json:"rotate_time,omitempty"`
+	// Secret before the last rotation, it stays valid until previous_secret_expiration_time.
+	PreviousSecretHash           string     `protobuf:"bytes,7,opt,name=previous_secret_hash,json=previousSecretHash,proto3" json:"previous_secret_hash,omitempty"`
+	PreviousSecretExpirationTime *time.Time `protobuf:"bytes,8,opt,name=previous_secret_expiration_time,json=previousSecretExpirationTime,proto3,stdtime" json:"previous_secret_expiration_time,omitempty"`
+}
+
+func (m *NamespaceApiKey) Reset()      { *m = NamespaceApiKey{} }
+func (*NamespaceApiKey) ProtoMessage() {}
+func (*NamespaceApiKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0486d93c2107d6bc, []int{3}
+}
+func (m *NamespaceApiKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceApiKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceApiKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceApiKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceApiKey.Merge(m, src)
+}
+func (m *NamespaceApiKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceApiKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceApiKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceApiKey proto.InternalMessageInfo
+
+func (m *NamespaceApiKey) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *NamespaceApiKey) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NamespaceApiKey) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *NamespaceApiKey) GetSecretHash() string {
+	if m != nil {
+		return m.SecretHash
+	}
+	return ""
+}
+
+func (m *NamespaceApiKey) GetCreateTime() *time.Time {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *NamespaceApiKey) GetRotateTime() *time.Time {
+	if m != nil {
+		return m.RotateTime
+	}
+	return nil
+}
+
+func (m *NamespaceApiKey) GetPreviousSecretHash() string {
+	if m != nil {
+		return m.PreviousSecretHash
+	}
+	return ""
+}
+
+func (m *NamespaceApiKey) GetPreviousSecretExpirationTime() *time.Time {
+	if m != nil {
+		return m.PreviousSecretExpirationTime
+	}
+	return nil
+}
+
 type NamespaceConfig struct {
 	Retention               *time.Duration   `protobuf:"bytes,1,opt,name=retention,proto3,stdduration" json:"retention,omitempty"`
 	ArchivalBucket          string           `protobuf:"bytes,2,opt,name=archival_bucket,json=archivalBucket,proto3" json:"archival_bucket,omitempty"`
@@ -245,7 +416,7 @@ type NamespaceConfig struct {
 func (m *NamespaceConfig) Reset()      { *m = NamespaceConfig{} }
 func (*NamespaceConfig) ProtoMessage() {}
 func (*NamespaceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0486d93c2107d6bc, []int{2}
+	return fileDescriptor_0486d93c2107d6bc, []int{4}
 }
 func (m *NamespaceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -327,12 +498,15 @@ type NamespaceReplicationConfig struct {
 	ActiveClusterName string              `protobuf:"bytes,1,opt,name=active_cluster_name,json=activeClusterName,proto3" json:"active_cluster_name,omitempty"`
 	Clusters          []string            `protobuf:"bytes,2,rep,name=clusters,proto3" json:"clusters,omitempty"`
 	State             v1.ReplicationState `protobuf:"varint,3,opt,name=state,proto3,enum=temporal.api.enums.v1.ReplicationState" json:"state,omitempty"`
+	// Visibility style predicate, only workflow executions matching it are replicated to remote clusters.
+	// Empty filter replicates all workflow executions.
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
 func (m *NamespaceReplicationConfig) Reset()      { *m = NamespaceReplicationConfig{} }
 func (*NamespaceReplicationConfig) ProtoMessage() {}
 func (*NamespaceReplicationConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0486d93c2107d6bc, []int{3}
+	return fileDescriptor_0486d93c2107d6bc, []int{5}
 }
 func (m *NamespaceReplicationConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,10 +556,19 @@ func (m *NamespaceReplicationConfig) GetState() v1.ReplicationState {
 	return v1.REPLICATION_STATE_UNSPECIFIED
 }
 
+func (m *NamespaceReplicationConfig) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*NamespaceDetail)(nil), "temporal.server.api.persistence.v1.NamespaceDetail")
 	proto.RegisterType((*NamespaceInfo)(nil), "temporal.server.api.persistence.v1.NamespaceInfo")
 	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.persistence.v1.NamespaceInfo.DataEntry")
+	proto.RegisterType((*NamespaceNameAlias)(nil), "temporal.server.api.persistence.v1.NamespaceNameAlias")
+	proto.RegisterType((*NamespaceApiKey)(nil), "temporal.server.api.persistence.v1.NamespaceApiKey")
 	proto.RegisterType((*NamespaceConfig)(nil), "temporal.server.api.persistence.v1.NamespaceConfig")
 	proto.RegisterType((*NamespaceReplicationConfig)(nil), "temporal.server.api.persistence.v1.NamespaceReplicationConfig")
 }
@@ -395,59 +578,72 @@ func init() {
 }
 
 var fileDescriptor_0486d93c2107d6bc = []byte{
-	// 832 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x73, 0xe3, 0x34,
-	0x1c, 0x8d, 0x93, 0x34, 0x8b, 0x15, 0x36, 0xdd, 0x8a, 0xc2, 0x66, 0xc3, 0xe0, 0x0d, 0x19, 0x4a,
-	0xc3, 0xc5, 0x26, 0x2d, 0x03, 0x0c, 0x9d, 0x65, 0x86, 0x6c, 0x7b, 0xd8, 0x81, 0x59, 0x66, 0x0c,
-	0xcb, 0xa1, 0x17, 0xa3, 0xd8, 0x4a, 0x2a, 0xea, 0x48, 0x1e, 0x49, 0x31, 0xd3, 0x1b, 0x1f, 0xa1,
-	0x47, 0xbe, 0x01, 0x9c, 0xf9, 0x14, 0x1c, 0x7b, 0xec, 0x0d, 0x9a, 0x5e, 0x38, 0x70, 0xe8, 0x91,
-	0x23, 0x63, 0x49, 0xb6, 0x93, 0x86, 0x0c, 0x93, 0x9b, 0xf5, 0xd3, 0x7b, 0x4f, 0xbf, 0x3f, 0x4f,
-	0x32, 0x38, 0x94, 0x78, 0x9a, 0x30, 0x8e, 0x62, 0x4f, 0x60, 0x9e, 0x62, 0xee, 0xa1, 0x84, 0x78,
-	0x09, 0xe6, 0x82, 0x08, 0x89, 0x69, 0x88, 0xbd, 0x74, 0xe0, 0x51, 0x34, 0xc5, 0x22, 0x41, 0x21,
-	0x16, 0x6e, 0xc2, 0x99, 0x64, 0xb0, 0x97, 0x93, 0x5c, 0x4d, 0x72, 0x51, 0x42, 0xdc, 0x05, 0x92,
-	0x9b, 0x0e, 0x3a, 0xce, 0x84, 0xb1, 0x49, 0x8c, 0x3d, 0xc5, 0x18, 0xcd, 0xc6, 0x5e, 0x34, 0xe3,
-	0x48, 0x12, 0x46, 0xb5, 0x46, 0xe7, 0xe9, 0xfd, 0x7d, 0x49, 0xa6, 0x58, 0x48, 0x34, 0x4d, 0x0c,
-	0xe0, 0xdd, 0x08, 0x27, 0x98, 0x46, 0x98, 0x86, 0x04, 0x0b, 0x6f, 0xc2, 0x26, 0x4c, 0xc5, 0xd5,
-	0x97, 0x81, 0xec, 0x15, 0xc9, 0x67, 0x59, 0x63, 0x3a, 0x9b, 0x8a, 0xa5, 0x7c, 0x0d, 0x6c, 0x7f,
-	0x09, 0x56, 0xec, 0x66, 0xd0, 0x29, 0x16, 0x02, 0x4d, 0x0c, 0xb0, 0xf7, 0x4f, 0x0d, 0x6c, 0xbf,
-	0xcc, 0xb7, 0x8f, 0xb1, 0x44, 0x24, 0x86, 0x27, 0xa0, 0x4e, 0xe8, 0x98, 0xb5, 0xad, 0xae, 0xd5,
-	0x6f, 0x1e, 0x0c, 0xdc, 0xff, 0x2f, 0xdd, 0x2d, 0x24, 0x5e, 0xd0, 0x31, 0xf3, 0x15, 0x1d, 0x7e,
-	0x09, 0x1a, 0x21, 0xa3, 0x63, 0x32, 0x69, 0x57, 0x95, 0xd0, 0xe1, 0x46, 0x42, 0xcf, 0x15, 0xd5,
-	0x37, 0x12, 0x70, 0x0a, 0x20, 0xc7, 0x49, 0x4c, 0x42, 0xd5, 0xd0, 0xc0, 0x08, 0xd7, 0x94, 0xf0,
-	0xe7, 0x1b, 0x09, 0xfb, 0xa5, 0x8c, 0x39, 0x63, 0x87, 0xdf, 0x0f, 0xc1, 0x3d, 0xd0, 0xd2, 0x47,
-	0x04, 0x69, 0x26, 0xc3, 0x68, 0xbb, 0xde, 0xb5, 0xfa, 0x35, 0xff, 0xa1, 0x8e, 0x7e, 0xa7, 0x83,
-	0x70, 0x08, 0xde, 0x19, 0x23, 0x12, 0xb3, 0x14, 0xf3, 0x80, 0x32, 0x49, 0xc6, 0x79, 0x7e, 0x39,
-	0x6b, 0x4b, 0xb1, 0xde, 0xce, 0x41, 0x2f, 0x17, 0x30, 0xb9, 0xc6, 0x07, 0xe0, 0x51, 0xa1, 0x91,
-	0xd3, 0x1a, 0x8a, 0xb6, 0x9d, 0xc7, 0x73, 0xe8, 0x57, 0x60, 0xa7, 0x80, 0x62, 0x1a, 0x05, 0x99,
-	0x7f, 0xda, 0x0f, 0x54, 0x0f, 0x3a, 0xae, 0x36, 0x97, 0x9b, 0x9b, 0xcb, 0xfd, 0x36, 0x37, 0xd7,
-	0xb0, 0x7e, 0xf9, 0xc7, 0x53, 0xab, 0x54, 0x3b, 0xa1, 0x51, 0xb6, 0xd7, 0xfb, 0xad, 0x0a, 0x1e,
-	0x2e, 0xcd, 0x0d, 0xb6, 0x40, 0x95, 0x44, 0x6a, 0xec, 0xb6, 0x5f, 0x25, 0x11, 0x3c, 0x02, 0x5b,
-	0x42, 0x22, 0x89, 0xd5, 0x00, 0x5b, 0x07, 0x7b, 0x65, 0x9f, 0xb3, 0x06, 0x2b, 0xf3, 0x2d, 0xb5,
-	0xf6, 0x9b, 0x0c, 0xec, 0x6b, 0x0e, 0x84, 0xa0, 0x9e, 0xf9, 0x4e, 0xcd, 0xc8, 0xf6, 0xd5, 0x37,
-	0xec, 0x82, 0x66, 0x84, 0x45, 0xc8, 0x49, 0x22, 0xf3, 0x9e, 0xda, 0xfe, 0x62, 0x08, 0xee, 0x82,
-	0x2d, 0xf6, 0x23, 0xc5, 0x5c, 0x75, 0xce, 0xf6, 0xf5, 0x02, 0x7e, 0x0d, 0xea, 0x11, 0x92, 0xa8,
-	0xdd, 0xe8, 0xd6, 0xfa, 0xcd, 0x83, 0xa3, 0x8d, 0x1d, 0xe9, 0x1e, 0x23, 0x89, 0x4e, 0xa8, 0xe4,
-	0x17, 0xbe, 0x12, 0xea, 0x7c, 0x02, 0xec, 0x22, 0x04, 0x1f, 0x81, 0xda, 0x39, 0xbe, 0x30, 0x75,
-	0x67, 0x9f, 0x59, 0x16, 0x29, 0x8a, 0x67, 0xba, 0x70, 0xdb, 0xd7, 0x8b, 0xcf, 0xaa, 0x9f, 0x5a,
-	0xbd, 0xbf, 0x17, 0xef, 0x8b, 0x31, 0xcb, 0x33, 0x60, 0x73, 0x2c, 0x31, 0x55, 0x35, 0xe9, 0x4b,
-	0xf3, 0x64, 0x65, 0x1c, 0xc7, 0xe6, 0x2d, 0x18, 0xd6, 0x7f, 0xce, 0xa6, 0x51, 0x32, 0xe0, 0x3e,
-	0xd8, 0x46, 0x3c, 0x3c, 0x23, 0x29, 0x8a, 0x83, 0xd1, 0x2c, 0x3c, 0xc7, 0xd2, 0x1c, 0xdb, 0xca,
-	0xc3, 0x43, 0x15, 0x85, 0x2f, 0xc0, 0xeb, 0x23, 0x14, 0x05, 0x23, 0x42, 0x11, 0x27, 0x58, 0x18,
-	0xf7, 0xbf, 0xbf, 0x3c, 0x95, 0xf2, 0x25, 0x48, 0x07, 0xee, 0x10, 0x45, 0x43, 0x83, 0xf6, 0x9b,
-	0xa3, 0x72, 0x01, 0x4f, 0xc1, 0x5b, 0x67, 0x44, 0x48, 0xc6, 0x2f, 0x82, 0xe2, 0x6c, 0x3d, 0xea,
-	0xba, 0x1a, 0xf5, 0x7b, 0x6b, 0x46, 0xfd, 0x85, 0x01, 0xeb, 0x49, 0xef, 0x1a, 0x8d, 0xa5, 0x28,
-	0xfc, 0x10, 0xec, 0xae, 0x68, 0xcf, 0x38, 0x31, 0x13, 0x85, 0xf7, 0x38, 0xaf, 0x38, 0x81, 0xdf,
-	0x83, 0x27, 0x29, 0x11, 0x64, 0x44, 0x62, 0x22, 0x57, 0x12, 0x6a, 0x6c, 0x90, 0xd0, 0xe3, 0x52,
-	0x66, 0x39, 0xa7, 0x8f, 0xc1, 0xe3, 0xff, 0x3a, 0x21, 0x4b, 0xeb, 0x81, 0x4a, 0xeb, 0xcd, 0x55,
-	0xe6, 0x2b, 0x4e, 0x7a, 0xbf, 0x58, 0xa0, 0xb3, 0xfe, 0xe5, 0x80, 0x2e, 0x78, 0x03, 0x85, 0x92,
-	0xa4, 0x38, 0x08, 0xe3, 0x99, 0x90, 0xd9, 0x2b, 0x90, 0x59, 0x5e, 0x3b, 0x69, 0x47, 0x6f, 0x3d,
-	0xd7, 0x3b, 0x99, 0x0a, 0xec, 0x80, 0xd7, 0x0c, 0x50, 0xb4, 0xab, 0xdd, 0x5a, 0xdf, 0xf6, 0x8b,
-	0x35, 0x7c, 0x96, 0x5f, 0xb6, 0x9a, 0x2a, 0x78, 0x7f, 0x4d, 0xc1, 0x0b, 0x49, 0x2c, 0x5e, 0xb7,
-	0xe1, 0x0f, 0x57, 0x37, 0x4e, 0xe5, 0xfa, 0xc6, 0xa9, 0xdc, 0xdd, 0x38, 0xd6, 0x4f, 0x73, 0xc7,
-	0xfa, 0x75, 0xee, 0x58, 0xbf, 0xcf, 0x1d, 0xeb, 0x6a, 0xee, 0x58, 0x7f, 0xce, 0x1d, 0xeb, 0xaf,
-	0xb9, 0x53, 0xb9, 0x9b, 0x3b, 0xd6, 0xe5, 0xad, 0x53, 0xb9, 0xba, 0x75, 0x2a, 0xd7, 0xb7, 0x4e,
-	0xe5, 0xf4, 0xa3, 0x09, 0x2b, 0xcf, 0x21, 0x6c, 0xfd, 0x1f, 0xf1, 0x68, 0x61, 0x39, 0x6a, 0x28,
-	0x57, 0x1f, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x06, 0x41, 0x1e, 0xc1, 0x4a, 0x07, 0x00, 0x00,
+	// 1029 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xbf, 0x6f, 0x23, 0x45,
+	0x14, 0xf6, 0xda, 0x8e, 0x13, 0x8f, 0xef, 0x92, 0xcb, 0x10, 0xee, 0x7c, 0x06, 0x36, 0x21, 0x22,
+	0x24, 0x34, 0x6b, 0x92, 0xa0, 0x03, 0x11, 0x1d, 0x52, 0x7c, 0x89, 0x44, 0x74, 0x28, 0x48, 0x7b,
+	0x1c, 0x12, 0xd7, 0x2c, 0xe3, 0xdd, 0x67, 0x67, 0xc8, 0x7a, 0x67, 0x35, 0x33, 0x5e, 0x48, 0x47,
+	0x4f, 0x73, 0x25, 0x7f, 0x02, 0xff, 0x05, 0x2d, 0x65, 0xca, 0x6b, 0x10, 0xc4, 0x69, 0x28, 0x28,
+	0xae, 0xa4, 0x44, 0x33, 0xb3, 0x3f, 0xfc, 0x83, 0x88, 0xb8, 0xca, 0xcc, 0x9b, 0xf7, 0x7d, 0xf3,
+	0xed, 0x7b, 0x9f, 0xdf, 0x04, 0xed, 0x4b, 0x18, 0xc4, 0x8c, 0x93, 0xb0, 0x2d, 0x80, 0x27, 0xc0,
+	0xdb, 0x24, 0xa6, 0xed, 0x18, 0xb8, 0xa0, 0x42, 0x42, 0xe4, 0x43, 0x3b, 0xd9, 0x6d, 0x47, 0x64,
+	0x00, 0x22, 0x26, 0x3e, 0x08, 0x27, 0xe6, 0x4c, 0x32, 0xbc, 0x99, 0x81, 0x1c, 0x03, 0x72, 0x48,
+	0x4c, 0x9d, 0x31, 0x90, 0x93, 0xec, 0xb6, 0xec, 0x3e, 0x63, 0xfd, 0x10, 0xda, 0x1a, 0xd1, 0x1d,
+	0xf6, 0xda, 0xc1, 0x90, 0x13, 0x49, 0x59, 0x64, 0x38, 0x5a, 0xeb, 0xd3, 0xe7, 0x92, 0x0e, 0x40,
+	0x48, 0x32, 0x88, 0xd3, 0x84, 0x77, 0x03, 0x88, 0x21, 0x0a, 0x20, 0xf2, 0x29, 0x88, 0x76, 0x9f,
+	0xf5, 0x99, 0x8e, 0xeb, 0x55, 0x9a, 0xb2, 0x95, 0x8b, 0x57, 0xaa, 0x21, 0x1a, 0x0e, 0xc4, 0x84,
+	0xde, 0x34, 0x6d, 0x7b, 0x22, 0x2d, 0x3f, 0x55, 0xa9, 0x03, 0x10, 0x82, 0xf4, 0xd3, 0xc4, 0xcd,
+	0x7f, 0x2a, 0x68, 0xe5, 0x34, 0x3b, 0x3e, 0x02, 0x49, 0x68, 0x88, 0x8f, 0x51, 0x95, 0x46, 0x3d,
+	0xd6, 0xb4, 0x36, 0xac, 0x9d, 0xc6, 0xde, 0xae, 0xf3, 0xff, 0x9f, 0xee, 0xe4, 0x14, 0x27, 0x51,
+	0x8f, 0xb9, 0x1a, 0x8e, 0x9f, 0xa2, 0x9a, 0xcf, 0xa2, 0x1e, 0xed, 0x37, 0xcb, 0x9a, 0x68, 0x7f,
+	0x2e, 0xa2, 0x27, 0x1a, 0xea, 0xa6, 0x14, 0x78, 0x80, 0x30, 0x87, 0x38, 0xa4, 0xbe, 0x2e, 0xa8,
+	0x97, 0x12, 0x57, 0x34, 0xf1, 0x67, 0x73, 0x11, 0xbb, 0x05, 0x4d, 0x7a, 0xc7, 0x2a, 0x9f, 0x0e,
+	0xe1, 0x2d, 0xb4, 0x6c, 0xae, 0xf0, 0x12, 0x45, 0xc3, 0xa2, 0x66, 0x75, 0xc3, 0xda, 0xa9, 0xb8,
+	0x77, 0x4d, 0xf4, 0x6b, 0x13, 0xc4, 0x1d, 0xf4, 0x4e, 0x8f, 0xd0, 0x90, 0x25, 0xc0, 0xbd, 0x88,
+	0x49, 0xda, 0xcb, 0xf4, 0x65, 0xa8, 0x05, 0x8d, 0x7a, 0x2b, 0x4b, 0x3a, 0x1d, 0xcb, 0xc9, 0x38,
+	0x3e, 0x40, 0xf7, 0x72, 0x8e, 0x0c, 0x56, 0xd3, 0xb0, 0x95, 0x2c, 0x9e, 0xa5, 0x7e, 0x81, 0x56,
+	0xf3, 0x54, 0x88, 0x02, 0x4f, 0xf9, 0xa7, 0xb9, 0xa8, 0x6b, 0xd0, 0x72, 0x8c, 0xb9, 0x9c, 0xcc,
+	0x5c, 0xce, 0x57, 0x99, 0xb9, 0x3a, 0xd5, 0x97, 0x7f, 0xac, 0x5b, 0x05, 0xdb, 0x71, 0x14, 0xa8,
+	0xb3, 0xcd, 0xdf, 0x2b, 0xe8, 0xee, 0x44, 0xdf, 0xf0, 0x32, 0x2a, 0xd3, 0x40, 0xb7, 0xbd, 0xee,
+	0x96, 0x69, 0x80, 0x0f, 0xd0, 0x82, 0x90, 0x44, 0x82, 0x6e, 0xe0, 0xf2, 0xde, 0x56, 0x51, 0x67,
+	0x55, 0x60, 0x6d, 0xbe, 0x89, 0xd2, 0x3e, 0x53, 0xc9, 0xae, 0xc1, 0x60, 0x8c, 0xaa, 0xca, 0x77,
+	0xba, 0x47, 0x75, 0x57, 0xaf, 0xf1, 0x06, 0x6a, 0x04, 0x20, 0x7c, 0x4e, 0x63, 0x99, 0xd5, 0xb4,
+	0xee, 0x8e, 0x87, 0xf0, 0x1a, 0x5a, 0x60, 0xdf, 0x47, 0xc0, 0x75, 0xe5, 0xea, 0xae, 0xd9, 0xe0,
+	0x2f, 0x51, 0x35, 0x20, 0x92, 0x34, 0x6b, 0x1b, 0x95, 0x9d, 0xc6, 0xde, 0xc1, 0xdc, 0x8e, 0x74,
+	0x8e, 0x88, 0x24, 0xc7, 0x91, 0xe4, 0x17, 0xae, 0x26, 0xc2, 0xdf, 0xa0, 0x3b, 0x4a, 0x90, 0x47,
+	0x42, 0x4a, 0x04, 0x88, 0xe6, 0xa2, 0x26, 0x7e, 0x34, 0x17, 0xb1, 0x5a, 0x1c, 0x2a, 0xbc, 0xdb,
+	0x88, 0xb2, 0x25, 0x08, 0x7c, 0x8a, 0x96, 0x48, 0x4c, 0xbd, 0x73, 0xb8, 0x10, 0xcd, 0x25, 0x4d,
+	0x3b, 0x9f, 0xf1, 0x0f, 0x63, 0xfa, 0x14, 0x2e, 0xdc, 0x45, 0xa2, 0xff, 0x8a, 0xd6, 0xc7, 0xa8,
+	0x9e, 0xab, 0xc7, 0xf7, 0x50, 0xe5, 0x1c, 0x2e, 0xd2, 0x16, 0xa9, 0xa5, 0x2a, 0x58, 0x42, 0xc2,
+	0xa1, 0xe9, 0x51, 0xdd, 0x35, 0x9b, 0x4f, 0xcb, 0x9f, 0x58, 0x9b, 0x02, 0xe1, 0x59, 0xad, 0x79,
+	0x5b, 0xac, 0xb1, 0xb6, 0x9c, 0xa0, 0x15, 0xf8, 0x21, 0xa6, 0x66, 0x58, 0x19, 0x57, 0x95, 0x6f,
+	0xe9, 0xaa, 0xe5, 0x02, 0xa8, 0x4d, 0xf5, 0xd3, 0xf8, 0x3c, 0x31, 0x9f, 0x32, 0x63, 0xab, 0x4c,
+	0x42, 0x79, 0x4c, 0x02, 0x46, 0x55, 0xce, 0xc2, 0xdc, 0x2d, 0x6a, 0x8d, 0xd7, 0x51, 0x43, 0x80,
+	0xcf, 0x41, 0x7a, 0x67, 0x44, 0x9c, 0xa5, 0x6e, 0x41, 0x26, 0xf4, 0x39, 0x11, 0x67, 0xf8, 0x10,
+	0x35, 0x7c, 0x0e, 0x44, 0x82, 0xd1, 0xbc, 0x70, 0x4b, 0xcd, 0xc8, 0x80, 0x54, 0x58, 0x51, 0x70,
+	0x26, 0x73, 0x8a, 0xda, 0x6d, 0x29, 0x0c, 0x48, 0x53, 0x7c, 0x88, 0xd6, 0x62, 0x0e, 0x09, 0x65,
+	0x43, 0xe1, 0x8d, 0xeb, 0x5d, 0xd4, 0x7a, 0x71, 0x76, 0xf6, 0xac, 0xd0, 0xdd, 0x47, 0xeb, 0xd3,
+	0x88, 0xe9, 0xfa, 0x2f, 0xdd, 0x52, 0xc8, 0xdb, 0x93, 0xf4, 0xc7, 0x93, 0xdd, 0xf8, 0x7b, 0xbc,
+	0x1b, 0xe9, 0x68, 0x7b, 0x8c, 0xea, 0x1c, 0x24, 0x44, 0xfa, 0x17, 0x68, 0x46, 0xfc, 0xc3, 0x99,
+	0x6b, 0x8e, 0xd2, 0x97, 0xab, 0x53, 0xfd, 0x59, 0xdd, 0x52, 0x20, 0xf0, 0x36, 0x5a, 0x21, 0xdc,
+	0x3f, 0xa3, 0x09, 0x09, 0xbd, 0xee, 0xd0, 0x3f, 0x07, 0x99, 0xf6, 0x71, 0x39, 0x0b, 0x77, 0x74,
+	0x14, 0x9f, 0xa0, 0x3b, 0x5d, 0x12, 0x78, 0x5d, 0x1a, 0x11, 0x4e, 0x41, 0xa4, 0xb3, 0xfa, 0xfd,
+	0xc9, 0x19, 0x52, 0xbc, 0x5b, 0xc9, 0xae, 0xd3, 0x21, 0x41, 0x27, 0xcd, 0x76, 0x1b, 0xdd, 0x62,
+	0x83, 0x5f, 0xa0, 0xfb, 0x67, 0x54, 0x48, 0xc6, 0x2f, 0xbc, 0xfc, 0x6e, 0x33, 0x98, 0xaa, 0x7a,
+	0x30, 0xbd, 0x77, 0xc3, 0x60, 0x3a, 0x4c, 0x93, 0xcd, 0x5c, 0x5a, 0x4b, 0x39, 0x26, 0xa2, 0xaa,
+	0x7b, 0x33, 0xdc, 0x43, 0x4e, 0xd3, 0xf9, 0x83, 0xa7, 0x30, 0xcf, 0x39, 0xc5, 0xdf, 0xa2, 0x87,
+	0x09, 0x15, 0xb4, 0x4b, 0x43, 0x2a, 0x67, 0x04, 0xd5, 0xe6, 0x10, 0xf4, 0xa0, 0xa0, 0x99, 0xd4,
+	0xf4, 0x08, 0x3d, 0xf8, 0xaf, 0x1b, 0x94, 0x2c, 0x63, 0xaa, 0x37, 0x67, 0x91, 0xcf, 0x39, 0xdd,
+	0xfc, 0xd5, 0x42, 0xad, 0x9b, 0xdf, 0x39, 0xec, 0xa0, 0x37, 0x88, 0x2f, 0x69, 0x02, 0x9e, 0x1f,
+	0x0e, 0x85, 0x54, 0x6f, 0x56, 0x31, 0x09, 0x56, 0xcd, 0xd1, 0x13, 0x73, 0xa2, 0x58, 0x70, 0x0b,
+	0x2d, 0xa5, 0x89, 0xa2, 0x59, 0xde, 0xa8, 0xec, 0xd4, 0xdd, 0x7c, 0x8f, 0x1f, 0x67, 0x4f, 0x43,
+	0x45, 0x7f, 0xf0, 0xf6, 0x0d, 0x1f, 0x3c, 0x26, 0x62, 0xe2, 0x71, 0xb8, 0x8f, 0x6a, 0x3d, 0x1a,
+	0x4a, 0xe0, 0xe9, 0xaf, 0x3a, 0xdd, 0x75, 0xbe, 0xbb, 0xbc, 0xb2, 0x4b, 0xaf, 0xae, 0xec, 0xd2,
+	0xeb, 0x2b, 0xdb, 0xfa, 0x71, 0x64, 0x5b, 0xbf, 0x8c, 0x6c, 0xeb, 0xb7, 0x91, 0x6d, 0x5d, 0x8e,
+	0x6c, 0xeb, 0xcf, 0x91, 0x6d, 0xfd, 0x35, 0xb2, 0x4b, 0xaf, 0x47, 0xb6, 0xf5, 0xf2, 0xda, 0x2e,
+	0x5d, 0x5e, 0xdb, 0xa5, 0x57, 0xd7, 0x76, 0xe9, 0xc5, 0x47, 0x7d, 0x56, 0xdc, 0x4f, 0xd9, 0xcd,
+	0xff, 0xd7, 0x1d, 0x8c, 0x6d, 0xbb, 0x35, 0xed, 0xf6, 0xfd, 0x7f, 0x07, 0x00, 0x3b, 0xfe, 0xe6,
+	0x23, 0x10, 0x0a, 0x00, 0x00,
 }
 
 func (this *NamespaceDetail) Equal(that interface{}) bool {
@@ -538,6 +734,110 @@ func (this *NamespaceInfo) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.NameAliases) != len(that1.NameAliases) {
+		return false
+	}
+	for i := range this.NameAliases {
+		if !this.NameAliases[i].Equal(that1.NameAliases[i]) {
+			return false
+		}
+	}
+	if len(this.ApiKeys) != len(that1.ApiKeys) {
+		return false
+	}
+	for i := range this.ApiKeys {
+		if !this.ApiKeys[i].Equal(that1.ApiKeys[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *NamespaceNameAlias) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NamespaceNameAlias)
+	if !ok {
+		that2, ok := that.(NamespaceNameAlias)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if that1.ExpirationTime == nil {
+		if this.ExpirationTime != nil {
+			return false
+		}
+	} else if !this.ExpirationTime.Equal(*that1.ExpirationTime) {
+		return false
+	}
+	return true
+}
+func (this *NamespaceApiKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NamespaceApiKey)
+	if !ok {
+		that2, ok := that.(NamespaceApiKey)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if this.SecretHash != that1.SecretHash {
+		return false
+	}
+	if that1.CreateTime == nil {
+		if this.CreateTime != nil {
+			return false
+		}
+	} else if !this.CreateTime.Equal(*that1.CreateTime) {
+		return false
+	}
+	if that1.RotateTime == nil {
+		if this.RotateTime != nil {
+			return false
+		}
+	} else if !this.RotateTime.Equal(*that1.RotateTime) {
+		return false
+	}
+	if this.PreviousSecretHash != that1.PreviousSecretHash {
+		return false
+	}
+	if that1.PreviousSecretExpirationTime == nil {
+		if this.PreviousSecretExpirationTime != nil {
+			return false
+		}
+	} else if !this.PreviousSecretExpirationTime.Equal(*that1.PreviousSecretExpirationTime) {
+		return false
+	}
 	return true
 }
 func (this *NamespaceConfig) Equal(that interface{}) bool {
@@ -621,6 +921,9 @@ func (this *NamespaceReplicationConfig) Equal(that interface{}) bool {
 	if this.State != that1.State {
 		return false
 	}
+	if this.Filter != that1.Filter {
+		return false
+	}
 	return true
 }
 func (this *NamespaceDetail) GoString() string {
@@ -649,7 +952,7 @@ func (this *NamespaceInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 10)
+	s := make([]string, 0, 12)
 	s = append(s, "&persistence.NamespaceInfo{")
 	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
 	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
@@ -669,6 +972,40 @@ func (this *NamespaceInfo) GoString() string {
 	if this.Data != nil {
 		s = append(s, "Data: "+mapStringForData+",\n")
 	}
+	if this.NameAliases != nil {
+		s = append(s, "NameAliases: "+fmt.Sprintf("%#v", this.NameAliases)+",\n")
+	}
+	if this.ApiKeys != nil {
+		s = append(s, "ApiKeys: "+fmt.Sprintf("%#v", this.ApiKeys)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NamespaceNameAlias) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&persistence.NamespaceNameAlias{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "ExpirationTime: "+fmt.Sprintf("%#v", this.ExpirationTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NamespaceApiKey) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&persistence.NamespaceApiKey{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
+	s = append(s, "SecretHash: "+fmt.Sprintf("%#v", this.SecretHash)+",\n")
+	s = append(s, "CreateTime: "+fmt.Sprintf("%#v", this.CreateTime)+",\n")
+	s = append(s, "RotateTime: "+fmt.Sprintf("%#v", this.RotateTime)+",\n")
+	s = append(s, "PreviousSecretHash: "+fmt.Sprintf("%#v", this.PreviousSecretHash)+",\n")
+	s = append(s, "PreviousSecretExpirationTime: "+fmt.Sprintf("%#v", this.PreviousSecretExpirationTime)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -694,11 +1031,12 @@ func (this *NamespaceReplicationConfig) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 8)
 	s = append(s, "&persistence.NamespaceReplicationConfig{")
 	s = append(s, "ActiveClusterName: "+fmt.Sprintf("%#v", this.ActiveClusterName)+",\n")
 	s = append(s, "Clusters: "+fmt.Sprintf("%#v", this.Clusters)+",\n")
 	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "Filter: "+fmt.Sprintf("%#v", this.Filter)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -814,6 +1152,34 @@ func (m *NamespaceInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ApiKeys) > 0 {
+		for iNdEx := len(m.ApiKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ApiKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNamespaces(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.NameAliases) > 0 {
+		for iNdEx := len(m.NameAliases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NameAliases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNamespaces(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Data) > 0 {
 		for k := range m.Data {
 			v := m.Data[k]
@@ -869,7 +1235,7 @@ func (m *NamespaceInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *NamespaceConfig) Marshal() (dAtA []byte, err error) {
+func (m *NamespaceNameAlias) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -879,39 +1245,167 @@ func (m *NamespaceConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NamespaceConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *NamespaceNameAlias) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NamespaceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NamespaceNameAlias) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.VisibilityArchivalUri) > 0 {
-		i -= len(m.VisibilityArchivalUri)
-		copy(dAtA[i:], m.VisibilityArchivalUri)
-		i = encodeVarintNamespaces(dAtA, i, uint64(len(m.VisibilityArchivalUri)))
+	if m.ExpirationTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintNamespaces(dAtA, i, uint64(n5))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x12
 	}
-	if m.VisibilityArchivalState != 0 {
-		i = encodeVarintNamespaces(dAtA, i, uint64(m.VisibilityArchivalState))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintNamespaces(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0xa
 	}
-	if len(m.HistoryArchivalUri) > 0 {
-		i -= len(m.HistoryArchivalUri)
-		copy(dAtA[i:], m.HistoryArchivalUri)
-		i = encodeVarintNamespaces(dAtA, i, uint64(len(m.HistoryArchivalUri)))
-		i--
-		dAtA[i] = 0x2a
+	return len(dAtA) - i, nil
+}
+
+func (m *NamespaceApiKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.HistoryArchivalState != 0 {
-		i = encodeVarintNamespaces(dAtA, i, uint64(m.HistoryArchivalState))
-		i--
-		dAtA[i] = 0x20
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceApiKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceApiKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PreviousSecretExpirationTime != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.PreviousSecretExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.PreviousSecretExpirationTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintNamespaces(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PreviousSecretHash) > 0 {
+		i -= len(m.PreviousSecretHash)
+		copy(dAtA[i:], m.PreviousSecretHash)
+		i = encodeVarintNamespaces(dAtA, i, uint64(len(m.PreviousSecretHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RotateTime != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RotateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RotateTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintNamespaces(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CreateTime != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreateTime):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintNamespaces(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SecretHash) > 0 {
+		i -= len(m.SecretHash)
+		copy(dAtA[i:], m.SecretHash)
+		i = encodeVarintNamespaces(dAtA, i, uint64(len(m.SecretHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintNamespaces(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintNamespaces(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintNamespaces(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NamespaceConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VisibilityArchivalUri) > 0 {
+		i -= len(m.VisibilityArchivalUri)
+		copy(dAtA[i:], m.VisibilityArchivalUri)
+		i = encodeVarintNamespaces(dAtA, i, uint64(len(m.VisibilityArchivalUri)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.VisibilityArchivalState != 0 {
+		i = encodeVarintNamespaces(dAtA, i, uint64(m.VisibilityArchivalState))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.HistoryArchivalUri) > 0 {
+		i -= len(m.HistoryArchivalUri)
+		copy(dAtA[i:], m.HistoryArchivalUri)
+		i = encodeVarintNamespaces(dAtA, i, uint64(len(m.HistoryArchivalUri)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.HistoryArchivalState != 0 {
+		i = encodeVarintNamespaces(dAtA, i, uint64(m.HistoryArchivalState))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.BadBinaries != nil {
 		{
@@ -933,12 +1427,12 @@ func (m *NamespaceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if m.Retention != nil {
-		n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Retention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Retention):])
-		if err6 != nil {
-			return 0, err6
+		n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Retention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Retention):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n6
-		i = encodeVarintNamespaces(dAtA, i, uint64(n6))
+		i -= n10
+		i = encodeVarintNamespaces(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -965,6 +1459,13 @@ func (m *NamespaceReplicationConfig) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.Filter) > 0 {
+		i -= len(m.Filter)
+		copy(dAtA[i:], m.Filter)
+		i = encodeVarintNamespaces(dAtA, i, uint64(len(m.Filter)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.State != 0 {
 		i = encodeVarintNamespaces(dAtA, i, uint64(m.State))
 		i--
@@ -1067,6 +1568,76 @@ func (m *NamespaceInfo) Size() (n int) {
 			n += mapEntrySize + 1 + sovNamespaces(uint64(mapEntrySize))
 		}
 	}
+	if len(m.NameAliases) > 0 {
+		for _, e := range m.NameAliases {
+			l = e.Size()
+			n += 1 + l + sovNamespaces(uint64(l))
+		}
+	}
+	if len(m.ApiKeys) > 0 {
+		for _, e := range m.ApiKeys {
+			l = e.Size()
+			n += 1 + l + sovNamespaces(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NamespaceNameAlias) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	return n
+}
+
+func (m *NamespaceApiKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	l = len(m.SecretHash)
+	if l > 0 {
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	if m.CreateTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreateTime)
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	if m.RotateTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.RotateTime)
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	l = len(m.PreviousSecretHash)
+	if l > 0 {
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	if m.PreviousSecretExpirationTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.PreviousSecretExpirationTime)
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
 	return n
 }
 
@@ -1124,6 +1695,10 @@ func (m *NamespaceReplicationConfig) Size() (n int) {
 	if m.State != 0 {
 		n += 1 + sovNamespaces(uint64(m.State))
 	}
+	l = len(m.Filter)
+	if l > 0 {
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
 	return n
 }
 
@@ -1153,6 +1728,16 @@ func (this *NamespaceInfo) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForNameAliases := "[]*NamespaceNameAlias{"
+	for _, f := range this.NameAliases {
+		repeatedStringForNameAliases += strings.Replace(f.String(), "NamespaceNameAlias", "NamespaceNameAlias", 1) + ","
+	}
+	repeatedStringForNameAliases += "}"
+	repeatedStringForApiKeys := "[]*NamespaceApiKey{"
+	for _, f := range this.ApiKeys {
+		repeatedStringForApiKeys += strings.Replace(f.String(), "NamespaceApiKey", "NamespaceApiKey", 1) + ","
+	}
+	repeatedStringForApiKeys += "}"
 	keysForData := make([]string, 0, len(this.Data))
 	for k, _ := range this.Data {
 		keysForData = append(keysForData, k)
@@ -1170,6 +1755,36 @@ func (this *NamespaceInfo) String() string {
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
 		`Owner:` + fmt.Sprintf("%v", this.Owner) + `,`,
 		`Data:` + mapStringForData + `,`,
+		`NameAliases:` + repeatedStringForNameAliases + `,`,
+		`ApiKeys:` + repeatedStringForApiKeys + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceNameAlias) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceNameAlias{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ExpirationTime:` + strings.Replace(fmt.Sprintf("%v", this.ExpirationTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceApiKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceApiKey{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`SecretHash:` + fmt.Sprintf("%v", this.SecretHash) + `,`,
+		`CreateTime:` + strings.Replace(fmt.Sprintf("%v", this.CreateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`RotateTime:` + strings.Replace(fmt.Sprintf("%v", this.RotateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`PreviousSecretHash:` + fmt.Sprintf("%v", this.PreviousSecretHash) + `,`,
+		`PreviousSecretExpirationTime:` + strings.Replace(fmt.Sprintf("%v", this.PreviousSecretExpirationTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1198,6 +1813,7 @@ func (this *NamespaceReplicationConfig) String() string {
 		`ActiveClusterName:` + fmt.Sprintf("%v", this.ActiveClusterName) + `,`,
 		`Clusters:` + fmt.Sprintf("%v", this.Clusters) + `,`,
 		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Filter:` + fmt.Sprintf("%v", this.Filter) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1389,7 +2005,510 @@ func (m *NamespaceDetail) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FailoverVersion", wireType)
 			}
-			m.FailoverVersion = 0
+			m.FailoverVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailoverVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailoverEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FailoverEndTime == nil {
+				m.FailoverEndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FailoverEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNamespaces(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNamespaces
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= v1.NamespaceState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNamespaces
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNamespaces
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNamespaces
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipNamespaces(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Data[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameAliases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameAliases = append(m.NameAliases, &NamespaceNameAlias{})
+			if err := m.NameAliases[len(m.NameAliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiKeys = append(m.ApiKeys, &NamespaceApiKey{})
+			if err := m.ApiKeys[len(m.ApiKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNamespaces(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceNameAlias) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNamespaces
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceNameAlias: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceNameAlias: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNamespaces
@@ -1399,14 +2518,27 @@ func (m *NamespaceDetail) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FailoverVersion |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailoverEndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1433,10 +2565,10 @@ func (m *NamespaceDetail) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FailoverEndTime == nil {
-				m.FailoverEndTime = new(time.Time)
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FailoverEndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1464,7 +2596,7 @@ func (m *NamespaceDetail) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamespaceInfo) Unmarshal(dAtA []byte) error {
+func (m *NamespaceApiKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1487,10 +2619,10 @@ func (m *NamespaceInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamespaceInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamespaceApiKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespaceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamespaceApiKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1526,10 +2658,10 @@ func (m *NamespaceInfo) Unmarshal(dAtA []byte) error {
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.State = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNamespaces
@@ -1539,14 +2671,27 @@ func (m *NamespaceInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= v1.NamespaceState(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1574,11 +2719,11 @@ func (m *NamespaceInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1606,11 +2751,83 @@ func (m *NamespaceInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.SecretHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateTime == nil {
+				m.CreateTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RotateTime == nil {
+				m.RotateTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.RotateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousSecretHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1638,11 +2855,11 @@ func (m *NamespaceInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.PreviousSecretHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousSecretExpirationTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1669,103 +2886,12 @@ func (m *NamespaceInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = make(map[string]string)
+			if m.PreviousSecretExpirationTime == nil {
+				m.PreviousSecretExpirationTime = new(time.Time)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowNamespaces
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowNamespaces
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthNamespaces
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthNamespaces
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowNamespaces
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthNamespaces
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthNamespaces
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipNamespaces(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthNamespaces
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.PreviousSecretExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Data[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2162,6 +3288,38 @@ func (m *NamespaceReplicationConfig) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNamespaces(dAtA[iNdEx:])
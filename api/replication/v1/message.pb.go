@@ -28,6 +28,7 @@
 package repication
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -38,15 +39,19 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
-	v14 "go.temporal.io/api/common/v1"
-	v15 "go.temporal.io/api/failure/v1"
-	v13 "go.temporal.io/api/history/v1"
+	v16 "go.temporal.io/api/common/v1"
+	v14 "go.temporal.io/api/enums/v1"
+	v17 "go.temporal.io/api/failure/v1"
+	v15 "go.temporal.io/api/history/v1"
 	v11 "go.temporal.io/api/namespace/v1"
 	v12 "go.temporal.io/api/replication/v1"
 	v1 "go.temporal.io/server/api/enums/v1"
-	v16 "go.temporal.io/server/api/history/v1"
+	v18 "go.temporal.io/server/api/history/v1"
+	v13 "go.temporal.io/server/api/persistence/v1"
+	v19 "go.temporal.io/server/api/workflow/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -71,6 +76,7 @@ type ReplicationTask struct {
 	//	*ReplicationTask_SyncActivityTaskAttributes
 	//	*ReplicationTask_HistoryMetadataTaskAttributes
 	//	*ReplicationTask_HistoryTaskV2Attributes
+	//	*ReplicationTask_SearchAttributesTaskAttributes
 	Attributes     isReplicationTask_Attributes `protobuf_oneof:"attributes"`
 	VisibilityTime *time.Time                   `protobuf:"bytes,9,opt,name=visibility_time,json=visibilityTime,proto3,stdtime" json:"visibility_time,omitempty"`
 }
@@ -132,13 +138,17 @@ type ReplicationTask_HistoryMetadataTaskAttributes struct {
 type ReplicationTask_HistoryTaskV2Attributes struct {
 	HistoryTaskV2Attributes *HistoryTaskV2Attributes `protobuf:"bytes,8,opt,name=history_task_v2_attributes,json=historyTaskV2Attributes,proto3,oneof" json:"history_task_v2_attributes,omitempty"`
 }
+type ReplicationTask_SearchAttributesTaskAttributes struct {
+	SearchAttributesTaskAttributes *SearchAttributesTaskAttributes `protobuf:"bytes,10,opt,name=search_attributes_task_attributes,json=searchAttributesTaskAttributes,proto3,oneof" json:"search_attributes_task_attributes,omitempty"`
+}
 
-func (*ReplicationTask_NamespaceTaskAttributes) isReplicationTask_Attributes()       {}
-func (*ReplicationTask_HistoryTaskAttributes) isReplicationTask_Attributes()         {}
-func (*ReplicationTask_SyncShardStatusTaskAttributes) isReplicationTask_Attributes() {}
-func (*ReplicationTask_SyncActivityTaskAttributes) isReplicationTask_Attributes()    {}
-func (*ReplicationTask_HistoryMetadataTaskAttributes) isReplicationTask_Attributes() {}
-func (*ReplicationTask_HistoryTaskV2Attributes) isReplicationTask_Attributes()       {}
+func (*ReplicationTask_NamespaceTaskAttributes) isReplicationTask_Attributes()        {}
+func (*ReplicationTask_HistoryTaskAttributes) isReplicationTask_Attributes()          {}
+func (*ReplicationTask_SyncShardStatusTaskAttributes) isReplicationTask_Attributes()  {}
+func (*ReplicationTask_SyncActivityTaskAttributes) isReplicationTask_Attributes()     {}
+func (*ReplicationTask_HistoryMetadataTaskAttributes) isReplicationTask_Attributes()  {}
+func (*ReplicationTask_HistoryTaskV2Attributes) isReplicationTask_Attributes()        {}
+func (*ReplicationTask_SearchAttributesTaskAttributes) isReplicationTask_Attributes() {}
 
 func (m *ReplicationTask) GetAttributes() isReplicationTask_Attributes {
 	if m != nil {
@@ -203,6 +213,13 @@ func (m *ReplicationTask) GetHistoryTaskV2Attributes() *HistoryTaskV2Attributes
 	return nil
 }
 
+func (m *ReplicationTask) GetSearchAttributesTaskAttributes() *SearchAttributesTaskAttributes {
+	if x, ok := m.GetAttributes().(*ReplicationTask_SearchAttributesTaskAttributes); ok {
+		return x.SearchAttributesTaskAttributes
+	}
+	return nil
+}
+
 func (m *ReplicationTask) GetVisibilityTime() *time.Time {
 	if m != nil {
 		return m.VisibilityTime
@@ -219,6 +236,7 @@ func (*ReplicationTask) XXX_OneofWrappers() []interface{} {
 		(*ReplicationTask_SyncActivityTaskAttributes)(nil),
 		(*ReplicationTask_HistoryMetadataTaskAttributes)(nil),
 		(*ReplicationTask_HistoryTaskV2Attributes)(nil),
+		(*ReplicationTask_SearchAttributesTaskAttributes)(nil),
 	}
 }
 
@@ -520,6 +538,9 @@ type NamespaceTaskAttributes struct {
 	ReplicationConfig  *v12.NamespaceReplicationConfig `protobuf:"bytes,5,opt,name=replication_config,json=replicationConfig,proto3" json:"replication_config,omitempty"`
 	ConfigVersion      int64                           `protobuf:"varint,6,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
 	FailoverVersion    int64                           `protobuf:"varint,7,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
+	ReplicationFilter  string                          `protobuf:"bytes,8,opt,name=replication_filter,json=replicationFilter,proto3" json:"replication_filter,omitempty"`
+	NameAliases        []*v13.NamespaceNameAlias       `protobuf:"bytes,9,rep,name=name_aliases,json=nameAliases,proto3" json:"name_aliases,omitempty"`
+	ApiKeys            []*v13.NamespaceApiKey          `protobuf:"bytes,10,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
 }
 
 func (m *NamespaceTaskAttributes) Reset()      { *m = NamespaceTaskAttributes{} }
@@ -603,6 +624,72 @@ func (m *NamespaceTaskAttributes) GetFailoverVersion() int64 {
 	return 0
 }
 
+func (m *NamespaceTaskAttributes) GetReplicationFilter() string {
+	if m != nil {
+		return m.ReplicationFilter
+	}
+	return ""
+}
+
+func (m *NamespaceTaskAttributes) GetNameAliases() []*v13.NamespaceNameAlias {
+	if m != nil {
+		return m.NameAliases
+	}
+	return nil
+}
+
+func (m *NamespaceTaskAttributes) GetApiKeys() []*v13.NamespaceApiKey {
+	if m != nil {
+		return m.ApiKeys
+	}
+	return nil
+}
+
+// SearchAttributesTaskAttributes carries custom search attribute definitions of the source cluster.
+// Receiving cluster adds the ones it is missing.
+type SearchAttributesTaskAttributes struct {
+	CustomSearchAttributes map[string]v14.IndexedValueType `protobuf:"bytes,1,rep,name=custom_search_attributes,json=customSearchAttributes,proto3" json:"custom_search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=temporal.api.enums.v1.IndexedValueType"`
+}
+
+func (m *SearchAttributesTaskAttributes) Reset()      { *m = SearchAttributesTaskAttributes{} }
+func (*SearchAttributesTaskAttributes) ProtoMessage() {}
+func (*SearchAttributesTaskAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edd9fae2af6b0532, []int{6}
+}
+func (m *SearchAttributesTaskAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchAttributesTaskAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchAttributesTaskAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SearchAttributesTaskAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchAttributesTaskAttributes.Merge(m, src)
+}
+func (m *SearchAttributesTaskAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchAttributesTaskAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchAttributesTaskAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchAttributesTaskAttributes proto.InternalMessageInfo
+
+func (m *SearchAttributesTaskAttributes) GetCustomSearchAttributes() map[string]v14.IndexedValueType {
+	if m != nil {
+		return m.CustomSearchAttributes
+	}
+	return nil
+}
+
 type HistoryTaskAttributes struct {
 	TargetClusters []string     `protobuf:"bytes,1,rep,name=target_clusters,json=targetClusters,proto3" json:"target_clusters,omitempty"`
 	NamespaceId    string       `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -611,14 +698,14 @@ type HistoryTaskAttributes struct {
 	FirstEventId   int64        `protobuf:"varint,5,opt,name=first_event_id,json=firstEventId,proto3" json:"first_event_id,omitempty"`
 	NextEventId    int64        `protobuf:"varint,6,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
 	Version        int64        `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
-	History        *v13.History `protobuf:"bytes,9,opt,name=history,proto3" json:"history,omitempty"`
-	NewRunHistory  *v13.History `protobuf:"bytes,10,opt,name=new_run_history,json=newRunHistory,proto3" json:"new_run_history,omitempty"`
+	History        *v15.History `protobuf:"bytes,9,opt,name=history,proto3" json:"history,omitempty"`
+	NewRunHistory  *v15.History `protobuf:"bytes,10,opt,name=new_run_history,json=newRunHistory,proto3" json:"new_run_history,omitempty"`
 }
 
 func (m *HistoryTaskAttributes) Reset()      { *m = HistoryTaskAttributes{} }
 func (*HistoryTaskAttributes) ProtoMessage() {}
 func (*HistoryTaskAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edd9fae2af6b0532, []int{6}
+	return fileDescriptor_edd9fae2af6b0532, []int{7}
 }
 func (m *HistoryTaskAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -696,14 +783,14 @@ func (m *HistoryTaskAttributes) GetVersion() int64 {
 	return 0
 }
 
-func (m *HistoryTaskAttributes) GetHistory() *v13.History {
+func (m *HistoryTaskAttributes) GetHistory() *v15.History {
 	if m != nil {
 		return m.History
 	}
 	return nil
 }
 
-func (m *HistoryTaskAttributes) GetNewRunHistory() *v13.History {
+func (m *HistoryTaskAttributes) GetNewRunHistory() *v15.History {
 	if m != nil {
 		return m.NewRunHistory
 	}
@@ -723,7 +810,7 @@ type HistoryMetadataTaskAttributes struct {
 func (m *HistoryMetadataTaskAttributes) Reset()      { *m = HistoryMetadataTaskAttributes{} }
 func (*HistoryMetadataTaskAttributes) ProtoMessage() {}
 func (*HistoryMetadataTaskAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edd9fae2af6b0532, []int{7}
+	return fileDescriptor_edd9fae2af6b0532, []int{8}
 }
 func (m *HistoryMetadataTaskAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -810,7 +897,7 @@ type SyncShardStatusTaskAttributes struct {
 func (m *SyncShardStatusTaskAttributes) Reset()      { *m = SyncShardStatusTaskAttributes{} }
 func (*SyncShardStatusTaskAttributes) ProtoMessage() {}
 func (*SyncShardStatusTaskAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edd9fae2af6b0532, []int{8}
+	return fileDescriptor_edd9fae2af6b0532, []int{9}
 }
 func (m *SyncShardStatusTaskAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -870,17 +957,17 @@ type SyncActivityTaskAttributes struct {
 	StartedId          int64               `protobuf:"varint,7,opt,name=started_id,json=startedId,proto3" json:"started_id,omitempty"`
 	StartedTime        *time.Time          `protobuf:"bytes,8,opt,name=started_time,json=startedTime,proto3,stdtime" json:"started_time,omitempty"`
 	LastHeartbeatTime  *time.Time          `protobuf:"bytes,9,opt,name=last_heartbeat_time,json=lastHeartbeatTime,proto3,stdtime" json:"last_heartbeat_time,omitempty"`
-	Details            *v14.Payloads       `protobuf:"bytes,10,opt,name=details,proto3" json:"details,omitempty"`
+	Details            *v16.Payloads       `protobuf:"bytes,10,opt,name=details,proto3" json:"details,omitempty"`
 	Attempt            int32               `protobuf:"varint,11,opt,name=attempt,proto3" json:"attempt,omitempty"`
-	LastFailure        *v15.Failure        `protobuf:"bytes,12,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
+	LastFailure        *v17.Failure        `protobuf:"bytes,12,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
 	LastWorkerIdentity string              `protobuf:"bytes,13,opt,name=last_worker_identity,json=lastWorkerIdentity,proto3" json:"last_worker_identity,omitempty"`
-	VersionHistory     *v16.VersionHistory `protobuf:"bytes,14,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
+	VersionHistory     *v18.VersionHistory `protobuf:"bytes,14,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
 }
 
 func (m *SyncActivityTaskAttributes) Reset()      { *m = SyncActivityTaskAttributes{} }
 func (*SyncActivityTaskAttributes) ProtoMessage() {}
 func (*SyncActivityTaskAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edd9fae2af6b0532, []int{9}
+	return fileDescriptor_edd9fae2af6b0532, []int{10}
 }
 func (m *SyncActivityTaskAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -972,7 +1059,7 @@ func (m *SyncActivityTaskAttributes) GetLastHeartbeatTime() *time.Time {
 	return nil
 }
 
-func (m *SyncActivityTaskAttributes) GetDetails() *v14.Payloads {
+func (m *SyncActivityTaskAttributes) GetDetails() *v16.Payloads {
 	if m != nil {
 		return m.Details
 	}
@@ -986,7 +1073,7 @@ func (m *SyncActivityTaskAttributes) GetAttempt() int32 {
 	return 0
 }
 
-func (m *SyncActivityTaskAttributes) GetLastFailure() *v15.Failure {
+func (m *SyncActivityTaskAttributes) GetLastFailure() *v17.Failure {
 	if m != nil {
 		return m.LastFailure
 	}
@@ -1000,7 +1087,7 @@ func (m *SyncActivityTaskAttributes) GetLastWorkerIdentity() string {
 	return ""
 }
 
-func (m *SyncActivityTaskAttributes) GetVersionHistory() *v16.VersionHistory {
+func (m *SyncActivityTaskAttributes) GetVersionHistory() *v18.VersionHistory {
 	if m != nil {
 		return m.VersionHistory
 	}
@@ -1011,16 +1098,16 @@ type HistoryTaskV2Attributes struct {
 	NamespaceId         string                    `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowId          string                    `protobuf:"bytes,3,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
 	RunId               string                    `protobuf:"bytes,4,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	VersionHistoryItems []*v16.VersionHistoryItem `protobuf:"bytes,5,rep,name=version_history_items,json=versionHistoryItems,proto3" json:"version_history_items,omitempty"`
-	Events              *v14.DataBlob             `protobuf:"bytes,6,opt,name=events,proto3" json:"events,omitempty"`
+	VersionHistoryItems []*v18.VersionHistoryItem `protobuf:"bytes,5,rep,name=version_history_items,json=versionHistoryItems,proto3" json:"version_history_items,omitempty"`
+	Events              *v16.DataBlob             `protobuf:"bytes,6,opt,name=events,proto3" json:"events,omitempty"`
 	// New run events does not need version history since there is no prior events.
-	NewRunEvents *v14.DataBlob `protobuf:"bytes,7,opt,name=new_run_events,json=newRunEvents,proto3" json:"new_run_events,omitempty"`
+	NewRunEvents *v16.DataBlob `protobuf:"bytes,7,opt,name=new_run_events,json=newRunEvents,proto3" json:"new_run_events,omitempty"`
 }
 
 func (m *HistoryTaskV2Attributes) Reset()      { *m = HistoryTaskV2Attributes{} }
 func (*HistoryTaskV2Attributes) ProtoMessage() {}
 func (*HistoryTaskV2Attributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edd9fae2af6b0532, []int{10}
+	return fileDescriptor_edd9fae2af6b0532, []int{11}
 }
 func (m *HistoryTaskV2Attributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1070,444 +1157,803 @@ func (m *HistoryTaskV2Attributes) GetRunId() string {
 	return ""
 }
 
-func (m *HistoryTaskV2Attributes) GetVersionHistoryItems() []*v16.VersionHistoryItem {
+func (m *HistoryTaskV2Attributes) GetVersionHistoryItems() []*v18.VersionHistoryItem {
 	if m != nil {
 		return m.VersionHistoryItems
 	}
 	return nil
 }
 
-func (m *HistoryTaskV2Attributes) GetEvents() *v14.DataBlob {
+func (m *HistoryTaskV2Attributes) GetEvents() *v16.DataBlob {
 	if m != nil {
 		return m.Events
 	}
 	return nil
 }
 
-func (m *HistoryTaskV2Attributes) GetNewRunEvents() *v14.DataBlob {
+func (m *HistoryTaskV2Attributes) GetNewRunEvents() *v16.DataBlob {
 	if m != nil {
 		return m.NewRunEvents
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*ReplicationTask)(nil), "temporal.server.api.replication.v1.ReplicationTask")
-	proto.RegisterType((*ReplicationToken)(nil), "temporal.server.api.replication.v1.ReplicationToken")
-	proto.RegisterType((*SyncShardStatus)(nil), "temporal.server.api.replication.v1.SyncShardStatus")
-	proto.RegisterType((*ReplicationMessages)(nil), "temporal.server.api.replication.v1.ReplicationMessages")
-	proto.RegisterType((*ReplicationTaskInfo)(nil), "temporal.server.api.replication.v1.ReplicationTaskInfo")
-	proto.RegisterType((*NamespaceTaskAttributes)(nil), "temporal.server.api.replication.v1.NamespaceTaskAttributes")
-	proto.RegisterType((*HistoryTaskAttributes)(nil), "temporal.server.api.replication.v1.HistoryTaskAttributes")
-	proto.RegisterType((*HistoryMetadataTaskAttributes)(nil), "temporal.server.api.replication.v1.HistoryMetadataTaskAttributes")
-	proto.RegisterType((*SyncShardStatusTaskAttributes)(nil), "temporal.server.api.replication.v1.SyncShardStatusTaskAttributes")
-	proto.RegisterType((*SyncActivityTaskAttributes)(nil), "temporal.server.api.replication.v1.SyncActivityTaskAttributes")
-	proto.RegisterType((*HistoryTaskV2Attributes)(nil), "temporal.server.api.replication.v1.HistoryTaskV2Attributes")
+type NamespaceReplicationLag struct {
+	// Number of replication tasks of the namespace not yet acked by the remote cluster.
+	PendingTaskCount int64 `protobuf:"varint,1,opt,name=pending_task_count,json=pendingTaskCount,proto3" json:"pending_task_count,omitempty"`
+	// Creation time of the oldest replication task of the namespace not yet acked by the remote cluster.
+	OldestPendingTaskTime *time.Time `protobuf:"bytes,2,opt,name=oldest_pending_task_time,json=oldestPendingTaskTime,proto3,stdtime" json:"oldest_pending_task_time,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("temporal/server/api/replication/v1/message.proto", fileDescriptor_edd9fae2af6b0532)
+func (m *NamespaceReplicationLag) Reset()      { *m = NamespaceReplicationLag{} }
+func (*NamespaceReplicationLag) ProtoMessage() {}
+func (*NamespaceReplicationLag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edd9fae2af6b0532, []int{12}
 }
-
-var fileDescriptor_edd9fae2af6b0532 = []byte{
-	// 1537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xda, 0x8e, 0xff, 0x3c, 0xff, 0xed, 0x84, 0x10, 0xc7, 0x28, 0x6e, 0x62, 0xb5, 0x34,
-	0x45, 0xc8, 0x6e, 0x92, 0x03, 0xb4, 0x45, 0x48, 0x49, 0xa1, 0xc4, 0x91, 0x5a, 0xaa, 0x6d, 0xd4,
-	0x4a, 0x5c, 0x96, 0x89, 0x77, 0x6c, 0xaf, 0x62, 0xef, 0x5a, 0x33, 0x63, 0x07, 0x73, 0x42, 0xe2,
-	0x80, 0x84, 0x40, 0xea, 0x77, 0x80, 0x03, 0x27, 0x3e, 0x47, 0x8f, 0xbd, 0x20, 0x95, 0x13, 0x34,
-	0xe5, 0xc0, 0xb1, 0x7c, 0x03, 0x34, 0xb3, 0xb3, 0xf6, 0xae, 0xd7, 0x76, 0xdd, 0xa2, 0x9e, 0xb8,
-	0x79, 0xde, 0x7b, 0xbf, 0xdf, 0x9b, 0x79, 0xf3, 0xfe, 0xcc, 0x1a, 0xae, 0x71, 0xd2, 0xed, 0x39,
-	0x14, 0x77, 0x6a, 0x8c, 0xd0, 0x01, 0xa1, 0x35, 0xdc, 0xb3, 0x6a, 0x94, 0xf4, 0x3a, 0x56, 0x03,
-	0x73, 0xcb, 0xb1, 0x6b, 0x83, 0x9d, 0x5a, 0x97, 0x30, 0x86, 0x5b, 0xa4, 0xda, 0xa3, 0x0e, 0x77,
-	0x50, 0xc5, 0x43, 0x54, 0x5d, 0x44, 0x15, 0xf7, 0xac, 0xaa, 0x0f, 0x51, 0x1d, 0xec, 0x94, 0x2e,
-	0xb6, 0x1c, 0xa7, 0xd5, 0x21, 0x35, 0x89, 0x38, 0xe9, 0x37, 0x6b, 0xdc, 0xea, 0x12, 0xc6, 0x71,
-	0xb7, 0xe7, 0x92, 0x94, 0xb6, 0x4c, 0xd2, 0x23, 0xb6, 0x49, 0xec, 0x86, 0x45, 0x58, 0xad, 0xe5,
-	0xb4, 0x1c, 0x29, 0x97, 0xbf, 0x94, 0x49, 0x75, 0xda, 0xce, 0x88, 0xdd, 0xef, 0x32, 0xb1, 0x27,
-	0xbf, 0x43, 0xd7, 0xfe, 0xca, 0x5c, 0x7b, 0x8e, 0xd9, 0xa9, 0x32, 0x7c, 0x7f, 0x9a, 0x61, 0xdb,
-	0x62, 0xdc, 0xa1, 0xc3, 0xd0, 0x71, 0x4b, 0x97, 0x46, 0xd6, 0xc2, 0xac, 0xe1, 0x74, 0xbb, 0x53,
-	0x82, 0xe2, 0x73, 0x2e, 0xac, 0x6c, 0xdc, 0x25, 0xac, 0x87, 0x1b, 0x24, 0x6c, 0x78, 0x35, 0x60,
-	0x38, 0x2f, 0xd0, 0xa5, 0xcb, 0x01, 0xd3, 0x99, 0x1b, 0x0c, 0x9a, 0x35, 0xb1, 0xd5, 0xe9, 0xd3,
-	0xb0, 0xe3, 0xca, 0x3f, 0x09, 0xc8, 0xeb, 0x63, 0x77, 0xc7, 0x98, 0x9d, 0xa2, 0xbb, 0x90, 0x12,
-	0x71, 0x31, 0xf8, 0xb0, 0x47, 0x8a, 0xda, 0xa6, 0xb6, 0x9d, 0xdb, 0xdd, 0xa9, 0x4e, 0xbb, 0x5e,
-	0x19, 0xc6, 0xea, 0x60, 0xa7, 0x3a, 0xc1, 0x70, 0x3c, 0xec, 0x11, 0x3d, 0xc9, 0xd5, 0x2f, 0x74,
-	0x09, 0x72, 0xcc, 0xe9, 0xd3, 0x06, 0x31, 0x24, 0xad, 0x65, 0x16, 0x23, 0x9b, 0xda, 0x76, 0x54,
-	0xcf, 0xb8, 0x52, 0x81, 0xa8, 0x9b, 0x68, 0x08, 0xeb, 0xa3, 0x00, 0xb9, 0x86, 0x98, 0x73, 0x6a,
-	0x9d, 0xf4, 0x39, 0x61, 0xc5, 0xe8, 0xa6, 0xb6, 0x9d, 0xde, 0xbd, 0x59, 0x7d, 0x79, 0x92, 0x55,
-	0xef, 0x7a, 0x24, 0x82, 0x77, 0x7f, 0x44, 0x71, 0xb8, 0xa4, 0xaf, 0xd9, 0xd3, 0x55, 0x88, 0xc1,
-	0x9a, 0x8a, 0x63, 0xc8, 0x71, 0x4c, 0x3a, 0xbe, 0xbe, 0x88, 0xe3, 0x43, 0x97, 0x22, 0xe4, 0x76,
-	0xb5, 0x3d, 0x4d, 0x81, 0x7e, 0xd4, 0x60, 0x8b, 0x0d, 0xed, 0x86, 0xc1, 0xda, 0x98, 0x9a, 0x06,
-	0xe3, 0x98, 0xf7, 0x59, 0xc8, 0xff, 0xb2, 0xf4, 0xbf, 0xbf, 0x88, 0xff, 0xfb, 0x43, 0xbb, 0x71,
-	0x5f, 0x70, 0xdd, 0x97, 0x54, 0xa1, 0x7d, 0x6c, 0xb0, 0x79, 0x06, 0xe8, 0x5b, 0x0d, 0xa4, 0x85,
-	0x81, 0x1b, 0xdc, 0x1a, 0x58, 0x3c, 0x1c, 0x8b, 0xb8, 0xdc, 0xcb, 0xc7, 0x8b, 0xee, 0x65, 0x5f,
-	0xf1, 0x84, 0x36, 0x52, 0x62, 0x33, 0xb5, 0xe8, 0x07, 0x0d, 0x36, 0xbd, 0xbb, 0xe8, 0x12, 0x8e,
-	0x4d, 0xcc, 0x71, 0x68, 0x23, 0x89, 0xc5, 0x83, 0xa2, 0x2e, 0xe5, 0x8e, 0xa2, 0x0a, 0x07, 0xa5,
-	0x3d, 0xcf, 0x00, 0x7d, 0x0d, 0xa5, 0x40, 0x66, 0x0c, 0x76, 0xfd, 0xfb, 0x48, 0x2e, 0x9e, 0x95,
-	0xbe, 0xe4, 0x78, 0xb0, 0x1b, 0xcc, 0xca, 0xf6, 0x74, 0x15, 0xaa, 0x43, 0x7e, 0x60, 0x31, 0xeb,
-	0xc4, 0xea, 0xc8, 0xcb, 0xb0, 0xba, 0xa4, 0x98, 0x92, 0x0e, 0x4b, 0x55, 0xb7, 0x8f, 0x56, 0xbd,
-	0x3e, 0x5a, 0x3d, 0xf6, 0xfa, 0xe8, 0x41, 0xec, 0xd1, 0x1f, 0x17, 0x35, 0x3d, 0x37, 0x06, 0x0a,
-	0xd5, 0x41, 0x06, 0x60, 0xbc, 0xed, 0xca, 0xf7, 0x11, 0x28, 0xf8, 0x2b, 0xd6, 0x39, 0x25, 0x36,
-	0x5a, 0x87, 0xa4, 0x9b, 0x88, 0x96, 0x29, 0x6b, 0x7e, 0x59, 0x4f, 0xc8, 0x75, 0xdd, 0x44, 0xd7,
-	0x61, 0xbd, 0x83, 0x19, 0x37, 0x28, 0xe1, 0xd4, 0x22, 0x03, 0x62, 0x1a, 0xaa, 0x87, 0x8c, 0x4b,
-	0xf9, 0x6d, 0x61, 0xa0, 0x7b, 0xfa, 0x3b, 0xae, 0xda, 0x07, 0xed, 0x51, 0xa7, 0x41, 0x18, 0x0b,
-	0x42, 0xa3, 0x63, 0xe8, 0x3d, 0x4f, 0x3f, 0x86, 0x12, 0x28, 0x4f, 0x40, 0x27, 0xa3, 0x11, 0x5b,
-	0x30, 0x1a, 0xef, 0x04, 0x3c, 0x3c, 0x08, 0x84, 0xa6, 0x72, 0x0c, 0xf9, 0x89, 0xc2, 0x41, 0xfb,
-	0x90, 0xf6, 0xaa, 0x51, 0xb8, 0xd1, 0x16, 0x74, 0x03, 0x2e, 0x48, 0xb2, 0xfe, 0x1a, 0x81, 0x15,
-	0x5f, 0x88, 0xd5, 0xa9, 0x18, 0xfa, 0x12, 0x2e, 0xf8, 0x12, 0x43, 0xe6, 0x14, 0x2b, 0x6a, 0x9b,
-	0xd1, 0xed, 0xf4, 0xee, 0xde, 0x22, 0x69, 0x34, 0xd1, 0x68, 0xf5, 0x02, 0x0d, 0x0a, 0xd8, 0x7f,
-	0xb9, 0xac, 0x75, 0x48, 0xb6, 0x31, 0x33, 0xba, 0x0e, 0x25, 0xf2, 0x6e, 0x92, 0x7a, 0xa2, 0x8d,
-	0xd9, 0x1d, 0x87, 0x12, 0x64, 0xc0, 0x85, 0x50, 0xaf, 0x52, 0xf1, 0xdf, 0x7b, 0x8d, 0xde, 0xa4,
-	0xe7, 0x27, 0x7a, 0x51, 0xe5, 0xb7, 0x60, 0xc0, 0xe4, 0x4c, 0xb0, 0x9b, 0x0e, 0xda, 0x82, 0xcc,
-	0x78, 0x2a, 0xa8, 0xd4, 0x4c, 0xe9, 0xe9, 0x91, 0xac, 0x6e, 0xa2, 0x8b, 0x90, 0x3e, 0x73, 0xe8,
-	0x69, 0xb3, 0xe3, 0x9c, 0x79, 0x67, 0x4c, 0xe9, 0xe0, 0x89, 0xea, 0x26, 0x5a, 0x85, 0x38, 0xed,
-	0xdb, 0x5e, 0xc6, 0xa5, 0xf4, 0x65, 0xda, 0xb7, 0xeb, 0x26, 0xba, 0xe5, 0x1f, 0x73, 0x31, 0x39,
-	0xe6, 0xde, 0x9d, 0x3f, 0xe6, 0xa6, 0xcc, 0xb6, 0x35, 0x48, 0x78, 0x43, 0x6d, 0x59, 0x06, 0x37,
-	0xce, 0xdd, 0x71, 0x56, 0x84, 0xc4, 0x80, 0x50, 0x66, 0x39, 0xb6, 0xec, 0x9b, 0x51, 0xdd, 0x5b,
-	0x8a, 0x71, 0xd8, 0xb4, 0x28, 0xe3, 0x06, 0x19, 0x10, 0x9b, 0x0b, 0x64, 0xc2, 0x1d, 0x87, 0x52,
-	0xfa, 0xa9, 0x10, 0xd6, 0x4d, 0x54, 0x81, 0xac, 0x4d, 0xbe, 0xf2, 0x19, 0x25, 0xa5, 0x51, 0x5a,
-	0x08, 0x3d, 0x9b, 0x2d, 0xc8, 0xb0, 0x46, 0x9b, 0x98, 0xfd, 0x0e, 0x91, 0x75, 0x9b, 0x72, 0x4d,
-	0x46, 0xb2, 0xba, 0x59, 0x79, 0x1c, 0x85, 0xb5, 0x19, 0x13, 0x11, 0x61, 0x58, 0x19, 0xc7, 0xd6,
-	0xe9, 0x11, 0x2a, 0x43, 0xaf, 0x26, 0xfe, 0xb5, 0xf9, 0xa1, 0x18, 0x71, 0x7e, 0xee, 0xe1, 0x74,
-	0x64, 0x87, 0x64, 0x28, 0x07, 0x91, 0xd1, 0x95, 0x44, 0x2c, 0x13, 0x7d, 0x04, 0x31, 0xcb, 0x6e,
-	0x3a, 0x6a, 0x9e, 0x6f, 0x8f, 0x7d, 0x08, 0xf2, 0x11, 0x3e, 0xe0, 0x40, 0xa4, 0x81, 0x2e, 0x51,
-	0xe8, 0x00, 0xe2, 0x0d, 0xc7, 0x6e, 0x5a, 0x2d, 0x95, 0x7a, 0xef, 0x2d, 0x82, 0xbf, 0x25, 0x11,
-	0xba, 0x42, 0xa2, 0x26, 0x20, 0x7f, 0x05, 0x2a, 0x3e, 0x77, 0xcc, 0x7e, 0x10, 0xe4, 0x9b, 0xf5,
-	0xb0, 0xf0, 0xe5, 0xa9, 0x22, 0xf7, 0x17, 0xb5, 0x2b, 0x42, 0x97, 0x21, 0xe7, 0x72, 0x1b, 0xc1,
-	0x34, 0xc8, 0xba, 0xd2, 0x07, 0x2a, 0x19, 0xae, 0x42, 0x41, 0xbc, 0xcd, 0x9c, 0x01, 0xa1, 0x23,
-	0x43, 0x37, 0x1d, 0xf2, 0x9e, 0x5c, 0x99, 0x56, 0x7e, 0x8a, 0xc2, 0xea, 0xd4, 0x37, 0x06, 0xba,
-	0x02, 0x79, 0x8e, 0x69, 0x8b, 0x70, 0xa3, 0xd1, 0xe9, 0x33, 0x4e, 0xa8, 0xdb, 0x53, 0x52, 0x7a,
-	0xce, 0x15, 0xdf, 0x52, 0xd2, 0x50, 0x35, 0x45, 0x5e, 0x5a, 0x4d, 0xd1, 0x39, 0xd5, 0x14, 0xf3,
-	0x57, 0x53, 0x38, 0xab, 0x97, 0x17, 0xc9, 0xea, 0x78, 0x38, 0xab, 0x7d, 0x95, 0x93, 0x08, 0x56,
-	0xce, 0x0d, 0x48, 0xa8, 0x61, 0xa9, 0x26, 0xe1, 0x66, 0xf0, 0xc2, 0x94, 0xd2, 0x37, 0x6f, 0x75,
-	0x0f, 0x80, 0x0e, 0x21, 0x6f, 0x93, 0x33, 0x43, 0x6c, 0xdd, 0xe3, 0x80, 0x05, 0x39, 0xb2, 0x36,
-	0x39, 0xd3, 0xfb, 0xb6, 0x5a, 0x1e, 0xc5, 0x92, 0xc9, 0x42, 0xea, 0x28, 0x96, 0x4c, 0x17, 0x32,
-	0x47, 0xb1, 0x64, 0xa6, 0x90, 0x3d, 0x8a, 0x25, 0xb3, 0x85, 0xdc, 0x51, 0x2c, 0x99, 0x2b, 0xe4,
-	0x2b, 0xdf, 0x45, 0x60, 0x63, 0xee, 0xa3, 0xe3, 0xff, 0x72, 0x5b, 0x95, 0x9f, 0x35, 0xd8, 0x98,
-	0xfb, 0x26, 0x15, 0x35, 0xa2, 0x3e, 0x0c, 0x54, 0x24, 0x54, 0x7b, 0xcf, 0xba, 0x52, 0x15, 0x88,
-	0xc0, 0xd3, 0x24, 0x12, 0x7c, 0x9a, 0x4c, 0x8c, 0xea, 0xe8, 0x6b, 0x8c, 0xea, 0xdf, 0x97, 0xa1,
-	0x34, 0xfb, 0xb9, 0xfa, 0x26, 0x07, 0x90, 0x2f, 0x74, 0xb1, 0x60, 0xa2, 0x4f, 0x36, 0xf6, 0xe5,
-	0x50, 0x63, 0x47, 0x9f, 0x41, 0x6e, 0x6c, 0x22, 0x0f, 0x1f, 0x5f, 0xf0, 0xf0, 0xd9, 0x11, 0x4e,
-	0x68, 0xd0, 0x06, 0x88, 0x68, 0x50, 0xee, 0x7a, 0x72, 0xef, 0x30, 0xa5, 0x24, 0x72, 0x4a, 0x66,
-	0x3c, 0xb5, 0xf4, 0x92, 0x5c, 0xd0, 0x4b, 0x5a, 0xa1, 0xa4, 0x8f, 0x7b, 0xb0, 0x22, 0x1f, 0x25,
-	0x6d, 0x82, 0x29, 0x3f, 0x21, 0x98, 0xbf, 0xda, 0x73, 0xf6, 0x82, 0x00, 0x1f, 0x7a, 0x58, 0xc9,
-	0x78, 0x03, 0x12, 0x26, 0xe1, 0xd8, 0xea, 0xb0, 0xe9, 0x65, 0xec, 0x7e, 0x91, 0x8b, 0x2a, 0xbe,
-	0x87, 0x87, 0x1d, 0x07, 0x9b, 0x4c, 0xf7, 0x00, 0x22, 0xee, 0x98, 0x0b, 0x6b, 0x5e, 0x4c, 0xbb,
-	0xe9, 0xa4, 0x96, 0xe2, 0xb0, 0x72, 0x9f, 0xea, 0x73, 0xb9, 0x98, 0x99, 0x46, 0xad, 0x94, 0x82,
-	0xfb, 0xb6, 0xfb, 0x53, 0x4f, 0x0b, 0x94, 0x5a, 0xa0, 0x6b, 0xf0, 0x96, 0x24, 0x11, 0x09, 0x40,
-	0xa8, 0x61, 0x99, 0xc4, 0xe6, 0x16, 0x1f, 0x16, 0xb3, 0xf2, 0xee, 0x91, 0xd0, 0x3d, 0x94, 0xaa,
-	0xba, 0xd2, 0xa0, 0x87, 0x90, 0x57, 0x37, 0x3f, 0xea, 0x4d, 0x39, 0xe9, 0xb9, 0x3a, 0x75, 0x08,
-	0xfb, 0x5a, 0x94, 0x9a, 0x0d, 0x5e, 0xa7, 0xca, 0x0d, 0x02, 0xeb, 0xca, 0x5f, 0x11, 0x58, 0x9b,
-	0xf1, 0xe5, 0xf1, 0x26, 0xbb, 0x4b, 0x13, 0x56, 0x27, 0xce, 0x63, 0x58, 0x9c, 0x74, 0xc5, 0xd7,
-	0xac, 0x78, 0xe9, 0xee, 0xbe, 0xda, 0xa9, 0xea, 0x9c, 0x74, 0xf5, 0x95, 0x41, 0x48, 0xc6, 0xd0,
-	0x87, 0x10, 0x97, 0xad, 0xc9, 0xfb, 0x34, 0x9d, 0x99, 0x03, 0x9f, 0x60, 0x8e, 0x0f, 0x3a, 0xce,
-	0x89, 0xae, 0xec, 0xd1, 0x6d, 0xc8, 0x79, 0xd3, 0x40, 0x31, 0x24, 0x16, 0x64, 0xc8, 0xb8, 0xc3,
-	0x40, 0xb6, 0x3f, 0x76, 0x14, 0x4b, 0x6a, 0x85, 0xc8, 0x81, 0xf5, 0xe4, 0x59, 0x79, 0xe9, 0xe9,
-	0xb3, 0xf2, 0xd2, 0x8b, 0x67, 0x65, 0xed, 0x9b, 0xf3, 0xb2, 0xf6, 0xcb, 0x79, 0x59, 0x7b, 0x7c,
-	0x5e, 0xd6, 0x9e, 0x9c, 0x97, 0xb5, 0x3f, 0xcf, 0xcb, 0xda, 0xdf, 0xe7, 0xe5, 0xa5, 0x17, 0xe7,
-	0x65, 0xed, 0xd1, 0xf3, 0xf2, 0xd2, 0x93, 0xe7, 0xe5, 0xa5, 0xa7, 0xcf, 0xcb, 0x4b, 0x5f, 0xec,
-	0xb5, 0x9c, 0xb1, 0x37, 0xcb, 0x99, 0xfd, 0x4f, 0xdb, 0x4d, 0x4a, 0x7a, 0x6a, 0x75, 0x12, 0x97,
-	0x45, 0xb2, 0xf7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa0, 0xdf, 0x1d, 0x36, 0xa1, 0x13, 0x00,
-	0x00,
+func (m *NamespaceReplicationLag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (this *ReplicationTask) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*ReplicationTask)
-	if !ok {
-		that2, ok := that.(ReplicationTask)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *NamespaceReplicationLag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceReplicationLag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.TaskType != that1.TaskType {
-		return false
-	}
-	if this.SourceTaskId != that1.SourceTaskId {
-		return false
+}
+func (m *NamespaceReplicationLag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceReplicationLag.Merge(m, src)
+}
+func (m *NamespaceReplicationLag) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceReplicationLag) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceReplicationLag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceReplicationLag proto.InternalMessageInfo
+
+func (m *NamespaceReplicationLag) GetPendingTaskCount() int64 {
+	if m != nil {
+		return m.PendingTaskCount
 	}
-	if that1.Attributes == nil {
-		if this.Attributes != nil {
-			return false
-		}
-	} else if this.Attributes == nil {
-		return false
-	} else if !this.Attributes.Equal(that1.Attributes) {
-		return false
+	return 0
+}
+
+func (m *NamespaceReplicationLag) GetOldestPendingTaskTime() *time.Time {
+	if m != nil {
+		return m.OldestPendingTaskTime
 	}
-	if that1.VisibilityTime == nil {
-		if this.VisibilityTime != nil {
-			return false
+	return nil
+}
+
+type ShardReplicationLag struct {
+	ShardId int32 `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// Number of replication tasks not yet acked by the remote cluster.
+	TaskLag int64 `protobuf:"varint,2,opt,name=task_lag,json=taskLag,proto3" json:"task_lag,omitempty"`
+	// Time between the last acked replication task and shard local time.
+	TimeLag *time.Duration `protobuf:"bytes,3,opt,name=time_lag,json=timeLag,proto3,stdduration" json:"time_lag,omitempty"`
+	// Number of replication tasks from the remote cluster in the shard's DLQ.
+	DlqSize int64 `protobuf:"varint,4,opt,name=dlq_size,json=dlqSize,proto3" json:"dlq_size,omitempty"`
+}
+
+func (m *ShardReplicationLag) Reset()      { *m = ShardReplicationLag{} }
+func (*ShardReplicationLag) ProtoMessage() {}
+func (*ShardReplicationLag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edd9fae2af6b0532, []int{13}
+}
+func (m *ShardReplicationLag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardReplicationLag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardReplicationLag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if !this.VisibilityTime.Equal(*that1.VisibilityTime) {
-		return false
+		return b[:n], nil
 	}
-	return true
 }
-func (this *ReplicationTask_NamespaceTaskAttributes) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ShardReplicationLag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardReplicationLag.Merge(m, src)
+}
+func (m *ShardReplicationLag) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardReplicationLag) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardReplicationLag.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ReplicationTask_NamespaceTaskAttributes)
-	if !ok {
-		that2, ok := that.(ReplicationTask_NamespaceTaskAttributes)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_ShardReplicationLag proto.InternalMessageInfo
+
+func (m *ShardReplicationLag) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *ShardReplicationLag) GetTaskLag() int64 {
+	if m != nil {
+		return m.TaskLag
 	}
-	if !this.NamespaceTaskAttributes.Equal(that1.NamespaceTaskAttributes) {
-		return false
+	return 0
+}
+
+func (m *ShardReplicationLag) GetTimeLag() *time.Duration {
+	if m != nil {
+		return m.TimeLag
 	}
-	return true
+	return nil
 }
-func (this *ReplicationTask_HistoryTaskAttributes) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ShardReplicationLag) GetDlqSize() int64 {
+	if m != nil {
+		return m.DlqSize
 	}
+	return 0
+}
 
-	that1, ok := that.(*ReplicationTask_HistoryTaskAttributes)
-	if !ok {
-		that2, ok := that.(ReplicationTask_HistoryTaskAttributes)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ClusterReplicationLag struct {
+	// Sum of task lag over all shards.
+	TotalTaskLag int64 `protobuf:"varint,1,opt,name=total_task_lag,json=totalTaskLag,proto3" json:"total_task_lag,omitempty"`
+	// Max task lag of a single shard.
+	MaxTaskLag int64 `protobuf:"varint,2,opt,name=max_task_lag,json=maxTaskLag,proto3" json:"max_task_lag,omitempty"`
+	// Max time lag of a single shard.
+	MaxTimeLag *time.Duration `protobuf:"bytes,3,opt,name=max_time_lag,json=maxTimeLag,proto3,stdduration" json:"max_time_lag,omitempty"`
+	// Sum of DLQ sizes over all shards.
+	DlqSize int64 `protobuf:"varint,4,opt,name=dlq_size,json=dlqSize,proto3" json:"dlq_size,omitempty"`
+	// Lag per namespace name.
+	Namespaces map[string]*NamespaceReplicationLag `protobuf:"bytes,5,rep,name=namespaces,proto3" json:"namespaces,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Shards with the largest lag, ordered by time lag then task lag.
+	SlowestShards []*ShardReplicationLag `protobuf:"bytes,6,rep,name=slowest_shards,json=slowestShards,proto3" json:"slowest_shards,omitempty"`
+	// Set if pending task or DLQ scans reached their limit on some shard, namespace lag and DLQ sizes are lower bounds then.
+	Truncated bool `protobuf:"varint,7,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (m *ClusterReplicationLag) Reset()      { *m = ClusterReplicationLag{} }
+func (*ClusterReplicationLag) ProtoMessage() {}
+func (*ClusterReplicationLag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edd9fae2af6b0532, []int{14}
+}
+func (m *ClusterReplicationLag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterReplicationLag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterReplicationLag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.HistoryTaskAttributes.Equal(that1.HistoryTaskAttributes) {
-		return false
-	}
-	return true
 }
-func (this *ReplicationTask_SyncShardStatusTaskAttributes) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ClusterReplicationLag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterReplicationLag.Merge(m, src)
+}
+func (m *ClusterReplicationLag) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterReplicationLag) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterReplicationLag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterReplicationLag proto.InternalMessageInfo
+
+func (m *ClusterReplicationLag) GetTotalTaskLag() int64 {
+	if m != nil {
+		return m.TotalTaskLag
 	}
+	return 0
+}
 
-	that1, ok := that.(*ReplicationTask_SyncShardStatusTaskAttributes)
-	if !ok {
-		that2, ok := that.(ReplicationTask_SyncShardStatusTaskAttributes)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ClusterReplicationLag) GetMaxTaskLag() int64 {
+	if m != nil {
+		return m.MaxTaskLag
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *ClusterReplicationLag) GetMaxTimeLag() *time.Duration {
+	if m != nil {
+		return m.MaxTimeLag
 	}
-	if !this.SyncShardStatusTaskAttributes.Equal(that1.SyncShardStatusTaskAttributes) {
-		return false
+	return nil
+}
+
+func (m *ClusterReplicationLag) GetDlqSize() int64 {
+	if m != nil {
+		return m.DlqSize
 	}
-	return true
+	return 0
 }
-func (this *ReplicationTask_SyncActivityTaskAttributes) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ClusterReplicationLag) GetNamespaces() map[string]*NamespaceReplicationLag {
+	if m != nil {
+		return m.Namespaces
 	}
+	return nil
+}
 
-	that1, ok := that.(*ReplicationTask_SyncActivityTaskAttributes)
-	if !ok {
-		that2, ok := that.(ReplicationTask_SyncActivityTaskAttributes)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ClusterReplicationLag) GetSlowestShards() []*ShardReplicationLag {
+	if m != nil {
+		return m.SlowestShards
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *ClusterReplicationLag) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
 	}
-	if !this.SyncActivityTaskAttributes.Equal(that1.SyncActivityTaskAttributes) {
-		return false
+	return false
+}
+
+type NamespaceHandoverStep struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// One of Pending, Running, Completed, Failed or Skipped.
+	State     string     `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Error     string     `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	StartTime *time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	EndTime   *time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+}
+
+func (m *NamespaceHandoverStep) Reset()      { *m = NamespaceHandoverStep{} }
+func (*NamespaceHandoverStep) ProtoMessage() {}
+func (*NamespaceHandoverStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edd9fae2af6b0532, []int{15}
+}
+func (m *NamespaceHandoverStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceHandoverStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceHandoverStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *ReplicationTask_HistoryMetadataTaskAttributes) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *NamespaceHandoverStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceHandoverStep.Merge(m, src)
+}
+func (m *NamespaceHandoverStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceHandoverStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceHandoverStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceHandoverStep proto.InternalMessageInfo
+
+func (m *NamespaceHandoverStep) GetName() string {
+	if m != nil {
+		return m.Name
 	}
+	return ""
+}
 
-	that1, ok := that.(*ReplicationTask_HistoryMetadataTaskAttributes)
-	if !ok {
-		that2, ok := that.(ReplicationTask_HistoryMetadataTaskAttributes)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *NamespaceHandoverStep) GetState() string {
+	if m != nil {
+		return m.State
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *NamespaceHandoverStep) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	if !this.HistoryMetadataTaskAttributes.Equal(that1.HistoryMetadataTaskAttributes) {
-		return false
+	return ""
+}
+
+func (m *NamespaceHandoverStep) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return true
+	return nil
 }
-func (this *ReplicationTask_HistoryTaskV2Attributes) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *NamespaceHandoverStep) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
 	}
+	return nil
+}
 
-	that1, ok := that.(*ReplicationTask_HistoryTaskV2Attributes)
-	if !ok {
-		that2, ok := that.(ReplicationTask_HistoryTaskV2Attributes)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type SearchAttributeConflict struct {
+	Name               string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CurrentClusterType v14.IndexedValueType `protobuf:"varint,2,opt,name=current_cluster_type,json=currentClusterType,proto3,enum=temporal.api.enums.v1.IndexedValueType" json:"current_cluster_type,omitempty"`
+	RemoteClusterType  v14.IndexedValueType `protobuf:"varint,3,opt,name=remote_cluster_type,json=remoteClusterType,proto3,enum=temporal.api.enums.v1.IndexedValueType" json:"remote_cluster_type,omitempty"`
+}
+
+func (m *SearchAttributeConflict) Reset()      { *m = SearchAttributeConflict{} }
+func (*SearchAttributeConflict) ProtoMessage() {}
+func (*SearchAttributeConflict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edd9fae2af6b0532, []int{16}
+}
+func (m *SearchAttributeConflict) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchAttributeConflict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchAttributeConflict.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *SearchAttributeConflict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchAttributeConflict.Merge(m, src)
+}
+func (m *SearchAttributeConflict) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchAttributeConflict) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchAttributeConflict.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchAttributeConflict proto.InternalMessageInfo
+
+func (m *SearchAttributeConflict) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if !this.HistoryTaskV2Attributes.Equal(that1.HistoryTaskV2Attributes) {
-		return false
+	return ""
+}
+
+func (m *SearchAttributeConflict) GetCurrentClusterType() v14.IndexedValueType {
+	if m != nil {
+		return m.CurrentClusterType
 	}
-	return true
+	return v14.INDEXED_VALUE_TYPE_UNSPECIFIED
 }
-func (this *ReplicationToken) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *SearchAttributeConflict) GetRemoteClusterType() v14.IndexedValueType {
+	if m != nil {
+		return m.RemoteClusterType
 	}
+	return v14.INDEXED_VALUE_TYPE_UNSPECIFIED
+}
 
-	that1, ok := that.(*ReplicationToken)
-	if !ok {
-		that2, ok := that.(ReplicationToken)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ReplicationDLQFilter selects replication DLQ tasks. Empty fields match all tasks.
+type ReplicationDLQFilter struct {
+	NamespaceId string      `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowId  string      `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	TaskType    v1.TaskType `protobuf:"varint,3,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
+}
+
+func (m *ReplicationDLQFilter) Reset()      { *m = ReplicationDLQFilter{} }
+func (*ReplicationDLQFilter) ProtoMessage() {}
+func (*ReplicationDLQFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edd9fae2af6b0532, []int{17}
+}
+func (m *ReplicationDLQFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationDLQFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationDLQFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ShardId != that1.ShardId {
-		return false
+}
+func (m *ReplicationDLQFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationDLQFilter.Merge(m, src)
+}
+func (m *ReplicationDLQFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationDLQFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationDLQFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationDLQFilter proto.InternalMessageInfo
+
+func (m *ReplicationDLQFilter) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	if this.LastRetrievedMessageId != that1.LastRetrievedMessageId {
-		return false
+	return ""
+}
+
+func (m *ReplicationDLQFilter) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
 	}
-	if this.LastProcessedMessageId != that1.LastProcessedMessageId {
-		return false
+	return ""
+}
+
+func (m *ReplicationDLQFilter) GetTaskType() v1.TaskType {
+	if m != nil {
+		return m.TaskType
 	}
-	if that1.LastProcessedVisibilityTime == nil {
-		if this.LastProcessedVisibilityTime != nil {
-			return false
+	return v1.TASK_TYPE_UNSPECIFIED
+}
+
+type DLQShardResult struct {
+	ShardId      int32  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	MessageCount int64  `protobuf:"varint,2,opt,name=message_count,json=messageCount,proto3" json:"message_count,omitempty"`
+	Error        string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *DLQShardResult) Reset()      { *m = DLQShardResult{} }
+func (*DLQShardResult) ProtoMessage() {}
+func (*DLQShardResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edd9fae2af6b0532, []int{18}
+}
+func (m *DLQShardResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DLQShardResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DLQShardResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if !this.LastProcessedVisibilityTime.Equal(*that1.LastProcessedVisibilityTime) {
-		return false
+		return b[:n], nil
 	}
-	return true
 }
-func (this *SyncShardStatus) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *DLQShardResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DLQShardResult.Merge(m, src)
+}
+func (m *DLQShardResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *DLQShardResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_DLQShardResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DLQShardResult proto.InternalMessageInfo
+
+func (m *DLQShardResult) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
 	}
+	return 0
+}
 
-	that1, ok := that.(*SyncShardStatus)
-	if !ok {
-		that2, ok := that.(SyncShardStatus)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *DLQShardResult) GetMessageCount() int64 {
+	if m != nil {
+		return m.MessageCount
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *DLQShardResult) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	if that1.StatusTime == nil {
-		if this.StatusTime != nil {
-			return false
+	return ""
+}
+
+// WorkflowReplicationState summarizes mutable state of a workflow execution in one cluster.
+type WorkflowReplicationState struct {
+	ClusterName           string                      `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	CurrentVersionHistory *v18.VersionHistory         `protobuf:"bytes,2,opt,name=current_version_history,json=currentVersionHistory,proto3" json:"current_version_history,omitempty"`
+	LastEventId           int64                       `protobuf:"varint,3,opt,name=last_event_id,json=lastEventId,proto3" json:"last_event_id,omitempty"`
+	Status                v14.WorkflowExecutionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"status,omitempty"`
+	Checksum              []byte                      `protobuf:"bytes,5,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	SizeInfo              *v19.ExecutionSizeInfo      `protobuf:"bytes,6,opt,name=size_info,json=sizeInfo,proto3" json:"size_info,omitempty"`
+	// Set when mutable state could not be loaded from the cluster.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// Whether the state matches the state in the active cluster of the namespace.
+	Consistent bool `protobuf:"varint,8,opt,name=consistent,proto3" json:"consistent,omitempty"`
+	// Set when replication tasks were resent to the cluster because its state diverged.
+	Resent bool `protobuf:"varint,9,opt,name=resent,proto3" json:"resent,omitempty"`
+}
+
+func (m *WorkflowReplicationState) Reset()      { *m = WorkflowReplicationState{} }
+func (*WorkflowReplicationState) ProtoMessage() {}
+func (*WorkflowReplicationState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edd9fae2af6b0532, []int{19}
+}
+func (m *WorkflowReplicationState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowReplicationState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowReplicationState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if !this.StatusTime.Equal(*that1.StatusTime) {
-		return false
+		return b[:n], nil
 	}
-	return true
 }
-func (this *ReplicationMessages) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *WorkflowReplicationState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowReplicationState.Merge(m, src)
+}
+func (m *WorkflowReplicationState) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowReplicationState) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowReplicationState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowReplicationState proto.InternalMessageInfo
+
+func (m *WorkflowReplicationState) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
 	}
+	return ""
+}
 
-	that1, ok := that.(*ReplicationMessages)
-	if !ok {
-		that2, ok := that.(ReplicationMessages)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *WorkflowReplicationState) GetCurrentVersionHistory() *v18.VersionHistory {
+	if m != nil {
+		return m.CurrentVersionHistory
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *WorkflowReplicationState) GetLastEventId() int64 {
+	if m != nil {
+		return m.LastEventId
 	}
-	if len(this.ReplicationTasks) != len(that1.ReplicationTasks) {
-		return false
+	return 0
+}
+
+func (m *WorkflowReplicationState) GetStatus() v14.WorkflowExecutionStatus {
+	if m != nil {
+		return m.Status
 	}
-	for i := range this.ReplicationTasks {
-		if !this.ReplicationTasks[i].Equal(that1.ReplicationTasks[i]) {
-			return false
-		}
+	return v14.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED
+}
+
+func (m *WorkflowReplicationState) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
 	}
-	if this.LastRetrievedMessageId != that1.LastRetrievedMessageId {
-		return false
+	return nil
+}
+
+func (m *WorkflowReplicationState) GetSizeInfo() *v19.ExecutionSizeInfo {
+	if m != nil {
+		return m.SizeInfo
 	}
-	if this.HasMore != that1.HasMore {
-		return false
+	return nil
+}
+
+func (m *WorkflowReplicationState) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	if !this.SyncShardStatus.Equal(that1.SyncShardStatus) {
-		return false
+	return ""
+}
+
+func (m *WorkflowReplicationState) GetConsistent() bool {
+	if m != nil {
+		return m.Consistent
 	}
-	return true
+	return false
 }
-func (this *ReplicationTaskInfo) Equal(that interface{}) bool {
+
+func (m *WorkflowReplicationState) GetResent() bool {
+	if m != nil {
+		return m.Resent
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*ReplicationTask)(nil), "temporal.server.api.replication.v1.ReplicationTask")
+	proto.RegisterType((*ReplicationToken)(nil), "temporal.server.api.replication.v1.ReplicationToken")
+	proto.RegisterType((*SyncShardStatus)(nil), "temporal.server.api.replication.v1.SyncShardStatus")
+	proto.RegisterType((*ReplicationMessages)(nil), "temporal.server.api.replication.v1.ReplicationMessages")
+	proto.RegisterType((*ReplicationTaskInfo)(nil), "temporal.server.api.replication.v1.ReplicationTaskInfo")
+	proto.RegisterType((*NamespaceTaskAttributes)(nil), "temporal.server.api.replication.v1.NamespaceTaskAttributes")
+	proto.RegisterType((*SearchAttributesTaskAttributes)(nil), "temporal.server.api.replication.v1.SearchAttributesTaskAttributes")
+	proto.RegisterMapType((map[string]v14.IndexedValueType)(nil), "temporal.server.api.replication.v1.SearchAttributesTaskAttributes.CustomSearchAttributesEntry")
+	proto.RegisterType((*HistoryTaskAttributes)(nil), "temporal.server.api.replication.v1.HistoryTaskAttributes")
+	proto.RegisterType((*HistoryMetadataTaskAttributes)(nil), "temporal.server.api.replication.v1.HistoryMetadataTaskAttributes")
+	proto.RegisterType((*SyncShardStatusTaskAttributes)(nil), "temporal.server.api.replication.v1.SyncShardStatusTaskAttributes")
+	proto.RegisterType((*SyncActivityTaskAttributes)(nil), "temporal.server.api.replication.v1.SyncActivityTaskAttributes")
+	proto.RegisterType((*HistoryTaskV2Attributes)(nil), "temporal.server.api.replication.v1.HistoryTaskV2Attributes")
+	proto.RegisterType((*NamespaceReplicationLag)(nil), "temporal.server.api.replication.v1.NamespaceReplicationLag")
+	proto.RegisterType((*ShardReplicationLag)(nil), "temporal.server.api.replication.v1.ShardReplicationLag")
+	proto.RegisterType((*ClusterReplicationLag)(nil), "temporal.server.api.replication.v1.ClusterReplicationLag")
+	proto.RegisterMapType((map[string]*NamespaceReplicationLag)(nil), "temporal.server.api.replication.v1.ClusterReplicationLag.NamespacesEntry")
+	proto.RegisterType((*NamespaceHandoverStep)(nil), "temporal.server.api.replication.v1.NamespaceHandoverStep")
+	proto.RegisterType((*SearchAttributeConflict)(nil), "temporal.server.api.replication.v1.SearchAttributeConflict")
+	proto.RegisterType((*ReplicationDLQFilter)(nil), "temporal.server.api.replication.v1.ReplicationDLQFilter")
+	proto.RegisterType((*DLQShardResult)(nil), "temporal.server.api.replication.v1.DLQShardResult")
+	proto.RegisterType((*WorkflowReplicationState)(nil), "temporal.server.api.replication.v1.WorkflowReplicationState")
+}
+
+func init() {
+	proto.RegisterFile("temporal/server/api/replication/v1/message.proto", fileDescriptor_edd9fae2af6b0532)
+}
+
+var fileDescriptor_edd9fae2af6b0532 = []byte{
+	// 2374 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0xf2, 0x43, 0x24, 0x1f, 0x29, 0x8a, 0x1e, 0x59, 0x16, 0xc5, 0xd4, 0xb4, 0xcc, 0x3a,
+	0xb5, 0x53, 0x24, 0x94, 0x25, 0x03, 0x6d, 0x62, 0xf7, 0x03, 0x92, 0x3f, 0x6a, 0xaa, 0xb6, 0x63,
+	0xaf, 0x0c, 0x1b, 0xee, 0x21, 0xdb, 0xd1, 0xee, 0x90, 0x5c, 0x68, 0xb9, 0xcb, 0xec, 0x0c, 0x69,
+	0xd3, 0xa7, 0x02, 0x39, 0x14, 0x28, 0x5a, 0x34, 0xc7, 0xdc, 0x0a, 0xb8, 0x3d, 0xf4, 0xd4, 0xfe,
+	0x1b, 0x3d, 0xf4, 0xe0, 0x4b, 0x80, 0xf4, 0xd4, 0x5a, 0xee, 0xa1, 0xc7, 0xfc, 0x09, 0xc5, 0x7c,
+	0x2c, 0xb9, 0xcb, 0x5d, 0xd1, 0x94, 0xd3, 0x9c, 0x7a, 0x12, 0xf7, 0x7d, 0xcf, 0x9b, 0x37, 0xbf,
+	0xf7, 0x66, 0x04, 0x97, 0x19, 0xe9, 0xf5, 0x3d, 0x1f, 0x3b, 0x9b, 0x94, 0xf8, 0x43, 0xe2, 0x6f,
+	0xe2, 0xbe, 0xbd, 0xe9, 0x93, 0xbe, 0x63, 0x9b, 0x98, 0xd9, 0x9e, 0xbb, 0x39, 0xdc, 0xda, 0xec,
+	0x11, 0x4a, 0x71, 0x87, 0x34, 0xfb, 0xbe, 0xc7, 0x3c, 0xd4, 0x08, 0x34, 0x9a, 0x52, 0xa3, 0x89,
+	0xfb, 0x76, 0x33, 0xa4, 0xd1, 0x1c, 0x6e, 0xd5, 0xea, 0x1d, 0xcf, 0xeb, 0x38, 0x64, 0x53, 0x68,
+	0x1c, 0x0c, 0xda, 0x9b, 0xd6, 0xc0, 0x97, 0x4c, 0x41, 0xa9, 0x9d, 0x9b, 0xe6, 0x33, 0xbb, 0x47,
+	0x28, 0xc3, 0xbd, 0xbe, 0x12, 0x38, 0x6f, 0x91, 0x3e, 0x71, 0x2d, 0xe2, 0x9a, 0x36, 0xa1, 0x9b,
+	0x1d, 0xaf, 0xe3, 0x09, 0xba, 0xf8, 0xa5, 0x44, 0x9a, 0x49, 0x91, 0x13, 0x77, 0xd0, 0xa3, 0x3c,
+	0xe6, 0x70, 0x40, 0x52, 0xfe, 0xe2, 0x4c, 0x79, 0x86, 0xe9, 0xa1, 0x12, 0x7c, 0x3f, 0x49, 0xb0,
+	0x6b, 0x53, 0xe6, 0xf9, 0xa3, 0x58, 0x3a, 0x6a, 0x57, 0x92, 0xa4, 0xfb, 0xc4, 0xa7, 0x36, 0x65,
+	0xc4, 0x35, 0x09, 0xd7, 0x70, 0x71, 0x8f, 0xd0, 0x3e, 0x36, 0x09, 0x55, 0x4a, 0x1f, 0x24, 0x29,
+	0x3d, 0xf5, 0xfc, 0xc3, 0xb6, 0xe3, 0x3d, 0x8d, 0xfb, 0xb8, 0x30, 0x16, 0xe7, 0x72, 0xa6, 0xd7,
+	0xeb, 0x25, 0x6c, 0x4c, 0xad, 0x11, 0x91, 0x1a, 0xaf, 0x4c, 0x8a, 0x27, 0x5a, 0x1a, 0xcb, 0x04,
+	0xae, 0x63, 0xa9, 0xe2, 0x52, 0xe3, 0xe8, 0xe3, 0x2e, 0xdf, 0x8b, 0x08, 0xce, 0x2a, 0x9b, 0xda,
+	0xbb, 0x11, 0xd1, 0x63, 0xd3, 0x19, 0x15, 0x6b, 0x63, 0xdb, 0x19, 0xf8, 0x71, 0xc7, 0x8d, 0xcf,
+	0x0a, 0xb0, 0xac, 0x4f, 0xdc, 0x3d, 0xc4, 0xf4, 0x10, 0xdd, 0x83, 0x02, 0xdf, 0x45, 0x83, 0x8d,
+	0xfa, 0xa4, 0xaa, 0x6d, 0x68, 0x97, 0xca, 0xdb, 0x5b, 0xcd, 0xa4, 0x62, 0x15, 0xcb, 0x6e, 0x0e,
+	0xb7, 0x9a, 0x53, 0x16, 0x1e, 0x8e, 0xfa, 0x44, 0xcf, 0x33, 0xf5, 0x0b, 0x5d, 0x80, 0x32, 0xf5,
+	0x06, 0xbe, 0x49, 0x0c, 0x61, 0xd6, 0xb6, 0xaa, 0xa9, 0x0d, 0xed, 0x52, 0x5a, 0x2f, 0x49, 0x2a,
+	0xd7, 0x68, 0x59, 0x68, 0x04, 0xeb, 0xe3, 0x04, 0x49, 0x41, 0xcc, 0x98, 0x6f, 0x1f, 0x0c, 0x18,
+	0xa1, 0xd5, 0xf4, 0x86, 0x76, 0xa9, 0xb8, 0x7d, 0xad, 0xf9, 0xe6, 0x23, 0xd3, 0xbc, 0x17, 0x18,
+	0xe1, 0x76, 0x77, 0xc6, 0x26, 0x6e, 0x2f, 0xe8, 0x6b, 0x6e, 0x32, 0x0b, 0x51, 0x58, 0x53, 0x79,
+	0x8c, 0x39, 0xce, 0x08, 0xc7, 0x1f, 0xcd, 0xe3, 0xf8, 0xb6, 0x34, 0x11, 0x73, 0xbb, 0xda, 0x4d,
+	0x62, 0xa0, 0xdf, 0x69, 0x70, 0x9e, 0x8e, 0x5c, 0xd3, 0xa0, 0x5d, 0xec, 0x5b, 0x06, 0x65, 0x98,
+	0x0d, 0x68, 0xcc, 0x7f, 0x56, 0xf8, 0xdf, 0x99, 0xc7, 0xff, 0xfe, 0xc8, 0x35, 0xf7, 0xb9, 0xad,
+	0x7d, 0x61, 0x2a, 0x16, 0xc7, 0x59, 0x3a, 0x4b, 0x00, 0x7d, 0xa6, 0x81, 0x90, 0x30, 0xb0, 0xc9,
+	0xec, 0xa1, 0xcd, 0xe2, 0xb9, 0x58, 0x14, 0xb1, 0xfc, 0x64, 0xde, 0x58, 0x76, 0x94, 0x9d, 0x58,
+	0x20, 0x35, 0x7a, 0x2c, 0x17, 0xfd, 0x56, 0x83, 0x8d, 0x60, 0x2f, 0x7a, 0x84, 0x61, 0x0b, 0x33,
+	0x1c, 0x0b, 0x24, 0x37, 0x7f, 0x52, 0xd4, 0xa6, 0xdc, 0x55, 0xa6, 0xe2, 0x49, 0xe9, 0xce, 0x12,
+	0x40, 0xcf, 0xa1, 0x16, 0xa9, 0x8c, 0xe1, 0x76, 0x38, 0x8e, 0xfc, 0xfc, 0x55, 0x19, 0x2a, 0x8e,
+	0x47, 0xdb, 0xd1, 0xaa, 0xec, 0x26, 0xb3, 0xd0, 0xef, 0x79, 0x81, 0x10, 0xec, 0x9b, 0xdd, 0x90,
+	0xcf, 0x58, 0x2e, 0x40, 0xc4, 0xb0, 0x3b, 0xd7, 0xa6, 0x08, 0x63, 0x13, 0x0f, 0xb1, 0x64, 0xd4,
+	0xe9, 0x4c, 0x09, 0xd4, 0x82, 0xe5, 0xa1, 0x4d, 0xed, 0x03, 0xdb, 0x11, 0xe5, 0x61, 0xf7, 0x48,
+	0xb5, 0x20, 0xdc, 0xd7, 0x9a, 0xb2, 0x0f, 0x35, 0x83, 0x3e, 0xd4, 0x7c, 0x18, 0xf4, 0xa1, 0xdd,
+	0xcc, 0xe7, 0xff, 0x3c, 0xa7, 0xe9, 0xe5, 0x89, 0x22, 0x67, 0xed, 0x96, 0x00, 0x26, 0x8b, 0x68,
+	0xfc, 0x26, 0x05, 0x95, 0x30, 0x86, 0x78, 0x87, 0xc4, 0x45, 0xeb, 0x90, 0x97, 0x47, 0xc3, 0xb6,
+	0x04, 0x0a, 0x65, 0xf5, 0x9c, 0xf8, 0x6e, 0x59, 0xe8, 0x23, 0x58, 0x77, 0x30, 0x65, 0x86, 0x4f,
+	0x98, 0x6f, 0x93, 0x21, 0xb1, 0x0c, 0x85, 0x6a, 0x13, 0x70, 0x39, 0xc3, 0x05, 0xf4, 0x80, 0x7f,
+	0x57, 0xb2, 0x43, 0xaa, 0x7d, 0xdf, 0x33, 0x09, 0xa5, 0x51, 0xd5, 0xf4, 0x44, 0xf5, 0x7e, 0xc0,
+	0x9f, 0xa8, 0x12, 0xa8, 0x4f, 0xa9, 0x4e, 0x67, 0x23, 0x33, 0x67, 0x36, 0xde, 0x89, 0x78, 0x78,
+	0x14, 0x49, 0x4d, 0xe3, 0x21, 0x2c, 0x4f, 0x1d, 0x65, 0xb4, 0x03, 0xc5, 0x00, 0x1f, 0xb8, 0x1b,
+	0x6d, 0x4e, 0x37, 0x20, 0x95, 0x84, 0xd5, 0xbf, 0xa4, 0x60, 0x25, 0x94, 0x62, 0xb5, 0x2a, 0x8a,
+	0x7e, 0x09, 0xa7, 0x42, 0x65, 0x22, 0xca, 0x8b, 0x56, 0xb5, 0x8d, 0xf4, 0xa5, 0xe2, 0xf6, 0x95,
+	0x79, 0x8a, 0x6a, 0x0a, 0xfa, 0xf5, 0x8a, 0x1f, 0x25, 0xd0, 0x6f, 0xb2, 0x59, 0xeb, 0x90, 0xef,
+	0x62, 0x6a, 0xf4, 0x3c, 0x9f, 0x88, 0xbd, 0xc9, 0xeb, 0xb9, 0x2e, 0xa6, 0x77, 0x3d, 0x9f, 0x20,
+	0x03, 0x4e, 0xc5, 0xd0, 0x53, 0xe5, 0xff, 0xca, 0x5b, 0xa0, 0xa5, 0xbe, 0x3c, 0x85, 0x8e, 0x8d,
+	0x2f, 0xa3, 0x09, 0x13, 0x5d, 0xca, 0x6d, 0x7b, 0xe8, 0x3c, 0x94, 0x26, 0x7d, 0x4a, 0x95, 0x66,
+	0x41, 0x2f, 0x8e, 0x69, 0x2d, 0x0b, 0x9d, 0x83, 0x62, 0x30, 0x08, 0x04, 0x6b, 0x2c, 0xe8, 0x10,
+	0x90, 0x5a, 0x16, 0x5a, 0x85, 0x45, 0x7f, 0xe0, 0x06, 0x15, 0x57, 0xd0, 0xb3, 0xfe, 0xc0, 0x6d,
+	0x59, 0xe8, 0x7a, 0xb8, 0xf1, 0x66, 0x44, 0xe3, 0xfd, 0xde, 0xec, 0xc6, 0x9b, 0xd0, 0x6d, 0xd7,
+	0x20, 0x17, 0xb4, 0xd9, 0xac, 0x48, 0xee, 0x22, 0x93, 0x0d, 0xb6, 0x0a, 0xb9, 0x21, 0xf1, 0xa9,
+	0xed, 0xb9, 0x02, 0xc9, 0xd3, 0x7a, 0xf0, 0xc9, 0x1b, 0x74, 0xdb, 0xf6, 0x29, 0x33, 0xc8, 0x90,
+	0xb8, 0x8c, 0x6b, 0xe6, 0x64, 0x83, 0x16, 0xd4, 0x9b, 0x9c, 0xd8, 0xb2, 0x50, 0x03, 0x96, 0x5c,
+	0xf2, 0x2c, 0x24, 0x94, 0x17, 0x42, 0x45, 0x4e, 0x0c, 0x64, 0xce, 0x43, 0x89, 0x9a, 0x5d, 0x62,
+	0x0d, 0x1c, 0x22, 0xce, 0x6d, 0x41, 0x8a, 0x8c, 0x69, 0x2d, 0xab, 0xf1, 0x22, 0x0b, 0x6b, 0xc7,
+	0xf4, 0x68, 0x84, 0x61, 0x65, 0x92, 0x5b, 0xaf, 0x4f, 0xe4, 0xac, 0xab, 0x66, 0x90, 0xcb, 0xb3,
+	0x53, 0x31, 0xb6, 0xf9, 0x71, 0xa0, 0xa7, 0x23, 0x37, 0x46, 0x43, 0x65, 0x48, 0x8d, 0xb7, 0x24,
+	0x65, 0x5b, 0xe8, 0x47, 0x90, 0xb1, 0xdd, 0xb6, 0xa7, 0x26, 0x8c, 0x4b, 0x13, 0x1f, 0xdc, 0xf8,
+	0x58, 0x3f, 0xe2, 0x80, 0x97, 0x81, 0x2e, 0xb4, 0xd0, 0x2e, 0x2c, 0x9a, 0x9e, 0xdb, 0xb6, 0x3b,
+	0xaa, 0xf4, 0xbe, 0x3f, 0x8f, 0xfe, 0x75, 0xa1, 0xa1, 0x2b, 0x4d, 0xd4, 0x06, 0x14, 0x3e, 0x81,
+	0xca, 0x9e, 0x6c, 0xfc, 0x3f, 0x8c, 0xda, 0x3b, 0x6e, 0xd4, 0x09, 0xd5, 0xa9, 0x32, 0x7e, 0xca,
+	0x9f, 0x26, 0xa1, 0x77, 0xa1, 0x2c, 0x6d, 0x1b, 0xd1, 0x32, 0x58, 0x92, 0xd4, 0x47, 0xaa, 0x18,
+	0xde, 0x83, 0x0a, 0x9f, 0x16, 0xbd, 0x21, 0xf1, 0xc7, 0x82, 0xb2, 0x1c, 0x96, 0x03, 0x7a, 0x20,
+	0xfa, 0x41, 0x34, 0xf2, 0xb6, 0xed, 0x30, 0xe2, 0x8b, 0xb2, 0x28, 0x44, 0x02, 0xb8, 0x25, 0x18,
+	0xe8, 0x89, 0x3c, 0x39, 0x06, 0x76, 0x6c, 0x4c, 0x09, 0xad, 0x16, 0x04, 0xca, 0xfc, 0x20, 0x71,
+	0x5b, 0x43, 0x83, 0x7f, 0x64, 0xa5, 0xfc, 0xc7, 0x0e, 0xd7, 0x97, 0x27, 0x6e, 0x47, 0x9a, 0x42,
+	0xf7, 0x20, 0x8f, 0xfb, 0xb6, 0x71, 0x48, 0x46, 0xbc, 0x23, 0x1e, 0x0f, 0x5e, 0xc7, 0x99, 0xdd,
+	0xe9, 0xdb, 0x3f, 0x27, 0x23, 0x3d, 0x87, 0xc5, 0x5f, 0xda, 0xf8, 0x6b, 0x0a, 0xea, 0xb3, 0xdb,
+	0x25, 0xfa, 0x42, 0x83, 0xaa, 0x39, 0xa0, 0xcc, 0xeb, 0x19, 0xb1, 0x2e, 0xad, 0x00, 0xf4, 0x93,
+	0x6f, 0xde, 0x95, 0x9b, 0xd7, 0x85, 0x8b, 0x69, 0xa1, 0x9b, 0x2e, 0xf3, 0x47, 0xfa, 0x19, 0x33,
+	0x91, 0x59, 0xf3, 0xe1, 0x9d, 0x19, 0x6a, 0xa8, 0x02, 0xe9, 0x43, 0x32, 0x52, 0xc0, 0xc5, 0x7f,
+	0xa2, 0x1f, 0x43, 0x76, 0x88, 0x9d, 0x01, 0x11, 0xe7, 0xa2, 0xbc, 0x7d, 0x31, 0x5a, 0x75, 0xe3,
+	0x23, 0xd6, 0x72, 0x2d, 0xf2, 0x8c, 0x58, 0x8f, 0xb8, 0xa8, 0x40, 0x1d, 0xa9, 0x75, 0x35, 0xf5,
+	0xa1, 0xd6, 0xf8, 0x63, 0x1a, 0x56, 0x13, 0x27, 0x60, 0x74, 0x11, 0x96, 0x19, 0xf6, 0x3b, 0x84,
+	0x19, 0xa6, 0x33, 0xa0, 0x8c, 0xf8, 0x32, 0x3d, 0x05, 0xbd, 0x2c, 0xc9, 0xd7, 0x15, 0x35, 0x86,
+	0xac, 0xa9, 0x37, 0x22, 0x6b, 0x7a, 0x06, 0xb2, 0x66, 0xc2, 0xc8, 0x1a, 0x47, 0xb8, 0xec, 0x3c,
+	0x08, 0xb7, 0x18, 0x47, 0xb8, 0x10, 0x8a, 0xe6, 0xa2, 0x28, 0x7a, 0x15, 0x72, 0x6a, 0x94, 0x53,
+	0x53, 0xd1, 0x46, 0x34, 0x8d, 0x8a, 0x19, 0x9a, 0x06, 0xf5, 0x40, 0x01, 0xdd, 0x86, 0x65, 0x97,
+	0x3c, 0x35, 0x78, 0xe8, 0x81, 0x0d, 0x98, 0xd3, 0xc6, 0x92, 0x4b, 0x9e, 0xea, 0x03, 0x57, 0x7d,
+	0xee, 0x65, 0xf2, 0xf9, 0x4a, 0x61, 0x2f, 0x93, 0x2f, 0x56, 0x4a, 0x7b, 0x99, 0x7c, 0xa9, 0xb2,
+	0xb4, 0x97, 0xc9, 0x2f, 0x55, 0xca, 0x7b, 0x99, 0x7c, 0xb9, 0xb2, 0xdc, 0xf8, 0x75, 0x0a, 0xce,
+	0xce, 0x1c, 0x89, 0xff, 0x5f, 0x76, 0xab, 0xf1, 0x27, 0x0d, 0xce, 0xce, 0xbc, 0x31, 0x71, 0xbc,
+	0x54, 0xd7, 0x56, 0x95, 0x09, 0x75, 0x62, 0x96, 0x24, 0x55, 0x25, 0x22, 0x32, 0xa6, 0xa6, 0xa2,
+	0x63, 0xea, 0xd4, 0xd8, 0x96, 0x7e, 0x8b, 0xb1, 0xed, 0x1f, 0x59, 0xa8, 0x1d, 0x7f, 0x99, 0xfa,
+	0x36, 0x87, 0x91, 0x50, 0xea, 0x32, 0xd1, 0x42, 0x9f, 0x6e, 0xf2, 0xd9, 0x58, 0x93, 0x47, 0x3f,
+	0x83, 0xf2, 0x44, 0x44, 0x2c, 0x7e, 0x71, 0xce, 0xc5, 0x2f, 0x8d, 0xf5, 0x38, 0x07, 0x9d, 0x05,
+	0x9e, 0x0d, 0x9f, 0x49, 0x4f, 0x72, 0x0f, 0x0b, 0x8a, 0x22, 0x26, 0xa6, 0x52, 0xc0, 0x16, 0x5e,
+	0xf2, 0x73, 0x7a, 0x29, 0x2a, 0x2d, 0xe1, 0xe3, 0x3e, 0xac, 0x88, 0x01, 0xb5, 0x4b, 0xb0, 0xcf,
+	0x0e, 0x08, 0x66, 0x27, 0xbb, 0xda, 0x9c, 0xe2, 0xca, 0xb7, 0x03, 0x5d, 0x61, 0xf1, 0x2a, 0xe4,
+	0x2c, 0xc2, 0xb0, 0xed, 0xd0, 0xe4, 0x63, 0xac, 0x9e, 0x92, 0x86, 0x5b, 0xcd, 0xfb, 0x78, 0xe4,
+	0x78, 0xd8, 0xa2, 0x7a, 0xa0, 0xc0, 0xf3, 0x8e, 0x19, 0x97, 0x66, 0xd5, 0xa2, 0x2c, 0x27, 0xf5,
+	0xc9, 0x17, 0x2b, 0xe2, 0x54, 0x8f, 0x39, 0xd5, 0x52, 0x92, 0x69, 0xc5, 0xe4, 0xb6, 0x6f, 0xc9,
+	0x9f, 0x7a, 0x91, 0x6b, 0xa9, 0x0f, 0x74, 0x19, 0x4e, 0x0b, 0x23, 0xbc, 0x00, 0x88, 0x6f, 0xd8,
+	0x16, 0x71, 0x99, 0xcd, 0x46, 0xd5, 0x25, 0xb1, 0xf7, 0x88, 0xf3, 0x1e, 0x0b, 0x56, 0x4b, 0x71,
+	0xd0, 0x63, 0x58, 0x56, 0x3b, 0x3f, 0xc6, 0xa6, 0xb2, 0xf0, 0xdc, 0x4c, 0x6c, 0x6f, 0x21, 0x88,
+	0x52, 0x73, 0x42, 0x80, 0x54, 0xe5, 0x61, 0xe4, 0xbb, 0xf1, 0xef, 0x14, 0xac, 0x1d, 0x73, 0x2f,
+	0xfe, 0x36, 0xd1, 0xa5, 0x0d, 0xab, 0x53, 0xeb, 0x31, 0x6c, 0x46, 0x7a, 0xfc, 0xad, 0x85, 0x37,
+	0xed, 0xed, 0x93, 0xad, 0xaa, 0xc5, 0x48, 0x4f, 0x5f, 0x19, 0xc6, 0x68, 0x14, 0x7d, 0x08, 0x8b,
+	0x02, 0x9a, 0x82, 0x87, 0x93, 0x63, 0x6b, 0xe0, 0x06, 0x66, 0x78, 0xd7, 0xf1, 0x0e, 0x74, 0x25,
+	0x8f, 0x6e, 0x41, 0x39, 0xe8, 0x06, 0xca, 0x42, 0x6e, 0x4e, 0x0b, 0x25, 0xd9, 0x0c, 0x04, 0xfc,
+	0xd1, 0xbd, 0x4c, 0x5e, 0xab, 0xa4, 0x1a, 0x2f, 0x34, 0x58, 0x4b, 0x9a, 0x14, 0xef, 0xe0, 0x0e,
+	0x7a, 0x1f, 0x10, 0x7f, 0x1e, 0xb6, 0xdd, 0x8e, 0x7c, 0x58, 0x30, 0xbd, 0x81, 0xcb, 0x04, 0x8a,
+	0xa4, 0xf5, 0x8a, 0xe2, 0xf0, 0xbd, 0xb9, 0xce, 0xe9, 0xe8, 0x09, 0x54, 0x3d, 0xc7, 0x22, 0xfc,
+	0x0a, 0x1c, 0x56, 0x12, 0xa7, 0x25, 0x35, 0xe7, 0x69, 0x59, 0x95, 0x16, 0xee, 0x4f, 0x6c, 0x0b,
+	0x9c, 0x7b, 0xa1, 0xc1, 0x8a, 0x80, 0xe2, 0xa9, 0x00, 0x67, 0x3c, 0x02, 0xac, 0x83, 0xb8, 0xf4,
+	0x18, 0x0e, 0xee, 0xa8, 0x6b, 0xa4, 0xb8, 0xf8, 0x70, 0xad, 0xab, 0x90, 0xe7, 0x41, 0x09, 0x96,
+	0x44, 0xdd, 0xf5, 0x58, 0x60, 0x37, 0xd4, 0x4b, 0xfa, 0x6e, 0xe6, 0x0b, 0x1e, 0x57, 0x8e, 0x2b,
+	0x28, 0x8f, 0x96, 0xf3, 0xa9, 0x41, 0xed, 0xe7, 0x24, 0x00, 0x3e, 0xcb, 0xf9, 0x74, 0xdf, 0x7e,
+	0x4e, 0x1a, 0x2f, 0x32, 0xb0, 0xaa, 0x60, 0x7f, 0x2a, 0xcc, 0x0b, 0x50, 0x66, 0x1e, 0xc3, 0x8e,
+	0x31, 0x8e, 0x48, 0xe6, 0xb0, 0x24, 0xa8, 0x0f, 0x55, 0x58, 0x1b, 0x50, 0xea, 0xe1, 0x67, 0xc6,
+	0x54, 0xd4, 0xd0, 0xc3, 0xcf, 0x02, 0x89, 0x1d, 0x25, 0x71, 0xc2, 0xe0, 0x85, 0x89, 0x37, 0xc6,
+	0x8f, 0x6c, 0x80, 0xc9, 0x0b, 0xba, 0x2a, 0xf7, 0xd6, 0x3c, 0x33, 0x6a, 0xe2, 0xa2, 0x27, 0xd3,
+	0xb3, 0x1a, 0x47, 0x43, 0xc6, 0xd1, 0x27, 0x50, 0xa6, 0x8e, 0xf7, 0x94, 0xd7, 0x8a, 0xd8, 0x2f,
+	0x7e, 0x08, 0xd2, 0xd1, 0x0b, 0xcd, 0x8c, 0x91, 0x38, 0x5e, 0x08, 0xfa, 0x92, 0x32, 0x27, 0x78,
+	0x14, 0x7d, 0x07, 0x0a, 0xcc, 0x1f, 0xb8, 0x26, 0x66, 0x44, 0xb6, 0x85, 0xbc, 0x3e, 0x21, 0xd4,
+	0x9e, 0xc3, 0xf2, 0x54, 0x70, 0x09, 0x43, 0xef, 0x83, 0xf0, 0xd0, 0x7b, 0xd2, 0xc7, 0xe5, 0xa9,
+	0xe8, 0x42, 0x83, 0xf0, 0x97, 0x1a, 0xac, 0x8e, 0xc5, 0x6e, 0x63, 0xd7, 0xe2, 0x37, 0xa6, 0x7d,
+	0x46, 0xfa, 0x08, 0x41, 0x86, 0x67, 0x48, 0xc5, 0x20, 0x7e, 0xa3, 0xd3, 0x90, 0xa5, 0x0c, 0x33,
+	0xa2, 0x00, 0x4e, 0x7e, 0x70, 0x2a, 0xf1, 0x7d, 0xcf, 0x0f, 0x3a, 0xb2, 0xf8, 0x40, 0x3f, 0x55,
+	0xbd, 0xf0, 0x64, 0x6f, 0x4d, 0xb2, 0x5b, 0x72, 0x2a, 0xba, 0x06, 0x79, 0xe2, 0xaa, 0x4e, 0x99,
+	0x9d, 0x53, 0x3d, 0x47, 0x5c, 0xd1, 0x25, 0x1b, 0xaf, 0x34, 0x58, 0x9b, 0xba, 0x4f, 0xf0, 0x8b,
+	0xa5, 0x63, 0x9b, 0x2c, 0x71, 0x65, 0x4f, 0xe0, 0xb4, 0x39, 0xf0, 0x7d, 0x3e, 0x9b, 0xa9, 0xf9,
+	0x49, 0xbe, 0x6b, 0x9c, 0xf0, 0x8a, 0x81, 0x94, 0x11, 0x55, 0x82, 0x9c, 0x86, 0x1e, 0xc3, 0x8a,
+	0x4f, 0x7a, 0x1e, 0x23, 0x51, 0xcb, 0xe9, 0x93, 0x59, 0x3e, 0x25, 0x6d, 0x84, 0x0c, 0x37, 0xfe,
+	0xa0, 0xc1, 0xe9, 0xd0, 0xce, 0xde, 0xb8, 0xf3, 0x40, 0x5d, 0x5d, 0xff, 0x17, 0x73, 0x56, 0xe4,
+	0x75, 0x27, 0xfd, 0x76, 0xaf, 0x3b, 0x8d, 0x36, 0x94, 0x6f, 0xdc, 0x79, 0xa0, 0x0e, 0x08, 0x1d,
+	0x38, 0x6c, 0x16, 0x42, 0x7e, 0x17, 0x96, 0x82, 0xa7, 0x36, 0x09, 0xec, 0xea, 0xff, 0x2e, 0x8a,
+	0x28, 0x41, 0x3d, 0xb1, 0xd6, 0x1a, 0x7f, 0x4f, 0x43, 0xf5, 0xb1, 0x8a, 0x3d, 0x94, 0x91, 0x7d,
+	0x51, 0x9e, 0xe7, 0xa1, 0x14, 0x24, 0x3e, 0xb4, 0xed, 0x45, 0x45, 0xe3, 0xc5, 0x8f, 0xda, 0xb0,
+	0x16, 0xec, 0xfe, 0xf4, 0xf0, 0x90, 0x7a, 0xab, 0xe1, 0x61, 0x55, 0x99, 0x8b, 0x92, 0xf9, 0x25,
+	0x40, 0x8c, 0x33, 0xe3, 0x4b, 0x80, 0x7c, 0xc2, 0x15, 0x23, 0x4f, 0x70, 0x09, 0xb8, 0x05, 0x8b,
+	0xa1, 0xf7, 0xc1, 0x72, 0xd8, 0x75, 0x24, 0xdd, 0xc1, 0x7a, 0x6f, 0x3e, 0x23, 0xe6, 0x20, 0x58,
+	0xed, 0x80, 0xea, 0x4a, 0x1b, 0xd5, 0x20, 0x6f, 0x76, 0x89, 0x79, 0x48, 0x07, 0x3d, 0x71, 0x7c,
+	0x4a, 0xfa, 0xf8, 0x1b, 0x7d, 0x0c, 0x05, 0x8e, 0xb8, 0x86, 0x78, 0x4b, 0x92, 0xfd, 0x3e, 0x79,
+	0x90, 0x18, 0xff, 0x87, 0x70, 0xb8, 0xd5, 0x9c, 0x38, 0xb2, 0x9f, 0xcb, 0x57, 0xa5, 0x3c, 0x55,
+	0xbf, 0x26, 0xdb, 0x92, 0x0b, 0x43, 0x40, 0x1d, 0xc0, 0xf4, 0x5c, 0xf9, 0x84, 0xc1, 0xc4, 0xb4,
+	0x9b, 0xd7, 0x43, 0x14, 0x74, 0x06, 0x16, 0x7d, 0x42, 0x39, 0xaf, 0x20, 0x78, 0xea, 0x6b, 0xd7,
+	0x7e, 0xf9, 0xaa, 0xbe, 0xf0, 0xd5, 0xab, 0xfa, 0xc2, 0xd7, 0xaf, 0xea, 0xda, 0xaf, 0x8e, 0xea,
+	0xda, 0x9f, 0x8f, 0xea, 0xda, 0xdf, 0x8e, 0xea, 0xda, 0xcb, 0xa3, 0xba, 0xf6, 0xaf, 0xa3, 0xba,
+	0xf6, 0x9f, 0xa3, 0xfa, 0xc2, 0xd7, 0x47, 0x75, 0xed, 0xf3, 0xd7, 0xf5, 0x85, 0x97, 0xaf, 0xeb,
+	0x0b, 0x5f, 0xbd, 0xae, 0x2f, 0xfc, 0xe2, 0x4a, 0xc7, 0x9b, 0xac, 0xc1, 0xf6, 0x8e, 0xff, 0xcf,
+	0xf6, 0x35, 0x9f, 0xf4, 0xd5, 0xd7, 0xc1, 0xa2, 0x80, 0x92, 0x2b, 0xff, 0x1d, 0x00, 0x46, 0x34,
+	0x8a, 0x48, 0x11, 0x1f, 0x00, 0x00,
+}
+
+func (this *ReplicationTask) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ReplicationTaskInfo)
+	that1, ok := that.(*ReplicationTask)
 	if !ok {
-		that2, ok := that.(ReplicationTaskInfo)
+		that2, ok := that.(ReplicationTask)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1519,43 +1965,38 @@ func (this *ReplicationTaskInfo) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
-	}
-	if this.WorkflowId != that1.WorkflowId {
-		return false
-	}
-	if this.RunId != that1.RunId {
-		return false
-	}
 	if this.TaskType != that1.TaskType {
 		return false
 	}
-	if this.TaskId != that1.TaskId {
-		return false
-	}
-	if this.Version != that1.Version {
+	if this.SourceTaskId != that1.SourceTaskId {
 		return false
 	}
-	if this.FirstEventId != that1.FirstEventId {
+	if that1.Attributes == nil {
+		if this.Attributes != nil {
+			return false
+		}
+	} else if this.Attributes == nil {
 		return false
-	}
-	if this.NextEventId != that1.NextEventId {
+	} else if !this.Attributes.Equal(that1.Attributes) {
 		return false
 	}
-	if this.ScheduledId != that1.ScheduledId {
+	if that1.VisibilityTime == nil {
+		if this.VisibilityTime != nil {
+			return false
+		}
+	} else if !this.VisibilityTime.Equal(*that1.VisibilityTime) {
 		return false
 	}
 	return true
 }
-func (this *NamespaceTaskAttributes) Equal(that interface{}) bool {
+func (this *ReplicationTask_NamespaceTaskAttributes) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*NamespaceTaskAttributes)
+	that1, ok := that.(*ReplicationTask_NamespaceTaskAttributes)
 	if !ok {
-		that2, ok := that.(NamespaceTaskAttributes)
+		that2, ok := that.(ReplicationTask_NamespaceTaskAttributes)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1567,37 +2008,19 @@ func (this *NamespaceTaskAttributes) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.NamespaceOperation != that1.NamespaceOperation {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if !this.Info.Equal(that1.Info) {
-		return false
-	}
-	if !this.Config.Equal(that1.Config) {
-		return false
-	}
-	if !this.ReplicationConfig.Equal(that1.ReplicationConfig) {
-		return false
-	}
-	if this.ConfigVersion != that1.ConfigVersion {
-		return false
-	}
-	if this.FailoverVersion != that1.FailoverVersion {
+	if !this.NamespaceTaskAttributes.Equal(that1.NamespaceTaskAttributes) {
 		return false
 	}
 	return true
 }
-func (this *HistoryTaskAttributes) Equal(that interface{}) bool {
+func (this *ReplicationTask_HistoryTaskAttributes) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*HistoryTaskAttributes)
+	that1, ok := that.(*ReplicationTask_HistoryTaskAttributes)
 	if !ok {
-		that2, ok := that.(HistoryTaskAttributes)
+		that2, ok := that.(ReplicationTask_HistoryTaskAttributes)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1609,48 +2032,43 @@ func (this *HistoryTaskAttributes) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.TargetClusters) != len(that1.TargetClusters) {
+	if !this.HistoryTaskAttributes.Equal(that1.HistoryTaskAttributes) {
 		return false
 	}
-	for i := range this.TargetClusters {
-		if this.TargetClusters[i] != that1.TargetClusters[i] {
+	return true
+}
+func (this *ReplicationTask_SyncShardStatusTaskAttributes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReplicationTask_SyncShardStatusTaskAttributes)
+	if !ok {
+		that2, ok := that.(ReplicationTask_SyncShardStatusTaskAttributes)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
-	}
-	if this.WorkflowId != that1.WorkflowId {
-		return false
-	}
-	if this.RunId != that1.RunId {
-		return false
-	}
-	if this.FirstEventId != that1.FirstEventId {
-		return false
-	}
-	if this.NextEventId != that1.NextEventId {
-		return false
-	}
-	if this.Version != that1.Version {
-		return false
-	}
-	if !this.History.Equal(that1.History) {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if !this.NewRunHistory.Equal(that1.NewRunHistory) {
+	if !this.SyncShardStatusTaskAttributes.Equal(that1.SyncShardStatusTaskAttributes) {
 		return false
 	}
 	return true
 }
-func (this *HistoryMetadataTaskAttributes) Equal(that interface{}) bool {
+func (this *ReplicationTask_SyncActivityTaskAttributes) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*HistoryMetadataTaskAttributes)
+	that1, ok := that.(*ReplicationTask_SyncActivityTaskAttributes)
 	if !ok {
-		that2, ok := that.(HistoryMetadataTaskAttributes)
+		that2, ok := that.(ReplicationTask_SyncActivityTaskAttributes)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1662,42 +2080,67 @@ func (this *HistoryMetadataTaskAttributes) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.TargetClusters) != len(that1.TargetClusters) {
+	if !this.SyncActivityTaskAttributes.Equal(that1.SyncActivityTaskAttributes) {
 		return false
 	}
-	for i := range this.TargetClusters {
-		if this.TargetClusters[i] != that1.TargetClusters[i] {
+	return true
+}
+func (this *ReplicationTask_HistoryMetadataTaskAttributes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReplicationTask_HistoryMetadataTaskAttributes)
+	if !ok {
+		that2, ok := that.(ReplicationTask_HistoryMetadataTaskAttributes)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if this.NamespaceId != that1.NamespaceId {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.WorkflowId != that1.WorkflowId {
+	if !this.HistoryMetadataTaskAttributes.Equal(that1.HistoryMetadataTaskAttributes) {
 		return false
 	}
-	if this.RunId != that1.RunId {
-		return false
+	return true
+}
+func (this *ReplicationTask_HistoryTaskV2Attributes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.FirstEventId != that1.FirstEventId {
-		return false
+
+	that1, ok := that.(*ReplicationTask_HistoryTaskV2Attributes)
+	if !ok {
+		that2, ok := that.(ReplicationTask_HistoryTaskV2Attributes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.NextEventId != that1.NextEventId {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.Version != that1.Version {
+	if !this.HistoryTaskV2Attributes.Equal(that1.HistoryTaskV2Attributes) {
 		return false
 	}
 	return true
 }
-func (this *SyncShardStatusTaskAttributes) Equal(that interface{}) bool {
+func (this *ReplicationTask_SearchAttributesTaskAttributes) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SyncShardStatusTaskAttributes)
+	that1, ok := that.(*ReplicationTask_SearchAttributesTaskAttributes)
 	if !ok {
-		that2, ok := that.(SyncShardStatusTaskAttributes)
+		that2, ok := that.(ReplicationTask_SearchAttributesTaskAttributes)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1709,29 +2152,19 @@ func (this *SyncShardStatusTaskAttributes) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.SourceCluster != that1.SourceCluster {
-		return false
-	}
-	if this.ShardId != that1.ShardId {
-		return false
-	}
-	if that1.StatusTime == nil {
-		if this.StatusTime != nil {
-			return false
-		}
-	} else if !this.StatusTime.Equal(*that1.StatusTime) {
+	if !this.SearchAttributesTaskAttributes.Equal(that1.SearchAttributesTaskAttributes) {
 		return false
 	}
 	return true
 }
-func (this *SyncActivityTaskAttributes) Equal(that interface{}) bool {
+func (this *ReplicationToken) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SyncActivityTaskAttributes)
+	that1, ok := that.(*ReplicationToken)
 	if !ok {
-		that2, ok := that.(SyncActivityTaskAttributes)
+		that2, ok := that.(ReplicationToken)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1743,70 +2176,98 @@ func (this *SyncActivityTaskAttributes) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.NamespaceId != that1.NamespaceId {
+	if this.ShardId != that1.ShardId {
 		return false
 	}
-	if this.WorkflowId != that1.WorkflowId {
+	if this.LastRetrievedMessageId != that1.LastRetrievedMessageId {
 		return false
 	}
-	if this.RunId != that1.RunId {
+	if this.LastProcessedMessageId != that1.LastProcessedMessageId {
 		return false
 	}
-	if this.Version != that1.Version {
+	if that1.LastProcessedVisibilityTime == nil {
+		if this.LastProcessedVisibilityTime != nil {
+			return false
+		}
+	} else if !this.LastProcessedVisibilityTime.Equal(*that1.LastProcessedVisibilityTime) {
 		return false
 	}
-	if this.ScheduledId != that1.ScheduledId {
-		return false
+	return true
+}
+func (this *SyncShardStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if that1.ScheduledTime == nil {
-		if this.ScheduledTime != nil {
+
+	that1, ok := that.(*SyncShardStatus)
+	if !ok {
+		that2, ok := that.(SyncShardStatus)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
-	} else if !this.ScheduledTime.Equal(*that1.ScheduledTime) {
-		return false
 	}
-	if this.StartedId != that1.StartedId {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if that1.StartedTime == nil {
-		if this.StartedTime != nil {
+	if that1.StatusTime == nil {
+		if this.StatusTime != nil {
 			return false
 		}
-	} else if !this.StartedTime.Equal(*that1.StartedTime) {
+	} else if !this.StatusTime.Equal(*that1.StatusTime) {
 		return false
 	}
-	if that1.LastHeartbeatTime == nil {
-		if this.LastHeartbeatTime != nil {
+	return true
+}
+func (this *ReplicationMessages) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReplicationMessages)
+	if !ok {
+		that2, ok := that.(ReplicationMessages)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
-	} else if !this.LastHeartbeatTime.Equal(*that1.LastHeartbeatTime) {
-		return false
 	}
-	if !this.Details.Equal(that1.Details) {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.Attempt != that1.Attempt {
+	if len(this.ReplicationTasks) != len(that1.ReplicationTasks) {
 		return false
 	}
-	if !this.LastFailure.Equal(that1.LastFailure) {
+	for i := range this.ReplicationTasks {
+		if !this.ReplicationTasks[i].Equal(that1.ReplicationTasks[i]) {
+			return false
+		}
+	}
+	if this.LastRetrievedMessageId != that1.LastRetrievedMessageId {
 		return false
 	}
-	if this.LastWorkerIdentity != that1.LastWorkerIdentity {
+	if this.HasMore != that1.HasMore {
 		return false
 	}
-	if !this.VersionHistory.Equal(that1.VersionHistory) {
+	if !this.SyncShardStatus.Equal(that1.SyncShardStatus) {
 		return false
 	}
 	return true
 }
-func (this *HistoryTaskV2Attributes) Equal(that interface{}) bool {
+func (this *ReplicationTaskInfo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*HistoryTaskV2Attributes)
+	that1, ok := that.(*ReplicationTaskInfo)
 	if !ok {
-		that2, ok := that.(HistoryTaskV2Attributes)
+		that2, ok := that.(ReplicationTaskInfo)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1827,1094 +2288,1094 @@ func (this *HistoryTaskV2Attributes) Equal(that interface{}) bool {
 	if this.RunId != that1.RunId {
 		return false
 	}
-	if len(this.VersionHistoryItems) != len(that1.VersionHistoryItems) {
+	if this.TaskType != that1.TaskType {
 		return false
 	}
-	for i := range this.VersionHistoryItems {
-		if !this.VersionHistoryItems[i].Equal(that1.VersionHistoryItems[i]) {
-			return false
-		}
-	}
-	if !this.Events.Equal(that1.Events) {
+	if this.TaskId != that1.TaskId {
 		return false
 	}
-	if !this.NewRunEvents.Equal(that1.NewRunEvents) {
+	if this.Version != that1.Version {
 		return false
 	}
-	return true
-}
-func (this *ReplicationTask) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.FirstEventId != that1.FirstEventId {
+		return false
 	}
-	s := make([]string, 0, 13)
-	s = append(s, "&repication.ReplicationTask{")
-	s = append(s, "TaskType: "+fmt.Sprintf("%#v", this.TaskType)+",\n")
-	s = append(s, "SourceTaskId: "+fmt.Sprintf("%#v", this.SourceTaskId)+",\n")
-	if this.Attributes != nil {
-		s = append(s, "Attributes: "+fmt.Sprintf("%#v", this.Attributes)+",\n")
+	if this.NextEventId != that1.NextEventId {
+		return false
 	}
-	s = append(s, "VisibilityTime: "+fmt.Sprintf("%#v", this.VisibilityTime)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ReplicationTask_NamespaceTaskAttributes) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.ScheduledId != that1.ScheduledId {
+		return false
 	}
-	s := strings.Join([]string{`&repication.ReplicationTask_NamespaceTaskAttributes{` +
-		`NamespaceTaskAttributes:` + fmt.Sprintf("%#v", this.NamespaceTaskAttributes) + `}`}, ", ")
-	return s
+	return true
 }
-func (this *ReplicationTask_HistoryTaskAttributes) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *NamespaceTaskAttributes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&repication.ReplicationTask_HistoryTaskAttributes{` +
-		`HistoryTaskAttributes:` + fmt.Sprintf("%#v", this.HistoryTaskAttributes) + `}`}, ", ")
-	return s
-}
-func (this *ReplicationTask_SyncShardStatusTaskAttributes) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*NamespaceTaskAttributes)
+	if !ok {
+		that2, ok := that.(NamespaceTaskAttributes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&repication.ReplicationTask_SyncShardStatusTaskAttributes{` +
-		`SyncShardStatusTaskAttributes:` + fmt.Sprintf("%#v", this.SyncShardStatusTaskAttributes) + `}`}, ", ")
-	return s
-}
-func (this *ReplicationTask_SyncActivityTaskAttributes) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&repication.ReplicationTask_SyncActivityTaskAttributes{` +
-		`SyncActivityTaskAttributes:` + fmt.Sprintf("%#v", this.SyncActivityTaskAttributes) + `}`}, ", ")
-	return s
-}
-func (this *ReplicationTask_HistoryMetadataTaskAttributes) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.NamespaceOperation != that1.NamespaceOperation {
+		return false
 	}
-	s := strings.Join([]string{`&repication.ReplicationTask_HistoryMetadataTaskAttributes{` +
-		`HistoryMetadataTaskAttributes:` + fmt.Sprintf("%#v", this.HistoryMetadataTaskAttributes) + `}`}, ", ")
-	return s
-}
-func (this *ReplicationTask_HistoryTaskV2Attributes) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Id != that1.Id {
+		return false
 	}
-	s := strings.Join([]string{`&repication.ReplicationTask_HistoryTaskV2Attributes{` +
-		`HistoryTaskV2Attributes:` + fmt.Sprintf("%#v", this.HistoryTaskV2Attributes) + `}`}, ", ")
-	return s
-}
-func (this *ReplicationToken) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Info.Equal(that1.Info) {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&repication.ReplicationToken{")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "LastRetrievedMessageId: "+fmt.Sprintf("%#v", this.LastRetrievedMessageId)+",\n")
-	s = append(s, "LastProcessedMessageId: "+fmt.Sprintf("%#v", this.LastProcessedMessageId)+",\n")
-	s = append(s, "LastProcessedVisibilityTime: "+fmt.Sprintf("%#v", this.LastProcessedVisibilityTime)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *SyncShardStatus) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Config.Equal(that1.Config) {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&repication.SyncShardStatus{")
-	s = append(s, "StatusTime: "+fmt.Sprintf("%#v", this.StatusTime)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ReplicationMessages) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.ReplicationConfig.Equal(that1.ReplicationConfig) {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&repication.ReplicationMessages{")
-	if this.ReplicationTasks != nil {
-		s = append(s, "ReplicationTasks: "+fmt.Sprintf("%#v", this.ReplicationTasks)+",\n")
+	if this.ConfigVersion != that1.ConfigVersion {
+		return false
 	}
-	s = append(s, "LastRetrievedMessageId: "+fmt.Sprintf("%#v", this.LastRetrievedMessageId)+",\n")
-	s = append(s, "HasMore: "+fmt.Sprintf("%#v", this.HasMore)+",\n")
-	if this.SyncShardStatus != nil {
-		s = append(s, "SyncShardStatus: "+fmt.Sprintf("%#v", this.SyncShardStatus)+",\n")
+	if this.FailoverVersion != that1.FailoverVersion {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ReplicationTaskInfo) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.ReplicationFilter != that1.ReplicationFilter {
+		return false
 	}
-	s := make([]string, 0, 13)
-	s = append(s, "&repication.ReplicationTaskInfo{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
-	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
-	s = append(s, "TaskType: "+fmt.Sprintf("%#v", this.TaskType)+",\n")
-	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
-	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
-	s = append(s, "FirstEventId: "+fmt.Sprintf("%#v", this.FirstEventId)+",\n")
-	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
-	s = append(s, "ScheduledId: "+fmt.Sprintf("%#v", this.ScheduledId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *NamespaceTaskAttributes) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.NameAliases) != len(that1.NameAliases) {
+		return false
 	}
-	s := make([]string, 0, 11)
-	s = append(s, "&repication.NamespaceTaskAttributes{")
-	s = append(s, "NamespaceOperation: "+fmt.Sprintf("%#v", this.NamespaceOperation)+",\n")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	if this.Info != nil {
-		s = append(s, "Info: "+fmt.Sprintf("%#v", this.Info)+",\n")
+	for i := range this.NameAliases {
+		if !this.NameAliases[i].Equal(that1.NameAliases[i]) {
+			return false
+		}
 	}
-	if this.Config != nil {
-		s = append(s, "Config: "+fmt.Sprintf("%#v", this.Config)+",\n")
+	if len(this.ApiKeys) != len(that1.ApiKeys) {
+		return false
 	}
-	if this.ReplicationConfig != nil {
-		s = append(s, "ReplicationConfig: "+fmt.Sprintf("%#v", this.ReplicationConfig)+",\n")
+	for i := range this.ApiKeys {
+		if !this.ApiKeys[i].Equal(that1.ApiKeys[i]) {
+			return false
+		}
 	}
-	s = append(s, "ConfigVersion: "+fmt.Sprintf("%#v", this.ConfigVersion)+",\n")
-	s = append(s, "FailoverVersion: "+fmt.Sprintf("%#v", this.FailoverVersion)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *HistoryTaskAttributes) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *SearchAttributesTaskAttributes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 13)
-	s = append(s, "&repication.HistoryTaskAttributes{")
-	s = append(s, "TargetClusters: "+fmt.Sprintf("%#v", this.TargetClusters)+",\n")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
-	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
-	s = append(s, "FirstEventId: "+fmt.Sprintf("%#v", this.FirstEventId)+",\n")
-	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
-	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
-	if this.History != nil {
-		s = append(s, "History: "+fmt.Sprintf("%#v", this.History)+",\n")
+
+	that1, ok := that.(*SearchAttributesTaskAttributes)
+	if !ok {
+		that2, ok := that.(SearchAttributesTaskAttributes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.NewRunHistory != nil {
-		s = append(s, "NewRunHistory: "+fmt.Sprintf("%#v", this.NewRunHistory)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *HistoryMetadataTaskAttributes) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.CustomSearchAttributes) != len(that1.CustomSearchAttributes) {
+		return false
 	}
-	s := make([]string, 0, 11)
-	s = append(s, "&repication.HistoryMetadataTaskAttributes{")
-	s = append(s, "TargetClusters: "+fmt.Sprintf("%#v", this.TargetClusters)+",\n")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
-	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
-	s = append(s, "FirstEventId: "+fmt.Sprintf("%#v", this.FirstEventId)+",\n")
-	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
-	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *SyncShardStatusTaskAttributes) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.CustomSearchAttributes {
+		if this.CustomSearchAttributes[i] != that1.CustomSearchAttributes[i] {
+			return false
+		}
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&repication.SyncShardStatusTaskAttributes{")
-	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "StatusTime: "+fmt.Sprintf("%#v", this.StatusTime)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *SyncActivityTaskAttributes) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *HistoryTaskAttributes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 18)
-	s = append(s, "&repication.SyncActivityTaskAttributes{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
-	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
-	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
-	s = append(s, "ScheduledId: "+fmt.Sprintf("%#v", this.ScheduledId)+",\n")
-	s = append(s, "ScheduledTime: "+fmt.Sprintf("%#v", this.ScheduledTime)+",\n")
-	s = append(s, "StartedId: "+fmt.Sprintf("%#v", this.StartedId)+",\n")
-	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
-	s = append(s, "LastHeartbeatTime: "+fmt.Sprintf("%#v", this.LastHeartbeatTime)+",\n")
-	if this.Details != nil {
-		s = append(s, "Details: "+fmt.Sprintf("%#v", this.Details)+",\n")
+
+	that1, ok := that.(*HistoryTaskAttributes)
+	if !ok {
+		that2, ok := that.(HistoryTaskAttributes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
-	if this.LastFailure != nil {
-		s = append(s, "LastFailure: "+fmt.Sprintf("%#v", this.LastFailure)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "LastWorkerIdentity: "+fmt.Sprintf("%#v", this.LastWorkerIdentity)+",\n")
-	if this.VersionHistory != nil {
-		s = append(s, "VersionHistory: "+fmt.Sprintf("%#v", this.VersionHistory)+",\n")
+	if len(this.TargetClusters) != len(that1.TargetClusters) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *HistoryTaskV2Attributes) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.TargetClusters {
+		if this.TargetClusters[i] != that1.TargetClusters[i] {
+			return false
+		}
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&repication.HistoryTaskV2Attributes{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
-	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
-	if this.VersionHistoryItems != nil {
-		s = append(s, "VersionHistoryItems: "+fmt.Sprintf("%#v", this.VersionHistoryItems)+",\n")
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	if this.Events != nil {
-		s = append(s, "Events: "+fmt.Sprintf("%#v", this.Events)+",\n")
+	if this.WorkflowId != that1.WorkflowId {
+		return false
 	}
-	if this.NewRunEvents != nil {
-		s = append(s, "NewRunEvents: "+fmt.Sprintf("%#v", this.NewRunEvents)+",\n")
+	if this.RunId != that1.RunId {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringMessage(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if this.FirstEventId != that1.FirstEventId {
+		return false
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
-}
-func (m *ReplicationTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.NextEventId != that1.NextEventId {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ReplicationTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Version != that1.Version {
+		return false
+	}
+	if !this.History.Equal(that1.History) {
+		return false
+	}
+	if !this.NewRunHistory.Equal(that1.NewRunHistory) {
+		return false
+	}
+	return true
 }
+func (this *HistoryMetadataTaskAttributes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ReplicationTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VisibilityTime != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err1 != nil {
-			return 0, err1
+	that1, ok := that.(*HistoryMetadataTaskAttributes)
+	if !ok {
+		that2, ok := that.(HistoryMetadataTaskAttributes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= n1
-		i = encodeVarintMessage(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x4a
 	}
-	if m.Attributes != nil {
-		{
-			size := m.Attributes.Size()
-			i -= size
-			if _, err := m.Attributes.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.TargetClusters) != len(that1.TargetClusters) {
+		return false
+	}
+	for i := range this.TargetClusters {
+		if this.TargetClusters[i] != that1.TargetClusters[i] {
+			return false
 		}
 	}
-	if m.SourceTaskId != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.SourceTaskId))
-		i--
-		dAtA[i] = 0x10
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	if m.TaskType != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.TaskType))
-		i--
-		dAtA[i] = 0x8
+	if this.WorkflowId != that1.WorkflowId {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ReplicationTask_NamespaceTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.RunId != that1.RunId {
+		return false
+	}
+	if this.FirstEventId != that1.FirstEventId {
+		return false
+	}
+	if this.NextEventId != that1.NextEventId {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
 }
+func (this *SyncShardStatusTaskAttributes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ReplicationTask_NamespaceTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.NamespaceTaskAttributes != nil {
-		{
-			size, err := m.NamespaceTaskAttributes.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
+	that1, ok := that.(*SyncShardStatusTaskAttributes)
+	if !ok {
+		that2, ok := that.(SyncShardStatusTaskAttributes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *ReplicationTask_HistoryTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ReplicationTask_HistoryTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.HistoryTaskAttributes != nil {
-		{
-			size, err := m.HistoryTaskAttributes.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SourceCluster != that1.SourceCluster {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if that1.StatusTime == nil {
+		if this.StatusTime != nil {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
+	} else if !this.StatusTime.Equal(*that1.StatusTime) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-func (m *ReplicationTask_SyncShardStatusTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *SyncActivityTaskAttributes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ReplicationTask_SyncShardStatusTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.SyncShardStatusTaskAttributes != nil {
-		{
-			size, err := m.SyncShardStatusTaskAttributes.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
+	that1, ok := that.(*SyncActivityTaskAttributes)
+	if !ok {
+		that2, ok := that.(SyncActivityTaskAttributes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *ReplicationTask_SyncActivityTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ReplicationTask_SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.SyncActivityTaskAttributes != nil {
-		{
-			size, err := m.SyncActivityTaskAttributes.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.WorkflowId != that1.WorkflowId {
+		return false
+	}
+	if this.RunId != that1.RunId {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.ScheduledId != that1.ScheduledId {
+		return false
+	}
+	if that1.ScheduledTime == nil {
+		if this.ScheduledTime != nil {
+			return false
 		}
-		i--
-		dAtA[i] = 0x32
+	} else if !this.ScheduledTime.Equal(*that1.ScheduledTime) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-func (m *ReplicationTask_HistoryMetadataTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ReplicationTask_HistoryMetadataTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.HistoryMetadataTaskAttributes != nil {
-		{
-			size, err := m.HistoryMetadataTaskAttributes.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
+	if this.StartedId != that1.StartedId {
+		return false
+	}
+	if that1.StartedTime == nil {
+		if this.StartedTime != nil {
+			return false
 		}
-		i--
-		dAtA[i] = 0x3a
+	} else if !this.StartedTime.Equal(*that1.StartedTime) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-func (m *ReplicationTask_HistoryTaskV2Attributes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ReplicationTask_HistoryTaskV2Attributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.HistoryTaskV2Attributes != nil {
-		{
-			size, err := m.HistoryTaskV2Attributes.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
+	if that1.LastHeartbeatTime == nil {
+		if this.LastHeartbeatTime != nil {
+			return false
 		}
-		i--
-		dAtA[i] = 0x42
+	} else if !this.LastHeartbeatTime.Equal(*that1.LastHeartbeatTime) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-func (m *ReplicationToken) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.Details.Equal(that1.Details) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ReplicationToken) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ReplicationToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastProcessedVisibilityTime != nil {
-		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastProcessedVisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastProcessedVisibilityTime):])
-		if err8 != nil {
-			return 0, err8
-		}
-		i -= n8
-		i = encodeVarintMessage(dAtA, i, uint64(n8))
-		i--
-		dAtA[i] = 0x22
+	if this.Attempt != that1.Attempt {
+		return false
 	}
-	if m.LastProcessedMessageId != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.LastProcessedMessageId))
-		i--
-		dAtA[i] = 0x18
+	if !this.LastFailure.Equal(that1.LastFailure) {
+		return false
 	}
-	if m.LastRetrievedMessageId != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.LastRetrievedMessageId))
-		i--
-		dAtA[i] = 0x10
+	if this.LastWorkerIdentity != that1.LastWorkerIdentity {
+		return false
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x8
+	if !this.VersionHistory.Equal(that1.VersionHistory) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *SyncShardStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *HistoryTaskV2Attributes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SyncShardStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *SyncShardStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StatusTime != nil {
-		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
-		if err9 != nil {
-			return 0, err9
+	that1, ok := that.(*HistoryTaskV2Attributes)
+	if !ok {
+		that2, ok := that.(HistoryTaskV2Attributes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= n9
-		i = encodeVarintMessage(dAtA, i, uint64(n9))
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ReplicationMessages) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ReplicationMessages) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ReplicationMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SyncShardStatus != nil {
-		{
-			size, err := m.SyncShardStatus.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.WorkflowId != that1.WorkflowId {
+		return false
+	}
+	if this.RunId != that1.RunId {
+		return false
+	}
+	if len(this.VersionHistoryItems) != len(that1.VersionHistoryItems) {
+		return false
+	}
+	for i := range this.VersionHistoryItems {
+		if !this.VersionHistoryItems[i].Equal(that1.VersionHistoryItems[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	if m.HasMore {
-		i--
-		if m.HasMore {
-			dAtA[i] = 1
+	if !this.Events.Equal(that1.Events) {
+		return false
+	}
+	if !this.NewRunEvents.Equal(that1.NewRunEvents) {
+		return false
+	}
+	return true
+}
+func (this *NamespaceReplicationLag) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NamespaceReplicationLag)
+	if !ok {
+		that2, ok := that.(NamespaceReplicationLag)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x18
 	}
-	if m.LastRetrievedMessageId != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.LastRetrievedMessageId))
-		i--
-		dAtA[i] = 0x10
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.ReplicationTasks) > 0 {
-		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMessage(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if this.PendingTaskCount != that1.PendingTaskCount {
+		return false
+	}
+	if that1.OldestPendingTaskTime == nil {
+		if this.OldestPendingTaskTime != nil {
+			return false
 		}
+	} else if !this.OldestPendingTaskTime.Equal(*that1.OldestPendingTaskTime) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *ReplicationTaskInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ShardReplicationLag) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *ReplicationTaskInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*ShardReplicationLag)
+	if !ok {
+		that2, ok := that.(ShardReplicationLag)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.TaskLag != that1.TaskLag {
+		return false
+	}
+	if this.TimeLag != nil && that1.TimeLag != nil {
+		if *this.TimeLag != *that1.TimeLag {
+			return false
+		}
+	} else if this.TimeLag != nil {
+		return false
+	} else if that1.TimeLag != nil {
+		return false
+	}
+	if this.DlqSize != that1.DlqSize {
+		return false
+	}
+	return true
 }
+func (this *ClusterReplicationLag) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ReplicationTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ScheduledId != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.ScheduledId))
-		i--
-		dAtA[i] = 0x48
+	that1, ok := that.(*ClusterReplicationLag)
+	if !ok {
+		that2, ok := that.(ClusterReplicationLag)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.NextEventId != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.NextEventId))
-		i--
-		dAtA[i] = 0x40
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.FirstEventId != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.FirstEventId))
-		i--
-		dAtA[i] = 0x38
+	if this.TotalTaskLag != that1.TotalTaskLag {
+		return false
 	}
-	if m.Version != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x30
+	if this.MaxTaskLag != that1.MaxTaskLag {
+		return false
 	}
-	if m.TaskId != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.TaskId))
-		i--
-		dAtA[i] = 0x28
+	if this.MaxTimeLag != nil && that1.MaxTimeLag != nil {
+		if *this.MaxTimeLag != *that1.MaxTimeLag {
+			return false
+		}
+	} else if this.MaxTimeLag != nil {
+		return false
+	} else if that1.MaxTimeLag != nil {
+		return false
 	}
-	if m.TaskType != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.TaskType))
-		i--
-		dAtA[i] = 0x20
+	if this.DlqSize != that1.DlqSize {
+		return false
 	}
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunId)))
-		i--
-		dAtA[i] = 0x1a
+	if len(this.Namespaces) != len(that1.Namespaces) {
+		return false
 	}
-	if len(m.WorkflowId) > 0 {
-		i -= len(m.WorkflowId)
-		copy(dAtA[i:], m.WorkflowId)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
-		i--
-		dAtA[i] = 0x12
+	for i := range this.Namespaces {
+		if !this.Namespaces[i].Equal(that1.Namespaces[i]) {
+			return false
+		}
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	if len(this.SlowestShards) != len(that1.SlowestShards) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *NamespaceTaskAttributes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	for i := range this.SlowestShards {
+		if !this.SlowestShards[i].Equal(that1.SlowestShards[i]) {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *NamespaceTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Truncated != that1.Truncated {
+		return false
+	}
+	return true
 }
+func (this *NamespaceHandoverStep) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *NamespaceTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FailoverVersion != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.FailoverVersion))
-		i--
-		dAtA[i] = 0x38
+	that1, ok := that.(*NamespaceHandoverStep)
+	if !ok {
+		that2, ok := that.(NamespaceHandoverStep)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.ConfigVersion != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.ConfigVersion))
-		i--
-		dAtA[i] = 0x30
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.ReplicationConfig != nil {
-		{
-			size, err := m.ReplicationConfig.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if that1.StartTime == nil {
+		if this.StartTime != nil {
+			return false
 		}
-		i--
-		dAtA[i] = 0x2a
+	} else if !this.StartTime.Equal(*that1.StartTime) {
+		return false
 	}
-	if m.Config != nil {
-		{
-			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
+	if that1.EndTime == nil {
+		if this.EndTime != nil {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
+	} else if !this.EndTime.Equal(*that1.EndTime) {
+		return false
 	}
-	if m.Info != nil {
-		{
-			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
+	return true
+}
+func (this *SearchAttributeConflict) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SearchAttributeConflict)
+	if !ok {
+		that2, ok := that.(SearchAttributeConflict)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.NamespaceOperation != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.NamespaceOperation))
-		i--
-		dAtA[i] = 0x8
+	if this.Name != that1.Name {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *HistoryTaskAttributes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.CurrentClusterType != that1.CurrentClusterType {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HistoryTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.RemoteClusterType != that1.RemoteClusterType {
+		return false
+	}
+	return true
 }
+func (this *ReplicationDLQFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *HistoryTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NewRunHistory != nil {
-		{
-			size, err := m.NewRunHistory.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
+	that1, ok := that.(*ReplicationDLQFilter)
+	if !ok {
+		that2, ok := that.(ReplicationDLQFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x52
 	}
-	if m.History != nil {
-		{
-			size, err := m.History.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Version != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x38
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	if m.NextEventId != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.NextEventId))
-		i--
-		dAtA[i] = 0x30
+	if this.WorkflowId != that1.WorkflowId {
+		return false
 	}
-	if m.FirstEventId != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.FirstEventId))
-		i--
-		dAtA[i] = 0x28
+	if this.TaskType != that1.TaskType {
+		return false
 	}
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunId)))
-		i--
-		dAtA[i] = 0x22
+	return true
+}
+func (this *DLQShardResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.WorkflowId) > 0 {
-		i -= len(m.WorkflowId)
-		copy(dAtA[i:], m.WorkflowId)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
-		i--
-		dAtA[i] = 0x1a
+
+	that1, ok := that.(*DLQShardResult)
+	if !ok {
+		that2, ok := that.(DLQShardResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.TargetClusters) > 0 {
-		for iNdEx := len(m.TargetClusters) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TargetClusters[iNdEx])
-			copy(dAtA[i:], m.TargetClusters[iNdEx])
-			i = encodeVarintMessage(dAtA, i, uint64(len(m.TargetClusters[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if this.ShardId != that1.ShardId {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *HistoryMetadataTaskAttributes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.MessageCount != that1.MessageCount {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HistoryMetadataTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
 }
+func (this *WorkflowReplicationState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *HistoryMetadataTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x38
+	that1, ok := that.(*WorkflowReplicationState)
+	if !ok {
+		that2, ok := that.(WorkflowReplicationState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.NextEventId != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.NextEventId))
-		i--
-		dAtA[i] = 0x30
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.FirstEventId != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.FirstEventId))
-		i--
-		dAtA[i] = 0x28
+	if this.ClusterName != that1.ClusterName {
+		return false
 	}
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunId)))
-		i--
-		dAtA[i] = 0x22
+	if !this.CurrentVersionHistory.Equal(that1.CurrentVersionHistory) {
+		return false
 	}
-	if len(m.WorkflowId) > 0 {
-		i -= len(m.WorkflowId)
-		copy(dAtA[i:], m.WorkflowId)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
-		i--
-		dAtA[i] = 0x1a
+	if this.LastEventId != that1.LastEventId {
+		return false
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0x12
+	if this.Status != that1.Status {
+		return false
 	}
-	if len(m.TargetClusters) > 0 {
-		for iNdEx := len(m.TargetClusters) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TargetClusters[iNdEx])
-			copy(dAtA[i:], m.TargetClusters[iNdEx])
-			i = encodeVarintMessage(dAtA, i, uint64(len(m.TargetClusters[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if !bytes.Equal(this.Checksum, that1.Checksum) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if !this.SizeInfo.Equal(that1.SizeInfo) {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if this.Consistent != that1.Consistent {
+		return false
+	}
+	if this.Resent != that1.Resent {
+		return false
+	}
+	return true
 }
-
-func (m *SyncShardStatusTaskAttributes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ReplicationTask) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 14)
+	s = append(s, "&repication.ReplicationTask{")
+	s = append(s, "TaskType: "+fmt.Sprintf("%#v", this.TaskType)+",\n")
+	s = append(s, "SourceTaskId: "+fmt.Sprintf("%#v", this.SourceTaskId)+",\n")
+	if this.Attributes != nil {
+		s = append(s, "Attributes: "+fmt.Sprintf("%#v", this.Attributes)+",\n")
+	}
+	s = append(s, "VisibilityTime: "+fmt.Sprintf("%#v", this.VisibilityTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *SyncShardStatusTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *ReplicationTask_NamespaceTaskAttributes) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&repication.ReplicationTask_NamespaceTaskAttributes{` +
+		`NamespaceTaskAttributes:` + fmt.Sprintf("%#v", this.NamespaceTaskAttributes) + `}`}, ", ")
+	return s
 }
-
-func (m *SyncShardStatusTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StatusTime != nil {
-		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
-		if err16 != nil {
-			return 0, err16
-		}
-		i -= n16
-		i = encodeVarintMessage(dAtA, i, uint64(n16))
-		i--
-		dAtA[i] = 0x1a
+func (this *ReplicationTask_HistoryTaskAttributes) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x10
+	s := strings.Join([]string{`&repication.ReplicationTask_HistoryTaskAttributes{` +
+		`HistoryTaskAttributes:` + fmt.Sprintf("%#v", this.HistoryTaskAttributes) + `}`}, ", ")
+	return s
+}
+func (this *ReplicationTask_SyncShardStatusTaskAttributes) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.SourceCluster)))
-		i--
-		dAtA[i] = 0xa
+	s := strings.Join([]string{`&repication.ReplicationTask_SyncShardStatusTaskAttributes{` +
+		`SyncShardStatusTaskAttributes:` + fmt.Sprintf("%#v", this.SyncShardStatusTaskAttributes) + `}`}, ", ")
+	return s
+}
+func (this *ReplicationTask_SyncActivityTaskAttributes) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := strings.Join([]string{`&repication.ReplicationTask_SyncActivityTaskAttributes{` +
+		`SyncActivityTaskAttributes:` + fmt.Sprintf("%#v", this.SyncActivityTaskAttributes) + `}`}, ", ")
+	return s
 }
-
-func (m *SyncActivityTaskAttributes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ReplicationTask_HistoryMetadataTaskAttributes) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := strings.Join([]string{`&repication.ReplicationTask_HistoryMetadataTaskAttributes{` +
+		`HistoryMetadataTaskAttributes:` + fmt.Sprintf("%#v", this.HistoryMetadataTaskAttributes) + `}`}, ", ")
+	return s
 }
-
-func (m *SyncActivityTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *ReplicationTask_HistoryTaskV2Attributes) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&repication.ReplicationTask_HistoryTaskV2Attributes{` +
+		`HistoryTaskV2Attributes:` + fmt.Sprintf("%#v", this.HistoryTaskV2Attributes) + `}`}, ", ")
+	return s
 }
-
-func (m *SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VersionHistory != nil {
-		{
-			size, err := m.VersionHistory.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
+func (this *ReplicationTask_SearchAttributesTaskAttributes) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.LastWorkerIdentity) > 0 {
-		i -= len(m.LastWorkerIdentity)
-		copy(dAtA[i:], m.LastWorkerIdentity)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.LastWorkerIdentity)))
-		i--
-		dAtA[i] = 0x6a
+	s := strings.Join([]string{`&repication.ReplicationTask_SearchAttributesTaskAttributes{` +
+		`SearchAttributesTaskAttributes:` + fmt.Sprintf("%#v", this.SearchAttributesTaskAttributes) + `}`}, ", ")
+	return s
+}
+func (this *ReplicationToken) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.LastFailure != nil {
-		{
-			size, err := m.LastFailure.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
+	s := make([]string, 0, 8)
+	s = append(s, "&repication.ReplicationToken{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "LastRetrievedMessageId: "+fmt.Sprintf("%#v", this.LastRetrievedMessageId)+",\n")
+	s = append(s, "LastProcessedMessageId: "+fmt.Sprintf("%#v", this.LastProcessedMessageId)+",\n")
+	s = append(s, "LastProcessedVisibilityTime: "+fmt.Sprintf("%#v", this.LastProcessedVisibilityTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SyncShardStatus) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Attempt != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.Attempt))
-		i--
-		dAtA[i] = 0x58
+	s := make([]string, 0, 5)
+	s = append(s, "&repication.SyncShardStatus{")
+	s = append(s, "StatusTime: "+fmt.Sprintf("%#v", this.StatusTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReplicationMessages) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Details != nil {
-		{
-			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
+	s := make([]string, 0, 8)
+	s = append(s, "&repication.ReplicationMessages{")
+	if this.ReplicationTasks != nil {
+		s = append(s, "ReplicationTasks: "+fmt.Sprintf("%#v", this.ReplicationTasks)+",\n")
 	}
-	if m.LastHeartbeatTime != nil {
-		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
-		if err20 != nil {
-			return 0, err20
-		}
-		i -= n20
-		i = encodeVarintMessage(dAtA, i, uint64(n20))
-		i--
-		dAtA[i] = 0x4a
+	s = append(s, "LastRetrievedMessageId: "+fmt.Sprintf("%#v", this.LastRetrievedMessageId)+",\n")
+	s = append(s, "HasMore: "+fmt.Sprintf("%#v", this.HasMore)+",\n")
+	if this.SyncShardStatus != nil {
+		s = append(s, "SyncShardStatus: "+fmt.Sprintf("%#v", this.SyncShardStatus)+",\n")
 	}
-	if m.StartedTime != nil {
-		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err21 != nil {
-			return 0, err21
-		}
-		i -= n21
-		i = encodeVarintMessage(dAtA, i, uint64(n21))
-		i--
-		dAtA[i] = 0x42
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReplicationTaskInfo) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.StartedId != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.StartedId))
-		i--
-		dAtA[i] = 0x38
+	s := make([]string, 0, 13)
+	s = append(s, "&repication.ReplicationTaskInfo{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "TaskType: "+fmt.Sprintf("%#v", this.TaskType)+",\n")
+	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "FirstEventId: "+fmt.Sprintf("%#v", this.FirstEventId)+",\n")
+	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
+	s = append(s, "ScheduledId: "+fmt.Sprintf("%#v", this.ScheduledId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NamespaceTaskAttributes) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.ScheduledTime != nil {
-		n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err22 != nil {
-			return 0, err22
-		}
-		i -= n22
-		i = encodeVarintMessage(dAtA, i, uint64(n22))
-		i--
-		dAtA[i] = 0x32
+	s := make([]string, 0, 14)
+	s = append(s, "&repication.NamespaceTaskAttributes{")
+	s = append(s, "NamespaceOperation: "+fmt.Sprintf("%#v", this.NamespaceOperation)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.Info != nil {
+		s = append(s, "Info: "+fmt.Sprintf("%#v", this.Info)+",\n")
 	}
-	if m.ScheduledId != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.ScheduledId))
-		i--
-		dAtA[i] = 0x28
+	if this.Config != nil {
+		s = append(s, "Config: "+fmt.Sprintf("%#v", this.Config)+",\n")
 	}
-	if m.Version != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x20
+	if this.ReplicationConfig != nil {
+		s = append(s, "ReplicationConfig: "+fmt.Sprintf("%#v", this.ReplicationConfig)+",\n")
 	}
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunId)))
-		i--
-		dAtA[i] = 0x1a
+	s = append(s, "ConfigVersion: "+fmt.Sprintf("%#v", this.ConfigVersion)+",\n")
+	s = append(s, "FailoverVersion: "+fmt.Sprintf("%#v", this.FailoverVersion)+",\n")
+	s = append(s, "ReplicationFilter: "+fmt.Sprintf("%#v", this.ReplicationFilter)+",\n")
+	if this.NameAliases != nil {
+		s = append(s, "NameAliases: "+fmt.Sprintf("%#v", this.NameAliases)+",\n")
 	}
-	if len(m.WorkflowId) > 0 {
-		i -= len(m.WorkflowId)
-		copy(dAtA[i:], m.WorkflowId)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
-		i--
-		dAtA[i] = 0x12
+	if this.ApiKeys != nil {
+		s = append(s, "ApiKeys: "+fmt.Sprintf("%#v", this.ApiKeys)+",\n")
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SearchAttributesTaskAttributes) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 5)
+	s = append(s, "&repication.SearchAttributesTaskAttributes{")
+	keysForCustomSearchAttributes := make([]string, 0, len(this.CustomSearchAttributes))
+	for k, _ := range this.CustomSearchAttributes {
+		keysForCustomSearchAttributes = append(keysForCustomSearchAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomSearchAttributes)
+	mapStringForCustomSearchAttributes := "map[string]v14.IndexedValueType{"
+	for _, k := range keysForCustomSearchAttributes {
+		mapStringForCustomSearchAttributes += fmt.Sprintf("%#v: %#v,", k, this.CustomSearchAttributes[k])
+	}
+	mapStringForCustomSearchAttributes += "}"
+	if this.CustomSearchAttributes != nil {
+		s = append(s, "CustomSearchAttributes: "+mapStringForCustomSearchAttributes+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *HistoryTaskV2Attributes) Marshal() (dAtA []byte, err error) {
+func (this *HistoryTaskAttributes) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&repication.HistoryTaskAttributes{")
+	s = append(s, "TargetClusters: "+fmt.Sprintf("%#v", this.TargetClusters)+",\n")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "FirstEventId: "+fmt.Sprintf("%#v", this.FirstEventId)+",\n")
+	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	if this.History != nil {
+		s = append(s, "History: "+fmt.Sprintf("%#v", this.History)+",\n")
+	}
+	if this.NewRunHistory != nil {
+		s = append(s, "NewRunHistory: "+fmt.Sprintf("%#v", this.NewRunHistory)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HistoryMetadataTaskAttributes) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&repication.HistoryMetadataTaskAttributes{")
+	s = append(s, "TargetClusters: "+fmt.Sprintf("%#v", this.TargetClusters)+",\n")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "FirstEventId: "+fmt.Sprintf("%#v", this.FirstEventId)+",\n")
+	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SyncShardStatusTaskAttributes) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&repication.SyncShardStatusTaskAttributes{")
+	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "StatusTime: "+fmt.Sprintf("%#v", this.StatusTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SyncActivityTaskAttributes) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 18)
+	s = append(s, "&repication.SyncActivityTaskAttributes{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "ScheduledId: "+fmt.Sprintf("%#v", this.ScheduledId)+",\n")
+	s = append(s, "ScheduledTime: "+fmt.Sprintf("%#v", this.ScheduledTime)+",\n")
+	s = append(s, "StartedId: "+fmt.Sprintf("%#v", this.StartedId)+",\n")
+	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
+	s = append(s, "LastHeartbeatTime: "+fmt.Sprintf("%#v", this.LastHeartbeatTime)+",\n")
+	if this.Details != nil {
+		s = append(s, "Details: "+fmt.Sprintf("%#v", this.Details)+",\n")
+	}
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	if this.LastFailure != nil {
+		s = append(s, "LastFailure: "+fmt.Sprintf("%#v", this.LastFailure)+",\n")
+	}
+	s = append(s, "LastWorkerIdentity: "+fmt.Sprintf("%#v", this.LastWorkerIdentity)+",\n")
+	if this.VersionHistory != nil {
+		s = append(s, "VersionHistory: "+fmt.Sprintf("%#v", this.VersionHistory)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HistoryTaskV2Attributes) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&repication.HistoryTaskV2Attributes{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	if this.VersionHistoryItems != nil {
+		s = append(s, "VersionHistoryItems: "+fmt.Sprintf("%#v", this.VersionHistoryItems)+",\n")
+	}
+	if this.Events != nil {
+		s = append(s, "Events: "+fmt.Sprintf("%#v", this.Events)+",\n")
+	}
+	if this.NewRunEvents != nil {
+		s = append(s, "NewRunEvents: "+fmt.Sprintf("%#v", this.NewRunEvents)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NamespaceReplicationLag) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&repication.NamespaceReplicationLag{")
+	s = append(s, "PendingTaskCount: "+fmt.Sprintf("%#v", this.PendingTaskCount)+",\n")
+	s = append(s, "OldestPendingTaskTime: "+fmt.Sprintf("%#v", this.OldestPendingTaskTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ShardReplicationLag) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&repication.ShardReplicationLag{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "TaskLag: "+fmt.Sprintf("%#v", this.TaskLag)+",\n")
+	s = append(s, "TimeLag: "+fmt.Sprintf("%#v", this.TimeLag)+",\n")
+	s = append(s, "DlqSize: "+fmt.Sprintf("%#v", this.DlqSize)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ClusterReplicationLag) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&repication.ClusterReplicationLag{")
+	s = append(s, "TotalTaskLag: "+fmt.Sprintf("%#v", this.TotalTaskLag)+",\n")
+	s = append(s, "MaxTaskLag: "+fmt.Sprintf("%#v", this.MaxTaskLag)+",\n")
+	s = append(s, "MaxTimeLag: "+fmt.Sprintf("%#v", this.MaxTimeLag)+",\n")
+	s = append(s, "DlqSize: "+fmt.Sprintf("%#v", this.DlqSize)+",\n")
+	keysForNamespaces := make([]string, 0, len(this.Namespaces))
+	for k, _ := range this.Namespaces {
+		keysForNamespaces = append(keysForNamespaces, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNamespaces)
+	mapStringForNamespaces := "map[string]*NamespaceReplicationLag{"
+	for _, k := range keysForNamespaces {
+		mapStringForNamespaces += fmt.Sprintf("%#v: %#v,", k, this.Namespaces[k])
+	}
+	mapStringForNamespaces += "}"
+	if this.Namespaces != nil {
+		s = append(s, "Namespaces: "+mapStringForNamespaces+",\n")
+	}
+	if this.SlowestShards != nil {
+		s = append(s, "SlowestShards: "+fmt.Sprintf("%#v", this.SlowestShards)+",\n")
+	}
+	s = append(s, "Truncated: "+fmt.Sprintf("%#v", this.Truncated)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NamespaceHandoverStep) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&repication.NamespaceHandoverStep{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "StartTime: "+fmt.Sprintf("%#v", this.StartTime)+",\n")
+	s = append(s, "EndTime: "+fmt.Sprintf("%#v", this.EndTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SearchAttributeConflict) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&repication.SearchAttributeConflict{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "CurrentClusterType: "+fmt.Sprintf("%#v", this.CurrentClusterType)+",\n")
+	s = append(s, "RemoteClusterType: "+fmt.Sprintf("%#v", this.RemoteClusterType)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReplicationDLQFilter) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&repication.ReplicationDLQFilter{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "TaskType: "+fmt.Sprintf("%#v", this.TaskType)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DLQShardResult) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&repication.DLQShardResult{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "MessageCount: "+fmt.Sprintf("%#v", this.MessageCount)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *WorkflowReplicationState) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&repication.WorkflowReplicationState{")
+	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
+	if this.CurrentVersionHistory != nil {
+		s = append(s, "CurrentVersionHistory: "+fmt.Sprintf("%#v", this.CurrentVersionHistory)+",\n")
+	}
+	s = append(s, "LastEventId: "+fmt.Sprintf("%#v", this.LastEventId)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "Checksum: "+fmt.Sprintf("%#v", this.Checksum)+",\n")
+	if this.SizeInfo != nil {
+		s = append(s, "SizeInfo: "+fmt.Sprintf("%#v", this.SizeInfo)+",\n")
+	}
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "Consistent: "+fmt.Sprintf("%#v", this.Consistent)+",\n")
+	s = append(s, "Resent: "+fmt.Sprintf("%#v", this.Resent)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringMessage(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *ReplicationTask) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2924,31 +3385,58 @@ func (m *HistoryTaskV2Attributes) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HistoryTaskV2Attributes) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReplicationTask) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HistoryTaskV2Attributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReplicationTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NewRunEvents != nil {
+	if m.Attributes != nil {
 		{
-			size, err := m.NewRunEvents.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+			size := m.Attributes.Size()
+			i -= size
+			if _, err := m.Attributes.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
 		}
+	}
+	if m.VisibilityTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintMessage(dAtA, i, uint64(n1))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x4a
 	}
-	if m.Events != nil {
+	if m.SourceTaskId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.SourceTaskId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TaskType != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicationTask_NamespaceTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationTask_NamespaceTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NamespaceTaskAttributes != nil {
 		{
-			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.NamespaceTaskAttributes.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2956,732 +3444,4058 @@ func (m *HistoryTaskV2Attributes) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintMessage(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x1a
 	}
-	if len(m.VersionHistoryItems) > 0 {
-		for iNdEx := len(m.VersionHistoryItems) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VersionHistoryItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMessage(dAtA, i, uint64(size))
+	return len(dAtA) - i, nil
+}
+func (m *ReplicationTask_HistoryTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationTask_HistoryTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.HistoryTaskAttributes != nil {
+		{
+			size, err := m.HistoryTaskAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x2a
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunId)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.WorkflowId) > 0 {
-		i -= len(m.WorkflowId)
-		copy(dAtA[i:], m.WorkflowId)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
+	return len(dAtA) - i, nil
+}
+func (m *ReplicationTask_SyncShardStatusTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationTask_SyncShardStatusTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SyncShardStatusTaskAttributes != nil {
+		{
+			size, err := m.SyncShardStatusTaskAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x2a
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.NamespaceId)))
+	return len(dAtA) - i, nil
+}
+func (m *ReplicationTask_SyncActivityTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationTask_SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SyncActivityTaskAttributes != nil {
+		{
+			size, err := m.SyncActivityTaskAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x32
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ReplicationTask_HistoryMetadataTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
 
-func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMessage(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ReplicationTask_HistoryMetadataTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.HistoryMetadataTaskAttributes != nil {
+		{
+			size, err := m.HistoryMetadataTaskAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return len(dAtA) - i, nil
 }
-func (m *ReplicationTask) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReplicationTask_HistoryTaskV2Attributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationTask_HistoryTaskV2Attributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.HistoryTaskV2Attributes != nil {
+		{
+			size, err := m.HistoryTaskV2Attributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
+	return len(dAtA) - i, nil
+}
+func (m *ReplicationTask_SearchAttributesTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationTask_SearchAttributesTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SearchAttributesTaskAttributes != nil {
+		{
+			size, err := m.SearchAttributesTaskAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ReplicationToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TaskType != 0 {
-		n += 1 + sovMessage(uint64(m.TaskType))
+	if m.LastProcessedVisibilityTime != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastProcessedVisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastProcessedVisibilityTime):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintMessage(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.SourceTaskId != 0 {
-		n += 1 + sovMessage(uint64(m.SourceTaskId))
+	if m.LastProcessedMessageId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.LastProcessedMessageId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Attributes != nil {
-		n += m.Attributes.Size()
+	if m.LastRetrievedMessageId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.LastRetrievedMessageId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.VisibilityTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime)
-		n += 1 + l + sovMessage(uint64(l))
+	if m.ShardId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ReplicationTask_NamespaceTaskAttributes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.NamespaceTaskAttributes != nil {
-		l = m.NamespaceTaskAttributes.Size()
-		n += 1 + l + sovMessage(uint64(l))
+func (m *SyncShardStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ReplicationTask_HistoryTaskAttributes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SyncShardStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncShardStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.HistoryTaskAttributes != nil {
-		l = m.HistoryTaskAttributes.Size()
-		n += 1 + l + sovMessage(uint64(l))
+	if m.StatusTime != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintMessage(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *ReplicationTask_SyncShardStatusTaskAttributes) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ReplicationMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SyncShardStatusTaskAttributes != nil {
-		l = m.SyncShardStatusTaskAttributes.Size()
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	return n
-}
-func (m *ReplicationTask_SyncActivityTaskAttributes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SyncActivityTaskAttributes != nil {
-		l = m.SyncActivityTaskAttributes.Size()
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	return n
-}
-func (m *ReplicationTask_HistoryMetadataTaskAttributes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.HistoryMetadataTaskAttributes != nil {
-		l = m.HistoryMetadataTaskAttributes.Size()
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	return n
-}
-func (m *ReplicationTask_HistoryTaskV2Attributes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.HistoryTaskV2Attributes != nil {
-		l = m.HistoryTaskV2Attributes.Size()
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	return n
-}
-func (m *ReplicationToken) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SyncShardStatus != nil {
+		{
+			size, err := m.SyncShardStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovMessage(uint64(m.ShardId))
+	if m.HasMore {
+		i--
+		if m.HasMore {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.LastRetrievedMessageId != 0 {
-		n += 1 + sovMessage(uint64(m.LastRetrievedMessageId))
-	}
-	if m.LastProcessedMessageId != 0 {
-		n += 1 + sovMessage(uint64(m.LastProcessedMessageId))
+		i = encodeVarintMessage(dAtA, i, uint64(m.LastRetrievedMessageId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.LastProcessedVisibilityTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastProcessedVisibilityTime)
-		n += 1 + l + sovMessage(uint64(l))
+	if len(m.ReplicationTasks) > 0 {
+		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SyncShardStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StatusTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime)
-		n += 1 + l + sovMessage(uint64(l))
+func (m *ReplicationTaskInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ReplicationMessages) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ReplicationTasks) > 0 {
-		for _, e := range m.ReplicationTasks {
-			l = e.Size()
-			n += 1 + l + sovMessage(uint64(l))
-		}
-	}
-	if m.LastRetrievedMessageId != 0 {
-		n += 1 + sovMessage(uint64(m.LastRetrievedMessageId))
-	}
-	if m.HasMore {
-		n += 2
-	}
-	if m.SyncShardStatus != nil {
-		l = m.SyncShardStatus.Size()
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	return n
+func (m *ReplicationTaskInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReplicationTaskInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ReplicationTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if m.ScheduledId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ScheduledId))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.WorkflowId)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if m.NextEventId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.NextEventId))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if m.FirstEventId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.FirstEventId))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.TaskType != 0 {
-		n += 1 + sovMessage(uint64(m.TaskType))
+	if m.Version != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x30
 	}
 	if m.TaskId != 0 {
-		n += 1 + sovMessage(uint64(m.TaskId))
+		i = encodeVarintMessage(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Version != 0 {
-		n += 1 + sovMessage(uint64(m.Version))
+	if m.TaskType != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.FirstEventId != 0 {
-		n += 1 + sovMessage(uint64(m.FirstEventId))
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.NextEventId != 0 {
-		n += 1 + sovMessage(uint64(m.NextEventId))
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ScheduledId != 0 {
-		n += 1 + sovMessage(uint64(m.ScheduledId))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *NamespaceTaskAttributes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NamespaceTaskAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NamespaceOperation != 0 {
-		n += 1 + sovMessage(uint64(m.NamespaceOperation))
+	if len(m.ApiKeys) > 0 {
+		for iNdEx := len(m.ApiKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ApiKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if len(m.NameAliases) > 0 {
+		for iNdEx := len(m.NameAliases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NameAliases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovMessage(uint64(l))
+	if len(m.ReplicationFilter) > 0 {
+		i -= len(m.ReplicationFilter)
+		copy(dAtA[i:], m.ReplicationFilter)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.ReplicationFilter)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovMessage(uint64(l))
+	if m.FailoverVersion != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.FailoverVersion))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ConfigVersion != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ConfigVersion))
+		i--
+		dAtA[i] = 0x30
 	}
 	if m.ReplicationConfig != nil {
-		l = m.ReplicationConfig.Size()
-		n += 1 + l + sovMessage(uint64(l))
+		{
+			size, err := m.ReplicationConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.ConfigVersion != 0 {
-		n += 1 + sovMessage(uint64(m.ConfigVersion))
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.FailoverVersion != 0 {
-		n += 1 + sovMessage(uint64(m.FailoverVersion))
+	if m.Info != nil {
+		{
+			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.NamespaceOperation != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.NamespaceOperation))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *HistoryTaskAttributes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SearchAttributesTaskAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchAttributesTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchAttributesTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TargetClusters) > 0 {
-		for _, s := range m.TargetClusters {
-			l = len(s)
-			n += 1 + l + sovMessage(uint64(l))
+	if len(m.CustomSearchAttributes) > 0 {
+		for k := range m.CustomSearchAttributes {
+			v := m.CustomSearchAttributes[k]
+			baseI := i
+			i = encodeVarintMessage(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	l = len(m.WorkflowId)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	if m.FirstEventId != 0 {
-		n += 1 + sovMessage(uint64(m.FirstEventId))
-	}
-	if m.NextEventId != 0 {
-		n += 1 + sovMessage(uint64(m.NextEventId))
-	}
-	if m.Version != 0 {
-		n += 1 + sovMessage(uint64(m.Version))
-	}
-	if m.History != nil {
-		l = m.History.Size()
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	if m.NewRunHistory != nil {
-		l = m.NewRunHistory.Size()
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *HistoryMetadataTaskAttributes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HistoryTaskAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TargetClusters) > 0 {
-		for _, s := range m.TargetClusters {
-			l = len(s)
-			n += 1 + l + sovMessage(uint64(l))
+	if m.NewRunHistory != nil {
+		{
+			size, err := m.NewRunHistory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x52
 	}
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	l = len(m.WorkflowId)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if m.History != nil {
+		{
+			size, err := m.History.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.FirstEventId != 0 {
-		n += 1 + sovMessage(uint64(m.FirstEventId))
+	if m.Version != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x38
 	}
 	if m.NextEventId != 0 {
-		n += 1 + sovMessage(uint64(m.NextEventId))
+		i = encodeVarintMessage(dAtA, i, uint64(m.NextEventId))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Version != 0 {
-		n += 1 + sovMessage(uint64(m.Version))
+	if m.FirstEventId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.FirstEventId))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
-}
-
-func (m *SyncShardStatusTaskAttributes) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	l = len(m.SourceCluster)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ShardId != 0 {
-		n += 1 + sovMessage(uint64(m.ShardId))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.StatusTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime)
-		n += 1 + l + sovMessage(uint64(l))
+	if len(m.TargetClusters) > 0 {
+		for iNdEx := len(m.TargetClusters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TargetClusters[iNdEx])
+			copy(dAtA[i:], m.TargetClusters[iNdEx])
+			i = encodeVarintMessage(dAtA, i, uint64(len(m.TargetClusters[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SyncActivityTaskAttributes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HistoryMetadataTaskAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryMetadataTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryMetadataTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	l = len(m.WorkflowId)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
 	if m.Version != 0 {
-		n += 1 + sovMessage(uint64(m.Version))
-	}
-	if m.ScheduledId != 0 {
-		n += 1 + sovMessage(uint64(m.ScheduledId))
-	}
-	if m.ScheduledTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	if m.StartedId != 0 {
-		n += 1 + sovMessage(uint64(m.StartedId))
-	}
-	if m.StartedTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
-		n += 1 + l + sovMessage(uint64(l))
+		i = encodeVarintMessage(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.LastHeartbeatTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime)
-		n += 1 + l + sovMessage(uint64(l))
+	if m.NextEventId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.NextEventId))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Details != nil {
-		l = m.Details.Size()
-		n += 1 + l + sovMessage(uint64(l))
+	if m.FirstEventId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.FirstEventId))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Attempt != 0 {
-		n += 1 + sovMessage(uint64(m.Attempt))
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.LastFailure != nil {
-		l = m.LastFailure.Size()
-		n += 1 + l + sovMessage(uint64(l))
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.LastWorkerIdentity)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.VersionHistory != nil {
-		l = m.VersionHistory.Size()
-		n += 1 + l + sovMessage(uint64(l))
+	if len(m.TargetClusters) > 0 {
+		for iNdEx := len(m.TargetClusters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TargetClusters[iNdEx])
+			copy(dAtA[i:], m.TargetClusters[iNdEx])
+			i = encodeVarintMessage(dAtA, i, uint64(len(m.TargetClusters[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *HistoryTaskV2Attributes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SyncShardStatusTaskAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncShardStatusTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncShardStatusTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	l = len(m.WorkflowId)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	if len(m.VersionHistoryItems) > 0 {
-		for _, e := range m.VersionHistoryItems {
-			l = e.Size()
-			n += 1 + l + sovMessage(uint64(l))
+	if m.StatusTime != nil {
+		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
+		if err17 != nil {
+			return 0, err17
 		}
+		i -= n17
+		i = encodeVarintMessage(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Events != nil {
-		l = m.Events.Size()
-		n += 1 + l + sovMessage(uint64(l))
+	if m.ShardId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.NewRunEvents != nil {
-		l = m.NewRunEvents.Size()
-		n += 1 + l + sovMessage(uint64(l))
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovMessage(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMessage(x uint64) (n int) {
-	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *ReplicationTask) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ReplicationTask{`,
-		`TaskType:` + fmt.Sprintf("%v", this.TaskType) + `,`,
-		`SourceTaskId:` + fmt.Sprintf("%v", this.SourceTaskId) + `,`,
-		`Attributes:` + fmt.Sprintf("%v", this.Attributes) + `,`,
-		`VisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.VisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReplicationTask_NamespaceTaskAttributes) String() string {
-	if this == nil {
-		return "nil"
+func (m *SyncActivityTaskAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ReplicationTask_NamespaceTaskAttributes{`,
-		`NamespaceTaskAttributes:` + strings.Replace(fmt.Sprintf("%v", this.NamespaceTaskAttributes), "NamespaceTaskAttributes", "NamespaceTaskAttributes", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ReplicationTask_HistoryTaskAttributes) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ReplicationTask_HistoryTaskAttributes{`,
-		`HistoryTaskAttributes:` + strings.Replace(fmt.Sprintf("%v", this.HistoryTaskAttributes), "HistoryTaskAttributes", "HistoryTaskAttributes", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *SyncActivityTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *ReplicationTask_SyncShardStatusTaskAttributes) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VersionHistory != nil {
+		{
+			size, err := m.VersionHistory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
 	}
-	s := strings.Join([]string{`&ReplicationTask_SyncShardStatusTaskAttributes{`,
-		`SyncShardStatusTaskAttributes:` + strings.Replace(fmt.Sprintf("%v", this.SyncShardStatusTaskAttributes), "SyncShardStatusTaskAttributes", "SyncShardStatusTaskAttributes", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReplicationTask_SyncActivityTaskAttributes) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.LastWorkerIdentity) > 0 {
+		i -= len(m.LastWorkerIdentity)
+		copy(dAtA[i:], m.LastWorkerIdentity)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.LastWorkerIdentity)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	s := strings.Join([]string{`&ReplicationTask_SyncActivityTaskAttributes{`,
-		`SyncActivityTaskAttributes:` + strings.Replace(fmt.Sprintf("%v", this.SyncActivityTaskAttributes), "SyncActivityTaskAttributes", "SyncActivityTaskAttributes", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReplicationTask_HistoryMetadataTaskAttributes) String() string {
-	if this == nil {
-		return "nil"
+	if m.LastFailure != nil {
+		{
+			size, err := m.LastFailure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
 	}
-	s := strings.Join([]string{`&ReplicationTask_HistoryMetadataTaskAttributes{`,
-		`HistoryMetadataTaskAttributes:` + strings.Replace(fmt.Sprintf("%v", this.HistoryMetadataTaskAttributes), "HistoryMetadataTaskAttributes", "HistoryMetadataTaskAttributes", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReplicationTask_HistoryTaskV2Attributes) String() string {
-	if this == nil {
-		return "nil"
+	if m.Attempt != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x58
 	}
-	s := strings.Join([]string{`&ReplicationTask_HistoryTaskV2Attributes{`,
-		`HistoryTaskV2Attributes:` + strings.Replace(fmt.Sprintf("%v", this.HistoryTaskV2Attributes), "HistoryTaskV2Attributes", "HistoryTaskV2Attributes", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReplicationToken) String() string {
-	if this == nil {
-		return "nil"
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	s := strings.Join([]string{`&ReplicationToken{`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`LastRetrievedMessageId:` + fmt.Sprintf("%v", this.LastRetrievedMessageId) + `,`,
-		`LastProcessedMessageId:` + fmt.Sprintf("%v", this.LastProcessedMessageId) + `,`,
-		`LastProcessedVisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.LastProcessedVisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SyncShardStatus) String() string {
-	if this == nil {
-		return "nil"
+	if m.LastHeartbeatTime != nil {
+		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
+		if err21 != nil {
+			return 0, err21
+		}
+		i -= n21
+		i = encodeVarintMessage(dAtA, i, uint64(n21))
+		i--
+		dAtA[i] = 0x4a
 	}
-	s := strings.Join([]string{`&SyncShardStatus{`,
-		`StatusTime:` + strings.Replace(fmt.Sprintf("%v", this.StatusTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReplicationMessages) String() string {
-	if this == nil {
-		return "nil"
+	if m.StartedTime != nil {
+		n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err22 != nil {
+			return 0, err22
+		}
+		i -= n22
+		i = encodeVarintMessage(dAtA, i, uint64(n22))
+		i--
+		dAtA[i] = 0x42
 	}
-	repeatedStringForReplicationTasks := "[]*ReplicationTask{"
-	for _, f := range this.ReplicationTasks {
-		repeatedStringForReplicationTasks += strings.Replace(f.String(), "ReplicationTask", "ReplicationTask", 1) + ","
+	if m.StartedId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.StartedId))
+		i--
+		dAtA[i] = 0x38
 	}
-	repeatedStringForReplicationTasks += "}"
-	s := strings.Join([]string{`&ReplicationMessages{`,
-		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
-		`LastRetrievedMessageId:` + fmt.Sprintf("%v", this.LastRetrievedMessageId) + `,`,
-		`HasMore:` + fmt.Sprintf("%v", this.HasMore) + `,`,
-		`SyncShardStatus:` + strings.Replace(this.SyncShardStatus.String(), "SyncShardStatus", "SyncShardStatus", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReplicationTaskInfo) String() string {
-	if this == nil {
-		return "nil"
+	if m.ScheduledTime != nil {
+		n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err23 != nil {
+			return 0, err23
+		}
+		i -= n23
+		i = encodeVarintMessage(dAtA, i, uint64(n23))
+		i--
+		dAtA[i] = 0x32
 	}
-	s := strings.Join([]string{`&ReplicationTaskInfo{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
-		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
-		`TaskType:` + fmt.Sprintf("%v", this.TaskType) + `,`,
-		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`FirstEventId:` + fmt.Sprintf("%v", this.FirstEventId) + `,`,
-		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
-		`ScheduledId:` + fmt.Sprintf("%v", this.ScheduledId) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.ScheduledId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ScheduledId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Version != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (this *NamespaceTaskAttributes) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *HistoryTaskV2Attributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&NamespaceTaskAttributes{`,
-		`NamespaceOperation:` + fmt.Sprintf("%v", this.NamespaceOperation) + `,`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Info:` + strings.Replace(fmt.Sprintf("%v", this.Info), "NamespaceInfo", "v11.NamespaceInfo", 1) + `,`,
-		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "NamespaceConfig", "v11.NamespaceConfig", 1) + `,`,
-		`ReplicationConfig:` + strings.Replace(fmt.Sprintf("%v", this.ReplicationConfig), "NamespaceReplicationConfig", "v12.NamespaceReplicationConfig", 1) + `,`,
-		`ConfigVersion:` + fmt.Sprintf("%v", this.ConfigVersion) + `,`,
-		`FailoverVersion:` + fmt.Sprintf("%v", this.FailoverVersion) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *HistoryTaskAttributes) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *HistoryTaskV2Attributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryTaskV2Attributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewRunEvents != nil {
+		{
+			size, err := m.NewRunEvents.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	s := strings.Join([]string{`&HistoryTaskAttributes{`,
-		`TargetClusters:` + fmt.Sprintf("%v", this.TargetClusters) + `,`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
-		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
-		`FirstEventId:` + fmt.Sprintf("%v", this.FirstEventId) + `,`,
-		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`History:` + strings.Replace(fmt.Sprintf("%v", this.History), "History", "v13.History", 1) + `,`,
-		`NewRunHistory:` + strings.Replace(fmt.Sprintf("%v", this.NewRunHistory), "History", "v13.History", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.Events != nil {
+		{
+			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.VersionHistoryItems) > 0 {
+		for iNdEx := len(m.VersionHistoryItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VersionHistoryItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
 }
-func (this *HistoryMetadataTaskAttributes) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *NamespaceReplicationLag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&HistoryMetadataTaskAttributes{`,
-		`TargetClusters:` + fmt.Sprintf("%v", this.TargetClusters) + `,`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
-		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
-		`FirstEventId:` + fmt.Sprintf("%v", this.FirstEventId) + `,`,
-		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *SyncShardStatusTaskAttributes) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *NamespaceReplicationLag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceReplicationLag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OldestPendingTaskTime != nil {
+		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.OldestPendingTaskTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.OldestPendingTaskTime):])
+		if err26 != nil {
+			return 0, err26
+		}
+		i -= n26
+		i = encodeVarintMessage(dAtA, i, uint64(n26))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PendingTaskCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.PendingTaskCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShardReplicationLag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardReplicationLag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardReplicationLag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DlqSize != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.DlqSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TimeLag != nil {
+		n27, err27 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TimeLag, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TimeLag):])
+		if err27 != nil {
+			return 0, err27
+		}
+		i -= n27
+		i = encodeVarintMessage(dAtA, i, uint64(n27))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TaskLag != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TaskLag))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterReplicationLag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterReplicationLag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterReplicationLag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SlowestShards) > 0 {
+		for iNdEx := len(m.SlowestShards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlowestShards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for k := range m.Namespaces {
+			v := m.Namespaces[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintMessage(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.DlqSize != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.DlqSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxTimeLag != nil {
+		n29, err29 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxTimeLag, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxTimeLag):])
+		if err29 != nil {
+			return 0, err29
+		}
+		i -= n29
+		i = encodeVarintMessage(dAtA, i, uint64(n29))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxTaskLag != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.MaxTaskLag))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TotalTaskLag != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TotalTaskLag))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NamespaceHandoverStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceHandoverStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceHandoverStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err30 != nil {
+			return 0, err30
+		}
+		i -= n30
+		i = encodeVarintMessage(dAtA, i, uint64(n30))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StartTime != nil {
+		n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err31 != nil {
+			return 0, err31
+		}
+		i -= n31
+		i = encodeVarintMessage(dAtA, i, uint64(n31))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchAttributeConflict) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchAttributeConflict) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchAttributeConflict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemoteClusterType != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.RemoteClusterType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CurrentClusterType != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.CurrentClusterType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicationDLQFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationDLQFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationDLQFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaskType != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DLQShardResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DLQShardResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DLQShardResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MessageCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.MessageCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowReplicationState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowReplicationState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowReplicationState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resent {
+		i--
+		if m.Resent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Consistent {
+		i--
+		if m.Consistent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SizeInfo != nil {
+		{
+			size, err := m.SizeInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastEventId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.LastEventId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CurrentVersionHistory != nil {
+		{
+			size, err := m.CurrentVersionHistory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ReplicationTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskType != 0 {
+		n += 1 + sovMessage(uint64(m.TaskType))
+	}
+	if m.SourceTaskId != 0 {
+		n += 1 + sovMessage(uint64(m.SourceTaskId))
+	}
+	if m.Attributes != nil {
+		n += m.Attributes.Size()
+	}
+	if m.VisibilityTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *ReplicationTask_NamespaceTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NamespaceTaskAttributes != nil {
+		l = m.NamespaceTaskAttributes.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+func (m *ReplicationTask_HistoryTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HistoryTaskAttributes != nil {
+		l = m.HistoryTaskAttributes.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+func (m *ReplicationTask_SyncShardStatusTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SyncShardStatusTaskAttributes != nil {
+		l = m.SyncShardStatusTaskAttributes.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+func (m *ReplicationTask_SyncActivityTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SyncActivityTaskAttributes != nil {
+		l = m.SyncActivityTaskAttributes.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+func (m *ReplicationTask_HistoryMetadataTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HistoryMetadataTaskAttributes != nil {
+		l = m.HistoryMetadataTaskAttributes.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+func (m *ReplicationTask_HistoryTaskV2Attributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HistoryTaskV2Attributes != nil {
+		l = m.HistoryTaskV2Attributes.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+func (m *ReplicationTask_SearchAttributesTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SearchAttributesTaskAttributes != nil {
+		l = m.SearchAttributesTaskAttributes.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+func (m *ReplicationToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovMessage(uint64(m.ShardId))
+	}
+	if m.LastRetrievedMessageId != 0 {
+		n += 1 + sovMessage(uint64(m.LastRetrievedMessageId))
+	}
+	if m.LastProcessedMessageId != 0 {
+		n += 1 + sovMessage(uint64(m.LastProcessedMessageId))
+	}
+	if m.LastProcessedVisibilityTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastProcessedVisibilityTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncShardStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StatusTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *ReplicationMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReplicationTasks) > 0 {
+		for _, e := range m.ReplicationTasks {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.LastRetrievedMessageId != 0 {
+		n += 1 + sovMessage(uint64(m.LastRetrievedMessageId))
+	}
+	if m.HasMore {
+		n += 2
+	}
+	if m.SyncShardStatus != nil {
+		l = m.SyncShardStatus.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *ReplicationTaskInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovMessage(uint64(m.TaskType))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovMessage(uint64(m.TaskId))
+	}
+	if m.Version != 0 {
+		n += 1 + sovMessage(uint64(m.Version))
+	}
+	if m.FirstEventId != 0 {
+		n += 1 + sovMessage(uint64(m.FirstEventId))
+	}
+	if m.NextEventId != 0 {
+		n += 1 + sovMessage(uint64(m.NextEventId))
+	}
+	if m.ScheduledId != 0 {
+		n += 1 + sovMessage(uint64(m.ScheduledId))
+	}
+	return n
+}
+
+func (m *NamespaceTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NamespaceOperation != 0 {
+		n += 1 + sovMessage(uint64(m.NamespaceOperation))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ReplicationConfig != nil {
+		l = m.ReplicationConfig.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ConfigVersion != 0 {
+		n += 1 + sovMessage(uint64(m.ConfigVersion))
+	}
+	if m.FailoverVersion != 0 {
+		n += 1 + sovMessage(uint64(m.FailoverVersion))
+	}
+	l = len(m.ReplicationFilter)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.NameAliases) > 0 {
+		for _, e := range m.NameAliases {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.ApiKeys) > 0 {
+		for _, e := range m.ApiKeys {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SearchAttributesTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CustomSearchAttributes) > 0 {
+		for k, v := range m.CustomSearchAttributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + 1 + sovMessage(uint64(v))
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *HistoryTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TargetClusters) > 0 {
+		for _, s := range m.TargetClusters {
+			l = len(s)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.FirstEventId != 0 {
+		n += 1 + sovMessage(uint64(m.FirstEventId))
+	}
+	if m.NextEventId != 0 {
+		n += 1 + sovMessage(uint64(m.NextEventId))
+	}
+	if m.Version != 0 {
+		n += 1 + sovMessage(uint64(m.Version))
+	}
+	if m.History != nil {
+		l = m.History.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.NewRunHistory != nil {
+		l = m.NewRunHistory.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *HistoryMetadataTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TargetClusters) > 0 {
+		for _, s := range m.TargetClusters {
+			l = len(s)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.FirstEventId != 0 {
+		n += 1 + sovMessage(uint64(m.FirstEventId))
+	}
+	if m.NextEventId != 0 {
+		n += 1 + sovMessage(uint64(m.NextEventId))
+	}
+	if m.Version != 0 {
+		n += 1 + sovMessage(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *SyncShardStatusTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovMessage(uint64(m.ShardId))
+	}
+	if m.StatusTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncActivityTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovMessage(uint64(m.Version))
+	}
+	if m.ScheduledId != 0 {
+		n += 1 + sovMessage(uint64(m.ScheduledId))
+	}
+	if m.ScheduledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.StartedId != 0 {
+		n += 1 + sovMessage(uint64(m.StartedId))
+	}
+	if m.StartedTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.LastHeartbeatTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovMessage(uint64(m.Attempt))
+	}
+	if m.LastFailure != nil {
+		l = m.LastFailure.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.LastWorkerIdentity)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.VersionHistory != nil {
+		l = m.VersionHistory.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *HistoryTaskV2Attributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.VersionHistoryItems) > 0 {
+		for _, e := range m.VersionHistoryItems {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.Events != nil {
+		l = m.Events.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.NewRunEvents != nil {
+		l = m.NewRunEvents.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *NamespaceReplicationLag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PendingTaskCount != 0 {
+		n += 1 + sovMessage(uint64(m.PendingTaskCount))
+	}
+	if m.OldestPendingTaskTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.OldestPendingTaskTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *ShardReplicationLag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovMessage(uint64(m.ShardId))
+	}
+	if m.TaskLag != 0 {
+		n += 1 + sovMessage(uint64(m.TaskLag))
+	}
+	if m.TimeLag != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TimeLag)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.DlqSize != 0 {
+		n += 1 + sovMessage(uint64(m.DlqSize))
+	}
+	return n
+}
+
+func (m *ClusterReplicationLag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalTaskLag != 0 {
+		n += 1 + sovMessage(uint64(m.TotalTaskLag))
+	}
+	if m.MaxTaskLag != 0 {
+		n += 1 + sovMessage(uint64(m.MaxTaskLag))
+	}
+	if m.MaxTimeLag != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxTimeLag)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.DlqSize != 0 {
+		n += 1 + sovMessage(uint64(m.DlqSize))
+	}
+	if len(m.Namespaces) > 0 {
+		for k, v := range m.Namespaces {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovMessage(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	if len(m.SlowestShards) > 0 {
+		for _, e := range m.SlowestShards {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.Truncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *NamespaceHandoverStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.StartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *SearchAttributeConflict) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.CurrentClusterType != 0 {
+		n += 1 + sovMessage(uint64(m.CurrentClusterType))
+	}
+	if m.RemoteClusterType != 0 {
+		n += 1 + sovMessage(uint64(m.RemoteClusterType))
+	}
+	return n
+}
+
+func (m *ReplicationDLQFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovMessage(uint64(m.TaskType))
+	}
+	return n
+}
+
+func (m *DLQShardResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovMessage(uint64(m.ShardId))
+	}
+	if m.MessageCount != 0 {
+		n += 1 + sovMessage(uint64(m.MessageCount))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkflowReplicationState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.CurrentVersionHistory != nil {
+		l = m.CurrentVersionHistory.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.LastEventId != 0 {
+		n += 1 + sovMessage(uint64(m.LastEventId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovMessage(uint64(m.Status))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.SizeInfo != nil {
+		l = m.SizeInfo.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Consistent {
+		n += 2
+	}
+	if m.Resent {
+		n += 2
+	}
+	return n
+}
+
+func sovMessage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessage(x uint64) (n int) {
+	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ReplicationTask) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplicationTask{`,
+		`TaskType:` + fmt.Sprintf("%v", this.TaskType) + `,`,
+		`SourceTaskId:` + fmt.Sprintf("%v", this.SourceTaskId) + `,`,
+		`Attributes:` + fmt.Sprintf("%v", this.Attributes) + `,`,
+		`VisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.VisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplicationTask_NamespaceTaskAttributes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplicationTask_NamespaceTaskAttributes{`,
+		`NamespaceTaskAttributes:` + strings.Replace(fmt.Sprintf("%v", this.NamespaceTaskAttributes), "NamespaceTaskAttributes", "NamespaceTaskAttributes", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplicationTask_HistoryTaskAttributes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplicationTask_HistoryTaskAttributes{`,
+		`HistoryTaskAttributes:` + strings.Replace(fmt.Sprintf("%v", this.HistoryTaskAttributes), "HistoryTaskAttributes", "HistoryTaskAttributes", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplicationTask_SyncShardStatusTaskAttributes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplicationTask_SyncShardStatusTaskAttributes{`,
+		`SyncShardStatusTaskAttributes:` + strings.Replace(fmt.Sprintf("%v", this.SyncShardStatusTaskAttributes), "SyncShardStatusTaskAttributes", "SyncShardStatusTaskAttributes", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplicationTask_SyncActivityTaskAttributes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplicationTask_SyncActivityTaskAttributes{`,
+		`SyncActivityTaskAttributes:` + strings.Replace(fmt.Sprintf("%v", this.SyncActivityTaskAttributes), "SyncActivityTaskAttributes", "SyncActivityTaskAttributes", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplicationTask_HistoryMetadataTaskAttributes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplicationTask_HistoryMetadataTaskAttributes{`,
+		`HistoryMetadataTaskAttributes:` + strings.Replace(fmt.Sprintf("%v", this.HistoryMetadataTaskAttributes), "HistoryMetadataTaskAttributes", "HistoryMetadataTaskAttributes", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplicationTask_HistoryTaskV2Attributes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplicationTask_HistoryTaskV2Attributes{`,
+		`HistoryTaskV2Attributes:` + strings.Replace(fmt.Sprintf("%v", this.HistoryTaskV2Attributes), "HistoryTaskV2Attributes", "HistoryTaskV2Attributes", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplicationTask_SearchAttributesTaskAttributes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplicationTask_SearchAttributesTaskAttributes{`,
+		`SearchAttributesTaskAttributes:` + strings.Replace(fmt.Sprintf("%v", this.SearchAttributesTaskAttributes), "SearchAttributesTaskAttributes", "SearchAttributesTaskAttributes", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplicationToken) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplicationToken{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`LastRetrievedMessageId:` + fmt.Sprintf("%v", this.LastRetrievedMessageId) + `,`,
+		`LastProcessedMessageId:` + fmt.Sprintf("%v", this.LastProcessedMessageId) + `,`,
+		`LastProcessedVisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.LastProcessedVisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncShardStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncShardStatus{`,
+		`StatusTime:` + strings.Replace(fmt.Sprintf("%v", this.StatusTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplicationMessages) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForReplicationTasks := "[]*ReplicationTask{"
+	for _, f := range this.ReplicationTasks {
+		repeatedStringForReplicationTasks += strings.Replace(f.String(), "ReplicationTask", "ReplicationTask", 1) + ","
+	}
+	repeatedStringForReplicationTasks += "}"
+	s := strings.Join([]string{`&ReplicationMessages{`,
+		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
+		`LastRetrievedMessageId:` + fmt.Sprintf("%v", this.LastRetrievedMessageId) + `,`,
+		`HasMore:` + fmt.Sprintf("%v", this.HasMore) + `,`,
+		`SyncShardStatus:` + strings.Replace(this.SyncShardStatus.String(), "SyncShardStatus", "SyncShardStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplicationTaskInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplicationTaskInfo{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`TaskType:` + fmt.Sprintf("%v", this.TaskType) + `,`,
+		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`FirstEventId:` + fmt.Sprintf("%v", this.FirstEventId) + `,`,
+		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
+		`ScheduledId:` + fmt.Sprintf("%v", this.ScheduledId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceTaskAttributes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForNameAliases := "[]*NamespaceNameAlias{"
+	for _, f := range this.NameAliases {
+		repeatedStringForNameAliases += strings.Replace(fmt.Sprintf("%v", f), "NamespaceNameAlias", "v13.NamespaceNameAlias", 1) + ","
+	}
+	repeatedStringForNameAliases += "}"
+	repeatedStringForApiKeys := "[]*NamespaceApiKey{"
+	for _, f := range this.ApiKeys {
+		repeatedStringForApiKeys += strings.Replace(fmt.Sprintf("%v", f), "NamespaceApiKey", "v13.NamespaceApiKey", 1) + ","
+	}
+	repeatedStringForApiKeys += "}"
+	s := strings.Join([]string{`&NamespaceTaskAttributes{`,
+		`NamespaceOperation:` + fmt.Sprintf("%v", this.NamespaceOperation) + `,`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Info:` + strings.Replace(fmt.Sprintf("%v", this.Info), "NamespaceInfo", "v11.NamespaceInfo", 1) + `,`,
+		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "NamespaceConfig", "v11.NamespaceConfig", 1) + `,`,
+		`ReplicationConfig:` + strings.Replace(fmt.Sprintf("%v", this.ReplicationConfig), "NamespaceReplicationConfig", "v12.NamespaceReplicationConfig", 1) + `,`,
+		`ConfigVersion:` + fmt.Sprintf("%v", this.ConfigVersion) + `,`,
+		`FailoverVersion:` + fmt.Sprintf("%v", this.FailoverVersion) + `,`,
+		`ReplicationFilter:` + fmt.Sprintf("%v", this.ReplicationFilter) + `,`,
+		`NameAliases:` + repeatedStringForNameAliases + `,`,
+		`ApiKeys:` + repeatedStringForApiKeys + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchAttributesTaskAttributes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForCustomSearchAttributes := make([]string, 0, len(this.CustomSearchAttributes))
+	for k, _ := range this.CustomSearchAttributes {
+		keysForCustomSearchAttributes = append(keysForCustomSearchAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomSearchAttributes)
+	mapStringForCustomSearchAttributes := "map[string]v14.IndexedValueType{"
+	for _, k := range keysForCustomSearchAttributes {
+		mapStringForCustomSearchAttributes += fmt.Sprintf("%v: %v,", k, this.CustomSearchAttributes[k])
+	}
+	mapStringForCustomSearchAttributes += "}"
+	s := strings.Join([]string{`&SearchAttributesTaskAttributes{`,
+		`CustomSearchAttributes:` + mapStringForCustomSearchAttributes + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HistoryTaskAttributes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HistoryTaskAttributes{`,
+		`TargetClusters:` + fmt.Sprintf("%v", this.TargetClusters) + `,`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`FirstEventId:` + fmt.Sprintf("%v", this.FirstEventId) + `,`,
+		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`History:` + strings.Replace(fmt.Sprintf("%v", this.History), "History", "v15.History", 1) + `,`,
+		`NewRunHistory:` + strings.Replace(fmt.Sprintf("%v", this.NewRunHistory), "History", "v15.History", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HistoryMetadataTaskAttributes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HistoryMetadataTaskAttributes{`,
+		`TargetClusters:` + fmt.Sprintf("%v", this.TargetClusters) + `,`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`FirstEventId:` + fmt.Sprintf("%v", this.FirstEventId) + `,`,
+		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncShardStatusTaskAttributes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncShardStatusTaskAttributes{`,
+		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`StatusTime:` + strings.Replace(fmt.Sprintf("%v", this.StatusTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncActivityTaskAttributes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncActivityTaskAttributes{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`ScheduledId:` + fmt.Sprintf("%v", this.ScheduledId) + `,`,
+		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`StartedId:` + fmt.Sprintf("%v", this.StartedId) + `,`,
+		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastHeartbeatTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Details:` + strings.Replace(fmt.Sprintf("%v", this.Details), "Payloads", "v16.Payloads", 1) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`LastFailure:` + strings.Replace(fmt.Sprintf("%v", this.LastFailure), "Failure", "v17.Failure", 1) + `,`,
+		`LastWorkerIdentity:` + fmt.Sprintf("%v", this.LastWorkerIdentity) + `,`,
+		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v18.VersionHistory", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HistoryTaskV2Attributes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVersionHistoryItems := "[]*VersionHistoryItem{"
+	for _, f := range this.VersionHistoryItems {
+		repeatedStringForVersionHistoryItems += strings.Replace(fmt.Sprintf("%v", f), "VersionHistoryItem", "v18.VersionHistoryItem", 1) + ","
+	}
+	repeatedStringForVersionHistoryItems += "}"
+	s := strings.Join([]string{`&HistoryTaskV2Attributes{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`VersionHistoryItems:` + repeatedStringForVersionHistoryItems + `,`,
+		`Events:` + strings.Replace(fmt.Sprintf("%v", this.Events), "DataBlob", "v16.DataBlob", 1) + `,`,
+		`NewRunEvents:` + strings.Replace(fmt.Sprintf("%v", this.NewRunEvents), "DataBlob", "v16.DataBlob", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceReplicationLag) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceReplicationLag{`,
+		`PendingTaskCount:` + fmt.Sprintf("%v", this.PendingTaskCount) + `,`,
+		`OldestPendingTaskTime:` + strings.Replace(fmt.Sprintf("%v", this.OldestPendingTaskTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShardReplicationLag) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShardReplicationLag{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`TaskLag:` + fmt.Sprintf("%v", this.TaskLag) + `,`,
+		`TimeLag:` + strings.Replace(fmt.Sprintf("%v", this.TimeLag), "Duration", "types.Duration", 1) + `,`,
+		`DlqSize:` + fmt.Sprintf("%v", this.DlqSize) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterReplicationLag) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSlowestShards := "[]*ShardReplicationLag{"
+	for _, f := range this.SlowestShards {
+		repeatedStringForSlowestShards += strings.Replace(f.String(), "ShardReplicationLag", "ShardReplicationLag", 1) + ","
+	}
+	repeatedStringForSlowestShards += "}"
+	keysForNamespaces := make([]string, 0, len(this.Namespaces))
+	for k, _ := range this.Namespaces {
+		keysForNamespaces = append(keysForNamespaces, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNamespaces)
+	mapStringForNamespaces := "map[string]*NamespaceReplicationLag{"
+	for _, k := range keysForNamespaces {
+		mapStringForNamespaces += fmt.Sprintf("%v: %v,", k, this.Namespaces[k])
+	}
+	mapStringForNamespaces += "}"
+	s := strings.Join([]string{`&ClusterReplicationLag{`,
+		`TotalTaskLag:` + fmt.Sprintf("%v", this.TotalTaskLag) + `,`,
+		`MaxTaskLag:` + fmt.Sprintf("%v", this.MaxTaskLag) + `,`,
+		`MaxTimeLag:` + strings.Replace(fmt.Sprintf("%v", this.MaxTimeLag), "Duration", "types.Duration", 1) + `,`,
+		`DlqSize:` + fmt.Sprintf("%v", this.DlqSize) + `,`,
+		`Namespaces:` + mapStringForNamespaces + `,`,
+		`SlowestShards:` + repeatedStringForSlowestShards + `,`,
+		`Truncated:` + fmt.Sprintf("%v", this.Truncated) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceHandoverStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceHandoverStep{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`StartTime:` + strings.Replace(fmt.Sprintf("%v", this.StartTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`EndTime:` + strings.Replace(fmt.Sprintf("%v", this.EndTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchAttributeConflict) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SearchAttributeConflict{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`CurrentClusterType:` + fmt.Sprintf("%v", this.CurrentClusterType) + `,`,
+		`RemoteClusterType:` + fmt.Sprintf("%v", this.RemoteClusterType) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplicationDLQFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplicationDLQFilter{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`TaskType:` + fmt.Sprintf("%v", this.TaskType) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DLQShardResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DLQShardResult{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`MessageCount:` + fmt.Sprintf("%v", this.MessageCount) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowReplicationState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowReplicationState{`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`CurrentVersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.CurrentVersionHistory), "VersionHistory", "v18.VersionHistory", 1) + `,`,
+		`LastEventId:` + fmt.Sprintf("%v", this.LastEventId) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Checksum:` + fmt.Sprintf("%v", this.Checksum) + `,`,
+		`SizeInfo:` + strings.Replace(fmt.Sprintf("%v", this.SizeInfo), "ExecutionSizeInfo", "v19.ExecutionSizeInfo", 1) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`Consistent:` + fmt.Sprintf("%v", this.Consistent) + `,`,
+		`Resent:` + fmt.Sprintf("%v", this.Resent) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringMessage(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= v1.ReplicationTaskType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceTaskId", wireType)
+			}
+			m.SourceTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceTaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceTaskAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NamespaceTaskAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &ReplicationTask_NamespaceTaskAttributes{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryTaskAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &HistoryTaskAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &ReplicationTask_HistoryTaskAttributes{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncShardStatusTaskAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SyncShardStatusTaskAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &ReplicationTask_SyncShardStatusTaskAttributes{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncActivityTaskAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SyncActivityTaskAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &ReplicationTask_SyncActivityTaskAttributes{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryMetadataTaskAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &HistoryMetadataTaskAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &ReplicationTask_HistoryMetadataTaskAttributes{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryTaskV2Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &HistoryTaskV2Attributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &ReplicationTask_HistoryTaskV2Attributes{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VisibilityTime == nil {
+				m.VisibilityTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.VisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributesTaskAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SearchAttributesTaskAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &ReplicationTask_SearchAttributesTaskAttributes{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicationToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRetrievedMessageId", wireType)
+			}
+			m.LastRetrievedMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRetrievedMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastProcessedMessageId", wireType)
+			}
+			m.LastProcessedMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastProcessedMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastProcessedVisibilityTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastProcessedVisibilityTime == nil {
+				m.LastProcessedVisibilityTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastProcessedVisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncShardStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncShardStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncShardStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StatusTime == nil {
+				m.StatusTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StatusTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicationMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicationTasks = append(m.ReplicationTasks, &ReplicationTask{})
+			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRetrievedMessageId", wireType)
+			}
+			m.LastRetrievedMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRetrievedMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMore", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMore = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncShardStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncShardStatus == nil {
+				m.SyncShardStatus = &SyncShardStatus{}
+			}
+			if err := m.SyncShardStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicationTaskInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationTaskInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationTaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= v1.TaskType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstEventId", wireType)
+			}
+			m.FirstEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			}
+			m.NextEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledId", wireType)
+			}
+			m.ScheduledId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceTaskAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceTaskAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceTaskAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceOperation", wireType)
+			}
+			m.NamespaceOperation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NamespaceOperation |= v1.NamespaceOperation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &v11.NamespaceInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &v11.NamespaceConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReplicationConfig == nil {
+				m.ReplicationConfig = &v12.NamespaceReplicationConfig{}
+			}
+			if err := m.ReplicationConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigVersion", wireType)
+			}
+			m.ConfigVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfigVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailoverVersion", wireType)
+			}
+			m.FailoverVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailoverVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationFilter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicationFilter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameAliases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameAliases = append(m.NameAliases, &v13.NamespaceNameAlias{})
+			if err := m.NameAliases[len(m.NameAliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiKeys = append(m.ApiKeys, &v13.NamespaceApiKey{})
+			if err := m.ApiKeys[len(m.ApiKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&SyncShardStatusTaskAttributes{`,
-		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`StatusTime:` + strings.Replace(fmt.Sprintf("%v", this.StatusTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SyncActivityTaskAttributes) String() string {
-	if this == nil {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	s := strings.Join([]string{`&SyncActivityTaskAttributes{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
-		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`ScheduledId:` + fmt.Sprintf("%v", this.ScheduledId) + `,`,
-		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`StartedId:` + fmt.Sprintf("%v", this.StartedId) + `,`,
-		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`LastHeartbeatTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Details:` + strings.Replace(fmt.Sprintf("%v", this.Details), "Payloads", "v14.Payloads", 1) + `,`,
-		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
-		`LastFailure:` + strings.Replace(fmt.Sprintf("%v", this.LastFailure), "Failure", "v15.Failure", 1) + `,`,
-		`LastWorkerIdentity:` + fmt.Sprintf("%v", this.LastWorkerIdentity) + `,`,
-		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v16.VersionHistory", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func (this *HistoryTaskV2Attributes) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForVersionHistoryItems := "[]*VersionHistoryItem{"
-	for _, f := range this.VersionHistoryItems {
-		repeatedStringForVersionHistoryItems += strings.Replace(fmt.Sprintf("%v", f), "VersionHistoryItem", "v16.VersionHistoryItem", 1) + ","
+func (m *SearchAttributesTaskAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchAttributesTaskAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchAttributesTaskAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomSearchAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CustomSearchAttributes == nil {
+				m.CustomSearchAttributes = make(map[string]v14.IndexedValueType)
+			}
+			var mapkey string
+			var mapvalue v14.IndexedValueType
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= v14.IndexedValueType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CustomSearchAttributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	repeatedStringForVersionHistoryItems += "}"
-	s := strings.Join([]string{`&HistoryTaskV2Attributes{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
-		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
-		`VersionHistoryItems:` + repeatedStringForVersionHistoryItems + `,`,
-		`Events:` + strings.Replace(fmt.Sprintf("%v", this.Events), "DataBlob", "v14.DataBlob", 1) + `,`,
-		`NewRunEvents:` + strings.Replace(fmt.Sprintf("%v", this.NewRunEvents), "DataBlob", "v14.DataBlob", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringMessage(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
+func (m *HistoryTaskAttributes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3704,17 +7518,17 @@ func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicationTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: HistoryTaskAttributes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicationTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HistoryTaskAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetClusters", wireType)
 			}
-			m.TaskType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -3724,16 +7538,29 @@ func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskType |= v1.ReplicationTaskType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetClusters = append(m.TargetClusters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceTaskId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			m.SourceTaskId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -3743,16 +7570,29 @@ func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SourceTaskId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceTaskAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -3762,32 +7602,29 @@ func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &NamespaceTaskAttributes{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Attributes = &ReplicationTask_NamespaceTaskAttributes{v}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoryTaskAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -3797,32 +7634,29 @@ func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &HistoryTaskAttributes{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Attributes = &ReplicationTask_HistoryTaskAttributes{v}
+			m.RunId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncShardStatusTaskAttributes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstEventId", wireType)
 			}
-			var msglen int
+			m.FirstEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -3832,32 +7666,16 @@ func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FirstEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &SyncShardStatusTaskAttributes{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Attributes = &ReplicationTask_SyncShardStatusTaskAttributes{v}
-			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncActivityTaskAttributes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
 			}
-			var msglen int
+			m.NextEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -3867,32 +7685,16 @@ func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NextEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &SyncActivityTaskAttributes{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Attributes = &ReplicationTask_SyncActivityTaskAttributes{v}
-			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoryMetadataTaskAttributes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -3902,30 +7704,14 @@ func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Version |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &HistoryMetadataTaskAttributes{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Attributes = &ReplicationTask_HistoryMetadataTaskAttributes{v}
-			iNdEx = postIndex
-		case 8:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoryTaskV2Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3952,15 +7738,16 @@ func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &HistoryTaskV2Attributes{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.History == nil {
+				m.History = &v15.History{}
+			}
+			if err := m.History.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Attributes = &ReplicationTask_HistoryTaskV2Attributes{v}
 			iNdEx = postIndex
-		case 9:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRunHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3987,10 +7774,10 @@ func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VisibilityTime == nil {
-				m.VisibilityTime = new(time.Time)
+			if m.NewRunHistory == nil {
+				m.NewRunHistory = &v15.History{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.VisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewRunHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4018,7 +7805,7 @@ func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicationToken) Unmarshal(dAtA []byte) error {
+func (m *HistoryMetadataTaskAttributes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4041,17 +7828,17 @@ func (m *ReplicationToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicationToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: HistoryMetadataTaskAttributes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicationToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HistoryMetadataTaskAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetClusters", wireType)
 			}
-			m.ShardId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4061,16 +7848,29 @@ func (m *ReplicationToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetClusters = append(m.TargetClusters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRetrievedMessageId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			m.LastRetrievedMessageId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4080,35 +7880,29 @@ func (m *ReplicationToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastRetrievedMessageId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastProcessedMessageId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
 			}
-			m.LastProcessedMessageId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastProcessedMessageId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastProcessedVisibilityTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4118,86 +7912,29 @@ func (m *ReplicationToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastProcessedVisibilityTime == nil {
-				m.LastProcessedVisibilityTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastProcessedVisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMessage(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncShardStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMessage
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncShardStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncShardStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4207,28 +7944,81 @@ func (m *SyncShardStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StatusTime == nil {
-				m.StatusTime = new(time.Time)
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstEventId", wireType)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StatusTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FirstEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			}
+			m.NextEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -4253,7 +8043,7 @@ func (m *SyncShardStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicationMessages) Unmarshal(dAtA []byte) error {
+func (m *SyncShardStatusTaskAttributes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4276,17 +8066,17 @@ func (m *ReplicationMessages) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicationMessages: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncShardStatusTaskAttributes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicationMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncShardStatusTaskAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4296,31 +8086,29 @@ func (m *ReplicationMessages) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &ReplicationTask{})
-			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRetrievedMessageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			m.LastRetrievedMessageId = 0
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4330,34 +8118,14 @@ func (m *ReplicationMessages) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastRetrievedMessageId |= int64(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasMore", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.HasMore = bool(v != 0)
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncShardStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4384,10 +8152,10 @@ func (m *ReplicationMessages) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncShardStatus == nil {
-				m.SyncShardStatus = &SyncShardStatus{}
+			if m.StatusTime == nil {
+				m.StatusTime = new(time.Time)
 			}
-			if err := m.SyncShardStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StatusTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4415,7 +8183,7 @@ func (m *ReplicationMessages) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicationTaskInfo) Unmarshal(dAtA []byte) error {
+func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4438,10 +8206,10 @@ func (m *ReplicationTaskInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicationTaskInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncActivityTaskAttributes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicationTaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncActivityTaskAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4541,44 +8309,6 @@ func (m *ReplicationTaskInfo) Unmarshal(dAtA []byte) error {
 			m.RunId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
-			}
-			m.TaskType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskType |= v1.TaskType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
-			}
-			m.TaskId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
@@ -4597,30 +8327,11 @@ func (m *ReplicationTaskInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstEventId", wireType)
-			}
-			m.FirstEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FirstEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledId", wireType)
 			}
-			m.NextEventId = 0
+			m.ScheduledId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4630,88 +8341,52 @@ func (m *ReplicationTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextEventId |= int64(b&0x7F) << shift
+				m.ScheduledId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledId", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
 			}
-			m.ScheduledId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduledId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMessage(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NamespaceTaskAttributes) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMessage
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = new(time.Time)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NamespaceTaskAttributes: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespaceTaskAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceOperation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedId", wireType)
 			}
-			m.NamespaceOperation = 0
+			m.StartedId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4721,16 +8396,16 @@ func (m *NamespaceTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NamespaceOperation |= v1.NamespaceOperation(b&0x7F) << shift
+				m.StartedId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4740,27 +8415,31 @@ func (m *NamespaceTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if m.StartedTime == nil {
+				m.StartedTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4787,16 +8466,16 @@ func (m *NamespaceTaskAttributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Info == nil {
-				m.Info = &v11.NamespaceInfo{}
+			if m.LastHeartbeatTime == nil {
+				m.LastHeartbeatTime = new(time.Time)
 			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastHeartbeatTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4823,16 +8502,35 @@ func (m *NamespaceTaskAttributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &v11.NamespaceConfig{}
+			if m.Details == nil {
+				m.Details = &v16.Payloads{}
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailure", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4859,18 +8557,18 @@ func (m *NamespaceTaskAttributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReplicationConfig == nil {
-				m.ReplicationConfig = &v12.NamespaceReplicationConfig{}
+			if m.LastFailure == nil {
+				m.LastFailure = &v17.Failure{}
 			}
-			if err := m.ReplicationConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigVersion", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWorkerIdentity", wireType)
 			}
-			m.ConfigVersion = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4880,16 +8578,29 @@ func (m *NamespaceTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConfigVersion |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailoverVersion", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
 			}
-			m.FailoverVersion = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastWorkerIdentity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4899,11 +8610,28 @@ func (m *NamespaceTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FailoverVersion |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionHistory == nil {
+				m.VersionHistory = &v18.VersionHistory{}
+			}
+			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -4928,7 +8656,7 @@ func (m *NamespaceTaskAttributes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HistoryTaskAttributes) Unmarshal(dAtA []byte) error {
+func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4951,44 +8679,12 @@ func (m *HistoryTaskAttributes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistoryTaskAttributes: wiretype end group for non-group")
+			return fmt.Errorf("proto: HistoryTaskV2Attributes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoryTaskAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HistoryTaskV2Attributes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetClusters", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TargetClusters = append(m.TargetClusters, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
@@ -5086,10 +8782,10 @@ func (m *HistoryTaskAttributes) Unmarshal(dAtA []byte) error {
 			m.RunId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstEventId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistoryItems", wireType)
 			}
-			m.FirstEventId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -5099,52 +8795,29 @@ func (m *HistoryTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FirstEventId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
 			}
-			m.NextEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.VersionHistoryItems = append(m.VersionHistoryItems, &v18.VersionHistoryItem{})
+			if err := m.VersionHistoryItems[len(m.VersionHistoryItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 9:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5171,16 +8844,16 @@ func (m *HistoryTaskAttributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.History == nil {
-				m.History = &v13.History{}
+			if m.Events == nil {
+				m.Events = &v16.DataBlob{}
 			}
-			if err := m.History.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRunHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRunEvents", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5207,10 +8880,10 @@ func (m *HistoryTaskAttributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewRunHistory == nil {
-				m.NewRunHistory = &v13.History{}
+			if m.NewRunEvents == nil {
+				m.NewRunEvents = &v16.DataBlob{}
 			}
-			if err := m.NewRunHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewRunEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5238,7 +8911,7 @@ func (m *HistoryTaskAttributes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HistoryMetadataTaskAttributes) Unmarshal(dAtA []byte) error {
+func (m *NamespaceReplicationLag) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5261,17 +8934,17 @@ func (m *HistoryMetadataTaskAttributes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistoryMetadataTaskAttributes: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamespaceReplicationLag: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoryMetadataTaskAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamespaceReplicationLag: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetClusters", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTaskCount", wireType)
 			}
-			var stringLen uint64
+			m.PendingTaskCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -5281,29 +8954,16 @@ func (m *HistoryMetadataTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PendingTaskCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TargetClusters = append(m.TargetClusters, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestPendingTaskTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -5313,61 +8973,86 @@ func (m *HistoryMetadataTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			if m.OldestPendingTaskTime == nil {
+				m.OldestPendingTaskTime = new(time.Time)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.OldestPendingTaskTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthMessage
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardReplicationLag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardReplicationLag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardReplicationLag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -5377,29 +9062,16 @@ func (m *HistoryMetadataTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskLag", wireType)
 			}
-			m.FirstEventId = 0
+			m.TaskLag = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -5409,16 +9081,16 @@ func (m *HistoryMetadataTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FirstEventId |= int64(b&0x7F) << shift
+				m.TaskLag |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLag", wireType)
 			}
-			m.NextEventId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -5428,16 +9100,33 @@ func (m *HistoryMetadataTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextEventId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimeLag == nil {
+				m.TimeLag = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.TimeLag, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DlqSize", wireType)
 			}
-			m.Version = 0
+			m.DlqSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -5447,7 +9136,7 @@ func (m *HistoryMetadataTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
+				m.DlqSize |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5476,7 +9165,7 @@ func (m *HistoryMetadataTaskAttributes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncShardStatusTaskAttributes) Unmarshal(dAtA []byte) error {
+func (m *ClusterReplicationLag) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5499,17 +9188,110 @@ func (m *SyncShardStatusTaskAttributes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncShardStatusTaskAttributes: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterReplicationLag: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncShardStatusTaskAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterReplicationLag: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTaskLag", wireType)
+			}
+			m.TotalTaskLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalTaskLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTaskLag", wireType)
+			}
+			m.MaxTaskLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTaskLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeLag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxTimeLag == nil {
+				m.MaxTimeLag = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.MaxTimeLag, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DlqSize", wireType)
+			}
+			m.DlqSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DlqSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -5519,46 +9301,124 @@ func (m *SyncShardStatusTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			if m.Namespaces == nil {
+				m.Namespaces = make(map[string]*NamespaceReplicationLag)
+			}
+			var mapkey string
+			var mapvalue *NamespaceReplicationLag
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &NamespaceReplicationLag{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Namespaces[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlowestShards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5585,13 +9445,31 @@ func (m *SyncShardStatusTaskAttributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StatusTime == nil {
-				m.StatusTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StatusTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.SlowestShards = append(m.SlowestShards, &ShardReplicationLag{})
+			if err := m.SlowestShards[len(m.SlowestShards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -5616,7 +9494,7 @@ func (m *SyncShardStatusTaskAttributes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
+func (m *NamespaceHandoverStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5639,15 +9517,15 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncActivityTaskAttributes: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamespaceHandoverStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncActivityTaskAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamespaceHandoverStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5675,11 +9553,11 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5707,11 +9585,11 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5739,13 +9617,13 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			m.Version = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -5755,16 +9633,33 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			m.ScheduledId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -5774,16 +9669,86 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScheduledId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchAttributeConflict) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchAttributeConflict: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchAttributeConflict: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -5793,33 +9758,29 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTime == nil {
-				m.ScheduledTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentClusterType", wireType)
 			}
-			m.StartedId = 0
+			m.CurrentClusterType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -5829,16 +9790,16 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartedId |= int64(b&0x7F) << shift
+				m.CurrentClusterType |= v14.IndexedValueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteClusterType", wireType)
 			}
-			var msglen int
+			m.RemoteClusterType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -5848,33 +9809,69 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RemoteClusterType |= v14.IndexedValueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthMessage
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = new(time.Time)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicationDLQFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationDLQFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationDLQFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -5884,33 +9881,29 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastHeartbeatTime == nil {
-				m.LastHeartbeatTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastHeartbeatTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				return io.ErrUnexpectedEOF
 			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -5920,33 +9913,29 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Details == nil {
-				m.Details = &v14.Payloads{}
-			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
 			}
-			m.Attempt = 0
+			m.TaskType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -5956,16 +9945,69 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
+				m.TaskType |= v1.TaskType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastFailure", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DLQShardResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DLQShardResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DLQShardResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -5975,33 +10017,16 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastFailure == nil {
-				m.LastFailure = &v15.Failure{}
-			}
-			if err := m.LastFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastWorkerIdentity", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageCount", wireType)
 			}
-			var stringLen uint64
+			m.MessageCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -6011,29 +10036,16 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MessageCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LastWorkerIdentity = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 14:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -6043,27 +10055,23 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionHistory == nil {
-				m.VersionHistory = &v16.VersionHistory{}
-			}
-			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6089,7 +10097,7 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
+func (m *WorkflowReplicationState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6112,15 +10120,15 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistoryTaskV2Attributes: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowReplicationState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoryTaskV2Attributes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowReplicationState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6148,13 +10156,13 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentVersionHistory", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -6164,29 +10172,33 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			if m.CurrentVersionHistory == nil {
+				m.CurrentVersionHistory = &v18.VersionHistory{}
+			}
+			if err := m.CurrentVersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventId", wireType)
 			}
-			var stringLen uint64
+			m.LastEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -6196,29 +10208,35 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LastEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= v14.WorkflowExecutionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistoryItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -6228,29 +10246,29 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VersionHistoryItems = append(m.VersionHistoryItems, &v16.VersionHistoryItem{})
-			if err := m.VersionHistoryItems[len(m.VersionHistoryItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6277,18 +10295,18 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Events == nil {
-				m.Events = &v14.DataBlob{}
+			if m.SizeInfo == nil {
+				m.SizeInfo = &v19.ExecutionSizeInfo{}
 			}
-			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SizeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRunEvents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -6298,28 +10316,64 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewRunEvents == nil {
-				m.NewRunEvents = &v14.DataBlob{}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consistent", wireType)
 			}
-			if err := m.NewRunEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Consistent = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Resent = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
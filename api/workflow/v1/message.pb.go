@@ -117,8 +117,126 @@ func (m *ParentExecutionInfo) GetInitiatedId() int64 {
 	return 0
 }
 
+// ExecutionSizeInfo describes how large a workflow execution is, and how many items
+// are pending in its mutable state.
+type ExecutionSizeInfo struct {
+	HistorySizeBytes           int64 `protobuf:"varint,1,opt,name=history_size_bytes,json=historySizeBytes,proto3" json:"history_size_bytes,omitempty"`
+	HistoryEventCount          int64 `protobuf:"varint,2,opt,name=history_event_count,json=historyEventCount,proto3" json:"history_event_count,omitempty"`
+	MutableStateSizeBytes      int64 `protobuf:"varint,3,opt,name=mutable_state_size_bytes,json=mutableStateSizeBytes,proto3" json:"mutable_state_size_bytes,omitempty"`
+	PendingActivityCount       int64 `protobuf:"varint,4,opt,name=pending_activity_count,json=pendingActivityCount,proto3" json:"pending_activity_count,omitempty"`
+	PendingTimerCount          int64 `protobuf:"varint,5,opt,name=pending_timer_count,json=pendingTimerCount,proto3" json:"pending_timer_count,omitempty"`
+	PendingChildExecutionCount int64 `protobuf:"varint,6,opt,name=pending_child_execution_count,json=pendingChildExecutionCount,proto3" json:"pending_child_execution_count,omitempty"`
+	PendingRequestCancelCount  int64 `protobuf:"varint,7,opt,name=pending_request_cancel_count,json=pendingRequestCancelCount,proto3" json:"pending_request_cancel_count,omitempty"`
+	PendingSignalCount         int64 `protobuf:"varint,8,opt,name=pending_signal_count,json=pendingSignalCount,proto3" json:"pending_signal_count,omitempty"`
+	SignalCount                int64 `protobuf:"varint,9,opt,name=signal_count,json=signalCount,proto3" json:"signal_count,omitempty"`
+	BufferedEventCount         int64 `protobuf:"varint,10,opt,name=buffered_event_count,json=bufferedEventCount,proto3" json:"buffered_event_count,omitempty"`
+}
+
+func (m *ExecutionSizeInfo) Reset()      { *m = ExecutionSizeInfo{} }
+func (*ExecutionSizeInfo) ProtoMessage() {}
+func (*ExecutionSizeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4f1ca48d03c9ded, []int{1}
+}
+func (m *ExecutionSizeInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionSizeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionSizeInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionSizeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionSizeInfo.Merge(m, src)
+}
+func (m *ExecutionSizeInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionSizeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionSizeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionSizeInfo proto.InternalMessageInfo
+
+func (m *ExecutionSizeInfo) GetHistorySizeBytes() int64 {
+	if m != nil {
+		return m.HistorySizeBytes
+	}
+	return 0
+}
+
+func (m *ExecutionSizeInfo) GetHistoryEventCount() int64 {
+	if m != nil {
+		return m.HistoryEventCount
+	}
+	return 0
+}
+
+func (m *ExecutionSizeInfo) GetMutableStateSizeBytes() int64 {
+	if m != nil {
+		return m.MutableStateSizeBytes
+	}
+	return 0
+}
+
+func (m *ExecutionSizeInfo) GetPendingActivityCount() int64 {
+	if m != nil {
+		return m.PendingActivityCount
+	}
+	return 0
+}
+
+func (m *ExecutionSizeInfo) GetPendingTimerCount() int64 {
+	if m != nil {
+		return m.PendingTimerCount
+	}
+	return 0
+}
+
+func (m *ExecutionSizeInfo) GetPendingChildExecutionCount() int64 {
+	if m != nil {
+		return m.PendingChildExecutionCount
+	}
+	return 0
+}
+
+func (m *ExecutionSizeInfo) GetPendingRequestCancelCount() int64 {
+	if m != nil {
+		return m.PendingRequestCancelCount
+	}
+	return 0
+}
+
+func (m *ExecutionSizeInfo) GetPendingSignalCount() int64 {
+	if m != nil {
+		return m.PendingSignalCount
+	}
+	return 0
+}
+
+func (m *ExecutionSizeInfo) GetSignalCount() int64 {
+	if m != nil {
+		return m.SignalCount
+	}
+	return 0
+}
+
+func (m *ExecutionSizeInfo) GetBufferedEventCount() int64 {
+	if m != nil {
+		return m.BufferedEventCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ParentExecutionInfo)(nil), "temporal.server.api.workflow.v1.ParentExecutionInfo")
+	proto.RegisterType((*ExecutionSizeInfo)(nil), "temporal.server.api.workflow.v1.ExecutionSizeInfo")
 }
 
 func init() {
@@ -126,26 +244,41 @@ func init() {
 }
 
 var fileDescriptor_c4f1ca48d03c9ded = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0x31, 0x4e, 0xc3, 0x30,
-	0x18, 0x85, 0xfd, 0x53, 0x84, 0xd4, 0x94, 0x29, 0x2c, 0x15, 0x42, 0x3f, 0x05, 0x31, 0x94, 0x01,
-	0x47, 0x85, 0x91, 0x0d, 0x09, 0xa1, 0x6c, 0x28, 0x0b, 0x12, 0x0b, 0x32, 0x89, 0x5b, 0x59, 0x34,
-	0x76, 0xe4, 0x98, 0x94, 0x91, 0x23, 0x70, 0x0c, 0xce, 0xc1, 0xc4, 0x98, 0xb1, 0x23, 0x71, 0x16,
-	0xc6, 0x1e, 0x01, 0xa5, 0x25, 0xce, 0x80, 0xd8, 0xec, 0xe7, 0xe7, 0x4f, 0x9f, 0x9e, 0x77, 0x66,
-	0x78, 0x9a, 0x29, 0xcd, 0xe6, 0x41, 0xce, 0x75, 0xc1, 0x75, 0xc0, 0x32, 0x11, 0x2c, 0x94, 0x7e,
-	0x9a, 0xce, 0xd5, 0x22, 0x28, 0x26, 0x41, 0xca, 0xf3, 0x9c, 0xcd, 0x38, 0xcd, 0xb4, 0x32, 0xca,
-	0x3f, 0x6c, 0xeb, 0x74, 0x53, 0xa7, 0x2c, 0x13, 0xb4, 0xad, 0xd3, 0x62, 0xb2, 0x7f, 0xe2, 0x78,
-	0x0d, 0x28, 0x56, 0x69, 0xaa, 0xe4, 0x1f, 0xcc, 0xf1, 0x07, 0x78, 0x7b, 0xb7, 0x4c, 0x73, 0x69,
-	0xae, 0x5f, 0x78, 0xfc, 0x6c, 0x84, 0x92, 0xa1, 0x9c, 0x2a, 0xff, 0xc8, 0xdb, 0x95, 0x2c, 0xe5,
-	0x79, 0xc6, 0x62, 0xfe, 0x20, 0x92, 0x21, 0x8c, 0x60, 0xdc, 0x8f, 0x06, 0x2e, 0x0b, 0x13, 0xff,
-	0xc0, 0xeb, 0xbb, 0xeb, 0x70, 0x6b, 0xfd, 0xde, 0x05, 0xfe, 0x8d, 0xd7, 0xe7, 0x2d, 0x71, 0xd8,
-	0x1b, 0xc1, 0x78, 0x70, 0x7e, 0x4a, 0x9d, 0x73, 0x23, 0xbb, 0x51, 0xa2, 0xc5, 0x84, 0xde, 0xfd,
-	0x6a, 0x3b, 0x85, 0xa8, 0xfb, 0xdb, 0x98, 0x08, 0x29, 0x8c, 0x60, 0x86, 0x27, 0x8d, 0xc9, 0xf6,
-	0x08, 0xc6, 0xbd, 0x68, 0xe0, 0xb2, 0x30, 0xb9, 0x4a, 0xca, 0x0a, 0xc9, 0xb2, 0x42, 0xb2, 0xaa,
-	0x10, 0x5e, 0x2d, 0xc2, 0xbb, 0x45, 0xf8, 0xb4, 0x08, 0xa5, 0x45, 0xf8, 0xb2, 0x08, 0xdf, 0x16,
-	0xc9, 0xca, 0x22, 0xbc, 0xd5, 0x48, 0xca, 0x1a, 0xc9, 0xb2, 0x46, 0x72, 0x4f, 0x67, 0xaa, 0x13,
-	0x12, 0xea, 0x9f, 0xd9, 0x2f, 0xdb, 0xf3, 0xe3, 0xce, 0x7a, 0xb1, 0x8b, 0x9f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xd4, 0x7b, 0x8c, 0xc8, 0xa9, 0x01, 0x00, 0x00,
+	// 540 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x7d, 0xa4, 0x14, 0x72, 0xe9, 0x40, 0x9d, 0x82, 0x42, 0x55, 0x8e, 0xb4, 0x62, 0x08,
+	0x12, 0x38, 0x0d, 0x20, 0x31, 0x30, 0xa0, 0x36, 0xaa, 0x50, 0x36, 0xe4, 0x20, 0x21, 0xb1, 0x58,
+	0x17, 0xfb, 0x4d, 0x7a, 0x22, 0xbe, 0x33, 0xf6, 0xc5, 0x25, 0x9d, 0xf8, 0x08, 0xec, 0x7c, 0x01,
+	0x3e, 0x07, 0x13, 0x63, 0xc6, 0x8e, 0xc4, 0x59, 0x18, 0xfb, 0x11, 0x90, 0xef, 0x8f, 0xd3, 0x0a,
+	0x75, 0x4b, 0xee, 0xf9, 0x3d, 0xcf, 0xfb, 0xbc, 0x3e, 0x1b, 0x3f, 0x97, 0x10, 0x27, 0x22, 0xa5,
+	0xd3, 0x6e, 0x06, 0x69, 0x0e, 0x69, 0x97, 0x26, 0xac, 0x7b, 0x26, 0xd2, 0xcf, 0xe3, 0xa9, 0x38,
+	0xeb, 0xe6, 0xbd, 0x6e, 0x0c, 0x59, 0x46, 0x27, 0xe0, 0x25, 0xa9, 0x90, 0xc2, 0x7d, 0x6c, 0x71,
+	0x4f, 0xe3, 0x1e, 0x4d, 0x98, 0x67, 0x71, 0x2f, 0xef, 0xed, 0x3e, 0xa9, 0xf2, 0xca, 0xa0, 0x50,
+	0xc4, 0xb1, 0xe0, 0xff, 0xc5, 0x1c, 0xfc, 0x42, 0xb8, 0xf9, 0x9e, 0xa6, 0xc0, 0xe5, 0xc9, 0x57,
+	0x08, 0x67, 0x92, 0x09, 0x3e, 0xe0, 0x63, 0xe1, 0xee, 0xe3, 0x2d, 0x4e, 0x63, 0xc8, 0x12, 0x1a,
+	0x42, 0xc0, 0xa2, 0x16, 0x6a, 0xa3, 0x4e, 0xdd, 0x6f, 0x54, 0x67, 0x83, 0xc8, 0xdd, 0xc3, 0xf5,
+	0xea, 0x6f, 0xeb, 0x96, 0xd2, 0xd7, 0x07, 0xee, 0x3b, 0x5c, 0x07, 0x9b, 0xd8, 0xaa, 0xb5, 0x51,
+	0xa7, 0xf1, 0xe2, 0xa9, 0x57, 0x75, 0x2e, 0xcb, 0xea, 0x4a, 0x5e, 0xde, 0xf3, 0x3e, 0x9a, 0xda,
+	0x55, 0x05, 0x7f, 0xed, 0x2d, 0x9b, 0x30, 0xce, 0x24, 0xa3, 0x12, 0xa2, 0xb2, 0xc9, 0x46, 0x1b,
+	0x75, 0x6a, 0x7e, 0xa3, 0x3a, 0x1b, 0x44, 0x07, 0x3f, 0x36, 0xf0, 0x76, 0xe5, 0x1d, 0xb2, 0x73,
+	0x50, 0x2b, 0x3c, 0xc3, 0xee, 0x29, 0xcb, 0xa4, 0x48, 0xe7, 0x41, 0xc6, 0xce, 0x21, 0x18, 0xcd,
+	0x25, 0x64, 0x6a, 0x91, 0x9a, 0x7f, 0xcf, 0x28, 0x25, 0x7c, 0x5c, 0x9e, 0xbb, 0x1e, 0x6e, 0x5a,
+	0x1a, 0x72, 0xe0, 0x32, 0x08, 0xc5, 0x8c, 0x4b, 0xb5, 0x57, 0xcd, 0xdf, 0x36, 0xd2, 0x49, 0xa9,
+	0xf4, 0x4b, 0xc1, 0x7d, 0x8d, 0x5b, 0xf1, 0x4c, 0xd2, 0xd1, 0x14, 0x82, 0x4c, 0x52, 0x09, 0x57,
+	0x67, 0xd4, 0x94, 0xe9, 0xbe, 0xd1, 0x87, 0xa5, 0xbc, 0x1e, 0xf4, 0x0a, 0x3f, 0x48, 0x80, 0x47,
+	0x8c, 0x4f, 0x02, 0x1a, 0x4a, 0x96, 0x33, 0x39, 0x37, 0xb3, 0xf4, 0x66, 0x3b, 0x46, 0x3d, 0x32,
+	0xa2, 0x1e, 0xe7, 0xe1, 0xa6, 0x75, 0x49, 0x16, 0x43, 0x6a, 0x2c, 0xb7, 0x75, 0x3d, 0x23, 0x7d,
+	0x28, 0x15, 0xcd, 0x1f, 0xe1, 0x47, 0x96, 0x0f, 0x4f, 0xd9, 0x34, 0x0a, 0xaa, 0x07, 0x6a, 0x9c,
+	0x9b, 0xca, 0xb9, 0x6b, 0xa0, 0x7e, 0xc9, 0x54, 0x8f, 0x50, 0x47, 0xbc, 0xc5, 0x7b, 0x36, 0x22,
+	0x85, 0x2f, 0x33, 0xc8, 0x64, 0x10, 0x52, 0x1e, 0xc2, 0xd4, 0x24, 0xdc, 0x51, 0x09, 0x0f, 0x0d,
+	0xe3, 0x6b, 0xa4, 0xaf, 0x08, 0x1d, 0x70, 0x88, 0xed, 0x2e, 0x41, 0xc6, 0x26, 0x9c, 0x5a, 0xe3,
+	0x5d, 0x65, 0x74, 0x8d, 0x36, 0x54, 0x92, 0x76, 0xec, 0xe3, 0xad, 0x6b, 0x64, 0x5d, 0xdf, 0x75,
+	0x76, 0x05, 0x39, 0xc4, 0x3b, 0xa3, 0xd9, 0x78, 0x0c, 0x29, 0x44, 0xd7, 0x2e, 0x0a, 0xeb, 0x50,
+	0xab, 0xad, 0x6f, 0xea, 0x38, 0x5a, 0x2c, 0x89, 0x73, 0xb1, 0x24, 0xce, 0xe5, 0x92, 0xa0, 0x6f,
+	0x05, 0x41, 0x3f, 0x0b, 0x82, 0x7e, 0x17, 0x04, 0x2d, 0x0a, 0x82, 0xfe, 0x14, 0x04, 0xfd, 0x2d,
+	0x88, 0x73, 0x59, 0x10, 0xf4, 0x7d, 0x45, 0x9c, 0xc5, 0x8a, 0x38, 0x17, 0x2b, 0xe2, 0x7c, 0xf2,
+	0x26, 0x62, 0xfd, 0xba, 0x32, 0x71, 0xc3, 0x47, 0xf9, 0xc6, 0xfe, 0x1e, 0x6d, 0xaa, 0xef, 0xe9,
+	0xe5, 0xbf, 0x01, 0x00, 0x56, 0xe5, 0x2a, 0x55, 0xc7, 0x03, 0x00, 0x00,
 }
 
 func (this *ParentExecutionInfo) Equal(that interface{}) bool {
@@ -181,6 +314,57 @@ func (this *ParentExecutionInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ExecutionSizeInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExecutionSizeInfo)
+	if !ok {
+		that2, ok := that.(ExecutionSizeInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HistorySizeBytes != that1.HistorySizeBytes {
+		return false
+	}
+	if this.HistoryEventCount != that1.HistoryEventCount {
+		return false
+	}
+	if this.MutableStateSizeBytes != that1.MutableStateSizeBytes {
+		return false
+	}
+	if this.PendingActivityCount != that1.PendingActivityCount {
+		return false
+	}
+	if this.PendingTimerCount != that1.PendingTimerCount {
+		return false
+	}
+	if this.PendingChildExecutionCount != that1.PendingChildExecutionCount {
+		return false
+	}
+	if this.PendingRequestCancelCount != that1.PendingRequestCancelCount {
+		return false
+	}
+	if this.PendingSignalCount != that1.PendingSignalCount {
+		return false
+	}
+	if this.SignalCount != that1.SignalCount {
+		return false
+	}
+	if this.BufferedEventCount != that1.BufferedEventCount {
+		return false
+	}
+	return true
+}
 func (this *ParentExecutionInfo) GoString() string {
 	if this == nil {
 		return "nil"
@@ -196,6 +380,25 @@ func (this *ParentExecutionInfo) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ExecutionSizeInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&workflow.ExecutionSizeInfo{")
+	s = append(s, "HistorySizeBytes: "+fmt.Sprintf("%#v", this.HistorySizeBytes)+",\n")
+	s = append(s, "HistoryEventCount: "+fmt.Sprintf("%#v", this.HistoryEventCount)+",\n")
+	s = append(s, "MutableStateSizeBytes: "+fmt.Sprintf("%#v", this.MutableStateSizeBytes)+",\n")
+	s = append(s, "PendingActivityCount: "+fmt.Sprintf("%#v", this.PendingActivityCount)+",\n")
+	s = append(s, "PendingTimerCount: "+fmt.Sprintf("%#v", this.PendingTimerCount)+",\n")
+	s = append(s, "PendingChildExecutionCount: "+fmt.Sprintf("%#v", this.PendingChildExecutionCount)+",\n")
+	s = append(s, "PendingRequestCancelCount: "+fmt.Sprintf("%#v", this.PendingRequestCancelCount)+",\n")
+	s = append(s, "PendingSignalCount: "+fmt.Sprintf("%#v", this.PendingSignalCount)+",\n")
+	s = append(s, "SignalCount: "+fmt.Sprintf("%#v", this.SignalCount)+",\n")
+	s = append(s, "BufferedEventCount: "+fmt.Sprintf("%#v", this.BufferedEventCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringMessage(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -258,6 +461,79 @@ func (m *ParentExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExecutionSizeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionSizeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionSizeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BufferedEventCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.BufferedEventCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.SignalCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.SignalCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PendingSignalCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.PendingSignalCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PendingRequestCancelCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.PendingRequestCancelCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PendingChildExecutionCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.PendingChildExecutionCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PendingTimerCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.PendingTimerCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PendingActivityCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.PendingActivityCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MutableStateSizeBytes != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.MutableStateSizeBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HistoryEventCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.HistoryEventCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HistorySizeBytes != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.HistorySizeBytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessage(v)
 	base := offset
@@ -293,6 +569,45 @@ func (m *ParentExecutionInfo) Size() (n int) {
 	return n
 }
 
+func (m *ExecutionSizeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HistorySizeBytes != 0 {
+		n += 1 + sovMessage(uint64(m.HistorySizeBytes))
+	}
+	if m.HistoryEventCount != 0 {
+		n += 1 + sovMessage(uint64(m.HistoryEventCount))
+	}
+	if m.MutableStateSizeBytes != 0 {
+		n += 1 + sovMessage(uint64(m.MutableStateSizeBytes))
+	}
+	if m.PendingActivityCount != 0 {
+		n += 1 + sovMessage(uint64(m.PendingActivityCount))
+	}
+	if m.PendingTimerCount != 0 {
+		n += 1 + sovMessage(uint64(m.PendingTimerCount))
+	}
+	if m.PendingChildExecutionCount != 0 {
+		n += 1 + sovMessage(uint64(m.PendingChildExecutionCount))
+	}
+	if m.PendingRequestCancelCount != 0 {
+		n += 1 + sovMessage(uint64(m.PendingRequestCancelCount))
+	}
+	if m.PendingSignalCount != 0 {
+		n += 1 + sovMessage(uint64(m.PendingSignalCount))
+	}
+	if m.SignalCount != 0 {
+		n += 1 + sovMessage(uint64(m.SignalCount))
+	}
+	if m.BufferedEventCount != 0 {
+		n += 1 + sovMessage(uint64(m.BufferedEventCount))
+	}
+	return n
+}
+
 func sovMessage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -312,6 +627,25 @@ func (this *ParentExecutionInfo) String() string {
 	}, "")
 	return s
 }
+func (this *ExecutionSizeInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExecutionSizeInfo{`,
+		`HistorySizeBytes:` + fmt.Sprintf("%v", this.HistorySizeBytes) + `,`,
+		`HistoryEventCount:` + fmt.Sprintf("%v", this.HistoryEventCount) + `,`,
+		`MutableStateSizeBytes:` + fmt.Sprintf("%v", this.MutableStateSizeBytes) + `,`,
+		`PendingActivityCount:` + fmt.Sprintf("%v", this.PendingActivityCount) + `,`,
+		`PendingTimerCount:` + fmt.Sprintf("%v", this.PendingTimerCount) + `,`,
+		`PendingChildExecutionCount:` + fmt.Sprintf("%v", this.PendingChildExecutionCount) + `,`,
+		`PendingRequestCancelCount:` + fmt.Sprintf("%v", this.PendingRequestCancelCount) + `,`,
+		`PendingSignalCount:` + fmt.Sprintf("%v", this.PendingSignalCount) + `,`,
+		`SignalCount:` + fmt.Sprintf("%v", this.SignalCount) + `,`,
+		`BufferedEventCount:` + fmt.Sprintf("%v", this.BufferedEventCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringMessage(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -492,6 +826,249 @@ func (m *ParentExecutionInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExecutionSizeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionSizeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionSizeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistorySizeBytes", wireType)
+			}
+			m.HistorySizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistorySizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryEventCount", wireType)
+			}
+			m.HistoryEventCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryEventCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MutableStateSizeBytes", wireType)
+			}
+			m.MutableStateSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MutableStateSizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingActivityCount", wireType)
+			}
+			m.PendingActivityCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingActivityCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTimerCount", wireType)
+			}
+			m.PendingTimerCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingTimerCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChildExecutionCount", wireType)
+			}
+			m.PendingChildExecutionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingChildExecutionCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRequestCancelCount", wireType)
+			}
+			m.PendingRequestCancelCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingRequestCancelCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSignalCount", wireType)
+			}
+			m.PendingSignalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingSignalCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalCount", wireType)
+			}
+			m.SignalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignalCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferedEventCount", wireType)
+			}
+			m.BufferedEventCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BufferedEventCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -287,6 +287,34 @@ func (c *clientImpl) RemoveRemoteCluster(
 	return client.RemoveRemoteCluster(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListClusterMetadataHistory(
+	ctx context.Context,
+	request *adminservice.ListClusterMetadataHistoryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListClusterMetadataHistoryResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListClusterMetadataHistory(ctx, request, opts...)
+}
+
+func (c *clientImpl) RollbackClusterMetadata(
+	ctx context.Context,
+	request *adminservice.RollbackClusterMetadataRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RollbackClusterMetadataResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RollbackClusterMetadata(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetReplicationMessagesRequest,
@@ -301,6 +329,18 @@ func (c *clientImpl) GetReplicationMessages(
 	return client.GetReplicationMessages(ctx, request, opts...)
 }
 
+func (c *clientImpl) StreamReplicationMessages(
+	ctx context.Context,
+	opts ...grpc.CallOption,
+) (adminservice.AdminService_StreamReplicationMessagesClient, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	// stream is long lived, it is closed by canceling ctx
+	return client.StreamReplicationMessages(ctx, opts...)
+}
+
 func (c *clientImpl) GetNamespaceReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetNamespaceReplicationMessagesRequest,
@@ -400,6 +440,216 @@ func (c *clientImpl) RefreshWorkflowTasks(
 	return client.RefreshWorkflowTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UnpauseWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetReplicationLag(
+	ctx context.Context,
+	request *adminservice.GetReplicationLagRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetReplicationLagResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetReplicationLag(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateNamespaceReplicationFilter(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceReplicationFilterRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNamespaceReplicationFilterResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateNamespaceReplicationFilter(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateNamespaceDataResidencyPolicy(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceDataResidencyPolicyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNamespaceDataResidencyPolicyResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateNamespaceDataResidencyPolicy(ctx, request, opts...)
+}
+
+func (c *clientImpl) RenameNamespace(
+	ctx context.Context,
+	request *adminservice.RenameNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RenameNamespaceResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RenameNamespace(ctx, request, opts...)
+}
+
+func (c *clientImpl) CreateApiKey(
+	ctx context.Context,
+	request *adminservice.CreateApiKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CreateApiKeyResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.CreateApiKey(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListApiKeys(
+	ctx context.Context,
+	request *adminservice.ListApiKeysRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListApiKeysResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListApiKeys(ctx, request, opts...)
+}
+
+func (c *clientImpl) RotateApiKey(
+	ctx context.Context,
+	request *adminservice.RotateApiKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RotateApiKeyResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RotateApiKey(ctx, request, opts...)
+}
+
+func (c *clientImpl) RevokeApiKey(
+	ctx context.Context,
+	request *adminservice.RevokeApiKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RevokeApiKeyResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RevokeApiKey(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeNamespaceHandover(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceHandoverRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNamespaceHandoverResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeNamespaceHandover(ctx, request, opts...)
+}
+
+func (c *clientImpl) SyncSearchAttributes(
+	ctx context.Context,
+	request *adminservice.SyncSearchAttributesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SyncSearchAttributesResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.SyncSearchAttributes(ctx, request, opts...)
+}
+
+func (c *clientImpl) PurgeDLQMessagesAllShards(
+	ctx context.Context,
+	request *adminservice.PurgeDLQMessagesAllShardsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PurgeDLQMessagesAllShardsResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PurgeDLQMessagesAllShards(ctx, request, opts...)
+}
+
+func (c *clientImpl) MergeDLQMessagesAllShards(
+	ctx context.Context,
+	request *adminservice.MergeDLQMessagesAllShardsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MergeDLQMessagesAllShardsResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.MergeDLQMessagesAllShards(ctx, request, opts...)
+}
+
+func (c *clientImpl) VerifyWorkflowReplication(
+	ctx context.Context,
+	request *adminservice.VerifyWorkflowReplicationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.VerifyWorkflowReplicationResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.VerifyWorkflowReplication(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartNamespaceHandover(
+	ctx context.Context,
+	request *adminservice.StartNamespaceHandoverRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartNamespaceHandoverResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.StartNamespaceHandover(ctx, request, opts...)
+}
+
 func (c *clientImpl) ResendReplicationTasks(
 	ctx context.Context,
 	request *adminservice.ResendReplicationTasksRequest,
@@ -428,6 +678,34 @@ func (c *clientImpl) GetTaskQueueTasks(
 	return client.GetTaskQueueTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) ReportNamespaceQuotaUsage(
+	ctx context.Context,
+	request *adminservice.ReportNamespaceQuotaUsageRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ReportNamespaceQuotaUsageResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ReportNamespaceQuotaUsage(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListPollerCounts(
+	ctx context.Context,
+	request *adminservice.ListPollerCountsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListPollerCountsResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListPollerCounts(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	return context.WithTimeout(parent, c.timeout)
 }
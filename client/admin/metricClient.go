@@ -335,6 +335,42 @@ func (c *metricClient) RemoveRemoteCluster(
 	return resp, err
 }
 
+func (c *metricClient) ListClusterMetadataHistory(
+	ctx context.Context,
+	request *adminservice.ListClusterMetadataHistoryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListClusterMetadataHistoryResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListClusterMetadataHistoryScope, metrics.ClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListClusterMetadataHistoryScope, metrics.ClientLatency)
+	resp, err := c.client.ListClusterMetadataHistory(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListClusterMetadataHistoryScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) RollbackClusterMetadata(
+	ctx context.Context,
+	request *adminservice.RollbackClusterMetadataRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RollbackClusterMetadataResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRollbackClusterMetadataScope, metrics.ClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRollbackClusterMetadataScope, metrics.ClientLatency)
+	resp, err := c.client.RollbackClusterMetadata(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRollbackClusterMetadataScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) GetReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetReplicationMessagesRequest,
@@ -352,6 +388,22 @@ func (c *metricClient) GetReplicationMessages(
 	return resp, err
 }
 
+func (c *metricClient) StreamReplicationMessages(
+	ctx context.Context,
+	opts ...grpc.CallOption,
+) (adminservice.AdminService_StreamReplicationMessagesClient, error) {
+	c.metricsClient.IncCounter(metrics.AdminClientStreamReplicationMessagesScope, metrics.ClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientStreamReplicationMessagesScope, metrics.ClientLatency)
+	stream, err := c.client.StreamReplicationMessages(ctx, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientStreamReplicationMessagesScope, metrics.ClientFailures)
+	}
+	return stream, err
+}
+
 func (c *metricClient) GetNamespaceReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetNamespaceReplicationMessagesRequest,
@@ -471,6 +523,261 @@ func (c *metricClient) RefreshWorkflowTasks(
 	return resp, err
 }
 
+func (c *metricClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUnpauseWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUnpauseWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUnpauseWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetReplicationLag(
+	ctx context.Context,
+	request *adminservice.GetReplicationLagRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetReplicationLagResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetReplicationLagScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetReplicationLagScope, metrics.ClientLatency)
+	resp, err := c.client.GetReplicationLag(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetReplicationLagScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateNamespaceReplicationFilter(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceReplicationFilterRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNamespaceReplicationFilterResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateNamespaceReplicationFilterScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateNamespaceReplicationFilterScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateNamespaceReplicationFilter(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateNamespaceReplicationFilterScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateNamespaceDataResidencyPolicy(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceDataResidencyPolicyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNamespaceDataResidencyPolicyResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateNamespaceDataResidencyPolicyScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateNamespaceDataResidencyPolicyScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateNamespaceDataResidencyPolicy(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateNamespaceDataResidencyPolicyScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) RenameNamespace(
+	ctx context.Context,
+	request *adminservice.RenameNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RenameNamespaceResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRenameNamespaceScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRenameNamespaceScope, metrics.ClientLatency)
+	resp, err := c.client.RenameNamespace(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRenameNamespaceScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) CreateApiKey(
+	ctx context.Context,
+	request *adminservice.CreateApiKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CreateApiKeyResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRenameNamespaceScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRenameNamespaceScope, metrics.ClientLatency)
+	resp, err := c.client.CreateApiKey(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRenameNamespaceScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListApiKeys(
+	ctx context.Context,
+	request *adminservice.ListApiKeysRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListApiKeysResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRenameNamespaceScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRenameNamespaceScope, metrics.ClientLatency)
+	resp, err := c.client.ListApiKeys(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRenameNamespaceScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) RotateApiKey(
+	ctx context.Context,
+	request *adminservice.RotateApiKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RotateApiKeyResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRenameNamespaceScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRenameNamespaceScope, metrics.ClientLatency)
+	resp, err := c.client.RotateApiKey(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRenameNamespaceScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) RevokeApiKey(
+	ctx context.Context,
+	request *adminservice.RevokeApiKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RevokeApiKeyResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRenameNamespaceScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRenameNamespaceScope, metrics.ClientLatency)
+	resp, err := c.client.RevokeApiKey(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRenameNamespaceScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeNamespaceHandover(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceHandoverRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNamespaceHandoverResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeNamespaceHandoverScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeNamespaceHandoverScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeNamespaceHandover(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeNamespaceHandoverScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) SyncSearchAttributes(
+	ctx context.Context,
+	request *adminservice.SyncSearchAttributesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SyncSearchAttributesResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientSyncSearchAttributesScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientSyncSearchAttributesScope, metrics.ClientLatency)
+	resp, err := c.client.SyncSearchAttributes(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientSyncSearchAttributesScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) PurgeDLQMessagesAllShards(
+	ctx context.Context,
+	request *adminservice.PurgeDLQMessagesAllShardsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PurgeDLQMessagesAllShardsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientPurgeDLQMessagesAllShardsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPurgeDLQMessagesAllShardsScope, metrics.ClientLatency)
+	resp, err := c.client.PurgeDLQMessagesAllShards(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPurgeDLQMessagesAllShardsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) MergeDLQMessagesAllShards(
+	ctx context.Context,
+	request *adminservice.MergeDLQMessagesAllShardsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MergeDLQMessagesAllShardsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientMergeDLQMessagesAllShardsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientMergeDLQMessagesAllShardsScope, metrics.ClientLatency)
+	resp, err := c.client.MergeDLQMessagesAllShards(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientMergeDLQMessagesAllShardsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) VerifyWorkflowReplication(
+	ctx context.Context,
+	request *adminservice.VerifyWorkflowReplicationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.VerifyWorkflowReplicationResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientVerifyWorkflowReplicationScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientVerifyWorkflowReplicationScope, metrics.ClientLatency)
+	resp, err := c.client.VerifyWorkflowReplication(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientVerifyWorkflowReplicationScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) StartNamespaceHandover(
+	ctx context.Context,
+	request *adminservice.StartNamespaceHandoverRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartNamespaceHandoverResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientStartNamespaceHandoverScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientStartNamespaceHandoverScope, metrics.ClientLatency)
+	resp, err := c.client.StartNamespaceHandover(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientStartNamespaceHandoverScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) ResendReplicationTasks(
 	ctx context.Context,
 	request *adminservice.ResendReplicationTasksRequest,
@@ -504,3 +811,37 @@ func (c *metricClient) GetTaskQueueTasks(
 	}
 	return resp, err
 }
+
+func (c *metricClient) ReportNamespaceQuotaUsage(
+	ctx context.Context,
+	request *adminservice.ReportNamespaceQuotaUsageRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ReportNamespaceQuotaUsageResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientResendReplicationTasksScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientResendReplicationTasksScope, metrics.ClientLatency)
+	resp, err := c.client.ReportNamespaceQuotaUsage(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientResendReplicationTasksScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListPollerCounts(
+	ctx context.Context,
+	request *adminservice.ListPollerCountsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListPollerCountsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientResendReplicationTasksScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientResendReplicationTasksScope, metrics.ClientLatency)
+	resp, err := c.client.ListPollerCounts(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientResendReplicationTasksScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
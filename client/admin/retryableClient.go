@@ -305,6 +305,38 @@ func (c *retryableClient) RemoveRemoteCluster(
 	return resp, err
 }
 
+func (c *retryableClient) ListClusterMetadataHistory(
+	ctx context.Context,
+	request *adminservice.ListClusterMetadataHistoryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListClusterMetadataHistoryResponse, error) {
+
+	var resp *adminservice.ListClusterMetadataHistoryResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListClusterMetadataHistory(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RollbackClusterMetadata(
+	ctx context.Context,
+	request *adminservice.RollbackClusterMetadataRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RollbackClusterMetadataResponse, error) {
+
+	var resp *adminservice.RollbackClusterMetadataResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RollbackClusterMetadata(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetReplicationMessagesRequest,
@@ -320,6 +352,21 @@ func (c *retryableClient) GetReplicationMessages(
 	return resp, err
 }
 
+func (c *retryableClient) StreamReplicationMessages(
+	ctx context.Context,
+	opts ...grpc.CallOption,
+) (adminservice.AdminService_StreamReplicationMessagesClient, error) {
+	// only opening the stream is retried, messages are not resent
+	var stream adminservice.AdminService_StreamReplicationMessagesClient
+	op := func() error {
+		var err error
+		stream, err = c.client.StreamReplicationMessages(ctx, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return stream, err
+}
+
 func (c *retryableClient) GetNamespaceReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetNamespaceReplicationMessagesRequest,
@@ -429,6 +476,246 @@ func (c *retryableClient) RefreshWorkflowTasks(
 	return resp, err
 }
 
+func (c *retryableClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+
+	var resp *adminservice.UnpauseWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetReplicationLag(
+	ctx context.Context,
+	request *adminservice.GetReplicationLagRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetReplicationLagResponse, error) {
+
+	var resp *adminservice.GetReplicationLagResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetReplicationLag(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateNamespaceReplicationFilter(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceReplicationFilterRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNamespaceReplicationFilterResponse, error) {
+
+	var resp *adminservice.UpdateNamespaceReplicationFilterResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateNamespaceReplicationFilter(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateNamespaceDataResidencyPolicy(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceDataResidencyPolicyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNamespaceDataResidencyPolicyResponse, error) {
+
+	var resp *adminservice.UpdateNamespaceDataResidencyPolicyResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateNamespaceDataResidencyPolicy(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RenameNamespace(
+	ctx context.Context,
+	request *adminservice.RenameNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RenameNamespaceResponse, error) {
+
+	var resp *adminservice.RenameNamespaceResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RenameNamespace(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) CreateApiKey(
+	ctx context.Context,
+	request *adminservice.CreateApiKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CreateApiKeyResponse, error) {
+
+	var resp *adminservice.CreateApiKeyResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.CreateApiKey(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListApiKeys(
+	ctx context.Context,
+	request *adminservice.ListApiKeysRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListApiKeysResponse, error) {
+
+	var resp *adminservice.ListApiKeysResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListApiKeys(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RotateApiKey(
+	ctx context.Context,
+	request *adminservice.RotateApiKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RotateApiKeyResponse, error) {
+
+	var resp *adminservice.RotateApiKeyResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RotateApiKey(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RevokeApiKey(
+	ctx context.Context,
+	request *adminservice.RevokeApiKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RevokeApiKeyResponse, error) {
+
+	var resp *adminservice.RevokeApiKeyResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RevokeApiKey(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeNamespaceHandover(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceHandoverRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNamespaceHandoverResponse, error) {
+
+	var resp *adminservice.DescribeNamespaceHandoverResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeNamespaceHandover(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) SyncSearchAttributes(
+	ctx context.Context,
+	request *adminservice.SyncSearchAttributesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SyncSearchAttributesResponse, error) {
+
+	var resp *adminservice.SyncSearchAttributesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.SyncSearchAttributes(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PurgeDLQMessagesAllShards(
+	ctx context.Context,
+	request *adminservice.PurgeDLQMessagesAllShardsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PurgeDLQMessagesAllShardsResponse, error) {
+
+	var resp *adminservice.PurgeDLQMessagesAllShardsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.PurgeDLQMessagesAllShards(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) MergeDLQMessagesAllShards(
+	ctx context.Context,
+	request *adminservice.MergeDLQMessagesAllShardsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MergeDLQMessagesAllShardsResponse, error) {
+
+	var resp *adminservice.MergeDLQMessagesAllShardsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.MergeDLQMessagesAllShards(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) VerifyWorkflowReplication(
+	ctx context.Context,
+	request *adminservice.VerifyWorkflowReplicationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.VerifyWorkflowReplicationResponse, error) {
+
+	var resp *adminservice.VerifyWorkflowReplicationResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.VerifyWorkflowReplication(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartNamespaceHandover(
+	ctx context.Context,
+	request *adminservice.StartNamespaceHandoverRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartNamespaceHandoverResponse, error) {
+
+	var resp *adminservice.StartNamespaceHandoverResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.StartNamespaceHandover(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ResendReplicationTasks(
 	ctx context.Context,
 	request *adminservice.ResendReplicationTasksRequest,
@@ -460,3 +747,35 @@ func (c *retryableClient) GetTaskQueueTasks(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) ReportNamespaceQuotaUsage(
+	ctx context.Context,
+	request *adminservice.ReportNamespaceQuotaUsageRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ReportNamespaceQuotaUsageResponse, error) {
+
+	var resp *adminservice.ReportNamespaceQuotaUsageResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ReportNamespaceQuotaUsage(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListPollerCounts(
+	ctx context.Context,
+	request *adminservice.ListPollerCountsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListPollerCountsResponse, error) {
+
+	var resp *adminservice.ListPollerCountsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListPollerCounts(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -114,6 +114,16 @@ func (c *metricClient) GetShard(
 	return resp, err
 }
 
+func (c *metricClient) CompleteShardHandoff(
+	context context.Context,
+	request *historyservice.CompleteShardHandoffRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.CompleteShardHandoffResponse, retError error) {
+	resp, err := c.client.CompleteShardHandoff(context, request, opts...)
+
+	return resp, err
+}
+
 func (c *metricClient) RebuildMutableState(
 	context context.Context,
 	request *historyservice.RebuildMutableStateRequest,
@@ -585,6 +595,20 @@ func (c *metricClient) RefreshWorkflowTasks(
 	return c.client.RefreshWorkflowTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.UnpauseWorkflowExecutionResponse, retError error) {
+
+	scope, stopwatch := c.startMetricsRecording(metrics.HistoryClientUnpauseWorkflowExecutionScope)
+	defer func() {
+		c.finishMetricsRecording(scope, stopwatch, retError)
+	}()
+
+	return c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *historyservice.GenerateLastHistoryReplicationTasksRequest,
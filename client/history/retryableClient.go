@@ -114,6 +114,22 @@ func (c *retryableClient) GetShard(
 	return resp, err
 }
 
+func (c *retryableClient) CompleteShardHandoff(
+	ctx context.Context,
+	request *historyservice.CompleteShardHandoffRequest,
+	opts ...grpc.CallOption) (*historyservice.CompleteShardHandoffResponse, error) {
+
+	var resp *historyservice.CompleteShardHandoffResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.CompleteShardHandoff(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RemoveTask(
 	ctx context.Context,
 	request *historyservice.RemoveTaskRequest,
@@ -675,6 +691,23 @@ func (c *retryableClient) RefreshWorkflowTasks(
 	return resp, err
 }
 
+func (c *retryableClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+
+	var resp *historyservice.UnpauseWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *historyservice.GenerateLastHistoryReplicationTasksRequest,
@@ -156,7 +156,11 @@ func buildCLI() *cli.App {
 
 				authorizer, err := authorization.GetAuthorizerFromConfig(
 					&cfg.Global.Authorization,
+					logger,
 				)
+				if err != nil {
+					return cli.Exit(fmt.Sprintf("Unable to instantiate authorizer: %v.", err), 1)
+				}
 
 				claimMapper, err := authorization.GetClaimMapperFromConfig(&cfg.Global.Authorization, logger)
 				if err != nil {
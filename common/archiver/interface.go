@@ -71,10 +71,18 @@ type (
 
 	// HistoryBootstrapContainer contains components needed by all history Archiver implementations
 	HistoryBootstrapContainer struct {
-		ExecutionManager persistence.ExecutionManager
-		Logger           log.Logger
-		MetricsClient    metrics.Client
-		ClusterMetadata  cluster.Metadata
+		ExecutionManager     persistence.ExecutionManager
+		Logger               log.Logger
+		MetricsClient        metrics.Client
+		ClusterMetadata      cluster.Metadata
+		DataResidencyChecker DataResidencyChecker
+	}
+
+	// DataResidencyChecker checks archival against the data residency policy of a namespace.
+	DataResidencyChecker interface {
+		// CheckArchival returns false if the namespace policy does not allow archiving into the URI and region.
+		// Region is empty for archivers which are not region aware.
+		CheckArchival(namespaceID string, URI URI, region string) (bool, error)
 	}
 
 	// HistoryArchiver is used to archive history and read archived history
@@ -97,9 +105,10 @@ type (
 
 	// VisibilityBootstrapContainer contains components needed by all visibility Archiver implementations
 	VisibilityBootstrapContainer struct {
-		Logger          log.Logger
-		MetricsClient   metrics.Client
-		ClusterMetadata cluster.Metadata
+		Logger               log.Logger
+		MetricsClient        metrics.Client
+		ClusterMetadata      cluster.Metadata
+		DataResidencyChecker DataResidencyChecker
 	}
 
 	// QueryVisibilityRequest is the request to query archived visibility records
@@ -37,6 +37,44 @@ import (
 	searchattribute "go.temporal.io/server/common/searchattribute"
 )
 
+// MockDataResidencyChecker is a mock of DataResidencyChecker interface.
+type MockDataResidencyChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataResidencyCheckerMockRecorder
+}
+
+// MockDataResidencyCheckerMockRecorder is the mock recorder for MockDataResidencyChecker.
+type MockDataResidencyCheckerMockRecorder struct {
+	mock *MockDataResidencyChecker
+}
+
+// NewMockDataResidencyChecker creates a new mock instance.
+func NewMockDataResidencyChecker(ctrl *gomock.Controller) *MockDataResidencyChecker {
+	mock := &MockDataResidencyChecker{ctrl: ctrl}
+	mock.recorder = &MockDataResidencyCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataResidencyChecker) EXPECT() *MockDataResidencyCheckerMockRecorder {
+	return m.recorder
+}
+
+// CheckArchival mocks base method.
+func (m *MockDataResidencyChecker) CheckArchival(namespaceID string, URI URI, region string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckArchival", namespaceID, URI, region)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckArchival indicates an expected call of CheckArchival.
+func (mr *MockDataResidencyCheckerMockRecorder) CheckArchival(namespaceID, URI, region interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckArchival", reflect.TypeOf((*MockDataResidencyChecker)(nil).CheckArchival), namespaceID, URI, region)
+}
+
 // MockHistoryArchiver is a mock of HistoryArchiver interface.
 type MockHistoryArchiver struct {
 	ctrl     *gomock.Controller
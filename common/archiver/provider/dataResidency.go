@@ -0,0 +1,138 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// dataResidencyHistoryArchiver double-checks the data residency policy of the namespace before archiving history,
+	// namespace handler already validates it when the archival URI is set.
+	dataResidencyHistoryArchiver struct {
+		archiver.HistoryArchiver
+		checker archiver.DataResidencyChecker
+		logger  log.Logger
+		region  string
+	}
+
+	// dataResidencyVisibilityArchiver double-checks the data residency policy of the namespace before archiving visibility.
+	dataResidencyVisibilityArchiver struct {
+		archiver.VisibilityArchiver
+		checker archiver.DataResidencyChecker
+		logger  log.Logger
+		region  string
+	}
+)
+
+var (
+	errArchivalNotAllowedByDataResidencyPolicy = errors.New("archival is not allowed by namespace data residency policy")
+)
+
+func newDataResidencyHistoryArchiver(
+	historyArchiver archiver.HistoryArchiver,
+	container *archiver.HistoryBootstrapContainer,
+	region string,
+) archiver.HistoryArchiver {
+	return &dataResidencyHistoryArchiver{
+		HistoryArchiver: historyArchiver,
+		checker:         container.DataResidencyChecker,
+		logger:          container.Logger,
+		region:          region,
+	}
+}
+
+func (a *dataResidencyHistoryArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ArchiveHistoryRequest,
+	opts ...archiver.ArchiveOption,
+) error {
+	if err := checkDataResidency(a.checker, a.logger, request.NamespaceID, URI, a.region, opts...); err != nil {
+		return err
+	}
+	return a.HistoryArchiver.Archive(ctx, URI, request, opts...)
+}
+
+func newDataResidencyVisibilityArchiver(
+	visibilityArchiver archiver.VisibilityArchiver,
+	container *archiver.VisibilityBootstrapContainer,
+	region string,
+) archiver.VisibilityArchiver {
+	return &dataResidencyVisibilityArchiver{
+		VisibilityArchiver: visibilityArchiver,
+		checker:            container.DataResidencyChecker,
+		logger:             container.Logger,
+		region:             region,
+	}
+}
+
+func (a *dataResidencyVisibilityArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiverspb.VisibilityRecord,
+	opts ...archiver.ArchiveOption,
+) error {
+	if err := checkDataResidency(a.checker, a.logger, request.GetNamespaceId(), URI, a.region, opts...); err != nil {
+		return err
+	}
+	return a.VisibilityArchiver.Archive(ctx, URI, request, opts...)
+}
+
+// checkDataResidency returns the non-retryable error of the archive options if the namespace policy
+// does not allow the archival, errors looking up the policy are returned as is to be retried.
+func checkDataResidency(
+	checker archiver.DataResidencyChecker,
+	logger log.Logger,
+	namespaceID string,
+	URI archiver.URI,
+	region string,
+	opts ...archiver.ArchiveOption,
+) error {
+	allowed, err := checker.CheckArchival(namespaceID, URI, region)
+	if err != nil {
+		return err
+	}
+	if allowed {
+		return nil
+	}
+
+	logger.Error(archiver.ArchiveNonRetryableErrorMsg,
+		tag.ArchivalArchiveFailReason(errArchivalNotAllowedByDataResidencyPolicy.Error()),
+		tag.WorkflowNamespaceID(namespaceID),
+		tag.ArchivalURI(URI.String()),
+	)
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	if featureCatalog.NonRetryableError != nil {
+		return featureCatalog.NonRetryableError()
+	}
+	return errArchivalNotAllowedByDataResidencyPolicy
+}
@@ -62,6 +62,12 @@ type (
 		) error
 		GetHistoryArchiver(scheme, serviceName string) (archiver.HistoryArchiver, error)
 		GetVisibilityArchiver(scheme, serviceName string) (archiver.VisibilityArchiver, error)
+		// GetHistoryArchiverRegion returns the region history archiver of the scheme stores data in,
+		// empty if the archiver is not region aware.
+		GetHistoryArchiverRegion(scheme string) string
+		// GetVisibilityArchiverRegion returns the region visibility archiver of the scheme stores data in,
+		// empty if the archiver is not region aware.
+		GetVisibilityArchiverRegion(scheme string) string
 	}
 
 	archiverProvider struct {
@@ -164,6 +170,9 @@ func (p *archiverProvider) GetHistoryArchiver(scheme, serviceName string) (histo
 	if err != nil {
 		return nil, err
 	}
+	if container.DataResidencyChecker != nil {
+		historyArchiver = newDataResidencyHistoryArchiver(historyArchiver, container, p.GetHistoryArchiverRegion(scheme))
+	}
 
 	p.Lock()
 	defer p.Unlock()
@@ -214,6 +223,9 @@ func (p *archiverProvider) GetVisibilityArchiver(scheme, serviceName string) (ar
 	if err != nil {
 		return nil, err
 	}
+	if container.DataResidencyChecker != nil {
+		visibilityArchiver = newDataResidencyVisibilityArchiver(visibilityArchiver, container, p.GetVisibilityArchiverRegion(scheme))
+	}
 
 	p.Lock()
 	defer p.Unlock()
@@ -225,6 +237,20 @@ func (p *archiverProvider) GetVisibilityArchiver(scheme, serviceName string) (ar
 
 }
 
+func (p *archiverProvider) GetHistoryArchiverRegion(scheme string) string {
+	if scheme == s3store.URIScheme && p.historyArchiverConfigs != nil && p.historyArchiverConfigs.S3store != nil {
+		return p.historyArchiverConfigs.S3store.Region
+	}
+	return ""
+}
+
+func (p *archiverProvider) GetVisibilityArchiverRegion(scheme string) string {
+	if scheme == s3store.URIScheme && p.visibilityArchiverConfigs != nil && p.visibilityArchiverConfigs.S3store != nil {
+		return p.visibilityArchiverConfigs.S3store.Region
+	}
+	return ""
+}
+
 func (p *archiverProvider) getArchiverKey(scheme, serviceName string) string {
 	return scheme + ":" + serviceName
 }
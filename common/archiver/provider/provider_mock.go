@@ -73,6 +73,20 @@ func (mr *MockArchiverProviderMockRecorder) GetHistoryArchiver(scheme, serviceNa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryArchiver", reflect.TypeOf((*MockArchiverProvider)(nil).GetHistoryArchiver), scheme, serviceName)
 }
 
+// GetHistoryArchiverRegion mocks base method.
+func (m *MockArchiverProvider) GetHistoryArchiverRegion(scheme string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoryArchiverRegion", scheme)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetHistoryArchiverRegion indicates an expected call of GetHistoryArchiverRegion.
+func (mr *MockArchiverProviderMockRecorder) GetHistoryArchiverRegion(scheme interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryArchiverRegion", reflect.TypeOf((*MockArchiverProvider)(nil).GetHistoryArchiverRegion), scheme)
+}
+
 // GetVisibilityArchiver mocks base method.
 func (m *MockArchiverProvider) GetVisibilityArchiver(scheme, serviceName string) (archiver.VisibilityArchiver, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +102,20 @@ func (mr *MockArchiverProviderMockRecorder) GetVisibilityArchiver(scheme, servic
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVisibilityArchiver", reflect.TypeOf((*MockArchiverProvider)(nil).GetVisibilityArchiver), scheme, serviceName)
 }
 
+// GetVisibilityArchiverRegion mocks base method.
+func (m *MockArchiverProvider) GetVisibilityArchiverRegion(scheme string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVisibilityArchiverRegion", scheme)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetVisibilityArchiverRegion indicates an expected call of GetVisibilityArchiverRegion.
+func (mr *MockArchiverProviderMockRecorder) GetVisibilityArchiverRegion(scheme interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVisibilityArchiverRegion", reflect.TypeOf((*MockArchiverProvider)(nil).GetVisibilityArchiverRegion), scheme)
+}
+
 // RegisterBootstrapContainer mocks base method.
 func (m *MockArchiverProvider) RegisterBootstrapContainer(serviceName string, historyContainer *archiver.HistoryBootstrapContainer, visibilityContainter *archiver.VisibilityBootstrapContainer) error {
 	m.ctrl.T.Helper()
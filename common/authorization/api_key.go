@@ -0,0 +1,207 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	apiKeyPrefix        = "tmprl_"
+	apiKeySeparator     = "_"
+	apiKeyIDLength      = 8
+	apiKeySecretLength  = 32
+	apiKeySubjectPrefix = "api-key:"
+
+	defaultAPIKeyCacheTTL  = time.Minute
+	defaultAPIKeyCacheSize = 10000
+)
+
+var errInvalidAPIKey = serviceerror.NewPermissionDenied("invalid API key", "")
+
+type (
+	// APIKeyStore looks up server managed API keys
+	APIKeyStore interface {
+		// GetAPIKey returns the name of the namespace and its API key with the given id,
+		// NotFound error if there is no such key
+		GetAPIKey(namespaceID string, id string) (string, *persistencespb.NamespaceApiKey, error)
+	}
+
+	// apiKeyClaimMapper maps API keys to claims of their namespace and role,
+	// other tokens are mapped by the fallback claim mapper
+	apiKeyClaimMapper struct {
+		store    APIKeyStore
+		fallback ClaimMapper
+		cache    cache.Cache
+		cacheTTL time.Duration
+	}
+
+	// apiKeyCacheEntry is a validated API key, cached until expiration
+	apiKeyCacheEntry struct {
+		claims     Claims
+		expiration time.Time
+	}
+)
+
+var _ ClaimMapper = (*apiKeyClaimMapper)(nil)
+
+// NewAPIKeyClaimMapper creates a claim mapper which validates API keys against store.
+// Bearer tokens which are not API keys are passed to fallback.
+func NewAPIKeyClaimMapper(store APIKeyStore, fallback ClaimMapper, cfg *config.APIKeys) ClaimMapper {
+	cacheTTL := cfg.CacheTTL
+	if cacheTTL <= 0 {
+		cacheTTL = defaultAPIKeyCacheTTL
+	}
+	cacheSize := cfg.CacheSize
+	if cacheSize <= 0 {
+		cacheSize = defaultAPIKeyCacheSize
+	}
+	return &apiKeyClaimMapper{
+		store:    store,
+		fallback: fallback,
+		cache:    cache.New(cacheSize, &cache.Options{TTL: cacheTTL}),
+		cacheTTL: cacheTTL,
+	}
+}
+
+func (a *apiKeyClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
+	parts := strings.SplitN(authInfo.AuthToken, " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], authorizationBearer) || !strings.HasPrefix(parts[1], apiKeyPrefix) {
+		return a.fallback.GetClaims(authInfo)
+	}
+	apiKey := parts[1]
+
+	hash := sha256.Sum256([]byte(apiKey))
+	cacheKey := hex.EncodeToString(hash[:])
+	now := time.Now().UTC()
+	if cached, ok := a.cache.Get(cacheKey).(*apiKeyCacheEntry); ok && now.Before(cached.expiration) {
+		return cached.claims.clone(), nil
+	}
+
+	namespaceID, id, secret, ok := parseAPIKey(apiKey)
+	if !ok {
+		return nil, errInvalidAPIKey
+	}
+	namespaceName, storedKey, err := a.store.GetAPIKey(namespaceID, id)
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		return nil, errInvalidAPIKey
+	default:
+		return nil, err
+	}
+
+	entry := &apiKeyCacheEntry{
+		claims: Claims{
+			Subject:    apiKeySubjectPrefix + id,
+			Namespaces: map[string]Role{strings.ToLower(namespaceName): permissionToRole(storedKey.GetRole())},
+		},
+		expiration: now.Add(a.cacheTTL),
+	}
+	secretHash := hashAPIKeySecret(secret)
+	switch {
+	case secretHashEqual(secretHash, storedKey.GetSecretHash()):
+	case secretHashEqual(secretHash, storedKey.GetPreviousSecretHash()) &&
+		timestamp.TimeValue(storedKey.GetPreviousSecretExpirationTime()).After(now):
+		// previous secret during the rotation grace period
+		if expiration := timestamp.TimeValue(storedKey.GetPreviousSecretExpirationTime()); expiration.Before(entry.expiration) {
+			entry.expiration = expiration
+		}
+	default:
+		return nil, errInvalidAPIKey
+	}
+	a.cache.Put(cacheKey, entry)
+	return entry.claims.clone(), nil
+}
+
+// NewAPIKeyID generates the id of a new API key
+func NewAPIKeyID() (string, error) {
+	id := make([]byte, apiKeyIDLength)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id), nil
+}
+
+// GenerateAPIKey generates a random secret for the API key with the given id.
+// It returns the API key handed out to the caller and the hash of its secret, which is what the server stores.
+func GenerateAPIKey(namespaceID string, id string) (string, string, error) {
+	secret := make([]byte, apiKeySecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", err
+	}
+	secretHex := hex.EncodeToString(secret)
+	apiKey := apiKeyPrefix + strings.Join([]string{namespaceID, id, secretHex}, apiKeySeparator)
+	return apiKey, hashAPIKeySecret(secretHex), nil
+}
+
+// ValidateAPIKeyRole returns an error if role is not a namespace permission: read, write, worker or admin
+func ValidateAPIKeyRole(role string) error {
+	if permissionToRole(role) == RoleUndefined {
+		return serviceerror.NewInvalidArgument("API key role must be one of: read, write, worker, admin.")
+	}
+	return nil
+}
+
+// parseAPIKey splits an API key into the namespace id, the key id and the secret
+func parseAPIKey(apiKey string) (string, string, string, bool) {
+	parts := strings.Split(strings.TrimPrefix(apiKey, apiKeyPrefix), apiKeySeparator)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", false
+	}
+	return parts[0], parts[1], parts[2], true
+}
+
+func hashAPIKeySecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
+
+func secretHashEqual(hash string, storedHash string) bool {
+	return storedHash != "" && subtle.ConstantTimeCompare([]byte(hash), []byte(storedHash)) == 1
+}
+
+func (c *Claims) clone() *Claims {
+	claims := *c
+	if c.Namespaces != nil {
+		claims.Namespaces = make(map[string]Role, len(c.Namespaces))
+		for namespace, role := range c.Namespaces {
+			claims.Namespaces[namespace] = role
+		}
+	}
+	return &claims
+}
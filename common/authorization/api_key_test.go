@@ -0,0 +1,172 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	testAPIKeyNamespaceID = "deadd0d0-c001-face-d00d-000000000000"
+	testAPIKeyNamespace   = "payments"
+)
+
+type testAPIKeyStore struct {
+	keys      map[string]*persistencespb.NamespaceApiKey
+	namespace string
+	calls     int
+}
+
+func (s *testAPIKeyStore) GetAPIKey(namespaceID string, id string) (string, *persistencespb.NamespaceApiKey, error) {
+	s.calls++
+	key, ok := s.keys[id]
+	if !ok || namespaceID != testAPIKeyNamespaceID {
+		return "", nil, serviceerror.NewNotFound("API key not found.")
+	}
+	if s.namespace != "" {
+		return s.namespace, key, nil
+	}
+	return testAPIKeyNamespace, key, nil
+}
+
+func newTestAPIKey(t *testing.T, store *testAPIKeyStore, role string) (string, string) {
+	id, err := NewAPIKeyID()
+	require.NoError(t, err)
+	apiKey, secretHash, err := GenerateAPIKey(testAPIKeyNamespaceID, id)
+	require.NoError(t, err)
+	store.keys[id] = &persistencespb.NamespaceApiKey{Id: id, Role: role, SecretHash: secretHash}
+	return id, apiKey
+}
+
+func TestAPIKeyClaimMapper(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	fallback := NewMockClaimMapper(controller)
+	store := &testAPIKeyStore{keys: map[string]*persistencespb.NamespaceApiKey{}}
+	claimMapper := NewAPIKeyClaimMapper(store, fallback, &config.APIKeys{Enabled: true})
+
+	id, apiKey := newTestAPIKey(t, store, "worker")
+	for i := 0; i < 3; i++ {
+		claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + apiKey})
+		require.NoError(t, err)
+		require.Equal(t, apiKeySubjectPrefix+id, claims.Subject)
+		require.Equal(t, RoleUndefined, claims.System)
+		require.Equal(t, map[string]Role{testAPIKeyNamespace: RoleWorker}, claims.Namespaces)
+		// cached claims are not shared with callers
+		claims.Namespaces[testAPIKeyNamespace] = RoleAdmin
+	}
+	require.Equal(t, 1, store.calls)
+
+	_, otherAPIKey := newTestAPIKey(t, store, "read")
+	_, err := claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + otherAPIKey[:len(otherAPIKey)-1] + "x"})
+	require.Equal(t, errInvalidAPIKey, err)
+	_, err = claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + apiKeyPrefix + "malformed"})
+	require.Equal(t, errInvalidAPIKey, err)
+	_, err = claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + apiKeyPrefix + "ns_unknown_secret"})
+	require.Equal(t, errInvalidAPIKey, err)
+
+	jwt := &AuthInfo{AuthToken: "Bearer eyJhbGciOiJSUzI1NiJ9.e30.sig"}
+	fallback.EXPECT().GetClaims(jwt).Return(&Claims{Subject: "jwt"}, nil)
+	claims, err := claimMapper.GetClaims(jwt)
+	require.NoError(t, err)
+	require.Equal(t, "jwt", claims.Subject)
+
+	noToken := &AuthInfo{}
+	fallback.EXPECT().GetClaims(noToken).Return(nil, nil)
+	claims, err = claimMapper.GetClaims(noToken)
+	require.NoError(t, err)
+	require.Nil(t, claims)
+}
+
+func TestAPIKeyClaimMapperMixedCaseNamespace(t *testing.T) {
+	store := &testAPIKeyStore{keys: map[string]*persistencespb.NamespaceApiKey{}, namespace: "Payments-Prod"}
+	claimMapper := NewAPIKeyClaimMapper(store, NewNoopClaimMapper(), &config.APIKeys{Enabled: true})
+
+	_, apiKey := newTestAPIKey(t, store, "write")
+	claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + apiKey})
+	require.NoError(t, err)
+	require.Equal(t, map[string]Role{"payments-prod": RoleWriter}, claims.Namespaces)
+
+	// the default authorizer looks up the lower cased namespace of the target
+	result, err := NewDefaultAuthorizer().Authorize(context.Background(), claims, &CallTarget{
+		APIName:   "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution",
+		Namespace: "Payments-Prod",
+	})
+	require.NoError(t, err)
+	require.Equal(t, DecisionAllow, result.Decision)
+}
+
+func TestAPIKeyClaimMapperRotation(t *testing.T) {
+	store := &testAPIKeyStore{keys: map[string]*persistencespb.NamespaceApiKey{}}
+	claimMapper := NewAPIKeyClaimMapper(store, NewNoopClaimMapper(), &config.APIKeys{CacheTTL: time.Millisecond})
+
+	id, previousAPIKey := newTestAPIKey(t, store, "write")
+	apiKey, secretHash, err := GenerateAPIKey(testAPIKeyNamespaceID, id)
+	require.NoError(t, err)
+	expiration := time.Now().UTC().Add(time.Hour)
+	store.keys[id] = &persistencespb.NamespaceApiKey{
+		Id:                           id,
+		Role:                         "write",
+		SecretHash:                   secretHash,
+		PreviousSecretHash:           store.keys[id].GetSecretHash(),
+		PreviousSecretExpirationTime: &expiration,
+	}
+
+	for _, key := range []string{apiKey, previousAPIKey} {
+		claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + key})
+		require.NoError(t, err)
+		require.Equal(t, RoleWriter, claims.Namespaces[testAPIKeyNamespace])
+	}
+
+	// previous secret is rejected after the grace period
+	expiration = time.Now().UTC().Add(-time.Second)
+	store.keys[id].PreviousSecretExpirationTime = &expiration
+	time.Sleep(5 * time.Millisecond)
+	_, err = claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + previousAPIKey})
+	require.Equal(t, errInvalidAPIKey, err)
+
+	// revoked key is rejected once the cache expires
+	delete(store.keys, id)
+	_, err = claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + apiKey})
+	require.Equal(t, errInvalidAPIKey, err)
+}
+
+func TestValidateAPIKeyRole(t *testing.T) {
+	for _, role := range []string{"read", "write", "worker", "admin", "Admin"} {
+		require.NoError(t, ValidateAPIKeyRole(role))
+	}
+	for _, role := range []string{"", "owner", "system:admin"} {
+		require.Error(t, ValidateAPIKeyRole(role))
+	}
+}
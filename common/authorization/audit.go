@@ -0,0 +1,245 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	// AuditOutcomeSuccess is the outcome of a call which succeeded
+	AuditOutcomeSuccess = "success"
+	// AuditOutcomeDenied is the outcome of a call which was not authorized
+	AuditOutcomeDenied = "denied"
+	// AuditOutcomeError is the outcome of a call which failed
+	AuditOutcomeError = "error"
+
+	// AuditLogWorkflowName is the type of the system workflows which keep audit records in their history
+	AuditLogWorkflowName = "temporal-sys-audit-log-workflow"
+
+	healthServicePrefix = "/grpc.health.v1.Health/"
+)
+
+type (
+	// AuditRecord describes an audited API call
+	AuditRecord struct {
+		Time time.Time `json:"time"`
+		// Subject is the authenticated caller from Claims, empty if the call carried no credentials
+		Subject string `json:"subject,omitempty"`
+		// Identity is the identity reported by the client, it is not authenticated
+		Identity   string `json:"identity,omitempty"`
+		API        string `json:"api"`
+		Namespace  string `json:"namespace,omitempty"`
+		WorkflowID string `json:"workflowId,omitempty"`
+		RunID      string `json:"runId,omitempty"`
+		Outcome    string `json:"outcome"`
+		Error      string `json:"error,omitempty"`
+	}
+
+	// AuditSink stores audit records
+	AuditSink interface {
+		Write(record *AuditRecord) error
+		Close() error
+	}
+
+	// AuditInterceptor records writer and admin API calls to audit sinks
+	AuditInterceptor struct {
+		sinks         []AuditSink
+		metricsClient metrics.Client
+		logger        log.Logger
+	}
+
+	contextKeyAuditCall struct{}
+
+	// auditCall is filled in by the authorization interceptor with the claims of the caller
+	auditCall struct {
+		claims *Claims
+	}
+
+	hasWorkflowID interface {
+		GetWorkflowId() string
+	}
+
+	hasIdentity interface {
+		GetIdentity() string
+	}
+)
+
+var auditCallKey contextKeyAuditCall
+
+// NewAuditInterceptor creates an interceptor which writes audit records to sinks
+func NewAuditInterceptor(
+	sinks []AuditSink,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *AuditInterceptor {
+	return &AuditInterceptor{
+		sinks:         sinks,
+		metricsClient: metricsClient,
+		logger:        logger,
+	}
+}
+
+// GetAuditSinksFromConfig creates the file and syslog audit sinks enabled in config
+func GetAuditSinksFromConfig(config *config.AuditLog) ([]AuditSink, error) {
+	var sinks []AuditSink
+	if config.File != "" {
+		sink, err := NewFileAuditSink(config.File)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if config.Syslog != nil {
+		sink, err := NewSyslogAuditSink(config.Syslog)
+		if err != nil {
+			for _, s := range sinks {
+				_ = s.Close()
+			}
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	return sinks, nil
+}
+
+func (a *AuditInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if len(a.sinks) == 0 || !IsAuditedAPI(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	call := &auditCall{}
+	resp, err := handler(context.WithValue(ctx, auditCallKey, call), req)
+	if isAuditLogCall(ctx, call.claims) {
+		return resp, err
+	}
+	a.write(newAuditRecord(info.FullMethod, req, call.claims, err))
+	return resp, err
+}
+
+// Close closes all audit sinks
+func (a *AuditInterceptor) Close() {
+	for _, sink := range a.sinks {
+		if err := sink.Close(); err != nil {
+			a.logger.Error("Unable to close audit sink.", tag.Error(err))
+		}
+	}
+}
+
+func (a *AuditInterceptor) write(record *AuditRecord) {
+	for _, sink := range a.sinks {
+		if err := sink.Write(record); err != nil {
+			a.metricsClient.IncCounter(metrics.AuditLogScope, metrics.AuditRecordFailures)
+			a.logger.Error("Unable to write audit record.",
+				tag.NewAnyTag("audit-record", record),
+				tag.Error(err),
+			)
+		}
+	}
+}
+
+// IsAuditedAPI returns true for calls to APIs which may change state: everything except
+// read-only, worker and health check APIs.
+func IsAuditedAPI(fullMethod string) bool {
+	if strings.HasPrefix(fullMethod, healthServicePrefix) {
+		return false
+	}
+	api := ApiName(fullMethod)
+	return !IsReadOnlyNamespaceAPI(api) &&
+		!IsReadOnlyGlobalAPI(api) &&
+		!IsReadOnlyAdminAPI(api) &&
+		!IsWorkerAPI(api)
+}
+
+// isAuditLogCall returns true for calls made by the system namespace audit sink. Any client can set
+// the header, it is only trusted from callers with verified system admin claims.
+func isAuditLogCall(ctx context.Context, claims *Claims) bool {
+	if claims == nil || claims.System&RoleAdmin == 0 {
+		return false
+	}
+	return headers.GetValues(ctx, headers.AuditLogCallHeaderName)[0] != ""
+}
+
+func newAuditRecord(fullMethod string, req interface{}, claims *Claims, err error) *AuditRecord {
+	record := &AuditRecord{
+		Time:    time.Now().UTC(),
+		API:     ApiName(fullMethod),
+		Outcome: AuditOutcomeSuccess,
+	}
+	if claims != nil {
+		record.Subject = claims.Subject
+	}
+	if r, ok := req.(hasIdentity); ok {
+		record.Identity = r.GetIdentity()
+	}
+	if r, ok := req.(hasNamespace); ok {
+		record.Namespace = r.GetNamespace()
+	}
+
+	var execution *commonpb.WorkflowExecution
+	switch r := req.(type) {
+	case hasWorkflowExecution:
+		execution = r.GetWorkflowExecution()
+	case hasExecution:
+		execution = r.GetExecution()
+	case hasWorkflowID:
+		execution = &commonpb.WorkflowExecution{WorkflowId: r.GetWorkflowId()}
+	}
+	record.WorkflowID = execution.GetWorkflowId()
+	record.RunID = execution.GetRunId()
+
+	if err != nil {
+		record.Outcome = AuditOutcomeError
+		if _, ok := err.(*serviceerror.PermissionDenied); ok {
+			record.Outcome = AuditOutcomeDenied
+		}
+		record.Error = err.Error()
+	}
+	return record
+}
+
+// setAuditClaims passes the claims of the caller to the audit interceptor
+func setAuditClaims(ctx context.Context, claims *Claims) {
+	if call, ok := ctx.Value(auditCallKey).(*auditCall); ok {
+		call.claims = claims
+	}
+}
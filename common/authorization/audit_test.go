@@ -0,0 +1,199 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+type testAuditSink struct {
+	records []*AuditRecord
+}
+
+func (s *testAuditSink) Write(record *AuditRecord) error {
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *testAuditSink) Close() error {
+	return nil
+}
+
+func TestAuditInterceptor(t *testing.T) {
+	s := require.New(t)
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	sink := &testAuditSink{}
+	auditInterceptor := NewAuditInterceptor([]AuditSink{sink}, metrics.NoopClient, log.NewNoopLogger())
+
+	claimMapper := NewMockClaimMapper(controller)
+	claimMapper.EXPECT().GetClaims(gomock.Any()).DoAndReturn(func(authInfo *AuthInfo) (*Claims, error) {
+		if authInfo.AuthToken == "system-token" {
+			return &Claims{Subject: "temporal-system", System: RoleAdmin}, nil
+		}
+		return &Claims{Subject: "alice"}, nil
+	}).AnyTimes()
+	authorizer := NewMockAuthorizer(controller)
+	authzInterceptor := NewAuthorizationInterceptor(claimMapper, authorizer, metrics.NoopClient, log.NewNoopLogger(), nil, nil)
+
+	chain := func(ctx context.Context, req interface{}, fullMethod string, handlerErr error) error {
+		info := &grpc.UnaryServerInfo{FullMethod: fullMethod}
+		_, err := auditInterceptor.Intercept(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return authzInterceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, handlerErr
+			})
+		})
+		return err
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "token"))
+
+	terminateRequest := &workflowservice.TerminateWorkflowExecutionRequest{
+		Namespace:         "payments",
+		WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "rid"},
+		Identity:          "cli",
+	}
+	authorizer.EXPECT().Authorize(gomock.Any(), gomock.Any(), gomock.Any()).Return(resultAllow, nil)
+	s.NoError(chain(ctx, terminateRequest, "/temporal.api.workflowservice.v1.WorkflowService/TerminateWorkflowExecution", nil))
+	s.Len(sink.records, 1)
+	record := sink.records[0]
+	s.Equal("alice", record.Subject)
+	s.Equal("cli", record.Identity)
+	s.Equal("TerminateWorkflowExecution", record.API)
+	s.Equal("payments", record.Namespace)
+	s.Equal("wid", record.WorkflowID)
+	s.Equal("rid", record.RunID)
+	s.Equal(AuditOutcomeSuccess, record.Outcome)
+	s.Empty(record.Error)
+
+	authorizer.EXPECT().Authorize(gomock.Any(), gomock.Any(), gomock.Any()).Return(resultDeny, nil)
+	s.Error(chain(ctx, terminateRequest, "/temporal.api.workflowservice.v1.WorkflowService/TerminateWorkflowExecution", nil))
+	s.Len(sink.records, 2)
+	s.Equal("alice", sink.records[1].Subject)
+	s.Equal(AuditOutcomeDenied, sink.records[1].Outcome)
+
+	startRequest := &workflowservice.StartWorkflowExecutionRequest{Namespace: "payments", WorkflowId: "wid2"}
+	authorizer.EXPECT().Authorize(gomock.Any(), gomock.Any(), gomock.Any()).Return(resultAllow, nil)
+	s.Error(chain(ctx, startRequest, "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution", serviceerror.NewWorkflowExecutionAlreadyStarted("started", "", "")))
+	s.Len(sink.records, 3)
+	s.Equal("wid2", sink.records[2].WorkflowID)
+	s.Equal(AuditOutcomeError, sink.records[2].Outcome)
+	s.Equal("started", sink.records[2].Error)
+
+	// read-only, worker and audit log calls are not audited
+	auditLogRequest := &workflowservice.SignalWithStartWorkflowExecutionRequest{
+		Namespace:    common.SystemLocalNamespace,
+		WorkflowType: &commonpb.WorkflowType{Name: AuditLogWorkflowName},
+	}
+	auditLogCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"authorization", "system-token",
+		headers.AuditLogCallHeaderName, "true",
+	))
+	authorizer.EXPECT().Authorize(gomock.Any(), gomock.Any(), gomock.Any()).Return(resultAllow, nil).Times(3)
+	s.NoError(chain(ctx, &workflowservice.DescribeWorkflowExecutionRequest{}, "/temporal.api.workflowservice.v1.WorkflowService/DescribeWorkflowExecution", nil))
+	s.NoError(chain(ctx, &workflowservice.PollActivityTaskQueueRequest{}, "/temporal.api.workflowservice.v1.WorkflowService/PollActivityTaskQueue", nil))
+	s.NoError(chain(auditLogCtx, auditLogRequest, "/temporal.api.workflowservice.v1.WorkflowService/SignalWithStartWorkflowExecution", nil))
+	s.Len(sink.records, 3)
+
+	// the audit log workflow and header don't skip the audit of other callers
+	spoofedCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"authorization", "token",
+		headers.AuditLogCallHeaderName, "true",
+	))
+	authorizer.EXPECT().Authorize(gomock.Any(), gomock.Any(), gomock.Any()).Return(resultAllow, nil).Times(2)
+	s.NoError(chain(ctx, auditLogRequest, "/temporal.api.workflowservice.v1.WorkflowService/SignalWithStartWorkflowExecution", nil))
+	s.NoError(chain(spoofedCtx, terminateRequest, "/temporal.api.workflowservice.v1.WorkflowService/TerminateWorkflowExecution", nil))
+	s.Len(sink.records, 5)
+	s.Equal("alice", sink.records[4].Subject)
+
+	// anonymous callers are audited even if they send the header
+	anonymousCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(headers.AuditLogCallHeaderName, "true"))
+	authorizer.EXPECT().Authorize(gomock.Any(), nil, gomock.Any()).Return(resultAllow, nil).Times(2)
+	s.NoError(chain(anonymousCtx, auditLogRequest, "/temporal.api.workflowservice.v1.WorkflowService/SignalWithStartWorkflowExecution", nil))
+	s.NoError(chain(anonymousCtx, terminateRequest, "/temporal.api.workflowservice.v1.WorkflowService/TerminateWorkflowExecution", nil))
+	s.Len(sink.records, 7)
+	s.Empty(sink.records[5].Subject)
+	s.Equal("SignalWithStartWorkflowExecution", sink.records[5].API)
+	s.Empty(sink.records[6].Subject)
+	s.Equal("TerminateWorkflowExecution", sink.records[6].API)
+}
+
+func TestIsAuditedAPI(t *testing.T) {
+	s := require.New(t)
+	s.True(IsAuditedAPI("/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution"))
+	s.True(IsAuditedAPI("/temporal.api.workflowservice.v1.WorkflowService/UpdateNamespace"))
+	s.True(IsAuditedAPI("/temporal.api.operatorservice.v1.OperatorService/AddSearchAttributes"))
+	s.True(IsAuditedAPI("/temporal.server.api.adminservice.v1.AdminService/RemoveRemoteCluster"))
+	s.False(IsAuditedAPI("/temporal.api.workflowservice.v1.WorkflowService/ListNamespaces"))
+	s.False(IsAuditedAPI("/temporal.api.workflowservice.v1.WorkflowService/RespondWorkflowTaskCompleted"))
+	s.False(IsAuditedAPI("/temporal.server.api.adminservice.v1.AdminService/GetReplicationMessages"))
+	s.False(IsAuditedAPI("/grpc.health.v1.Health/Check"))
+}
+
+func TestFileAuditSink(t *testing.T) {
+	s := require.New(t)
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	sink, err := NewFileAuditSink(path)
+	s.NoError(err)
+	s.NoError(sink.Write(&AuditRecord{Subject: "alice", API: "TerminateWorkflowExecution", Outcome: AuditOutcomeSuccess}))
+	s.NoError(sink.Close())
+
+	// records are appended to the existing file
+	sink, err = NewFileAuditSink(path)
+	s.NoError(err)
+	s.NoError(sink.Write(&AuditRecord{Subject: "bob", API: "UpdateNamespace", Outcome: AuditOutcomeDenied}))
+	s.NoError(sink.Close())
+
+	file, err := os.Open(path)
+	s.NoError(err)
+	defer file.Close()
+	var subjects []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record AuditRecord
+		s.NoError(json.Unmarshal(scanner.Bytes(), &record))
+		subjects = append(subjects, record.Subject)
+	}
+	s.Equal([]string{"alice", "bob"}, subjects)
+}
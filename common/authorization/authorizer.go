@@ -32,6 +32,7 @@ import (
 	"strings"
 
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
 )
 
 const (
@@ -43,7 +44,6 @@ const (
 
 // @@@SNIPSTART temporal-common-authorization-authorizer-calltarget
 // CallTarget is contains information for Authorizer to make a decision.
-// It can be extended to include resources like WorkflowType and TaskQueue
 type CallTarget struct {
 	// APIName must be the full API function name.
 	// Example: "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution".
@@ -52,6 +52,14 @@ type CallTarget struct {
 	Namespace string
 	// Request contains a deserialized copy of the API request object
 	Request interface{}
+	// WorkflowType is set if the request carries a workflow type, otherwise it is an empty string.
+	WorkflowType string
+	// TaskQueue is set if the request carries a task queue, otherwise it is an empty string.
+	TaskQueue string
+
+	// workflowTypeResolver looks up the workflow type of the execution referenced by the request.
+	// It is nil if the request doesn't reference an execution or no resolver is configured.
+	workflowTypeResolver func(ctx context.Context) (string, error)
 }
 
 // @@@SNIPEND
@@ -80,13 +88,15 @@ type hasNamespace interface {
 	GetNamespace() string
 }
 
-func GetAuthorizerFromConfig(config *config.Authorization) (Authorizer, error) {
+func GetAuthorizerFromConfig(config *config.Authorization, logger log.Logger) (Authorizer, error) {
 
 	switch strings.ToLower(config.Authorizer) {
 	case "":
 		return NewNoopAuthorizer(), nil
 	case "default":
 		return NewDefaultAuthorizer(), nil
+	case "policy":
+		return NewPolicyAuthorizer(&config.Policy, logger)
 	}
 	return nil, fmt.Errorf("unknown authorizer: %s", config.Authorizer)
 }
@@ -45,6 +45,8 @@ type AuthInfo struct {
 	TLSConnection *credentials.TLSInfo
 	ExtraData     string
 	Audience      string
+	// Namespace targeted by the request, empty if the request doesn't target a namespace
+	Namespace string
 }
 
 // @@@SNIPEND
@@ -72,11 +74,35 @@ func (*noopClaimMapper) GetClaims(_ *AuthInfo) (*Claims, error) {
 
 func GetClaimMapperFromConfig(config *config.Authorization, logger log.Logger) (ClaimMapper, error) {
 
-	switch strings.ToLower(config.ClaimMapper) {
+	names := strings.Split(config.ClaimMapper, ",")
+	if len(names) == 1 {
+		return getClaimMapper(strings.TrimSpace(names[0]), config, logger)
+	}
+
+	var mappers []ClaimMapper
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return nil, fmt.Errorf("empty claim mapper name in: %s", config.ClaimMapper)
+		}
+		mapper, err := getClaimMapper(name, config, logger)
+		if err != nil {
+			return nil, err
+		}
+		mappers = append(mappers, mapper)
+	}
+	return NewCompositeClaimMapper(mappers...), nil
+}
+
+func getClaimMapper(name string, config *config.Authorization, logger log.Logger) (ClaimMapper, error) {
+
+	switch strings.ToLower(name) {
 	case "":
 		return NewNoopClaimMapper(), nil
 	case "default":
 		return NewDefaultJWTClaimMapper(NewDefaultTokenKeyProvider(config, logger), config, logger), nil
+	case "tls":
+		return NewTLSClaimMapper(&config.TLSClaimMapper)
 	}
-	return nil, fmt.Errorf("unknown claim mapper: %s", config.ClaimMapper)
+	return nil, fmt.Errorf("unknown claim mapper: %s", name)
 }
@@ -0,0 +1,69 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+type (
+	// compositeClaimMapper combines the claims of several claim mappers, e.g. JWT and TLS,
+	// so that a caller may authenticate with either credential type or both.
+	compositeClaimMapper struct {
+		mappers []ClaimMapper
+	}
+)
+
+var _ ClaimMapper = (*compositeClaimMapper)(nil)
+
+// NewCompositeClaimMapper creates a claim mapper which merges the claims of mappers.
+// Roles are combined, the subject is taken from the first mapper which returns one.
+// An error from any of the mappers fails the whole mapping.
+func NewCompositeClaimMapper(mappers ...ClaimMapper) ClaimMapper {
+	return &compositeClaimMapper{mappers: mappers}
+}
+
+func (c *compositeClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
+	claims := Claims{}
+	for _, mapper := range c.mappers {
+		mapped, err := mapper.GetClaims(authInfo)
+		if err != nil {
+			return nil, err
+		}
+		if mapped == nil {
+			continue
+		}
+		if claims.Subject == "" {
+			claims.Subject = mapped.Subject
+		}
+		if claims.Extensions == nil {
+			claims.Extensions = mapped.Extensions
+		}
+		claims.System |= mapped.System
+		for namespace, role := range mapped.Namespaces {
+			if claims.Namespaces == nil {
+				claims.Namespaces = make(map[string]Role)
+			}
+			claims.Namespaces[namespace] |= role
+		}
+	}
+	return &claims, nil
+}
@@ -45,6 +45,7 @@ const (
 	permissionWrite             = "write"
 	permissionWorker            = "worker"
 	permissionAdmin             = "admin"
+	claimScope                  = "scope"
 )
 
 // Default claim mapper that gives system level admin permission to everybody
@@ -52,6 +53,13 @@ type defaultJWTClaimMapper struct {
 	keyProvider          TokenKeyProvider
 	logger               log.Logger
 	permissionsClaimName string
+	validation           config.TokenValidation
+	introspector         *tokenIntrospector
+}
+
+// oidcProvider is implemented by token key providers which use OIDC discovery
+type oidcProvider interface {
+	discoveredOIDC() *oidcConfiguration
 }
 
 func NewDefaultJWTClaimMapper(provider TokenKeyProvider, cfg *config.Authorization, logger log.Logger) ClaimMapper {
@@ -59,7 +67,16 @@ func NewDefaultJWTClaimMapper(provider TokenKeyProvider, cfg *config.Authorizati
 	if claimName == "" {
 		claimName = defaultPermissionsClaimName
 	}
-	return &defaultJWTClaimMapper{keyProvider: provider, logger: logger, permissionsClaimName: claimName}
+	mapper := &defaultJWTClaimMapper{
+		keyProvider:          provider,
+		logger:               logger,
+		permissionsClaimName: claimName,
+		validation:           cfg.TokenValidation,
+	}
+	if cfg.TokenIntrospection.Enabled {
+		mapper.introspector = newTokenIntrospector(&cfg.TokenIntrospection, cfg.JWTKeyProvider.OIDCIssuerURL)
+	}
+	return mapper
 }
 
 var _ ClaimMapper = (*defaultJWTClaimMapper)(nil)
@@ -79,17 +96,30 @@ func (a *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
 	if !strings.EqualFold(parts[0], authorizationBearer) {
 		return nil, serviceerror.NewPermissionDenied("unexpected name in authorization token", "")
 	}
-	jwtClaims, err := parseJWTWithAudience(parts[1], a.keyProvider, authInfo.Audience)
+	var tokenClaims jwt.MapClaims
+	var err error
+	if a.introspector != nil && !isJWT(parts[1]) {
+		tokenClaims, err = a.introspector.introspect(parts[1])
+	} else {
+		tokenClaims, err = parseJWTWithAudience(parts[1], a.keyProvider, authInfo.Audience)
+	}
 	if err != nil {
 		return nil, err
 	}
-	subject, ok := jwtClaims[headerSubject].(string)
+	if err := a.validateIssuerAndAudience(tokenClaims, authInfo); err != nil {
+		return nil, err
+	}
+	subject, ok := tokenClaims[headerSubject].(string)
 	if !ok {
 		return nil, serviceerror.NewPermissionDenied("unexpected value type of \"sub\" claim", "")
 	}
 	claims.Subject = subject
-	permissions, ok := jwtClaims[a.permissionsClaimName].([]interface{})
-	if ok {
+	permissions, ok := tokenClaims[a.permissionsClaimName].([]interface{})
+	if !ok && a.introspector != nil {
+		// introspection responses commonly carry permissions as space separated scopes
+		permissions = scopePermissions(tokenClaims)
+	}
+	if len(permissions) > 0 {
 		err := a.extractPermissions(permissions, &claims)
 		if err != nil {
 			return nil, err
@@ -98,6 +128,55 @@ func (a *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
 	return &claims, nil
 }
 
+// validateIssuerAndAudience checks the issuer and audience required for the namespace of the request
+func (a *defaultJWTClaimMapper) validateIssuerAndAudience(tokenClaims jwt.MapClaims, authInfo *AuthInfo) error {
+	issuer := a.validation.Issuer
+	audience := a.validation.Audience
+	if namespaceValidation, ok := a.validation.Namespaces[authInfo.Namespace]; ok && authInfo.Namespace != "" {
+		if namespaceValidation.Issuer != "" {
+			issuer = namespaceValidation.Issuer
+		}
+		if namespaceValidation.Audience != "" {
+			audience = namespaceValidation.Audience
+		}
+	}
+	if issuer == "" {
+		if provider, ok := a.keyProvider.(oidcProvider); ok {
+			if oidc := provider.discoveredOIDC(); oidc != nil {
+				issuer = oidc.Issuer
+			}
+		}
+	}
+	if strings.TrimSpace(authInfo.Audience) != "" {
+		audience = authInfo.Audience
+	}
+
+	if issuer != "" && !tokenClaims.VerifyIssuer(issuer, true) {
+		return serviceerror.NewPermissionDenied("issuer mismatch", "")
+	}
+	if audience != "" && !tokenClaims.VerifyAudience(audience, true) {
+		return serviceerror.NewPermissionDenied("audience mismatch", "")
+	}
+	return nil
+}
+
+// scopePermissions returns the scopes of the token which are in the permission format
+func scopePermissions(tokenClaims jwt.MapClaims) []interface{} {
+	scope, _ := tokenClaims[claimScope].(string)
+	var permissions []interface{}
+	for _, s := range strings.Fields(scope) {
+		if strings.Contains(s, ":") {
+			permissions = append(permissions, s)
+		}
+	}
+	return permissions
+}
+
+// isJWT returns true if the token has the three parts of a JWS compact serialization
+func isJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
+
 func (a *defaultJWTClaimMapper) extractPermissions(permissions []interface{}, claims *Claims) error {
 	for _, permission := range permissions {
 		p, ok := permission.(string)
@@ -153,6 +153,7 @@ func (s *defaultClaimMapperSuite) testTokenWithAdminPermissions(alg keyAlgorithm
 		nil,
 		"",
 		"",
+		"",
 	}
 	claims, err := s.claimMapper.GetClaims(authInfo)
 	s.NoError(err)
@@ -179,6 +180,7 @@ func (s *defaultClaimMapperSuite) testTokenWithReaderWriterWorkerPermissions(alg
 		nil,
 		"",
 		"test-audience",
+		"",
 	}
 	claims, err := s.claimMapper.GetClaims(authInfo)
 	s.NoError(err)
@@ -208,6 +210,7 @@ func (s *defaultClaimMapperSuite) TestWrongAudience() {
 		nil,
 		"",
 		"foo",
+		"",
 	}
 	_, err = s.claimMapper.GetClaims(authInfo)
 	s.Error(err)
@@ -222,6 +225,7 @@ func (s *defaultClaimMapperSuite) TestCorrectAudience() {
 		nil,
 		"",
 		"test-audience",
+		"",
 	}
 	_, err = s.claimMapper.GetClaims(authInfo)
 	s.NoError(err)
@@ -236,6 +240,7 @@ func (s *defaultClaimMapperSuite) TestIgnoreAudience() {
 		nil,
 		"",
 		"",
+		"",
 	}
 	_, err = s.claimMapper.GetClaims(authInfo)
 	s.NoError(err)
@@ -44,19 +44,21 @@ import (
 
 // Default token key provider
 type defaultTokenKeyProvider struct {
-	config   config.JWTKeyProvider
-	rsaKeys  map[string]*rsa.PublicKey
-	ecKeys   map[string]*ecdsa.PublicKey
-	keysLock sync.RWMutex
-	ticker   *time.Ticker
-	logger   log.Logger
-	stop     chan bool
+	config     config.JWTKeyProvider
+	rsaKeys    map[string]*rsa.PublicKey
+	ecKeys     map[string]*ecdsa.PublicKey
+	oidc       *oidcConfiguration
+	keysLock   sync.RWMutex
+	ticker     *time.Ticker
+	logger     log.Logger
+	stop       chan bool
+	httpClient *http.Client
 }
 
 var _ TokenKeyProvider = (*defaultTokenKeyProvider)(nil)
 
 func NewDefaultTokenKeyProvider(cfg *config.Authorization, logger log.Logger) *defaultTokenKeyProvider {
-	provider := defaultTokenKeyProvider{config: cfg.JWTKeyProvider, logger: logger}
+	provider := defaultTokenKeyProvider{config: cfg.JWTKeyProvider, logger: logger, httpClient: newOIDCHTTPClient()}
 	provider.initialize()
 	return &provider
 }
@@ -139,7 +141,18 @@ func (a *defaultTokenKeyProvider) updateKeys() error {
 	rsaKeys := make(map[string]*rsa.PublicKey)
 	ecKeys := make(map[string]*ecdsa.PublicKey)
 
-	for _, uri := range a.config.KeySourceURIs {
+	uris := a.config.KeySourceURIs
+	var oidc *oidcConfiguration
+	if strings.TrimSpace(a.config.OIDCIssuerURL) != "" {
+		var err error
+		oidc, err = discoverOIDC(a.httpClient, a.config.OIDCIssuerURL)
+		if err != nil {
+			return err
+		}
+		uris = append([]string{oidc.JWKSURI}, uris...)
+	}
+
+	for _, uri := range uris {
 		if strings.TrimSpace(uri) == "" {
 			continue
 		}
@@ -152,6 +165,9 @@ func (a *defaultTokenKeyProvider) updateKeys() error {
 	a.keysLock.Lock()
 	a.rsaKeys = rsaKeys
 	a.ecKeys = ecKeys
+	if oidc != nil {
+		a.oidc = oidc
+	}
 	a.keysLock.Unlock()
 	return nil
 }
@@ -162,11 +178,14 @@ func (a *defaultTokenKeyProvider) updateKeysFromURI(
 	ecKeys map[string]*ecdsa.PublicKey,
 ) error {
 
-	resp, err := http.Get(uri)
+	resp, err := a.httpClient.Get(uri)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("token keys at %s returned status %d", uri, resp.StatusCode)
+	}
 
 	jwks := jose.JSONWebKeySet{}
 	err = json.NewDecoder(resp.Body).Decode(&jwks)
@@ -187,6 +206,13 @@ func (a *defaultTokenKeyProvider) updateKeysFromURI(
 	return nil
 }
 
+// discoveredOIDC returns the OIDC configuration found at the last refresh, nil if OIDC discovery is not configured
+func (a *defaultTokenKeyProvider) discoveredOIDC() *oidcConfiguration {
+	a.keysLock.RLock()
+	defer a.keysLock.RUnlock()
+	return a.oidc
+}
+
 func (a *defaultTokenKeyProvider) HmacKey(alg string, kid string) ([]byte, error) {
 	return nil, fmt.Errorf("unsupported key type HMAC for: %s", alg)
 }
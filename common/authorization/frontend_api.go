@@ -55,3 +55,59 @@ func IsReadOnlyGlobalAPI(api string) bool {
 	_, found := readOnlyGlobalAPI[api]
 	return found
 }
+
+// workerAPI are the APIs called by workers while processing tasks
+var workerAPI = map[string]struct{}{
+	"PollWorkflowTaskQueue":            {},
+	"PollActivityTaskQueue":            {},
+	"RespondWorkflowTaskCompleted":     {},
+	"RespondWorkflowTaskFailed":        {},
+	"RespondQueryTaskCompleted":        {},
+	"RecordActivityTaskHeartbeat":      {},
+	"RecordActivityTaskHeartbeatById":  {},
+	"RespondActivityTaskCompleted":     {},
+	"RespondActivityTaskCompletedById": {},
+	"RespondActivityTaskFailed":        {},
+	"RespondActivityTaskFailedById":    {},
+	"RespondActivityTaskCanceled":      {},
+	"RespondActivityTaskCanceledById":  {},
+	"ResetStickyTaskQueue":             {},
+}
+
+// readOnlyAdminAPI are the admin and operator APIs which don't change any state,
+// including the ones used by replication to read from the source cluster
+var readOnlyAdminAPI = map[string]struct{}{
+	"DescribeMutableState":               {},
+	"DescribeHistoryHost":                {},
+	"GetShard":                           {},
+	"ListHistoryTasks":                   {},
+	"GetWorkflowExecutionRawHistoryV2":   {},
+	"GetReplicationMessages":             {},
+	"StreamReplicationMessages":          {},
+	"GetNamespaceReplicationMessages":    {},
+	"GetDLQReplicationMessages":          {},
+	"GetSearchAttributes":                {},
+	"ListSearchAttributes":               {},
+	"DescribeCluster":                    {},
+	"ListClusters":                       {},
+	"ListClusterMembers":                 {},
+	"ListClusterMetadataHistory":         {},
+	"GetDLQMessages":                     {},
+	"GetReplicationLag":                  {},
+	"DescribeNamespaceHandover":          {},
+	"VerifyWorkflowReplication":          {},
+	"GetTaskQueueTasks":                  {},
+	"GetWorkflowExecutionHistoryReverse": {},
+	"ListApiKeys":                        {},
+	"ListPollerCounts":                   {},
+}
+
+func IsWorkerAPI(api string) bool {
+	_, found := workerAPI[api]
+	return found
+}
+
+func IsReadOnlyAdminAPI(api string) bool {
+	_, found := readOnlyAdminAPI[api]
+	return found
+}
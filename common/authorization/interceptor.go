@@ -29,7 +29,9 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 
+	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
@@ -50,6 +52,28 @@ type (
 	JWTAudienceMapper interface {
 		Audience(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo) string
 	}
+
+	// WorkflowTypeResolver returns the workflow type of an existing workflow execution.
+	// It lets authorizers check requests which reference an execution without carrying its type.
+	WorkflowTypeResolver interface {
+		WorkflowType(ctx context.Context, namespace string, execution *commonpb.WorkflowExecution) (string, error)
+	}
+
+	hasWorkflowType interface {
+		GetWorkflowType() *commonpb.WorkflowType
+	}
+
+	hasTaskQueue interface {
+		GetTaskQueue() *taskqueuepb.TaskQueue
+	}
+
+	hasWorkflowExecution interface {
+		GetWorkflowExecution() *commonpb.WorkflowExecution
+	}
+
+	hasExecution interface {
+		GetExecution() *commonpb.WorkflowExecution
+	}
 )
 
 const (
@@ -70,8 +94,42 @@ func (a *interceptor) Interceptor(
 	handler grpc.UnaryHandler,
 ) (interface{}, error) {
 
+	ctx, err := a.authorizeRequest(ctx, req, info)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor authorizes a stream when it is opened, there is no request message at that time.
+func (a *interceptor) StreamInterceptor(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+
+	ctx, err := a.authorizeRequest(stream.Context(), nil, &grpc.UnaryServerInfo{Server: srv, FullMethod: info.FullMethod})
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authorizedServerStream{ServerStream: stream, ctx: ctx})
+}
+
+// authorizeRequest returns ctx with mapped claims if the request is allowed.
+func (a *interceptor) authorizeRequest(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+) (context.Context, error) {
+
 	var claims *Claims
 
+	var namespace string
+	if requestWithNamespace, ok := req.(hasNamespace); ok {
+		namespace = requestWithNamespace.GetNamespace()
+	}
+
 	if a.claimMapper != nil && a.authorizer != nil {
 		var tlsSubject *pkix.Name
 		var authHeaders []string
@@ -108,6 +166,7 @@ func (a *interceptor) Interceptor(
 				TLSConnection: tlsConnection,
 				ExtraData:     authExtraHeader,
 				Audience:      audience,
+				Namespace:     namespace,
 			}
 			mappedClaims, err := a.claimMapper.GetClaims(&authInfo)
 			if err != nil {
@@ -115,6 +174,7 @@ func (a *interceptor) Interceptor(
 				return nil, errUnauthorized // return a generic error to the caller without disclosing details
 			}
 			claims = mappedClaims
+			setAuditClaims(ctx, mappedClaims)
 			ctx = context.WithValue(ctx, MappedClaims, mappedClaims)
 			if authHeader != "" {
 				ctx = context.WithValue(ctx, AuthHeader, authHeader)
@@ -123,18 +183,8 @@ func (a *interceptor) Interceptor(
 	}
 
 	if a.authorizer != nil {
-		var namespace string
-		requestWithNamespace, ok := req.(hasNamespace)
-		if ok {
-			namespace = requestWithNamespace.GetNamespace()
-		}
-
 		scope := a.getMetricsScope(metrics.AuthorizationScope, namespace)
-		result, err := a.authorize(ctx, claims, &CallTarget{
-			Namespace: namespace,
-			APIName:   info.FullMethod,
-			Request:   req,
-		}, scope)
+		result, err := a.authorize(ctx, claims, a.newCallTarget(namespace, info.FullMethod, req), scope)
 		if err != nil {
 			scope.IncCounter(metrics.ServiceErrAuthorizeFailedCounter)
 			a.logAuthError(err)
@@ -149,7 +199,38 @@ func (a *interceptor) Interceptor(
 			return nil, errUnauthorized // return a generic error to the caller without disclosing details
 		}
 	}
-	return handler(ctx, req)
+	return ctx, nil
+}
+
+func (a *interceptor) newCallTarget(namespace string, apiName string, req interface{}) *CallTarget {
+	target := &CallTarget{
+		Namespace: namespace,
+		APIName:   apiName,
+		Request:   req,
+	}
+	if r, ok := req.(hasWorkflowType); ok {
+		target.WorkflowType = r.GetWorkflowType().GetName()
+	}
+	if r, ok := req.(hasTaskQueue); ok {
+		target.TaskQueue = r.GetTaskQueue().GetName()
+	}
+
+	if target.WorkflowType != "" || a.workflowTypeResolver == nil || namespace == "" {
+		return target
+	}
+	var execution *commonpb.WorkflowExecution
+	switch r := req.(type) {
+	case hasWorkflowExecution:
+		execution = r.GetWorkflowExecution()
+	case hasExecution:
+		execution = r.GetExecution()
+	}
+	if execution.GetWorkflowId() != "" {
+		target.workflowTypeResolver = func(ctx context.Context) (string, error) {
+			return a.workflowTypeResolver.WorkflowType(ctx, namespace, execution)
+		}
+	}
+	return target
 }
 
 func (a *interceptor) authorize(ctx context.Context, claims *Claims, callTarget *CallTarget, scope metrics.Scope) (Result, error) {
@@ -162,12 +243,22 @@ func (a *interceptor) logAuthError(err error) {
 	a.logger.Error("Authorization error", tag.Error(err))
 }
 
+type authorizedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedServerStream) Context() context.Context {
+	return s.ctx
+}
+
 type interceptor struct {
-	authorizer     Authorizer
-	claimMapper    ClaimMapper
-	metricsClient  metrics.Client
-	logger         log.Logger
-	audienceGetter JWTAudienceMapper
+	authorizer           Authorizer
+	claimMapper          ClaimMapper
+	metricsClient        metrics.Client
+	logger               log.Logger
+	audienceGetter       JWTAudienceMapper
+	workflowTypeResolver WorkflowTypeResolver
 }
 
 // NewAuthorizationInterceptor creates an authorization interceptor and return a func that points to its Interceptor method
@@ -177,16 +268,37 @@ func NewAuthorizationInterceptor(
 	metrics metrics.Client,
 	logger log.Logger,
 	audienceGetter JWTAudienceMapper,
+	workflowTypeResolver WorkflowTypeResolver,
 ) grpc.UnaryServerInterceptor {
 	return (&interceptor{
-		claimMapper:    claimMapper,
-		authorizer:     authorizer,
-		metricsClient:  metrics,
-		logger:         logger,
-		audienceGetter: audienceGetter,
+		claimMapper:          claimMapper,
+		authorizer:           authorizer,
+		metricsClient:        metrics,
+		logger:               logger,
+		audienceGetter:       audienceGetter,
+		workflowTypeResolver: workflowTypeResolver,
 	}).Interceptor
 }
 
+// NewAuthorizationStreamInterceptor creates an authorization interceptor for streaming RPCs
+func NewAuthorizationStreamInterceptor(
+	claimMapper ClaimMapper,
+	authorizer Authorizer,
+	metrics metrics.Client,
+	logger log.Logger,
+	audienceGetter JWTAudienceMapper,
+	workflowTypeResolver WorkflowTypeResolver,
+) grpc.StreamServerInterceptor {
+	return (&interceptor{
+		claimMapper:          claimMapper,
+		authorizer:           authorizer,
+		metricsClient:        metrics,
+		logger:               logger,
+		audienceGetter:       audienceGetter,
+		workflowTypeResolver: workflowTypeResolver,
+	}).StreamInterceptor
+}
+
 // getMetricsScope return metrics scope with namespace tag
 func (a *interceptor) getMetricsScope(
 	scope int,
@@ -31,6 +31,8 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/api/workflowservicemock/v1"
 	"google.golang.org/grpc"
@@ -94,6 +96,7 @@ func (s *authorizerInterceptorSuite) SetupTest() {
 		s.mockAuthorizer,
 		s.mockMetricsClient,
 		log.NewNoopLogger(),
+		nil,
 		nil)
 	s.handler = func(ctx context.Context, req interface{}) (interface{}, error) { return true, nil }
 }
@@ -139,3 +142,83 @@ func (s *authorizerInterceptorSuite) TestAuthorizationFailed() {
 	s.Nil(res)
 	s.Error(err)
 }
+
+func TestNewCallTarget(t *testing.T) {
+	s := require.New(t)
+	a := &interceptor{}
+	target := a.newCallTarget(testNamespace, startWorkflowExecutionInfo.FullMethod, &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:    testNamespace,
+		WorkflowType: &commonpb.WorkflowType{Name: "wf-type"},
+		TaskQueue:    &taskqueuepb.TaskQueue{Name: "task-queue"},
+	})
+	s.Equal("wf-type", target.WorkflowType)
+	s.Equal("task-queue", target.TaskQueue)
+	s.Nil(target.workflowTypeResolver)
+
+	signalRequest := &workflowservice.SignalWorkflowExecutionRequest{
+		Namespace:         testNamespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "wid"},
+	}
+	target = a.newCallTarget(testNamespace, "SignalWorkflowExecution", signalRequest)
+	s.Nil(target.workflowTypeResolver)
+
+	a.workflowTypeResolver = testWorkflowTypeResolver{}
+	target = a.newCallTarget(testNamespace, "SignalWorkflowExecution", signalRequest)
+	s.NotNil(target.workflowTypeResolver)
+	workflowType, err := target.workflowTypeResolver(ctx)
+	s.NoError(err)
+	s.Equal(testNamespace+"/wid", workflowType)
+}
+
+type testWorkflowTypeResolver struct{}
+
+func (testWorkflowTypeResolver) WorkflowType(_ context.Context, namespace string, execution *commonpb.WorkflowExecution) (string, error) {
+	return namespace + "/" + execution.GetWorkflowId(), nil
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockAuthorizer := NewMockAuthorizer(controller)
+	mockMetricsClient := metrics.NewMockClient(controller)
+	mockMetricsScope := metrics.NewMockScope(controller)
+	mockStopwatch := metrics.NewMockStopwatch(controller)
+	mockStopwatch.EXPECT().Stop().AnyTimes()
+	mockMetricsClient.EXPECT().Scope(metrics.AuthorizationScope).Return(mockMetricsScope).AnyTimes()
+	mockMetricsScope.EXPECT().Tagged(metrics.NamespaceUnknownTag()).Return(mockMetricsScope).AnyTimes()
+	mockMetricsScope.EXPECT().StartTimer(metrics.ServiceAuthorizationLatency).Return(mockStopwatch).AnyTimes()
+
+	interceptor := NewAuthorizationStreamInterceptor(
+		NewMockClaimMapper(controller),
+		mockAuthorizer,
+		mockMetricsClient,
+		log.NewNoopLogger(),
+		nil,
+		nil)
+	streamInfo := &grpc.StreamServerInfo{FullMethod: "/temporal.server.api.adminservice.v1.AdminService/StreamReplicationMessages"}
+	streamTarget := &CallTarget{APIName: streamInfo.FullMethod}
+	handlerCalled := false
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		handlerCalled = true
+		return nil
+	}
+
+	mockAuthorizer.EXPECT().Authorize(ctx, nil, streamTarget).Return(Result{Decision: DecisionAllow}, nil)
+	require.NoError(t, interceptor(nil, &testServerStream{}, streamInfo, handler))
+	require.True(t, handlerCalled)
+
+	handlerCalled = false
+	mockAuthorizer.EXPECT().Authorize(ctx, nil, streamTarget).Return(Result{Decision: DecisionDeny}, nil)
+	mockMetricsScope.EXPECT().IncCounter(metrics.ServiceErrUnauthorizedCounter)
+	require.Error(t, interceptor(nil, &testServerStream{}, streamInfo, handler))
+	require.False(t, handlerCalled)
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *testServerStream) Context() context.Context {
+	return ctx
+}
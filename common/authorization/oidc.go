@@ -0,0 +1,73 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	oidcDiscoveryPath = "/.well-known/openid-configuration"
+	oidcHTTPTimeout   = 10 * time.Second
+)
+
+type (
+	// oidcConfiguration is the part of the OpenID provider metadata used for token validation
+	oidcConfiguration struct {
+		Issuer                string `json:"issuer"`
+		JWKSURI               string `json:"jwks_uri"`
+		IntrospectionEndpoint string `json:"introspection_endpoint"`
+	}
+)
+
+func newOIDCHTTPClient() *http.Client {
+	return &http.Client{Timeout: oidcHTTPTimeout}
+}
+
+// discoverOIDC reads the OpenID provider metadata of the issuer
+func discoverOIDC(client *http.Client, issuerURL string) (*oidcConfiguration, error) {
+	uri := strings.TrimSuffix(strings.TrimSpace(issuerURL), "/") + oidcDiscoveryPath
+	resp, err := client.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OIDC discovery at %s returned status %d", uri, resp.StatusCode)
+	}
+
+	configuration := &oidcConfiguration{}
+	if err := json.NewDecoder(resp.Body).Decode(configuration); err != nil {
+		return nil, fmt.Errorf("unable to decode OIDC configuration from %s: %w", uri, err)
+	}
+	if configuration.JWKSURI == "" {
+		return nil, fmt.Errorf("OIDC configuration from %s has no jwks_uri", uri)
+	}
+	return configuration, nil
+}
@@ -0,0 +1,255 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/square/go-jose.v2"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+const (
+	testIntrospectionClientID     = "temporal"
+	testIntrospectionClientSecret = "secret"
+	testActiveToken               = "active-opaque-token"
+)
+
+type (
+	oidcSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		tokenGenerator     *tokenGenerator
+		server             *httptest.Server
+		introspectionCalls int32
+	}
+)
+
+func TestOIDCSuite(t *testing.T) {
+	s := new(oidcSuite)
+	suite.Run(t, s)
+}
+
+func (s *oidcSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.tokenGenerator = newTokenGenerator()
+	s.introspectionCalls = 0
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(oidcDiscoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 "test",
+			"jwks_uri":               s.server.URL + "/keys",
+			"introspection_endpoint": s.server.URL + "/introspect",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: s.tokenGenerator.rsaPublicKey, KeyID: "test-key", Algorithm: "RS256", Use: "sig"},
+			{Key: s.tokenGenerator.ecdsaPublicKey, KeyID: "test-key", Algorithm: "ES256", Use: "sig"},
+		}})
+	})
+	mux.HandleFunc("/introspect", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&s.introspectionCalls, 1)
+		clientID, clientSecret, ok := r.BasicAuth()
+		if !ok || clientID != testIntrospectionClientID || clientSecret != testIntrospectionClientSecret {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.PostFormValue("token") != testActiveToken {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"active": false})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"active": true,
+			"sub":    testSubject,
+			"iss":    "test",
+			"aud":    "test-audience",
+			"exp":    time.Now().Add(time.Hour).Unix(),
+			"scope":  "openid system:admin default:read",
+		})
+	})
+	s.server = httptest.NewServer(mux)
+}
+
+func (s *oidcSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *oidcSuite) newClaimMapper(cfg *config.Authorization) ClaimMapper {
+	cfg.JWTKeyProvider.OIDCIssuerURL = s.server.URL
+	provider := NewDefaultTokenKeyProvider(cfg, log.NewNoopLogger())
+	return NewDefaultJWTClaimMapper(provider, cfg, log.NewNoopLogger())
+}
+
+func (s *oidcSuite) newIntrospectionConfig() *config.Authorization {
+	return &config.Authorization{
+		TokenIntrospection: config.TokenIntrospection{
+			Enabled:      true,
+			ClientID:     testIntrospectionClientID,
+			ClientSecret: testIntrospectionClientSecret,
+		},
+	}
+}
+
+func (s *oidcSuite) TestDiscovery() {
+	oidc, err := discoverOIDC(newOIDCHTTPClient(), s.server.URL+"/")
+	s.NoError(err)
+	s.Equal("test", oidc.Issuer)
+	s.Equal(s.server.URL+"/keys", oidc.JWKSURI)
+	s.Equal(s.server.URL+"/introspect", oidc.IntrospectionEndpoint)
+
+	_, err = discoverOIDC(newOIDCHTTPClient(), s.server.URL+"/unknown")
+	s.Error(err)
+}
+
+func (s *oidcSuite) TestJWTWithDiscoveredKeys() {
+	claimMapper := s.newClaimMapper(&config.Authorization{})
+	for _, alg := range []keyAlgorithm{RSA, ECDSA} {
+		tokenString, err := s.tokenGenerator.generateToken(alg, testSubject, permissionsAdmin, errorTestOptionNoError)
+		s.NoError(err)
+		claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(tokenString)})
+		s.NoError(err)
+		s.Equal(testSubject, claims.Subject)
+		s.Equal(RoleAdmin, claims.System)
+	}
+}
+
+func (s *oidcSuite) TestDiscoveredIssuerMismatch() {
+	claimMapper := NewDefaultJWTClaimMapper(
+		&discoveredTokenGenerator{tokenGenerator: s.tokenGenerator, oidc: &oidcConfiguration{Issuer: "other"}},
+		&config.Authorization{},
+		log.NewNoopLogger(),
+	)
+	tokenString, err := s.tokenGenerator.generateRSAToken(testSubject, permissionsAdmin, errorTestOptionNoError)
+	s.NoError(err)
+	_, err = claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(tokenString)})
+	s.Error(err)
+}
+
+func (s *oidcSuite) TestIssuerAndAudiencePerNamespace() {
+	claimMapper := s.newClaimMapper(&config.Authorization{
+		TokenValidation: config.TokenValidation{
+			Issuer:   "test",
+			Audience: "test-audience",
+			Namespaces: map[string]config.NamespaceTokenValidation{
+				"other-issuer":   {Issuer: "other"},
+				"other-audience": {Audience: "other-audience"},
+			},
+		},
+	})
+	tokenString, err := s.tokenGenerator.generateRSAToken(testSubject, permissionsAdmin, errorTestOptionNoError)
+	s.NoError(err)
+
+	testCases := []struct {
+		namespace string
+		valid     bool
+	}{
+		{"", true},
+		{defaultNamespace, true},
+		{"other-issuer", false},
+		{"other-audience", false},
+	}
+	for _, tc := range testCases {
+		_, err := claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(tokenString), Namespace: tc.namespace})
+		if tc.valid {
+			s.NoError(err, tc.namespace)
+		} else {
+			s.Error(err, tc.namespace)
+		}
+	}
+}
+
+func (s *oidcSuite) TestIntrospectionActiveToken() {
+	claimMapper := s.newClaimMapper(s.newIntrospectionConfig())
+	for i := 0; i < 3; i++ {
+		claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(testActiveToken)})
+		s.NoError(err)
+		s.Equal(testSubject, claims.Subject)
+		s.Equal(RoleAdmin, claims.System)
+		s.Equal(RoleReader, claims.Namespaces[defaultNamespace])
+	}
+	s.Equal(int32(1), atomic.LoadInt32(&s.introspectionCalls))
+}
+
+func (s *oidcSuite) TestIntrospectionInactiveToken() {
+	claimMapper := s.newClaimMapper(s.newIntrospectionConfig())
+	for i := 0; i < 2; i++ {
+		_, err := claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer("revoked-token")})
+		s.ErrorIs(err, errTokenNotActive)
+	}
+	s.Equal(int32(1), atomic.LoadInt32(&s.introspectionCalls))
+}
+
+func (s *oidcSuite) TestIntrospectionAudienceMismatch() {
+	cfg := s.newIntrospectionConfig()
+	cfg.TokenValidation.Audience = "other-audience"
+	claimMapper := s.newClaimMapper(cfg)
+	_, err := claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(testActiveToken)})
+	s.Error(err)
+}
+
+func (s *oidcSuite) TestIntrospectionWrongCredentials() {
+	cfg := s.newIntrospectionConfig()
+	cfg.TokenIntrospection.ClientSecret = "wrong"
+	claimMapper := s.newClaimMapper(cfg)
+	_, err := claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(testActiveToken)})
+	s.Error(err)
+}
+
+func (s *oidcSuite) TestIntrospectionCacheExpiration() {
+	introspector := newTokenIntrospector(&config.TokenIntrospection{
+		Enabled:      true,
+		Endpoint:     s.server.URL + "/introspect",
+		ClientID:     testIntrospectionClientID,
+		ClientSecret: testIntrospectionClientSecret,
+		CacheTTL:     time.Millisecond,
+	}, "")
+	_, err := introspector.introspect(testActiveToken)
+	s.NoError(err)
+	time.Sleep(5 * time.Millisecond)
+	_, err = introspector.introspect(testActiveToken)
+	s.NoError(err)
+	s.Equal(int32(2), atomic.LoadInt32(&s.introspectionCalls))
+}
+
+type discoveredTokenGenerator struct {
+	*tokenGenerator
+	oidc *oidcConfiguration
+}
+
+func (tg *discoveredTokenGenerator) discoveredOIDC() *oidcConfiguration {
+	return tg.oidc
+}
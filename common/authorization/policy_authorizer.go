@@ -0,0 +1,434 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"gopkg.in/yaml.v3"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	// PolicyEffectAllow allows requests matched by a policy rule
+	PolicyEffectAllow = "allow"
+	// PolicyEffectDeny denies requests matched by a policy rule
+	PolicyEffectDeny = "deny"
+	// PolicyEffectRoles delegates requests to the role based default authorizer,
+	// it is only valid as the policy default decision
+	PolicyEffectRoles = "roles"
+)
+
+type (
+	// Policy is a declarative authorization policy. Rules are evaluated in order and the first rule
+	// which matches a request decides it. Requests not matched by any rule get the DefaultDecision.
+	Policy struct {
+		// DefaultDecision is "allow", "deny" or "roles", empty string means "deny"
+		DefaultDecision string       `yaml:"defaultDecision"`
+		Rules           []PolicyRule `yaml:"rules"`
+	}
+
+	// PolicyRule matches requests on API name, namespace, workflow type, task queue and caller claims.
+	// Every non-empty condition must match for the rule to apply. Patterns may use "*" as a wildcard
+	// for any sequence of characters.
+	PolicyRule struct {
+		Name string `yaml:"name"`
+		// Effect is "allow" or "deny"
+		Effect string `yaml:"effect"`
+		// APIs are API names without the service prefix, e.g. "SignalWorkflowExecution"
+		APIs          []string     `yaml:"apis"`
+		Namespaces    []string     `yaml:"namespaces"`
+		WorkflowTypes []string     `yaml:"workflowTypes"`
+		TaskQueues    []string     `yaml:"taskQueues"`
+		Claims        PolicyClaims `yaml:"claims"`
+	}
+
+	// PolicyClaims matches the claims of the caller.
+	PolicyClaims struct {
+		Subjects []string `yaml:"subjects"`
+		// SystemRole is the minimal system role of the caller: "worker", "reader", "writer" or "admin"
+		SystemRole string `yaml:"systemRole"`
+		// NamespaceRole is the minimal role of the caller in the target namespace
+		NamespaceRole string `yaml:"namespaceRole"`
+		// Attributes match string values in Claims.Extensions when it is a map
+		Attributes map[string][]string `yaml:"attributes"`
+	}
+
+	policyAuthorizer struct {
+		config          config.AuthorizationPolicy
+		logger          log.Logger
+		defaultAuthz    Authorizer
+		policy          atomic.Value // *compiledPolicy
+		lastUpdatedTime time.Time
+		ticker          *time.Ticker
+		stop            chan struct{}
+	}
+
+	compiledPolicy struct {
+		defaultDecision string
+		rules           []compiledPolicyRule
+	}
+
+	compiledPolicyRule struct {
+		PolicyRule
+		systemRole    Role
+		namespaceRole Role
+	}
+)
+
+var _ Authorizer = (*policyAuthorizer)(nil)
+
+var policyRoles = map[string]Role{
+	"worker": RoleWorker,
+	"reader": RoleReader,
+	"writer": RoleWriter,
+	"admin":  RoleAdmin,
+}
+
+// NewPolicyAuthorizer creates an authorizer which evaluates the policy file in cfg.
+// The policy file is reloaded when it changes if cfg.RefreshInterval is set.
+func NewPolicyAuthorizer(cfg *config.AuthorizationPolicy, logger log.Logger) (*policyAuthorizer, error) {
+	if cfg.File == "" {
+		return nil, fmt.Errorf("policy authorizer requires a policy file")
+	}
+	a := &policyAuthorizer{
+		config:       *cfg,
+		logger:       logger,
+		defaultAuthz: NewDefaultAuthorizer(),
+	}
+	if err := a.reload(); err != nil {
+		return nil, err
+	}
+	if cfg.DryRun {
+		logger.Warn("Authorization policy is in dry run mode, decisions are logged but the default authorizer is enforced.")
+	}
+	if cfg.RefreshInterval > 0 {
+		a.stop = make(chan struct{})
+		a.ticker = time.NewTicker(cfg.RefreshInterval)
+		go a.reloadLoop()
+	}
+	return a, nil
+}
+
+// Close stops reloading the policy file.
+func (a *policyAuthorizer) Close() {
+	if a.ticker != nil {
+		a.ticker.Stop()
+		close(a.stop)
+	}
+}
+
+func (a *policyAuthorizer) Authorize(ctx context.Context, claims *Claims, target *CallTarget) (Result, error) {
+	policy := a.policy.Load().(*compiledPolicy)
+	result, rule, err := policy.evaluate(ctx, claims, target, a.defaultAuthz)
+	if err != nil {
+		return Result{}, err
+	}
+	if !a.config.DryRun {
+		return result, nil
+	}
+
+	var subject string
+	if claims != nil {
+		subject = claims.Subject
+	}
+	a.logger.Info("Authorization policy decision (dry run).",
+		tag.NewStringTag("api-name", ApiName(target.APIName)),
+		tag.WorkflowNamespace(target.Namespace),
+		tag.WorkflowType(target.WorkflowType),
+		tag.WorkflowTaskQueueName(target.TaskQueue),
+		tag.NewStringTag("subject", subject),
+		tag.NewStringTag("policy-rule", rule),
+		tag.NewBoolTag("allowed", result.Decision == DecisionAllow),
+	)
+	// the policy is not enforced in dry run, requests are authorized as without the policy
+	return a.defaultAuthz.Authorize(ctx, claims, target)
+}
+
+func (a *policyAuthorizer) reloadLoop() {
+	for {
+		select {
+		case <-a.stop:
+			return
+		case <-a.ticker.C:
+			if err := a.reload(); err != nil {
+				a.logger.Error("Unable to reload authorization policy, keeping the previous policy.", tag.Error(err))
+			}
+		}
+	}
+}
+
+// reload loads the policy file if it was modified since it was last loaded.
+func (a *policyAuthorizer) reload() error {
+	info, err := os.Stat(a.config.File)
+	if err != nil {
+		return fmt.Errorf("authorization policy file: %s: %w", a.config.File, err)
+	}
+	if info.ModTime().Equal(a.lastUpdatedTime) {
+		return nil
+	}
+
+	content, err := os.ReadFile(a.config.File)
+	if err != nil {
+		return fmt.Errorf("authorization policy file: %s: %w", a.config.File, err)
+	}
+	policy, err := parsePolicy(content)
+	if err != nil {
+		return fmt.Errorf("authorization policy file: %s: %w", a.config.File, err)
+	}
+
+	a.policy.Store(policy)
+	a.lastUpdatedTime = info.ModTime()
+	a.logger.Info("Loaded authorization policy.", tag.NewStringTag("policy-file", a.config.File), tag.Counter(len(policy.rules)))
+	return nil
+}
+
+// parsePolicy decodes and validates a YAML authorization policy.
+func parsePolicy(content []byte) (*compiledPolicy, error) {
+	policy := &Policy{}
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(policy); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("unable to decode authorization policy: %w", err)
+	}
+	return compilePolicy(policy)
+}
+
+func compilePolicy(policy *Policy) (*compiledPolicy, error) {
+	compiled := &compiledPolicy{
+		defaultDecision: strings.ToLower(policy.DefaultDecision),
+	}
+	switch compiled.defaultDecision {
+	case "":
+		compiled.defaultDecision = PolicyEffectDeny
+	case PolicyEffectAllow, PolicyEffectDeny, PolicyEffectRoles:
+	default:
+		return nil, fmt.Errorf("invalid default decision: %s", policy.DefaultDecision)
+	}
+
+	for i, rule := range policy.Rules {
+		compiledRule := compiledPolicyRule{PolicyRule: rule}
+		compiledRule.Effect = strings.ToLower(rule.Effect)
+		if compiledRule.Effect != PolicyEffectAllow && compiledRule.Effect != PolicyEffectDeny {
+			return nil, fmt.Errorf("rule %d %q: invalid effect: %s", i, rule.Name, rule.Effect)
+		}
+		var err error
+		if compiledRule.systemRole, err = parsePolicyRole(rule.Claims.SystemRole); err != nil {
+			return nil, fmt.Errorf("rule %d %q: %w", i, rule.Name, err)
+		}
+		if compiledRule.namespaceRole, err = parsePolicyRole(rule.Claims.NamespaceRole); err != nil {
+			return nil, fmt.Errorf("rule %d %q: %w", i, rule.Name, err)
+		}
+		compiled.rules = append(compiled.rules, compiledRule)
+	}
+	return compiled, nil
+}
+
+func parsePolicyRole(role string) (Role, error) {
+	if role == "" {
+		return RoleUndefined, nil
+	}
+	r, ok := policyRoles[strings.ToLower(role)]
+	if !ok {
+		return RoleUndefined, fmt.Errorf("invalid role: %s", role)
+	}
+	return r, nil
+}
+
+// evaluate returns the decision for the request and the name of the rule which made it.
+func (p *compiledPolicy) evaluate(
+	ctx context.Context,
+	claims *Claims,
+	target *CallTarget,
+	defaultAuthz Authorizer,
+) (Result, string, error) {
+	for i := range p.rules {
+		rule := &p.rules[i]
+		matched, err := rule.matches(ctx, claims, target)
+		if err != nil {
+			return Result{}, "", err
+		}
+		if !matched {
+			continue
+		}
+		if rule.Effect == PolicyEffectAllow {
+			return resultAllow, rule.Name, nil
+		}
+		return Result{Decision: DecisionDeny, Reason: fmt.Sprintf("denied by policy rule %q", rule.Name)}, rule.Name, nil
+	}
+
+	switch p.defaultDecision {
+	case PolicyEffectAllow:
+		return resultAllow, "", nil
+	case PolicyEffectRoles:
+		result, err := defaultAuthz.Authorize(ctx, claims, target)
+		return result, "", err
+	default:
+		return resultDeny, "", nil
+	}
+}
+
+func (r *compiledPolicyRule) matches(ctx context.Context, claims *Claims, target *CallTarget) (bool, error) {
+	if !matchAny(r.APIs, ApiName(target.APIName)) ||
+		!matchAny(r.Namespaces, target.Namespace) ||
+		!matchAny(r.TaskQueues, target.TaskQueue) ||
+		!r.matchesClaims(claims, target.Namespace) {
+		return false, nil
+	}
+	if len(r.WorkflowTypes) == 0 {
+		return true, nil
+	}
+	workflowType, err := resolveWorkflowType(ctx, target)
+	if err != nil {
+		return false, err
+	}
+	return workflowType != "" && matchAny(r.WorkflowTypes, workflowType), nil
+}
+
+func (r *compiledPolicyRule) matchesClaims(claims *Claims, namespace string) bool {
+	c := r.Claims
+	if len(c.Subjects) == 0 && r.systemRole == RoleUndefined && r.namespaceRole == RoleUndefined && len(c.Attributes) == 0 {
+		return true
+	}
+	if claims == nil {
+		return false
+	}
+	if !matchAny(c.Subjects, claims.Subject) {
+		return false
+	}
+	if r.systemRole != RoleUndefined && claims.System < r.systemRole {
+		return false
+	}
+	if r.namespaceRole != RoleUndefined && claims.Namespaces[strings.ToLower(namespace)] < r.namespaceRole {
+		return false
+	}
+	for name, patterns := range c.Attributes {
+		if !matchAnyValue(patterns, claimAttribute(claims.Extensions, name)) {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveWorkflowType returns the workflow type of the target, looking it up for requests which only
+// reference an execution. An empty string is returned if the workflow type is unknown.
+func resolveWorkflowType(ctx context.Context, target *CallTarget) (string, error) {
+	if target.WorkflowType != "" || target.workflowTypeResolver == nil {
+		return target.WorkflowType, nil
+	}
+	workflowType, err := target.workflowTypeResolver(ctx)
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		// the execution doesn't exist, the request will fail on its own
+	default:
+		return "", err
+	}
+	target.WorkflowType = workflowType
+	target.workflowTypeResolver = nil
+	return workflowType, nil
+}
+
+// claimAttribute returns the string values of the named attribute in claim extensions.
+func claimAttribute(extensions interface{}, name string) []string {
+	var value interface{}
+	switch e := extensions.(type) {
+	case map[string]interface{}:
+		value = e[name]
+	case map[string]string:
+		value = e[name]
+	case map[string][]string:
+		value = e[name]
+	}
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		var values []string
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+// matchAny returns true if patterns is empty or value matches one of them.
+func matchAny(patterns []string, value string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if matchPattern(pattern, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchAnyValue(patterns []string, values []string) bool {
+	for _, value := range values {
+		if matchAny(patterns, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPattern matches value against pattern where "*" matches any sequence of characters.
+func matchPattern(pattern string, value string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == value
+	}
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	value = value[len(parts[0]):]
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		index := strings.Index(value, part)
+		if index < 0 {
+			return false
+		}
+		value = value[index+len(part):]
+	}
+	return len(value) >= len(last) && strings.HasSuffix(value, last)
+}
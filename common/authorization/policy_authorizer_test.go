@@ -0,0 +1,285 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+const testPolicy = `
+defaultDecision: roles
+rules:
+  - name: block-payments
+    effect: deny
+    apis: ["TerminateWorkflowExecution"]
+    workflowTypes: ["Payment*"]
+  - name: team-a-signal
+    effect: allow
+    apis: ["SignalWorkflowExecution", "SignalWithStartWorkflowExecution"]
+    namespaces: ["team-a"]
+    workflowTypes: ["Order"]
+    claims:
+      subjects: ["team-a:*"]
+  - name: team-a-signal-other
+    effect: deny
+    apis: ["Signal*"]
+    namespaces: ["team-a"]
+  - name: worker-poll
+    effect: allow
+    apis: ["Poll*TaskQueue"]
+    taskQueues: ["orders"]
+    claims:
+      attributes:
+        groups: ["workers"]
+`
+
+type (
+	policyAuthorizerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		policy *compiledPolicy
+	}
+)
+
+func TestPolicyAuthorizerSuite(t *testing.T) {
+	s := new(policyAuthorizerSuite)
+	suite.Run(t, s)
+}
+
+func (s *policyAuthorizerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	policy, err := parsePolicy([]byte(testPolicy))
+	s.NoError(err)
+	s.policy = policy
+}
+
+func (s *policyAuthorizerSuite) evaluate(claims *Claims, target *CallTarget) (Decision, string) {
+	result, rule, err := s.policy.evaluate(context.Background(), claims, target, NewDefaultAuthorizer())
+	s.NoError(err)
+	return result.Decision, rule
+}
+
+func (s *policyAuthorizerSuite) TestParsePolicy_Invalid() {
+	_, err := parsePolicy([]byte("defaultDecision: maybe"))
+	s.Error(err)
+	_, err = parsePolicy([]byte("rules:\n  - effect: permit"))
+	s.Error(err)
+	_, err = parsePolicy([]byte("rules:\n  - effect: allow\n    claims:\n      systemRole: owner"))
+	s.Error(err)
+	_, err = parsePolicy([]byte("rules:\n  - effect: allow\n    workflowType: [foo]"))
+	s.Error(err)
+
+	policy, err := parsePolicy(nil)
+	s.NoError(err)
+	s.Equal(PolicyEffectDeny, policy.defaultDecision)
+}
+
+func (s *policyAuthorizerSuite) TestSignalWithStart_WorkflowType() {
+	claims := &Claims{Subject: "team-a:alice"}
+	target := &CallTarget{
+		APIName:      "/temporal.api.workflowservice.v1.WorkflowService/SignalWithStartWorkflowExecution",
+		Namespace:    "team-a",
+		WorkflowType: "Order",
+	}
+	decision, rule := s.evaluate(claims, target)
+	s.Equal(DecisionAllow, decision)
+	s.Equal("team-a-signal", rule)
+
+	target.WorkflowType = "Invoice"
+	decision, rule = s.evaluate(claims, target)
+	s.Equal(DecisionDeny, decision)
+	s.Equal("team-a-signal-other", rule)
+
+	target.WorkflowType = "Order"
+	decision, _ = s.evaluate(&Claims{Subject: "team-b:bob"}, target)
+	s.Equal(DecisionDeny, decision)
+}
+
+func (s *policyAuthorizerSuite) TestSignal_ResolvesWorkflowType() {
+	resolved := 0
+	target := &CallTarget{
+		APIName:   "/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution",
+		Namespace: "team-a",
+		workflowTypeResolver: func(ctx context.Context) (string, error) {
+			resolved++
+			return "Order", nil
+		},
+	}
+	decision, rule := s.evaluate(&Claims{Subject: "team-a:alice"}, target)
+	s.Equal(DecisionAllow, decision)
+	s.Equal("team-a-signal", rule)
+	s.Equal(1, resolved)
+	s.Equal("Order", target.WorkflowType)
+
+	target = &CallTarget{
+		APIName:   "/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution",
+		Namespace: "team-a",
+		workflowTypeResolver: func(ctx context.Context) (string, error) {
+			return "", serviceerror.NewNotFound("workflow not found")
+		},
+	}
+	decision, rule = s.evaluate(&Claims{Subject: "team-a:alice"}, target)
+	s.Equal(DecisionDeny, decision)
+	s.Equal("team-a-signal-other", rule)
+
+	target.workflowTypeResolver = func(ctx context.Context) (string, error) {
+		return "", serviceerror.NewUnavailable("history unavailable")
+	}
+	_, _, err := s.policy.evaluate(context.Background(), &Claims{Subject: "team-a:alice"}, target, NewDefaultAuthorizer())
+	s.Error(err)
+}
+
+func (s *policyAuthorizerSuite) TestPoll_TaskQueueAndAttributes() {
+	claims := &Claims{
+		Subject:    "worker-1",
+		Extensions: map[string]interface{}{"groups": []interface{}{"ops", "workers"}},
+	}
+	target := &CallTarget{
+		APIName:   "/temporal.api.workflowservice.v1.WorkflowService/PollActivityTaskQueue",
+		Namespace: "team-c",
+		TaskQueue: "orders",
+	}
+	decision, rule := s.evaluate(claims, target)
+	s.Equal(DecisionAllow, decision)
+	s.Equal("worker-poll", rule)
+
+	// falls back to roles, which deny a caller without namespace roles
+	target.TaskQueue = "payments"
+	decision, rule = s.evaluate(claims, target)
+	s.Equal(DecisionDeny, decision)
+	s.Equal("", rule)
+
+	target.TaskQueue = "orders"
+	decision, _ = s.evaluate(&Claims{Subject: "worker-1", Extensions: map[string]string{"groups": "ops"}}, target)
+	s.Equal(DecisionDeny, decision)
+}
+
+func (s *policyAuthorizerSuite) TestDefaultDecision_Roles() {
+	target := &CallTarget{
+		APIName:   "/temporal.api.workflowservice.v1.WorkflowService/DescribeWorkflowExecution",
+		Namespace: "team-c",
+	}
+	decision, _ := s.evaluate(&Claims{Namespaces: map[string]Role{"team-c": RoleReader}}, target)
+	s.Equal(DecisionAllow, decision)
+	decision, _ = s.evaluate(&Claims{}, target)
+	s.Equal(DecisionDeny, decision)
+}
+
+func (s *policyAuthorizerSuite) TestNamespaceRole() {
+	policy, err := parsePolicy([]byte(`
+rules:
+  - effect: allow
+    claims:
+      namespaceRole: writer
+`))
+	s.NoError(err)
+	target := &CallTarget{APIName: "StartWorkflowExecution", Namespace: "Foo"}
+	result, _, err := policy.evaluate(context.Background(), &Claims{Namespaces: map[string]Role{"foo": RoleAdmin}}, target, nil)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+	result, _, err = policy.evaluate(context.Background(), &Claims{Namespaces: map[string]Role{"foo": RoleReader}}, target, nil)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+	result, _, err = policy.evaluate(context.Background(), nil, target, nil)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+
+func (s *policyAuthorizerSuite) TestDryRunAndReload() {
+	file := filepath.Join(s.T().TempDir(), "policy.yaml")
+	s.NoError(os.WriteFile(file, []byte("defaultDecision: deny"), 0644))
+
+	authorizer, err := NewPolicyAuthorizer(&config.AuthorizationPolicy{File: file}, log.NewNoopLogger())
+	s.NoError(err)
+	defer authorizer.Close()
+	target := &CallTarget{APIName: "StartWorkflowExecution", Namespace: "foo"}
+	result, err := authorizer.Authorize(context.Background(), nil, target)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+
+	s.NoError(os.WriteFile(file, []byte("defaultDecision: allow"), 0644))
+	s.NoError(os.Chtimes(file, time.Now(), time.Now().Add(time.Minute)))
+	s.NoError(authorizer.reload())
+	result, err = authorizer.Authorize(context.Background(), nil, target)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	// an invalid policy keeps the previous one
+	s.NoError(os.WriteFile(file, []byte("defaultDecision: maybe"), 0644))
+	s.NoError(os.Chtimes(file, time.Now(), time.Now().Add(2*time.Minute)))
+	s.Error(authorizer.reload())
+	result, err = authorizer.Authorize(context.Background(), nil, target)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	dryRun, err := NewPolicyAuthorizer(&config.AuthorizationPolicy{File: file, DryRun: true}, log.NewNoopLogger())
+	s.Error(err)
+	s.Nil(dryRun)
+}
+
+func (s *policyAuthorizerSuite) TestDryRun_EnforcesDefaultAuthorizer() {
+	file := filepath.Join(s.T().TempDir(), "policy.yaml")
+	s.NoError(os.WriteFile(file, []byte("defaultDecision: allow"), 0644))
+
+	dryRun, err := NewPolicyAuthorizer(&config.AuthorizationPolicy{File: file, DryRun: true}, log.NewNoopLogger())
+	s.NoError(err)
+	target := &CallTarget{APIName: "StartWorkflowExecution", Namespace: "foo"}
+
+	// the policy allows the request, but the request without claims is still denied
+	result, err := dryRun.Authorize(context.Background(), nil, target)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+
+	s.NoError(os.WriteFile(file, []byte("defaultDecision: deny"), 0644))
+	dryRun, err = NewPolicyAuthorizer(&config.AuthorizationPolicy{File: file, DryRun: true}, log.NewNoopLogger())
+	s.NoError(err)
+	result, err = dryRun.Authorize(context.Background(), &Claims{System: RoleAdmin}, target)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+
+func (s *policyAuthorizerSuite) TestMatchPattern() {
+	s.True(matchPattern("foo", "foo"))
+	s.False(matchPattern("foo", "foobar"))
+	s.True(matchPattern("*", ""))
+	s.True(matchPattern("foo*", "foobar"))
+	s.True(matchPattern("*bar", "foobar"))
+	s.True(matchPattern("f*o*r", "foobar"))
+	s.True(matchPattern("/_sys/*", "/_sys/orders/1"))
+	s.False(matchPattern("a*a", "a"))
+	s.False(matchPattern("f*z*r", "foobar"))
+}
@@ -0,0 +1,156 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto/x509/pkix"
+	"fmt"
+	"strings"
+
+	"go.temporal.io/server/common/config"
+)
+
+type (
+	// tlsClaimMapper maps client certificates to claims with configurable rules
+	tlsClaimMapper struct {
+		rules []tlsClaimMapperRule
+	}
+
+	tlsClaimMapperRule struct {
+		config.TLSClaimMapperRule
+		system     Role
+		namespaces map[string]Role
+	}
+
+	// tlsIdentity is the part of a client certificate matched by the rules
+	tlsIdentity struct {
+		commonName          string
+		organizations       []string
+		organizationalUnits []string
+		uris                []string
+	}
+)
+
+var _ ClaimMapper = (*tlsClaimMapper)(nil)
+
+// NewTLSClaimMapper creates a claim mapper which grants roles to client certificates matching the rules in cfg.
+// The subject of the claims is the first SAN URI of the certificate, e.g. its SPIFFE ID, or its common name.
+func NewTLSClaimMapper(cfg *config.TLSClaimMapper) (ClaimMapper, error) {
+	mapper := &tlsClaimMapper{}
+	for i, rule := range cfg.Rules {
+		if rule.CommonName == "" && rule.Organization == "" && rule.OrganizationalUnit == "" && rule.URI == "" {
+			return nil, fmt.Errorf("tls claim mapper rule %d has no conditions", i)
+		}
+		compiled := tlsClaimMapperRule{
+			TLSClaimMapperRule: rule,
+			namespaces:         make(map[string]Role),
+		}
+		for _, permission := range rule.Permissions {
+			parts := strings.Split(permission, ":")
+			if len(parts) != 2 {
+				return nil, fmt.Errorf("tls claim mapper rule %d: permission in unexpected format: %s", i, permission)
+			}
+			role := permissionToRole(parts[1])
+			if role == RoleUndefined {
+				return nil, fmt.Errorf("tls claim mapper rule %d: unknown role in permission: %s", i, permission)
+			}
+			namespace := strings.ToLower(parts[0])
+			if namespace == permissionScopeSystem {
+				compiled.system |= role
+			} else {
+				compiled.namespaces[namespace] |= role
+			}
+		}
+		mapper.rules = append(mapper.rules, compiled)
+	}
+	return mapper, nil
+}
+
+func (m *tlsClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
+	claims := Claims{}
+
+	identity := newTLSIdentity(authInfo)
+	if identity == nil {
+		return &claims, nil
+	}
+	claims.Subject = identity.commonName
+	if len(identity.uris) > 0 {
+		claims.Subject = identity.uris[0]
+	}
+
+	for i := range m.rules {
+		rule := &m.rules[i]
+		if !rule.matches(identity) {
+			continue
+		}
+		claims.System |= rule.system
+		for namespace, role := range rule.namespaces {
+			if claims.Namespaces == nil {
+				claims.Namespaces = make(map[string]Role)
+			}
+			claims.Namespaces[namespace] |= role
+		}
+	}
+	return &claims, nil
+}
+
+// newTLSIdentity returns the identity of the verified client certificate or nil if there is none
+func newTLSIdentity(authInfo *AuthInfo) *tlsIdentity {
+	if cert := PeerCert(authInfo.TLSConnection); cert != nil {
+		identity := tlsIdentityFromSubject(&cert.Subject)
+		for _, uri := range cert.URIs {
+			identity.uris = append(identity.uris, uri.String())
+		}
+		return identity
+	}
+	if authInfo.TLSSubject != nil {
+		return tlsIdentityFromSubject(authInfo.TLSSubject)
+	}
+	return nil
+}
+
+func tlsIdentityFromSubject(subject *pkix.Name) *tlsIdentity {
+	return &tlsIdentity{
+		commonName:          subject.CommonName,
+		organizations:       subject.Organization,
+		organizationalUnits: subject.OrganizationalUnit,
+	}
+}
+
+func (r *tlsClaimMapperRule) matches(identity *tlsIdentity) bool {
+	if r.CommonName != "" && !matchPattern(r.CommonName, identity.commonName) {
+		return false
+	}
+	if r.Organization != "" && !matchAnyValue([]string{r.Organization}, identity.organizations) {
+		return false
+	}
+	if r.OrganizationalUnit != "" && !matchAnyValue([]string{r.OrganizationalUnit}, identity.organizationalUnits) {
+		return false
+	}
+	if r.URI != "" && !matchAnyValue([]string{r.URI}, identity.uris) {
+		return false
+	}
+	return true
+}
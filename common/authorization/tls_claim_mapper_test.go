@@ -0,0 +1,178 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/credentials"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+var testTLSClaimMapperConfig = config.TLSClaimMapper{
+	Rules: []config.TLSClaimMapperRule{
+		{
+			URI:         "spiffe://example.org/ns/payments/*",
+			Permissions: []string{"payments:worker"},
+		},
+		{
+			URI:         "spiffe://example.org/ns/payments/deployer",
+			Permissions: []string{"payments:write", "Payments:read"},
+		},
+		{
+			OrganizationalUnit: "platform",
+			CommonName:         "*.ops.example.org",
+			Permissions:        []string{"system:admin"},
+		},
+	},
+}
+
+func newTestTLSAuthInfo(subject pkix.Name, uris ...string) *AuthInfo {
+	cert := &x509.Certificate{Subject: subject}
+	for _, uri := range uris {
+		u, _ := url.Parse(uri)
+		cert.URIs = append(cert.URIs, u)
+	}
+	return &AuthInfo{
+		TLSSubject: &cert.Subject,
+		TLSConnection: &credentials.TLSInfo{
+			State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+		},
+	}
+}
+
+func TestTLSClaimMapper_SPIFFE(t *testing.T) {
+	s := require.New(t)
+	mapper, err := NewTLSClaimMapper(&testTLSClaimMapperConfig)
+	s.NoError(err)
+
+	claims, err := mapper.GetClaims(newTestTLSAuthInfo(pkix.Name{CommonName: "worker"}, "spiffe://example.org/ns/payments/worker"))
+	s.NoError(err)
+	s.Equal("spiffe://example.org/ns/payments/worker", claims.Subject)
+	s.Equal(RoleUndefined, claims.System)
+	s.Equal(map[string]Role{"payments": RoleWorker}, claims.Namespaces)
+
+	claims, err = mapper.GetClaims(newTestTLSAuthInfo(pkix.Name{}, "spiffe://example.org/ns/payments/deployer"))
+	s.NoError(err)
+	s.Equal(map[string]Role{"payments": RoleWorker | RoleWriter | RoleReader}, claims.Namespaces)
+
+	claims, err = mapper.GetClaims(newTestTLSAuthInfo(pkix.Name{}, "spiffe://example.org/ns/orders/worker"))
+	s.NoError(err)
+	s.Nil(claims.Namespaces)
+}
+
+func TestTLSClaimMapper_Subject(t *testing.T) {
+	s := require.New(t)
+	mapper, err := NewTLSClaimMapper(&testTLSClaimMapperConfig)
+	s.NoError(err)
+
+	claims, err := mapper.GetClaims(newTestTLSAuthInfo(pkix.Name{CommonName: "host1.ops.example.org", OrganizationalUnit: []string{"sre", "platform"}}))
+	s.NoError(err)
+	s.Equal("host1.ops.example.org", claims.Subject)
+	s.Equal(RoleAdmin, claims.System)
+
+	claims, err = mapper.GetClaims(newTestTLSAuthInfo(pkix.Name{CommonName: "host1.ops.example.org", OrganizationalUnit: []string{"sre"}}))
+	s.NoError(err)
+	s.Equal(RoleUndefined, claims.System)
+
+	// the subject alone is used if the connection state is not available
+	claims, err = mapper.GetClaims(&AuthInfo{TLSSubject: &pkix.Name{CommonName: "host2.ops.example.org", OrganizationalUnit: []string{"platform"}}})
+	s.NoError(err)
+	s.Equal(RoleAdmin, claims.System)
+
+	claims, err = mapper.GetClaims(&AuthInfo{})
+	s.NoError(err)
+	s.Equal(Claims{}, *claims)
+}
+
+func TestNewTLSClaimMapper_Invalid(t *testing.T) {
+	s := require.New(t)
+	_, err := NewTLSClaimMapper(&config.TLSClaimMapper{Rules: []config.TLSClaimMapperRule{{Permissions: []string{"system:admin"}}}})
+	s.Error(err)
+	_, err = NewTLSClaimMapper(&config.TLSClaimMapper{Rules: []config.TLSClaimMapperRule{{CommonName: "a", Permissions: []string{"admin"}}}})
+	s.Error(err)
+	_, err = NewTLSClaimMapper(&config.TLSClaimMapper{Rules: []config.TLSClaimMapperRule{{CommonName: "a", Permissions: []string{"system:owner"}}}})
+	s.Error(err)
+}
+
+func TestCompositeClaimMapper(t *testing.T) {
+	s := require.New(t)
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	jwtMapper := NewMockClaimMapper(controller)
+	tlsMapper := NewMockClaimMapper(controller)
+	mapper := NewCompositeClaimMapper(jwtMapper, tlsMapper)
+	authInfo := &AuthInfo{}
+
+	jwtMapper.EXPECT().GetClaims(authInfo).Return(&Claims{}, nil)
+	tlsMapper.EXPECT().GetClaims(authInfo).Return(&Claims{Subject: "worker", Namespaces: map[string]Role{"payments": RoleWorker}}, nil)
+	claims, err := mapper.GetClaims(authInfo)
+	s.NoError(err)
+	s.Equal("worker", claims.Subject)
+	s.Equal(map[string]Role{"payments": RoleWorker}, claims.Namespaces)
+
+	jwtMapper.EXPECT().GetClaims(authInfo).Return(&Claims{Subject: "alice", System: RoleReader, Namespaces: map[string]Role{"payments": RoleWriter}}, nil)
+	tlsMapper.EXPECT().GetClaims(authInfo).Return(&Claims{Subject: "worker", Namespaces: map[string]Role{"payments": RoleWorker}}, nil)
+	claims, err = mapper.GetClaims(authInfo)
+	s.NoError(err)
+	s.Equal("alice", claims.Subject)
+	s.Equal(RoleReader, claims.System)
+	s.Equal(map[string]Role{"payments": RoleWriter | RoleWorker}, claims.Namespaces)
+
+	jwtMapper.EXPECT().GetClaims(authInfo).Return(nil, errors.New("invalid token"))
+	_, err = mapper.GetClaims(authInfo)
+	s.Error(err)
+}
+
+func TestGetClaimMapperFromConfig_Composite(t *testing.T) {
+	s := require.New(t)
+	cfg := config.Authorization{ClaimMapper: "default, tls", TLSClaimMapper: testTLSClaimMapperConfig}
+	mapper, err := GetClaimMapperFromConfig(&cfg, log.NewNoopLogger())
+	s.NoError(err)
+	s.IsType(&compositeClaimMapper{}, mapper)
+	s.Len(mapper.(*compositeClaimMapper).mappers, 2)
+
+	cfg.ClaimMapper = "tls"
+	mapper, err = GetClaimMapperFromConfig(&cfg, log.NewNoopLogger())
+	s.NoError(err)
+	s.IsType(&tlsClaimMapper{}, mapper)
+
+	cfg.ClaimMapper = "default,"
+	_, err = GetClaimMapperFromConfig(&cfg, log.NewNoopLogger())
+	s.Error(err)
+	cfg.ClaimMapper = "default,foo"
+	_, err = GetClaimMapperFromConfig(&cfg, log.NewNoopLogger())
+	s.Error(err)
+}
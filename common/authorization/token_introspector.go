@@ -0,0 +1,179 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	defaultIntrospectionCacheTTL  = time.Minute
+	defaultIntrospectionCacheSize = 10000
+)
+
+var errTokenNotActive = serviceerror.NewPermissionDenied("token is not active", "")
+
+type (
+	// tokenIntrospector resolves opaque access tokens with RFC 7662 token introspection
+	tokenIntrospector struct {
+		config     config.TokenIntrospection
+		issuerURL  string
+		httpClient *http.Client
+		cache      cache.Cache
+		cacheTTL   time.Duration
+
+		endpointLock sync.Mutex
+		endpoint     string
+	}
+
+	// introspectionResult is a cached introspection response, claims is nil for inactive tokens
+	introspectionResult struct {
+		claims     map[string]interface{}
+		expiration time.Time
+	}
+)
+
+func newTokenIntrospector(cfg *config.TokenIntrospection, issuerURL string) *tokenIntrospector {
+	cacheTTL := cfg.CacheTTL
+	if cacheTTL <= 0 {
+		cacheTTL = defaultIntrospectionCacheTTL
+	}
+	cacheSize := cfg.CacheSize
+	if cacheSize <= 0 {
+		cacheSize = defaultIntrospectionCacheSize
+	}
+	return &tokenIntrospector{
+		config:     *cfg,
+		issuerURL:  issuerURL,
+		httpClient: newOIDCHTTPClient(),
+		cache:      cache.New(cacheSize, &cache.Options{TTL: cacheTTL}),
+		cacheTTL:   cacheTTL,
+		endpoint:   cfg.Endpoint,
+	}
+}
+
+// introspect returns the claims of an active token, results are cached until the token expires or for cacheTTL
+func (t *tokenIntrospector) introspect(token string) (map[string]interface{}, error) {
+	hash := sha256.Sum256([]byte(token))
+	key := hex.EncodeToString(hash[:])
+	now := time.Now().UTC()
+
+	if cached, ok := t.cache.Get(key).(*introspectionResult); ok && now.Before(cached.expiration) {
+		if cached.claims == nil {
+			return nil, errTokenNotActive
+		}
+		return cached.claims, nil
+	}
+
+	claims, err := t.request(token)
+	if err != nil {
+		return nil, err
+	}
+	result := &introspectionResult{expiration: now.Add(t.cacheTTL)}
+	if exp, ok := claims["exp"].(float64); ok {
+		if expiration := time.Unix(int64(exp), 0).UTC(); expiration.Before(result.expiration) {
+			result.expiration = expiration
+		}
+	}
+	if active, _ := claims["active"].(bool); active && now.Before(result.expiration) {
+		result.claims = claims
+	}
+	t.cache.Put(key, result)
+
+	if result.claims == nil {
+		return nil, errTokenNotActive
+	}
+	return result.claims, nil
+}
+
+func (t *tokenIntrospector) request(token string) (map[string]interface{}, error) {
+	endpoint, err := t.getEndpoint()
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("token", token)
+	form.Set("token_type_hint", "access_token")
+	req, err := http.NewRequest(http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if t.config.ClientID != "" {
+		req.SetBasicAuth(url.QueryEscape(t.config.ClientID), url.QueryEscape(t.config.ClientSecret))
+	}
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token introspection returned status %d", resp.StatusCode)
+	}
+
+	var claims map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&claims); err != nil {
+		return nil, fmt.Errorf("unable to decode token introspection response: %w", err)
+	}
+	return claims, nil
+}
+
+// getEndpoint returns the configured introspection endpoint or discovers it with OIDC discovery
+func (t *tokenIntrospector) getEndpoint() (string, error) {
+	t.endpointLock.Lock()
+	defer t.endpointLock.Unlock()
+
+	if t.endpoint != "" {
+		return t.endpoint, nil
+	}
+	if strings.TrimSpace(t.issuerURL) == "" {
+		return "", fmt.Errorf("token introspection endpoint is not configured")
+	}
+	oidc, err := discoverOIDC(t.httpClient, t.issuerURL)
+	if err != nil {
+		return "", err
+	}
+	if oidc.IntrospectionEndpoint == "" {
+		return "", fmt.Errorf("OIDC configuration of %s has no introspection_endpoint", t.issuerURL)
+	}
+	t.endpoint = oidc.IntrospectionEndpoint
+	return t.endpoint, nil
+}
@@ -46,6 +46,8 @@ import (
 const (
 	defaultClusterMetadataPageSize = 100
 	refreshInterval                = time.Minute
+	watchInterval                  = 5 * time.Second
+	watchDisabledRecheckInterval   = time.Minute
 
 	FakeClusterForEmptyVersion = "fake-cluster-for-empty-version"
 )
@@ -108,8 +110,13 @@ type (
 		clusterMetadataStore persistence.ClusterMetadataManager
 		refresher            *goro.Handle
 		refreshDuration      dynamicconfig.DurationPropertyFn
+		watcher              *goro.Handle
+		watchDuration        dynamicconfig.DurationPropertyFn
 		logger               log.Logger
 
+		// refreshLock serializes refreshes triggered by the refresh and watch loops
+		refreshLock sync.Mutex
+
 		// Immutable fields
 
 		// EnableGlobalNamespace whether the global namespace is enabled,
@@ -142,6 +149,7 @@ func NewMetadata(
 	clusterInfo map[string]ClusterInformation,
 	clusterMetadataStore persistence.ClusterMetadataManager,
 	refreshDuration dynamicconfig.DurationPropertyFn,
+	watchDuration dynamicconfig.DurationPropertyFn,
 	logger log.Logger,
 ) Metadata {
 	if len(clusterInfo) == 0 {
@@ -172,6 +180,9 @@ func NewMetadata(
 	if refreshDuration == nil {
 		refreshDuration = dynamicconfig.GetDurationPropertyFn(refreshInterval)
 	}
+	if watchDuration == nil {
+		watchDuration = dynamicconfig.GetDurationPropertyFn(watchInterval)
+	}
 	return &metadataImpl{
 		status:                   common.DaemonStatusInitialized,
 		enableGlobalNamespace:    enableGlobalNamespace,
@@ -184,6 +195,7 @@ func NewMetadata(
 		clusterMetadataStore:     clusterMetadataStore,
 		logger:                   logger,
 		refreshDuration:          refreshDuration,
+		watchDuration:            watchDuration,
 	}
 }
 
@@ -201,6 +213,7 @@ func NewMetadataFromConfig(
 		config.ClusterInformation,
 		clusterMetadataStore,
 		dynamicCollection.GetDurationProperty(dynamicconfig.ClusterMetadataRefreshInterval, refreshInterval),
+		dynamicCollection.GetDurationProperty(dynamicconfig.ClusterMetadataWatchInterval, watchInterval),
 		logger,
 	)
 }
@@ -216,6 +229,7 @@ func NewMetadataForTest(
 		config.ClusterInformation,
 		nil,
 		nil,
+		nil,
 		log.NewNoopLogger(),
 	)
 }
@@ -230,6 +244,7 @@ func (m *metadataImpl) Start() {
 		m.logger.Fatal("Unable to initialize cluster metadata cache", tag.Error(err))
 	}
 	m.refresher = goro.Go(context.Background(), m.refreshLoop)
+	m.watcher = goro.Go(context.Background(), m.watchLoop)
 }
 
 func (m *metadataImpl) Stop() {
@@ -238,7 +253,9 @@ func (m *metadataImpl) Stop() {
 	}
 
 	m.refresher.Cancel()
+	m.watcher.Cancel()
 	<-m.refresher.Done()
+	<-m.watcher.Done()
 }
 
 func (m *metadataImpl) IsGlobalNamespaceEnabled() bool {
@@ -381,7 +398,33 @@ func (m *metadataImpl) refreshLoop(ctx context.Context) error {
 	}
 }
 
+// watchLoop picks up cluster metadata changes without waiting for the next periodic refresh.
+// A refresh only notifies the cluster change callbacks if versions of the cluster metadata records changed.
+func (m *metadataImpl) watchLoop(ctx context.Context) error {
+	for {
+		interval := m.watchDuration()
+		if interval <= 0 {
+			interval = watchDisabledRecheckInterval
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+		if m.watchDuration() <= 0 {
+			continue
+		}
+
+		if err := m.refreshClusterMetadata(ctx); err != nil {
+			m.logger.Warn("Error watching cluster metadata", tag.Error(err))
+		}
+	}
+}
+
 func (m *metadataImpl) refreshClusterMetadata(ctx context.Context) error {
+	m.refreshLock.Lock()
+	defer m.refreshLock.Unlock()
+
 	clusterMetadataMap, err := m.listAllClusterMetadataFromDB(ctx)
 	if err != nil {
 		return err
@@ -99,6 +99,7 @@ func (s *metadataSuite) SetupTest() {
 		clusterInfo,
 		s.mockClusterMetadataStore,
 		dynamicconfig.GetDurationPropertyFn(time.Second),
+		dynamicconfig.GetDurationPropertyFn(time.Second),
 		log.NewNoopLogger(),
 	).(*metadataImpl)
 }
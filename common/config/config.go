@@ -446,10 +446,85 @@ type (
 		// Signing key provider for validating JWT tokens
 		JWTKeyProvider       JWTKeyProvider `yaml:"jwtKeyProvider"`
 		PermissionsClaimName string         `yaml:"permissionsClaimName"`
-		// Empty string for noopAuthorizer or "default" for defaultAuthorizer
+		// Issuer and audience validation of access tokens by the default claim mapper
+		TokenValidation TokenValidation `yaml:"tokenValidation"`
+		// Introspection of opaque access tokens by the default claim mapper
+		TokenIntrospection TokenIntrospection `yaml:"tokenIntrospection"`
+		// Empty string for noopAuthorizer, "default" for defaultAuthorizer or "policy" for policyAuthorizer
 		Authorizer string `yaml:"authorizer"`
-		// Empty string for noopClaimMapper or "default" for defaultJWTClaimMapper
+		// Empty string for noopClaimMapper, "default" for defaultJWTClaimMapper or "tls" for tlsClaimMapper.
+		// A comma separated list, e.g. "default,tls", combines the claims of several claim mappers.
 		ClaimMapper string `yaml:"claimMapper"`
+		// TLS claim mapper settings, used when ClaimMapper includes "tls"
+		TLSClaimMapper TLSClaimMapper `yaml:"tlsClaimMapper"`
+		// Policy authorizer settings, used when Authorizer is "policy"
+		Policy AuthorizationPolicy `yaml:"policy"`
+		// Audit log of writer and admin API calls, disabled if no sink is configured
+		Audit AuditLog `yaml:"audit"`
+		// Server managed API keys, checked by the frontend before ClaimMapper
+		APIKeys APIKeys `yaml:"apiKeys"`
+	}
+
+	// APIKeys contains the config for authentication with namespace API keys
+	APIKeys struct {
+		// Enabled turns on validation of API keys sent as "Authorization: Bearer <api key>"
+		Enabled bool `yaml:"enabled"`
+		// CacheTTL is how long validated API keys are cached, and so how long a revoked key may still be accepted.
+		// Defaults to 1 minute.
+		CacheTTL time.Duration `yaml:"cacheTTL"`
+		// CacheSize is the maximum number of cached API keys. Defaults to 10000.
+		CacheSize int `yaml:"cacheSize"`
+	}
+
+	// AuthorizationPolicy contains the config for the declarative policy authorizer
+	AuthorizationPolicy struct {
+		// File is the path to the YAML policy file
+		File string `yaml:"file"`
+		// RefreshInterval is how often the policy file is checked for changes, 0 disables hot reload
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
+		// DryRun logs policy decisions without enforcing them, requests are authorized by the default authorizer
+		DryRun bool `yaml:"dryRun"`
+	}
+
+	// TLSClaimMapper contains the rules mapping client certificates to roles
+	TLSClaimMapper struct {
+		// Rules are evaluated for every client certificate, roles of all matching rules are combined
+		Rules []TLSClaimMapperRule `yaml:"rules"`
+	}
+
+	// TLSClaimMapperRule grants permissions to client certificates matching all of its non-empty conditions.
+	// Conditions are patterns which may use "*" as a wildcard for any sequence of characters.
+	TLSClaimMapperRule struct {
+		// CommonName matches the subject common name
+		CommonName string `yaml:"commonName"`
+		// Organization matches any of the subject organizations
+		Organization string `yaml:"organization"`
+		// OrganizationalUnit matches any of the subject organizational units
+		OrganizationalUnit string `yaml:"organizationalUnit"`
+		// URI matches any of the subject alternative name URIs, e.g. SPIFFE IDs
+		URI string `yaml:"uri"`
+		// Permissions granted in the "<namespace>:<role>" or "system:<role>" format of JWT permissions,
+		// where role is "read", "write", "worker" or "admin"
+		Permissions []string `yaml:"permissions"`
+	}
+
+	// AuditLog contains the config for audit log sinks
+	AuditLog struct {
+		// File appends audit records as newline delimited JSON to the file
+		File string `yaml:"file"`
+		// Syslog sends audit records to syslog
+		Syslog *AuditSyslog `yaml:"syslog"`
+		// SystemNamespace records audit records in system workflow histories in the temporal-system namespace
+		SystemNamespace bool `yaml:"systemNamespace"`
+	}
+
+	// AuditSyslog contains the config for the syslog audit sink
+	AuditSyslog struct {
+		// Network and Address of the syslog server, local syslog is used if both are empty
+		Network string `yaml:"network"`
+		Address string `yaml:"address"`
+		// Tag of syslog messages, defaults to "temporal-audit"
+		Tag string `yaml:"tag"`
 	}
 
 	// @@@SNIPSTART temporal-common-service-config-jwtkeyprovider
@@ -457,8 +532,42 @@ type (
 	JWTKeyProvider struct {
 		KeySourceURIs   []string      `yaml:"keySourceURIs"`
 		RefreshInterval time.Duration `yaml:"refreshInterval"`
+		// OIDCIssuerURL enables OIDC discovery, keys are also read from the JWKS URI
+		// published at <OIDCIssuerURL>/.well-known/openid-configuration
+		OIDCIssuerURL string `yaml:"oidcIssuerURL"`
 	}
 	// @@@SNIPEND
+
+	// TokenValidation contains the issuer and audience required in access tokens
+	TokenValidation struct {
+		// Issuer required in tokens. If empty and OIDC discovery is enabled, the discovered issuer is required.
+		Issuer string `yaml:"issuer"`
+		// Audience required in tokens, unless the JWTAudienceMapper returns an audience for the request
+		Audience string `yaml:"audience"`
+		// Namespaces overrides Issuer and Audience for requests to specific namespaces
+		Namespaces map[string]NamespaceTokenValidation `yaml:"namespaces"`
+	}
+
+	// NamespaceTokenValidation contains the issuer and audience required in tokens for a namespace
+	NamespaceTokenValidation struct {
+		Issuer   string `yaml:"issuer"`
+		Audience string `yaml:"audience"`
+	}
+
+	// TokenIntrospection contains the config for RFC 7662 introspection of opaque access tokens
+	TokenIntrospection struct {
+		// Enabled turns on introspection of tokens which are not JWTs
+		Enabled bool `yaml:"enabled"`
+		// Endpoint of the introspection API. If empty, the "introspection_endpoint" found with OIDC discovery is used.
+		Endpoint string `yaml:"endpoint"`
+		// ClientID and ClientSecret authenticate the server to the introspection endpoint
+		ClientID     string `yaml:"clientID"`
+		ClientSecret string `yaml:"clientSecret"`
+		// CacheTTL is how long introspection results are cached, never past token expiration. Defaults to 1 minute.
+		CacheTTL time.Duration `yaml:"cacheTTL"`
+		// CacheSize is the maximum number of cached introspection results. Defaults to 10000.
+		CacheSize int `yaml:"cacheSize"`
+	}
 )
 
 // Validate validates this config
@@ -494,6 +603,9 @@ func (r *GroupTLS) IsClientEnabled() bool {
 }
 
 func (p *JWTKeyProvider) HasSourceURIsConfigured() bool {
+	if strings.TrimSpace(p.OIDCIssuerURL) != "" {
+		return true
+	}
 	if len(p.KeySourceURIs) == 0 {
 		return false
 	}
@@ -100,6 +100,9 @@ const (
 	EnableCrossNamespaceCommands = "system.enableCrossNamespaceCommands"
 	// ClusterMetadataRefreshInterval is config to manage cluster metadata table refresh interval
 	ClusterMetadataRefreshInterval = "system.clusterMetadataRefreshInterval"
+	// ClusterMetadataWatchInterval is config to manage how often cluster metadata records are checked for changes,
+	// 0 disables the watch and changes are only picked up by the periodic refresh
+	ClusterMetadataWatchInterval = "system.clusterMetadataWatchInterval"
 	// ForceSearchAttributesCacheRefreshOnRead forces refreshing search attributes cache on a read operation, so we always
 	// get the latest data from DB. This effectively bypasses cache value and is used to facilitate testing of changes in
 	// search attributes. This should not be turned on in production.
@@ -124,6 +127,10 @@ const (
 	MemoSizeLimitError = "limit.memoSize.error"
 	// MemoSizeLimitWarn is the per event memo size limit for warning
 	MemoSizeLimitWarn = "limit.memoSize.warn"
+	// RequestSizeLimitError is the per request size limit, requests exceeding it are rejected by frontend
+	RequestSizeLimitError = "limit.requestSize.error"
+	// RequestSizeLimitWarn is the per request size limit for warning
+	RequestSizeLimitWarn = "limit.requestSize.warn"
 	// HistorySizeLimitError is the per workflow execution history size limit
 	HistorySizeLimitError = "limit.historySize.error"
 	// HistorySizeLimitWarn is the per workflow execution history size limit for warning
@@ -132,6 +139,18 @@ const (
 	HistoryCountLimitError = "limit.historyCount.error"
 	// HistoryCountLimitWarn is the per workflow execution history event count limit for warning
 	HistoryCountLimitWarn = "limit.historyCount.warn"
+	// NumPendingActivitiesLimitError is the per workflow execution limit of pending activities
+	NumPendingActivitiesLimitError = "limit.numPendingActivities.error"
+	// NumPendingActivitiesLimitWarn is the per workflow execution limit of pending activities for warning
+	NumPendingActivitiesLimitWarn = "limit.numPendingActivities.warn"
+	// NumPendingChildExecutionsLimitError is the per workflow execution limit of pending child workflows
+	NumPendingChildExecutionsLimitError = "limit.numPendingChildExecutions.error"
+	// NumPendingChildExecutionsLimitWarn is the per workflow execution limit of pending child workflows for warning
+	NumPendingChildExecutionsLimitWarn = "limit.numPendingChildExecutions.warn"
+	// NumPendingSignalsLimitError is the per workflow execution limit of pending signals to external workflows
+	NumPendingSignalsLimitError = "limit.numPendingSignals.error"
+	// NumPendingSignalsLimitWarn is the per workflow execution limit of pending signals to external workflows for warning
+	NumPendingSignalsLimitWarn = "limit.numPendingSignals.warn"
 	// MaxIDLengthLimit is the length limit for various IDs, including: Namespace, TaskQueue, WorkflowID, ActivityID, TimerID,
 	// WorkflowType, ActivityType, SignalName, MarkerName, ErrorReason/FailureReason/CancelCause, Identity, RequestID
 	MaxIDLengthLimit = "limit.maxIDLength"
@@ -156,6 +175,8 @@ const (
 	FrontendMaxNamespaceBurstPerInstance = "frontend.namespaceBurst"
 	// FrontendMaxNamespaceCountPerInstance is workflow namespace count limit per second
 	FrontendMaxNamespaceCountPerInstance = "frontend.namespaceCount"
+	// FrontendMaxTaskQueuePollerCountPerInstance is the limit of concurrent pollers of a task queue, 0 means unlimited
+	FrontendMaxTaskQueuePollerCountPerInstance = "frontend.taskQueuePollerCount"
 	// FrontendMaxNamespaceVisibilityRPSPerInstance is namespace rate limit per second for visibility APIs.
 	// This config is EXPERIMENTAL and may be changed or removed in a later release.
 	FrontendMaxNamespaceVisibilityRPSPerInstance = "frontend.namespaceRPS.visibility"
@@ -168,6 +189,24 @@ const (
 	FrontendThrottledLogRPS = "frontend.throttledLogRPS"
 	// FrontendShutdownDrainDuration is the duration of traffic drain during shutdown
 	FrontendShutdownDrainDuration = "frontend.shutdownDrainDuration"
+	// FrontendNamespaceRenameAliasGracePeriod is how long the previous name of a renamed namespace keeps resolving to it
+	FrontendNamespaceRenameAliasGracePeriod = "frontend.namespaceRenameAliasGracePeriod"
+	// FrontendAPIKeyRotationGracePeriod is how long the previous secret of a rotated API key stays valid
+	FrontendAPIKeyRotationGracePeriod = "frontend.apiKeyRotationGracePeriod"
+	// FrontendEnableGlobalNamespaceRPSCoordination enables distributing global namespace rate limits across frontend hosts by their load
+	FrontendEnableGlobalNamespaceRPSCoordination = "frontend.enableGlobalNamespaceRPSCoordination"
+	// FrontendGlobalNamespaceRPSReportInterval is how often frontend hosts report namespace request rates to the quota coordinator
+	FrontendGlobalNamespaceRPSReportInterval = "frontend.globalNamespaceRPSReportInterval"
+	// FrontendReplicationLagMetricsInterval is how often replication lag metrics are emitted, 0 disables them
+	FrontendReplicationLagMetricsInterval = "frontend.replicationLagMetricsInterval"
+	// FrontendEnableAdaptiveLoadShedding enables shedding of low priority requests while history is unhealthy
+	FrontendEnableAdaptiveLoadShedding = "frontend.enableAdaptiveLoadShedding"
+	// FrontendLoadSheddingHistoryLatencyThreshold is the average latency of history calls, long polls excluded, above which history is considered unhealthy
+	FrontendLoadSheddingHistoryLatencyThreshold = "frontend.loadSheddingHistoryLatencyThreshold"
+	// FrontendLoadSheddingHistoryErrorRatioThreshold is the ratio of failed history calls above which history is considered unhealthy
+	FrontendLoadSheddingHistoryErrorRatioThreshold = "frontend.loadSheddingHistoryErrorRatioThreshold"
+	// FrontendLoadSheddingRetryAfter is the retry after hint returned with shed requests per shedding level
+	FrontendLoadSheddingRetryAfter = "frontend.loadSheddingRetryAfter"
 	// EnableClientVersionCheck enables client version check for frontend
 	EnableClientVersionCheck = "frontend.enableClientVersionCheck"
 	// FrontendMaxBadBinaries is the max number of bad binaries in namespace config
@@ -299,6 +338,12 @@ const (
 	AcquireShardInterval = "history.acquireShardInterval"
 	// AcquireShardConcurrency is number of goroutines that can be used to acquire shards in the shard controller.
 	AcquireShardConcurrency = "history.acquireShardConcurrency"
+	// EnableShardHandoff indicates whether a shard that moved to another host should be drained and handed off
+	// to the new owner instead of being unloaded immediately
+	EnableShardHandoff = "history.enableShardHandoff"
+	// ShardHandoffTimeout is the max time the new owner of a shard waits for the previous owner to complete
+	// the handoff before stealing the shard
+	ShardHandoffTimeout = "history.shardHandoffTimeout"
 	// StandbyClusterDelay is the artificial delay added to standby cluster's view of active cluster's time
 	StandbyClusterDelay = "history.standbyClusterDelay"
 	// StandbyTaskMissingEventsResendDelay is the amount of time standby cluster's will wait (if events are missing)
@@ -474,6 +519,9 @@ const (
 	WorkflowTaskHeartbeatTimeout = "history.workflowTaskHeartbeatTimeout"
 	// WorkflowTaskCriticalAttempts is the number of attempts for a workflow task that's regarded as critical
 	WorkflowTaskCriticalAttempts = "history.workflowTaskCriticalAttempt"
+	// WorkflowTaskPauseAttempts is the number of failed attempts of a workflow task after which the workflow
+	// is paused and no more workflow tasks are scheduled until it is unpaused, 0 disables pausing
+	WorkflowTaskPauseAttempts = "history.workflowTaskPauseAttempts"
 	// DefaultWorkflowTaskTimeout for a workflow task
 	DefaultWorkflowTaskTimeout = "history.defaultWorkflowTaskTimeout"
 	// SkipReapplicationByNamespaceID is whether skipping a event re-application for a namespace
@@ -497,6 +545,11 @@ const (
 	ReplicationTaskFetcherTimerJitterCoefficient = "history.ReplicationTaskFetcherTimerJitterCoefficient"
 	// ReplicationTaskFetcherErrorRetryWait is the wait time when fetcher encounters error
 	ReplicationTaskFetcherErrorRetryWait = "history.ReplicationTaskFetcherErrorRetryWait"
+	// ReplicationTaskFetcherStreamingClusters is a map of remote cluster name to bool, replication tasks are pushed
+	// through per shard streams from clusters set to true, other clusters are polled
+	ReplicationTaskFetcherStreamingClusters = "history.ReplicationTaskFetcherStreamingClusters"
+	// ReplicationTaskFetcherStreamMaxWait is how long remote cluster holds a stream request when there is no new task
+	ReplicationTaskFetcherStreamMaxWait = "history.ReplicationTaskFetcherStreamMaxWait"
 	// ReplicationTaskProcessorErrorRetryWait is the initial retry wait when we see errors in applying replication tasks
 	ReplicationTaskProcessorErrorRetryWait = "history.ReplicationTaskProcessorErrorRetryWait"
 	// ReplicationTaskProcessorErrorRetryBackoffCoefficient is the retry wait backoff time coefficient
@@ -511,6 +564,9 @@ const (
 	ReplicationTaskProcessorNoTaskInitialWait = "history.ReplicationTaskProcessorNoTaskInitialWait"
 	// ReplicationTaskProcessorCleanupInterval determines how frequently the cleanup replication queue
 	ReplicationTaskProcessorCleanupInterval = "history.ReplicationTaskProcessorCleanupInterval"
+	// ReplicationTaskProcessorDLQAutoMergeInterval is how often replication DLQ of a shard is merged automatically
+	// while source cluster is healthy. Zero disables automatic merge.
+	ReplicationTaskProcessorDLQAutoMergeInterval = "history.ReplicationTaskProcessorDLQAutoMergeInterval"
 	// ReplicationTaskProcessorCleanupJitterCoefficient is the jitter for cleanup timer
 	ReplicationTaskProcessorCleanupJitterCoefficient = "history.ReplicationTaskProcessorCleanupJitterCoefficient"
 	// ReplicationTaskProcessorStartWait is the wait time before each task processing batch
@@ -519,6 +575,9 @@ const (
 	ReplicationTaskProcessorHostQPS = "history.ReplicationTaskProcessorHostQPS"
 	// ReplicationTaskProcessorShardQPS is the qps of task processing rate limiter on shard level
 	ReplicationTaskProcessorShardQPS = "history.ReplicationTaskProcessorShardQPS"
+	// ReplicationStatusMaxScanTasks is the max number of replication tasks and DLQ tasks scanned per shard
+	// when reporting per namespace replication lag and DLQ sizes
+	ReplicationStatusMaxScanTasks = "history.replicationStatusMaxScanTasks"
 
 	// key for worker
 
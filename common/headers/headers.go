@@ -36,6 +36,13 @@ const (
 	SupportedServerVersionsHeaderName = "supported-server-versions"
 	SupportedFeaturesHeaderName       = "supported-features"
 	SupportedFeaturesHeaderDelim      = ","
+
+	// AuditLogCallHeaderName marks calls made by the audit log itself, which are not audited
+	AuditLogCallHeaderName = "temporal-audit-log-call"
+)
+
+type (
+	contextKeyAuditLogCall struct{}
 )
 
 var (
@@ -126,6 +133,18 @@ func SetVersionsForTests(ctx context.Context, clientVersion, clientName, support
 	}))
 }
 
+// SetAuditLogCall marks calls made with ctx as calls of the audit log itself.
+// SDK clients created by the server send the AuditLogCallHeaderName header for them.
+func SetAuditLogCall(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKeyAuditLogCall{}, true)
+}
+
+// IsAuditLogCall returns true if ctx was marked with SetAuditLogCall.
+func IsAuditLogCall(ctx context.Context) bool {
+	marked, _ := ctx.Value(contextKeyAuditLogCall{}).(bool)
+	return marked
+}
+
 func getSingleHeaderValue(md metadata.MD, headerName string) string {
 	values := md.Get(headerName)
 	if len(values) == 0 {
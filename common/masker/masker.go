@@ -33,8 +33,8 @@ import (
 const passwordMask = "******"
 
 var (
-	DefaultFieldNames     = []string{"Password", "KeyData"}
-	DefaultYAMLFieldNames = []string{"password", "keyData"}
+	DefaultFieldNames     = []string{"Password", "KeyData", "ClientSecret"}
+	DefaultYAMLFieldNames = []string{"password", "keyData", "clientSecret"}
 )
 
 // MaskYaml replace password values with mask and returns copy of the string.
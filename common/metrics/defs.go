@@ -205,6 +205,14 @@ const (
 	PersistenceCompleteVisibilityTaskScope
 	// PersistenceRangeCompleteVisibilityTasksScope tracks CompleteVisibilityTasks calls made by service to persistence layer
 	PersistenceRangeCompleteVisibilityTasksScope
+	// PersistenceGetHistoryTaskScope tracks GetHistoryTask calls for custom task categories made by service to persistence layer
+	PersistenceGetHistoryTaskScope
+	// PersistenceGetHistoryTasksScope tracks GetHistoryTasks calls for custom task categories made by service to persistence layer
+	PersistenceGetHistoryTasksScope
+	// PersistenceCompleteHistoryTaskScope tracks CompleteHistoryTask calls for custom task categories made by service to persistence layer
+	PersistenceCompleteHistoryTaskScope
+	// PersistenceRangeCompleteHistoryTasksScope tracks RangeCompleteHistoryTasks calls for custom task categories made by service to persistence layer
+	PersistenceRangeCompleteHistoryTasksScope
 
 	// PersistenceGetReplicationTaskScope tracks GetReplicationTask calls made by service to persistence layer
 	PersistenceGetReplicationTaskScope
@@ -404,6 +412,8 @@ const (
 	HistoryClientMergeDLQMessagesScope
 	// HistoryClientRefreshWorkflowTasksScope tracks RPC calls to history service
 	HistoryClientRefreshWorkflowTasksScope
+	// HistoryClientUnpauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUnpauseWorkflowExecutionScope
 	// HistoryClientGenerateLastHistoryReplicationTasksScope tracks RPC calls to history service
 	HistoryClientGenerateLastHistoryReplicationTasksScope
 	// HistoryClientGetReplicationStatusScope tracks RPC calls to history service
@@ -550,6 +560,10 @@ const (
 	AdminClientAddOrUpdateRemoteClusterScope
 	// AdminClientRemoveRemoteClusterScope tracks RPC calls to admin service
 	AdminClientRemoveRemoteClusterScope
+	// AdminClientListClusterMetadataHistoryScope tracks RPC calls to admin service
+	AdminClientListClusterMetadataHistoryScope
+	// AdminClientRollbackClusterMetadataScope tracks RPC calls to admin service
+	AdminClientRollbackClusterMetadataScope
 	// AdminClientGetDLQMessagesScope tracks RPC calls to admin service
 	AdminClientGetDLQMessagesScope
 	// AdminClientPurgeDLQMessagesScope tracks RPC calls to admin service
@@ -558,10 +572,46 @@ const (
 	AdminClientMergeDLQMessagesScope
 	// AdminClientRefreshWorkflowTasksScope tracks RPC calls to admin service
 	AdminClientRefreshWorkflowTasksScope
+	// AdminClientUnpauseWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientUnpauseWorkflowExecutionScope
+	// AdminClientGetReplicationLagScope tracks RPC calls to admin service
+	AdminClientGetReplicationLagScope
+	// AdminClientUpdateNamespaceReplicationFilterScope tracks RPC calls to admin service
+	AdminClientUpdateNamespaceReplicationFilterScope
+	// AdminClientUpdateNamespaceDataResidencyPolicyScope tracks RPC calls to admin service
+	AdminClientUpdateNamespaceDataResidencyPolicyScope
+	// AdminClientRenameNamespaceScope tracks RPC calls to admin service
+	AdminClientRenameNamespaceScope
+	// AdminClientCreateApiKeyScope tracks RPC calls to admin service
+	AdminClientCreateApiKeyScope
+	// AdminClientListApiKeysScope tracks RPC calls to admin service
+	AdminClientListApiKeysScope
+	// AdminClientRotateApiKeyScope tracks RPC calls to admin service
+	AdminClientRotateApiKeyScope
+	// AdminClientRevokeApiKeyScope tracks RPC calls to admin service
+	AdminClientRevokeApiKeyScope
+	// AdminClientStartNamespaceHandoverScope tracks RPC calls to admin service
+	AdminClientStartNamespaceHandoverScope
+	// AdminClientStreamReplicationMessagesScope tracks RPC calls to admin service
+	AdminClientStreamReplicationMessagesScope
+	// AdminClientDescribeNamespaceHandoverScope tracks RPC calls to admin service
+	AdminClientDescribeNamespaceHandoverScope
+	// AdminClientSyncSearchAttributesScope tracks RPC calls to admin service
+	AdminClientSyncSearchAttributesScope
+	// AdminClientPurgeDLQMessagesAllShardsScope tracks RPC calls to admin service
+	AdminClientPurgeDLQMessagesAllShardsScope
+	// AdminClientMergeDLQMessagesAllShardsScope tracks RPC calls to admin service
+	AdminClientMergeDLQMessagesAllShardsScope
+	// AdminClientVerifyWorkflowReplicationScope tracks RPC calls to admin service
+	AdminClientVerifyWorkflowReplicationScope
 	// AdminClientResendReplicationTasksScope tracks RPC calls to admin service
 	AdminClientResendReplicationTasksScope
 	// AdminClientGetTaskQueueTasksScope tracks RPC calls to admin service
 	AdminClientGetTaskQueueTasksScope
+	// AdminClientReportNamespaceQuotaUsageScope tracks RPC calls to admin service
+	AdminClientReportNamespaceQuotaUsageScope
+	// AdminClientListPollerCountsScope tracks RPC calls to admin service
+	AdminClientListPollerCountsScope
 	// DCRedirectionDeprecateNamespaceScope tracks RPC calls for dc redirection
 	DCRedirectionDeprecateNamespaceScope
 	// DCRedirectionDescribeNamespaceScope tracks RPC calls for dc redirection
@@ -754,10 +804,46 @@ const (
 	AdminReapplyEventsScope
 	// AdminRefreshWorkflowTasksScope is the metric scope for admin.RefreshWorkflowTasks
 	AdminRefreshWorkflowTasksScope
+	// AdminUnpauseWorkflowExecutionScope is the metric scope for admin.UnpauseWorkflowExecution
+	AdminUnpauseWorkflowExecutionScope
+	// AdminGetReplicationLagScope is the metric scope for admin.GetReplicationLag
+	AdminGetReplicationLagScope
+	// AdminUpdateNamespaceReplicationFilterScope is the metric scope for admin.UpdateNamespaceReplicationFilter
+	AdminUpdateNamespaceReplicationFilterScope
+	// AdminUpdateNamespaceDataResidencyPolicyScope is the metric scope for admin.UpdateNamespaceDataResidencyPolicy
+	AdminUpdateNamespaceDataResidencyPolicyScope
+	// AdminRenameNamespaceScope is the metric scope for admin.RenameNamespace
+	AdminRenameNamespaceScope
+	// AdminCreateApiKeyScope is the metric scope for admin.CreateApiKey
+	AdminCreateApiKeyScope
+	// AdminListApiKeysScope is the metric scope for admin.ListApiKeys
+	AdminListApiKeysScope
+	// AdminRotateApiKeyScope is the metric scope for admin.RotateApiKey
+	AdminRotateApiKeyScope
+	// AdminRevokeApiKeyScope is the metric scope for admin.RevokeApiKey
+	AdminRevokeApiKeyScope
+	// AdminStartNamespaceHandoverScope is the metric scope for admin.StartNamespaceHandover
+	AdminStartNamespaceHandoverScope
+	// AdminStreamReplicationMessagesScope is the metric scope for admin.StreamReplicationMessages
+	AdminStreamReplicationMessagesScope
+	// AdminDescribeNamespaceHandoverScope is the metric scope for admin.DescribeNamespaceHandover
+	AdminDescribeNamespaceHandoverScope
+	// AdminSyncSearchAttributesScope is the metric scope for admin.SyncSearchAttributes
+	AdminSyncSearchAttributesScope
+	// AdminPurgeDLQMessagesAllShardsScope is the metric scope for admin.PurgeDLQMessagesAllShards
+	AdminPurgeDLQMessagesAllShardsScope
+	// AdminMergeDLQMessagesAllShardsScope is the metric scope for admin.MergeDLQMessagesAllShards
+	AdminMergeDLQMessagesAllShardsScope
+	// AdminVerifyWorkflowReplicationScope is the metric scope for admin.VerifyWorkflowReplication
+	AdminVerifyWorkflowReplicationScope
 	// AdminResendReplicationTasksScope is the metric scope for admin.ResendReplicationTasks
 	AdminResendReplicationTasksScope
 	// AdminGetTaskQueueTasksScope is the metric scope for admin.GetTaskQueueTasks
 	AdminGetTaskQueueTasksScope
+	// AdminReportNamespaceQuotaUsageScope is the metric scope for admin.ReportNamespaceQuotaUsage
+	AdminReportNamespaceQuotaUsageScope
+	// AdminListPollerCountsScope is the metric scope for admin.ListPollerCounts
+	AdminListPollerCountsScope
 	// AdminRemoveTaskScope is the metric scope for admin.AdminRemoveTaskScope
 	AdminRemoveTaskScope
 	// AdminCloseShardScope is the metric scope for admin.AdminCloseShardScope
@@ -782,6 +868,10 @@ const (
 	AdminAddOrUpdateRemoteClusterScope
 	// AdminRemoveRemoteClusterScope is the metric scope for admin.AdminRemoveRemoteClusterScope
 	AdminRemoveRemoteClusterScope
+	// AdminListClusterMetadataHistoryScope is the metric scope for admin.AdminListClusterMetadataHistoryScope
+	AdminListClusterMetadataHistoryScope
+	// AdminRollbackClusterMetadataScope is the metric scope for admin.AdminRollbackClusterMetadataScope
+	AdminRollbackClusterMetadataScope
 
 	NumAdminScopes
 )
@@ -892,6 +982,10 @@ const (
 	VersionCheckScope
 	// AuthorizationScope is the scope used by all metric emitted by authorization code
 	AuthorizationScope
+	// AuditLogScope is the scope used by all metric emitted by audit log code
+	AuditLogScope
+	// ReplicationLagScope is the scope used by replication lag metrics
+	ReplicationLagScope
 
 	NumFrontendScopes
 )
@@ -962,6 +1056,8 @@ const (
 	HistoryReapplyEventsScope
 	// HistoryRefreshWorkflowTasksScope is the scope used by refresh workflow tasks API
 	HistoryRefreshWorkflowTasksScope
+	// HistoryUnpauseWorkflowExecutionScope is the scope used by unpause workflow execution API
+	HistoryUnpauseWorkflowExecutionScope
 	// HistoryGenerateLastHistoryReplicationTasksScope is the scope used by generate last replication tasks API
 	HistoryGenerateLastHistoryReplicationTasksScope
 	// HistoryGetReplicationStatusScope is the scope used by GetReplicationStatus API
@@ -972,6 +1068,8 @@ const (
 	HistoryCloseShard
 	// HistoryGetShard is the scope used by get shard API
 	HistoryGetShard
+	// HistoryCompleteShardHandoffScope is the scope used by complete shard handoff API
+	HistoryCompleteShardHandoffScope
 	// HistoryReplicateEventsV2 is the scope used by replicate events API
 	HistoryReplicateEventsV2
 	// HistoryResetStickyTaskQueue is the scope used by reset sticky task queue API
@@ -1239,6 +1337,10 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceGetVisibilityTasksScope:                {operation: "GetVisibilityTasks"},
 		PersistenceCompleteVisibilityTaskScope:            {operation: "CompleteVisibilityTask"},
 		PersistenceRangeCompleteVisibilityTasksScope:      {operation: "RangeCompleteVisibilityTask"},
+		PersistenceGetHistoryTaskScope:                    {operation: "GetHistoryTask"},
+		PersistenceGetHistoryTasksScope:                   {operation: "GetHistoryTasks"},
+		PersistenceCompleteHistoryTaskScope:               {operation: "CompleteHistoryTask"},
+		PersistenceRangeCompleteHistoryTasksScope:         {operation: "RangeCompleteHistoryTasks"},
 		PersistenceGetReplicationTaskScope:                {operation: "GetReplicationTask"},
 		PersistenceGetReplicationTasksScope:               {operation: "GetReplicationTasks"},
 		PersistenceCompleteReplicationTaskScope:           {operation: "CompleteReplicationTask"},
@@ -1353,6 +1455,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientPurgeDLQMessagesScope:                    {operation: "HistoryClientPurgeDLQMessagesScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientMergeDLQMessagesScope:                    {operation: "HistoryClientMergeDLQMessagesScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRefreshWorkflowTasksScope:                {operation: "HistoryClientRefreshWorkflowTasksScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientUnpauseWorkflowExecutionScope:            {operation: "HistoryClientUnpauseWorkflowExecutionScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientGenerateLastHistoryReplicationTasksScope: {operation: "HistoryClientGenerateLastHistoryReplicationTasksScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientGetReplicationStatusScope:                {operation: "HistoryClientGetReplicationStatusScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		MatchingClientPollWorkflowTaskQueueScope:              {operation: "MatchingClientPollWorkflowTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
@@ -1422,9 +1525,29 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientListClustersScope:                          {operation: "AdminClientListClusters", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientAddOrUpdateRemoteClusterScope:              {operation: "AdminClientAddOrUpdateRemoteCluster", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientRemoveRemoteClusterScope:                   {operation: "AdminClientRemoveRemoteCluster", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListClusterMetadataHistoryScope:            {operation: "AdminClientListClusterMetadataHistory", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientRollbackClusterMetadataScope:               {operation: "AdminClientRollbackClusterMetadata", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientRefreshWorkflowTasksScope:                  {operation: "AdminClientRefreshWorkflowTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUnpauseWorkflowExecutionScope:              {operation: "AdminClientUnpauseWorkflowExecution", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetReplicationLagScope:                     {operation: "AdminClientGetReplicationLag", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateNamespaceReplicationFilterScope:      {operation: "AdminClientUpdateNamespaceReplicationFilter", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateNamespaceDataResidencyPolicyScope:    {operation: "AdminClientUpdateNamespaceDataResidencyPolicy", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientRenameNamespaceScope:                       {operation: "AdminClientRenameNamespace", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientCreateApiKeyScope:                          {operation: "AdminClientCreateApiKey", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListApiKeysScope:                           {operation: "AdminClientListApiKeys", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientRotateApiKeyScope:                          {operation: "AdminClientRotateApiKey", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientRevokeApiKeyScope:                          {operation: "AdminClientRevokeApiKey", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientStreamReplicationMessagesScope:             {operation: "AdminClientStreamReplicationMessages", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientStartNamespaceHandoverScope:                {operation: "AdminClientStartNamespaceHandover", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeNamespaceHandoverScope:             {operation: "AdminClientDescribeNamespaceHandover", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientSyncSearchAttributesScope:                  {operation: "AdminClientSyncSearchAttributes", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientPurgeDLQMessagesAllShardsScope:             {operation: "AdminClientPurgeDLQMessagesAllShards", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientMergeDLQMessagesAllShardsScope:             {operation: "AdminClientMergeDLQMessagesAllShards", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientVerifyWorkflowReplicationScope:             {operation: "AdminClientVerifyWorkflowReplication", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientResendReplicationTasksScope:                {operation: "AdminClientResendReplicationTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetTaskQueueTasksScope:                     {operation: "AdminClientGetTaskQueueTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientReportNamespaceQuotaUsageScope:             {operation: "AdminClientReportNamespaceQuotaUsage", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListPollerCountsScope:                      {operation: "AdminClientListPollerCounts", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientListClusterMembersScope:                    {operation: "AdminClientListClusterMembers", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientCloseShardScope:                            {operation: "AdminClientCloseShard", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetShardScope:                              {operation: "AdminClientGetShard", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
@@ -1526,12 +1649,32 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminGetDLQReplicationMessagesScope:             {operation: "AdminGetDLQReplicationMessages"},
 		AdminReapplyEventsScope:                         {operation: "ReapplyEvents"},
 		AdminRefreshWorkflowTasksScope:                  {operation: "RefreshWorkflowTasks"},
+		AdminUnpauseWorkflowExecutionScope:              {operation: "UnpauseWorkflowExecution"},
+		AdminGetReplicationLagScope:                     {operation: "GetReplicationLag"},
+		AdminUpdateNamespaceReplicationFilterScope:      {operation: "UpdateNamespaceReplicationFilter"},
+		AdminUpdateNamespaceDataResidencyPolicyScope:    {operation: "UpdateNamespaceDataResidencyPolicy"},
+		AdminRenameNamespaceScope:                       {operation: "AdminRenameNamespace"},
+		AdminCreateApiKeyScope:                          {operation: "CreateApiKey"},
+		AdminListApiKeysScope:                           {operation: "ListApiKeys"},
+		AdminRotateApiKeyScope:                          {operation: "RotateApiKey"},
+		AdminRevokeApiKeyScope:                          {operation: "RevokeApiKey"},
+		AdminStreamReplicationMessagesScope:             {operation: "StreamReplicationMessages"},
+		AdminStartNamespaceHandoverScope:                {operation: "StartNamespaceHandover"},
+		AdminDescribeNamespaceHandoverScope:             {operation: "DescribeNamespaceHandover"},
+		AdminSyncSearchAttributesScope:                  {operation: "SyncSearchAttributes"},
+		AdminPurgeDLQMessagesAllShardsScope:             {operation: "PurgeDLQMessagesAllShards"},
+		AdminMergeDLQMessagesAllShardsScope:             {operation: "MergeDLQMessagesAllShards"},
+		AdminVerifyWorkflowReplicationScope:             {operation: "VerifyWorkflowReplication"},
 		AdminResendReplicationTasksScope:                {operation: "ResendReplicationTasks"},
 		AdminGetTaskQueueTasksScope:                     {operation: "GetTaskQueueTasks"},
+		AdminReportNamespaceQuotaUsageScope:             {operation: "ReportNamespaceQuotaUsage"},
+		AdminListPollerCountsScope:                      {operation: "ListPollerCounts"},
 		AdminDescribeClusterScope:                       {operation: "AdminDescribeCluster"},
 		AdminListClustersScope:                          {operation: "AdminListClusters"},
 		AdminAddOrUpdateRemoteClusterScope:              {operation: "AdminAddOrUpdateRemoteCluster"},
 		AdminRemoveRemoteClusterScope:                   {operation: "AdminRemoveRemoteCluster"},
+		AdminListClusterMetadataHistoryScope:            {operation: "AdminListClusterMetadataHistory"},
+		AdminRollbackClusterMetadataScope:               {operation: "AdminRollbackClusterMetadata"},
 		OperatorAddSearchAttributesScope:                {operation: "OperatorAddSearchAttributes"},
 		OperatorRemoveSearchAttributesScope:             {operation: "OperatorRemoveSearchAttributes"},
 		OperatorListSearchAttributesScope:               {operation: "OperatorListSearchAttributes"},
@@ -1581,6 +1724,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendGetSystemInfoScope:                      {operation: "GetSystemInfo"},
 		VersionCheckScope:                               {operation: "VersionCheck"},
 		AuthorizationScope:                              {operation: "Authorization"},
+		AuditLogScope:                                   {operation: "AuditLog"},
+		ReplicationLagScope:                             {operation: "ReplicationLag"},
 	},
 	// History Scope Names
 	History: {
@@ -1620,11 +1765,13 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryShardControllerScope:                     {operation: "ShardController"},
 		HistoryReapplyEventsScope:                       {operation: "EventReapplication"},
 		HistoryRefreshWorkflowTasksScope:                {operation: "RefreshWorkflowTasks"},
+		HistoryUnpauseWorkflowExecutionScope:            {operation: "UnpauseWorkflowExecution"},
 		HistoryGenerateLastHistoryReplicationTasksScope: {operation: "GenerateLastHistoryReplicationTasks"},
 		HistoryGetReplicationStatusScope:                {operation: "GetReplicationStatus"},
 		HistoryHistoryRemoveTaskScope:                   {operation: "RemoveTask"},
 		HistoryCloseShard:                               {operation: "CloseShard"},
 		HistoryGetShard:                                 {operation: "GetShard"},
+		HistoryCompleteShardHandoffScope:                {operation: "CompleteShardHandoff"},
 		HistoryReplicateEventsV2:                        {operation: "ReplicateEventsV2"},
 		HistoryResetStickyTaskQueue:                     {operation: "ResetStickyTaskQueue"},
 		HistoryReapplyEvents:                            {operation: "ReapplyEvents"},
@@ -1753,6 +1900,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 const (
 	ServiceRequests = iota
 	ServicePendingRequests
+	ServiceTaskQueuePollers
 	ServiceFailures
 	ServiceCriticalFailures
 	ServiceLatency
@@ -1775,6 +1923,11 @@ const (
 	ServiceErrNonDeterministicCounter
 	ServiceErrUnauthorizedCounter
 	ServiceErrAuthorizeFailedCounter
+	AuditRecordFailures
+	AuditRecordsDropped
+	ServiceRequestSize
+	ServiceRequestSizeExceedsWarnLimitCounter
+	ServiceErrRequestSizeExceedsLimitCounter
 
 	PersistenceRequests
 	PersistenceFailures
@@ -1870,6 +2023,13 @@ const (
 	NamespaceReplicationDLQAckLevelGauge
 	NamespaceReplicationDLQMaxLevelGauge
 
+	ClusterReplicationTaskLagGauge
+	ClusterReplicationMaxTaskLagGauge
+	ClusterReplicationTimeLagGauge
+	ClusterReplicationDLQSizeGauge
+	NamespaceReplicationTaskLagGauge
+	NamespaceReplicationTimeLagGauge
+
 	// common metrics that are emitted per task queue
 
 	ServiceRequestsPerTaskQueue
@@ -1959,6 +2119,7 @@ const (
 	MultipleCompletionCommandsCounter
 	FailedWorkflowTasksCounter
 	WorkflowTaskAttempt
+	WorkflowTaskPausedCounter
 	StaleMutableStateCounter
 	AutoResetPointsLimitExceededCounter
 	AutoResetPointCorruptionCounter
@@ -1999,6 +2160,10 @@ const (
 	GetEngineForShardErrorCounter
 	GetEngineForShardLatency
 	RemoveEngineForShardLatency
+	ShardHandoffDrainLatency
+	ShardHandoffLatency
+	ShardHandoffFailedCounter
+	ShardHandoffTimeoutCounter
 	CompleteWorkflowTaskWithStickyEnabledCounter
 	CompleteWorkflowTaskWithStickyDisabledCounter
 	WorkflowTaskHeartbeatTimeoutCounter
@@ -2065,6 +2230,7 @@ const (
 	ReplicationTasksReturned
 	ReplicationTasksAppliedLatency
 	ReplicationDLQFailed
+	ReplicationDLQMergeFailed
 	ReplicationDLQMaxLevelGauge
 	ReplicationDLQAckLevelGauge
 	GetReplicationMessagesForShardLatency
@@ -2252,6 +2418,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 	Common: {
 		ServiceRequests:                                     NewCounterDef("service_requests"),
 		ServicePendingRequests:                              NewGaugeDef("service_pending_requests"),
+		ServiceTaskQueuePollers:                             NewGaugeDef("service_task_queue_pollers"),
 		ServiceFailures:                                     NewCounterDef("service_errors"),
 		ServiceCriticalFailures:                             NewCounterDef("service_errors_critical"),
 		ServiceLatency:                                      NewTimerDef("service_latency"),
@@ -2274,6 +2441,11 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ServiceErrNonDeterministicCounter:                   NewCounterDef("service_errors_nondeterministic"),
 		ServiceErrUnauthorizedCounter:                       NewCounterDef("service_errors_unauthorized"),
 		ServiceErrAuthorizeFailedCounter:                    NewCounterDef("service_errors_authorize_failed"),
+		AuditRecordFailures:                                 NewCounterDef("audit_record_failures"),
+		AuditRecordsDropped:                                 NewCounterDef("audit_records_dropped"),
+		ServiceRequestSize:                                  NewBytesHistogramDef("service_request_size"),
+		ServiceRequestSizeExceedsWarnLimitCounter:           NewCounterDef("service_request_size_exceeds_warn_limit"),
+		ServiceErrRequestSizeExceedsLimitCounter:            NewCounterDef("service_errors_request_size_exceeds_limit"),
 		PersistenceRequests:                                 NewCounterDef("persistence_requests"),
 		PersistenceFailures:                                 NewCounterDef("persistence_errors"),
 		PersistenceLatency:                                  NewTimerDef("persistence_latency"),
@@ -2367,6 +2539,13 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		NamespaceReplicationDLQAckLevelGauge:  NewGaugeDef("namespace_dlq_ack_level"),
 		NamespaceReplicationDLQMaxLevelGauge:  NewGaugeDef("namespace_dlq_max_level"),
 
+		ClusterReplicationTaskLagGauge:    NewGaugeDef("cluster_replication_task_lag"),
+		ClusterReplicationMaxTaskLagGauge: NewGaugeDef("cluster_replication_max_shard_task_lag"),
+		ClusterReplicationTimeLagGauge:    NewGaugeDef("cluster_replication_time_lag_seconds"),
+		ClusterReplicationDLQSizeGauge:    NewGaugeDef("cluster_replication_dlq_size"),
+		NamespaceReplicationTaskLagGauge:  NewGaugeDef("namespace_replication_task_lag"),
+		NamespaceReplicationTimeLagGauge:  NewGaugeDef("namespace_replication_time_lag_seconds"),
+
 		// per task queue common metrics
 
 		ServiceRequestsPerTaskQueue:                               NewRollupCounterDef("service_requests_per_tl", "service_requests"),
@@ -2441,6 +2620,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		MultipleCompletionCommandsCounter:                 NewCounterDef("multiple_completion_commands"),
 		FailedWorkflowTasksCounter:                        NewCounterDef("failed_workflow_tasks"),
 		WorkflowTaskAttempt:                               NewDimensionlessHistogramDef("worrkflow_task_attempt"),
+		WorkflowTaskPausedCounter:                         NewCounterDef("workflow_task_paused"),
 		StaleMutableStateCounter:                          NewCounterDef("stale_mutable_state"),
 		AutoResetPointsLimitExceededCounter:               NewCounterDef("auto_reset_points_exceed_limit"),
 		AutoResetPointCorruptionCounter:                   NewCounterDef("auto_reset_point_corruption"),
@@ -2481,6 +2661,10 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		GetEngineForShardErrorCounter:                     NewCounterDef("get_engine_for_shard_errors"),
 		GetEngineForShardLatency:                          NewTimerDef("get_engine_for_shard_latency"),
 		RemoveEngineForShardLatency:                       NewTimerDef("remove_engine_for_shard_latency"),
+		ShardHandoffDrainLatency:                          NewTimerDef("shard_handoff_drain_latency"),
+		ShardHandoffLatency:                               NewTimerDef("shard_handoff_latency"),
+		ShardHandoffFailedCounter:                         NewCounterDef("shard_handoff_failed_count"),
+		ShardHandoffTimeoutCounter:                        NewCounterDef("shard_handoff_timeout_count"),
 		CompleteWorkflowTaskWithStickyEnabledCounter:      NewCounterDef("complete_workflow_task_sticky_enabled_count"),
 		CompleteWorkflowTaskWithStickyDisabledCounter:     NewCounterDef("complete_workflow_task_sticky_disabled_count"),
 		WorkflowTaskHeartbeatTimeoutCounter:               NewCounterDef("workflow_task_heartbeat_timeout_count"),
@@ -2547,6 +2731,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ReplicationTasksReturned:                          NewTimerDef("replication_tasks_returned"),
 		ReplicationTasksAppliedLatency:                    NewTimerDef("replication_tasks_applied_latency"),
 		ReplicationDLQFailed:                              NewCounterDef("replication_dlq_enqueue_failed"),
+		ReplicationDLQMergeFailed:                         NewCounterDef("replication_dlq_merge_failed"),
 		ReplicationDLQMaxLevelGauge:                       NewGaugeDef("replication_dlq_max_level"),
 		ReplicationDLQAckLevelGauge:                       NewGaugeDef("replication_dlq_ack_level"),
 		GetReplicationMessagesForShardLatency:             NewTimerDef("get_replication_messages_for_shard"),
@@ -0,0 +1,167 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"fmt"
+	"strings"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/archiver"
+)
+
+const (
+	dataResidencyKeyPrefix = "temporal.dataResidency."
+
+	// DataResidencyAllowedClustersKey is the namespace data key of the comma separated list of clusters
+	// the namespace may be replicated to.
+	DataResidencyAllowedClustersKey = "temporal.dataResidency.allowedClusters"
+	// DataResidencyAllowedArchivalSchemesKey is the namespace data key of the comma separated list of
+	// archival URI schemes the namespace may be archived with.
+	DataResidencyAllowedArchivalSchemesKey = "temporal.dataResidency.allowedArchivalSchemes"
+	// DataResidencyAllowedArchivalRegionsKey is the namespace data key of the comma separated list of
+	// regions the namespace may be archived into.
+	DataResidencyAllowedArchivalRegionsKey = "temporal.dataResidency.allowedArchivalRegions"
+)
+
+type (
+	// DataResidencyPolicy restricts where data of a namespace may be stored. The policy is kept in the
+	// namespace data under the DataResidency*Key keys, an empty list does not restrict anything.
+	DataResidencyPolicy struct {
+		AllowedClusters        []string
+		AllowedArchivalSchemes []string
+		// AllowedArchivalRegions applies to archivers configured with a region only, e.g. s3store.
+		AllowedArchivalRegions []string
+	}
+
+	// dataResidencyChecker checks archival against the data residency policy of namespaces in the registry.
+	dataResidencyChecker struct {
+		registry Registry
+	}
+)
+
+var _ archiver.DataResidencyChecker = (*dataResidencyChecker)(nil)
+
+// ParseDataResidencyPolicy reads the data residency policy from namespace data.
+func ParseDataResidencyPolicy(data map[string]string) *DataResidencyPolicy {
+	return &DataResidencyPolicy{
+		AllowedClusters:        splitDataResidencyList(data[DataResidencyAllowedClustersKey]),
+		AllowedArchivalSchemes: splitDataResidencyList(data[DataResidencyAllowedArchivalSchemesKey]),
+		AllowedArchivalRegions: splitDataResidencyList(data[DataResidencyAllowedArchivalRegionsKey]),
+	}
+}
+
+// setData writes the policy into namespace data, keys of empty lists are removed.
+func (p *DataResidencyPolicy) setData(data map[string]string) {
+	for key, items := range map[string][]string{
+		DataResidencyAllowedClustersKey:        p.AllowedClusters,
+		DataResidencyAllowedArchivalSchemesKey: p.AllowedArchivalSchemes,
+		DataResidencyAllowedArchivalRegionsKey: p.AllowedArchivalRegions,
+	} {
+		if len(items) == 0 {
+			delete(data, key)
+			continue
+		}
+		data[key] = strings.Join(items, ",")
+	}
+}
+
+// ValidateClusters returns an InvalidArgument error if any of the clusters is not allowed by the policy.
+func (p *DataResidencyPolicy) ValidateClusters(clusters []string) error {
+	for _, cluster := range clusters {
+		if !p.AllowsCluster(cluster) {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("Cluster %v is not allowed by the namespace data residency policy, allowed clusters: %v.", cluster, p.AllowedClusters))
+		}
+	}
+	return nil
+}
+
+// ValidateArchival returns an InvalidArgument error if archiving with the URI scheme into the region is not
+// allowed by the policy. Empty region means the archiver is not region aware.
+func (p *DataResidencyPolicy) ValidateArchival(scheme string, region string) error {
+	if len(p.AllowedArchivalSchemes) != 0 && !containsDataResidencyItem(p.AllowedArchivalSchemes, scheme) {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Archival URI scheme %v is not allowed by the namespace data residency policy, allowed schemes: %v.", scheme, p.AllowedArchivalSchemes))
+	}
+	if region != "" && len(p.AllowedArchivalRegions) != 0 && !containsDataResidencyItem(p.AllowedArchivalRegions, region) {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Archival region %v is not allowed by the namespace data residency policy, allowed regions: %v.", region, p.AllowedArchivalRegions))
+	}
+	return nil
+}
+
+// RestrictsArchival returns true if the policy restricts archival URI schemes or regions.
+func (p *DataResidencyPolicy) RestrictsArchival() bool {
+	return len(p.AllowedArchivalSchemes) != 0 || len(p.AllowedArchivalRegions) != 0
+}
+
+// AllowsCluster returns true if the namespace may be replicated to the cluster.
+func (p *DataResidencyPolicy) AllowsCluster(cluster string) bool {
+	return len(p.AllowedClusters) == 0 || containsDataResidencyItem(p.AllowedClusters, cluster)
+}
+
+// NewDataResidencyChecker returns an archiver.DataResidencyChecker which looks up the data residency policy
+// of namespaces in the registry.
+func NewDataResidencyChecker(registry Registry) archiver.DataResidencyChecker {
+	return &dataResidencyChecker{
+		registry: registry,
+	}
+}
+
+func (c *dataResidencyChecker) CheckArchival(namespaceID string, URI archiver.URI, region string) (bool, error) {
+	ns, err := c.registry.GetNamespaceByID(ID(namespaceID))
+	if err != nil {
+		return false, err
+	}
+	return ns.DataResidencyPolicy().ValidateArchival(URI.Scheme(), region) == nil, nil
+}
+
+func splitDataResidencyList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// hasDataResidencyKey returns true if the namespace data contains any key of the data residency policy.
+func hasDataResidencyKey(data map[string]string) bool {
+	for key := range data {
+		if strings.HasPrefix(key, dataResidencyKeyPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsDataResidencyItem(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
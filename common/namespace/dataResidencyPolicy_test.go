@@ -0,0 +1,72 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/namespace"
+)
+
+func TestDataResidencyPolicy(t *testing.T) {
+	policy := namespace.ParseDataResidencyPolicy(map[string]string{
+		namespace.DataResidencyAllowedClustersKey:        "eu-west, eu-central ",
+		namespace.DataResidencyAllowedArchivalSchemesKey: "s3",
+		namespace.DataResidencyAllowedArchivalRegionsKey: "eu-west-1,",
+		"other": "value",
+	})
+	require.Equal(t, &namespace.DataResidencyPolicy{
+		AllowedClusters:        []string{"eu-west", "eu-central"},
+		AllowedArchivalSchemes: []string{"s3"},
+		AllowedArchivalRegions: []string{"eu-west-1"},
+	}, policy)
+
+	require.NoError(t, policy.ValidateClusters([]string{"eu-west", "eu-central"}))
+	err := policy.ValidateClusters([]string{"eu-west", "us-east"})
+	require.IsType(t, &serviceerror.InvalidArgument{}, err)
+	require.Contains(t, err.Error(), "us-east")
+
+	require.NoError(t, policy.ValidateArchival("s3", "eu-west-1"))
+	require.Error(t, policy.ValidateArchival("s3", "us-east-1"))
+	require.Error(t, policy.ValidateArchival("file", ""))
+	require.Error(t, policy.ValidateArchival("gs", ""))
+
+	// archival regions don't apply to archivers which are not region aware
+	policy = namespace.ParseDataResidencyPolicy(map[string]string{
+		namespace.DataResidencyAllowedArchivalRegionsKey: "eu-west-1",
+	})
+	require.NoError(t, policy.ValidateArchival("file", ""))
+	require.Error(t, policy.ValidateArchival("s3", "us-east-1"))
+
+	// empty policy allows everything
+	policy = namespace.ParseDataResidencyPolicy(nil)
+	require.False(t, policy.RestrictsArchival())
+	require.True(t, policy.AllowsCluster("us-east"))
+	require.NoError(t, policy.ValidateClusters([]string{"us-east", "eu-west"}))
+	require.NoError(t, policy.ValidateArchival("s3", "us-east-1"))
+}
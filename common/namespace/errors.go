@@ -36,4 +36,11 @@ var (
 	errCannotDoNamespaceFailoverAndUpdate = serviceerror.NewInvalidArgument("Cannot set active cluster to current cluster when other parameters are set.")
 	errInvalidRetentionPeriod             = serviceerror.NewInvalidArgument("A valid retention period is not set on request.")
 	errInvalidArchivalConfig              = serviceerror.NewInvalidArgument("Invalid to enable archival without specifying a uri.")
+	errReplicationFilterOnLocalNamespace  = serviceerror.NewInvalidArgument("Replication filter can only be set on global namespace.")
+	errDataResidencyPolicyNotUpdatable    = serviceerror.NewInvalidArgument("Data residency policy can only be changed with the admin UpdateNamespaceDataResidencyPolicy API.")
+	errNewNamespaceNameNotSet             = serviceerror.NewInvalidArgument("New namespace name is not set.")
+	errRenameToSameName                   = serviceerror.NewInvalidArgument("New namespace name is the same as the current one.")
+	errAPIKeyIDNotSet                     = serviceerror.NewInvalidArgument("API key id is not set.")
+	errAPIKeyAlreadyExists                = serviceerror.NewInvalidArgument("API key with the same id already exists.")
+	errAPIKeyNotFound                     = serviceerror.NewNotFound("API key not found.")
 )
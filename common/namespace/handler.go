@@ -75,6 +75,39 @@ type (
 			ctx context.Context,
 			updateRequest *workflowservice.UpdateNamespaceRequest,
 		) (*workflowservice.UpdateNamespaceResponse, error)
+		UpdateReplicationFilter(
+			ctx context.Context,
+			namespaceName string,
+			filter string,
+		) error
+		UpdateDataResidencyPolicy(
+			ctx context.Context,
+			namespaceName string,
+			policy *DataResidencyPolicy,
+		) error
+		RenameNamespace(
+			ctx context.Context,
+			namespaceName string,
+			newName string,
+			aliasGracePeriod time.Duration,
+		) error
+		CreateAPIKey(
+			ctx context.Context,
+			namespaceName string,
+			apiKey *persistencespb.NamespaceApiKey,
+		) error
+		RotateAPIKey(
+			ctx context.Context,
+			namespaceName string,
+			id string,
+			secretHash string,
+			previousSecretExpiration time.Time,
+		) (*persistencespb.NamespaceApiKey, error)
+		RevokeAPIKey(
+			ctx context.Context,
+			namespaceName string,
+			id string,
+		) error
 	}
 
 	// HandlerImpl is the namespace operation handler implementation
@@ -82,6 +115,7 @@ type (
 		maxBadBinaryCount      dynamicconfig.IntPropertyFnWithNamespaceFilter
 		logger                 log.Logger
 		metadataMgr            persistence.MetadataManager
+		namespaceRegistry      Registry
 		clusterMetadata        cluster.Metadata
 		namespaceReplicator    Replicator
 		namespaceAttrValidator *AttrValidatorImpl
@@ -98,6 +132,7 @@ func NewHandler(
 	maxBadBinaryCount dynamicconfig.IntPropertyFnWithNamespaceFilter,
 	logger log.Logger,
 	metadataMgr persistence.MetadataManager,
+	namespaceRegistry Registry,
 	clusterMetadata cluster.Metadata,
 	namespaceReplicator Replicator,
 	archivalMetadata archiver.ArchivalMetadata,
@@ -107,6 +142,7 @@ func NewHandler(
 		maxBadBinaryCount:      maxBadBinaryCount,
 		logger:                 logger,
 		metadataMgr:            metadataMgr,
+		namespaceRegistry:      namespaceRegistry,
 		clusterMetadata:        clusterMetadata,
 		namespaceReplicator:    namespaceReplicator,
 		namespaceAttrValidator: newAttrValidator(clusterMetadata),
@@ -246,6 +282,14 @@ func (d *HandlerImpl) RegisterNamespace(
 		}
 	}
 
+	if err := d.validateDataResidencyPolicy(ParseDataResidencyPolicy(info.Data), config, replicationConfig); err != nil {
+		return nil, err
+	}
+
+	if err := d.validateNameNotAliased(registerRequest.GetNamespace(), ""); err != nil {
+		return nil, err
+	}
+
 	failoverVersion := common.EmptyVersion
 	if registerRequest.GetIsGlobalNamespace() {
 		failoverVersion = d.clusterMetadata.GetNextFailoverVersion(activeClusterName, 0)
@@ -440,6 +484,10 @@ func (d *HandlerImpl) UpdateNamespace(
 			info.Owner = updatedInfo.GetOwnerEmail()
 		}
 		if updatedInfo.Data != nil {
+			// the policy is set by the operator, namespace users must not be able to relax or clear it
+			if hasDataResidencyKey(updatedInfo.Data) {
+				return nil, errDataResidencyPolicyNotUpdatable
+			}
 			configurationChanged = true
 			// only do merging
 			info.Data = d.mergeNamespaceData(info.Data, updatedInfo.Data)
@@ -539,6 +587,10 @@ func (d *HandlerImpl) UpdateNamespace(
 		}
 	}
 
+	if err := d.validateDataResidencyPolicy(ParseDataResidencyPolicy(info.Data), config, replicationConfig); err != nil {
+		return nil, err
+	}
+
 	if configurationChanged && activeClusterChanged && isGlobalNamespace {
 		return nil, errCannotDoNamespaceFailoverAndUpdate
 	} else if configurationChanged || activeClusterChanged || needsNamespacePromotion {
@@ -599,6 +651,407 @@ func (d *HandlerImpl) UpdateNamespace(
 	return response, nil
 }
 
+// UpdateReplicationFilter sets the filter of workflow executions replicated to remote clusters of a global namespace.
+// Empty filter replicates all workflow executions.
+func (d *HandlerImpl) UpdateReplicationFilter(
+	ctx context.Context,
+	namespaceName string,
+	filter string,
+) error {
+
+	if filter != "" {
+		if _, err := ParseReplicationFilter(filter); err != nil {
+			return err
+		}
+	}
+
+	// must get the metadata (notificationVersion) first, see UpdateNamespace
+	metadata, err := d.metadataMgr.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	getResponse, err := d.metadataMgr.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: namespaceName})
+	if err != nil {
+		return err
+	}
+	if !getResponse.IsGlobalNamespace {
+		return errReplicationFilterOnLocalNamespace
+	}
+
+	replicationConfig := getResponse.Namespace.ReplicationConfig
+	if replicationConfig.Filter == filter {
+		return nil
+	}
+	replicationConfig.Filter = filter
+	configVersion := getResponse.Namespace.ConfigVersion + 1
+
+	err = d.metadataMgr.UpdateNamespace(ctx, &persistence.UpdateNamespaceRequest{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:                        getResponse.Namespace.Info,
+			Config:                      getResponse.Namespace.Config,
+			ReplicationConfig:           replicationConfig,
+			ConfigVersion:               configVersion,
+			FailoverVersion:             getResponse.Namespace.FailoverVersion,
+			FailoverNotificationVersion: getResponse.Namespace.FailoverNotificationVersion,
+		},
+		IsGlobalNamespace:   getResponse.IsGlobalNamespace,
+		NotificationVersion: metadata.NotificationVersion,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = d.namespaceReplicator.HandleTransmissionTask(
+		ctx,
+		enumsspb.NAMESPACE_OPERATION_UPDATE,
+		getResponse.Namespace.Info,
+		getResponse.Namespace.Config,
+		replicationConfig,
+		configVersion,
+		getResponse.Namespace.FailoverVersion,
+		getResponse.IsGlobalNamespace,
+	)
+	if err != nil {
+		return err
+	}
+
+	d.logger.Info("Update namespace replication filter succeeded",
+		tag.WorkflowNamespace(namespaceName),
+		tag.WorkflowNamespaceID(getResponse.Namespace.Info.Id),
+	)
+	return nil
+}
+
+// UpdateDataResidencyPolicy replaces the data residency policy of a namespace. The policy must allow
+// the current replication clusters and archival destinations of the namespace.
+func (d *HandlerImpl) UpdateDataResidencyPolicy(
+	ctx context.Context,
+	namespaceName string,
+	policy *DataResidencyPolicy,
+) error {
+
+	// must get the metadata (notificationVersion) first, see UpdateNamespace
+	metadata, err := d.metadataMgr.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	getResponse, err := d.metadataMgr.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: namespaceName})
+	if err != nil {
+		return err
+	}
+
+	info := getResponse.Namespace.Info
+	config := getResponse.Namespace.Config
+	replicationConfig := getResponse.Namespace.ReplicationConfig
+	if err := d.validateDataResidencyPolicy(policy, config, replicationConfig); err != nil {
+		return err
+	}
+
+	if info.Data == nil {
+		info.Data = make(map[string]string)
+	}
+	policy.setData(info.Data)
+	configVersion := getResponse.Namespace.ConfigVersion + 1
+
+	err = d.metadataMgr.UpdateNamespace(ctx, &persistence.UpdateNamespaceRequest{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:                        info,
+			Config:                      config,
+			ReplicationConfig:           replicationConfig,
+			ConfigVersion:               configVersion,
+			FailoverVersion:             getResponse.Namespace.FailoverVersion,
+			FailoverNotificationVersion: getResponse.Namespace.FailoverNotificationVersion,
+		},
+		IsGlobalNamespace:   getResponse.IsGlobalNamespace,
+		NotificationVersion: metadata.NotificationVersion,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = d.namespaceReplicator.HandleTransmissionTask(
+		ctx,
+		enumsspb.NAMESPACE_OPERATION_UPDATE,
+		info,
+		config,
+		replicationConfig,
+		configVersion,
+		getResponse.Namespace.FailoverVersion,
+		getResponse.IsGlobalNamespace,
+	)
+	if err != nil {
+		return err
+	}
+
+	d.logger.Info("Update namespace data residency policy succeeded",
+		tag.WorkflowNamespace(namespaceName),
+		tag.WorkflowNamespaceID(info.Id),
+	)
+	return nil
+}
+
+// RenameNamespace changes the name of a namespace, the namespace id stays the same.
+// Previous name keeps resolving to the namespace until the alias grace period passes.
+func (d *HandlerImpl) RenameNamespace(
+	ctx context.Context,
+	namespaceName string,
+	newName string,
+	aliasGracePeriod time.Duration,
+) error {
+
+	if newName == "" {
+		return errNewNamespaceNameNotSet
+	}
+	if newName == namespaceName {
+		return errRenameToSameName
+	}
+
+	// must get the metadata (notificationVersion) first, see UpdateNamespace
+	metadata, err := d.metadataMgr.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	getResponse, err := d.metadataMgr.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: namespaceName})
+	if err != nil {
+		return err
+	}
+	if getResponse.IsGlobalNamespace && !d.clusterMetadata.IsMasterCluster() {
+		return errNotMasterCluster
+	}
+
+	_, err = d.metadataMgr.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: newName})
+	switch err.(type) {
+	case nil:
+		return serviceerror.NewNamespaceAlreadyExists("Namespace already exists.")
+	case *serviceerror.NotFound:
+	default:
+		return err
+	}
+	info := getResponse.Namespace.Info
+	if err := d.validateNameNotAliased(newName, info.Id); err != nil {
+		return err
+	}
+
+	// the alias is saved and the config version bumped first, so remote clusters apply the rename in order
+	now := time.Now().UTC()
+	info.NameAliases = addNameAlias(info.NameAliases, namespaceName, newName, now.Add(aliasGracePeriod), now)
+	configVersion := getResponse.Namespace.ConfigVersion + 1
+	err = d.metadataMgr.UpdateNamespace(ctx, &persistence.UpdateNamespaceRequest{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:                        info,
+			Config:                      getResponse.Namespace.Config,
+			ReplicationConfig:           getResponse.Namespace.ReplicationConfig,
+			ConfigVersion:               configVersion,
+			FailoverVersion:             getResponse.Namespace.FailoverVersion,
+			FailoverNotificationVersion: getResponse.Namespace.FailoverNotificationVersion,
+		},
+		IsGlobalNamespace:   getResponse.IsGlobalNamespace,
+		NotificationVersion: metadata.NotificationVersion,
+	})
+	if err != nil {
+		return err
+	}
+	if err := d.metadataMgr.RenameNamespace(ctx, &persistence.RenameNamespaceRequest{
+		PreviousName: namespaceName,
+		NewName:      newName,
+	}); err != nil {
+		return err
+	}
+	info.Name = newName
+
+	err = d.namespaceReplicator.HandleTransmissionTask(
+		ctx,
+		enumsspb.NAMESPACE_OPERATION_UPDATE,
+		info,
+		getResponse.Namespace.Config,
+		getResponse.Namespace.ReplicationConfig,
+		configVersion,
+		getResponse.Namespace.FailoverVersion,
+		getResponse.IsGlobalNamespace,
+	)
+	if err != nil {
+		return err
+	}
+
+	d.logger.Info("Rename namespace succeeded",
+		tag.WorkflowNamespace(newName),
+		tag.WorkflowNamespaceID(info.Id),
+		tag.NewStringTag("previous-namespace", namespaceName),
+	)
+	return nil
+}
+
+// CreateAPIKey adds an API key to a namespace, only the hash of the key secret is stored.
+func (d *HandlerImpl) CreateAPIKey(
+	ctx context.Context,
+	namespaceName string,
+	apiKey *persistencespb.NamespaceApiKey,
+) error {
+
+	if apiKey.GetId() == "" {
+		return errAPIKeyIDNotSet
+	}
+	return d.updateAPIKeys(ctx, namespaceName, func(info *persistencespb.NamespaceInfo) error {
+		for _, key := range info.ApiKeys {
+			if key.GetId() == apiKey.GetId() {
+				return errAPIKeyAlreadyExists
+			}
+		}
+		info.ApiKeys = append(info.ApiKeys, apiKey)
+		return nil
+	})
+}
+
+// RotateAPIKey replaces the secret hash of an API key of a namespace.
+// Previous secret stays valid until previousSecretExpiration.
+func (d *HandlerImpl) RotateAPIKey(
+	ctx context.Context,
+	namespaceName string,
+	id string,
+	secretHash string,
+	previousSecretExpiration time.Time,
+) (*persistencespb.NamespaceApiKey, error) {
+
+	var rotated *persistencespb.NamespaceApiKey
+	err := d.updateAPIKeys(ctx, namespaceName, func(info *persistencespb.NamespaceInfo) error {
+		for i, key := range info.ApiKeys {
+			if key.GetId() != id {
+				continue
+			}
+			now := time.Now().UTC()
+			rotated = &persistencespb.NamespaceApiKey{
+				Id:         key.GetId(),
+				Name:       key.GetName(),
+				Role:       key.GetRole(),
+				SecretHash: secretHash,
+				CreateTime: key.GetCreateTime(),
+				RotateTime: &now,
+			}
+			if previousSecretExpiration.After(now) {
+				rotated.PreviousSecretHash = key.GetSecretHash()
+				rotated.PreviousSecretExpirationTime = &previousSecretExpiration
+			}
+			info.ApiKeys[i] = rotated
+			return nil
+		}
+		return errAPIKeyNotFound
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rotated, nil
+}
+
+// RevokeAPIKey deletes an API key of a namespace.
+func (d *HandlerImpl) RevokeAPIKey(
+	ctx context.Context,
+	namespaceName string,
+	id string,
+) error {
+
+	return d.updateAPIKeys(ctx, namespaceName, func(info *persistencespb.NamespaceInfo) error {
+		for i, key := range info.ApiKeys {
+			if key.GetId() == id {
+				info.ApiKeys = append(info.ApiKeys[:i:i], info.ApiKeys[i+1:]...)
+				return nil
+			}
+		}
+		return errAPIKeyNotFound
+	})
+}
+
+// updateAPIKeys applies update to the info of a namespace, then saves and replicates the namespace.
+func (d *HandlerImpl) updateAPIKeys(
+	ctx context.Context,
+	namespaceName string,
+	update func(info *persistencespb.NamespaceInfo) error,
+) error {
+
+	// must get the metadata (notificationVersion) first, see UpdateNamespace
+	metadata, err := d.metadataMgr.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	getResponse, err := d.metadataMgr.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: namespaceName})
+	if err != nil {
+		return err
+	}
+	if getResponse.IsGlobalNamespace && !d.clusterMetadata.IsMasterCluster() {
+		return errNotMasterCluster
+	}
+
+	info := getResponse.Namespace.Info
+	if err := update(info); err != nil {
+		return err
+	}
+	configVersion := getResponse.Namespace.ConfigVersion + 1
+	err = d.metadataMgr.UpdateNamespace(ctx, &persistence.UpdateNamespaceRequest{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:                        info,
+			Config:                      getResponse.Namespace.Config,
+			ReplicationConfig:           getResponse.Namespace.ReplicationConfig,
+			ConfigVersion:               configVersion,
+			FailoverVersion:             getResponse.Namespace.FailoverVersion,
+			FailoverNotificationVersion: getResponse.Namespace.FailoverNotificationVersion,
+		},
+		IsGlobalNamespace:   getResponse.IsGlobalNamespace,
+		NotificationVersion: metadata.NotificationVersion,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = d.namespaceReplicator.HandleTransmissionTask(
+		ctx,
+		enumsspb.NAMESPACE_OPERATION_UPDATE,
+		info,
+		getResponse.Namespace.Config,
+		getResponse.Namespace.ReplicationConfig,
+		configVersion,
+		getResponse.Namespace.FailoverVersion,
+		getResponse.IsGlobalNamespace,
+	)
+	if err != nil {
+		return err
+	}
+
+	d.logger.Info("Update namespace API keys succeeded",
+		tag.WorkflowNamespace(namespaceName),
+		tag.WorkflowNamespaceID(info.Id),
+	)
+	return nil
+}
+
+// validateNameNotAliased returns an error if the name is an unexpired alias of a namespace other than namespaceID.
+// Aliases are resolved by the name index of the namespace registry, so an alias added by a rename
+// is only seen after the next registry refresh.
+func (d *HandlerImpl) validateNameNotAliased(
+	name string,
+	namespaceID string,
+) error {
+
+	ns, err := d.namespaceRegistry.GetNamespace(Name(name))
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		return nil
+	default:
+		return err
+	}
+	if ns.ID() == ID(namespaceID) || ns.Name() == Name(name) {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	for _, alias := range ns.info.NameAliases {
+		if alias.GetName() == name && alias.GetExpirationTime().After(now) {
+			return serviceerror.NewNamespaceAlreadyExists(fmt.Sprintf(
+				"Namespace name is an alias of namespace %v until %v.", ns.Name(), alias.GetExpirationTime()))
+		}
+	}
+	return nil
+}
+
 // DeprecateNamespace deprecates a namespace
 // Deprecated.
 func (d *HandlerImpl) DeprecateNamespace(
@@ -756,6 +1209,41 @@ func (d *HandlerImpl) toArchivalUpdateEvent(
 	return event, nil
 }
 
+// validateDataResidencyPolicy checks the replication clusters and the enabled archival of a namespace
+// against its data residency policy.
+func (d *HandlerImpl) validateDataResidencyPolicy(
+	policy *DataResidencyPolicy,
+	config *persistencespb.NamespaceConfig,
+	replicationConfig *persistencespb.NamespaceReplicationConfig,
+) error {
+
+	if err := policy.ValidateClusters(replicationConfig.Clusters); err != nil {
+		return err
+	}
+	if !policy.RestrictsArchival() {
+		return nil
+	}
+	if config.HistoryArchivalState == enumspb.ARCHIVAL_STATE_ENABLED {
+		URI, err := archiver.NewURI(config.HistoryArchivalUri)
+		if err != nil {
+			return err
+		}
+		if err := policy.ValidateArchival(URI.Scheme(), d.archiverProvider.GetHistoryArchiverRegion(URI.Scheme())); err != nil {
+			return err
+		}
+	}
+	if config.VisibilityArchivalState == enumspb.ARCHIVAL_STATE_ENABLED {
+		URI, err := archiver.NewURI(config.VisibilityArchivalUri)
+		if err != nil {
+			return err
+		}
+		if err := policy.ValidateArchival(URI.Scheme(), d.archiverProvider.GetVisibilityArchiverRegion(URI.Scheme())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (d *HandlerImpl) validateHistoryArchivalURI(URIString string) error {
 	URI, err := archiver.NewURI(URIString)
 	if err != nil {
@@ -797,6 +1285,31 @@ func validateRetentionDuration(retention time.Duration, isGlobalNamespace bool)
 	return nil
 }
 
+// addNameAlias adds the previous name as an alias expiring at the given time. Expired aliases,
+// earlier aliases of the same name and the alias of the new name are dropped.
+func addNameAlias(
+	aliases []*persistencespb.NamespaceNameAlias,
+	previousName string,
+	newName string,
+	expiration time.Time,
+	now time.Time,
+) []*persistencespb.NamespaceNameAlias {
+	var result []*persistencespb.NamespaceNameAlias
+	for _, alias := range aliases {
+		if alias.GetName() == previousName || alias.GetName() == newName || !alias.GetExpirationTime().After(now) {
+			continue
+		}
+		result = append(result, alias)
+	}
+	if expiration.After(now) {
+		result = append(result, &persistencespb.NamespaceNameAlias{
+			Name:           previousName,
+			ExpirationTime: &expiration,
+		})
+	}
+	return result
+}
+
 func validateReplicationStateUpdate(existingNamespace *persistence.GetNamespaceResponse, nsUpdateRequest *workflowservice.UpdateNamespaceRequest) error {
 	if nsUpdateRequest.ReplicationConfig == nil ||
 		nsUpdateRequest.ReplicationConfig.State == enumspb.REPLICATION_STATE_UNSPECIFIED ||
@@ -60,6 +60,7 @@ type (
 
 		maxBadBinaryCount       int
 		metadataMgr             persistence.MetadataManager
+		mockNamespaceRegistry   *MockRegistry
 		mockProducer            *persistence.MockNamespaceReplicationQueue
 		mockNamespaceReplicator Replicator
 		archivalMetadata        archiver.ArchivalMetadata
@@ -100,10 +101,13 @@ func (s *namespaceHandlerGlobalNamespaceDisabledSuite) SetupTest() {
 		&config.ArchivalNamespaceDefaults{},
 	)
 	s.mockArchiverProvider = provider.NewMockArchiverProvider(s.controller)
+	s.mockNamespaceRegistry = NewMockRegistry(s.controller)
+	s.mockNamespaceRegistry.EXPECT().GetNamespace(gomock.Any()).Return(nil, serviceerror.NewNotFound("namespace not found")).AnyTimes()
 	s.handler = NewHandler(
 		dc.GetIntPropertyFilteredByNamespace(s.maxBadBinaryCount),
 		logger,
 		s.metadataMgr,
+		s.mockNamespaceRegistry,
 		s.ClusterMetadata,
 		s.mockNamespaceReplicator,
 		s.archivalMetadata,
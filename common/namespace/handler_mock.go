@@ -31,9 +31,11 @@ package namespace
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	workflowservice "go.temporal.io/api/workflowservice/v1"
+	persistence "go.temporal.io/server/api/persistence/v1"
 )
 
 // MockHandler is a mock of Handler interface.
@@ -59,6 +61,20 @@ func (m *MockHandler) EXPECT() *MockHandlerMockRecorder {
 	return m.recorder
 }
 
+// CreateAPIKey mocks base method.
+func (m *MockHandler) CreateAPIKey(ctx context.Context, namespaceName string, apiKey *persistence.NamespaceApiKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, namespaceName, apiKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockHandlerMockRecorder) CreateAPIKey(ctx, namespaceName, apiKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockHandler)(nil).CreateAPIKey), ctx, namespaceName, apiKey)
+}
+
 // DeprecateNamespace mocks base method.
 func (m *MockHandler) DeprecateNamespace(ctx context.Context, deprecateRequest *workflowservice.DeprecateNamespaceRequest) (*workflowservice.DeprecateNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -119,6 +135,63 @@ func (mr *MockHandlerMockRecorder) RegisterNamespace(ctx, registerRequest interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterNamespace", reflect.TypeOf((*MockHandler)(nil).RegisterNamespace), ctx, registerRequest)
 }
 
+// RenameNamespace mocks base method.
+func (m *MockHandler) RenameNamespace(ctx context.Context, namespaceName, newName string, aliasGracePeriod time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameNamespace", ctx, namespaceName, newName, aliasGracePeriod)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameNamespace indicates an expected call of RenameNamespace.
+func (mr *MockHandlerMockRecorder) RenameNamespace(ctx, namespaceName, newName, aliasGracePeriod interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameNamespace", reflect.TypeOf((*MockHandler)(nil).RenameNamespace), ctx, namespaceName, newName, aliasGracePeriod)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockHandler) RevokeAPIKey(ctx context.Context, namespaceName, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", ctx, namespaceName, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockHandlerMockRecorder) RevokeAPIKey(ctx, namespaceName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockHandler)(nil).RevokeAPIKey), ctx, namespaceName, id)
+}
+
+// RotateAPIKey mocks base method.
+func (m *MockHandler) RotateAPIKey(ctx context.Context, namespaceName, id, secretHash string, previousSecretExpiration time.Time) (*persistence.NamespaceApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateAPIKey", ctx, namespaceName, id, secretHash, previousSecretExpiration)
+	ret0, _ := ret[0].(*persistence.NamespaceApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateAPIKey indicates an expected call of RotateAPIKey.
+func (mr *MockHandlerMockRecorder) RotateAPIKey(ctx, namespaceName, id, secretHash, previousSecretExpiration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateAPIKey", reflect.TypeOf((*MockHandler)(nil).RotateAPIKey), ctx, namespaceName, id, secretHash, previousSecretExpiration)
+}
+
+// UpdateDataResidencyPolicy mocks base method.
+func (m *MockHandler) UpdateDataResidencyPolicy(ctx context.Context, namespaceName string, policy *DataResidencyPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDataResidencyPolicy", ctx, namespaceName, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDataResidencyPolicy indicates an expected call of UpdateDataResidencyPolicy.
+func (mr *MockHandlerMockRecorder) UpdateDataResidencyPolicy(ctx, namespaceName, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDataResidencyPolicy", reflect.TypeOf((*MockHandler)(nil).UpdateDataResidencyPolicy), ctx, namespaceName, policy)
+}
+
 // UpdateNamespace mocks base method.
 func (m *MockHandler) UpdateNamespace(ctx context.Context, updateRequest *workflowservice.UpdateNamespaceRequest) (*workflowservice.UpdateNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,3 +206,17 @@ func (mr *MockHandlerMockRecorder) UpdateNamespace(ctx, updateRequest interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNamespace", reflect.TypeOf((*MockHandler)(nil).UpdateNamespace), ctx, updateRequest)
 }
+
+// UpdateReplicationFilter mocks base method.
+func (m *MockHandler) UpdateReplicationFilter(ctx context.Context, namespaceName, filter string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReplicationFilter", ctx, namespaceName, filter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReplicationFilter indicates an expected call of UpdateReplicationFilter.
+func (mr *MockHandlerMockRecorder) UpdateReplicationFilter(ctx, namespaceName, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReplicationFilter", reflect.TypeOf((*MockHandler)(nil).UpdateReplicationFilter), ctx, namespaceName, filter)
+}
@@ -37,10 +37,12 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
 	replicationpb "go.temporal.io/api/replication/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 
 	"go.temporal.io/server/common/config"
 
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
@@ -61,6 +63,8 @@ type (
 
 		maxBadBinaryCount       int
 		metadataMgr             persistence.MetadataManager
+		mockNamespaceRegistry   *MockRegistry
+		registeredNamespaces    map[Name]*Namespace
 		mockProducer            *persistence.MockNamespaceReplicationQueue
 		mockNamespaceReplicator Replicator
 		archivalMetadata        archiver.ArchivalMetadata
@@ -103,10 +107,19 @@ func (s *namespaceHandlerCommonSuite) SetupTest() {
 		&config.ArchivalNamespaceDefaults{},
 	)
 	s.mockArchiverProvider = provider.NewMockArchiverProvider(s.controller)
+	s.registeredNamespaces = make(map[Name]*Namespace)
+	s.mockNamespaceRegistry = NewMockRegistry(s.controller)
+	s.mockNamespaceRegistry.EXPECT().GetNamespace(gomock.Any()).DoAndReturn(func(name Name) (*Namespace, error) {
+		if ns, ok := s.registeredNamespaces[name]; ok {
+			return ns, nil
+		}
+		return nil, serviceerror.NewNotFound("namespace not found")
+	}).AnyTimes()
 	s.handler = NewHandler(
 		dc.GetIntPropertyFilteredByNamespace(s.maxBadBinaryCount),
 		logger,
 		s.metadataMgr,
+		s.mockNamespaceRegistry,
 		s.ClusterMetadata,
 		s.mockNamespaceReplicator,
 		s.archivalMetadata,
@@ -459,6 +472,170 @@ func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_PromoteLocalNamespace(
 	s.Equal(cluster.TestCurrentClusterInitialFailoverVersion, descResp.FailoverVersion)
 }
 
+func (s *namespaceHandlerCommonSuite) TestDataResidencyPolicy() {
+	namespace := s.getRandomNamespace()
+	registerRequest := &workflowservice.RegisterNamespaceRequest{
+		Namespace:                        namespace,
+		Description:                      namespace,
+		WorkflowExecutionRetentionPeriod: timestamp.DurationPtr(24 * time.Hour),
+		IsGlobalNamespace:                false,
+		Data:                             map[string]string{DataResidencyAllowedClustersKey: "other-cluster"},
+	}
+	_, err := s.handler.RegisterNamespace(context.Background(), registerRequest)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	registerRequest.Data = map[string]string{DataResidencyAllowedClustersKey: cluster.TestCurrentClusterName}
+	_, err = s.handler.RegisterNamespace(context.Background(), registerRequest)
+	s.NoError(err)
+
+	// policy can't be changed or cleared through UpdateNamespace
+	_, err = s.handler.UpdateNamespace(context.Background(), &workflowservice.UpdateNamespaceRequest{
+		Namespace: namespace,
+		UpdateInfo: &namespacepb.UpdateNamespaceInfo{
+			Data: map[string]string{DataResidencyAllowedClustersKey: ""},
+		},
+	})
+	s.Equal(errDataResidencyPolicyNotUpdatable, err)
+
+	// new policy must allow the current clusters
+	err = s.handler.UpdateDataResidencyPolicy(context.Background(), namespace, &DataResidencyPolicy{
+		AllowedClusters: []string{"other-cluster"},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	err = s.handler.UpdateDataResidencyPolicy(context.Background(), namespace, &DataResidencyPolicy{
+		AllowedClusters: []string{cluster.TestCurrentClusterName, "other-cluster"},
+	})
+	s.NoError(err)
+
+	resp, err := s.metadataMgr.GetNamespace(context.Background(), &persistence.GetNamespaceRequest{Name: namespace})
+	s.NoError(err)
+	s.Equal(cluster.TestCurrentClusterName+",other-cluster", resp.Namespace.Info.Data[DataResidencyAllowedClustersKey])
+}
+
+func (s *namespaceHandlerCommonSuite) TestRenameNamespace() {
+	namespace := s.getRandomNamespace()
+	newNamespace := s.getRandomNamespace()
+	registerRequest := &workflowservice.RegisterNamespaceRequest{
+		Namespace:                        namespace,
+		Description:                      namespace,
+		WorkflowExecutionRetentionPeriod: timestamp.DurationPtr(24 * time.Hour),
+		IsGlobalNamespace:                false,
+	}
+	_, err := s.handler.RegisterNamespace(context.Background(), registerRequest)
+	s.NoError(err)
+
+	err = s.handler.RenameNamespace(context.Background(), namespace, newNamespace, time.Hour)
+	s.NoError(err)
+
+	resp, err := s.metadataMgr.GetNamespace(context.Background(), &persistence.GetNamespaceRequest{Name: newNamespace})
+	s.NoError(err)
+	s.Equal(int64(1), resp.Namespace.ConfigVersion)
+	s.Len(resp.Namespace.Info.NameAliases, 1)
+	s.Equal(namespace, resp.Namespace.Info.NameAliases[0].GetName())
+
+	// previous name is reserved during the grace period, once the registry indexed the alias
+	s.registeredNamespaces[Name(namespace)] = FromPersistentState(resp)
+	_, err = s.handler.RegisterNamespace(context.Background(), registerRequest)
+	s.IsType(&serviceerror.NamespaceAlreadyExists{}, err)
+
+	err = s.handler.RenameNamespace(context.Background(), newNamespace, newNamespace, time.Hour)
+	s.Equal(errRenameToSameName, err)
+}
+
+func (s *namespaceHandlerCommonSuite) TestAPIKeys() {
+	namespace := s.getRandomNamespace()
+	_, err := s.handler.RegisterNamespace(context.Background(), &workflowservice.RegisterNamespaceRequest{
+		Namespace:                        namespace,
+		WorkflowExecutionRetentionPeriod: timestamp.DurationPtr(24 * time.Hour),
+		IsGlobalNamespace:                false,
+	})
+	s.NoError(err)
+
+	createTime := time.Now().UTC()
+	err = s.handler.CreateAPIKey(context.Background(), namespace, &persistencespb.NamespaceApiKey{
+		Id:         "key-id",
+		Name:       "worker",
+		Role:       "worker",
+		SecretHash: "hash",
+		CreateTime: &createTime,
+	})
+	s.NoError(err)
+	err = s.handler.CreateAPIKey(context.Background(), namespace, &persistencespb.NamespaceApiKey{Id: "key-id"})
+	s.Equal(errAPIKeyAlreadyExists, err)
+
+	expiration := time.Now().UTC().Add(time.Hour)
+	rotated, err := s.handler.RotateAPIKey(context.Background(), namespace, "key-id", "new-hash", expiration)
+	s.NoError(err)
+	s.Equal("new-hash", rotated.GetSecretHash())
+	s.Equal("hash", rotated.GetPreviousSecretHash())
+	_, err = s.handler.RotateAPIKey(context.Background(), namespace, "unknown", "new-hash", expiration)
+	s.Equal(errAPIKeyNotFound, err)
+
+	resp, err := s.metadataMgr.GetNamespace(context.Background(), &persistence.GetNamespaceRequest{Name: namespace})
+	s.NoError(err)
+	s.Equal(int64(2), resp.Namespace.ConfigVersion)
+	s.Len(resp.Namespace.Info.ApiKeys, 1)
+	s.Equal("new-hash", resp.Namespace.Info.ApiKeys[0].GetSecretHash())
+	s.Equal("worker", resp.Namespace.Info.ApiKeys[0].GetRole())
+
+	err = s.handler.RevokeAPIKey(context.Background(), namespace, "key-id")
+	s.NoError(err)
+	err = s.handler.RevokeAPIKey(context.Background(), namespace, "key-id")
+	s.Equal(errAPIKeyNotFound, err)
+
+	resp, err = s.metadataMgr.GetNamespace(context.Background(), &persistence.GetNamespaceRequest{Name: namespace})
+	s.NoError(err)
+	s.Empty(resp.Namespace.Info.ApiKeys)
+}
+
+func TestAddNameAlias(t *testing.T) {
+	now := time.Now().UTC()
+	future := now.Add(time.Hour)
+	past := now.Add(-time.Hour)
+	aliases := []*persistencespb.NamespaceNameAlias{
+		{Name: "expired", ExpirationTime: &past},
+		{Name: "kept", ExpirationTime: &future},
+		{Name: "new", ExpirationTime: &future},
+		{Name: "previous", ExpirationTime: &past},
+	}
+
+	result := addNameAlias(aliases, "previous", "new", now.Add(2*time.Hour), now)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "kept", result[0].GetName())
+	assert.Equal(t, "previous", result[1].GetName())
+	assert.Equal(t, now.Add(2*time.Hour), *result[1].GetExpirationTime())
+
+	// no grace period, previous name is released immediately
+	result = addNameAlias(aliases, "previous", "new", now, now)
+	assert.Len(t, result, 1)
+	assert.Equal(t, "kept", result[0].GetName())
+}
+
+func TestValidateNameNotAliased(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	registry := NewMockRegistry(controller)
+	handler := &HandlerImpl{namespaceRegistry: registry}
+	ns := NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: "namespace-id", Name: "new-name"},
+		nil,
+		"",
+	).Clone(WithNameAlias("previous-name", time.Now().UTC().Add(time.Hour)))
+
+	registry.EXPECT().GetNamespace(Name("previous-name")).Return(ns, nil).Times(2)
+	assert.IsType(t, &serviceerror.NamespaceAlreadyExists{}, handler.validateNameNotAliased("previous-name", ""))
+	assert.NoError(t, handler.validateNameNotAliased("previous-name", "namespace-id"))
+
+	// current names are validated by the callers
+	registry.EXPECT().GetNamespace(Name("new-name")).Return(ns, nil)
+	assert.NoError(t, handler.validateNameNotAliased("new-name", ""))
+
+	registry.EXPECT().GetNamespace(Name("unknown")).Return(nil, serviceerror.NewNotFound("not found"))
+	assert.NoError(t, handler.validateNameNotAliased("unknown", ""))
+}
+
 func (s *namespaceHandlerCommonSuite) getRandomNamespace() string {
 	return "namespace" + uuid.New()
 }
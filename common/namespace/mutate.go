@@ -29,6 +29,7 @@ import (
 
 	namespacepb "go.temporal.io/api/namespace/v1"
 
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/persistence"
 )
 
@@ -82,6 +83,19 @@ func WithRetention(dur *time.Duration) Mutation {
 		})
 }
 
+// WithNameAlias adds a previous name to a Namespace during a Clone operation.
+func WithNameAlias(name string, expiration time.Time) Mutation {
+	return mutationFunc(
+		func(ns *persistence.GetNamespaceResponse) {
+			aliases := make([]*persistencespb.NamespaceNameAlias, 0, len(ns.Namespace.Info.NameAliases)+1)
+			aliases = append(aliases, ns.Namespace.Info.NameAliases...)
+			ns.Namespace.Info.NameAliases = append(
+				aliases,
+				&persistencespb.NamespaceNameAlias{Name: name, ExpirationTime: &expiration},
+			)
+		})
+}
+
 // WithData adds a key-value pair to a Namespace during a Clone operation.
 func WithData(key, value string) Mutation {
 	return mutationFunc(
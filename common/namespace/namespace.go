@@ -67,6 +67,8 @@ type (
 		isGlobalNamespace           bool
 		failoverNotificationVersion int64
 		notificationVersion         int64
+		replicationFilter           *ReplicationFilter
+		dataResidencyPolicy         *DataResidencyPolicy
 	}
 )
 
@@ -89,6 +91,8 @@ func FromPersistentState(record *persistence.GetNamespaceResponse) *Namespace {
 		isGlobalNamespace:           record.IsGlobalNamespace,
 		failoverNotificationVersion: record.Namespace.FailoverNotificationVersion,
 		notificationVersion:         record.NotificationVersion,
+		replicationFilter:           newReplicationFilter(record.Namespace.ReplicationConfig.GetFilter()),
+		dataResidencyPolicy:         ParseDataResidencyPolicy(record.Namespace.Info.GetData()),
 	}
 }
 
@@ -154,6 +158,34 @@ func (ns *Namespace) Name() Name {
 	return Name(ns.info.Name)
 }
 
+// NameAliases observes the previous names of this namespace which still
+// resolve to it at the given time.
+func (ns *Namespace) NameAliases(now time.Time) []Name {
+	var out []Name
+	for _, alias := range ns.info.NameAliases {
+		if alias.GetName() != ns.info.Name && alias.GetExpirationTime().After(now) {
+			out = append(out, Name(alias.GetName()))
+		}
+	}
+	return out
+}
+
+// APIKey observes the API key of this namespace with the given id, nil if
+// there is no such key.
+func (ns *Namespace) APIKey(id string) *persistencespb.NamespaceApiKey {
+	for _, key := range ns.info.ApiKeys {
+		if key.GetId() == id {
+			return key
+		}
+	}
+	return nil
+}
+
+// APIKeys observes the API keys of this namespace.
+func (ns *Namespace) APIKeys() []*persistencespb.NamespaceApiKey {
+	return ns.info.ApiKeys
+}
+
 func (ns *Namespace) State() enumspb.NamespaceState {
 	return ns.info.State
 }
@@ -177,6 +209,12 @@ func (ns *Namespace) ClusterNames() []string {
 	return out
 }
 
+// ReplicationFilter returns the filter of workflow executions replicated to remote clusters,
+// nil if all workflow executions are replicated.
+func (ns *Namespace) ReplicationFilter() *ReplicationFilter {
+	return ns.replicationFilter
+}
+
 // ConfigVersion return the namespace config version
 func (ns *Namespace) ConfigVersion() int64 {
 	return ns.configVersion
@@ -224,6 +262,14 @@ func (ns *Namespace) ReplicationPolicy() ReplicationPolicy {
 	return ReplicationPolicyOneCluster
 }
 
+// DataResidencyPolicy returns the data residency policy kept in the namespace data.
+func (ns *Namespace) DataResidencyPolicy() *DataResidencyPolicy {
+	if ns.dataResidencyPolicy == nil {
+		return &DataResidencyPolicy{}
+	}
+	return ns.dataResidencyPolicy
+}
+
 func (ns *Namespace) GetCustomData(key string) string {
 	if ns.info.Data == nil {
 		return ""
@@ -142,3 +142,15 @@ func TestNamespace_GetCustomData(t *testing.T) {
 	data2 := ns.GetCustomData("fake")
 	assert.Equal(t, "", data2)
 }
+
+func TestNamespace_NameAliases(t *testing.T) {
+	now := time.Now()
+	ns := base(t).Clone(
+		namespace.WithNameAlias("old-name", now.Add(time.Hour)),
+		namespace.WithNameAlias("expired-name", now.Add(-time.Hour)),
+		namespace.WithNameAlias(t.Name(), now.Add(time.Hour)),
+	)
+
+	require.Equal(t, []namespace.Name{"old-name"}, ns.NameAliases(now))
+	require.Empty(t, ns.NameAliases(now.Add(2*time.Hour)))
+}
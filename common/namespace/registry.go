@@ -416,13 +416,11 @@ func (r *registry) refreshNamespaces(ctx context.Context) error {
 	sort.Sort(namespacesDb)
 
 	// Make a copy of the existing namespace cache (excluding deleted), so we can calculate diff and do "compare and swap".
-	newCacheNameToID := cache.New(cacheMaxSize, &cacheOpts)
 	newCacheByID := cache.New(cacheMaxSize, &cacheOpts)
 	for _, namespace := range r.getAllNamespace() {
 		if _, namespaceExistsDb := namespaceIDsDb[namespace.ID()]; !namespaceExistsDb {
 			continue
 		}
-		newCacheNameToID.Put(Name(namespace.info.Name), ID(namespace.info.Id))
 		newCacheByID.Put(ID(namespace.info.Id), namespace)
 	}
 
@@ -439,7 +437,6 @@ UpdateLoop:
 			break UpdateLoop
 		}
 		oldNS := r.updateIDToNamespaceCache(newCacheByID, namespace.ID(), namespace)
-		r.updateNameToIDCache(newCacheNameToID, namespace.Name(), namespace.ID())
 
 		if oldNS != nil {
 			oldEntries = append(oldEntries, oldNS)
@@ -447,6 +444,10 @@ UpdateLoop:
 		}
 	}
 
+	// Name index is rebuilt from scratch, so previous names of renamed namespaces
+	// stop resolving once their aliases expire.
+	newCacheNameToID := r.newNameToIDCache(newCacheByID)
+
 	// NOTE: READ REF BEFORE MODIFICATION
 	// ref: historyEngine.go registerNamespaceFailoverCallback function
 	r.publishCacheUpdate(func() (Namespaces, Namespaces) {
@@ -459,6 +460,29 @@ UpdateLoop:
 	return nil
 }
 
+// newNameToIDCache indexes namespaces by name and by unexpired aliases of their previous names.
+// An alias never shadows the current name of another namespace.
+func (r *registry) newNameToIDCache(cacheByID cache.Cache) cache.Cache {
+	var namespaces []*Namespace
+	ite := cacheByID.Iterator()
+	defer ite.Close()
+	for ite.HasNext() {
+		namespaces = append(namespaces, ite.Next().Value().(*Namespace))
+	}
+
+	now := r.clock.Now()
+	cacheNameToID := cache.New(cacheMaxSize, &cacheOpts)
+	for _, namespace := range namespaces {
+		for _, alias := range namespace.NameAliases(now) {
+			r.updateNameToIDCache(cacheNameToID, alias, namespace.ID())
+		}
+	}
+	for _, namespace := range namespaces {
+		r.updateNameToIDCache(cacheNameToID, namespace.Name(), namespace.ID())
+	}
+	return cacheNameToID
+}
+
 func (r *registry) updateNameToIDCache(c cache.Cache, name Name, id ID) {
 	c.Put(name, id)
 }
@@ -641,6 +641,83 @@ func (s *registrySuite) TestRemoveDeletedNamespace() {
 	s.ErrorAs(err, &notFound)
 }
 
+func (s *registrySuite) TestRenamedNamespace() {
+	namespaceNotificationVersion := int64(0)
+	newRecord := func(id string, name string, aliases ...*persistencespb.NamespaceNameAlias) *persistence.GetNamespaceResponse {
+		return &persistence.GetNamespaceResponse{
+			Namespace: &persistencespb.NamespaceDetail{
+				Info: &persistencespb.NamespaceInfo{
+					Id:          id,
+					Name:        name,
+					Data:        make(map[string]string),
+					NameAliases: aliases,
+				},
+				Config: &persistencespb.NamespaceConfig{
+					Retention: timestamp.DurationFromDays(1),
+					BadBinaries: &namespacepb.BadBinaries{
+						Binaries: map[string]*namespacepb.BadBinaryInfo{},
+					}},
+				ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+					ActiveClusterName: cluster.TestCurrentClusterName,
+					Clusters:          []string{cluster.TestCurrentClusterName},
+				},
+			},
+			NotificationVersion: namespaceNotificationVersion,
+		}
+	}
+	expectRefresh := func(records ...*persistence.GetNamespaceResponse) {
+		s.regPersistence.EXPECT().GetMetadata(gomock.Any()).Return(
+			&persistence.GetMetadataResponse{
+				NotificationVersion: namespaceNotificationVersion + 1,
+			}, nil)
+		s.regPersistence.EXPECT().ListNamespaces(gomock.Any(), &persistence.ListNamespacesRequest{
+			PageSize:       namespace.CacheRefreshPageSize,
+			IncludeDeleted: true,
+			NextPageToken:  nil,
+		}).Return(&persistence.ListNamespacesResponse{
+			Namespaces: records,
+		}, nil)
+	}
+
+	renamedID := namespace.NewID().String()
+	otherID := namespace.NewID().String()
+	expectRefresh(newRecord(renamedID, "old-name"), newRecord(otherID, "other-name"))
+	s.registry.Start()
+	defer s.registry.Stop()
+
+	namespaceNotificationVersion++
+	now := time.Now().UTC()
+	future := now.Add(time.Hour)
+	past := now.Add(-time.Hour)
+	expectRefresh(
+		newRecord(renamedID, "new-name",
+			&persistencespb.NamespaceNameAlias{Name: "old-name", ExpirationTime: &future},
+			&persistencespb.NamespaceNameAlias{Name: "expired-name", ExpirationTime: &past},
+			&persistencespb.NamespaceNameAlias{Name: "other-name", ExpirationTime: &future},
+		),
+		newRecord(otherID, "other-name"),
+	)
+	s.registry.Refresh()
+
+	ns, err := s.registry.GetNamespace("new-name")
+	s.NoError(err)
+	s.Equal(renamedID, ns.ID().String())
+
+	ns, err = s.registry.GetNamespace("old-name")
+	s.NoError(err)
+	s.Equal(renamedID, ns.ID().String())
+	s.Equal(namespace.Name("new-name"), ns.Name())
+
+	_, err = s.registry.GetNamespace("expired-name")
+	var notFound *serviceerror.NotFound
+	s.ErrorAs(err, &notFound)
+
+	// alias never shadows the current name of another namespace
+	id, err := s.registry.GetNamespaceID("other-name")
+	s.NoError(err)
+	s.Equal(otherID, id.String())
+}
+
 func TestCacheByName(t *testing.T) {
 	nsrec := persistence.GetNamespaceResponse{
 		Namespace: &persistencespb.NamespaceDetail{
@@ -0,0 +1,252 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/xwb1989/sqlparser"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	// ReplicationFilter is a visibility style predicate on workflow executions of a global namespace,
+	// e.g. "WorkflowType = 'order' AND CustomKeywordField IN ('us', 'eu')". Only executions matching
+	// the filter are replicated to remote clusters.
+	ReplicationFilter struct {
+		filter string
+		expr   sqlparser.Expr
+	}
+
+	// ReplicationFilterAttributes are the attributes of a workflow execution a ReplicationFilter is evaluated against.
+	ReplicationFilterAttributes struct {
+		WorkflowID       string
+		WorkflowType     string
+		TaskQueue        string
+		SearchAttributes map[string]*commonpb.Payload
+	}
+)
+
+// ParseReplicationFilter parses and validates a replication filter. Supported are =, !=, IN and NOT IN
+// comparisons of WorkflowId, WorkflowType, TaskQueue or search attributes combined with AND, OR and NOT.
+func ParseReplicationFilter(filter string) (*ReplicationFilter, error) {
+	stmt, err := sqlparser.Parse("select * from executions where " + filter)
+	if err != nil {
+		return nil, newInvalidReplicationFilterError(err.Error())
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || sel.Where == nil || sel.OrderBy != nil || sel.Limit != nil || sel.GroupBy != nil || sel.Having != nil {
+		return nil, newInvalidReplicationFilterError("filter must be a where clause only")
+	}
+	if err := validateReplicationFilterExpr(sel.Where.Expr); err != nil {
+		return nil, err
+	}
+	return &ReplicationFilter{
+		filter: filter,
+		expr:   sel.Where.Expr,
+	}, nil
+}
+
+// newReplicationFilter returns nil for an empty filter. Filter is validated when it is set,
+// an invalid filter replicates all workflow executions.
+func newReplicationFilter(filter string) *ReplicationFilter {
+	if filter == "" {
+		return nil
+	}
+	replicationFilter, _ := ParseReplicationFilter(filter)
+	return replicationFilter
+}
+
+// String returns the filter as it was parsed.
+func (f *ReplicationFilter) String() string {
+	return f.filter
+}
+
+// Match returns true if the workflow execution with given attributes matches the filter.
+// A nil filter matches all executions.
+func (f *ReplicationFilter) Match(attributes *ReplicationFilterAttributes) bool {
+	if f == nil {
+		return true
+	}
+	return matchReplicationFilterExpr(f.expr, attributes)
+}
+
+func validateReplicationFilterExpr(expr sqlparser.Expr) error {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		if err := validateReplicationFilterExpr(expr.Left); err != nil {
+			return err
+		}
+		return validateReplicationFilterExpr(expr.Right)
+	case *sqlparser.OrExpr:
+		if err := validateReplicationFilterExpr(expr.Left); err != nil {
+			return err
+		}
+		return validateReplicationFilterExpr(expr.Right)
+	case *sqlparser.NotExpr:
+		return validateReplicationFilterExpr(expr.Expr)
+	case *sqlparser.ParenExpr:
+		return validateReplicationFilterExpr(expr.Expr)
+	case *sqlparser.ComparisonExpr:
+		if _, ok := expr.Left.(*sqlparser.ColName); !ok {
+			return newInvalidReplicationFilterError(fmt.Sprintf("left side of %q must be an attribute name", sqlparser.String(expr)))
+		}
+		switch expr.Operator {
+		case sqlparser.EqualStr, sqlparser.NotEqualStr:
+			if _, ok := replicationFilterValue(expr.Right); !ok {
+				return newInvalidReplicationFilterError(fmt.Sprintf("right side of %q must be a value", sqlparser.String(expr)))
+			}
+		case sqlparser.InStr, sqlparser.NotInStr:
+			values, ok := expr.Right.(sqlparser.ValTuple)
+			if !ok {
+				return newInvalidReplicationFilterError(fmt.Sprintf("right side of %q must be a list of values", sqlparser.String(expr)))
+			}
+			for _, value := range values {
+				if _, ok := replicationFilterValue(value); !ok {
+					return newInvalidReplicationFilterError(fmt.Sprintf("right side of %q must be a list of values", sqlparser.String(expr)))
+				}
+			}
+		default:
+			return newInvalidReplicationFilterError(fmt.Sprintf("operator %q is not supported", expr.Operator))
+		}
+		return nil
+	default:
+		return newInvalidReplicationFilterError(fmt.Sprintf("expression %q is not supported", sqlparser.String(expr)))
+	}
+}
+
+func matchReplicationFilterExpr(expr sqlparser.Expr, attributes *ReplicationFilterAttributes) bool {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		return matchReplicationFilterExpr(expr.Left, attributes) && matchReplicationFilterExpr(expr.Right, attributes)
+	case *sqlparser.OrExpr:
+		return matchReplicationFilterExpr(expr.Left, attributes) || matchReplicationFilterExpr(expr.Right, attributes)
+	case *sqlparser.NotExpr:
+		return !matchReplicationFilterExpr(expr.Expr, attributes)
+	case *sqlparser.ParenExpr:
+		return matchReplicationFilterExpr(expr.Expr, attributes)
+	case *sqlparser.ComparisonExpr:
+		actualValues := replicationFilterAttributeValues(expr.Left.(*sqlparser.ColName).Name.String(), attributes)
+		var expectedValues []string
+		switch right := expr.Right.(type) {
+		case sqlparser.ValTuple:
+			for _, value := range right {
+				v, _ := replicationFilterValue(value)
+				expectedValues = append(expectedValues, v)
+			}
+		default:
+			v, _ := replicationFilterValue(right)
+			expectedValues = append(expectedValues, v)
+		}
+
+		found := false
+		for _, actual := range actualValues {
+			for _, expected := range expectedValues {
+				if actual == expected {
+					found = true
+				}
+			}
+		}
+		if expr.Operator == sqlparser.EqualStr || expr.Operator == sqlparser.InStr {
+			return found
+		}
+		return !found
+	default:
+		// unreachable, filter is validated when parsed
+		return false
+	}
+}
+
+// replicationFilterAttributeValues returns values of the attribute in their string form.
+// Keyword list search attributes return one value per item.
+func replicationFilterAttributeValues(name string, attributes *ReplicationFilterAttributes) []string {
+	switch name {
+	case searchattribute.WorkflowID:
+		return []string{attributes.WorkflowID}
+	case searchattribute.WorkflowType:
+		return []string{attributes.WorkflowType}
+	case searchattribute.TaskQueue:
+		return []string{attributes.TaskQueue}
+	}
+
+	p, ok := attributes.SearchAttributes[name]
+	if !ok {
+		return nil
+	}
+	var value interface{}
+	if err := payload.Decode(p, &value); err != nil {
+		return nil
+	}
+	if list, ok := value.([]interface{}); ok {
+		values := make([]string, 0, len(list))
+		for _, item := range list {
+			values = append(values, formatReplicationFilterValue(item))
+		}
+		return values
+	}
+	return []string{formatReplicationFilterValue(value)}
+}
+
+func replicationFilterValue(expr sqlparser.Expr) (string, bool) {
+	switch expr := expr.(type) {
+	case *sqlparser.SQLVal:
+		switch expr.Type {
+		case sqlparser.StrVal:
+			return string(expr.Val), true
+		case sqlparser.IntVal, sqlparser.FloatVal:
+			number, err := strconv.ParseFloat(string(expr.Val), 64)
+			if err != nil {
+				return "", false
+			}
+			return formatReplicationFilterValue(number), true
+		}
+	case sqlparser.BoolVal:
+		return strconv.FormatBool(bool(expr)), true
+	}
+	return "", false
+}
+
+func formatReplicationFilterValue(value interface{}) string {
+	switch value := value.(type) {
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(value)
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
+
+func newInvalidReplicationFilterError(msg string) error {
+	return serviceerror.NewInvalidArgument("invalid replication filter: " + strings.TrimSpace(msg))
+}
@@ -0,0 +1,100 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+)
+
+func TestReplicationFilter_Match(t *testing.T) {
+	keywordList, err := payload.Encode([]string{"us", "eu"})
+	require.NoError(t, err)
+	intValue, err := payload.Encode(5)
+	require.NoError(t, err)
+	boolValue, err := payload.Encode(true)
+	require.NoError(t, err)
+
+	attributes := &namespace.ReplicationFilterAttributes{
+		WorkflowID:   "order-1",
+		WorkflowType: "order",
+		TaskQueue:    "orders",
+		SearchAttributes: map[string]*commonpb.Payload{
+			"CustomKeywordField": keywordList,
+			"CustomIntField":     intValue,
+			"CustomBoolField":    boolValue,
+		},
+	}
+
+	for _, tt := range [...]struct {
+		filter string
+		want   bool
+	}{
+		{filter: "WorkflowType = 'order'", want: true},
+		{filter: "WorkflowType != 'order'", want: false},
+		{filter: "WorkflowId = 'order-1' AND TaskQueue = 'orders'", want: true},
+		{filter: "WorkflowType = 'payment' OR TaskQueue = 'orders'", want: true},
+		{filter: "NOT (WorkflowType = 'order')", want: false},
+		{filter: "CustomKeywordField = 'eu'", want: true},
+		{filter: "CustomKeywordField IN ('ap', 'us')", want: true},
+		{filter: "CustomKeywordField NOT IN ('ap')", want: true},
+		{filter: "CustomIntField = 5", want: true},
+		{filter: "CustomIntField = 6", want: false},
+		{filter: "CustomBoolField = true", want: true},
+		{filter: "UnknownField = 'x'", want: false},
+		{filter: "UnknownField != 'x'", want: true},
+	} {
+		t.Run(tt.filter, func(t *testing.T) {
+			filter, err := namespace.ParseReplicationFilter(tt.filter)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, filter.Match(attributes))
+		})
+	}
+}
+
+func TestReplicationFilter_Invalid(t *testing.T) {
+	for _, filter := range []string{
+		"",
+		"WorkflowType",
+		"WorkflowType > 'a'",
+		"WorkflowType LIKE 'a%'",
+		"'order' = WorkflowType",
+		"WorkflowType = TaskQueue",
+		"WorkflowType = 'a' ORDER BY StartTime",
+	} {
+		_, err := namespace.ParseReplicationFilter(filter)
+		require.Error(t, err, filter)
+	}
+}
+
+func TestReplicationFilter_Nil(t *testing.T) {
+	var filter *namespace.ReplicationFilter
+	require.True(t, filter.Match(&namespace.ReplicationFilterAttributes{}))
+}
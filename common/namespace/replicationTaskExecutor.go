@@ -128,6 +128,8 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceCreationReplicatio
 				Description: task.Info.GetDescription(),
 				Owner:       task.Info.GetOwnerEmail(),
 				Data:        task.Info.Data,
+				NameAliases: task.GetNameAliases(),
+				ApiKeys:     task.GetApiKeys(),
 			},
 			Config: &persistencespb.NamespaceConfig{
 				Retention:               task.Config.GetWorkflowExecutionRetentionTtl(),
@@ -139,6 +141,7 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceCreationReplicatio
 			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
 				ActiveClusterName: task.ReplicationConfig.GetActiveClusterName(),
 				Clusters:          h.convertClusterReplicationConfigFromProto(task.ReplicationConfig.Clusters),
+				Filter:            task.GetReplicationFilter(),
 			},
 			ConfigVersion:   task.GetConfigVersion(),
 			FailoverVersion: task.GetFailoverVersion(),
@@ -215,8 +218,9 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceUpdateReplicationT
 
 	// plus, we need to check whether the config version is <= the config version set in the input
 	// plus, we need to check whether the failover version is <= the failover version set in the input
+	// namespace is looked up by id, since its name is changed by a rename
 	resp, err := h.metadataManagerV2.GetNamespace(ctx, &persistence.GetNamespaceRequest{
-		Name: task.Info.GetName(),
+		ID: task.GetId(),
 	})
 	if err != nil {
 		if _, ok := err.(*serviceerror.NotFound); ok {
@@ -227,6 +231,21 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceUpdateReplicationT
 		return err
 	}
 
+	if resp.Namespace.Info.Name != task.Info.GetName() && resp.Namespace.ConfigVersion < task.GetConfigVersion() {
+		if err := h.metadataManagerV2.RenameNamespace(ctx, &persistence.RenameNamespaceRequest{
+			PreviousName: resp.Namespace.Info.Name,
+			NewName:      task.Info.GetName(),
+		}); err != nil {
+			return err
+		}
+		// rename changes the notification version
+		metadata, err = h.metadataManagerV2.GetMetadata(ctx)
+		if err != nil {
+			return err
+		}
+		notificationVersion = metadata.NotificationVersion
+	}
+
 	recordUpdated := false
 	request := &persistence.UpdateNamespaceRequest{
 		Namespace:           resp.Namespace,
@@ -243,6 +262,8 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceUpdateReplicationT
 			Description: task.Info.GetDescription(),
 			Owner:       task.Info.GetOwnerEmail(),
 			Data:        task.Info.Data,
+			NameAliases: task.GetNameAliases(),
+			ApiKeys:     task.GetApiKeys(),
 		}
 		request.Namespace.Config = &persistencespb.NamespaceConfig{
 			Retention:               task.Config.GetWorkflowExecutionRetentionTtl(),
@@ -255,6 +276,7 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceUpdateReplicationT
 			request.Namespace.Config.BadBinaries = task.Config.GetBadBinaries()
 		}
 		request.Namespace.ReplicationConfig.Clusters = h.convertClusterReplicationConfigFromProto(task.ReplicationConfig.Clusters)
+		request.Namespace.ReplicationConfig.Filter = task.GetReplicationFilter()
 		request.Namespace.ConfigVersion = task.GetConfigVersion()
 	}
 	if resp.Namespace.FailoverVersion < task.GetFailoverVersion() {
@@ -45,7 +45,8 @@ func NewLocalNamespaceForTest(
 			ActiveClusterName: targetCluster,
 			Clusters:          []string{targetCluster},
 		},
-		failoverVersion: common.EmptyVersion,
+		failoverVersion:     common.EmptyVersion,
+		dataResidencyPolicy: ParseDataResidencyPolicy(info.GetData()),
 	}
 }
 
@@ -58,11 +59,13 @@ func NewNamespaceForTest(
 	failoverVersion int64,
 ) *Namespace {
 	return &Namespace{
-		info:              derefInfo(info),
-		config:            derefConfig(config),
-		isGlobalNamespace: isGlobalNamespace,
-		replicationConfig: derefRepConfig(repConfig),
-		failoverVersion:   failoverVersion,
+		info:                derefInfo(info),
+		config:              derefConfig(config),
+		isGlobalNamespace:   isGlobalNamespace,
+		replicationConfig:   derefRepConfig(repConfig),
+		failoverVersion:     failoverVersion,
+		replicationFilter:   newReplicationFilter(repConfig.GetFilter()),
+		dataResidencyPolicy: ParseDataResidencyPolicy(info.GetData()),
 	}
 }
 
@@ -74,11 +77,13 @@ func NewGlobalNamespaceForTest(
 	failoverVersion int64,
 ) *Namespace {
 	return &Namespace{
-		info:              derefInfo(info),
-		config:            derefConfig(config),
-		isGlobalNamespace: true,
-		replicationConfig: derefRepConfig(repConfig),
-		failoverVersion:   failoverVersion,
+		info:                derefInfo(info),
+		config:              derefConfig(config),
+		isGlobalNamespace:   true,
+		replicationConfig:   derefRepConfig(repConfig),
+		failoverVersion:     failoverVersion,
+		replicationFilter:   newReplicationFilter(repConfig.GetFilter()),
+		dataResidencyPolicy: ParseDataResidencyPolicy(info.GetData()),
 	}
 }
 
@@ -27,6 +27,7 @@ package namespace
 import (
 	"context"
 
+	enumspb "go.temporal.io/api/enums/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
 	replicationpb "go.temporal.io/api/replication/v1"
 
@@ -52,6 +53,10 @@ type (
 			failoverVersion int64,
 			isGlobalNamespace bool,
 		) error
+		HandleSearchAttributesTransmissionTask(
+			ctx context.Context,
+			customSearchAttributes map[string]enumspb.IndexedValueType,
+		) error
 	}
 
 	namespaceReplicatorImpl struct {
@@ -114,8 +119,11 @@ func (namespaceReplicator *namespaceReplicatorImpl) HandleTransmissionTask(
 				ActiveClusterName: replicationConfig.ActiveClusterName,
 				Clusters:          namespaceReplicator.convertClusterReplicationConfigToProto(replicationConfig.Clusters),
 			},
-			ConfigVersion:   configVersion,
-			FailoverVersion: failoverVersion,
+			ConfigVersion:     configVersion,
+			FailoverVersion:   failoverVersion,
+			ReplicationFilter: replicationConfig.Filter,
+			NameAliases:       info.NameAliases,
+			ApiKeys:           info.ApiKeys,
 		},
 	}
 
@@ -127,6 +135,29 @@ func (namespaceReplicator *namespaceReplicatorImpl) HandleTransmissionTask(
 		})
 }
 
+// HandleSearchAttributesTransmissionTask handle transmission of custom search attributes,
+// so remote clusters can add the ones they are missing
+func (namespaceReplicator *namespaceReplicatorImpl) HandleSearchAttributesTransmissionTask(
+	ctx context.Context,
+	customSearchAttributes map[string]enumspb.IndexedValueType,
+) error {
+
+	if len(customSearchAttributes) == 0 {
+		return nil
+	}
+
+	return namespaceReplicator.namespaceReplicationQueue.Publish(
+		ctx,
+		&replicationspb.ReplicationTask{
+			TaskType: enumsspb.REPLICATION_TASK_TYPE_SEARCH_ATTRIBUTES_TASK,
+			Attributes: &replicationspb.ReplicationTask_SearchAttributesTaskAttributes{
+				SearchAttributesTaskAttributes: &replicationspb.SearchAttributesTaskAttributes{
+					CustomSearchAttributes: customSearchAttributes,
+				},
+			},
+		})
+}
+
 func (namespaceReplicator *namespaceReplicatorImpl) convertClusterReplicationConfigToProto(
 	input []string,
 ) []*replicationpb.ClusterReplicationConfig {
@@ -318,3 +318,27 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_UpdateNamespaceTask_N
 	err := s.namespaceReplicator.HandleTransmissionTask(context.Background(), namespaceOperation, info, config, replicationConfig, configVersion, failoverVersion, isGlobalNamespace)
 	s.Nil(err)
 }
+
+func (s *transmissionTaskSuite) TestHandleSearchAttributesTransmissionTask() {
+	customSearchAttributes := map[string]enumspb.IndexedValueType{
+		"CustomKeywordField": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		"CustomIntField":     enumspb.INDEXED_VALUE_TYPE_INT,
+	}
+
+	s.namespaceReplicationQueue.EXPECT().Publish(gomock.Any(), &replicationspb.ReplicationTask{
+		TaskType: enumsspb.REPLICATION_TASK_TYPE_SEARCH_ATTRIBUTES_TASK,
+		Attributes: &replicationspb.ReplicationTask_SearchAttributesTaskAttributes{
+			SearchAttributesTaskAttributes: &replicationspb.SearchAttributesTaskAttributes{
+				CustomSearchAttributes: customSearchAttributes,
+			},
+		},
+	}).Return(nil)
+
+	err := s.namespaceReplicator.HandleSearchAttributesTransmissionTask(context.Background(), customSearchAttributes)
+	s.Nil(err)
+}
+
+func (s *transmissionTaskSuite) TestHandleSearchAttributesTransmissionTask_Empty() {
+	err := s.namespaceReplicator.HandleSearchAttributesTransmissionTask(context.Background(), nil)
+	s.Nil(err)
+}
@@ -26,7 +26,6 @@ package persistence
 
 import (
 	"context"
-	"fmt"
 
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
@@ -378,7 +377,7 @@ func (p *executionPersistenceClient) GetHistoryTask(
 	case tasks.CategoryIDReplication:
 		scopeIdx = metrics.PersistenceGetReplicationTaskScope
 	default:
-		return nil, serviceerror.NewInternal(fmt.Sprintf("unknown task category type: %v", request.TaskCategory))
+		scopeIdx = metrics.PersistenceGetHistoryTaskScope
 	}
 
 	p.metricClient.IncCounter(scopeIdx, metrics.PersistenceRequests)
@@ -409,7 +408,7 @@ func (p *executionPersistenceClient) GetHistoryTasks(
 	case tasks.CategoryIDReplication:
 		scopeIdx = metrics.PersistenceGetReplicationTasksScope
 	default:
-		return nil, serviceerror.NewInternal(fmt.Sprintf("unknown task category type: %v", request.TaskCategory))
+		scopeIdx = metrics.PersistenceGetHistoryTasksScope
 	}
 
 	p.metricClient.IncCounter(scopeIdx, metrics.PersistenceRequests)
@@ -440,7 +439,7 @@ func (p *executionPersistenceClient) CompleteHistoryTask(
 	case tasks.CategoryIDReplication:
 		scopeIdx = metrics.PersistenceCompleteReplicationTaskScope
 	default:
-		return serviceerror.NewInternal(fmt.Sprintf("unknown task category type: %v", request.TaskCategory))
+		scopeIdx = metrics.PersistenceCompleteHistoryTaskScope
 	}
 
 	p.metricClient.IncCounter(scopeIdx, metrics.PersistenceRequests)
@@ -471,7 +470,7 @@ func (p *executionPersistenceClient) RangeCompleteHistoryTasks(
 	case tasks.CategoryIDReplication:
 		scopeIdx = metrics.PersistenceRangeCompleteReplicationTasksScope
 	default:
-		return serviceerror.NewInternal(fmt.Sprintf("unknown task category type: %v", request.TaskCategory))
+		scopeIdx = metrics.PersistenceRangeCompleteHistoryTasksScope
 	}
 
 	p.metricClient.IncCounter(scopeIdx, metrics.PersistenceRequests)
@@ -26,6 +26,7 @@ package serialization
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
@@ -42,8 +43,49 @@ import (
 type (
 	TaskSerializer struct {
 	}
+
+	// CategoryTaskSerializer serializes and deserializes tasks of a custom task category,
+	// i.e. a category created via tasks.NewCategory outside of the built-in ones
+	CategoryTaskSerializer interface {
+		SerializeTask(task tasks.Task) (commonpb.DataBlob, error)
+		DeserializeTask(blob commonpb.DataBlob) (tasks.Task, error)
+	}
+)
+
+var (
+	categoryTaskSerializers = struct {
+		sync.RWMutex
+		m map[int32]CategoryTaskSerializer
+	}{
+		m: make(map[int32]CategoryTaskSerializer),
+	}
 )
 
+// RegisterCategoryTaskSerializer registers the serializer used for tasks of a custom task category
+// Registering a serializer for a category which already has one replaces the existing serializer
+func RegisterCategoryTaskSerializer(
+	category tasks.Category,
+	serializer CategoryTaskSerializer,
+) {
+	categoryTaskSerializers.Lock()
+	defer categoryTaskSerializers.Unlock()
+
+	categoryTaskSerializers.m[category.ID()] = serializer
+}
+
+func getCategoryTaskSerializer(
+	category tasks.Category,
+) (CategoryTaskSerializer, error) {
+	categoryTaskSerializers.RLock()
+	defer categoryTaskSerializers.RUnlock()
+
+	serializer, ok := categoryTaskSerializers.m[category.ID()]
+	if !ok {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown task category: %v", category))
+	}
+	return serializer, nil
+}
+
 func NewTaskSerializer() *TaskSerializer {
 	return &TaskSerializer{}
 }
@@ -62,7 +104,11 @@ func (s *TaskSerializer) SerializeTask(
 	case tasks.CategoryIDReplication:
 		return s.serializeReplicationTask(task)
 	default:
-		return commonpb.DataBlob{}, serviceerror.NewInternal(fmt.Sprintf("Unknown task category: %v", category))
+		serializer, err := getCategoryTaskSerializer(category)
+		if err != nil {
+			return commonpb.DataBlob{}, err
+		}
+		return serializer.SerializeTask(task)
 	}
 }
 
@@ -80,7 +126,11 @@ func (s *TaskSerializer) DeserializeTask(
 	case tasks.CategoryIDReplication:
 		return s.deserializeReplicationTasks(blob)
 	default:
-		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown task category: %v", category))
+		serializer, err := getCategoryTaskSerializer(category)
+		if err != nil {
+			return nil, err
+		}
+		return serializer.DeserializeTask(blob)
 	}
 }
 
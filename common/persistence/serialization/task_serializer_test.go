@@ -25,6 +25,7 @@
 package serialization
 
 import (
+	"encoding/json"
 	"math/rand"
 	"testing"
 	"time"
@@ -32,6 +33,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/definition"
@@ -47,6 +49,18 @@ type (
 		workflowKey    definition.WorkflowKey
 		taskSerializer *TaskSerializer
 	}
+
+	testCategoryTaskSerializer struct {
+		category tasks.Category
+	}
+)
+
+var (
+	testCustomCategory = tasks.NewCategory(
+		1001,
+		tasks.CategoryTypeScheduled,
+		"test-custom-category",
+	)
 )
 
 func TestTaskSerializerSuite(t *testing.T) {
@@ -330,6 +344,30 @@ func (s *taskSerializerSuite) TestReplicateHistoryTask() {
 	s.assertEqualTasks(replicateHistoryTask)
 }
 
+func (s *taskSerializerSuite) TestCustomCategoryTask() {
+	RegisterCategoryTaskSerializer(testCustomCategory, &testCategoryTaskSerializer{category: testCustomCategory})
+
+	customTask := tasks.NewFakeTask(testCustomCategory, time.Unix(0, rand.Int63()).UTC())
+	customTask.SetTaskID(rand.Int63())
+	customTask.SetVersion(rand.Int63())
+
+	s.assertEqualTasks(customTask)
+}
+
+func (s *taskSerializerSuite) TestUnknownCategoryTask() {
+	unknownCategory := tasks.NewCategory(
+		1002,
+		tasks.CategoryTypeImmediate,
+		"test-unknown-category",
+	)
+	unknownTask := tasks.NewFakeTask(unknownCategory, time.Unix(0, 0).UTC())
+
+	_, err := s.taskSerializer.SerializeTask(unknownTask)
+	s.Error(err)
+	_, err = s.taskSerializer.DeserializeTask(unknownCategory, commonpb.DataBlob{})
+	s.Error(err)
+}
+
 func (s *taskSerializerSuite) assertEqualTasks(
 	task tasks.Task,
 ) {
@@ -339,3 +377,26 @@ func (s *taskSerializerSuite) assertEqualTasks(
 	s.NoError(err)
 	s.Equal(task, deserializedTask)
 }
+
+func (t *testCategoryTaskSerializer) SerializeTask(
+	task tasks.Task,
+) (commonpb.DataBlob, error) {
+	data, err := json.Marshal([]int64{task.GetVisibilityTime().UnixNano(), task.GetTaskID(), task.GetVersion()})
+	if err != nil {
+		return commonpb.DataBlob{}, err
+	}
+	return commonpb.DataBlob{Data: data, EncodingType: enumspb.ENCODING_TYPE_JSON}, nil
+}
+
+func (t *testCategoryTaskSerializer) DeserializeTask(
+	blob commonpb.DataBlob,
+) (tasks.Task, error) {
+	var fields []int64
+	if err := json.Unmarshal(blob.Data, &fields); err != nil {
+		return nil, err
+	}
+	task := tasks.NewFakeTask(t.category, time.Unix(0, fields[0]).UTC())
+	task.SetTaskID(fields[1])
+	task.SetVersion(fields[2])
+	return task, nil
+}
@@ -28,6 +28,7 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 
 	"go.temporal.io/api/serviceerror"
@@ -249,89 +250,9 @@ func (m *sqlExecutionStore) GetWorkflowExecution(
 		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed. Error: %v", err))
 	}
 
-	state := &p.InternalWorkflowMutableState{
-		ExecutionInfo:  p.NewDataBlob(executionsRow.Data, executionsRow.DataEncoding),
-		ExecutionState: p.NewDataBlob(executionsRow.State, executionsRow.StateEncoding),
-		NextEventID:    executionsRow.NextEventID,
-
-		DBRecordVersion: executionsRow.DBRecordVersion,
-	}
-
-	state.ActivityInfos, err = getActivityInfoMap(ctx,
-		m.Db,
-		request.ShardID,
-		namespaceID,
-		workflowID,
-		runID,
-	)
+	state, err := m.mutableStateFromExecutionsRow(ctx, "GetWorkflowExecution", executionsRow)
 	if err != nil {
-		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get activity info. Error: %v", err))
-	}
-
-	state.TimerInfos, err = getTimerInfoMap(ctx,
-		m.Db,
-		request.ShardID,
-		namespaceID,
-		workflowID,
-		runID,
-	)
-	if err != nil {
-		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get timer info. Error: %v", err))
-	}
-
-	state.ChildExecutionInfos, err = getChildExecutionInfoMap(ctx,
-		m.Db,
-		request.ShardID,
-		namespaceID,
-		workflowID,
-		runID,
-	)
-	if err != nil {
-		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get child executionsRow info. Error: %v", err))
-	}
-
-	state.RequestCancelInfos, err = getRequestCancelInfoMap(ctx,
-		m.Db,
-		request.ShardID,
-		namespaceID,
-		workflowID,
-		runID,
-	)
-	if err != nil {
-		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get request cancel info. Error: %v", err))
-	}
-
-	state.SignalInfos, err = getSignalInfoMap(ctx,
-		m.Db,
-		request.ShardID,
-		namespaceID,
-		workflowID,
-		runID,
-	)
-	if err != nil {
-		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get signal info. Error: %v", err))
-	}
-
-	state.BufferedEvents, err = getBufferedEvents(ctx,
-		m.Db,
-		request.ShardID,
-		namespaceID,
-		workflowID,
-		runID,
-	)
-	if err != nil {
-		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get buffered events. Error: %v", err))
-	}
-
-	state.SignalRequestedIDs, err = getSignalsRequested(ctx,
-		m.Db,
-		request.ShardID,
-		namespaceID,
-		workflowID,
-		runID,
-	)
-	if err != nil {
-		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get signals requested. Error: %v", err))
+		return nil, err
 	}
 
 	return &p.InternalGetWorkflowExecutionResponse{
@@ -706,7 +627,159 @@ func (m *sqlExecutionStore) setWorkflowExecutionTx(
 
 func (m *sqlExecutionStore) ListConcreteExecutions(
 	_ context.Context,
-	_ *p.ListConcreteExecutionsRequest,
+	request *p.ListConcreteExecutionsRequest,
 ) (*p.InternalListConcreteExecutionsResponse, error) {
-	return nil, serviceerror.NewUnimplemented("ListConcreteExecutions is not implemented")
+	ctx, cancel := newExecutionContext()
+	defer cancel()
+
+	filter := sqlplugin.ExecutionsRangeFilter{
+		ShardID:     request.ShardID,
+		NamespaceID: make(primitives.UUID, 16),
+		WorkflowID:  "",
+		RunID:       make(primitives.UUID, 16),
+		PageSize:    request.PageSize,
+	}
+	if len(request.PageToken) != 0 {
+		var token executionPageToken
+		if err := token.deserialize(request.PageToken); err != nil {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("ListConcreteExecutions: invalid page token. Error: %v", err))
+		}
+		filter.NamespaceID = token.NamespaceID
+		filter.WorkflowID = token.WorkflowID
+		filter.RunID = token.RunID
+	}
+
+	rows, err := m.Db.RangeSelectFromExecutions(ctx, filter)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("ListConcreteExecutions: failed. Error: %v", err))
+	}
+
+	response := &p.InternalListConcreteExecutionsResponse{}
+	for i := range rows {
+		state, err := m.mutableStateFromExecutionsRow(ctx, "ListConcreteExecutions", &rows[i])
+		if err != nil {
+			return nil, err
+		}
+		response.States = append(response.States, state)
+	}
+	if len(rows) == request.PageSize {
+		lastRow := rows[len(rows)-1]
+		token := executionPageToken{
+			NamespaceID: lastRow.NamespaceID,
+			WorkflowID:  lastRow.WorkflowID,
+			RunID:       lastRow.RunID,
+		}
+		if response.NextPageToken, err = token.serialize(); err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("ListConcreteExecutions: failed to serialize page token. Error: %v", err))
+		}
+	}
+	return response, nil
+}
+
+func (m *sqlExecutionStore) mutableStateFromExecutionsRow(
+	ctx context.Context,
+	operation string,
+	executionsRow *sqlplugin.ExecutionsRow,
+) (*p.InternalWorkflowMutableState, error) {
+	state := &p.InternalWorkflowMutableState{
+		ExecutionInfo:  p.NewDataBlob(executionsRow.Data, executionsRow.DataEncoding),
+		ExecutionState: p.NewDataBlob(executionsRow.State, executionsRow.StateEncoding),
+		NextEventID:    executionsRow.NextEventID,
+
+		DBRecordVersion: executionsRow.DBRecordVersion,
+	}
+
+	var err error
+	state.ActivityInfos, err = getActivityInfoMap(ctx,
+		m.Db,
+		executionsRow.ShardID,
+		executionsRow.NamespaceID,
+		executionsRow.WorkflowID,
+		executionsRow.RunID,
+	)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("%v: failed to get activity info. Error: %v", operation, err))
+	}
+
+	state.TimerInfos, err = getTimerInfoMap(ctx,
+		m.Db,
+		executionsRow.ShardID,
+		executionsRow.NamespaceID,
+		executionsRow.WorkflowID,
+		executionsRow.RunID,
+	)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("%v: failed to get timer info. Error: %v", operation, err))
+	}
+
+	state.ChildExecutionInfos, err = getChildExecutionInfoMap(ctx,
+		m.Db,
+		executionsRow.ShardID,
+		executionsRow.NamespaceID,
+		executionsRow.WorkflowID,
+		executionsRow.RunID,
+	)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("%v: failed to get child executionsRow info. Error: %v", operation, err))
+	}
+
+	state.RequestCancelInfos, err = getRequestCancelInfoMap(ctx,
+		m.Db,
+		executionsRow.ShardID,
+		executionsRow.NamespaceID,
+		executionsRow.WorkflowID,
+		executionsRow.RunID,
+	)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("%v: failed to get request cancel info. Error: %v", operation, err))
+	}
+
+	state.SignalInfos, err = getSignalInfoMap(ctx,
+		m.Db,
+		executionsRow.ShardID,
+		executionsRow.NamespaceID,
+		executionsRow.WorkflowID,
+		executionsRow.RunID,
+	)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("%v: failed to get signal info. Error: %v", operation, err))
+	}
+
+	state.BufferedEvents, err = getBufferedEvents(ctx,
+		m.Db,
+		executionsRow.ShardID,
+		executionsRow.NamespaceID,
+		executionsRow.WorkflowID,
+		executionsRow.RunID,
+	)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("%v: failed to get buffered events. Error: %v", operation, err))
+	}
+
+	state.SignalRequestedIDs, err = getSignalsRequested(ctx,
+		m.Db,
+		executionsRow.ShardID,
+		executionsRow.NamespaceID,
+		executionsRow.WorkflowID,
+		executionsRow.RunID,
+	)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("%v: failed to get signals requested. Error: %v", operation, err))
+	}
+
+	return state, nil
+}
+
+type executionPageToken struct {
+	NamespaceID primitives.UUID
+	WorkflowID  string
+	RunID       primitives.UUID
+}
+
+func (t *executionPageToken) serialize() ([]byte, error) {
+	return json.Marshal(t)
+}
+
+func (t *executionPageToken) deserialize(payload []byte) error {
+	return json.Unmarshal(payload, t)
 }
@@ -74,7 +74,7 @@ func (m *sqlExecutionStore) GetHistoryTask(
 	case tasks.CategoryIDReplication:
 		return m.getReplicationTask(request)
 	default:
-		return nil, serviceerror.NewInternal(fmt.Sprintf("unknown task category: %v", request.TaskCategory))
+		return m.getHistoryTask(request)
 	}
 }
 
@@ -92,7 +92,7 @@ func (m *sqlExecutionStore) GetHistoryTasks(
 	case tasks.CategoryIDReplication:
 		return m.getReplicationTasks(request)
 	default:
-		return nil, serviceerror.NewInternal(fmt.Sprintf("unknown task category: %v", request.TaskCategory))
+		return m.getHistoryTasks(request)
 	}
 }
 
@@ -110,7 +110,7 @@ func (m *sqlExecutionStore) CompleteHistoryTask(
 	case tasks.CategoryIDReplication:
 		return m.completeReplicationTask(request)
 	default:
-		return serviceerror.NewInternal(fmt.Sprintf("unknown task category: %v", request.TaskCategory))
+		return m.completeHistoryTask(request)
 	}
 }
 
@@ -128,7 +128,7 @@ func (m *sqlExecutionStore) RangeCompleteHistoryTasks(
 	case tasks.CategoryIDReplication:
 		return m.rangeCompleteReplicationTasks(request)
 	default:
-		return serviceerror.NewInternal(fmt.Sprintf("unknown task category: %v", request.TaskCategory))
+		return m.rangeCompleteHistoryTasks(request)
 	}
 }
 
@@ -678,6 +678,265 @@ func (m *sqlExecutionStore) rangeCompleteVisibilityTasks(
 	return nil
 }
 
+func (m *sqlExecutionStore) getHistoryTask(
+	request *p.GetHistoryTaskRequest,
+) (*p.InternalGetHistoryTaskResponse, error) {
+	switch request.TaskCategory.Type() {
+	case tasks.CategoryTypeImmediate:
+		return m.getHistoryImmediateTask(request)
+	case tasks.CategoryTypeScheduled:
+		return m.getHistoryScheduledTask(request)
+	default:
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown task category type: %v", request.TaskCategory))
+	}
+}
+
+func (m *sqlExecutionStore) getHistoryTasks(
+	request *p.GetHistoryTasksRequest,
+) (*p.InternalGetHistoryTasksResponse, error) {
+	switch request.TaskCategory.Type() {
+	case tasks.CategoryTypeImmediate:
+		return m.getHistoryImmediateTasks(request)
+	case tasks.CategoryTypeScheduled:
+		return m.getHistoryScheduledTasks(request)
+	default:
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown task category type: %v", request.TaskCategory))
+	}
+}
+
+func (m *sqlExecutionStore) completeHistoryTask(
+	request *p.CompleteHistoryTaskRequest,
+) error {
+	switch request.TaskCategory.Type() {
+	case tasks.CategoryTypeImmediate:
+		return m.completeHistoryImmediateTask(request)
+	case tasks.CategoryTypeScheduled:
+		return m.completeHistoryScheduledTask(request)
+	default:
+		return serviceerror.NewInternal(fmt.Sprintf("Unknown task category type: %v", request.TaskCategory))
+	}
+}
+
+func (m *sqlExecutionStore) rangeCompleteHistoryTasks(
+	request *p.RangeCompleteHistoryTasksRequest,
+) error {
+	switch request.TaskCategory.Type() {
+	case tasks.CategoryTypeImmediate:
+		return m.rangeCompleteHistoryImmediateTasks(request)
+	case tasks.CategoryTypeScheduled:
+		return m.rangeCompleteHistoryScheduledTasks(request)
+	default:
+		return serviceerror.NewInternal(fmt.Sprintf("Unknown task category type: %v", request.TaskCategory))
+	}
+}
+
+func (m *sqlExecutionStore) getHistoryImmediateTask(
+	request *p.GetHistoryTaskRequest,
+) (*p.InternalGetHistoryTaskResponse, error) {
+	ctx, cancel := newExecutionContext()
+	defer cancel()
+	rows, err := m.Db.SelectFromHistoryImmediateTasks(ctx, sqlplugin.HistoryImmediateTasksFilter{
+		ShardID:    request.ShardID,
+		CategoryID: request.TaskCategory.ID(),
+		TaskID:     request.TaskKey.TaskID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, serviceerror.NewNotFound(fmt.Sprintf("GetHistoryTask operation failed. Task with ID %v not found. Error: %v", request.TaskKey.TaskID, err))
+		}
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetHistoryTask operation failed. Failed to get record. TaskId: %v. Error: %v", request.TaskKey.TaskID, err))
+	}
+
+	if len(rows) == 0 {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("GetHistoryTask operation failed. Failed to get record. TaskId: %v", request.TaskKey.TaskID))
+	}
+
+	immediateTaskRow := rows[0]
+	resp := &p.InternalGetHistoryTaskResponse{Task: *p.NewDataBlob(immediateTaskRow.Data, immediateTaskRow.DataEncoding)}
+	return resp, nil
+}
+
+func (m *sqlExecutionStore) getHistoryImmediateTasks(
+	request *p.GetHistoryTasksRequest,
+) (*p.InternalGetHistoryTasksResponse, error) {
+	ctx, cancel := newExecutionContext()
+	defer cancel()
+	inclusiveMinTaskID, exclusiveMaxTaskID, err := getImmediateTaskReadRange(request)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := m.Db.RangeSelectFromHistoryImmediateTasks(ctx, sqlplugin.HistoryImmediateTasksRangeFilter{
+		ShardID:            request.ShardID,
+		CategoryID:         request.TaskCategory.ID(),
+		InclusiveMinTaskID: inclusiveMinTaskID,
+		ExclusiveMaxTaskID: exclusiveMaxTaskID,
+		PageSize:           request.BatchSize,
+	})
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetHistoryTasks operation failed. Select failed. CategoryID: %v. Error: %v", request.TaskCategory.ID(), err))
+		}
+	}
+	resp := &p.InternalGetHistoryTasksResponse{
+		Tasks: make([]commonpb.DataBlob, len(rows)),
+	}
+	if len(rows) == 0 {
+		return resp, nil
+	}
+
+	for i, row := range rows {
+		resp.Tasks[i] = *p.NewDataBlob(row.Data, row.DataEncoding)
+	}
+	if len(rows) == request.BatchSize {
+		resp.NextPageToken = getImmediateTaskNextPageToken(
+			rows[len(rows)-1].TaskID,
+			exclusiveMaxTaskID,
+		)
+	}
+
+	return resp, nil
+}
+
+func (m *sqlExecutionStore) completeHistoryImmediateTask(
+	request *p.CompleteHistoryTaskRequest,
+) error {
+	ctx, cancel := newExecutionContext()
+	defer cancel()
+	if _, err := m.Db.DeleteFromHistoryImmediateTasks(ctx, sqlplugin.HistoryImmediateTasksFilter{
+		ShardID:    request.ShardID,
+		CategoryID: request.TaskCategory.ID(),
+		TaskID:     request.TaskKey.TaskID,
+	}); err != nil {
+		return serviceerror.NewUnavailable(fmt.Sprintf("CompleteHistoryTask operation failed. CategoryID: %v. Error: %v", request.TaskCategory.ID(), err))
+	}
+	return nil
+}
+
+func (m *sqlExecutionStore) rangeCompleteHistoryImmediateTasks(
+	request *p.RangeCompleteHistoryTasksRequest,
+) error {
+	ctx, cancel := newExecutionContext()
+	defer cancel()
+	if _, err := m.Db.RangeDeleteFromHistoryImmediateTasks(ctx, sqlplugin.HistoryImmediateTasksRangeFilter{
+		ShardID:            request.ShardID,
+		CategoryID:         request.TaskCategory.ID(),
+		InclusiveMinTaskID: request.InclusiveMinTaskKey.TaskID,
+		ExclusiveMaxTaskID: request.ExclusiveMaxTaskKey.TaskID,
+	}); err != nil {
+		return serviceerror.NewUnavailable(fmt.Sprintf("RangeCompleteHistoryTask operation failed. CategoryID: %v. Error: %v", request.TaskCategory.ID(), err))
+	}
+	return nil
+}
+
+func (m *sqlExecutionStore) getHistoryScheduledTask(
+	request *p.GetHistoryTaskRequest,
+) (*p.InternalGetHistoryTaskResponse, error) {
+	ctx, cancel := newExecutionContext()
+	defer cancel()
+	rows, err := m.Db.SelectFromHistoryScheduledTasks(ctx, sqlplugin.HistoryScheduledTasksFilter{
+		ShardID:             request.ShardID,
+		CategoryID:          request.TaskCategory.ID(),
+		TaskID:              request.TaskKey.TaskID,
+		VisibilityTimestamp: request.TaskKey.FireTime,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, serviceerror.NewNotFound(fmt.Sprintf("GetHistoryTask operation failed. Task with ID %v not found. Error: %v", request.TaskKey.TaskID, err))
+		}
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetHistoryTask operation failed. Failed to get record. TaskId: %v. Error: %v", request.TaskKey.TaskID, err))
+	}
+
+	if len(rows) == 0 {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("GetHistoryTask operation failed. Failed to get record. TaskId: %v", request.TaskKey.TaskID))
+	}
+
+	scheduledTaskRow := rows[0]
+	resp := &p.InternalGetHistoryTaskResponse{
+		Task: *p.NewDataBlob(scheduledTaskRow.Data, scheduledTaskRow.DataEncoding),
+	}
+	return resp, nil
+}
+
+func (m *sqlExecutionStore) getHistoryScheduledTasks(
+	request *p.GetHistoryTasksRequest,
+) (*p.InternalGetHistoryTasksResponse, error) {
+	ctx, cancel := newExecutionContext()
+	defer cancel()
+	pageToken := &timerTaskPageToken{TaskID: math.MinInt64, Timestamp: request.InclusiveMinTaskKey.FireTime}
+	if len(request.NextPageToken) > 0 {
+		if err := pageToken.deserialize(request.NextPageToken); err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("error deserializing timerTaskPageToken: %v", err))
+		}
+	}
+
+	rows, err := m.Db.RangeSelectFromHistoryScheduledTasks(ctx, sqlplugin.HistoryScheduledTasksRangeFilter{
+		ShardID:                         request.ShardID,
+		CategoryID:                      request.TaskCategory.ID(),
+		InclusiveMinVisibilityTimestamp: pageToken.Timestamp,
+		InclusiveMinTaskID:              pageToken.TaskID,
+		ExclusiveMaxVisibilityTimestamp: request.ExclusiveMaxTaskKey.FireTime,
+		PageSize:                        request.BatchSize,
+	})
+
+	if err != nil && err != sql.ErrNoRows {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetHistoryTasks operation failed. Select failed. CategoryID: %v. Error: %v", request.TaskCategory.ID(), err))
+	}
+
+	resp := &p.InternalGetHistoryTasksResponse{Tasks: make([]commonpb.DataBlob, len(rows))}
+	for i, row := range rows {
+		resp.Tasks[i] = *p.NewDataBlob(row.Data, row.DataEncoding)
+	}
+
+	if len(resp.Tasks) == request.BatchSize {
+		pageToken = &timerTaskPageToken{
+			TaskID:    rows[request.BatchSize-1].TaskID + 1,
+			Timestamp: rows[request.BatchSize-1].VisibilityTimestamp,
+		}
+		nextToken, err := pageToken.serialize()
+		if err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("GetHistoryTasks: error serializing page token: %v", err))
+		}
+		resp.NextPageToken = nextToken
+	}
+
+	return resp, nil
+}
+
+func (m *sqlExecutionStore) completeHistoryScheduledTask(
+	request *p.CompleteHistoryTaskRequest,
+) error {
+	ctx, cancel := newExecutionContext()
+	defer cancel()
+	if _, err := m.Db.DeleteFromHistoryScheduledTasks(ctx, sqlplugin.HistoryScheduledTasksFilter{
+		ShardID:             request.ShardID,
+		CategoryID:          request.TaskCategory.ID(),
+		VisibilityTimestamp: request.TaskKey.FireTime,
+		TaskID:              request.TaskKey.TaskID,
+	}); err != nil {
+		return serviceerror.NewUnavailable(fmt.Sprintf("CompleteHistoryTask operation failed. CategoryID: %v. Error: %v", request.TaskCategory.ID(), err))
+	}
+	return nil
+}
+
+func (m *sqlExecutionStore) rangeCompleteHistoryScheduledTasks(
+	request *p.RangeCompleteHistoryTasksRequest,
+) error {
+	ctx, cancel := newExecutionContext()
+	defer cancel()
+	start := request.InclusiveMinTaskKey.FireTime
+	end := request.ExclusiveMaxTaskKey.FireTime
+	if _, err := m.Db.RangeDeleteFromHistoryScheduledTasks(ctx, sqlplugin.HistoryScheduledTasksRangeFilter{
+		ShardID:                         request.ShardID,
+		CategoryID:                      request.TaskCategory.ID(),
+		InclusiveMinVisibilityTimestamp: start,
+		ExclusiveMaxVisibilityTimestamp: end,
+	}); err != nil {
+		return serviceerror.NewUnavailable(fmt.Sprintf("RangeCompleteHistoryTask operation failed. CategoryID: %v. Error: %v", request.TaskCategory.ID(), err))
+	}
+	return nil
+}
+
 type timerTaskPageToken struct {
 	TaskID    int64
 	Timestamp time.Time
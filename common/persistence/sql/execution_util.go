@@ -539,7 +539,7 @@ func applyTasks(
 		case tasks.CategoryIDReplication:
 			err = createReplicationTasks(ctx, tx, shardID, tasksByCategory)
 		default:
-			err = serviceerror.NewInternal(fmt.Sprintf("Unknown task category: %v", category))
+			err = createHistoryTasks(ctx, tx, shardID, category, tasksByCategory)
 		}
 
 		if err != nil {
@@ -846,6 +846,64 @@ func createVisibilityTasks(
 	return nil
 }
 
+func createHistoryTasks(
+	ctx context.Context,
+	tx sqlplugin.Tx,
+	shardID int32,
+	category tasks.Category,
+	historyTasks []p.InternalHistoryTask,
+) error {
+
+	if len(historyTasks) == 0 {
+		return nil
+	}
+
+	var result sql.Result
+	var err error
+	switch category.Type() {
+	case tasks.CategoryTypeImmediate:
+		immediateTasksRows := make([]sqlplugin.HistoryImmediateTasksRow, 0, len(historyTasks))
+		for _, task := range historyTasks {
+			immediateTasksRows = append(immediateTasksRows, sqlplugin.HistoryImmediateTasksRow{
+				ShardID:      shardID,
+				CategoryID:   category.ID(),
+				TaskID:       task.Key.TaskID,
+				Data:         task.Blob.Data,
+				DataEncoding: task.Blob.EncodingType.String(),
+			})
+		}
+		result, err = tx.InsertIntoHistoryImmediateTasks(ctx, immediateTasksRows)
+	case tasks.CategoryTypeScheduled:
+		scheduledTasksRows := make([]sqlplugin.HistoryScheduledTasksRow, 0, len(historyTasks))
+		for _, task := range historyTasks {
+			scheduledTasksRows = append(scheduledTasksRows, sqlplugin.HistoryScheduledTasksRow{
+				ShardID:             shardID,
+				CategoryID:          category.ID(),
+				VisibilityTimestamp: task.Key.FireTime,
+				TaskID:              task.Key.TaskID,
+				Data:                task.Blob.Data,
+				DataEncoding:        task.Blob.EncodingType.String(),
+			})
+		}
+		result, err = tx.InsertIntoHistoryScheduledTasks(ctx, scheduledTasksRows)
+	default:
+		return serviceerror.NewInternal(fmt.Sprintf("Unknown task category type: %v", category))
+	}
+	if err != nil {
+		return serviceerror.NewUnavailable(fmt.Sprintf("createHistoryTasks failed. CategoryID: %v. Error: %v", category.ID(), err))
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return serviceerror.NewUnavailable(fmt.Sprintf("createHistoryTasks failed. Could not verify number of rows inserted. Error: %v", err))
+	}
+
+	if int(rowsAffected) != len(historyTasks) {
+		return serviceerror.NewUnavailable(fmt.Sprintf("createHistoryTasks failed. Inserted %v instead of %v rows for category %v.", rowsAffected, len(historyTasks), category.ID()))
+	}
+	return nil
+}
+
 func assertNotCurrentExecution(
 	ctx context.Context,
 	tx sqlplugin.Tx,
@@ -59,6 +59,17 @@ type (
 		RunID       primitives.UUID
 	}
 
+	// ExecutionsRangeFilter contains the column names within executions table that
+	// can be used to page through all rows of a shard; the (namespace_id, workflow_id, run_id)
+	// tuple is an exclusive lower bound
+	ExecutionsRangeFilter struct {
+		ShardID     int32
+		NamespaceID primitives.UUID
+		WorkflowID  string
+		RunID       primitives.UUID
+		PageSize    int
+	}
+
 	// CurrentExecutionsRow represents a row in current_executions table
 	CurrentExecutionsRow struct {
 		ShardID          int32
@@ -91,6 +102,9 @@ type (
 		InsertIntoExecutions(ctx context.Context, row *ExecutionsRow) (sql.Result, error)
 		UpdateExecutions(ctx context.Context, row *ExecutionsRow) (sql.Result, error)
 		SelectFromExecutions(ctx context.Context, filter ExecutionsFilter) (*ExecutionsRow, error)
+		// RangeSelectFromExecutions returns up to PageSize rows of a shard ordered by
+		// (namespace_id, workflow_id, run_id), starting after the given tuple
+		RangeSelectFromExecutions(ctx context.Context, filter ExecutionsRangeFilter) ([]ExecutionsRow, error)
 		DeleteFromExecutions(ctx context.Context, filter ExecutionsFilter) (sql.Result, error)
 		ReadLockExecutions(ctx context.Context, filter ExecutionsFilter) (int64, int64, error)
 		WriteLockExecutions(ctx context.Context, filter ExecutionsFilter) (int64, int64, error)
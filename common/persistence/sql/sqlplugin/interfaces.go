@@ -79,6 +79,8 @@ type (
 		HistoryReplicationTask
 		HistoryReplicationDLQTask
 		HistoryVisibilityTask
+		HistoryImmediateTask
+		HistoryScheduledTask
 	}
 
 	// AdminCRUD defines admin operations for CLI and test suites
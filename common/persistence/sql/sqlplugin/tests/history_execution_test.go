@@ -145,6 +145,55 @@ func (s *historyExecutionSuite) TestInsertSelect() {
 	s.Equal(&execution, row)
 }
 
+func (s *historyExecutionSuite) TestInsertRangeSelect() {
+	shardID := rand.Int31()
+	numExecutions := 5
+	pageSize := 2
+
+	executions := make(map[string]sqlplugin.ExecutionsRow)
+	for i := 0; i < numExecutions; i++ {
+		namespaceID := primitives.NewUUID()
+		workflowID := shuffle.String(testHistoryExecutionWorkflowID)
+		runID := primitives.NewUUID()
+		execution := s.newRandomExecutionRow(shardID, namespaceID, workflowID, runID, rand.Int63(), rand.Int63())
+		result, err := s.store.InsertIntoExecutions(newExecutionContext(), &execution)
+		s.NoError(err)
+		rowsAffected, err := result.RowsAffected()
+		s.NoError(err)
+		s.Equal(1, int(rowsAffected))
+		executions[runID.String()] = execution
+	}
+
+	filter := sqlplugin.ExecutionsRangeFilter{
+		ShardID:     shardID,
+		NamespaceID: make(primitives.UUID, 16),
+		WorkflowID:  "",
+		RunID:       make(primitives.UUID, 16),
+		PageSize:    pageSize,
+	}
+	var result []sqlplugin.ExecutionsRow
+	for {
+		rows, err := s.store.RangeSelectFromExecutions(newExecutionContext(), filter)
+		s.NoError(err)
+		s.True(len(rows) <= pageSize)
+		result = append(result, rows...)
+		if len(rows) < pageSize {
+			break
+		}
+		lastRow := rows[len(rows)-1]
+		filter.NamespaceID = lastRow.NamespaceID
+		filter.WorkflowID = lastRow.WorkflowID
+		filter.RunID = lastRow.RunID
+	}
+
+	s.Len(result, numExecutions)
+	for _, row := range result {
+		execution, ok := executions[row.RunID.String()]
+		s.True(ok)
+		s.Equal(execution, row)
+	}
+}
+
 func (s *historyExecutionSuite) TestInsertUpdate_Success() {
 	shardID := rand.Int31()
 	namespaceID := primitives.NewUUID()
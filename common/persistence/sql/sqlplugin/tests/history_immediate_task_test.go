@@ -0,0 +1,355 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tests
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/shuffle"
+)
+
+type (
+	historyHistoryImmediateTaskSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		store sqlplugin.HistoryImmediateTask
+	}
+)
+
+const (
+	testHistoryImmediateTaskEncoding = "random encoding"
+)
+
+var (
+	testHistoryImmediateTaskData = []byte("random history immediate task data")
+)
+
+func newHistoryImmediateTaskSuite(
+	t *testing.T,
+	store sqlplugin.HistoryImmediateTask,
+) *historyHistoryImmediateTaskSuite {
+	return &historyHistoryImmediateTaskSuite{
+		Assertions: require.New(t),
+		store:      store,
+	}
+}
+
+func (s *historyHistoryImmediateTaskSuite) SetupSuite() {
+
+}
+
+func (s *historyHistoryImmediateTaskSuite) TearDownSuite() {
+
+}
+
+func (s *historyHistoryImmediateTaskSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *historyHistoryImmediateTaskSuite) TearDownTest() {
+
+}
+
+func (s *historyHistoryImmediateTaskSuite) TestInsert_Single_Success() {
+	shardID := rand.Int31()
+	categoryID := rand.Int31()
+	taskID := int64(1)
+
+	task := s.newRandomImmediateTaskRow(shardID, categoryID, taskID)
+	result, err := s.store.InsertIntoHistoryImmediateTasks(newExecutionContext(), []sqlplugin.HistoryImmediateTasksRow{task})
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(1, int(rowsAffected))
+}
+
+func (s *historyHistoryImmediateTaskSuite) TestInsert_Multiple_Success() {
+	shardID := rand.Int31()
+	categoryID := rand.Int31()
+	taskID := int64(1)
+
+	task1 := s.newRandomImmediateTaskRow(shardID, categoryID, taskID)
+	taskID++
+	task2 := s.newRandomImmediateTaskRow(shardID, categoryID, taskID)
+	result, err := s.store.InsertIntoHistoryImmediateTasks(newExecutionContext(), []sqlplugin.HistoryImmediateTasksRow{task1, task2})
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(2, int(rowsAffected))
+}
+
+func (s *historyHistoryImmediateTaskSuite) TestInsert_Single_Fail_Duplicate() {
+	shardID := rand.Int31()
+	categoryID := rand.Int31()
+	taskID := int64(1)
+
+	task := s.newRandomImmediateTaskRow(shardID, categoryID, taskID)
+	result, err := s.store.InsertIntoHistoryImmediateTasks(newExecutionContext(), []sqlplugin.HistoryImmediateTasksRow{task})
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(1, int(rowsAffected))
+
+	task = s.newRandomImmediateTaskRow(shardID, categoryID, taskID)
+	_, err = s.store.InsertIntoHistoryImmediateTasks(newExecutionContext(), []sqlplugin.HistoryImmediateTasksRow{task})
+	s.Error(err) // TODO persistence layer should do proper error translation
+}
+
+func (s *historyHistoryImmediateTaskSuite) TestInsert_Multiple_Fail_Duplicate() {
+	shardID := rand.Int31()
+	categoryID := rand.Int31()
+	taskID := int64(1)
+
+	task1 := s.newRandomImmediateTaskRow(shardID, categoryID, taskID)
+	taskID++
+	task2 := s.newRandomImmediateTaskRow(shardID, categoryID, taskID)
+	result, err := s.store.InsertIntoHistoryImmediateTasks(newExecutionContext(), []sqlplugin.HistoryImmediateTasksRow{task1, task2})
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(2, int(rowsAffected))
+
+	task2 = s.newRandomImmediateTaskRow(shardID, categoryID, taskID)
+	taskID++
+	task3 := s.newRandomImmediateTaskRow(shardID, categoryID, taskID)
+	_, err = s.store.InsertIntoHistoryImmediateTasks(newExecutionContext(), []sqlplugin.HistoryImmediateTasksRow{task2, task3})
+	s.Error(err) // TODO persistence layer should do proper error translation
+}
+
+func (s *historyHistoryImmediateTaskSuite) TestInsertSelect_Single() {
+	shardID := rand.Int31()
+	categoryID := rand.Int31()
+	taskID := int64(1)
+
+	task := s.newRandomImmediateTaskRow(shardID, categoryID, taskID)
+	result, err := s.store.InsertIntoHistoryImmediateTasks(newExecutionContext(), []sqlplugin.HistoryImmediateTasksRow{task})
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(1, int(rowsAffected))
+
+	filter := sqlplugin.HistoryImmediateTasksFilter{
+		ShardID:    shardID,
+		CategoryID: categoryID,
+		TaskID:     taskID,
+	}
+	rows, err := s.store.SelectFromHistoryImmediateTasks(newExecutionContext(), filter)
+	s.NoError(err)
+	for index := range rows {
+		rows[index].ShardID = shardID
+		rows[index].CategoryID = categoryID
+	}
+	s.Equal([]sqlplugin.HistoryImmediateTasksRow{task}, rows)
+}
+
+func (s *historyHistoryImmediateTaskSuite) TestInsertSelect_Multiple() {
+	numTasks := 20
+
+	shardID := rand.Int31()
+	categoryID := rand.Int31()
+	minTaskID := int64(1)
+	taskID := minTaskID
+	maxTaskID := taskID + int64(numTasks)
+
+	var tasks []sqlplugin.HistoryImmediateTasksRow
+	for i := 0; i < numTasks; i++ {
+		task := s.newRandomImmediateTaskRow(shardID, categoryID, taskID)
+		taskID++
+		tasks = append(tasks, task)
+	}
+	result, err := s.store.InsertIntoHistoryImmediateTasks(newExecutionContext(), tasks)
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(numTasks, int(rowsAffected))
+
+	for _, pageSize := range []int{numTasks / 2, numTasks * 2} {
+		filter := sqlplugin.HistoryImmediateTasksRangeFilter{
+			ShardID:            shardID,
+			CategoryID:         categoryID,
+			InclusiveMinTaskID: minTaskID,
+			ExclusiveMaxTaskID: maxTaskID,
+			PageSize:           pageSize,
+		}
+		rows, err := s.store.RangeSelectFromHistoryImmediateTasks(newExecutionContext(), filter)
+		s.NoError(err)
+		s.NotEmpty(rows)
+		s.True(len(rows) <= filter.PageSize)
+		for index := range rows {
+			rows[index].ShardID = shardID
+			rows[index].CategoryID = categoryID
+		}
+		s.Equal(tasks[:common.MinInt(numTasks, pageSize)], rows)
+	}
+}
+
+func (s *historyHistoryImmediateTaskSuite) TestDeleteSelect_Single() {
+	shardID := rand.Int31()
+	categoryID := rand.Int31()
+	taskID := int64(1)
+
+	filter := sqlplugin.HistoryImmediateTasksFilter{
+		ShardID:    shardID,
+		CategoryID: categoryID,
+		TaskID:     taskID,
+	}
+	result, err := s.store.DeleteFromHistoryImmediateTasks(newExecutionContext(), filter)
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(0, int(rowsAffected))
+
+	rows, err := s.store.SelectFromHistoryImmediateTasks(newExecutionContext(), filter)
+	s.NoError(err)
+	for index := range rows {
+		rows[index].ShardID = shardID
+		rows[index].CategoryID = categoryID
+	}
+	s.Equal([]sqlplugin.HistoryImmediateTasksRow(nil), rows)
+}
+
+func (s *historyHistoryImmediateTaskSuite) TestDeleteSelect_Multiple() {
+	shardID := rand.Int31()
+	categoryID := rand.Int31()
+	minTaskID := int64(1)
+	maxTaskID := int64(101)
+
+	filter := sqlplugin.HistoryImmediateTasksRangeFilter{
+		ShardID:            shardID,
+		CategoryID:         categoryID,
+		InclusiveMinTaskID: minTaskID,
+		ExclusiveMaxTaskID: maxTaskID,
+		PageSize:           int(maxTaskID - minTaskID),
+	}
+	result, err := s.store.RangeDeleteFromHistoryImmediateTasks(newExecutionContext(), filter)
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(0, int(rowsAffected))
+
+	rows, err := s.store.RangeSelectFromHistoryImmediateTasks(newExecutionContext(), filter)
+	s.NoError(err)
+	for index := range rows {
+		rows[index].ShardID = shardID
+		rows[index].CategoryID = categoryID
+	}
+	s.Equal([]sqlplugin.HistoryImmediateTasksRow(nil), rows)
+}
+
+func (s *historyHistoryImmediateTaskSuite) TestInsertDeleteSelect_Single() {
+	shardID := rand.Int31()
+	categoryID := rand.Int31()
+	taskID := int64(1)
+
+	task := s.newRandomImmediateTaskRow(shardID, categoryID, taskID)
+	result, err := s.store.InsertIntoHistoryImmediateTasks(newExecutionContext(), []sqlplugin.HistoryImmediateTasksRow{task})
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(1, int(rowsAffected))
+
+	filter := sqlplugin.HistoryImmediateTasksFilter{
+		ShardID:    shardID,
+		CategoryID: categoryID,
+		TaskID:     taskID,
+	}
+	result, err = s.store.DeleteFromHistoryImmediateTasks(newExecutionContext(), filter)
+	s.NoError(err)
+	rowsAffected, err = result.RowsAffected()
+	s.NoError(err)
+	s.Equal(1, int(rowsAffected))
+
+	rows, err := s.store.SelectFromHistoryImmediateTasks(newExecutionContext(), filter)
+	s.NoError(err)
+	for index := range rows {
+		rows[index].ShardID = shardID
+		rows[index].CategoryID = categoryID
+	}
+	s.Equal([]sqlplugin.HistoryImmediateTasksRow(nil), rows)
+}
+
+func (s *historyHistoryImmediateTaskSuite) TestInsertDeleteSelect_Multiple() {
+	numTasks := 20
+
+	shardID := rand.Int31()
+	categoryID := rand.Int31()
+	minTaskID := int64(1)
+	taskID := minTaskID
+	maxTaskID := taskID + int64(numTasks)
+
+	var tasks []sqlplugin.HistoryImmediateTasksRow
+	for i := 0; i < numTasks; i++ {
+		task := s.newRandomImmediateTaskRow(shardID, categoryID, taskID)
+		taskID++
+		tasks = append(tasks, task)
+	}
+	result, err := s.store.InsertIntoHistoryImmediateTasks(newExecutionContext(), tasks)
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(numTasks, int(rowsAffected))
+
+	filter := sqlplugin.HistoryImmediateTasksRangeFilter{
+		ShardID:            shardID,
+		CategoryID:         categoryID,
+		InclusiveMinTaskID: minTaskID,
+		ExclusiveMaxTaskID: maxTaskID,
+		PageSize:           int(maxTaskID - minTaskID),
+	}
+	result, err = s.store.RangeDeleteFromHistoryImmediateTasks(newExecutionContext(), filter)
+	s.NoError(err)
+	rowsAffected, err = result.RowsAffected()
+	s.NoError(err)
+	s.Equal(numTasks, int(rowsAffected))
+
+	rows, err := s.store.RangeSelectFromHistoryImmediateTasks(newExecutionContext(), filter)
+	s.NoError(err)
+	for index := range rows {
+		rows[index].ShardID = shardID
+		rows[index].CategoryID = categoryID
+	}
+	s.Equal([]sqlplugin.HistoryImmediateTasksRow(nil), rows)
+}
+
+func (s *historyHistoryImmediateTaskSuite) newRandomImmediateTaskRow(
+	shardID int32,
+	categoryID int32,
+	taskID int64,
+) sqlplugin.HistoryImmediateTasksRow {
+	return sqlplugin.HistoryImmediateTasksRow{
+		ShardID:      shardID,
+		CategoryID:   categoryID,
+		TaskID:       taskID,
+		Data:         shuffle.Bytes(testHistoryImmediateTaskData),
+		DataEncoding: testHistoryImmediateTaskEncoding,
+	}
+}
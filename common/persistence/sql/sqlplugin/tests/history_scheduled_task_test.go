@@ -0,0 +1,379 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tests
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/shuffle"
+)
+
+type (
+	historyHistoryScheduledTaskSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		store sqlplugin.HistoryScheduledTask
+	}
+)
+
+const (
+	testHistoryScheduledTaskEncoding = "random encoding"
+)
+
+var (
+	testHistoryScheduledTaskData = []byte("random history scheduled task data")
+)
+
+func newHistoryScheduledTaskSuite(
+	t *testing.T,
+	store sqlplugin.HistoryScheduledTask,
+) *historyHistoryScheduledTaskSuite {
+	return &historyHistoryScheduledTaskSuite{
+		Assertions: require.New(t),
+		store:      store,
+	}
+}
+
+func (s *historyHistoryScheduledTaskSuite) SetupSuite() {
+
+}
+
+func (s *historyHistoryScheduledTaskSuite) TearDownSuite() {
+
+}
+
+func (s *historyHistoryScheduledTaskSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *historyHistoryScheduledTaskSuite) TearDownTest() {
+
+}
+
+func (s *historyHistoryScheduledTaskSuite) TestInsert_Single_Success() {
+	shardID := rand.Int31()
+	categoryID := rand.Int31()
+	timestamp := s.now()
+	taskID := int64(1)
+
+	task := s.newRandomScheduledTaskRow(shardID, categoryID, timestamp, taskID)
+	result, err := s.store.InsertIntoHistoryScheduledTasks(newExecutionContext(), []sqlplugin.HistoryScheduledTasksRow{task})
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(1, int(rowsAffected))
+}
+
+func (s *historyHistoryScheduledTaskSuite) TestInsert_Multiple_Success() {
+	shardID := rand.Int31()
+	categoryID := rand.Int31()
+	timestamp := s.now()
+	taskID := int64(1)
+
+	task1 := s.newRandomScheduledTaskRow(shardID, categoryID, timestamp, taskID)
+	timestamp = timestamp.Add(time.Millisecond)
+	taskID++
+	task2 := s.newRandomScheduledTaskRow(shardID, categoryID, timestamp, taskID)
+	result, err := s.store.InsertIntoHistoryScheduledTasks(newExecutionContext(), []sqlplugin.HistoryScheduledTasksRow{task1, task2})
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(2, int(rowsAffected))
+}
+
+func (s *historyHistoryScheduledTaskSuite) TestInsert_Single_Fail_Duplicate() {
+	shardID := rand.Int31()
+	categoryID := rand.Int31()
+	timestamp := s.now()
+	taskID := int64(1)
+
+	task := s.newRandomScheduledTaskRow(shardID, categoryID, timestamp, taskID)
+	result, err := s.store.InsertIntoHistoryScheduledTasks(newExecutionContext(), []sqlplugin.HistoryScheduledTasksRow{task})
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(1, int(rowsAffected))
+
+	task = s.newRandomScheduledTaskRow(shardID, categoryID, timestamp, taskID)
+	_, err = s.store.InsertIntoHistoryScheduledTasks(newExecutionContext(), []sqlplugin.HistoryScheduledTasksRow{task})
+	s.Error(err) // TODO persistence layer should do proper error translation
+}
+
+func (s *historyHistoryScheduledTaskSuite) TestInsert_Multiple_Fail_Duplicate() {
+	shardID := rand.Int31()
+	categoryID := rand.Int31()
+	timestamp := s.now()
+	taskID := int64(1)
+
+	task1 := s.newRandomScheduledTaskRow(shardID, categoryID, timestamp, taskID)
+	timestamp = timestamp.Add(time.Millisecond)
+	taskID++
+	task2 := s.newRandomScheduledTaskRow(shardID, categoryID, timestamp, taskID)
+	result, err := s.store.InsertIntoHistoryScheduledTasks(newExecutionContext(), []sqlplugin.HistoryScheduledTasksRow{task1, task2})
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(2, int(rowsAffected))
+
+	task2 = s.newRandomScheduledTaskRow(shardID, categoryID, timestamp, taskID)
+	timestamp = timestamp.Add(time.Millisecond)
+	taskID++
+	task3 := s.newRandomScheduledTaskRow(shardID, categoryID, timestamp, taskID)
+	_, err = s.store.InsertIntoHistoryScheduledTasks(newExecutionContext(), []sqlplugin.HistoryScheduledTasksRow{task2, task3})
+	s.Error(err) // TODO persistence layer should do proper error translation
+}
+
+func (s *historyHistoryScheduledTaskSuite) TestInsertSelect_Single() {
+	shardID := rand.Int31()
+	categoryID := rand.Int31()
+	timestamp := s.now()
+	taskID := int64(1)
+
+	task := s.newRandomScheduledTaskRow(shardID, categoryID, timestamp, taskID)
+	result, err := s.store.InsertIntoHistoryScheduledTasks(newExecutionContext(), []sqlplugin.HistoryScheduledTasksRow{task})
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(1, int(rowsAffected))
+
+	filter := sqlplugin.HistoryScheduledTasksFilter{
+		ShardID:             shardID,
+		CategoryID:          categoryID,
+		VisibilityTimestamp: timestamp,
+		TaskID:              taskID,
+	}
+	rows, err := s.store.SelectFromHistoryScheduledTasks(newExecutionContext(), filter)
+	s.NoError(err)
+	for index := range rows {
+		rows[index].ShardID = shardID
+		rows[index].CategoryID = categoryID
+	}
+	s.Equal([]sqlplugin.HistoryScheduledTasksRow{task}, rows)
+}
+
+func (s *historyHistoryScheduledTaskSuite) TestInsertSelect_Multiple() {
+	numTasks := 20
+
+	shardID := rand.Int31()
+	categoryID := rand.Int31()
+	timestamp := s.now()
+	minTimestamp := timestamp
+	taskID := int64(1)
+	maxTimestamp := timestamp.Add(time.Duration(numTasks) * time.Millisecond)
+
+	var tasks []sqlplugin.HistoryScheduledTasksRow
+	for i := 0; i < numTasks; i++ {
+		task := s.newRandomScheduledTaskRow(shardID, categoryID, timestamp, taskID)
+		timestamp = timestamp.Add(time.Millisecond)
+		taskID++
+		tasks = append(tasks, task)
+	}
+	result, err := s.store.InsertIntoHistoryScheduledTasks(newExecutionContext(), tasks)
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(numTasks, int(rowsAffected))
+
+	filter := sqlplugin.HistoryScheduledTasksRangeFilter{
+		ShardID:                         shardID,
+		CategoryID:                      categoryID,
+		InclusiveMinVisibilityTimestamp: minTimestamp,
+		ExclusiveMaxVisibilityTimestamp: maxTimestamp,
+		PageSize:                        numTasks,
+	}
+	rows, err := s.store.RangeSelectFromHistoryScheduledTasks(newExecutionContext(), filter)
+	s.NoError(err)
+	for index := range rows {
+		rows[index].ShardID = shardID
+		rows[index].CategoryID = categoryID
+	}
+	s.Equal(tasks, rows)
+}
+
+func (s *historyHistoryScheduledTaskSuite) TestDeleteSelect_Single() {
+	shardID := rand.Int31()
+	categoryID := rand.Int31()
+	timestamp := s.now()
+	taskID := int64(1)
+
+	filter := sqlplugin.HistoryScheduledTasksFilter{
+		ShardID:             shardID,
+		CategoryID:          categoryID,
+		VisibilityTimestamp: timestamp,
+		TaskID:              taskID,
+	}
+	result, err := s.store.DeleteFromHistoryScheduledTasks(newExecutionContext(), filter)
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(0, int(rowsAffected))
+
+	rows, err := s.store.SelectFromHistoryScheduledTasks(newExecutionContext(), filter)
+	s.NoError(err)
+	for index := range rows {
+		rows[index].ShardID = shardID
+		rows[index].CategoryID = categoryID
+	}
+	s.Equal([]sqlplugin.HistoryScheduledTasksRow(nil), rows)
+}
+
+func (s *historyHistoryScheduledTaskSuite) TestDeleteSelect_Multiple() {
+	pageSize := 100
+
+	shardID := rand.Int31()
+	categoryID := rand.Int31()
+	minTimestamp := s.now()
+	maxTimestamp := minTimestamp.Add(time.Minute)
+
+	filter := sqlplugin.HistoryScheduledTasksRangeFilter{
+		ShardID:                         shardID,
+		CategoryID:                      categoryID,
+		InclusiveMinVisibilityTimestamp: minTimestamp,
+		ExclusiveMaxVisibilityTimestamp: maxTimestamp,
+		PageSize:                        0,
+	}
+	result, err := s.store.RangeDeleteFromHistoryScheduledTasks(newExecutionContext(), filter)
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(0, int(rowsAffected))
+
+	filter.PageSize = pageSize
+	rows, err := s.store.RangeSelectFromHistoryScheduledTasks(newExecutionContext(), filter)
+	s.NoError(err)
+	for index := range rows {
+		rows[index].ShardID = shardID
+		rows[index].CategoryID = categoryID
+	}
+	s.Equal([]sqlplugin.HistoryScheduledTasksRow(nil), rows)
+}
+
+func (s *historyHistoryScheduledTaskSuite) TestInsertDeleteSelect_Single() {
+	shardID := rand.Int31()
+	categoryID := rand.Int31()
+	timestamp := s.now()
+	taskID := int64(1)
+
+	task := s.newRandomScheduledTaskRow(shardID, categoryID, timestamp, taskID)
+	result, err := s.store.InsertIntoHistoryScheduledTasks(newExecutionContext(), []sqlplugin.HistoryScheduledTasksRow{task})
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(1, int(rowsAffected))
+
+	filter := sqlplugin.HistoryScheduledTasksFilter{
+		ShardID:             shardID,
+		CategoryID:          categoryID,
+		VisibilityTimestamp: timestamp,
+		TaskID:              taskID,
+	}
+	result, err = s.store.DeleteFromHistoryScheduledTasks(newExecutionContext(), filter)
+	s.NoError(err)
+	rowsAffected, err = result.RowsAffected()
+	s.NoError(err)
+	s.Equal(1, int(rowsAffected))
+
+	rows, err := s.store.SelectFromHistoryScheduledTasks(newExecutionContext(), filter)
+	s.NoError(err)
+	for index := range rows {
+		rows[index].ShardID = shardID
+		rows[index].CategoryID = categoryID
+	}
+	s.Equal([]sqlplugin.HistoryScheduledTasksRow(nil), rows)
+}
+
+func (s *historyHistoryScheduledTaskSuite) TestInsertDeleteSelect_Multiple() {
+	numTasks := 20
+	pageSize := numTasks
+
+	shardID := rand.Int31()
+	categoryID := rand.Int31()
+	timestamp := s.now()
+	minTimestamp := timestamp
+	taskID := int64(1)
+	maxTimestamp := timestamp.Add(time.Duration(numTasks) * time.Millisecond)
+
+	var tasks []sqlplugin.HistoryScheduledTasksRow
+	for i := 0; i < numTasks; i++ {
+		task := s.newRandomScheduledTaskRow(shardID, categoryID, timestamp, taskID)
+		timestamp = timestamp.Add(time.Millisecond)
+		taskID++
+		tasks = append(tasks, task)
+	}
+	result, err := s.store.InsertIntoHistoryScheduledTasks(newExecutionContext(), tasks)
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(numTasks, int(rowsAffected))
+
+	filter := sqlplugin.HistoryScheduledTasksRangeFilter{
+		ShardID:                         shardID,
+		CategoryID:                      categoryID,
+		InclusiveMinVisibilityTimestamp: minTimestamp,
+		ExclusiveMaxVisibilityTimestamp: maxTimestamp,
+		PageSize:                        0,
+	}
+	result, err = s.store.RangeDeleteFromHistoryScheduledTasks(newExecutionContext(), filter)
+	s.NoError(err)
+	rowsAffected, err = result.RowsAffected()
+	s.NoError(err)
+	s.Equal(numTasks, int(rowsAffected))
+
+	filter.PageSize = pageSize
+	rows, err := s.store.RangeSelectFromHistoryScheduledTasks(newExecutionContext(), filter)
+	s.NoError(err)
+	for index := range rows {
+		rows[index].ShardID = shardID
+		rows[index].CategoryID = categoryID
+	}
+	s.Equal([]sqlplugin.HistoryScheduledTasksRow(nil), rows)
+}
+
+func (s *historyHistoryScheduledTaskSuite) now() time.Time {
+	return time.Now().UTC().Truncate(time.Millisecond)
+}
+
+func (s *historyHistoryScheduledTaskSuite) newRandomScheduledTaskRow(
+	shardID int32,
+	categoryID int32,
+	timestamp time.Time,
+	taskID int64,
+) sqlplugin.HistoryScheduledTasksRow {
+	return sqlplugin.HistoryScheduledTasksRow{
+		ShardID:             shardID,
+		CategoryID:          categoryID,
+		VisibilityTimestamp: timestamp,
+		TaskID:              taskID,
+		Data:                shuffle.Bytes(testHistoryScheduledTaskData),
+		DataEncoding:        testHistoryScheduledTaskEncoding,
+	}
+}
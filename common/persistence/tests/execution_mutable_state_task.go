@@ -26,6 +26,7 @@ package tests
 
 import (
 	"context"
+	"encoding/json"
 	"math"
 	"math/rand"
 	"testing"
@@ -34,6 +35,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/definition"
@@ -60,6 +63,29 @@ type (
 		Ctx    context.Context
 		Cancel context.CancelFunc
 	}
+
+	testCategoryTaskSerializer struct {
+		category tasks.Category
+	}
+
+	testCategoryTaskPayload struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		Version             int64
+	}
+)
+
+var (
+	testImmediateCategory = tasks.NewCategory(
+		1001,
+		tasks.CategoryTypeImmediate,
+		"test-immediate-category",
+	)
+	testScheduledCategory = tasks.NewCategory(
+		1002,
+		tasks.CategoryTypeScheduled,
+		"test-scheduled-category",
+	)
 )
 
 func NewExecutionMutableStateTaskSuite(
@@ -69,6 +95,9 @@ func NewExecutionMutableStateTaskSuite(
 	serializer serialization.Serializer,
 	logger log.Logger,
 ) *ExecutionMutableStateTaskSuite {
+	serialization.RegisterCategoryTaskSerializer(testImmediateCategory, &testCategoryTaskSerializer{category: testImmediateCategory})
+	serialization.RegisterCategoryTaskSerializer(testScheduledCategory, &testCategoryTaskSerializer{category: testScheduledCategory})
+
 	return &ExecutionMutableStateTaskSuite{
 		Assertions: require.New(t),
 		ShardManager: p.NewShardManager(
@@ -116,7 +145,7 @@ func (s *ExecutionMutableStateTaskSuite) SetupTest() {
 }
 
 func (s *ExecutionMutableStateTaskSuite) TearDownTest() {
-	for _, category := range []tasks.Category{tasks.CategoryTransfer, tasks.CategoryReplication, tasks.CategoryVisibility} {
+	for _, category := range []tasks.Category{tasks.CategoryTransfer, tasks.CategoryReplication, tasks.CategoryVisibility, testImmediateCategory} {
 		err := s.ExecutionManager.RangeCompleteHistoryTasks(s.Ctx, &p.RangeCompleteHistoryTasksRequest{
 			ShardID:             s.ShardID,
 			TaskCategory:        category,
@@ -125,13 +154,15 @@ func (s *ExecutionMutableStateTaskSuite) TearDownTest() {
 		})
 		s.NoError(err)
 	}
-	err := s.ExecutionManager.RangeCompleteHistoryTasks(s.Ctx, &p.RangeCompleteHistoryTasksRequest{
-		ShardID:             s.ShardID,
-		TaskCategory:        tasks.CategoryTimer,
-		InclusiveMinTaskKey: tasks.Key{FireTime: time.Unix(0, 0)},
-		ExclusiveMaxTaskKey: tasks.Key{FireTime: time.Unix(0, math.MaxInt64)},
-	})
-	s.NoError(err)
+	for _, category := range []tasks.Category{tasks.CategoryTimer, testScheduledCategory} {
+		err := s.ExecutionManager.RangeCompleteHistoryTasks(s.Ctx, &p.RangeCompleteHistoryTasksRequest{
+			ShardID:             s.ShardID,
+			TaskCategory:        category,
+			InclusiveMinTaskKey: tasks.Key{FireTime: time.Unix(0, 0)},
+			ExclusiveMaxTaskKey: tasks.Key{FireTime: time.Unix(0, math.MaxInt64)},
+		})
+		s.NoError(err)
+	}
 
 	s.Cancel()
 }
@@ -232,6 +263,50 @@ func (s *ExecutionMutableStateTaskSuite) TestAddGetVisibilityTasks_Multiple() {
 	s.Equal(visibilityTasks, loadedTasks)
 }
 
+func (s *ExecutionMutableStateTaskSuite) TestAddGetImmediateTasks_Multiple() {
+	numTasks := 20
+	immediateTasks := s.AddRandomTasks(
+		testImmediateCategory,
+		numTasks,
+		func(workflowKey definition.WorkflowKey, taskID int64, visibilityTimestamp time.Time) tasks.Task {
+			task := tasks.NewFakeTask(testImmediateCategory, visibilityTimestamp)
+			task.SetTaskID(taskID)
+			return task
+		},
+	)
+
+	immediateTasks, inclusiveMinTaskKey, exclusiveMaxTaskKey := s.RandomPaginateRange(immediateTasks)
+	loadedTasks := s.PaginateTasks(
+		testImmediateCategory,
+		inclusiveMinTaskKey,
+		exclusiveMaxTaskKey,
+		rand.Intn(len(immediateTasks)*2)+1,
+	)
+	s.Equal(immediateTasks, loadedTasks)
+}
+
+func (s *ExecutionMutableStateTaskSuite) TestAddGetScheduledTasks_Multiple() {
+	numTasks := 20
+	scheduledTasks := s.AddRandomTasks(
+		testScheduledCategory,
+		numTasks,
+		func(workflowKey definition.WorkflowKey, taskID int64, visibilityTimestamp time.Time) tasks.Task {
+			task := tasks.NewFakeTask(testScheduledCategory, visibilityTimestamp)
+			task.SetTaskID(taskID)
+			return task
+		},
+	)
+
+	scheduledTasks, inclusiveMinTaskKey, exclusiveMaxTaskKey := s.RandomPaginateRange(scheduledTasks)
+	loadedTasks := s.PaginateTasks(
+		testScheduledCategory,
+		inclusiveMinTaskKey,
+		exclusiveMaxTaskKey,
+		rand.Intn(len(scheduledTasks)*2)+1,
+	)
+	s.Equal(scheduledTasks, loadedTasks)
+}
+
 func (s *ExecutionMutableStateTaskSuite) AddRandomTasks(
 	category tasks.Category,
 	numTasks int,
@@ -318,3 +393,30 @@ func (s *ExecutionMutableStateTaskSuite) RandomPaginateRange(
 
 	return createdTasks[firstTaskIdx:nextTaskIdx], inclusiveMinTaskKey, exclusiveMaxTaskKey
 }
+
+func (t *testCategoryTaskSerializer) SerializeTask(
+	task tasks.Task,
+) (commonpb.DataBlob, error) {
+	data, err := json.Marshal(testCategoryTaskPayload{
+		VisibilityTimestamp: task.GetVisibilityTime(),
+		TaskID:              task.GetTaskID(),
+		Version:             task.GetVersion(),
+	})
+	if err != nil {
+		return commonpb.DataBlob{}, err
+	}
+	return commonpb.DataBlob{Data: data, EncodingType: enumspb.ENCODING_TYPE_JSON}, nil
+}
+
+func (t *testCategoryTaskSerializer) DeserializeTask(
+	blob commonpb.DataBlob,
+) (tasks.Task, error) {
+	var payload testCategoryTaskPayload
+	if err := json.Unmarshal(blob.Data, &payload); err != nil {
+		return nil, err
+	}
+	task := tasks.NewFakeTask(t.category, payload.VisibilityTimestamp)
+	task.SetTaskID(payload.TaskID)
+	task.SetVersion(payload.Version)
+	return task, nil
+}
@@ -0,0 +1,134 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quotas
+
+import (
+	"sync"
+	"time"
+)
+
+type (
+	// HealthCheck compares the health signals of a backend dependency against thresholds,
+	// a threshold of 0 disables the corresponding check
+	HealthCheck struct {
+		Signals             HealthSignalAggregator
+		LatencyThreshold    func() time.Duration
+		ErrorRatioThreshold func() float64
+	}
+
+	// AdaptiveLoadShedder decides which priorities of requests are shed depending on the health
+	// of backend dependencies. Priority value 0 means highest priority, and is never shed.
+	AdaptiveLoadShedder interface {
+		// Allow returns whether a request of the given priority is admitted
+		Allow(now time.Time, priority int) bool
+		// Level returns the current shedding level, level N sheds the N lowest priorities
+		Level() int
+	}
+
+	adaptiveLoadShedderImpl struct {
+		healthChecks       []HealthCheck
+		maxPriority        int
+		minRequestCount    int64
+		evaluationInterval time.Duration
+
+		sync.Mutex
+		level          int
+		lastEvaluation time.Time
+	}
+)
+
+var _ AdaptiveLoadShedder = (*adaptiveLoadShedderImpl)(nil)
+
+// NewAdaptiveLoadShedder returns a load shedder which raises the shedding level by one
+// every evaluation interval while any health check fails, and lowers it by one every
+// evaluation interval once all health checks pass. Health checks with fewer than
+// minRequestCount recorded calls are considered healthy.
+func NewAdaptiveLoadShedder(
+	healthChecks []HealthCheck,
+	maxPriority int,
+	minRequestCount int64,
+	evaluationInterval time.Duration,
+) AdaptiveLoadShedder {
+	return &adaptiveLoadShedderImpl{
+		healthChecks:       healthChecks,
+		maxPriority:        maxPriority,
+		minRequestCount:    minRequestCount,
+		evaluationInterval: evaluationInterval,
+	}
+}
+
+func (s *adaptiveLoadShedderImpl) Allow(
+	now time.Time,
+	priority int,
+) bool {
+	level := s.evaluate(now)
+	if priority <= 0 {
+		return true
+	}
+	return priority <= s.maxPriority-level
+}
+
+func (s *adaptiveLoadShedderImpl) Level() int {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.level
+}
+
+func (s *adaptiveLoadShedderImpl) evaluate(
+	now time.Time,
+) int {
+	s.Lock()
+	defer s.Unlock()
+
+	if now.Sub(s.lastEvaluation) < s.evaluationInterval {
+		return s.level
+	}
+	s.lastEvaluation = now
+
+	if s.healthy() {
+		if s.level > 0 {
+			s.level--
+		}
+	} else if s.level < s.maxPriority {
+		s.level++
+	}
+	return s.level
+}
+
+func (s *adaptiveLoadShedderImpl) healthy() bool {
+	for _, check := range s.healthChecks {
+		if check.Signals.RequestCount() < s.minRequestCount {
+			continue
+		}
+		if threshold := check.LatencyThreshold(); threshold > 0 && check.Signals.AverageLatency() > threshold {
+			return false
+		}
+		if threshold := check.ErrorRatioThreshold(); threshold > 0 && check.Signals.ErrorRatio() > threshold {
+			return false
+		}
+	}
+	return true
+}
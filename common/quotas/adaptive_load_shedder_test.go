@@ -0,0 +1,170 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quotas
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/clock"
+)
+
+type (
+	adaptiveLoadShedderSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		timeSource *clock.EventTimeSource
+		signals    HealthSignalAggregator
+		shedder    AdaptiveLoadShedder
+	}
+)
+
+const (
+	testEvaluationInterval = time.Second
+	testMaxPriority        = 3
+)
+
+func TestAdaptiveLoadShedderSuite(t *testing.T) {
+	s := new(adaptiveLoadShedderSuite)
+	suite.Run(t, s)
+}
+
+func (s *adaptiveLoadShedderSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.timeSource = clock.NewEventTimeSource().Update(time.Unix(1000, 0))
+	s.signals = NewHealthSignalAggregator(10*time.Second, s.timeSource)
+	s.shedder = NewAdaptiveLoadShedder(
+		[]HealthCheck{{
+			Signals:             s.signals,
+			LatencyThreshold:    func() time.Duration { return 100 * time.Millisecond },
+			ErrorRatioThreshold: func() float64 { return 0.5 },
+		}},
+		testMaxPriority,
+		10,
+		testEvaluationInterval,
+	)
+}
+
+func (s *adaptiveLoadShedderSuite) TestHealthSignalAggregator() {
+	for i := 0; i < 4; i++ {
+		s.signals.Record(10*time.Millisecond, nil)
+	}
+	s.signals.Record(60*time.Millisecond, errors.New("some random error"))
+
+	s.Equal(int64(5), s.signals.RequestCount())
+	s.Equal(20*time.Millisecond, s.signals.AverageLatency())
+	s.Equal(0.2, s.signals.ErrorRatio())
+
+	s.timeSource.Update(s.timeSource.Now().Add(5 * time.Second))
+	s.signals.Record(40*time.Millisecond, nil)
+	s.Equal(int64(6), s.signals.RequestCount())
+
+	s.timeSource.Update(s.timeSource.Now().Add(6 * time.Second))
+	s.Equal(int64(1), s.signals.RequestCount())
+	s.Equal(40*time.Millisecond, s.signals.AverageLatency())
+	s.Equal(float64(0), s.signals.ErrorRatio())
+
+	s.timeSource.Update(s.timeSource.Now().Add(10 * time.Second))
+	s.Equal(int64(0), s.signals.RequestCount())
+	s.Equal(time.Duration(0), s.signals.AverageLatency())
+}
+
+func (s *adaptiveLoadShedderSuite) TestAllow_Healthy() {
+	for i := 0; i < 20; i++ {
+		s.signals.Record(10*time.Millisecond, nil)
+	}
+	for priority := 0; priority <= testMaxPriority; priority++ {
+		s.True(s.shedder.Allow(s.advance(), priority))
+	}
+	s.Equal(0, s.shedder.Level())
+}
+
+func (s *adaptiveLoadShedderSuite) TestAllow_NotEnoughRequests() {
+	for i := 0; i < 5; i++ {
+		s.signals.Record(time.Second, errors.New("some random error"))
+	}
+	s.True(s.shedder.Allow(s.advance(), testMaxPriority))
+	s.Equal(0, s.shedder.Level())
+}
+
+func (s *adaptiveLoadShedderSuite) TestAllow_ShedLowPriorityFirst() {
+	for i := 0; i < 20; i++ {
+		s.signals.Record(time.Second, nil)
+	}
+
+	s.False(s.shedder.Allow(s.advance(), 3))
+	s.Equal(1, s.shedder.Level())
+	s.True(s.shedder.Allow(s.timeSource.Now(), 2))
+
+	// level does not change within the evaluation interval
+	s.False(s.shedder.Allow(s.timeSource.Now().Add(testEvaluationInterval/2), 3))
+	s.Equal(1, s.shedder.Level())
+
+	s.False(s.shedder.Allow(s.advance(), 2))
+	s.Equal(2, s.shedder.Level())
+	s.True(s.shedder.Allow(s.timeSource.Now(), 1))
+
+	s.False(s.shedder.Allow(s.advance(), 1))
+	s.Equal(3, s.shedder.Level())
+
+	// highest priority is never shed
+	s.True(s.shedder.Allow(s.advance(), 0))
+	s.Equal(3, s.shedder.Level())
+}
+
+func (s *adaptiveLoadShedderSuite) TestAllow_ErrorRatio() {
+	for i := 0; i < 20; i++ {
+		s.signals.Record(time.Millisecond, errors.New("some random error"))
+	}
+	s.False(s.shedder.Allow(s.advance(), 3))
+	s.Equal(1, s.shedder.Level())
+}
+
+func (s *adaptiveLoadShedderSuite) TestAllow_Recover() {
+	for i := 0; i < 20; i++ {
+		s.signals.Record(time.Second, nil)
+	}
+	s.shedder.Allow(s.advance(), 0)
+	s.shedder.Allow(s.advance(), 0)
+	s.Equal(2, s.shedder.Level())
+
+	// signals expire from the window
+	s.timeSource.Update(s.timeSource.Now().Add(time.Minute))
+	s.True(s.shedder.Allow(s.advance(), 2))
+	s.Equal(1, s.shedder.Level())
+	s.True(s.shedder.Allow(s.advance(), 3))
+	s.Equal(0, s.shedder.Level())
+}
+
+func (s *adaptiveLoadShedderSuite) advance() time.Time {
+	s.timeSource.Update(s.timeSource.Now().Add(testEvaluationInterval))
+	return s.timeSource.Now()
+}
@@ -0,0 +1,168 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quotas
+
+import (
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/clock"
+)
+
+const (
+	healthSignalBucketCount = 10
+)
+
+type (
+	// HealthSignalAggregator collects latency and error signals of a backend dependency
+	// over a sliding window
+	HealthSignalAggregator interface {
+		// Record records a call which took latency, a non nil err marks the call as failed
+		Record(latency time.Duration, err error)
+		// AverageLatency returns the average latency of calls within the window
+		AverageLatency() time.Duration
+		// ErrorRatio returns the ratio of failed calls within the window
+		ErrorRatio() float64
+		// RequestCount returns the number of calls within the window
+		RequestCount() int64
+	}
+
+	healthSignalBucket struct {
+		start        time.Time
+		count        int64
+		errors       int64
+		totalLatency time.Duration
+	}
+
+	healthSignalAggregatorImpl struct {
+		timeSource     clock.TimeSource
+		bucketDuration time.Duration
+
+		sync.Mutex
+		buckets []healthSignalBucket
+	}
+
+	noopHealthSignalAggregator struct{}
+)
+
+var _ HealthSignalAggregator = (*healthSignalAggregatorImpl)(nil)
+var _ HealthSignalAggregator = (*noopHealthSignalAggregator)(nil)
+
+// NoopHealthSignalAggregator ignores all signals and always reports a healthy backend
+var NoopHealthSignalAggregator HealthSignalAggregator = &noopHealthSignalAggregator{}
+
+// NewHealthSignalAggregator returns a health signal aggregator over a sliding window
+func NewHealthSignalAggregator(
+	window time.Duration,
+	timeSource clock.TimeSource,
+) HealthSignalAggregator {
+	return &healthSignalAggregatorImpl{
+		timeSource:     timeSource,
+		bucketDuration: window / healthSignalBucketCount,
+		buckets:        make([]healthSignalBucket, healthSignalBucketCount),
+	}
+}
+
+func (a *healthSignalAggregatorImpl) Record(
+	latency time.Duration,
+	err error,
+) {
+	now := a.timeSource.Now()
+
+	a.Lock()
+	defer a.Unlock()
+
+	bucket := a.bucketLocked(now)
+	bucket.count++
+	bucket.totalLatency += latency
+	if err != nil {
+		bucket.errors++
+	}
+}
+
+func (a *healthSignalAggregatorImpl) AverageLatency() time.Duration {
+	count, _, totalLatency := a.sum()
+	if count == 0 {
+		return 0
+	}
+	return totalLatency / time.Duration(count)
+}
+
+func (a *healthSignalAggregatorImpl) ErrorRatio() float64 {
+	count, errors, _ := a.sum()
+	if count == 0 {
+		return 0
+	}
+	return float64(errors) / float64(count)
+}
+
+func (a *healthSignalAggregatorImpl) RequestCount() int64 {
+	count, _, _ := a.sum()
+	return count
+}
+
+func (a *healthSignalAggregatorImpl) bucketLocked(
+	now time.Time,
+) *healthSignalBucket {
+	start := now.Truncate(a.bucketDuration)
+	bucket := &a.buckets[int(start.UnixNano()/int64(a.bucketDuration))%len(a.buckets)]
+	if !bucket.start.Equal(start) {
+		*bucket = healthSignalBucket{start: start}
+	}
+	return bucket
+}
+
+func (a *healthSignalAggregatorImpl) sum() (int64, int64, time.Duration) {
+	windowStart := a.timeSource.Now().Add(-a.bucketDuration * healthSignalBucketCount)
+
+	a.Lock()
+	defer a.Unlock()
+
+	var count, errors int64
+	var totalLatency time.Duration
+	for _, bucket := range a.buckets {
+		if !bucket.start.After(windowStart) {
+			continue
+		}
+		count += bucket.count
+		errors += bucket.errors
+		totalLatency += bucket.totalLatency
+	}
+	return count, errors, totalLatency
+}
+
+func (a *noopHealthSignalAggregator) Record(_ time.Duration, _ error) {}
+
+func (a *noopHealthSignalAggregator) AverageLatency() time.Duration {
+	return 0
+}
+
+func (a *noopHealthSignalAggregator) ErrorRatio() float64 {
+	return 0
+}
+
+func (a *noopHealthSignalAggregator) RequestCount() int64 {
+	return 0
+}
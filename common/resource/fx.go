@@ -34,6 +34,7 @@ import (
 	"github.com/uber/tchannel-go"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.uber.org/fx"
+	"google.golang.org/grpc"
 
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
@@ -78,6 +79,15 @@ type (
 	MatchingRawClient matchingservice.MatchingServiceClient
 	MatchingClient    matchingservice.MatchingServiceClient
 
+	// InternodeClientInterceptors are added to gRPC connections to other hosts of the cluster
+	InternodeClientInterceptors []grpc.UnaryClientInterceptor
+
+	InternodeClientInterceptorsParams struct {
+		fx.In
+
+		Interceptors InternodeClientInterceptors `optional:"true"`
+	}
+
 	RuntimeMetricsReporterParams struct {
 		fx.In
 
@@ -302,11 +312,13 @@ func VisibilityBootstrapContainerProvider(
 	logger SnTaggedLogger,
 	metricsClient metrics.Client,
 	clusterMetadata cluster.Metadata,
+	namespaceRegistry namespace.Registry,
 ) *archiver.VisibilityBootstrapContainer {
 	return &archiver.VisibilityBootstrapContainer{
-		Logger:          logger,
-		MetricsClient:   metricsClient,
-		ClusterMetadata: clusterMetadata,
+		Logger:               logger,
+		MetricsClient:        metricsClient,
+		ClusterMetadata:      clusterMetadata,
+		DataResidencyChecker: namespace.NewDataResidencyChecker(namespaceRegistry),
 	}
 }
 
@@ -315,12 +327,14 @@ func HistoryBootstrapContainerProvider(
 	metricsClient metrics.Client,
 	clusterMetadata cluster.Metadata,
 	executionManager persistence.ExecutionManager,
+	namespaceRegistry namespace.Registry,
 ) *archiver.HistoryBootstrapContainer {
 	return &archiver.HistoryBootstrapContainer{
-		ExecutionManager: executionManager,
-		Logger:           logger,
-		MetricsClient:    metricsClient,
-		ClusterMetadata:  clusterMetadata,
+		ExecutionManager:     executionManager,
+		Logger:               logger,
+		MetricsClient:        metricsClient,
+		ClusterMetadata:      clusterMetadata,
+		DataResidencyChecker: namespace.NewDataResidencyChecker(namespaceRegistry),
 	}
 }
 
@@ -411,7 +425,16 @@ func RPCFactoryProvider(
 	tlsConfigProvider encryption.TLSConfigProvider,
 	dc *dynamicconfig.Collection,
 	clusterMetadata *cluster.Config,
+	internodeClientInterceptors InternodeClientInterceptorsParams,
 ) common.RPCFactory {
 	svcCfg := cfg.Services[string(svcName)]
-	return rpc.NewFactory(&svcCfg.RPC, string(svcName), logger, tlsConfigProvider, dc, clusterMetadata)
+	return rpc.NewFactory(
+		&svcCfg.RPC,
+		string(svcName),
+		logger,
+		tlsConfigProvider,
+		dc,
+		clusterMetadata,
+		internodeClientInterceptors.Interceptors...,
+	)
 }
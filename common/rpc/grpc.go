@@ -60,7 +60,8 @@ const (
 // The hostName syntax is defined in
 // https://github.com/grpc/grpc/blob/master/doc/naming.md.
 // e.g. to use dns resolver, a "dns:///" prefix should be applied to the target.
-func Dial(hostName string, tlsConfig *tls.Config, logger log.Logger) (*grpc.ClientConn, error) {
+// Additional interceptors are invoked before the default ones.
+func Dial(hostName string, tlsConfig *tls.Config, logger log.Logger, interceptors ...grpc.UnaryClientInterceptor) (*grpc.ClientConn, error) {
 	// Default to insecure
 	grpcSecureOpt := grpc.WithInsecure()
 	if tlsConfig != nil {
@@ -78,14 +79,17 @@ func Dial(hostName string, tlsConfig *tls.Config, logger log.Logger) (*grpc.Clie
 	}
 	cp.Backoff.MaxDelay = MaxBackoffDelay
 
+	unaryInterceptors := append(
+		append([]grpc.UnaryClientInterceptor(nil), interceptors...),
+		versionHeadersInterceptor,
+		metrics.NewClientMetricsTrailerPropagatorInterceptor(logger),
+		errorInterceptor,
+	)
+
 	dialOptions := []grpc.DialOption{
 		grpcSecureOpt,
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxInternodeRecvPayloadSize)),
-		grpc.WithChainUnaryInterceptor(
-			versionHeadersInterceptor,
-			metrics.NewClientMetricsTrailerPropagatorInterceptor(logger),
-			errorInterceptor,
-		),
+		grpc.WithChainUnaryInterceptor(unaryInterceptors...),
 		grpc.WithDefaultServiceConfig(DefaultServiceConfig),
 		grpc.WithDisableServiceConfig(),
 		grpc.WithConnectParams(cp),
@@ -0,0 +1,156 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	// RetryAfterHeaderName is the trailer which holds the number of seconds a client should wait
+	// before retrying a request rejected by load shedding
+	RetryAfterHeaderName = "retry-after"
+)
+
+type (
+	// LoadSheddingInterceptor rejects requests of low priority APIs while backend dependencies are
+	// unhealthy, APIs without a priority are never shed
+	LoadSheddingInterceptor struct {
+		enabled       dynamicconfig.BoolPropertyFn
+		retryAfter    dynamicconfig.DurationPropertyFn
+		shedder       quotas.AdaptiveLoadShedder
+		apiToPriority map[string]int
+		logger        log.Logger
+
+		level int32
+	}
+)
+
+var _ grpc.UnaryServerInterceptor = (*LoadSheddingInterceptor)(nil).Intercept
+
+func NewLoadSheddingInterceptor(
+	enabled dynamicconfig.BoolPropertyFn,
+	retryAfter dynamicconfig.DurationPropertyFn,
+	shedder quotas.AdaptiveLoadShedder,
+	apiToPriority map[string]int,
+	logger log.Logger,
+) *LoadSheddingInterceptor {
+	return &LoadSheddingInterceptor{
+		enabled:       enabled,
+		retryAfter:    retryAfter,
+		shedder:       shedder,
+		apiToPriority: apiToPriority,
+		logger:        logger,
+	}
+}
+
+func (i *LoadSheddingInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if !i.enabled() {
+		return handler(ctx, req)
+	}
+
+	_, methodName := splitMethodName(info.FullMethod)
+	allow := i.shedder.Allow(time.Now().UTC(), i.apiToPriority[methodName])
+	level := i.shedder.Level()
+	if previousLevel := atomic.SwapInt32(&i.level, int32(level)); previousLevel != int32(level) {
+		i.logger.Warn("Load shedding level changed.",
+			tag.NewInt("previous-level", int(previousLevel)),
+			tag.NewInt("level", level),
+		)
+	}
+	if allow {
+		return handler(ctx, req)
+	}
+
+	// back off longer the more priorities are shed
+	retryAfter := i.retryAfter() * time.Duration(level)
+	_ = grpc.SetTrailer(ctx, metadata.Pairs(
+		RetryAfterHeaderName,
+		strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))),
+	))
+	return nil, serviceerror.NewResourceExhausted(
+		enumspb.RESOURCE_EXHAUSTED_CAUSE_SYSTEM_OVERLOADED,
+		fmt.Sprintf("system overloaded, retry after %v", retryAfter),
+	)
+}
+
+// NewHealthSignalClientInterceptor returns a client interceptor which records the latency of calls
+// to methods with the given prefix, and whether they failed because the remote service is unhealthy.
+// Calls to excludedAPIs, e.g. long polls, are not recorded.
+func NewHealthSignalClientInterceptor(
+	methodPrefix string,
+	excludedAPIs map[string]struct{},
+	signals quotas.HealthSignalAggregator,
+) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if !strings.HasPrefix(method, methodPrefix) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		if _, ok := excludedAPIs[strings.TrimPrefix(method, methodPrefix)]; ok {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		startTime := time.Now().UTC()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		switch err.(type) {
+		case *serviceerror.Unavailable,
+			*serviceerror.Internal,
+			*serviceerror.DeadlineExceeded,
+			*serviceerror.ResourceExhausted:
+			signals.Record(time.Since(startTime), err)
+		default:
+			signals.Record(time.Since(startTime), nil)
+		}
+		return err
+	}
+}
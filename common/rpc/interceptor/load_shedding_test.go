@@ -0,0 +1,127 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/quotas"
+)
+
+type testLoadShedder struct {
+	level int
+}
+
+func (s *testLoadShedder) Allow(_ time.Time, priority int) bool {
+	return priority == 0 || priority <= 3-s.level
+}
+
+func (s *testLoadShedder) Level() int {
+	return s.level
+}
+
+func TestLoadSheddingInterceptor(t *testing.T) {
+	shedder := &testLoadShedder{}
+	enabled := true
+	interceptor := NewLoadSheddingInterceptor(
+		func(_ ...dynamicconfig.FilterOption) bool { return enabled },
+		dynamicconfig.GetDurationPropertyFn(time.Second),
+		shedder,
+		map[string]int{
+			"PollWorkflowTaskQueue":  2,
+			"ListWorkflowExecutions": 3,
+		},
+		log.NewNoopLogger(),
+	)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return true, nil
+	}
+	intercept := func(api string) error {
+		_, err := interceptor.Intercept(
+			context.Background(),
+			nil,
+			&grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/" + api},
+			handler,
+		)
+		return err
+	}
+
+	assert.NoError(t, intercept("ListWorkflowExecutions"))
+
+	shedder.level = 2
+	assert.NoError(t, intercept("StartWorkflowExecution"))
+	err := intercept("PollWorkflowTaskQueue")
+	var resourceExhausted *serviceerror.ResourceExhausted
+	assert.True(t, errors.As(err, &resourceExhausted))
+	assert.Equal(t, enumspb.RESOURCE_EXHAUSTED_CAUSE_SYSTEM_OVERLOADED, resourceExhausted.Cause)
+	assert.Contains(t, resourceExhausted.Message, "retry after 2s")
+	assert.Error(t, intercept("ListWorkflowExecutions"))
+
+	enabled = false
+	assert.NoError(t, intercept("ListWorkflowExecutions"))
+}
+
+func TestHealthSignalClientInterceptor(t *testing.T) {
+	signals := quotas.NewHealthSignalAggregator(time.Minute, clock.NewRealTimeSource())
+	interceptor := NewHealthSignalClientInterceptor(
+		"/temporal.server.api.historyservice.v1.HistoryService/",
+		map[string]struct{}{"PollMutableState": {}},
+		signals,
+	)
+
+	invoke := func(method string, err error) {
+		_ = interceptor(
+			context.Background(),
+			method,
+			nil,
+			nil,
+			nil,
+			func(_ context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+				return err
+			},
+		)
+	}
+
+	invoke("/temporal.server.api.historyservice.v1.HistoryService/StartWorkflowExecution", nil)
+	invoke("/temporal.server.api.historyservice.v1.HistoryService/StartWorkflowExecution", serviceerror.NewNotFound("workflow not found"))
+	invoke("/temporal.server.api.historyservice.v1.HistoryService/StartWorkflowExecution", serviceerror.NewUnavailable("history unavailable"))
+	invoke("/temporal.server.api.historyservice.v1.HistoryService/StartWorkflowExecution", serviceerror.NewInternal("internal error"))
+	invoke("/temporal.server.api.matchingservice.v1.MatchingService/PollWorkflowTaskQueue", serviceerror.NewUnavailable("matching unavailable"))
+	invoke("/temporal.server.api.historyservice.v1.HistoryService/PollMutableState", serviceerror.NewDeadlineExceeded("long poll timed out"))
+
+	assert.Equal(t, int64(4), signals.RequestCount())
+	assert.Equal(t, 0.5, signals.ErrorRatio())
+}
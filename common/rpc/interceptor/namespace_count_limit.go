@@ -26,11 +26,13 @@ package interceptor
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"sync/atomic"
 
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"google.golang.org/grpc"
 
 	"go.temporal.io/server/common/log"
@@ -39,7 +41,15 @@ import (
 )
 
 var (
-	ErrNamespaceCountLimitServerBusy = serviceerror.NewResourceExhausted(enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT, "namespace concurrent poller limit exceeded")
+	ErrNamespaceCountLimitServerBusy       = serviceerror.NewResourceExhausted(enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT, "namespace concurrent poller limit exceeded")
+	ErrTaskQueuePollerCountLimitServerBusy = serviceerror.NewResourceExhausted(enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT, "task queue concurrent poller limit exceeded")
+)
+
+var (
+	pollAPITaskQueueType = map[string]enumspb.TaskQueueType{
+		"PollWorkflowTaskQueue": enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		"PollActivityTaskQueue": enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	}
 )
 
 type (
@@ -47,11 +57,31 @@ type (
 		namespaceRegistry namespace.Registry
 		logger            log.Logger
 
-		countFn func(namespace string) int
-		tokens  map[string]int
+		countFn          func(namespace string) int
+		taskQueueCountFn func(namespace string, taskQueue string, taskType enumspb.TaskQueueType) int
+		tokens           map[string]int
 
 		sync.Mutex
 		namespaceToCount map[namespace.Name]*int32
+		taskQueueToCount map[taskQueueKey]int32
+	}
+
+	taskQueueKey struct {
+		namespace     namespace.Name
+		taskQueue     string
+		taskQueueType enumspb.TaskQueueType
+	}
+
+	// PollerCount is the number of concurrent pollers of a task queue, or of a namespace if TaskQueue is empty
+	PollerCount struct {
+		Namespace     namespace.Name
+		TaskQueue     string
+		TaskQueueType enumspb.TaskQueueType
+		Count         int32
+	}
+
+	taskQueueGetter interface {
+		GetTaskQueue() *taskqueuepb.TaskQueue
 	}
 )
 
@@ -61,15 +91,18 @@ func NewNamespaceCountLimitInterceptor(
 	namespaceRegistry namespace.Registry,
 	logger log.Logger,
 	countFn func(namespace string) int,
+	taskQueueCountFn func(namespace string, taskQueue string, taskType enumspb.TaskQueueType) int,
 	tokens map[string]int,
 ) *NamespaceCountLimitInterceptor {
 	return &NamespaceCountLimitInterceptor{
 		namespaceRegistry: namespaceRegistry,
 		logger:            logger,
 		countFn:           countFn,
+		taskQueueCountFn:  taskQueueCountFn,
 		tokens:            tokens,
 
 		namespaceToCount: make(map[namespace.Name]*int32),
+		taskQueueToCount: make(map[taskQueueKey]int32),
 	}
 }
 
@@ -94,11 +127,101 @@ func (ni *NamespaceCountLimitInterceptor) Intercept(
 		if int(count) > ni.countFn(namespace.String()) {
 			return nil, ErrNamespaceCountLimitServerBusy
 		}
+
+		if key, ok := ni.taskQueueKey(namespace, methodName, req); ok {
+			taskQueueScope := scope.Tagged(metrics.TaskQueueTag(key.taskQueue), metrics.TaskQueueTypeTag(key.taskQueueType))
+			count := ni.addTaskQueueCount(key, int32(token))
+			taskQueueScope.UpdateGauge(metrics.ServiceTaskQueuePollers, float64(count))
+			defer func() {
+				count := ni.addTaskQueueCount(key, -int32(token))
+				taskQueueScope.UpdateGauge(metrics.ServiceTaskQueuePollers, float64(count))
+			}()
+
+			limit := ni.taskQueueCountFn(namespace.String(), key.taskQueue, key.taskQueueType)
+			if limit > 0 && int(count) > limit {
+				return nil, ErrTaskQueuePollerCountLimitServerBusy
+			}
+		}
 	}
 
 	return handler(ctx, req)
 }
 
+// PollerCounts returns the current number of concurrent pollers per namespace and per task queue,
+// sticky task queues are only accounted for in the namespace counts
+func (ni *NamespaceCountLimitInterceptor) PollerCounts() []PollerCount {
+	ni.Lock()
+	defer ni.Unlock()
+
+	pollerCounts := make([]PollerCount, 0, len(ni.namespaceToCount)+len(ni.taskQueueToCount))
+	for namespaceName, count := range ni.namespaceToCount {
+		if count := atomic.LoadInt32(count); count > 0 {
+			pollerCounts = append(pollerCounts, PollerCount{
+				Namespace: namespaceName,
+				Count:     count,
+			})
+		}
+	}
+	for key, count := range ni.taskQueueToCount {
+		pollerCounts = append(pollerCounts, PollerCount{
+			Namespace:     key.namespace,
+			TaskQueue:     key.taskQueue,
+			TaskQueueType: key.taskQueueType,
+			Count:         count,
+		})
+	}
+	sort.Slice(pollerCounts, func(i, j int) bool {
+		if pollerCounts[i].Namespace != pollerCounts[j].Namespace {
+			return pollerCounts[i].Namespace < pollerCounts[j].Namespace
+		}
+		if pollerCounts[i].TaskQueue != pollerCounts[j].TaskQueue {
+			return pollerCounts[i].TaskQueue < pollerCounts[j].TaskQueue
+		}
+		return pollerCounts[i].TaskQueueType < pollerCounts[j].TaskQueueType
+	})
+	return pollerCounts
+}
+
+func (ni *NamespaceCountLimitInterceptor) taskQueueKey(
+	namespace namespace.Name,
+	methodName string,
+	req interface{},
+) (taskQueueKey, bool) {
+	taskQueueType, ok := pollAPITaskQueueType[methodName]
+	if !ok {
+		return taskQueueKey{}, false
+	}
+	getter, ok := req.(taskQueueGetter)
+	if !ok {
+		return taskQueueKey{}, false
+	}
+	taskQueue := getter.GetTaskQueue()
+	if taskQueue.GetName() == "" || taskQueue.GetKind() == enumspb.TASK_QUEUE_KIND_STICKY {
+		return taskQueueKey{}, false
+	}
+	return taskQueueKey{
+		namespace:     namespace,
+		taskQueue:     taskQueue.GetName(),
+		taskQueueType: taskQueueType,
+	}, true
+}
+
+func (ni *NamespaceCountLimitInterceptor) addTaskQueueCount(
+	key taskQueueKey,
+	delta int32,
+) int32 {
+	ni.Lock()
+	defer ni.Unlock()
+
+	count := ni.taskQueueToCount[key] + delta
+	if count <= 0 {
+		delete(ni.taskQueueToCount, key)
+		return 0
+	}
+	ni.taskQueueToCount[key] = count
+	return count
+}
+
 func (ni *NamespaceCountLimitInterceptor) counter(
 	namespace namespace.Name,
 ) *int32 {
@@ -0,0 +1,104 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+)
+
+func TestNamespaceCountLimitInterceptor_TaskQueuePollers(t *testing.T) {
+	interceptor := NewNamespaceCountLimitInterceptor(
+		nil,
+		log.NewNoopLogger(),
+		func(namespace string) int { return 3 },
+		func(namespace string, taskQueue string, taskType enumspb.TaskQueueType) int {
+			if taskQueue == "capped" {
+				return 1
+			}
+			return 0
+		},
+		map[string]int{
+			"PollWorkflowTaskQueue": 1,
+			"PollActivityTaskQueue": 1,
+		},
+	)
+	pollWorkflowTaskQueue := &grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/PollWorkflowTaskQueue"}
+	pollActivityTaskQueue := &grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/PollActivityTaskQueue"}
+	workflowPoll := func(taskQueue string, kind enumspb.TaskQueueKind) *workflowservice.PollWorkflowTaskQueueRequest {
+		return &workflowservice.PollWorkflowTaskQueueRequest{
+			Namespace: "test-namespace",
+			TaskQueue: &taskqueuepb.TaskQueue{Name: taskQueue, Kind: kind},
+		}
+	}
+
+	var errs []error
+	var pollerCounts []PollerCount
+	// every poll below is issued while the previous polls are still pending
+	intercept := func(info *grpc.UnaryServerInfo, req interface{}, next func()) {
+		_, err := interceptor.Intercept(context.Background(), req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			next()
+			return nil, nil
+		})
+		errs = append(errs, err)
+	}
+	intercept(pollWorkflowTaskQueue, workflowPoll("capped", enumspb.TASK_QUEUE_KIND_NORMAL), func() {
+		intercept(pollActivityTaskQueue, &workflowservice.PollActivityTaskQueueRequest{
+			Namespace: "test-namespace",
+			TaskQueue: &taskqueuepb.TaskQueue{Name: "capped"},
+		}, func() {
+			intercept(pollWorkflowTaskQueue, workflowPoll("sticky-queue", enumspb.TASK_QUEUE_KIND_STICKY), func() {
+				pollerCounts = interceptor.PollerCounts()
+				intercept(pollWorkflowTaskQueue, workflowPoll("capped", enumspb.TASK_QUEUE_KIND_NORMAL), func() {})
+			})
+		})
+	})
+
+	// innermost poll fails on the namespace limit before the task queue limit
+	assert.Equal(t, []error{ErrNamespaceCountLimitServerBusy, nil, nil, nil}, errs)
+	assert.Equal(t, []PollerCount{
+		{Namespace: namespace.Name("test-namespace"), Count: 3},
+		{Namespace: namespace.Name("test-namespace"), TaskQueue: "capped", TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW, Count: 1},
+		{Namespace: namespace.Name("test-namespace"), TaskQueue: "capped", TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY, Count: 1},
+	}, pollerCounts)
+	assert.Empty(t, interceptor.PollerCounts())
+
+	errs = nil
+	intercept(pollWorkflowTaskQueue, workflowPoll("capped", enumspb.TASK_QUEUE_KIND_NORMAL), func() {
+		intercept(pollWorkflowTaskQueue, workflowPoll("uncapped", enumspb.TASK_QUEUE_KIND_NORMAL), func() {
+			intercept(pollWorkflowTaskQueue, workflowPoll("capped", enumspb.TASK_QUEUE_KIND_NORMAL), func() {})
+		})
+	})
+	assert.Equal(t, []error{ErrTaskQueuePollerCountLimitServerBusy, nil, nil}, errs)
+}
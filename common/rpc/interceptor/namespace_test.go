@@ -58,6 +58,7 @@ var (
 
 	historyAPIExcluded = map[string]struct{}{
 		"CloseShard":                {},
+		"CompleteShardHandoff":      {},
 		"GetShard":                  {},
 		"GetDLQMessages":            {},
 		"GetDLQReplicationMessages": {},
@@ -0,0 +1,274 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+)
+
+const (
+	errRequestSizeExceedsLimitMessage = "Request size of %d bytes exceeds the limit of %d bytes, field %q is %d bytes."
+)
+
+var (
+	// errorLimitExemptAPIs are worker completion APIs. Workers retry a rejected completion forever, so these are
+	// only checked against the warn limit and left to the blob size limits of history, which fail the task instead.
+	errorLimitExemptAPIs = map[string]struct{}{
+		"RespondWorkflowTaskCompleted":     {},
+		"RespondWorkflowTaskFailed":        {},
+		"RespondQueryTaskCompleted":        {},
+		"RespondActivityTaskCompleted":     {},
+		"RespondActivityTaskCompletedById": {},
+		"RespondActivityTaskFailed":        {},
+		"RespondActivityTaskFailedById":    {},
+		"RespondActivityTaskCanceled":      {},
+		"RespondActivityTaskCanceledById":  {},
+	}
+)
+
+type (
+	// RequestSizeValidatorInterceptor checks the encoded size of every request against per namespace warn and error
+	// limits. Requests above the warn limit are logged, requests above the error limit are rejected with an
+	// InvalidArgument error naming the field which contributes the most to the request size. Worker completions are
+	// never rejected.
+	RequestSizeValidatorInterceptor struct {
+		namespaceRegistry namespace.Registry
+		logger            log.Logger
+		warnLimit         dynamicconfig.IntPropertyFnWithNamespaceFilter
+		errorLimit        dynamicconfig.IntPropertyFnWithNamespaceFilter
+	}
+
+	sizer interface {
+		Size() int
+	}
+
+	requestField struct {
+		name  string
+		value reflect.Value
+		size  int
+	}
+)
+
+var _ grpc.UnaryServerInterceptor = (*RequestSizeValidatorInterceptor)(nil).Intercept
+
+func NewRequestSizeValidatorInterceptor(
+	namespaceRegistry namespace.Registry,
+	logger log.Logger,
+	warnLimit dynamicconfig.IntPropertyFnWithNamespaceFilter,
+	errorLimit dynamicconfig.IntPropertyFnWithNamespaceFilter,
+) *RequestSizeValidatorInterceptor {
+	return &RequestSizeValidatorInterceptor{
+		namespaceRegistry: namespaceRegistry,
+		logger:            logger,
+		warnLimit:         warnLimit,
+		errorLimit:        errorLimit,
+	}
+}
+
+func (ri *RequestSizeValidatorInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	request, ok := req.(sizer)
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	size := request.Size()
+	scope := MetricsScope(ctx, ri.logger)
+	scope.RecordDistribution(metrics.ServiceRequestSize, size)
+
+	namespaceName := GetNamespace(ri.namespaceRegistry, req)
+	warnLimit := ri.warnLimit(namespaceName.String())
+	if size <= warnLimit {
+		return handler(ctx, req)
+	}
+
+	_, methodName := splitMethodName(info.FullMethod)
+	errorLimit := ri.errorLimit(namespaceName.String())
+	_, exempt := errorLimitExemptAPIs[methodName]
+	overErrorLimit := size > errorLimit && !exempt
+	limit := warnLimit
+	if overErrorLimit {
+		limit = errorLimit
+	}
+	fieldName, fieldSize := oversizedRequestField(req, limit)
+
+	if overErrorLimit {
+		scope.IncCounter(metrics.ServiceErrRequestSizeExceedsLimitCounter)
+		ri.logger.Warn("Request size exceeds the error limit.",
+			tag.WorkflowNamespace(namespaceName.String()),
+			tag.Operation(methodName),
+			tag.WorkflowSize(int64(size)),
+			tag.Key(fieldName),
+		)
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(errRequestSizeExceedsLimitMessage, size, errorLimit, fieldName, fieldSize))
+	}
+
+	scope.IncCounter(metrics.ServiceRequestSizeExceedsWarnLimitCounter)
+	ri.logger.Warn("Request size exceeds the warning limit.",
+		tag.WorkflowNamespace(namespaceName.String()),
+		tag.Operation(methodName),
+		tag.WorkflowSize(int64(size)),
+		tag.Key(fieldName),
+	)
+	return handler(ctx, req)
+}
+
+// oversizedRequestField returns the path and size of the largest field of the request. It keeps descending into
+// the largest nested field for as long as that field alone exceeds the limit, so the returned path points at the
+// most specific field responsible for the request being too large, e.g. "input.payloads[0].data".
+func oversizedRequestField(req interface{}, limit int) (string, int) {
+	var path []string
+	fieldSize := 0
+	fields := requestFields(reflect.ValueOf(req))
+	for len(fields) > 0 {
+		largest := fields[0]
+		for _, field := range fields[1:] {
+			if field.size > largest.size {
+				largest = field
+			}
+		}
+		if len(path) > 0 && largest.size <= limit {
+			break
+		}
+		path = append(path, largest.name)
+		fieldSize = largest.size
+		fields = requestFields(largest.value)
+	}
+	return strings.Replace(strings.Join(path, "."), ".[", "[", -1), fieldSize
+}
+
+// requestFields lists the fields of a message, the elements of a repeated field or the entries of a map field.
+func requestFields(v reflect.Value) []requestField {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	var fields []requestField
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			structField := v.Type().Field(i)
+			if _, ok := structField.Tag.Lookup("protobuf_oneof"); ok {
+				// Oneof fields are wrapped into a single field struct, whose field carries the protobuf tag.
+				fields = append(fields, requestFields(v.Field(i))...)
+				continue
+			}
+			name, ok := protobufFieldName(structField)
+			if !ok {
+				continue
+			}
+			fields = append(fields, requestField{name: name, value: v.Field(i), size: valueSize(v.Field(i))})
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return nil
+		}
+		for i := 0; i < v.Len(); i++ {
+			fields = append(fields, requestField{name: fmt.Sprintf("[%d]", i), value: v.Index(i), size: valueSize(v.Index(i))})
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			fields = append(fields, requestField{
+				name:  fmt.Sprintf("[%v]", iter.Key().Interface()),
+				value: iter.Value(),
+				size:  valueSize(iter.Key()) + valueSize(iter.Value()),
+			})
+		}
+	}
+	return fields
+}
+
+func protobufFieldName(structField reflect.StructField) (string, bool) {
+	protobufTag, ok := structField.Tag.Lookup("protobuf")
+	if !ok {
+		return "", false
+	}
+	for _, part := range strings.Split(protobufTag, ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name="), true
+		}
+	}
+	return structField.Name, true
+}
+
+// valueSize approximates the encoded size of a field value, scalar values other than strings and bytes are ignored.
+func valueSize(v reflect.Value) int {
+	if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil() {
+		return 0
+	}
+	if s, ok := v.Interface().(sizer); ok {
+		return s.Size()
+	}
+	if v.CanAddr() {
+		if s, ok := v.Addr().Interface().(sizer); ok {
+			return s.Size()
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.Len()
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return v.Len()
+		}
+		size := 0
+		for i := 0; i < v.Len(); i++ {
+			size += valueSize(v.Index(i))
+		}
+		return size
+	case reflect.Map:
+		size := 0
+		iter := v.MapRange()
+		for iter.Next() {
+			size += valueSize(iter.Key()) + valueSize(iter.Value())
+		}
+		return size
+	case reflect.Ptr, reflect.Interface:
+		return valueSize(v.Elem())
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,126 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	commandpb "go.temporal.io/api/command/v1"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/log"
+)
+
+func TestRequestSizeValidatorInterceptor(t *testing.T) {
+	interceptor := NewRequestSizeValidatorInterceptor(
+		nil,
+		log.NewNoopLogger(),
+		func(namespace string) int { return 100 },
+		func(namespace string) int {
+			if namespace == "large-namespace" {
+				return 10000
+			}
+			return 1000
+		},
+	)
+	info := &grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution"}
+	signal := func(namespace string, headerSize int, inputSize int) *workflowservice.SignalWorkflowExecutionRequest {
+		return &workflowservice.SignalWorkflowExecutionRequest{
+			Namespace:  namespace,
+			SignalName: "signal",
+			Header: &commonpb.Header{Fields: map[string]*commonpb.Payload{
+				"header": {Data: []byte(strings.Repeat("h", headerSize))},
+			}},
+			Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{
+				{Data: []byte("small")},
+				{Data: []byte(strings.Repeat("i", inputSize))},
+			}},
+		}
+	}
+	intercept := func(req interface{}) (bool, error) {
+		called := false
+		_, err := interceptor.Intercept(context.Background(), req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			called = true
+			return nil, nil
+		})
+		return called, err
+	}
+
+	// below the warn limit and between the warn and error limits
+	for _, inputSize := range []int{10, 500} {
+		called, err := intercept(signal("test-namespace", 10, inputSize))
+		assert.NoError(t, err)
+		assert.True(t, called)
+	}
+
+	called, err := intercept(signal("test-namespace", 10, 2000))
+	assert.False(t, called)
+	assert.IsType(t, &serviceerror.InvalidArgument{}, err)
+	assert.Contains(t, err.Error(), `field "input.payloads[1].data" is 2000 bytes`)
+
+	called, err = intercept(signal("test-namespace", 2000, 10))
+	assert.False(t, called)
+	assert.Contains(t, err.Error(), `field "header.fields[header].data" is 2000 bytes`)
+
+	// no single field exceeds the limit, the largest one is named
+	called, err = intercept(signal("test-namespace", 500, 700))
+	assert.False(t, called)
+	assert.Contains(t, err.Error(), `field "input" is`)
+
+	called, err = intercept(signal("large-namespace", 10, 2000))
+	assert.NoError(t, err)
+	assert.True(t, called)
+
+	// worker completions are left to history, rejecting them would make workers retry forever
+	largePayloads := &commonpb.Payloads{Payloads: []*commonpb.Payload{{Data: []byte(strings.Repeat("r", 2000))}}}
+	completions := map[string]interface{}{
+		"RespondWorkflowTaskCompleted": &workflowservice.RespondWorkflowTaskCompletedRequest{
+			Namespace: "test-namespace",
+			Commands: []*commandpb.Command{{
+				CommandType: enumspb.COMMAND_TYPE_COMPLETE_WORKFLOW_EXECUTION,
+				Attributes: &commandpb.Command_CompleteWorkflowExecutionCommandAttributes{
+					CompleteWorkflowExecutionCommandAttributes: &commandpb.CompleteWorkflowExecutionCommandAttributes{Result: largePayloads},
+				},
+			}},
+		},
+		"RespondQueryTaskCompleted": &workflowservice.RespondQueryTaskCompletedRequest{
+			Namespace:   "test-namespace",
+			QueryResult: largePayloads,
+		},
+	}
+	for method, req := range completions {
+		info = &grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/" + method}
+		called, err = intercept(req)
+		assert.NoError(t, err, method)
+		assert.True(t, called, method)
+	}
+}
@@ -50,6 +50,8 @@ type RPCFactory struct {
 	dc              *dynamicconfig.Collection
 	clusterMetadata *cluster.Config
 
+	internodeInterceptors []grpc.UnaryClientInterceptor
+
 	sync.Mutex
 	grpcListener   net.Listener
 	ringpopChannel *tchannel.Channel
@@ -57,7 +59,8 @@ type RPCFactory struct {
 }
 
 // NewFactory builds a new RPCFactory
-// conforming to the underlying configuration
+// conforming to the underlying configuration,
+// internodeInterceptors are added to connections to other hosts of the cluster
 func NewFactory(
 	cfg *config.RPC,
 	sName string,
@@ -65,14 +68,16 @@ func NewFactory(
 	tlsProvider encryption.TLSConfigProvider,
 	dc *dynamicconfig.Collection,
 	clusterMetadata *cluster.Config,
+	internodeInterceptors ...grpc.UnaryClientInterceptor,
 ) *RPCFactory {
 	return &RPCFactory{
-		config:          cfg,
-		serviceName:     sName,
-		logger:          logger,
-		dc:              dc,
-		tlsFactory:      tlsProvider,
-		clusterMetadata: clusterMetadata,
+		config:                cfg,
+		serviceName:           sName,
+		logger:                logger,
+		dc:                    dc,
+		tlsFactory:            tlsProvider,
+		clusterMetadata:       clusterMetadata,
+		internodeInterceptors: internodeInterceptors,
 	}
 }
 
@@ -291,11 +296,11 @@ func (d *RPCFactory) CreateInternodeGRPCConnection(hostName string) *grpc.Client
 		}
 	}
 
-	return d.dial(hostName, tlsClientConfig)
+	return d.dial(hostName, tlsClientConfig, d.internodeInterceptors...)
 }
 
-func (d *RPCFactory) dial(hostName string, tlsClientConfig *tls.Config) *grpc.ClientConn {
-	connection, err := Dial(hostName, tlsClientConfig, d.logger)
+func (d *RPCFactory) dial(hostName string, tlsClientConfig *tls.Config, interceptors ...grpc.UnaryClientInterceptor) *grpc.ClientConn {
+	connection, err := Dial(hostName, tlsClientConfig, d.logger, interceptors...)
 	if err != nil {
 		d.logger.Fatal("Failed to create gRPC connection", tag.Error(err))
 		return nil
@@ -27,6 +27,7 @@
 package sdk
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"sync"
@@ -35,6 +36,7 @@ import (
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 )
@@ -52,6 +54,9 @@ type (
 		systemSdkClient sdkclient.Client
 		once            *sync.Once
 	}
+
+	// headersProvider sends the internal headers set on the context of SDK calls
+	headersProvider struct{}
 )
 
 var _ ClientFactory = (*clientFactory)(nil)
@@ -71,10 +76,11 @@ func (f *clientFactory) NewClient(namespaceName string, logger log.Logger) (sdkc
 	// Retry for up to 1m, handles frontend service not ready
 	err := backoff.Retry(func() error {
 		sdkClient, err := sdkclient.NewClient(sdkclient.Options{
-			HostPort:       f.hostPort,
-			Namespace:      namespaceName,
-			MetricsHandler: f.metricsHandler,
-			Logger:         log.NewSdkLogger(logger),
+			HostPort:        f.hostPort,
+			Namespace:       namespaceName,
+			MetricsHandler:  f.metricsHandler,
+			Logger:          log.NewSdkLogger(logger),
+			HeadersProvider: headersProvider{},
 			ConnectionOptions: sdkclient.ConnectionOptions{
 				TLS: f.tlsConfig,
 			},
@@ -105,3 +111,10 @@ func (f *clientFactory) GetSystemClient(logger log.Logger) sdkclient.Client {
 	})
 	return f.systemSdkClient
 }
+
+func (headersProvider) GetHeaders(ctx context.Context) (map[string]string, error) {
+	if headers.IsAuditLogCall(ctx) {
+		return map[string]string{headers.AuditLogCallHeaderName: "true"}, nil
+	}
+	return nil, nil
+}
@@ -44,10 +44,12 @@ const (
 	ExecutionDuration    = "ExecutionDuration"
 	StateTransitionCount = "StateTransitionCount"
 
-	TemporalChangeVersion = "TemporalChangeVersion"
-	BinaryChecksums       = "BinaryChecksums"
-	BatcherNamespace      = "BatcherNamespace"
-	BatcherUser           = "BatcherUser"
+	TemporalChangeVersion      = "TemporalChangeVersion"
+	BinaryChecksums            = "BinaryChecksums"
+	BatcherNamespace           = "BatcherNamespace"
+	BatcherUser                = "BatcherUser"
+	TemporalWorkflowTaskPaused = "TemporalWorkflowTaskPaused"
+	TemporalConflictResolved   = "TemporalConflictResolved"
 
 	MemoEncoding      = "MemoEncoding"
 	Memo              = "Memo"
@@ -74,10 +76,12 @@ var (
 
 	// predefined are internal search attributes which are passed and stored in SearchAttributes object together with custom search attributes.
 	predefined = map[string]enumspb.IndexedValueType{
-		TemporalChangeVersion: enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-		BinaryChecksums:       enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-		BatcherNamespace:      enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-		BatcherUser:           enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TemporalChangeVersion:      enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		BinaryChecksums:            enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		BatcherNamespace:           enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		BatcherUser:                enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TemporalWorkflowTaskPaused: enumspb.INDEXED_VALUE_TYPE_BOOL,
+		TemporalConflictResolved:   enumspb.INDEXED_VALUE_TYPE_BOOL,
 	}
 
 	// reserved are internal field names that can't be used as search attribute names.
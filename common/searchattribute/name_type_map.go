@@ -26,6 +26,7 @@ package searchattribute
 
 import (
 	"fmt"
+	"sort"
 
 	enumspb "go.temporal.io/api/enums/v1"
 
@@ -117,3 +118,30 @@ func (m NameTypeMap) IsDefined(name string) bool {
 	}
 	return false
 }
+
+// DiffCustom compares custom search attributes of two clusters. It returns attributes defined only in current,
+// attributes defined only in remote, and sorted names of attributes defined in both with different types.
+func DiffCustom(
+	current map[string]enumspb.IndexedValueType,
+	remote map[string]enumspb.IndexedValueType,
+) (onlyInCurrent map[string]enumspb.IndexedValueType, onlyInRemote map[string]enumspb.IndexedValueType, conflicts []string) {
+	onlyInCurrent = make(map[string]enumspb.IndexedValueType)
+	onlyInRemote = make(map[string]enumspb.IndexedValueType)
+	for saName, saType := range current {
+		remoteType, ok := remote[saName]
+		if !ok {
+			onlyInCurrent[saName] = saType
+			continue
+		}
+		if remoteType != saType {
+			conflicts = append(conflicts, saName)
+		}
+	}
+	for saName, saType := range remote {
+		if _, ok := current[saName]; !ok {
+			onlyInRemote[saName] = saType
+		}
+	}
+	sort.Strings(conflicts)
+	return onlyInCurrent, onlyInRemote, conflicts
+}
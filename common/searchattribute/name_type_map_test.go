@@ -91,3 +91,27 @@ func Test_GetType(t *testing.T) {
 	assert.True(errors.Is(err, ErrInvalidName))
 	assert.Equal(enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED, ivt)
 }
+
+func Test_DiffCustom(t *testing.T) {
+	assert := assert.New(t)
+	current := map[string]enumspb.IndexedValueType{
+		"key1": enumspb.INDEXED_VALUE_TYPE_TEXT,
+		"key2": enumspb.INDEXED_VALUE_TYPE_INT,
+		"key3": enumspb.INDEXED_VALUE_TYPE_BOOL,
+	}
+	remote := map[string]enumspb.IndexedValueType{
+		"key1": enumspb.INDEXED_VALUE_TYPE_TEXT,
+		"key2": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		"key4": enumspb.INDEXED_VALUE_TYPE_DATETIME,
+	}
+
+	onlyInCurrent, onlyInRemote, conflicts := DiffCustom(current, remote)
+	assert.Equal(map[string]enumspb.IndexedValueType{"key3": enumspb.INDEXED_VALUE_TYPE_BOOL}, onlyInCurrent)
+	assert.Equal(map[string]enumspb.IndexedValueType{"key4": enumspb.INDEXED_VALUE_TYPE_DATETIME}, onlyInRemote)
+	assert.Equal([]string{"key2"}, conflicts)
+
+	onlyInCurrent, onlyInRemote, conflicts = DiffCustom(current, current)
+	assert.Empty(onlyInCurrent)
+	assert.Empty(onlyInRemote)
+	assert.Empty(conflicts)
+}
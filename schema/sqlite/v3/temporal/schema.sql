@@ -135,6 +135,27 @@ CREATE TABLE visibility_tasks(
 	PRIMARY KEY (shard_id, task_id)
 );
 
+CREATE TABLE history_immediate_tasks(
+	shard_id INT NOT NULL,
+	category_id INT NOT NULL,
+	task_id BIGINT NOT NULL,
+	--
+	data MEDIUMBLOB NOT NULL,
+	data_encoding VARCHAR(16) NOT NULL,
+	PRIMARY KEY (shard_id, category_id, task_id)
+);
+
+CREATE TABLE history_scheduled_tasks (
+	shard_id INT NOT NULL,
+	category_id INT NOT NULL,
+	visibility_timestamp TIMESTAMP NOT NULL,
+	task_id BIGINT NOT NULL,
+	--
+	data MEDIUMBLOB NOT NULL,
+	data_encoding VARCHAR(16) NOT NULL,
+	PRIMARY KEY (shard_id, category_id, visibility_timestamp, task_id)
+);
+
 CREATE TABLE activity_info_maps (
 -- each row corresponds to one key of one map<string, ActivityInfo>
 	shard_id INT NOT NULL,
@@ -28,7 +28,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -46,14 +49,17 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	namespacespb "go.temporal.io/server/api/namespace/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	serverClient "go.temporal.io/server/client"
 	"go.temporal.io/server/client/admin"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
@@ -77,12 +83,17 @@ import (
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker"
 	"go.temporal.io/server/service/worker/addsearchattributes"
+	"go.temporal.io/server/service/worker/migration"
 )
 
 const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
 	listClustersPageSize                    = 100
+	// must be shorter than history client timeout
+	maxReplicationStreamWait = 20 * time.Second
+	// number of shards processed concurrently by DLQ operations on all shards
+	dlqAllShardsConcurrency = 16
 )
 
 type (
@@ -97,6 +108,7 @@ type (
 		config                      *Config
 		namespaceHandler            namespace.Handler
 		namespaceDLQHandler         namespace.DLQMessageHandler
+		namespaceReplicator         namespace.Replicator
 		eventSerializer             serialization.Serializer
 		visibilityMgr               manager.VisibilityManager
 		persistenceExecutionManager persistence.ExecutionManager
@@ -115,6 +127,9 @@ type (
 		saManager                   searchattribute.Manager
 		clusterMetadata             cluster.Metadata
 		healthServer                *health.Server
+		namespaceQuotaCoordinator   *NamespaceQuotaCoordinator
+		pollerCounter               *interceptor.NamespaceCountLimitInterceptor
+		hostAdminClients            *hostAdminClients
 	}
 
 	NewAdminHandlerArgs struct {
@@ -144,6 +159,8 @@ type (
 		ArchivalMetadata                    archiver.ArchivalMetadata
 		HealthServer                        *health.Server
 		EventSerializer                     serialization.Serializer
+		NamespaceQuotaCoordinator           *NamespaceQuotaCoordinator
+		PollerCounter                       *interceptor.NamespaceCountLimitInterceptor
 	}
 )
 
@@ -163,6 +180,7 @@ func NewAdminHandler(
 		args.PersistenceMetadataManager,
 		args.Logger,
 	)
+	namespaceReplicator := namespace.NewNamespaceReplicator(args.ReplicatorNamespaceReplicationQueue, args.Logger)
 
 	return &AdminHandler{
 		logger:                args.Logger,
@@ -173,8 +191,9 @@ func NewAdminHandler(
 			args.Config.MaxBadBinaries,
 			args.Logger,
 			args.PersistenceMetadataManager,
+			args.NamespaceRegistry,
 			args.ClusterMetadata,
-			namespace.NewNamespaceReplicator(args.ReplicatorNamespaceReplicationQueue, args.Logger),
+			namespaceReplicator,
 			args.ArchivalMetadata,
 			args.ArchiverProvider,
 		),
@@ -183,6 +202,7 @@ func NewAdminHandler(
 			args.NamespaceReplicationQueue,
 			args.Logger,
 		),
+		namespaceReplicator:         namespaceReplicator,
 		eventSerializer:             args.EventSerializer,
 		visibilityMgr:               args.VisibilityMrg,
 		ESConfig:                    args.EsConfig,
@@ -203,6 +223,9 @@ func NewAdminHandler(
 		saManager:                   args.SaManager,
 		clusterMetadata:             args.ClusterMetadata,
 		healthServer:                args.HealthServer,
+		namespaceQuotaCoordinator:   args.NamespaceQuotaCoordinator,
+		pollerCounter:               args.PollerCounter,
+		hostAdminClients:            newHostAdminClients(args.ClientFactory),
 	}
 }
 
@@ -482,10 +505,12 @@ func (adh *AdminHandler) DescribeMutableState(ctx context.Context, request *admi
 		return nil, err
 	}
 	return &adminservice.DescribeMutableStateResponse{
-		ShardId:              shardIDStr,
-		HistoryAddr:          historyAddr,
-		DatabaseMutableState: historyResponse.GetDatabaseMutableState(),
-		CacheMutableState:    historyResponse.GetCacheMutableState(),
+		ShardId:                   shardIDStr,
+		HistoryAddr:               historyAddr,
+		DatabaseMutableState:      historyResponse.GetDatabaseMutableState(),
+		CacheMutableState:         historyResponse.GetCacheMutableState(),
+		SizeInfo:                  historyResponse.GetSizeInfo(),
+		ConflictResolutionRecords: historyResponse.GetDatabaseMutableState().GetExecutionInfo().GetConflictResolutionRecords(),
 	}, nil
 }
 
@@ -988,34 +1013,39 @@ func (adh *AdminHandler) AddOrUpdateRemoteCluster(
 		return nil, adh.error(err, scope)
 	}
 
-	var updateRequestVersion int64 = 0
-	clusterMetadataMrg := adh.clusterMetadataManager
-	clusterData, err := clusterMetadataMrg.GetClusterMetadata(
-		ctx,
-		&persistence.GetClusterMetadataRequest{ClusterName: resp.GetClusterName()},
-	)
-	switch err.(type) {
-	case nil:
-		updateRequestVersion = clusterData.Version
-	case *serviceerror.NotFound:
-		updateRequestVersion = 0
-	default:
+	var previousMetadata *persistencespb.ClusterMetadata
+	clusterData, err := adh.getClusterMetadataIfExists(ctx, resp.GetClusterName())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if clusterData != nil {
+		previousMetadata = &clusterData.ClusterMetadata
+	}
+	history, err := adh.listClusterMetadataHistory(ctx)
+	if err != nil {
 		return nil, adh.error(err, scope)
 	}
 
-	applied, err := clusterMetadataMrg.SaveClusterMetadata(ctx, &persistence.SaveClusterMetadataRequest{
-		ClusterMetadata: persistencespb.ClusterMetadata{
-			ClusterName:              resp.GetClusterName(),
-			HistoryShardCount:        resp.GetHistoryShardCount(),
-			ClusterId:                resp.GetClusterId(),
-			ClusterAddress:           request.GetFrontendAddress(),
-			FailoverVersionIncrement: resp.GetFailoverVersionIncrement(),
-			InitialFailoverVersion:   resp.GetInitialFailoverVersion(),
-			IsGlobalNamespaceEnabled: resp.GetIsGlobalNamespaceEnabled(),
-			IsConnectionEnabled:      request.GetEnableRemoteClusterConnection(),
-		},
-		Version: updateRequestVersion,
-	})
+	newMetadata := persistencespb.ClusterMetadata{
+		ClusterName:              resp.GetClusterName(),
+		HistoryShardCount:        resp.GetHistoryShardCount(),
+		ClusterId:                resp.GetClusterId(),
+		ClusterAddress:           request.GetFrontendAddress(),
+		FailoverVersionIncrement: resp.GetFailoverVersionIncrement(),
+		InitialFailoverVersion:   resp.GetInitialFailoverVersion(),
+		IsGlobalNamespaceEnabled: resp.GetIsGlobalNamespaceEnabled(),
+		IsConnectionEnabled:      request.GetEnableRemoteClusterConnection(),
+	}
+	change := newClusterMetadataChange(
+		ctx,
+		request.GetIdentity(),
+		clusterMetadataOperationAddOrUpdate,
+		resp.GetClusterName(),
+		previousMetadata,
+		&newMetadata,
+	)
+	change.Version = nextClusterMetadataChangeVersion(history, resp.GetClusterName())
+	applied, err := adh.saveClusterMetadataWithChange(ctx, clusterData, newMetadata, change)
 	if err != nil {
 		return nil, adh.error(err, scope)
 	}
@@ -1037,15 +1067,153 @@ func (adh *AdminHandler) RemoveRemoteCluster(
 	scope, sw := adh.startRequestProfile(metrics.AdminRemoveRemoteClusterScope)
 	defer sw.Stop()
 
+	clusterData, err := adh.getClusterMetadataIfExists(ctx, request.GetClusterName())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	history, err := adh.listClusterMetadataHistory(ctx)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
 	if err := adh.clusterMetadataManager.DeleteClusterMetadata(
 		ctx,
 		&persistence.DeleteClusterMetadataRequest{ClusterName: request.GetClusterName()},
 	); err != nil {
 		return nil, adh.error(err, scope)
 	}
+
+	if clusterData != nil {
+		change := newClusterMetadataChange(
+			ctx,
+			request.GetIdentity(),
+			clusterMetadataOperationRemove,
+			request.GetClusterName(),
+			&clusterData.ClusterMetadata,
+			nil,
+		)
+		change.Version = nextClusterMetadataChangeVersion(history, request.GetClusterName())
+		if err := adh.recordClusterMetadataRemoval(ctx, clusterData, change); err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
 	return &adminservice.RemoveRemoteClusterResponse{}, nil
 }
 
+// ListClusterMetadataHistory returns the audit log of cluster metadata changes, from the newest to the oldest.
+func (adh *AdminHandler) ListClusterMetadataHistory(
+	ctx context.Context,
+	request *adminservice.ListClusterMetadataHistoryRequest,
+) (_ *adminservice.ListClusterMetadataHistoryResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminListClusterMetadataHistoryScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	history, err := adh.listClusterMetadataHistory(ctx)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	changes := make([]*persistencespb.ClusterMetadataChange, 0, len(history))
+	for i := len(history) - 1; i >= 0; i-- {
+		if request.GetClusterName() != "" && history[i].GetClusterName() != request.GetClusterName() {
+			continue
+		}
+		changes = append(changes, history[i])
+	}
+	return &adminservice.ListClusterMetadataHistoryResponse{Changes: changes}, nil
+}
+
+// RollbackClusterMetadata restores metadata of the cluster to the state right after the change with the given version. The rollback itself is recorded as a new change.
+func (adh *AdminHandler) RollbackClusterMetadata(
+	ctx context.Context,
+	request *adminservice.RollbackClusterMetadataRequest,
+) (_ *adminservice.RollbackClusterMetadataResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminRollbackClusterMetadataScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetClusterName() == "" {
+		return nil, adh.error(errClusterNameNotSet, scope)
+	}
+	if request.GetVersion() <= 0 {
+		return nil, adh.error(errInvalidClusterMetadataVersion, scope)
+	}
+
+	history, err := adh.listClusterMetadataHistory(ctx)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	var target *persistencespb.ClusterMetadataChange
+	for _, change := range history {
+		if change.GetClusterName() == request.GetClusterName() && change.GetVersion() == request.GetVersion() {
+			target = change
+			break
+		}
+	}
+	if target == nil {
+		return nil, adh.error(serviceerror.NewNotFound(
+			fmt.Sprintf("Cluster metadata change version %v of cluster %v not found.", request.GetVersion(), request.GetClusterName())),
+			scope,
+		)
+	}
+
+	clusterData, err := adh.getClusterMetadataIfExists(ctx, target.GetClusterName())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	var previousMetadata *persistencespb.ClusterMetadata
+	if clusterData != nil {
+		previousMetadata = &clusterData.ClusterMetadata
+	}
+
+	change := newClusterMetadataChange(
+		ctx,
+		request.GetIdentity(),
+		clusterMetadataOperationRollback,
+		target.GetClusterName(),
+		previousMetadata,
+		target.GetNewMetadata(),
+	)
+	change.Version = nextClusterMetadataChangeVersion(history, target.GetClusterName())
+
+	if target.GetNewMetadata() != nil {
+		applied, err := adh.saveClusterMetadataWithChange(ctx, clusterData, *target.GetNewMetadata(), change)
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		if !applied {
+			return nil, adh.error(serviceerror.NewInvalidArgument(
+				"Cannot roll back cluster metadata due to update immutable fields"),
+				scope,
+			)
+		}
+		return &adminservice.RollbackClusterMetadataResponse{Change: change}, nil
+	}
+
+	if clusterData != nil {
+		if err := adh.clusterMetadataManager.DeleteClusterMetadata(
+			ctx,
+			&persistence.DeleteClusterMetadataRequest{ClusterName: target.GetClusterName()},
+		); err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+	if err := adh.recordClusterMetadataRemoval(ctx, clusterData, change); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.RollbackClusterMetadataResponse{Change: change}, nil
+}
+
 // GetReplicationMessages returns new replication tasks since the read level provided in the token.
 func (adh *AdminHandler) GetReplicationMessages(ctx context.Context, request *adminservice.GetReplicationMessagesRequest) (_ *adminservice.GetReplicationMessagesResponse, retError error) {
 	defer log.CapturePanic(adh.logger, &retError)
@@ -1070,6 +1238,58 @@ func (adh *AdminHandler) GetReplicationMessages(ctx context.Context, request *ad
 	return &adminservice.GetReplicationMessagesResponse{ShardMessages: resp.GetShardMessages()}, nil
 }
 
+// StreamReplicationMessages pushes replication tasks of one shard to the remote cluster. Each request from the
+// remote cluster acks processed tasks and is answered with the next batch of tasks, as soon as there is one.
+func (adh *AdminHandler) StreamReplicationMessages(server adminservice.AdminService_StreamReplicationMessagesServer) (retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminStreamReplicationMessagesScope)
+	defer sw.Stop()
+
+	shardID := int32(0)
+	for {
+		request, err := server.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if request.GetClusterName() == "" {
+			return adh.error(errClusterNameNotSet, scope)
+		}
+		if request.GetToken() == nil {
+			return adh.error(errReplicationTokenNotSet, scope)
+		}
+		if shardID == 0 {
+			shardID = request.GetToken().GetShardId()
+		}
+		if request.GetToken().GetShardId() != shardID {
+			return adh.error(errReplicationStreamShardMismatch, scope)
+		}
+
+		maxWait := timestamp.DurationValue(request.GetMaxWait())
+		if maxWait > maxReplicationStreamWait {
+			maxWait = maxReplicationStreamWait
+		}
+		resp, err := adh.historyClient.GetReplicationMessages(server.Context(), &historyservice.GetReplicationMessagesRequest{
+			Tokens:          []*replicationspb.ReplicationToken{request.GetToken()},
+			ClusterName:     request.GetClusterName(),
+			WaitForNewTasks: timestamp.DurationPtr(maxWait),
+		})
+		if err != nil {
+			return adh.error(err, scope)
+		}
+		if err := server.Send(&adminservice.StreamReplicationMessagesResponse{
+			ShardId:  shardID,
+			Messages: resp.GetShardMessages()[shardID],
+		}); err != nil {
+			return err
+		}
+	}
+}
+
 // GetNamespaceReplicationMessages returns new namespace replication tasks since last retrieved task ID.
 func (adh *AdminHandler) GetNamespaceReplicationMessages(ctx context.Context, request *adminservice.GetNamespaceReplicationMessagesRequest) (_ *adminservice.GetNamespaceReplicationMessagesResponse, retError error) {
 	defer log.CapturePanic(adh.logger, &retError)
@@ -1137,7 +1357,10 @@ func (adh *AdminHandler) GetDLQReplicationMessages(ctx context.Context, request
 		return nil, adh.error(errEmptyReplicationInfo, scope)
 	}
 
-	resp, err := adh.historyClient.GetDLQReplicationMessages(ctx, &historyservice.GetDLQReplicationMessagesRequest{TaskInfos: request.GetTaskInfos()})
+	resp, err := adh.historyClient.GetDLQReplicationMessages(ctx, &historyservice.GetDLQReplicationMessagesRequest{
+		TaskInfos:   request.GetTaskInfos(),
+		ClusterName: request.GetClusterName(),
+	})
 	if err != nil {
 		return nil, adh.error(err, scope)
 	}
@@ -1404,143 +1627,906 @@ func (adh *AdminHandler) RefreshWorkflowTasks(
 	return &adminservice.RefreshWorkflowTasksResponse{}, nil
 }
 
-// ResendReplicationTasks requests replication task from remote cluster
-func (adh *AdminHandler) ResendReplicationTasks(
-	_ context.Context,
-	request *adminservice.ResendReplicationTasksRequest,
-) (_ *adminservice.ResendReplicationTasksResponse, err error) {
+// UnpauseWorkflowExecution resumes workflow task scheduling of a workflow paused after repeated workflow task failures
+func (adh *AdminHandler) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+) (_ *adminservice.UnpauseWorkflowExecutionResponse, err error) {
 	defer log.CapturePanic(adh.logger, &err)
-	scope, sw := adh.startRequestProfile(metrics.AdminResendReplicationTasksScope)
+	scope, sw := adh.startRequestProfile(metrics.AdminUnpauseWorkflowExecutionScope)
 	defer sw.Stop()
 
 	if request == nil {
 		return nil, adh.error(errRequestNotSet, scope)
 	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	namespaceEntry, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
 
-	resender := xdc.NewNDCHistoryResender(
-		adh.namespaceRegistry,
-		adh.clientBean.GetRemoteAdminClient(request.GetRemoteCluster()),
-		func(ctx context.Context, request *historyservice.ReplicateEventsV2Request) error {
-			_, err1 := adh.historyClient.ReplicateEventsV2(ctx, request)
-			return err1
-		},
-		adh.eventSerializer,
-		nil,
-		adh.logger,
-	)
-	if err := resender.SendSingleWorkflowHistory(
-		namespace.ID(request.GetNamespaceId()),
-		request.GetWorkflowId(),
-		request.GetRunId(),
-		resendStartEventID,
-		request.StartVersion,
-		common.EmptyEventID,
-		common.EmptyVersion,
-	); err != nil {
-		return nil, err
+	_, err = adh.historyClient.UnpauseWorkflowExecution(ctx, &historyservice.UnpauseWorkflowExecutionRequest{
+		NamespaceId: namespaceEntry.ID().String(),
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
 	}
-	return &adminservice.ResendReplicationTasksResponse{}, nil
+	return &adminservice.UnpauseWorkflowExecutionResponse{}, nil
 }
 
-// GetTaskQueueTasks returns tasks from task queue
-func (adh *AdminHandler) GetTaskQueueTasks(
+// GetReplicationLag returns replication lag of all shards aggregated per remote cluster and namespace
+func (adh *AdminHandler) GetReplicationLag(
 	ctx context.Context,
-	request *adminservice.GetTaskQueueTasksRequest,
-) (_ *adminservice.GetTaskQueueTasksResponse, err error) {
+	request *adminservice.GetReplicationLagRequest,
+) (_ *adminservice.GetReplicationLagResponse, err error) {
 	defer log.CapturePanic(adh.logger, &err)
-	scope, sw := adh.startRequestProfile(metrics.AdminGetTaskQueueTasksScope)
+	scope, sw := adh.startRequestProfile(metrics.AdminGetReplicationLagScope)
 	defer sw.Stop()
 
 	if request == nil {
 		return nil, adh.error(errRequestNotSet, scope)
 	}
 
-	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
-	if err != nil {
-		return nil, adh.error(err, scope)
-	}
-
-	resp, err := adh.taskManager.GetTasks(ctx, &persistence.GetTasksRequest{
-		NamespaceID:        namespaceID.String(),
-		TaskQueue:          request.GetTaskQueue(),
-		TaskType:           request.GetTaskQueueType(),
-		InclusiveMinTaskID: request.GetMinTaskId(),
-		ExclusiveMaxTaskID: request.GetMaxTaskId(),
-		PageSize:           int(request.GetBatchSize()),
-		NextPageToken:      request.NextPageToken,
-	})
+	remoteClusters, err := getReplicationLag(
+		ctx,
+		adh.historyClient,
+		adh.namespaceRegistry,
+		request.GetRemoteClusters(),
+		int(request.GetSlowestShardCount()),
+	)
 	if err != nil {
 		return nil, adh.error(err, scope)
 	}
-
-	return &adminservice.GetTaskQueueTasksResponse{
-		Tasks:         resp.Tasks,
-		NextPageToken: resp.NextPageToken,
+	return &adminservice.GetReplicationLagResponse{
+		RemoteClusters: remoteClusters,
 	}, nil
 }
 
-func (adh *AdminHandler) validateGetWorkflowExecutionRawHistoryV2Request(
-	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
-) error {
+// UpdateNamespaceReplicationFilter sets the filter of workflow executions replicated to remote clusters
+func (adh *AdminHandler) UpdateNamespaceReplicationFilter(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceReplicationFilterRequest,
+) (_ *adminservice.UpdateNamespaceReplicationFilterResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminUpdateNamespaceReplicationFilterScope)
+	defer sw.Stop()
 
-	execution := request.Execution
-	if execution.GetWorkflowId() == "" {
-		return errWorkflowIDNotSet
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
 	}
-	// TODO currently, this API is only going to be used by re-send history events
-	// to remote cluster if kafka is lossy again, in the future, this API can be used
-	// by CLI and client, then empty runID (meaning the current workflow) should be allowed
-	if execution.GetRunId() == "" || uuid.Parse(execution.GetRunId()) == nil {
-		return errInvalidRunID
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
 	}
 
-	pageSize := int(request.GetMaximumPageSize())
-	if pageSize <= 0 {
-		return errInvalidPageSize
+	if err := adh.namespaceHandler.UpdateReplicationFilter(ctx, request.GetNamespace(), request.GetFilter()); err != nil {
+		return nil, adh.error(err, scope)
 	}
+	return &adminservice.UpdateNamespaceReplicationFilterResponse{}, nil
+}
 
-	if request.GetStartEventId() == common.EmptyEventID &&
-		request.GetStartEventVersion() == common.EmptyVersion &&
-		request.GetEndEventId() == common.EmptyEventID &&
-		request.GetEndEventVersion() == common.EmptyVersion {
-		return errInvalidEventQueryRange
+// UpdateNamespaceDataResidencyPolicy sets the clusters and the archival destinations data of a namespace may be stored in
+func (adh *AdminHandler) UpdateNamespaceDataResidencyPolicy(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceDataResidencyPolicyRequest,
+) (_ *adminservice.UpdateNamespaceDataResidencyPolicyResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminUpdateNamespaceDataResidencyPolicyScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
 	}
 
-	return nil
+	policy := &namespace.DataResidencyPolicy{
+		AllowedClusters:        request.GetAllowedClusters(),
+		AllowedArchivalSchemes: request.GetAllowedArchivalSchemes(),
+		AllowedArchivalRegions: request.GetAllowedArchivalRegions(),
+	}
+	if err := adh.namespaceHandler.UpdateDataResidencyPolicy(ctx, request.GetNamespace(), policy); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.UpdateNamespaceDataResidencyPolicyResponse{}, nil
 }
 
-func (adh *AdminHandler) validateRemoteClusterMetadata(metadata *adminservice.DescribeClusterResponse) error {
-	// Verify remote cluster config
-	currentClusterInfo := adh.clusterMetadata
-	if metadata.GetClusterName() == currentClusterInfo.GetCurrentClusterName() {
-		// cluster name conflict
-		return serviceerror.NewInvalidArgument("Cannot update current cluster metadata from rpc calls")
-	}
-	if metadata.GetFailoverVersionIncrement() != currentClusterInfo.GetFailoverVersionIncrement() {
-		// failover version increment is mismatch with current cluster config
-		return serviceerror.NewInvalidArgument("Cannot add remote cluster due to failover version increment mismatch")
+// RenameNamespace changes the name of a namespace, previous name keeps resolving to it during a grace period
+func (adh *AdminHandler) RenameNamespace(
+	ctx context.Context,
+	request *adminservice.RenameNamespaceRequest,
+) (_ *adminservice.RenameNamespaceResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminRenameNamespaceScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
 	}
-	if metadata.GetHistoryShardCount() != adh.config.NumHistoryShards {
-		// cluster shard number not equal
-		// TODO: remove this check once we support different shard numbers
-		return serviceerror.NewInvalidArgument("Cannot add remote cluster due to history shard number mismatch")
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
 	}
-	if !metadata.IsGlobalNamespaceEnabled {
-		// remote cluster doesn't support global namespace
-		return serviceerror.NewInvalidArgument("Cannot add remote cluster as global namespace is not supported")
+
+	aliasGracePeriod := adh.config.NamespaceRenameAliasGracePeriod()
+	if request.AliasGracePeriod != nil {
+		aliasGracePeriod = timestamp.DurationValue(request.AliasGracePeriod)
 	}
-	for clusterName, cluster := range currentClusterInfo.GetAllClusterInfo() {
-		if clusterName != metadata.ClusterName && cluster.InitialFailoverVersion == metadata.GetInitialFailoverVersion() {
-			// initial failover version conflict
-			// best effort: race condition if a concurrent write to db with the same version.
-			return serviceerror.NewInvalidArgument("Cannot add remote cluster due to initial failover version conflict")
-		}
+	if err := adh.namespaceHandler.RenameNamespace(
+		ctx,
+		request.GetNamespace(),
+		request.GetNewName(),
+		aliasGracePeriod,
+	); err != nil {
+		return nil, adh.error(err, scope)
 	}
-	return nil
+	// other hosts pick up the rename on their next registry refresh
+	adh.namespaceRegistry.Refresh()
+	return &adminservice.RenameNamespaceResponse{}, nil
 }
 
-func (adh *AdminHandler) setRequestDefaultValueAndGetTargetVersionHistory(
-	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
+// CreateApiKey creates an API key bound to a namespace and role, only the hash of its secret is stored
+func (adh *AdminHandler) CreateApiKey(
+	ctx context.Context,
+	request *adminservice.CreateApiKeyRequest,
+) (_ *adminservice.CreateApiKeyResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminCreateApiKeyScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetName() == "" {
+		return nil, adh.error(errAPIKeyNameNotSet, scope)
+	}
+	if err := authorization.ValidateAPIKeyRole(request.GetRole()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	id, err := authorization.NewAPIKeyID()
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	apiKey, secretHash, err := authorization.GenerateAPIKey(namespaceID.String(), id)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	now := time.Now().UTC()
+	key := &persistencespb.NamespaceApiKey{
+		Id:         id,
+		Name:       request.GetName(),
+		Role:       strings.ToLower(request.GetRole()),
+		SecretHash: secretHash,
+		CreateTime: &now,
+	}
+	if err := adh.namespaceHandler.CreateAPIKey(ctx, request.GetNamespace(), key); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	adh.namespaceRegistry.Refresh()
+	return &adminservice.CreateApiKeyResponse{
+		ApiKeyInfo: toAPIKeyInfo(key),
+		ApiKey:     apiKey,
+	}, nil
+}
+
+// ListApiKeys lists the API keys of a namespace, without their secrets
+func (adh *AdminHandler) ListApiKeys(
+	ctx context.Context,
+	request *adminservice.ListApiKeysRequest,
+) (_ *adminservice.ListApiKeysResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminListApiKeysScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+
+	ns, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	keys := ns.APIKeys()
+	infos := make([]*namespacespb.ApiKeyInfo, 0, len(keys))
+	for _, key := range keys {
+		infos = append(infos, toAPIKeyInfo(key))
+	}
+	return &adminservice.ListApiKeysResponse{ApiKeyInfos: infos}, nil
+}
+
+// RotateApiKey replaces the secret of an API key, previous secret stays valid during a grace period
+func (adh *AdminHandler) RotateApiKey(
+	ctx context.Context,
+	request *adminservice.RotateApiKeyRequest,
+) (_ *adminservice.RotateApiKeyResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminRotateApiKeyScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetId() == "" {
+		return nil, adh.error(errAPIKeyIDNotSet, scope)
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	apiKey, secretHash, err := authorization.GenerateAPIKey(namespaceID.String(), request.GetId())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	gracePeriod := adh.config.APIKeyRotationGracePeriod()
+	if request.PreviousSecretGracePeriod != nil {
+		gracePeriod = timestamp.DurationValue(request.PreviousSecretGracePeriod)
+	}
+	key, err := adh.namespaceHandler.RotateAPIKey(
+		ctx,
+		request.GetNamespace(),
+		request.GetId(),
+		secretHash,
+		time.Now().UTC().Add(gracePeriod),
+	)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	adh.namespaceRegistry.Refresh()
+	return &adminservice.RotateApiKeyResponse{
+		ApiKeyInfo: toAPIKeyInfo(key),
+		ApiKey:     apiKey,
+	}, nil
+}
+
+// RevokeApiKey deletes an API key of a namespace
+func (adh *AdminHandler) RevokeApiKey(
+	ctx context.Context,
+	request *adminservice.RevokeApiKeyRequest,
+) (_ *adminservice.RevokeApiKeyResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminRevokeApiKeyScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetId() == "" {
+		return nil, adh.error(errAPIKeyIDNotSet, scope)
+	}
+
+	if err := adh.namespaceHandler.RevokeAPIKey(ctx, request.GetNamespace(), request.GetId()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	// frontends may accept the key until their registry refresh and API key cache expire
+	adh.namespaceRegistry.Refresh()
+	return &adminservice.RevokeApiKeyResponse{}, nil
+}
+
+// StartNamespaceHandover starts the managed failover of a global namespace to a remote cluster
+func (adh *AdminHandler) StartNamespaceHandover(
+	ctx context.Context,
+	request *adminservice.StartNamespaceHandoverRequest,
+) (_ *adminservice.StartNamespaceHandoverResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminStartNamespaceHandoverScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetRemoteCluster() == "" {
+		return nil, adh.error(errClusterNameNotSet, scope)
+	}
+	if request.GetRemoteCluster() == adh.clusterMetadata.GetCurrentClusterName() {
+		return nil, adh.error(errHandoverToCurrentCluster, scope)
+	}
+	if _, ok := adh.clusterMetadata.GetAllClusterInfo()[request.GetRemoteCluster()]; !ok {
+		return nil, adh.error(serviceerror.NewInvalidArgument(fmt.Sprintf("Unknown cluster %s.", request.GetRemoteCluster())), scope)
+	}
+
+	wfParams := migration.NamespaceHandoverParams{
+		Namespace:              request.GetNamespace(),
+		RemoteCluster:          request.GetRemoteCluster(),
+		AllowedLaggingSeconds:  int(timestamp.DurationValue(request.GetAllowedReplicationLag()).Seconds()),
+		HandoverTimeoutSeconds: int(timestamp.DurationValue(request.GetHandoverTimeout()).Seconds()),
+		DryRun:                 request.GetDryRun(),
+	}
+
+	sdkClient := adh.sdkClientFactory.GetSystemClient(adh.logger)
+	run, err := sdkClient.ExecuteWorkflow(
+		ctx,
+		sdkclient.StartWorkflowOptions{
+			TaskQueue: worker.DefaultWorkerTaskQueue,
+			ID:        namespaceHandoverWorkflowID(request.GetNamespace()),
+			// only one handover of a namespace may run at a time
+			WorkflowExecutionErrorWhenAlreadyStarted: true,
+		},
+		migration.NamespaceHandoverWorkflowName,
+		wfParams,
+	)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.StartNamespaceHandoverResponse{
+		WorkflowId: run.GetID(),
+		RunId:      run.GetRunID(),
+	}, nil
+}
+
+// DescribeNamespaceHandover returns the status of each step of a namespace handover
+func (adh *AdminHandler) DescribeNamespaceHandover(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceHandoverRequest,
+) (_ *adminservice.DescribeNamespaceHandoverResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeNamespaceHandoverScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+
+	workflowID := namespaceHandoverWorkflowID(request.GetNamespace())
+	sdkClient := adh.sdkClientFactory.GetSystemClient(adh.logger)
+	descResp, err := sdkClient.DescribeWorkflowExecution(ctx, workflowID, request.GetRunId())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	// query the described run, latest run may have changed in the meantime
+	runID := descResp.GetWorkflowExecutionInfo().GetExecution().GetRunId()
+	value, err := sdkClient.QueryWorkflow(ctx, workflowID, runID, migration.HandoverReportQueryType)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	var report migration.HandoverReport
+	if err := value.Get(&report); err != nil {
+		return nil, adh.error(serviceerror.NewInternal(fmt.Sprintf("unable to decode handover report: %v", err)), scope)
+	}
+
+	steps := make([]*replicationspb.NamespaceHandoverStep, 0, len(report.Steps))
+	for _, step := range report.Steps {
+		steps = append(steps, &replicationspb.NamespaceHandoverStep{
+			Name:      step.Name,
+			State:     string(step.State),
+			Error:     step.Error,
+			StartTime: timestampOrNil(step.StartTime),
+			EndTime:   timestampOrNil(step.EndTime),
+		})
+	}
+	return &adminservice.DescribeNamespaceHandoverResponse{
+		RemoteCluster: report.RemoteCluster,
+		DryRun:        report.DryRun,
+		Status:        descResp.GetWorkflowExecutionInfo().GetStatus(),
+		Steps:         steps,
+		RolledBack:    report.RolledBack,
+	}, nil
+}
+
+// SyncSearchAttributes reports custom search attributes drift between current and remote cluster and fixes it unless dry run
+func (adh *AdminHandler) SyncSearchAttributes(
+	ctx context.Context,
+	request *adminservice.SyncSearchAttributesRequest,
+) (_ *adminservice.SyncSearchAttributesResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminSyncSearchAttributesScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetRemoteCluster() == "" {
+		return nil, adh.error(errClusterNameNotSet, scope)
+	}
+	if request.GetRemoteCluster() == adh.clusterMetadata.GetCurrentClusterName() {
+		return nil, adh.error(errSyncSearchAttributesWithCurrentCluster, scope)
+	}
+	if _, ok := adh.clusterMetadata.GetAllClusterInfo()[request.GetRemoteCluster()]; !ok {
+		return nil, adh.error(serviceerror.NewInvalidArgument(fmt.Sprintf("Unknown cluster %s.", request.GetRemoteCluster())), scope)
+	}
+	if !adh.clusterMetadata.IsGlobalNamespaceEnabled() {
+		return nil, adh.error(errGlobalNamespaceNotEnabled, scope)
+	}
+
+	indexName := adh.ESConfig.GetVisibilityIndex()
+	currentSearchAttributes, err := adh.saProvider.GetSearchAttributes(indexName, true)
+	if err != nil {
+		return nil, adh.error(serviceerror.NewUnavailable(fmt.Sprintf(errUnableToGetSearchAttributesMessage, err)), scope)
+	}
+	remoteSearchAttributes, err := adh.clientBean.GetRemoteAdminClient(request.GetRemoteCluster()).GetSearchAttributes(
+		ctx,
+		&adminservice.GetSearchAttributesRequest{},
+	)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	missingInRemote, missingInCurrent, conflictNames := searchattribute.DiffCustom(
+		currentSearchAttributes.Custom(),
+		remoteSearchAttributes.GetCustomAttributes(),
+	)
+	conflicts := make([]*replicationspb.SearchAttributeConflict, 0, len(conflictNames))
+	for _, saName := range conflictNames {
+		conflicts = append(conflicts, &replicationspb.SearchAttributeConflict{
+			Name:               saName,
+			CurrentClusterType: currentSearchAttributes.Custom()[saName],
+			RemoteClusterType:  remoteSearchAttributes.GetCustomAttributes()[saName],
+		})
+	}
+	resp := &adminservice.SyncSearchAttributesResponse{
+		MissingInCurrentCluster: missingInCurrent,
+		MissingInRemoteCluster:  missingInRemote,
+		Conflicts:               conflicts,
+	}
+	if request.GetDryRun() {
+		return resp, nil
+	}
+
+	// remote cluster adds the attributes when it receives them through namespace replication queue
+	if err := adh.namespaceReplicator.HandleSearchAttributesTransmissionTask(ctx, missingInRemote); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	if len(missingInCurrent) > 0 {
+		sdkClient := adh.sdkClientFactory.GetSystemClient(adh.logger)
+		run, err := sdkClient.ExecuteWorkflow(
+			ctx,
+			sdkclient.StartWorkflowOptions{
+				TaskQueue: worker.DefaultWorkerTaskQueue,
+				ID:        addsearchattributes.WorkflowName,
+			},
+			addsearchattributes.WorkflowName,
+			addsearchattributes.WorkflowParams{
+				CustomAttributesToAdd: missingInCurrent,
+				IndexName:             indexName,
+			},
+		)
+		if err != nil {
+			return nil, adh.error(serviceerror.NewUnavailable(fmt.Sprintf(errUnableToStartWorkflowMessage, addsearchattributes.WorkflowName, err)), scope)
+		}
+		if err := run.Get(ctx, nil); err != nil {
+			execution := &commonpb.WorkflowExecution{WorkflowId: addsearchattributes.WorkflowName, RunId: run.GetRunID()}
+			return nil, adh.error(serviceerror.NewSystemWorkflow(execution, err), scope)
+		}
+	}
+
+	return resp, nil
+}
+
+// PurgeDLQMessagesAllShards purges replication DLQ messages of all shards
+func (adh *AdminHandler) PurgeDLQMessagesAllShards(
+	ctx context.Context,
+	request *adminservice.PurgeDLQMessagesAllShardsRequest,
+) (_ *adminservice.PurgeDLQMessagesAllShardsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminPurgeDLQMessagesAllShardsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	filter, err := adh.validateDLQAllShardsRequest(request.GetSourceCluster(), request.GetNamespace(), request.GetWorkflowId(), request.GetTaskType())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	lastMessageID := request.GetInclusiveEndMessageId()
+	if lastMessageID <= 0 {
+		lastMessageID = common.EndMessageID
+	}
+
+	count, shards := adh.processDLQMessagesAllShards(ctx, func(shardID int32, pageToken []byte) (int64, []byte, error) {
+		resp, err := adh.historyClient.PurgeDLQMessages(ctx, &historyservice.PurgeDLQMessagesRequest{
+			Type:                  enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION,
+			ShardId:               shardID,
+			SourceCluster:         request.GetSourceCluster(),
+			InclusiveEndMessageId: lastMessageID,
+			Filter:                filter,
+			DryRun:                request.GetDryRun(),
+			MaximumPageSize:       common.ReadDLQMessagesPageSize,
+			NextPageToken:         pageToken,
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+		return resp.GetMessageCount(), resp.GetNextPageToken(), nil
+	})
+	return &adminservice.PurgeDLQMessagesAllShardsResponse{
+		MessageCount: count,
+		Shards:       shards,
+	}, nil
+}
+
+// MergeDLQMessagesAllShards merges replication DLQ messages of all shards
+func (adh *AdminHandler) MergeDLQMessagesAllShards(
+	ctx context.Context,
+	request *adminservice.MergeDLQMessagesAllShardsRequest,
+) (_ *adminservice.MergeDLQMessagesAllShardsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminMergeDLQMessagesAllShardsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	filter, err := adh.validateDLQAllShardsRequest(request.GetSourceCluster(), request.GetNamespace(), request.GetWorkflowId(), request.GetTaskType())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	lastMessageID := request.GetInclusiveEndMessageId()
+	if lastMessageID <= 0 {
+		lastMessageID = common.EndMessageID
+	}
+
+	count, shards := adh.processDLQMessagesAllShards(ctx, func(shardID int32, pageToken []byte) (int64, []byte, error) {
+		resp, err := adh.historyClient.MergeDLQMessages(ctx, &historyservice.MergeDLQMessagesRequest{
+			Type:                  enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION,
+			ShardId:               shardID,
+			SourceCluster:         request.GetSourceCluster(),
+			InclusiveEndMessageId: lastMessageID,
+			Filter:                filter,
+			DryRun:                request.GetDryRun(),
+			MaximumPageSize:       common.ReadDLQMessagesPageSize,
+			NextPageToken:         pageToken,
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+		return resp.GetMessageCount(), resp.GetNextPageToken(), nil
+	})
+	return &adminservice.MergeDLQMessagesAllShardsResponse{
+		MessageCount: count,
+		Shards:       shards,
+	}, nil
+}
+
+// ResendReplicationTasks requests replication task from remote cluster
+func (adh *AdminHandler) ResendReplicationTasks(
+	_ context.Context,
+	request *adminservice.ResendReplicationTasksRequest,
+) (_ *adminservice.ResendReplicationTasksResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminResendReplicationTasksScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	resender := xdc.NewNDCHistoryResender(
+		adh.namespaceRegistry,
+		adh.clientBean.GetRemoteAdminClient(request.GetRemoteCluster()),
+		func(ctx context.Context, request *historyservice.ReplicateEventsV2Request) error {
+			_, err1 := adh.historyClient.ReplicateEventsV2(ctx, request)
+			return err1
+		},
+		adh.eventSerializer,
+		nil,
+		adh.logger,
+	)
+	if err := resender.SendSingleWorkflowHistory(
+		namespace.ID(request.GetNamespaceId()),
+		request.GetWorkflowId(),
+		request.GetRunId(),
+		resendStartEventID,
+		request.StartVersion,
+		common.EmptyEventID,
+		common.EmptyVersion,
+	); err != nil {
+		return nil, err
+	}
+	return &adminservice.ResendReplicationTasksResponse{}, nil
+}
+
+// VerifyWorkflowReplication compares one workflow execution across all clusters of its namespace,
+// and resends replication tasks from the active cluster to clusters whose state diverged
+func (adh *AdminHandler) VerifyWorkflowReplication(
+	ctx context.Context,
+	request *adminservice.VerifyWorkflowReplicationRequest,
+) (_ *adminservice.VerifyWorkflowReplicationResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminVerifyWorkflowReplicationScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	namespaceEntry, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if !namespaceEntry.IsGlobalNamespace() {
+		return nil, adh.error(errNamespaceNotGlobal, scope)
+	}
+
+	execution := request.GetExecution()
+	if execution.GetRunId() == "" {
+		// resolve current run in current cluster, so the same run is compared in all clusters
+		resp, err := adh.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+			NamespaceId: namespaceEntry.ID().String(),
+			Execution:   execution,
+		})
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		execution = &commonpb.WorkflowExecution{
+			WorkflowId: execution.GetWorkflowId(),
+			RunId:      resp.GetDatabaseMutableState().GetExecutionState().GetRunId(),
+		}
+	}
+
+	activeCluster := namespaceEntry.ActiveClusterName()
+	var activeState *replicationspb.WorkflowReplicationState
+	var states []*replicationspb.WorkflowReplicationState
+	errs := make(map[string]error)
+	for _, clusterName := range namespaceEntry.ClusterNames() {
+		state, err := adh.getWorkflowReplicationState(ctx, clusterName, namespaceEntry, execution)
+		if err != nil {
+			state = &replicationspb.WorkflowReplicationState{
+				ClusterName: clusterName,
+				Error:       err.Error(),
+			}
+			errs[clusterName] = err
+		}
+		if clusterName == activeCluster {
+			activeState = state
+		}
+		states = append(states, state)
+	}
+
+	consistent := true
+	for _, state := range states {
+		clusterName := state.GetClusterName()
+		if clusterName == activeCluster {
+			state.Consistent = errs[activeCluster] == nil
+		} else if errs[activeCluster] == nil {
+			state.Consistent = errs[clusterName] == nil && workflowReplicationStateEqual(activeState, state)
+		}
+		if state.GetConsistent() {
+			continue
+		}
+		consistent = false
+
+		// replication tasks can only be resent from a readable active cluster, to a cluster which is
+		// reachable and either has a different state or does not have the execution at all
+		if request.GetSkipResend() || clusterName == activeCluster || errs[activeCluster] != nil {
+			continue
+		}
+		if _, isNotFound := errs[clusterName].(*serviceerror.NotFound); errs[clusterName] != nil && !isNotFound {
+			continue
+		}
+		if err := adh.resendWorkflowReplicationTasks(ctx, clusterName, activeCluster, namespaceEntry.ID(), execution); err != nil {
+			adh.logger.Warn("Failed to resend replication tasks of diverged workflow.",
+				tag.WorkflowNamespace(request.GetNamespace()),
+				tag.WorkflowID(execution.GetWorkflowId()),
+				tag.WorkflowRunID(execution.GetRunId()),
+				tag.ClusterName(clusterName),
+				tag.Error(err),
+			)
+			state.Error = fmt.Sprintf("Unable to resend replication tasks: %v", err)
+			continue
+		}
+		state.Resent = true
+	}
+
+	return &adminservice.VerifyWorkflowReplicationResponse{
+		Consistent:    consistent,
+		ActiveCluster: activeCluster,
+		ClusterStates: states,
+	}, nil
+}
+
+// GetTaskQueueTasks returns tasks from task queue
+func (adh *AdminHandler) GetTaskQueueTasks(
+	ctx context.Context,
+	request *adminservice.GetTaskQueueTasksRequest,
+) (_ *adminservice.GetTaskQueueTasksResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminGetTaskQueueTasksScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.taskManager.GetTasks(ctx, &persistence.GetTasksRequest{
+		NamespaceID:        namespaceID.String(),
+		TaskQueue:          request.GetTaskQueue(),
+		TaskType:           request.GetTaskQueueType(),
+		InclusiveMinTaskID: request.GetMinTaskId(),
+		ExclusiveMaxTaskID: request.GetMaxTaskId(),
+		PageSize:           int(request.GetBatchSize()),
+		NextPageToken:      request.NextPageToken,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	return &adminservice.GetTaskQueueTasksResponse{
+		Tasks:         resp.Tasks,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+// ReportNamespaceQuotaUsage records namespace request rates reported by a frontend host,
+// and returns the share of global namespace rate limits allotted to it
+func (adh *AdminHandler) ReportNamespaceQuotaUsage(
+	ctx context.Context,
+	request *adminservice.ReportNamespaceQuotaUsageRequest,
+) (_ *adminservice.ReportNamespaceQuotaUsageResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminReportNamespaceQuotaUsageScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetHostAddress() == "" {
+		return nil, adh.error(errHostAddressNotSet, scope)
+	}
+	if !adh.config.EnableGlobalNamespaceRPSCoordination() {
+		return nil, adh.error(errGlobalNamespaceRPSCoordinationDisabled, scope)
+	}
+
+	return &adminservice.ReportNamespaceQuotaUsageResponse{
+		NamespaceRps: adh.namespaceQuotaCoordinator.Allot(request.GetHostAddress(), request.GetNamespaceRps()),
+	}, nil
+}
+
+// ListPollerCounts returns the number of concurrent pollers per namespace and task queue of this frontend host,
+// or of all frontend hosts if requested
+func (adh *AdminHandler) ListPollerCounts(
+	ctx context.Context,
+	request *adminservice.ListPollerCountsRequest,
+) (_ *adminservice.ListPollerCountsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminListPollerCountsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	self, err := adh.membershipMonitor.WhoAmI()
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	var pollerCounts []*adminservice.PollerCount
+	for _, pollerCount := range adh.pollerCounter.PollerCounts() {
+		if request.GetNamespace() != "" && pollerCount.Namespace.String() != request.GetNamespace() {
+			continue
+		}
+		pollerCounts = append(pollerCounts, &adminservice.PollerCount{
+			HostAddress:   self.GetAddress(),
+			Namespace:     pollerCount.Namespace.String(),
+			TaskQueue:     pollerCount.TaskQueue,
+			TaskQueueType: pollerCount.TaskQueueType,
+			Count:         pollerCount.Count,
+		})
+	}
+
+	if request.GetAllHosts() {
+		resolver, err := adh.membershipMonitor.GetResolver(common.FrontendServiceName)
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		for _, host := range resolver.Members() {
+			if host.GetAddress() == self.GetAddress() {
+				continue
+			}
+			resp, err := adh.hostAdminClients.get(host.GetAddress()).ListPollerCounts(ctx, &adminservice.ListPollerCountsRequest{
+				Namespace: request.GetNamespace(),
+			})
+			if err != nil {
+				return nil, adh.error(err, scope)
+			}
+			pollerCounts = append(pollerCounts, resp.GetPollerCounts()...)
+		}
+	}
+
+	return &adminservice.ListPollerCountsResponse{
+		PollerCounts: pollerCounts,
+	}, nil
+}
+
+func (adh *AdminHandler) validateGetWorkflowExecutionRawHistoryV2Request(
+	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
+) error {
+
+	execution := request.Execution
+	if execution.GetWorkflowId() == "" {
+		return errWorkflowIDNotSet
+	}
+	// TODO currently, this API is only going to be used by re-send history events
+	// to remote cluster if kafka is lossy again, in the future, this API can be used
+	// by CLI and client, then empty runID (meaning the current workflow) should be allowed
+	if execution.GetRunId() == "" || uuid.Parse(execution.GetRunId()) == nil {
+		return errInvalidRunID
+	}
+
+	pageSize := int(request.GetMaximumPageSize())
+	if pageSize <= 0 {
+		return errInvalidPageSize
+	}
+
+	if request.GetStartEventId() == common.EmptyEventID &&
+		request.GetStartEventVersion() == common.EmptyVersion &&
+		request.GetEndEventId() == common.EmptyEventID &&
+		request.GetEndEventVersion() == common.EmptyVersion {
+		return errInvalidEventQueryRange
+	}
+
+	return nil
+}
+
+func (adh *AdminHandler) validateRemoteClusterMetadata(metadata *adminservice.DescribeClusterResponse) error {
+	// Verify remote cluster config
+	currentClusterInfo := adh.clusterMetadata
+	if metadata.GetClusterName() == currentClusterInfo.GetCurrentClusterName() {
+		// cluster name conflict
+		return serviceerror.NewInvalidArgument("Cannot update current cluster metadata from rpc calls")
+	}
+	if metadata.GetFailoverVersionIncrement() != currentClusterInfo.GetFailoverVersionIncrement() {
+		// failover version increment is mismatch with current cluster config
+		return serviceerror.NewInvalidArgument("Cannot add remote cluster due to failover version increment mismatch")
+	}
+	if metadata.GetHistoryShardCount() != adh.config.NumHistoryShards {
+		// cluster shard number not equal
+		// TODO: remove this check once we support different shard numbers
+		return serviceerror.NewInvalidArgument("Cannot add remote cluster due to history shard number mismatch")
+	}
+	if !metadata.IsGlobalNamespaceEnabled {
+		// remote cluster doesn't support global namespace
+		return serviceerror.NewInvalidArgument("Cannot add remote cluster as global namespace is not supported")
+	}
+	for clusterName, cluster := range currentClusterInfo.GetAllClusterInfo() {
+		if clusterName != metadata.ClusterName && cluster.InitialFailoverVersion == metadata.GetInitialFailoverVersion() {
+			// initial failover version conflict
+			// best effort: race condition if a concurrent write to db with the same version.
+			return serviceerror.NewInvalidArgument("Cannot add remote cluster due to initial failover version conflict")
+		}
+	}
+	return nil
+}
+
+func (adh *AdminHandler) setRequestDefaultValueAndGetTargetVersionHistory(
+	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
 	versionHistories *historyspb.VersionHistories,
 ) (*historyspb.VersionHistory, error) {
 
@@ -1648,3 +2634,197 @@ func (adh *AdminHandler) error(err error, scope metrics.Scope) error {
 
 	return err
 }
+
+func (adh *AdminHandler) validateDLQAllShardsRequest(
+	sourceCluster string,
+	namespaceName string,
+	workflowID string,
+	taskType enumsspb.TaskType,
+) (*replicationspb.ReplicationDLQFilter, error) {
+	if sourceCluster == "" {
+		return nil, errClusterNameNotSet
+	}
+	if _, ok := adh.clusterMetadata.GetAllClusterInfo()[sourceCluster]; !ok {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Unknown cluster %s.", sourceCluster))
+	}
+	switch taskType {
+	case enumsspb.TASK_TYPE_UNSPECIFIED, enumsspb.TASK_TYPE_REPLICATION_HISTORY, enumsspb.TASK_TYPE_REPLICATION_SYNC_ACTIVITY:
+	default:
+		return nil, errInvalidDLQTaskType
+	}
+
+	// filter is always set, so messages are deleted one by one and DLQ ack level of shards is not changed
+	filter := &replicationspb.ReplicationDLQFilter{
+		WorkflowId: workflowID,
+		TaskType:   taskType,
+	}
+	if namespaceName != "" {
+		namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(namespaceName))
+		if err != nil {
+			return nil, err
+		}
+		filter.NamespaceId = namespaceID.String()
+	}
+	return filter, nil
+}
+
+// processDLQMessagesAllShards runs the operation page by page for each shard, dlqAllShardsConcurrency shards at a time.
+// It returns total number of messages and the shards which had messages or failed.
+func (adh *AdminHandler) processDLQMessagesAllShards(
+	ctx context.Context,
+	processPage func(shardID int32, pageToken []byte) (int64, []byte, error),
+) (int64, []*replicationspb.DLQShardResult) {
+	results := make([]*replicationspb.DLQShardResult, adh.numberOfHistoryShards)
+	semaphore := make(chan struct{}, dlqAllShardsConcurrency)
+	var wg sync.WaitGroup
+	for shardID := int32(1); shardID <= adh.numberOfHistoryShards; shardID++ {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(shardID int32) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			result := &replicationspb.DLQShardResult{ShardId: shardID}
+			results[shardID-1] = result
+			var pageToken []byte
+			for {
+				if err := ctx.Err(); err != nil {
+					result.Error = err.Error()
+					return
+				}
+				count, nextPageToken, err := processPage(shardID, pageToken)
+				if err != nil {
+					result.Error = err.Error()
+					return
+				}
+				result.MessageCount += count
+				if len(nextPageToken) == 0 {
+					return
+				}
+				pageToken = nextPageToken
+			}
+		}(shardID)
+	}
+	wg.Wait()
+
+	var totalCount int64
+	var shards []*replicationspb.DLQShardResult
+	for _, result := range results {
+		totalCount += result.GetMessageCount()
+		if result.GetMessageCount() > 0 || result.GetError() != "" {
+			shards = append(shards, result)
+		}
+	}
+	return totalCount, shards
+}
+
+func (adh *AdminHandler) getWorkflowReplicationState(
+	ctx context.Context,
+	clusterName string,
+	namespaceEntry *namespace.Namespace,
+	execution *commonpb.WorkflowExecution,
+) (*replicationspb.WorkflowReplicationState, error) {
+	var mutableState *persistencespb.WorkflowMutableState
+	var sizeInfo *workflowspb.ExecutionSizeInfo
+	if clusterName == adh.clusterMetadata.GetCurrentClusterName() {
+		resp, err := adh.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+			NamespaceId: namespaceEntry.ID().String(),
+			Execution:   execution,
+		})
+		if err != nil {
+			return nil, err
+		}
+		mutableState, sizeInfo = resp.GetDatabaseMutableState(), resp.GetSizeInfo()
+	} else {
+		resp, err := adh.clientBean.GetRemoteAdminClient(clusterName).DescribeMutableState(ctx, &adminservice.DescribeMutableStateRequest{
+			Namespace: namespaceEntry.Name().String(),
+			Execution: execution,
+		})
+		if err != nil {
+			return nil, err
+		}
+		mutableState, sizeInfo = resp.GetDatabaseMutableState(), resp.GetSizeInfo()
+	}
+
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(mutableState.GetExecutionInfo().GetVersionHistories())
+	if err != nil {
+		return nil, err
+	}
+	return &replicationspb.WorkflowReplicationState{
+		ClusterName: clusterName,
+		// branch token is specific to the cluster, only items are comparable
+		CurrentVersionHistory: versionhistory.NewVersionHistory(nil, currentVersionHistory.GetItems()),
+		LastEventId:           mutableState.GetNextEventId() - 1,
+		Status:                mutableState.GetExecutionState().GetStatus(),
+		Checksum:              mutableState.GetChecksum().GetValue(),
+		SizeInfo:              sizeInfo,
+	}, nil
+}
+
+func (adh *AdminHandler) resendWorkflowReplicationTasks(
+	ctx context.Context,
+	targetCluster string,
+	sourceCluster string,
+	namespaceID namespace.ID,
+	execution *commonpb.WorkflowExecution,
+) error {
+	request := &adminservice.ResendReplicationTasksRequest{
+		NamespaceId:   namespaceID.String(),
+		WorkflowId:    execution.GetWorkflowId(),
+		RunId:         execution.GetRunId(),
+		RemoteCluster: sourceCluster,
+		StartVersion:  common.EmptyVersion,
+	}
+	var err error
+	if targetCluster == adh.clusterMetadata.GetCurrentClusterName() {
+		_, err = adh.ResendReplicationTasks(ctx, request)
+	} else {
+		_, err = adh.clientBean.GetRemoteAdminClient(targetCluster).ResendReplicationTasks(ctx, request)
+	}
+	return err
+}
+
+// workflowReplicationStateEqual compares workflow states of two clusters.
+// Mutable state checksum is not compared, since it covers cluster specific fields like branch token and sticky task queue.
+func workflowReplicationStateEqual(
+	state1 *replicationspb.WorkflowReplicationState,
+	state2 *replicationspb.WorkflowReplicationState,
+) bool {
+	size1 := state1.GetSizeInfo()
+	size2 := state2.GetSizeInfo()
+	return state1.GetLastEventId() == state2.GetLastEventId() &&
+		state1.GetStatus() == state2.GetStatus() &&
+		state1.GetCurrentVersionHistory().Equal(state2.GetCurrentVersionHistory()) &&
+		size1.GetPendingActivityCount() == size2.GetPendingActivityCount() &&
+		size1.GetPendingTimerCount() == size2.GetPendingTimerCount() &&
+		size1.GetPendingChildExecutionCount() == size2.GetPendingChildExecutionCount() &&
+		size1.GetPendingRequestCancelCount() == size2.GetPendingRequestCancelCount() &&
+		size1.GetPendingSignalCount() == size2.GetPendingSignalCount()
+}
+
+func namespaceHandoverWorkflowID(namespaceName string) string {
+	return fmt.Sprintf("%s/%s", migration.NamespaceHandoverWorkflowName, namespaceName)
+}
+
+func timestampOrNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamp.TimePtr(t)
+}
+
+func toAPIKeyInfo(key *persistencespb.NamespaceApiKey) *namespacespb.ApiKeyInfo {
+	info := &namespacespb.ApiKeyInfo{
+		Id:         key.GetId(),
+		Name:       key.GetName(),
+		Role:       key.GetRole(),
+		CreateTime: key.GetCreateTime(),
+		RotateTime: key.GetRotateTime(),
+	}
+	if key.GetPreviousSecretHash() != "" && timestamp.TimeValue(key.GetPreviousSecretExpirationTime()).After(time.Now().UTC()) {
+		info.PreviousSecretExpirationTime = key.GetPreviousSecretExpirationTime()
+	}
+	return info
+}
@@ -28,12 +28,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"google.golang.org/grpc/health"
 
 	"go.temporal.io/server/api/adminservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/membership"
 
@@ -45,16 +48,21 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	sdkclient "go.temporal.io/sdk/client"
 	sdkmocks "go.temporal.io/sdk/mocks"
+	"google.golang.org/grpc"
 
 	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	clientmocks "go.temporal.io/server/client"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
@@ -62,8 +70,14 @@ import (
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/service/frontend/configs"
+	"go.temporal.io/server/service/worker"
+	"go.temporal.io/server/service/worker/addsearchattributes"
+	"go.temporal.io/server/service/worker/migration"
 )
 
 type (
@@ -146,6 +160,21 @@ func (s *adminHandlerSuite) SetupTest() {
 		s.mockResource.GetArchivalMetadata(),
 		health.NewServer(),
 		serialization.NewSerializer(),
+		NewNamespaceQuotaCoordinator(
+			cfg,
+			s.mockResource.FrontendServiceResolver,
+			s.mockResource.MembershipMonitor,
+			s.mockResource.GetClientFactory(),
+			s.mockResource.TimeSource,
+			s.mockResource.Logger,
+		),
+		interceptor.NewNamespaceCountLimitInterceptor(
+			s.mockNamespaceCache,
+			s.mockResource.Logger,
+			dynamicconfig.GetIntPropertyFilteredByNamespace(10),
+			dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(0),
+			configs.ExecutionAPICountLimitOverride,
+		),
 	}
 	s.handler = NewAdminHandler(args)
 	s.handler.Start()
@@ -741,17 +770,57 @@ func (s *adminHandlerSuite) Test_RemoveSearchAttributes() {
 
 func (s *adminHandlerSuite) Test_RemoveRemoteCluster_Success() {
 	var clusterName = "cluster"
+	clusterData := &persistence.GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{
+			ClusterName:   clusterName,
+			ChangeHistory: []*persistencespb.ClusterMetadataChange{{Version: 1, ClusterName: clusterName}},
+		},
+		Version: 3,
+	}
+	s.mockClusterMetadataManager.EXPECT().GetClusterMetadata(gomock.Any(), &persistence.GetClusterMetadataRequest{ClusterName: clusterName}).Return(
+		clusterData, nil)
+	s.expectListClusterMetadata(clusterData)
 	s.mockClusterMetadataManager.EXPECT().DeleteClusterMetadata(
 		gomock.Any(),
 		&persistence.DeleteClusterMetadataRequest{ClusterName: clusterName},
 	).Return(nil)
+	s.mockClusterMetadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).Return(
+		&persistence.GetClusterMetadataResponse{
+			ClusterMetadata: persistencespb.ClusterMetadata{
+				ChangeHistory: []*persistencespb.ClusterMetadataChange{{Version: 1, ClusterName: "other-cluster"}},
+			},
+			Version: 10,
+		}, nil)
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.SaveClusterMetadataRequest) (bool, error) {
+			// the history of the removed cluster is moved to the current cluster record
+			s.Equal(int64(10), request.Version)
+			s.Len(request.ChangeHistory, 3)
+			s.Equal(clusterName, request.ChangeHistory[1].GetClusterName())
+			change := request.ChangeHistory[2]
+			s.Equal(int64(2), change.GetVersion())
+			s.NotNil(change.GetChangeTime())
+			s.Equal("RemoveRemoteCluster", change.GetOperation())
+			s.Equal("admin", change.GetIdentity())
+			s.Equal(clusterName, change.GetPreviousMetadata().GetClusterName())
+			s.Nil(change.GetNewMetadata())
+			return true, nil
+		})
 
-	_, err := s.handler.RemoveRemoteCluster(context.Background(), &adminservice.RemoveRemoteClusterRequest{ClusterName: clusterName})
+	_, err := s.handler.RemoveRemoteCluster(context.Background(), &adminservice.RemoveRemoteClusterRequest{
+		ClusterName: clusterName,
+		Identity:    "admin",
+	})
 	s.NoError(err)
 }
 
 func (s *adminHandlerSuite) Test_RemoveRemoteCluster_Error() {
 	var clusterName = "cluster"
+	s.mockClusterMetadataManager.EXPECT().GetClusterMetadata(gomock.Any(), &persistence.GetClusterMetadataRequest{ClusterName: clusterName}).Return(
+		nil,
+		serviceerror.NewNotFound("expected empty result"),
+	)
+	s.expectListClusterMetadata()
 	s.mockClusterMetadataManager.EXPECT().DeleteClusterMetadata(
 		gomock.Any(),
 		&persistence.DeleteClusterMetadataRequest{ClusterName: clusterName},
@@ -782,22 +851,27 @@ func (s *adminHandlerSuite) Test_AddOrUpdateRemoteCluster_RecordFound_Success()
 			InitialFailoverVersion:   0,
 			IsGlobalNamespaceEnabled: true,
 		}, nil)
-	s.mockClusterMetadataManager.EXPECT().GetClusterMetadata(gomock.Any(), &persistence.GetClusterMetadataRequest{ClusterName: clusterName}).Return(
-		&persistence.GetClusterMetadataResponse{
-			Version: recordVersion,
-		}, nil)
-	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), &persistence.SaveClusterMetadataRequest{
+	clusterData := &persistence.GetClusterMetadataResponse{
 		ClusterMetadata: persistencespb.ClusterMetadata{
-			ClusterName:              clusterName,
-			HistoryShardCount:        0,
-			ClusterId:                clusterId,
-			ClusterAddress:           rpcAddress,
-			FailoverVersionIncrement: 0,
-			InitialFailoverVersion:   0,
-			IsGlobalNamespaceEnabled: true,
+			ClusterName:   clusterName,
+			ChangeHistory: []*persistencespb.ClusterMetadataChange{{Version: 1, ClusterName: clusterName}},
 		},
 		Version: recordVersion,
-	}).Return(true, nil)
+	}
+	s.mockClusterMetadataManager.EXPECT().GetClusterMetadata(gomock.Any(), &persistence.GetClusterMetadataRequest{ClusterName: clusterName}).Return(
+		clusterData, nil)
+	s.expectListClusterMetadata(clusterData)
+	s.expectSaveClusterMetadataWithChange(recordVersion, func(request *persistence.SaveClusterMetadataRequest) {
+		s.Equal(rpcAddress, request.GetClusterAddress())
+		s.Len(request.ChangeHistory, 2)
+		change := request.ChangeHistory[1]
+		s.Equal(int64(2), change.GetVersion())
+		s.NotNil(change.GetChangeTime())
+		s.Equal("AddOrUpdateRemoteCluster", change.GetOperation())
+		s.Equal(clusterName, change.GetClusterName())
+		s.Equal(rpcAddress, change.GetNewMetadata().GetClusterAddress())
+		s.Nil(change.GetNewMetadata().GetChangeHistory())
+	})
 	_, err := s.handler.AddOrUpdateRemoteCluster(context.Background(), &adminservice.AddOrUpdateRemoteClusterRequest{FrontendAddress: rpcAddress})
 	s.NoError(err)
 }
@@ -826,20 +900,122 @@ func (s *adminHandlerSuite) Test_AddOrUpdateRemoteCluster_RecordNotFound_Success
 		nil,
 		serviceerror.NewNotFound("expected empty result"),
 	)
-	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), &persistence.SaveClusterMetadataRequest{
+	s.expectListClusterMetadata()
+	s.expectSaveClusterMetadataWithChange(0, func(request *persistence.SaveClusterMetadataRequest) {
+		s.Equal(clusterName, request.GetClusterName())
+		s.Equal(clusterId, request.GetClusterId())
+		s.Equal(rpcAddress, request.GetClusterAddress())
+		s.True(request.GetIsGlobalNamespaceEnabled())
+		s.Len(request.ChangeHistory, 1)
+		change := request.ChangeHistory[0]
+		s.Equal(int64(1), change.GetVersion())
+		s.Equal("AddOrUpdateRemoteCluster", change.GetOperation())
+		s.Equal(clusterName, change.GetClusterName())
+		s.Nil(change.GetPreviousMetadata())
+		s.Equal(rpcAddress, change.GetNewMetadata().GetClusterAddress())
+	})
+	_, err := s.handler.AddOrUpdateRemoteCluster(context.Background(), &adminservice.AddOrUpdateRemoteClusterRequest{FrontendAddress: rpcAddress})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_ListClusterMetadataHistory() {
+	changeTime := time.Date(2022, 5, 4, 13, 0, 0, 0, time.UTC)
+	clusterA := &persistence.GetClusterMetadataResponse{
 		ClusterMetadata: persistencespb.ClusterMetadata{
-			ClusterName:              clusterName,
-			HistoryShardCount:        0,
-			ClusterId:                clusterId,
-			ClusterAddress:           rpcAddress,
-			FailoverVersionIncrement: 0,
-			InitialFailoverVersion:   0,
-			IsGlobalNamespaceEnabled: true,
+			ClusterName: "cluster-a",
+			ChangeHistory: []*persistencespb.ClusterMetadataChange{
+				{Version: 1, ClusterName: "cluster-a", ChangeTime: timestamp.TimePtr(changeTime)},
+				{Version: 2, ClusterName: "cluster-a", ChangeTime: timestamp.TimePtr(changeTime.Add(2 * time.Minute))},
+			},
 		},
-		Version: 0,
-	}).Return(true, nil)
-	_, err := s.handler.AddOrUpdateRemoteCluster(context.Background(), &adminservice.AddOrUpdateRemoteClusterRequest{FrontendAddress: rpcAddress})
+	}
+	clusterB := &persistence.GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{
+			ClusterName: "cluster-b",
+			ChangeHistory: []*persistencespb.ClusterMetadataChange{
+				{Version: 1, ClusterName: "cluster-b", ChangeTime: timestamp.TimePtr(changeTime.Add(time.Minute))},
+			},
+		},
+	}
+	s.expectListClusterMetadata(clusterA, clusterB).Times(2)
+
+	resp, err := s.handler.ListClusterMetadataHistory(context.Background(), &adminservice.ListClusterMetadataHistoryRequest{})
 	s.NoError(err)
+	s.Len(resp.GetChanges(), 3)
+	s.Equal("cluster-a", resp.GetChanges()[0].GetClusterName())
+	s.Equal(int64(2), resp.GetChanges()[0].GetVersion())
+	s.Equal("cluster-b", resp.GetChanges()[1].GetClusterName())
+	s.Equal(int64(1), resp.GetChanges()[2].GetVersion())
+
+	resp, err = s.handler.ListClusterMetadataHistory(context.Background(), &adminservice.ListClusterMetadataHistoryRequest{ClusterName: "cluster-b"})
+	s.NoError(err)
+	s.Len(resp.GetChanges(), 1)
+	s.Equal("cluster-b", resp.GetChanges()[0].GetClusterName())
+}
+
+func (s *adminHandlerSuite) Test_RollbackClusterMetadata() {
+	clusterName := "cluster"
+	oldMetadata := persistencespb.ClusterMetadata{ClusterName: clusterName, ClusterAddress: "old-address"}
+	newMetadata := persistencespb.ClusterMetadata{ClusterName: clusterName, ClusterAddress: "new-address"}
+	clusterData := &persistence.GetClusterMetadataResponse{
+		ClusterMetadata: newMetadata,
+		Version:         4,
+	}
+	clusterData.ChangeHistory = []*persistencespb.ClusterMetadataChange{
+		{Version: 1, ClusterName: clusterName, NewMetadata: &oldMetadata},
+		{Version: 2, ClusterName: clusterName, PreviousMetadata: &oldMetadata, NewMetadata: &newMetadata},
+	}
+	s.expectListClusterMetadata(clusterData)
+	s.mockClusterMetadataManager.EXPECT().GetClusterMetadata(gomock.Any(), &persistence.GetClusterMetadataRequest{ClusterName: clusterName}).Return(
+		clusterData, nil)
+	s.expectSaveClusterMetadataWithChange(4, func(request *persistence.SaveClusterMetadataRequest) {
+		s.Equal("old-address", request.GetClusterAddress())
+		s.Len(request.ChangeHistory, 3)
+		s.Equal(int64(3), request.ChangeHistory[2].GetVersion())
+	})
+
+	resp, err := s.handler.RollbackClusterMetadata(context.Background(), &adminservice.RollbackClusterMetadataRequest{
+		Version:     1,
+		Identity:    "admin",
+		ClusterName: clusterName,
+	})
+	s.NoError(err)
+	s.Equal(int64(3), resp.GetChange().GetVersion())
+	s.Equal("RollbackClusterMetadata", resp.GetChange().GetOperation())
+	s.Equal("new-address", resp.GetChange().GetPreviousMetadata().GetClusterAddress())
+	s.Equal("old-address", resp.GetChange().GetNewMetadata().GetClusterAddress())
+}
+
+func (s *adminHandlerSuite) Test_RollbackClusterMetadata_VersionNotFound() {
+	s.expectListClusterMetadata(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{
+			ClusterName:   "other-cluster",
+			ChangeHistory: []*persistencespb.ClusterMetadataChange{{Version: 1, ClusterName: "other-cluster"}},
+		},
+	})
+
+	_, err := s.handler.RollbackClusterMetadata(context.Background(), &adminservice.RollbackClusterMetadataRequest{
+		Version:     1,
+		ClusterName: "cluster",
+	})
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
+func (s *adminHandlerSuite) expectListClusterMetadata(records ...*persistence.GetClusterMetadataResponse) *gomock.Call {
+	return s.mockClusterMetadataManager.EXPECT().ListClusterMetadata(gomock.Any(), gomock.Any()).Return(
+		&persistence.ListClusterMetadataResponse{ClusterMetadata: records}, nil)
+}
+
+func (s *adminHandlerSuite) expectSaveClusterMetadataWithChange(
+	version int64,
+	validate func(request *persistence.SaveClusterMetadataRequest),
+) {
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.SaveClusterMetadataRequest) (bool, error) {
+			s.Equal(version, request.Version)
+			validate(request)
+			return true, nil
+		})
 }
 
 func (s *adminHandlerSuite) Test_AddOrUpdateRemoteCluster_ValidationError_ClusterNameConflict() {
@@ -1030,18 +1206,8 @@ func (s *adminHandlerSuite) Test_AddOrUpdateRemoteCluster_SaveClusterMetadata_Er
 		nil,
 		serviceerror.NewNotFound("expected empty result"),
 	)
-	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), &persistence.SaveClusterMetadataRequest{
-		ClusterMetadata: persistencespb.ClusterMetadata{
-			ClusterName:              clusterName,
-			HistoryShardCount:        0,
-			ClusterId:                clusterId,
-			ClusterAddress:           rpcAddress,
-			FailoverVersionIncrement: 0,
-			InitialFailoverVersion:   0,
-			IsGlobalNamespaceEnabled: true,
-		},
-		Version: 0,
-	}).Return(false, fmt.Errorf("test error"))
+	s.expectListClusterMetadata()
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), gomock.Any()).Return(false, fmt.Errorf("test error"))
 	_, err := s.handler.AddOrUpdateRemoteCluster(context.Background(), &adminservice.AddOrUpdateRemoteClusterRequest{FrontendAddress: rpcAddress})
 	s.Error(err)
 }
@@ -1070,18 +1236,8 @@ func (s *adminHandlerSuite) Test_AddOrUpdateRemoteCluster_SaveClusterMetadata_No
 		nil,
 		serviceerror.NewNotFound("expected empty result"),
 	)
-	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), &persistence.SaveClusterMetadataRequest{
-		ClusterMetadata: persistencespb.ClusterMetadata{
-			ClusterName:              clusterName,
-			HistoryShardCount:        0,
-			ClusterId:                clusterId,
-			ClusterAddress:           rpcAddress,
-			FailoverVersionIncrement: 0,
-			InitialFailoverVersion:   0,
-			IsGlobalNamespaceEnabled: true,
-		},
-		Version: 0,
-	}).Return(false, nil)
+	s.expectListClusterMetadata()
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), gomock.Any()).Return(false, nil)
 	_, err := s.handler.AddOrUpdateRemoteCluster(context.Background(), &adminservice.AddOrUpdateRemoteClusterRequest{FrontendAddress: rpcAddress})
 	s.Error(err)
 	s.IsType(&serviceerror.InvalidArgument{}, err)
@@ -1186,3 +1342,567 @@ func (s *adminHandlerSuite) Test_ListClusters_Success() {
 	s.Equal(1, len(resp.Clusters))
 	s.Equal(0, len(resp.GetNextPageToken()))
 }
+
+func (s *adminHandlerSuite) Test_GetReplicationLag() {
+	now := time.Now().UTC()
+	remoteCluster := "remote"
+	s.mockHistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: []string{remoteCluster},
+		IncludeDetails: true,
+	}).Return(&historyservice.GetReplicationStatusResponse{
+		Shards: []*historyservice.ShardReplicationStatus{
+			{
+				ShardId:              1,
+				MaxReplicationTaskId: 100,
+				ShardLocalTime:       timestamp.TimePtr(now),
+				RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+					remoteCluster: {
+						AckedTaskId:             90,
+						AckedTaskVisibilityTime: timestamp.TimePtr(now.Add(-time.Minute)),
+						DlqSize:                 3,
+						Namespaces: map[string]*replicationspb.NamespaceReplicationLag{
+							s.namespaceID.String(): {PendingTaskCount: 10, OldestPendingTaskTime: timestamp.TimePtr(now.Add(-time.Minute))},
+						},
+					},
+				},
+			},
+			{
+				ShardId:              2,
+				MaxReplicationTaskId: 200,
+				ShardLocalTime:       timestamp.TimePtr(now),
+				RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+					remoteCluster: {
+						AckedTaskId:             150,
+						AckedTaskVisibilityTime: timestamp.TimePtr(now.Add(-time.Hour)),
+						Truncated:               true,
+						Namespaces: map[string]*replicationspb.NamespaceReplicationLag{
+							s.namespaceID.String(): {PendingTaskCount: 50, OldestPendingTaskTime: timestamp.TimePtr(now.Add(-time.Hour))},
+						},
+					},
+				},
+			},
+			{
+				ShardId:              3,
+				MaxReplicationTaskId: 300,
+				ShardLocalTime:       timestamp.TimePtr(now),
+				RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+					remoteCluster: {
+						AckedTaskId:             300,
+						AckedTaskVisibilityTime: timestamp.TimePtr(now.Add(-time.Hour)),
+					},
+				},
+			},
+		},
+	}, nil)
+	s.mockNamespaceCache.EXPECT().GetNamespaceName(s.namespaceID).Return(s.namespace, nil).AnyTimes()
+
+	resp, err := s.handler.GetReplicationLag(context.Background(), &adminservice.GetReplicationLagRequest{
+		RemoteClusters:    []string{remoteCluster},
+		SlowestShardCount: 2,
+	})
+	s.NoError(err)
+	s.Len(resp.RemoteClusters, 1)
+
+	clusterLag := resp.RemoteClusters[remoteCluster]
+	s.Equal(int64(60), clusterLag.GetTotalTaskLag())
+	s.Equal(int64(50), clusterLag.GetMaxTaskLag())
+	s.Equal(time.Hour, timestamp.DurationValue(clusterLag.GetMaxTimeLag()))
+	s.Equal(int64(3), clusterLag.GetDlqSize())
+	s.True(clusterLag.GetTruncated())
+
+	s.Len(clusterLag.GetSlowestShards(), 2)
+	s.Equal(int32(2), clusterLag.GetSlowestShards()[0].GetShardId())
+	s.Equal(int32(1), clusterLag.GetSlowestShards()[1].GetShardId())
+
+	namespaceLag := clusterLag.GetNamespaces()[s.namespace.String()]
+	s.NotNil(namespaceLag)
+	s.Equal(int64(60), namespaceLag.GetPendingTaskCount())
+	s.Equal(now.Add(-time.Hour), timestamp.TimeValue(namespaceLag.GetOldestPendingTaskTime()))
+}
+
+func (s *adminHandlerSuite) Test_UpdateNamespaceDataResidencyPolicy() {
+	namespaceHandler := namespace.NewMockHandler(s.controller)
+	s.handler.namespaceHandler = namespaceHandler
+	namespaceHandler.EXPECT().UpdateDataResidencyPolicy(gomock.Any(), s.namespace.String(), &namespace.DataResidencyPolicy{
+		AllowedClusters:        []string{"eu-1", "eu-2"},
+		AllowedArchivalRegions: []string{"eu-west-1"},
+	}).Return(nil)
+
+	_, err := s.handler.UpdateNamespaceDataResidencyPolicy(context.Background(), &adminservice.UpdateNamespaceDataResidencyPolicyRequest{
+		Namespace:              s.namespace.String(),
+		AllowedClusters:        []string{"eu-1", "eu-2"},
+		AllowedArchivalRegions: []string{"eu-west-1"},
+	})
+	s.NoError(err)
+
+	_, err = s.handler.UpdateNamespaceDataResidencyPolicy(context.Background(), &adminservice.UpdateNamespaceDataResidencyPolicyRequest{})
+	s.Error(err)
+}
+
+func (s *adminHandlerSuite) Test_UpdateNamespaceReplicationFilter() {
+	namespaceHandler := namespace.NewMockHandler(s.controller)
+	s.handler.namespaceHandler = namespaceHandler
+	namespaceHandler.EXPECT().UpdateReplicationFilter(gomock.Any(), s.namespace.String(), "WorkflowType = 'order'").Return(nil)
+
+	_, err := s.handler.UpdateNamespaceReplicationFilter(context.Background(), &adminservice.UpdateNamespaceReplicationFilterRequest{
+		Namespace: s.namespace.String(),
+		Filter:    "WorkflowType = 'order'",
+	})
+	s.NoError(err)
+
+	_, err = s.handler.UpdateNamespaceReplicationFilter(context.Background(), &adminservice.UpdateNamespaceReplicationFilterRequest{})
+	s.Error(err)
+}
+
+func (s *adminHandlerSuite) Test_RenameNamespace() {
+	namespaceHandler := namespace.NewMockHandler(s.controller)
+	s.handler.namespaceHandler = namespaceHandler
+	s.handler.config.NamespaceRenameAliasGracePeriod = dynamicconfig.GetDurationPropertyFn(time.Hour)
+
+	namespaceHandler.EXPECT().RenameNamespace(gomock.Any(), s.namespace.String(), "new-name", time.Hour).Return(nil)
+	s.mockNamespaceCache.EXPECT().Refresh()
+	_, err := s.handler.RenameNamespace(context.Background(), &adminservice.RenameNamespaceRequest{
+		Namespace: s.namespace.String(),
+		NewName:   "new-name",
+	})
+	s.NoError(err)
+
+	namespaceHandler.EXPECT().RenameNamespace(gomock.Any(), s.namespace.String(), "new-name", time.Duration(0)).Return(nil)
+	s.mockNamespaceCache.EXPECT().Refresh()
+	_, err = s.handler.RenameNamespace(context.Background(), &adminservice.RenameNamespaceRequest{
+		Namespace:        s.namespace.String(),
+		NewName:          "new-name",
+		AliasGracePeriod: timestamp.DurationPtr(0),
+	})
+	s.NoError(err)
+
+	_, err = s.handler.RenameNamespace(context.Background(), &adminservice.RenameNamespaceRequest{})
+	s.Error(err)
+}
+
+func (s *adminHandlerSuite) Test_ApiKeys() {
+	namespaceHandler := namespace.NewMockHandler(s.controller)
+	s.handler.namespaceHandler = namespaceHandler
+	s.handler.config.APIKeyRotationGracePeriod = dynamicconfig.GetDurationPropertyFn(time.Hour)
+
+	_, err := s.handler.CreateApiKey(context.Background(), &adminservice.CreateApiKeyRequest{
+		Namespace: s.namespace.String(),
+		Name:      "worker key",
+		Role:      "owner",
+	})
+	s.Error(err)
+
+	var stored *persistencespb.NamespaceApiKey
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).Times(2)
+	namespaceHandler.EXPECT().CreateAPIKey(gomock.Any(), s.namespace.String(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, apiKey *persistencespb.NamespaceApiKey) error {
+			stored = apiKey
+			return nil
+		})
+	s.mockNamespaceCache.EXPECT().Refresh().Times(3)
+	createResp, err := s.handler.CreateApiKey(context.Background(), &adminservice.CreateApiKeyRequest{
+		Namespace: s.namespace.String(),
+		Name:      "worker key",
+		Role:      "Worker",
+	})
+	s.NoError(err)
+	s.Equal(stored.GetId(), createResp.ApiKeyInfo.GetId())
+	s.Equal("worker", stored.GetRole())
+	s.True(strings.HasPrefix(createResp.ApiKey, "tmprl_"+s.namespaceID.String()+"_"+stored.GetId()+"_"))
+	s.NotContains(createResp.ApiKey, stored.GetSecretHash())
+
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String(), ApiKeys: []*persistencespb.NamespaceApiKey{stored}},
+		nil,
+		cluster.TestCurrentClusterName,
+	), nil)
+	listResp, err := s.handler.ListApiKeys(context.Background(), &adminservice.ListApiKeysRequest{Namespace: s.namespace.String()})
+	s.NoError(err)
+	s.Len(listResp.ApiKeyInfos, 1)
+	s.Equal("worker key", listResp.ApiKeyInfos[0].GetName())
+
+	namespaceHandler.EXPECT().RotateAPIKey(gomock.Any(), s.namespace.String(), stored.GetId(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, _ string, secretHash string, previousSecretExpiration time.Time) (*persistencespb.NamespaceApiKey, error) {
+			s.NotEqual(stored.GetSecretHash(), secretHash)
+			s.WithinDuration(time.Now().Add(time.Hour), previousSecretExpiration, time.Minute)
+			return &persistencespb.NamespaceApiKey{Id: stored.GetId(), SecretHash: secretHash}, nil
+		})
+	rotateResp, err := s.handler.RotateApiKey(context.Background(), &adminservice.RotateApiKeyRequest{
+		Namespace: s.namespace.String(),
+		Id:        stored.GetId(),
+	})
+	s.NoError(err)
+	s.NotEqual(createResp.ApiKey, rotateResp.ApiKey)
+
+	namespaceHandler.EXPECT().RevokeAPIKey(gomock.Any(), s.namespace.String(), stored.GetId()).Return(nil)
+	_, err = s.handler.RevokeApiKey(context.Background(), &adminservice.RevokeApiKeyRequest{
+		Namespace: s.namespace.String(),
+		Id:        stored.GetId(),
+	})
+	s.NoError(err)
+
+	_, err = s.handler.RevokeApiKey(context.Background(), &adminservice.RevokeApiKeyRequest{Namespace: s.namespace.String()})
+	s.Equal(errAPIKeyIDNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_StartNamespaceHandover() {
+	s.mockMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+
+	_, err := s.handler.StartNamespaceHandover(context.Background(), &adminservice.StartNamespaceHandoverRequest{
+		Namespace:     s.namespace.String(),
+		RemoteCluster: cluster.TestCurrentClusterName,
+	})
+	s.Equal(errHandoverToCurrentCluster, err)
+
+	_, err = s.handler.StartNamespaceHandover(context.Background(), &adminservice.StartNamespaceHandoverRequest{
+		Namespace:     s.namespace.String(),
+		RemoteCluster: "unknown-cluster",
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	mockSdkClient := &sdkmocks.Client{}
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient(gomock.Any()).Return(mockSdkClient)
+	mockRun := &sdkmocks.WorkflowRun{}
+	mockRun.On("GetID").Return("namespace-handover/" + s.namespace.String())
+	mockRun.On("GetRunID").Return("run-id")
+	mockSdkClient.On(
+		"ExecuteWorkflow",
+		mock.Anything,
+		sdkclient.StartWorkflowOptions{
+			TaskQueue:                                worker.DefaultWorkerTaskQueue,
+			ID:                                       "namespace-handover/" + s.namespace.String(),
+			WorkflowExecutionErrorWhenAlreadyStarted: true,
+		},
+		migration.NamespaceHandoverWorkflowName,
+		migration.NamespaceHandoverParams{
+			Namespace:              s.namespace.String(),
+			RemoteCluster:          cluster.TestAlternativeClusterName,
+			AllowedLaggingSeconds:  60,
+			HandoverTimeoutSeconds: 300,
+			DryRun:                 true,
+		},
+	).Return(mockRun, nil)
+
+	resp, err := s.handler.StartNamespaceHandover(context.Background(), &adminservice.StartNamespaceHandoverRequest{
+		Namespace:             s.namespace.String(),
+		RemoteCluster:         cluster.TestAlternativeClusterName,
+		AllowedReplicationLag: timestamp.DurationPtr(time.Minute),
+		HandoverTimeout:       timestamp.DurationPtr(5 * time.Minute),
+		DryRun:                true,
+	})
+	s.NoError(err)
+	s.Equal("run-id", resp.GetRunId())
+	mockSdkClient.AssertExpectations(s.T())
+}
+
+func (s *adminHandlerSuite) Test_SyncSearchAttributes() {
+	s.mockMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+	s.mockMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(true).AnyTimes()
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("", true).Return(searchattribute.TestNameTypeMap, nil).AnyTimes()
+	s.mockResource.RemoteAdminClient.EXPECT().GetSearchAttributes(gomock.Any(), &adminservice.GetSearchAttributesRequest{}).Return(&adminservice.GetSearchAttributesResponse{
+		CustomAttributes: map[string]enumspb.IndexedValueType{
+			"CustomIntField":      enumspb.INDEXED_VALUE_TYPE_INT,
+			"CustomTextField":     enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			"CustomKeywordField":  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			"CustomDatetimeField": enumspb.INDEXED_VALUE_TYPE_DATETIME,
+			"CustomDoubleField":   enumspb.INDEXED_VALUE_TYPE_DOUBLE,
+			"RemoteKeywordField":  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		},
+	}, nil).Times(2)
+
+	_, err := s.handler.SyncSearchAttributes(context.Background(), &adminservice.SyncSearchAttributesRequest{
+		RemoteCluster: cluster.TestCurrentClusterName,
+	})
+	s.Equal(errSyncSearchAttributesWithCurrentCluster, err)
+
+	expectedResp := &adminservice.SyncSearchAttributesResponse{
+		MissingInCurrentCluster: map[string]enumspb.IndexedValueType{
+			"RemoteKeywordField": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		},
+		MissingInRemoteCluster: map[string]enumspb.IndexedValueType{
+			"CustomBoolField": enumspb.INDEXED_VALUE_TYPE_BOOL,
+		},
+		Conflicts: []*replicationspb.SearchAttributeConflict{{
+			Name:               "CustomTextField",
+			CurrentClusterType: enumspb.INDEXED_VALUE_TYPE_TEXT,
+			RemoteClusterType:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		}},
+	}
+
+	// dry run only reports the drift
+	resp, err := s.handler.SyncSearchAttributes(context.Background(), &adminservice.SyncSearchAttributesRequest{
+		RemoteCluster: cluster.TestAlternativeClusterName,
+		DryRun:        true,
+	})
+	s.NoError(err)
+	s.Equal(expectedResp, resp)
+
+	s.mockProducer.EXPECT().Publish(gomock.Any(), &replicationspb.ReplicationTask{
+		TaskType: enumsspb.REPLICATION_TASK_TYPE_SEARCH_ATTRIBUTES_TASK,
+		Attributes: &replicationspb.ReplicationTask_SearchAttributesTaskAttributes{
+			SearchAttributesTaskAttributes: &replicationspb.SearchAttributesTaskAttributes{
+				CustomSearchAttributes: expectedResp.MissingInRemoteCluster,
+			},
+		},
+	}).Return(nil)
+	mockSdkClient := &sdkmocks.Client{}
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient(gomock.Any()).Return(mockSdkClient)
+	mockRun := &sdkmocks.WorkflowRun{}
+	mockRun.On("Get", mock.Anything, nil).Return(nil)
+	mockSdkClient.On(
+		"ExecuteWorkflow",
+		mock.Anything,
+		sdkclient.StartWorkflowOptions{
+			TaskQueue: worker.DefaultWorkerTaskQueue,
+			ID:        addsearchattributes.WorkflowName,
+		},
+		addsearchattributes.WorkflowName,
+		addsearchattributes.WorkflowParams{
+			CustomAttributesToAdd: expectedResp.MissingInCurrentCluster,
+		},
+	).Return(mockRun, nil)
+
+	resp, err = s.handler.SyncSearchAttributes(context.Background(), &adminservice.SyncSearchAttributesRequest{
+		RemoteCluster: cluster.TestAlternativeClusterName,
+	})
+	s.NoError(err)
+	s.Equal(expectedResp, resp)
+	mockSdkClient.AssertExpectations(s.T())
+	mockRun.AssertExpectations(s.T())
+}
+
+func (s *adminHandlerSuite) Test_PurgeDLQMessagesAllShards() {
+	s.handler.numberOfHistoryShards = 2
+	s.mockMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(namespace.Name(s.namespace)).Return(s.namespaceID, nil).AnyTimes()
+
+	_, err := s.handler.PurgeDLQMessagesAllShards(context.Background(), &adminservice.PurgeDLQMessagesAllShardsRequest{})
+	s.Equal(errClusterNameNotSet, err)
+
+	_, err = s.handler.PurgeDLQMessagesAllShards(context.Background(), &adminservice.PurgeDLQMessagesAllShardsRequest{
+		SourceCluster: cluster.TestAlternativeClusterName,
+		TaskType:      enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK,
+	})
+	s.Equal(errInvalidDLQTaskType, err)
+
+	filter := &replicationspb.ReplicationDLQFilter{
+		NamespaceId: s.namespaceID.String(),
+		WorkflowId:  "some random workflow ID",
+	}
+	pageToken := []byte("some random token")
+	s.mockHistoryClient.EXPECT().PurgeDLQMessages(gomock.Any(), &historyservice.PurgeDLQMessagesRequest{
+		Type:                  enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION,
+		ShardId:               1,
+		SourceCluster:         cluster.TestAlternativeClusterName,
+		InclusiveEndMessageId: common.EndMessageID,
+		Filter:                filter,
+		DryRun:                true,
+		MaximumPageSize:       common.ReadDLQMessagesPageSize,
+	}).Return(&historyservice.PurgeDLQMessagesResponse{MessageCount: 2, NextPageToken: pageToken}, nil)
+	s.mockHistoryClient.EXPECT().PurgeDLQMessages(gomock.Any(), &historyservice.PurgeDLQMessagesRequest{
+		Type:                  enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION,
+		ShardId:               1,
+		SourceCluster:         cluster.TestAlternativeClusterName,
+		InclusiveEndMessageId: common.EndMessageID,
+		Filter:                filter,
+		DryRun:                true,
+		MaximumPageSize:       common.ReadDLQMessagesPageSize,
+		NextPageToken:         pageToken,
+	}).Return(&historyservice.PurgeDLQMessagesResponse{MessageCount: 1}, nil)
+	s.mockHistoryClient.EXPECT().PurgeDLQMessages(gomock.Any(), &historyservice.PurgeDLQMessagesRequest{
+		Type:                  enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION,
+		ShardId:               2,
+		SourceCluster:         cluster.TestAlternativeClusterName,
+		InclusiveEndMessageId: common.EndMessageID,
+		Filter:                filter,
+		DryRun:                true,
+		MaximumPageSize:       common.ReadDLQMessagesPageSize,
+	}).Return(nil, serviceerror.NewUnavailable("random error"))
+
+	resp, err := s.handler.PurgeDLQMessagesAllShards(context.Background(), &adminservice.PurgeDLQMessagesAllShardsRequest{
+		SourceCluster: cluster.TestAlternativeClusterName,
+		Namespace:     s.namespace.String(),
+		WorkflowId:    "some random workflow ID",
+		DryRun:        true,
+	})
+	s.NoError(err)
+	s.Equal(&adminservice.PurgeDLQMessagesAllShardsResponse{
+		MessageCount: 3,
+		Shards: []*replicationspb.DLQShardResult{
+			{ShardId: 1, MessageCount: 3},
+			{ShardId: 2, Error: "random error"},
+		},
+	}, resp)
+}
+
+func (s *adminHandlerSuite) Test_VerifyWorkflowReplication() {
+	namespaceEntry := namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+		nil,
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters:          []string{cluster.TestCurrentClusterName, cluster.TestAlternativeClusterName},
+		},
+		1,
+	)
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	newMutableState := func(lastEventID int64) *persistencespb.WorkflowMutableState {
+		return &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				VersionHistories: versionhistory.NewVersionHistories(versionhistory.NewVersionHistory(
+					[]byte(uuid.New()),
+					[]*historyspb.VersionHistoryItem{versionhistory.NewVersionHistoryItem(lastEventID, 1)},
+				)),
+			},
+			ExecutionState: &persistencespb.WorkflowExecutionState{
+				RunId:  execution.GetRunId(),
+				Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			},
+			NextEventId: lastEventID + 1,
+		}
+	}
+
+	s.mockMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespaceEntry, nil).Times(2)
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), &historyservice.DescribeMutableStateRequest{
+		NamespaceId: s.namespaceID.String(),
+		Execution:   execution,
+	}).Return(&historyservice.DescribeMutableStateResponse{
+		DatabaseMutableState: newMutableState(10),
+	}, nil).Times(2)
+	s.mockResource.RemoteAdminClient.EXPECT().DescribeMutableState(gomock.Any(), &adminservice.DescribeMutableStateRequest{
+		Namespace: s.namespace.String(),
+		Execution: execution,
+	}).Return(&adminservice.DescribeMutableStateResponse{
+		DatabaseMutableState: newMutableState(8),
+	}, nil).Times(2)
+
+	expectedStates := []*replicationspb.WorkflowReplicationState{
+		{
+			ClusterName:           cluster.TestCurrentClusterName,
+			CurrentVersionHistory: versionhistory.NewVersionHistory(nil, []*historyspb.VersionHistoryItem{versionhistory.NewVersionHistoryItem(10, 1)}),
+			LastEventId:           10,
+			Status:                enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			Consistent:            true,
+		},
+		{
+			ClusterName:           cluster.TestAlternativeClusterName,
+			CurrentVersionHistory: versionhistory.NewVersionHistory(nil, []*historyspb.VersionHistoryItem{versionhistory.NewVersionHistoryItem(8, 1)}),
+			LastEventId:           8,
+			Status:                enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+	}
+	resp, err := s.handler.VerifyWorkflowReplication(context.Background(), &adminservice.VerifyWorkflowReplicationRequest{
+		Namespace:  s.namespace.String(),
+		Execution:  execution,
+		SkipResend: true,
+	})
+	s.NoError(err)
+	s.Equal(&adminservice.VerifyWorkflowReplicationResponse{
+		Consistent:    false,
+		ActiveCluster: cluster.TestCurrentClusterName,
+		ClusterStates: expectedStates,
+	}, resp)
+
+	s.mockResource.RemoteAdminClient.EXPECT().ResendReplicationTasks(gomock.Any(), &adminservice.ResendReplicationTasksRequest{
+		NamespaceId:   s.namespaceID.String(),
+		WorkflowId:    execution.GetWorkflowId(),
+		RunId:         execution.GetRunId(),
+		RemoteCluster: cluster.TestCurrentClusterName,
+		StartVersion:  common.EmptyVersion,
+	}).Return(&adminservice.ResendReplicationTasksResponse{}, nil)
+
+	expectedStates[1].Resent = true
+	resp, err = s.handler.VerifyWorkflowReplication(context.Background(), &adminservice.VerifyWorkflowReplicationRequest{
+		Namespace: s.namespace.String(),
+		Execution: execution,
+	})
+	s.NoError(err)
+	s.Equal(&adminservice.VerifyWorkflowReplicationResponse{
+		Consistent:    false,
+		ActiveCluster: cluster.TestCurrentClusterName,
+		ClusterStates: expectedStates,
+	}, resp)
+}
+
+func (s *adminHandlerSuite) Test_ReportNamespaceQuotaUsage() {
+	s.handler.config.EnableGlobalNamespaceRPSCoordination = dynamicconfig.GetBoolPropertyFn(false)
+	s.handler.config.GlobalNamespaceRPSReportInterval = dynamicconfig.GetDurationPropertyFn(5 * time.Second)
+	s.handler.config.GlobalNamespaceRPS = dynamicconfig.GetIntPropertyFilteredByNamespace(100)
+
+	_, err := s.handler.ReportNamespaceQuotaUsage(context.Background(), &adminservice.ReportNamespaceQuotaUsageRequest{})
+	s.Error(err)
+
+	_, err = s.handler.ReportNamespaceQuotaUsage(context.Background(), &adminservice.ReportNamespaceQuotaUsageRequest{
+		HostAddress:  "127.0.0.1:7233",
+		NamespaceRps: map[string]float64{s.namespace.String(): 10},
+	})
+	s.IsType(&serviceerror.Unavailable{}, err)
+
+	s.handler.config.EnableGlobalNamespaceRPSCoordination = dynamicconfig.GetBoolPropertyFn(true)
+	s.mockResource.FrontendServiceResolver.EXPECT().MemberCount().Return(1)
+	resp, err := s.handler.ReportNamespaceQuotaUsage(context.Background(), &adminservice.ReportNamespaceQuotaUsageRequest{
+		HostAddress:  "127.0.0.1:7233",
+		NamespaceRps: map[string]float64{s.namespace.String(): 10},
+	})
+	s.NoError(err)
+	s.Equal(map[string]float64{s.namespace.String(): 100}, resp.GetNamespaceRps())
+}
+
+func (s *adminHandlerSuite) Test_ListPollerCounts() {
+	selfAddress := "10.0.0.1:7233"
+	remoteAddress := "10.0.0.2:7233"
+	remotePollerCount := &adminservice.PollerCount{
+		HostAddress:   remoteAddress,
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "some random task queue",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		Count:         2,
+	}
+
+	s.mockResource.MembershipMonitor.EXPECT().WhoAmI().Return(membership.NewHostInfo(selfAddress, nil), nil).Times(2)
+	var resp *adminservice.ListPollerCountsResponse
+	_, err := s.handler.pollerCounter.Intercept(
+		context.Background(),
+		&workflowservice.PollWorkflowTaskQueueRequest{
+			Namespace: s.namespace.String(),
+			TaskQueue: &taskqueuepb.TaskQueue{Name: "some random task queue"},
+		},
+		&grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/PollWorkflowTaskQueue"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			var err error
+			resp, err = s.handler.ListPollerCounts(context.Background(), &adminservice.ListPollerCountsRequest{
+				Namespace: s.namespace.String(),
+			})
+			s.NoError(err)
+			return nil, nil
+		},
+	)
+	s.NoError(err)
+	s.Equal([]*adminservice.PollerCount{
+		{HostAddress: selfAddress, Namespace: s.namespace.String(), Count: 1},
+		{HostAddress: selfAddress, Namespace: s.namespace.String(), TaskQueue: "some random task queue", TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW, Count: 1},
+	}, resp.GetPollerCounts())
+
+	s.mockResource.FrontendServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{
+		membership.NewHostInfo(selfAddress, nil),
+		membership.NewHostInfo(remoteAddress, nil),
+	})
+	s.mockClientFactory.EXPECT().NewAdminClientWithTimeout(remoteAddress, gomock.Any(), gomock.Any()).Return(s.mockAdminClient)
+	s.mockAdminClient.EXPECT().ListPollerCounts(gomock.Any(), &adminservice.ListPollerCountsRequest{
+		Namespace: s.namespace.String(),
+	}).Return(&adminservice.ListPollerCountsResponse{
+		PollerCounts: []*adminservice.PollerCount{remotePollerCount},
+	}, nil)
+	resp, err = s.handler.ListPollerCounts(context.Background(), &adminservice.ListPollerCountsRequest{
+		Namespace: s.namespace.String(),
+		AllHosts:  true,
+	})
+	s.NoError(err)
+	s.Equal([]*adminservice.PollerCount{remotePollerCount}, resp.GetPollerCounts())
+}
@@ -0,0 +1,58 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/namespace"
+)
+
+type (
+	// namespaceAPIKeyStore looks up API keys in the namespace registry, which caches the persisted namespaces
+	namespaceAPIKeyStore struct {
+		namespaceRegistry namespace.Registry
+	}
+)
+
+var _ authorization.APIKeyStore = (*namespaceAPIKeyStore)(nil)
+
+func newNamespaceAPIKeyStore(namespaceRegistry namespace.Registry) *namespaceAPIKeyStore {
+	return &namespaceAPIKeyStore{namespaceRegistry: namespaceRegistry}
+}
+
+func (s *namespaceAPIKeyStore) GetAPIKey(namespaceID string, id string) (string, *persistencespb.NamespaceApiKey, error) {
+	ns, err := s.namespaceRegistry.GetNamespaceByID(namespace.ID(namespaceID))
+	if err != nil {
+		return "", nil, err
+	}
+	apiKey := ns.APIKey(id)
+	if apiKey == nil {
+		return "", nil, serviceerror.NewNotFound("API key not found.")
+	}
+	return ns.Name().String(), apiKey, nil
+}
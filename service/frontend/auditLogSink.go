@@ -0,0 +1,182 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	sdkclient "go.temporal.io/sdk/client"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/sdk"
+	"go.temporal.io/server/service/worker"
+	"go.temporal.io/server/service/worker/auditlog"
+)
+
+const (
+	systemNamespaceAuditSinkBufferSize    = 1000
+	systemNamespaceAuditSinkSignalTimeout = 10 * time.Second
+	systemNamespaceAuditSinkCloseTimeout  = 5 * time.Second
+)
+
+var (
+	errAuditSinkBufferFull = errors.New("audit record buffer is full")
+	errAuditSinkClosed     = errors.New("audit sink is closed")
+)
+
+type (
+	// systemNamespaceAuditSink signals audit records to the audit log system workflows in the temporal-system namespace.
+	// Records are sent asynchronously so that API calls don't wait on the system workflows.
+	systemNamespaceAuditSink struct {
+		sdkClientFactory sdk.ClientFactory
+		metricsClient    metrics.Client
+		logger           log.Logger
+		retryPolicy      backoff.RetryPolicy
+
+		records    chan *authorization.AuditRecord
+		shutdownCh chan struct{}
+		doneCh     chan struct{}
+	}
+)
+
+var _ authorization.AuditSink = (*systemNamespaceAuditSink)(nil)
+
+func newSystemNamespaceAuditSink(
+	sdkClientFactory sdk.ClientFactory,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *systemNamespaceAuditSink {
+	retryPolicy := backoff.NewExponentialRetryPolicy(100 * time.Millisecond)
+	retryPolicy.SetMaximumInterval(5 * time.Second)
+	retryPolicy.SetExpirationInterval(time.Minute)
+
+	s := &systemNamespaceAuditSink{
+		sdkClientFactory: sdkClientFactory,
+		metricsClient:    metricsClient,
+		logger:           logger,
+		retryPolicy:      retryPolicy,
+		records:          make(chan *authorization.AuditRecord, systemNamespaceAuditSinkBufferSize),
+		shutdownCh:       make(chan struct{}),
+		doneCh:           make(chan struct{}),
+	}
+	go s.sendLoop()
+	return s
+}
+
+func (s *systemNamespaceAuditSink) Write(record *authorization.AuditRecord) error {
+	// without system admin claims the signals of this sink are audited as well, sending
+	// their records back to the audit log workflows would never end
+	if isAuditLogSignal(record) {
+		return nil
+	}
+
+	select {
+	case <-s.shutdownCh:
+		return errAuditSinkClosed
+	default:
+	}
+
+	select {
+	case s.records <- record:
+		return nil
+	default:
+		s.metricsClient.IncCounter(metrics.AuditLogScope, metrics.AuditRecordsDropped)
+		return errAuditSinkBufferFull
+	}
+}
+
+func (s *systemNamespaceAuditSink) Close() error {
+	close(s.shutdownCh)
+	select {
+	case <-s.doneCh:
+	case <-time.After(systemNamespaceAuditSinkCloseTimeout):
+	}
+	return nil
+}
+
+func (s *systemNamespaceAuditSink) sendLoop() {
+	defer close(s.doneCh)
+	for {
+		select {
+		case <-s.shutdownCh:
+			if pending := len(s.records); pending > 0 {
+				s.metricsClient.AddCounter(metrics.AuditLogScope, metrics.AuditRecordsDropped, int64(pending))
+				s.logger.Warn("Audit records were not sent to the system namespace before shutdown.", tag.Counter(pending))
+			}
+			return
+		case record := <-s.records:
+			op := func() error {
+				return s.signal(record)
+			}
+			if err := backoff.Retry(op, s.retryPolicy, nil); err != nil {
+				s.metricsClient.IncCounter(metrics.AuditLogScope, metrics.AuditRecordsDropped)
+				s.logger.Error("Unable to send audit record to the system namespace.",
+					tag.NewAnyTag("audit-record", record),
+					tag.Error(err),
+				)
+			}
+		}
+	}
+}
+
+func (s *systemNamespaceAuditSink) signal(record *authorization.AuditRecord) error {
+	ctx, cancel := context.WithTimeout(context.Background(), systemNamespaceAuditSinkSignalTimeout)
+	defer cancel()
+	// the signal goes through the frontend, mark it so that the audit interceptor does not audit it again
+	ctx = headers.SetAuditLogCall(ctx)
+
+	workflowID := auditlog.WorkflowID(record.Time)
+	_, err := s.sdkClientFactory.GetSystemClient(s.logger).SignalWithStartWorkflow(
+		ctx,
+		workflowID,
+		auditlog.SignalName,
+		record,
+		sdkclient.StartWorkflowOptions{
+			ID:        workflowID,
+			TaskQueue: worker.DefaultWorkerTaskQueue,
+		},
+		auditlog.WorkflowName,
+		auditlog.WorkflowParams{
+			WindowEnd: record.Time.UTC().Truncate(auditlog.RecordWindow).Add(auditlog.RecordWindow),
+		},
+	)
+	return err
+}
+
+// isAuditLogSignal returns true for the record of a signal to the audit log workflows.
+func isAuditLogSignal(record *authorization.AuditRecord) bool {
+	return record.API == "SignalWithStartWorkflowExecution" &&
+		record.Namespace == common.SystemLocalNamespace &&
+		strings.HasPrefix(record.WorkflowID, auditlog.WorkflowName+"-")
+}
@@ -0,0 +1,88 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	sdkclient "go.temporal.io/sdk/client"
+	sdkmocks "go.temporal.io/sdk/mocks"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/sdk"
+	"go.temporal.io/server/service/worker"
+	"go.temporal.io/server/service/worker/auditlog"
+)
+
+func TestSystemNamespaceAuditSink(t *testing.T) {
+	s := require.New(t)
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockSdkClient := &sdkmocks.Client{}
+	defer mockSdkClient.AssertExpectations(t)
+	mockClientFactory := sdk.NewMockClientFactory(controller)
+	mockClientFactory.EXPECT().GetSystemClient(gomock.Any()).Return(mockSdkClient).AnyTimes()
+
+	recordTime := time.Date(2022, 5, 4, 13, 45, 12, 0, time.UTC)
+	record := &authorization.AuditRecord{Time: recordTime, Subject: "alice", API: "TerminateWorkflowExecution"}
+	workflowID := "temporal-sys-audit-log-workflow-2022-05-04T13"
+	signalled := make(chan struct{})
+	mockSdkClient.On(
+		"SignalWithStartWorkflow",
+		mock.MatchedBy(headers.IsAuditLogCall),
+		workflowID,
+		auditlog.SignalName,
+		record,
+		sdkclient.StartWorkflowOptions{ID: workflowID, TaskQueue: worker.DefaultWorkerTaskQueue},
+		auditlog.WorkflowName,
+		auditlog.WorkflowParams{WindowEnd: time.Date(2022, 5, 4, 14, 0, 0, 0, time.UTC)},
+	).Return(nil, nil).Once().Run(func(mock.Arguments) { close(signalled) })
+
+	sink := newSystemNamespaceAuditSink(mockClientFactory, metrics.NoopClient, log.NewNoopLogger())
+	// records of the sink's own signals are not sent back to the audit log workflows
+	s.NoError(sink.Write(&authorization.AuditRecord{
+		Time:       recordTime,
+		API:        "SignalWithStartWorkflowExecution",
+		Namespace:  common.SystemLocalNamespace,
+		WorkflowID: workflowID,
+	}))
+	s.NoError(sink.Write(record))
+	select {
+	case <-signalled:
+	case <-time.After(5 * time.Second):
+		s.Fail("audit record was not signalled")
+	}
+	s.NoError(sink.Close())
+	s.Error(sink.Write(record))
+}
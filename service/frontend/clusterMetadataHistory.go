@@ -0,0 +1,222 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	clusterMetadataHistoryMaxSize       = 100
+	clusterMetadataListPageSize         = 100
+	clusterMetadataHistoryMaxAttempts   = 3
+	clusterMetadataOperationAddOrUpdate = "AddOrUpdateRemoteCluster"
+	clusterMetadataOperationRemove      = "RemoveRemoteCluster"
+	clusterMetadataOperationRollback    = "RollbackClusterMetadata"
+)
+
+// newClusterMetadataChange creates a change to be recorded in the cluster metadata history.
+// Identity falls back to the subject of the caller's claims if not provided in the request.
+func newClusterMetadataChange(
+	ctx context.Context,
+	identity string,
+	operation string,
+	clusterName string,
+	previousMetadata *persistencespb.ClusterMetadata,
+	newMetadata *persistencespb.ClusterMetadata,
+) *persistencespb.ClusterMetadataChange {
+	if identity == "" {
+		if claims, ok := ctx.Value(authorization.MappedClaims).(*authorization.Claims); ok && claims != nil {
+			identity = claims.Subject
+		}
+	}
+	return &persistencespb.ClusterMetadataChange{
+		ChangeTime:       timestamp.TimePtr(time.Now().UTC()),
+		Identity:         identity,
+		Operation:        operation,
+		ClusterName:      clusterName,
+		PreviousMetadata: clusterMetadataSnapshot(previousMetadata),
+		NewMetadata:      clusterMetadataSnapshot(newMetadata),
+	}
+}
+
+// clusterMetadataSnapshot returns a copy of cluster metadata without the change history.
+func clusterMetadataSnapshot(metadata *persistencespb.ClusterMetadata) *persistencespb.ClusterMetadata {
+	if metadata == nil {
+		return nil
+	}
+	snapshot := *metadata
+	snapshot.ChangeHistory = nil
+	return &snapshot
+}
+
+// getClusterMetadataIfExists returns nil if there is no metadata for the cluster.
+func (adh *AdminHandler) getClusterMetadataIfExists(
+	ctx context.Context,
+	clusterName string,
+) (*persistence.GetClusterMetadataResponse, error) {
+	resp, err := adh.clusterMetadataManager.GetClusterMetadata(
+		ctx,
+		&persistence.GetClusterMetadataRequest{ClusterName: clusterName},
+	)
+	switch err.(type) {
+	case nil:
+		return resp, nil
+	case *serviceerror.NotFound:
+		return nil, nil
+	default:
+		return nil, err
+	}
+}
+
+// listClusterMetadataHistory returns the changes kept in all cluster metadata records, from the oldest to the newest.
+func (adh *AdminHandler) listClusterMetadataHistory(
+	ctx context.Context,
+) ([]*persistencespb.ClusterMetadataChange, error) {
+	var history []*persistencespb.ClusterMetadataChange
+	var nextPageToken []byte
+	for {
+		resp, err := adh.clusterMetadataManager.ListClusterMetadata(ctx, &persistence.ListClusterMetadataRequest{
+			PageSize:      clusterMetadataListPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, clusterData := range resp.ClusterMetadata {
+			history = append(history, clusterData.GetChangeHistory()...)
+		}
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+	sort.SliceStable(history, func(i, j int) bool {
+		return timestamp.TimeValue(history[i].GetChangeTime()).Before(timestamp.TimeValue(history[j].GetChangeTime()))
+	})
+	return history, nil
+}
+
+// nextClusterMetadataChangeVersion returns the version of the next change of the cluster.
+func nextClusterMetadataChangeVersion(
+	history []*persistencespb.ClusterMetadataChange,
+	clusterName string,
+) int64 {
+	var version int64
+	for _, change := range history {
+		if change.GetClusterName() == clusterName && change.GetVersion() > version {
+			version = change.GetVersion()
+		}
+	}
+	return version + 1
+}
+
+// appendClusterMetadataChange appends changes to the history and drops the oldest ones above the size limit.
+func appendClusterMetadataChange(
+	history []*persistencespb.ClusterMetadataChange,
+	changes ...*persistencespb.ClusterMetadataChange,
+) []*persistencespb.ClusterMetadataChange {
+	history = append(append([]*persistencespb.ClusterMetadataChange(nil), history...), changes...)
+	if len(history) > clusterMetadataHistoryMaxSize {
+		history = history[len(history)-clusterMetadataHistoryMaxSize:]
+	}
+	return history
+}
+
+// saveClusterMetadataWithChange saves the metadata of a cluster together with the change which leads to it,
+// so that the history can't miss an applied change.
+func (adh *AdminHandler) saveClusterMetadataWithChange(
+	ctx context.Context,
+	clusterData *persistence.GetClusterMetadataResponse,
+	metadata persistencespb.ClusterMetadata,
+	change *persistencespb.ClusterMetadataChange,
+) (bool, error) {
+	var updateRequestVersion int64
+	var history []*persistencespb.ClusterMetadataChange
+	if clusterData != nil {
+		updateRequestVersion = clusterData.Version
+		history = clusterData.GetChangeHistory()
+	}
+	metadata.ChangeHistory = appendClusterMetadataChange(history, change)
+	return adh.clusterMetadataManager.SaveClusterMetadata(ctx, &persistence.SaveClusterMetadataRequest{
+		ClusterMetadata: metadata,
+		Version:         updateRequestVersion,
+	})
+}
+
+// recordClusterMetadataRemoval moves the history of a removed cluster, together with the removal, to the record of
+// the current cluster. The removed record can't keep it, so this is done right after the record is deleted.
+func (adh *AdminHandler) recordClusterMetadataRemoval(
+	ctx context.Context,
+	clusterData *persistence.GetClusterMetadataResponse,
+	change *persistencespb.ClusterMetadataChange,
+) error {
+	var removedHistory []*persistencespb.ClusterMetadataChange
+	if clusterData != nil {
+		removedHistory = clusterData.GetChangeHistory()
+	}
+
+	var err error
+	for attempt := 0; attempt < clusterMetadataHistoryMaxAttempts; attempt++ {
+		var current *persistence.GetClusterMetadataResponse
+		current, err = adh.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+		if err != nil {
+			return err
+		}
+
+		metadata := current.ClusterMetadata
+		metadata.ChangeHistory = appendClusterMetadataChange(
+			appendClusterMetadataChange(metadata.GetChangeHistory(), removedHistory...),
+			change,
+		)
+
+		var applied bool
+		applied, err = adh.clusterMetadataManager.SaveClusterMetadata(ctx, &persistence.SaveClusterMetadataRequest{
+			ClusterMetadata: metadata,
+			Version:         current.Version,
+		})
+		switch err.(type) {
+		case nil:
+			if applied {
+				return nil
+			}
+			err = errClusterMetadataChangeConflict
+		case *serviceerror.Unavailable:
+			// conditional update failed, retry with the latest record
+		default:
+			return err
+		}
+	}
+	return err
+}
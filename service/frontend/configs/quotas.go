@@ -108,6 +108,45 @@ var (
 	OtherAPIPriorities = map[int]struct{}{
 		0: {},
 	}
+
+	// LoadSheddingAPIToPriority defines the order in which APIs are shed while the backend is unhealthy,
+	// the highest priority value is shed first. Priority 0 and APIs not listed here, e.g. starts and signals,
+	// are never shed.
+	LoadSheddingAPIToPriority = map[string]int{
+		// priority 0, shedding completions only adds retries and timeouts of work which is already in flight
+		"RecordActivityTaskHeartbeat":      0,
+		"RecordActivityTaskHeartbeatById":  0,
+		"RespondActivityTaskCanceled":      0,
+		"RespondActivityTaskCanceledById":  0,
+		"RespondActivityTaskFailed":        0,
+		"RespondActivityTaskFailedById":    0,
+		"RespondActivityTaskCompleted":     0,
+		"RespondActivityTaskCompletedById": 0,
+		"RespondWorkflowTaskCompleted":     0,
+		"RespondWorkflowTaskFailed":        0,
+		"RespondQueryTaskCompleted":        0,
+
+		// priority 1
+		"ResetWorkflowExecution":             1,
+		"DescribeWorkflowExecution":          1,
+		"QueryWorkflow":                      1,
+		"PollWorkflowTaskQueue":              1,
+		"PollActivityTaskQueue":              1,
+		"GetWorkflowExecutionHistoryReverse": 1,
+
+		// priority 2
+		"ResetStickyTaskQueue":           2,
+		"DescribeTaskQueue":              2,
+		"ListTaskQueuePartitions":        2,
+		"CountWorkflowExecutions":        2,
+		"ScanWorkflowExecutions":         2,
+		"ListOpenWorkflowExecutions":     2,
+		"ListClosedWorkflowExecutions":   2,
+		"ListWorkflowExecutions":         2,
+		"ListArchivedWorkflowExecutions": 2,
+	}
+
+	LoadSheddingMaxPriority = 2
 )
 
 type (
@@ -194,3 +194,32 @@ func (s *quotasSuite) TestAllAPIs() {
 	}
 	s.Equal(expectedAPIs, actualAPIs)
 }
+
+func (s *quotasSuite) TestLoadSheddingAPIToPriorityMapping() {
+	var service workflowservice.WorkflowServiceServer
+	t := reflect.TypeOf(&service).Elem()
+	for api, priority := range LoadSheddingAPIToPriority {
+		_, ok := t.MethodByName(api)
+		s.True(ok, api)
+		s.True(priority >= 0 && priority <= LoadSheddingMaxPriority, api)
+	}
+
+	for _, api := range []string{
+		"RecordActivityTaskHeartbeat",
+		"RespondActivityTaskCompleted",
+		"RespondWorkflowTaskCompleted",
+	} {
+		s.Equal(0, LoadSheddingAPIToPriority[api], api)
+	}
+
+	for _, api := range []string{
+		"StartWorkflowExecution",
+		"SignalWithStartWorkflowExecution",
+		"SignalWorkflowExecution",
+		"RequestCancelWorkflowExecution",
+		"TerminateWorkflowExecution",
+	} {
+		s.NotContains(LoadSheddingAPIToPriority, api)
+	}
+	s.Greater(LoadSheddingAPIToPriority["ListWorkflowExecutions"], LoadSheddingAPIToPriority["PollWorkflowTaskQueue"])
+}
@@ -70,6 +70,19 @@ var (
 	errDLQTypeIsNotSupported                              = serviceerror.NewInvalidArgument("The DLQ type is not supported.")
 	errFailureMustHaveApplicationFailureInfo              = serviceerror.NewInvalidArgument("Failure must have ApplicationFailureInfo.")
 	errStatusFilterMustBeNotRunning                       = serviceerror.NewInvalidArgument("StatusFilter must be specified and must be not Running.")
+	errHandoverToCurrentCluster                           = serviceerror.NewInvalidArgument("Namespace cannot be handed over to current cluster.")
+	errReplicationTokenNotSet                             = serviceerror.NewInvalidArgument("Replication token is not set on request.")
+	errReplicationStreamShardMismatch                     = serviceerror.NewInvalidArgument("All requests of a replication stream must be for the same shard.")
+	errSyncSearchAttributesWithCurrentCluster             = serviceerror.NewInvalidArgument("Search attributes cannot be synced with current cluster.")
+	errGlobalNamespaceNotEnabled                          = serviceerror.NewInvalidArgument("Global namespace is not enabled for cluster.")
+	errInvalidDLQTaskType                                 = serviceerror.NewInvalidArgument("Only replication history and sync activity task types are supported in DLQ.")
+	errNamespaceNotGlobal                                 = serviceerror.NewInvalidArgument("Namespace is not a global namespace.")
+	errInvalidClusterMetadataVersion                      = serviceerror.NewInvalidArgument("Cluster metadata change version must be positive.")
+	errAPIKeyIDNotSet                                     = serviceerror.NewInvalidArgument("API key id is not set on request.")
+	errAPIKeyNameNotSet                                   = serviceerror.NewInvalidArgument("API key name is not set on request.")
+	errHostAddressNotSet                                  = serviceerror.NewInvalidArgument("Host address is not set on request.")
+	errGlobalNamespaceRPSCoordinationDisabled             = serviceerror.NewUnavailable("Global namespace RPS coordination is disabled.")
+	errClusterMetadataChangeConflict                      = serviceerror.NewUnavailable("Cluster metadata was updated concurrently, please retry.")
 	errShuttingDown                                       = serviceerror.NewUnavailable("Shutting down")
 
 	errPageSizeTooBigMessage = "PageSize is larger than allowed %d."
@@ -27,6 +27,7 @@ package frontend
 import (
 	"context"
 	"net"
+	"time"
 
 	"go.uber.org/fx"
 	"google.golang.org/grpc"
@@ -67,6 +68,29 @@ import (
 
 type FEReplicatorNamespaceReplicationQueue persistence.NamespaceReplicationQueue
 
+const (
+	healthSignalWindow             = 10 * time.Second
+	loadSheddingEvaluationInterval = 2 * time.Second
+	loadSheddingMinRequestCount    = 20
+
+	historyServiceMethodPrefix = "/temporal.server.api.historyservice.v1.HistoryService/"
+)
+
+// historyLongPollAPIs may wait on workflow progress or workers, their latency says nothing about the health of history
+var historyLongPollAPIs = map[string]struct{}{
+	"GetMutableState":  {},
+	"PollMutableState": {},
+	"QueryWorkflow":    {},
+}
+
+type (
+	HealthSignalsParams struct {
+		fx.In
+
+		HistoryHealthSignals quotas.HealthSignalAggregator `name:"historyHealthSignals"`
+	}
+)
+
 var Module = fx.Options(
 	resource.Module,
 	fx.Provide(dynamicconfig.NewCollection),
@@ -74,10 +98,19 @@ var Module = fx.Options(
 	fx.Provide(NamespaceLogInterceptorProvider),
 	fx.Provide(TelemetryInterceptorProvider),
 	fx.Provide(RateLimitInterceptorProvider),
+	fx.Provide(fx.Annotated{Name: "historyHealthSignals", Target: HealthSignalAggregatorProvider}),
+	fx.Provide(InternodeClientInterceptorsProvider),
+	fx.Provide(LoadSheddingInterceptorProvider),
 	fx.Provide(NamespaceCountLimitInterceptorProvider),
 	fx.Provide(NamespaceValidatorInterceptorProvider),
+	fx.Provide(RequestSizeValidatorInterceptorProvider),
+	fx.Provide(NamespaceQuotaCoordinatorProvider),
+	fx.Provide(ReplicationLagReporterProvider),
 	fx.Provide(NamespaceRateLimitInterceptorProvider),
 	fx.Provide(SDKVersionInterceptorProvider),
+	fx.Provide(WorkflowTypeResolverProvider),
+	fx.Provide(AuditInterceptorProvider),
+	fx.Provide(APIKeyStoreProvider),
 	fx.Provide(GrpcServerOptionsProvider),
 	fx.Provide(VisibilityManagerProvider),
 	fx.Provide(ThrottledLoggerRpsFnProvider),
@@ -92,6 +125,7 @@ var Module = fx.Options(
 	fx.Provide(ServiceResolverProvider),
 	fx.Provide(NewServiceProvider),
 	fx.Invoke(ServiceLifetimeHooks),
+	fx.Invoke(ReplicationLagReporterLifetimeHooks),
 )
 
 func NewServiceProvider(
@@ -132,12 +166,18 @@ func GrpcServerOptionsProvider(
 	namespaceRateLimiterInterceptor *interceptor.NamespaceRateLimitInterceptor,
 	namespaceCountLimiterInterceptor *interceptor.NamespaceCountLimitInterceptor,
 	namespaceValidatorInterceptor *interceptor.NamespaceValidatorInterceptor,
+	requestSizeValidatorInterceptor *interceptor.RequestSizeValidatorInterceptor,
 	telemetryInterceptor *interceptor.TelemetryInterceptor,
 	rateLimitInterceptor *interceptor.RateLimitInterceptor,
+	loadSheddingInterceptor *interceptor.LoadSheddingInterceptor,
 	sdkVersionInterceptor *interceptor.SDKVersionInterceptor,
+	auditInterceptor *authorization.AuditInterceptor,
 	authorizer authorization.Authorizer,
 	claimMapper authorization.ClaimMapper,
+	apiKeyStore authorization.APIKeyStore,
+	cfg *config.Config,
 	audienceGetter authorization.JWTAudienceMapper,
+	workflowTypeResolver authorization.WorkflowTypeResolver,
 	customInterceptors []grpc.UnaryServerInterceptor,
 	metricsClient metrics.Client,
 ) []grpc.ServerOption {
@@ -156,21 +196,28 @@ func GrpcServerOptionsProvider(
 	if err != nil {
 		logger.Fatal("creating gRPC server options failed", tag.Error(err))
 	}
+	if cfg.Global.Authorization.APIKeys.Enabled {
+		claimMapper = authorization.NewAPIKeyClaimMapper(apiKeyStore, claimMapper, &cfg.Global.Authorization.APIKeys)
+	}
 	interceptors := []grpc.UnaryServerInterceptor{
 		namespaceLogInterceptor.Intercept,
 		rpc.ServiceErrorInterceptor,
 		metrics.NewServerMetricsContextInjectorInterceptor(),
 		telemetryInterceptor.Intercept,
 		namespaceValidatorInterceptor.Intercept,
+		requestSizeValidatorInterceptor.Intercept,
+		loadSheddingInterceptor.Intercept,
 		rateLimitInterceptor.Intercept,
 		namespaceRateLimiterInterceptor.Intercept,
 		namespaceCountLimiterInterceptor.Intercept,
+		auditInterceptor.Intercept,
 		authorization.NewAuthorizationInterceptor(
 			claimMapper,
 			authorizer,
 			metricsClient,
 			logger,
 			audienceGetter,
+			workflowTypeResolver,
 		),
 		sdkVersionInterceptor.Intercept,
 	}
@@ -183,6 +230,16 @@ func GrpcServerOptionsProvider(
 		grpc.KeepaliveParams(kp),
 		grpc.KeepaliveEnforcementPolicy(kep),
 		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(
+			authorization.NewAuthorizationStreamInterceptor(
+				claimMapper,
+				authorizer,
+				metricsClient,
+				logger,
+				audienceGetter,
+				workflowTypeResolver,
+			),
+		),
 	)
 }
 
@@ -239,16 +296,128 @@ func RateLimitInterceptorProvider(
 	)
 }
 
+func HealthSignalAggregatorProvider(
+	timeSource clock.TimeSource,
+) quotas.HealthSignalAggregator {
+	return quotas.NewHealthSignalAggregator(healthSignalWindow, timeSource)
+}
+
+func InternodeClientInterceptorsProvider(
+	healthSignals HealthSignalsParams,
+) resource.InternodeClientInterceptors {
+	return resource.InternodeClientInterceptors{
+		interceptor.NewHealthSignalClientInterceptor(historyServiceMethodPrefix, historyLongPollAPIs, healthSignals.HistoryHealthSignals),
+	}
+}
+
+func LoadSheddingInterceptorProvider(
+	serviceConfig *Config,
+	healthSignals HealthSignalsParams,
+	logger log.Logger,
+) *interceptor.LoadSheddingInterceptor {
+	shedder := quotas.NewAdaptiveLoadShedder(
+		[]quotas.HealthCheck{
+			{
+				// the frontend's own persistence calls are few and don't reflect the load of the cluster,
+				// history calls carry the health of history hosts and their persistence
+				Signals:             healthSignals.HistoryHealthSignals,
+				LatencyThreshold:    func() time.Duration { return serviceConfig.LoadSheddingHistoryLatencyThreshold() },
+				ErrorRatioThreshold: func() float64 { return serviceConfig.LoadSheddingHistoryErrorRatioThreshold() },
+			},
+		},
+		configs.LoadSheddingMaxPriority,
+		loadSheddingMinRequestCount,
+		loadSheddingEvaluationInterval,
+	)
+	return interceptor.NewLoadSheddingInterceptor(
+		serviceConfig.EnableAdaptiveLoadShedding,
+		serviceConfig.LoadSheddingRetryAfter,
+		shedder,
+		configs.LoadSheddingAPIToPriority,
+		logger,
+	)
+}
+
+func NamespaceQuotaCoordinatorProvider(
+	serviceConfig *Config,
+	frontendServiceResolver membership.ServiceResolver,
+	membershipMonitor membership.Monitor,
+	clientFactory client.Factory,
+	timeSource clock.TimeSource,
+	logger resource.SnTaggedLogger,
+	lc fx.Lifecycle,
+) *NamespaceQuotaCoordinator {
+	quotaCoordinator := NewNamespaceQuotaCoordinator(
+		serviceConfig,
+		frontendServiceResolver,
+		membershipMonitor,
+		clientFactory,
+		timeSource,
+		logger,
+	)
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			quotaCoordinator.Start()
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			quotaCoordinator.Stop()
+			return nil
+		},
+	})
+	return quotaCoordinator
+}
+
+func ReplicationLagReporterProvider(
+	serviceConfig *Config,
+	historyClient historyservice.HistoryServiceClient,
+	namespaceRegistry namespace.Registry,
+	frontendServiceResolver membership.ServiceResolver,
+	membershipMonitor membership.Monitor,
+	metricsClient metrics.Client,
+	timeSource clock.TimeSource,
+	logger resource.SnTaggedLogger,
+) *ReplicationLagReporter {
+	return NewReplicationLagReporter(
+		serviceConfig,
+		historyClient,
+		namespaceRegistry,
+		frontendServiceResolver,
+		membershipMonitor,
+		metricsClient,
+		timeSource,
+		logger,
+	)
+}
+
+func ReplicationLagReporterLifetimeHooks(
+	lc fx.Lifecycle,
+	reporter *ReplicationLagReporter,
+) {
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			reporter.Start()
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			reporter.Stop()
+			return nil
+		},
+	})
+}
+
 func NamespaceRateLimitInterceptorProvider(
 	serviceConfig *Config,
 	namespaceRegistry namespace.Registry,
 	frontendServiceResolver membership.ServiceResolver,
+	quotaCoordinator *NamespaceQuotaCoordinator,
 ) *interceptor.NamespaceRateLimitInterceptor {
 	rateFn := func(namespace string) float64 {
 		return namespaceRPS(
 			serviceConfig.MaxNamespaceRPSPerInstance,
 			serviceConfig.GlobalNamespaceRPS,
 			frontendServiceResolver,
+			quotaCoordinator,
 			namespace,
 		)
 	}
@@ -258,17 +427,21 @@ func NamespaceRateLimitInterceptorProvider(
 			serviceConfig.MaxNamespaceVisibilityRPSPerInstance,
 			serviceConfig.GlobalNamespaceRPS,
 			frontendServiceResolver,
+			quotaCoordinator,
 			namespace,
 		)
 	}
-	namespaceRateLimiter := quotas.NewNamespaceRateLimiter(
-		func(req quotas.Request) quotas.RequestRateLimiter {
-			return configs.NewRequestToRateLimiter(
-				configs.NewNamespaceRateBurst(req.Caller, rateFn, serviceConfig.MaxNamespaceBurstPerInstance),
-				configs.NewNamespaceRateBurst(req.Caller, visibilityRateFn, serviceConfig.MaxNamespaceVisibilityBurstPerInstance),
-				configs.NewNamespaceRateBurst(req.Caller, rateFn, serviceConfig.MaxNamespaceBurstPerInstance),
-			)
-		},
+	namespaceRateLimiter := newUsageRecordingRateLimiter(
+		quotaCoordinator,
+		quotas.NewNamespaceRateLimiter(
+			func(req quotas.Request) quotas.RequestRateLimiter {
+				return configs.NewRequestToRateLimiter(
+					configs.NewNamespaceRateBurst(req.Caller, rateFn, serviceConfig.MaxNamespaceBurstPerInstance),
+					configs.NewNamespaceRateBurst(req.Caller, visibilityRateFn, serviceConfig.MaxNamespaceVisibilityBurstPerInstance),
+					configs.NewNamespaceRateBurst(req.Caller, rateFn, serviceConfig.MaxNamespaceBurstPerInstance),
+				)
+			},
+		),
 	)
 	return interceptor.NewNamespaceRateLimitInterceptor(namespaceRegistry, namespaceRateLimiter, map[string]int{})
 }
@@ -282,6 +455,7 @@ func NamespaceCountLimitInterceptorProvider(
 		namespaceRegistry,
 		logger,
 		serviceConfig.MaxNamespaceCountPerInstance,
+		serviceConfig.MaxTaskQueuePollerCountPerInstance,
 		configs.ExecutionAPICountLimitOverride,
 	)
 }
@@ -296,10 +470,62 @@ func NamespaceValidatorInterceptorProvider(
 	)
 }
 
+func RequestSizeValidatorInterceptorProvider(
+	serviceConfig *Config,
+	namespaceRegistry namespace.Registry,
+	logger log.Logger,
+) *interceptor.RequestSizeValidatorInterceptor {
+	return interceptor.NewRequestSizeValidatorInterceptor(
+		namespaceRegistry,
+		logger,
+		serviceConfig.RequestSizeLimitWarn,
+		serviceConfig.RequestSizeLimitError,
+	)
+}
+
 func SDKVersionInterceptorProvider() *interceptor.SDKVersionInterceptor {
 	return interceptor.NewSDKVersionInterceptor()
 }
 
+func WorkflowTypeResolverProvider(
+	namespaceRegistry namespace.Registry,
+	historyClient historyservice.HistoryServiceClient,
+) authorization.WorkflowTypeResolver {
+	return newWorkflowTypeResolver(namespaceRegistry, historyClient)
+}
+
+func APIKeyStoreProvider(
+	namespaceRegistry namespace.Registry,
+) authorization.APIKeyStore {
+	return newNamespaceAPIKeyStore(namespaceRegistry)
+}
+
+func AuditInterceptorProvider(
+	cfg *config.Config,
+	sdkClientFactory sdk.ClientFactory,
+	metricsClient metrics.Client,
+	logger log.Logger,
+	lc fx.Lifecycle,
+) (*authorization.AuditInterceptor, error) {
+	auditConfig := &cfg.Global.Authorization.Audit
+	sinks, err := authorization.GetAuditSinksFromConfig(auditConfig)
+	if err != nil {
+		return nil, err
+	}
+	if auditConfig.SystemNamespace {
+		sinks = append(sinks, newSystemNamespaceAuditSink(sdkClientFactory, metricsClient, logger))
+	}
+
+	auditInterceptor := authorization.NewAuditInterceptor(sinks, metricsClient, logger)
+	lc.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			auditInterceptor.Close()
+			return nil
+		},
+	})
+	return auditInterceptor, nil
+}
+
 func PersistenceMaxQpsProvider(
 	serviceConfig *Config,
 ) persistenceClient.PersistenceMaxQps {
@@ -385,6 +611,8 @@ func AdminHandlerProvider(
 	archivalMetadata archiver.ArchivalMetadata,
 	healthServer *health.Server,
 	eventSerializer serialization.Serializer,
+	namespaceQuotaCoordinator *NamespaceQuotaCoordinator,
+	namespaceCountLimitInterceptor *interceptor.NamespaceCountLimitInterceptor,
 ) *AdminHandler {
 	args := NewAdminHandlerArgs{
 		persistenceConfig,
@@ -413,6 +641,8 @@ func AdminHandlerProvider(
 		archivalMetadata,
 		healthServer,
 		eventSerializer,
+		namespaceQuotaCoordinator,
+		namespaceCountLimitInterceptor,
 	}
 	return NewAdminHandler(args)
 }
@@ -0,0 +1,66 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"sync"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/client"
+	"go.temporal.io/server/client/admin"
+)
+
+type (
+	// hostAdminClients caches admin clients connected to individual frontend hosts
+	hostAdminClients struct {
+		clientFactory client.Factory
+
+		sync.Mutex
+		clients map[string]adminservice.AdminServiceClient
+	}
+)
+
+func newHostAdminClients(
+	clientFactory client.Factory,
+) *hostAdminClients {
+	return &hostAdminClients{
+		clientFactory: clientFactory,
+		clients:       make(map[string]adminservice.AdminServiceClient),
+	}
+}
+
+func (c *hostAdminClients) get(
+	address string,
+) adminservice.AdminServiceClient {
+	c.Lock()
+	defer c.Unlock()
+
+	adminClient, ok := c.clients[address]
+	if !ok {
+		adminClient = c.clientFactory.NewAdminClientWithTimeout(address, admin.DefaultTimeout, admin.DefaultLargeTimeout)
+		c.clients[address] = adminClient
+	}
+	return adminClient
+}
@@ -0,0 +1,310 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/client"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	// namespaceQuotaCoordinatorKey is looked up in the frontend membership ring to designate
+	// the host which coordinates global namespace rate limits
+	namespaceQuotaCoordinatorKey = "namespace-quota-coordinator"
+	// reports and allotments expire after this many report intervals
+	namespaceQuotaExpirationIntervals = 3
+	// every frontend host is allotted at least this ratio of an even share of a global namespace rate limit,
+	// so that hosts without recent traffic can still admit requests until their next report
+	namespaceQuotaMinShareRatio = 0.1
+)
+
+type (
+	// NamespaceQuotaCoordinator distributes global namespace rate limits across frontend hosts according
+	// to the load each host observes. Every host periodically reports its per namespace request rates to
+	// the coordinator host, which allots each reporting host a share of the global rate limits proportional
+	// to its reported rates.
+	NamespaceQuotaCoordinator struct {
+		status       int32
+		config       *Config
+		resolver     membership.ServiceResolver
+		monitor      membership.Monitor
+		adminClients *hostAdminClients
+		timeSource   clock.TimeSource
+		logger       log.Logger
+		shutdownCh   chan struct{}
+
+		sync.Mutex
+		requestCounts       map[string]int64
+		countStartTime      time.Time
+		allotments          map[string]float64
+		allotmentExpiration time.Time
+		hostReports         map[string]namespaceQuotaReport
+	}
+
+	namespaceQuotaReport struct {
+		namespaceRPS map[string]float64
+		reportTime   time.Time
+	}
+
+	// usageRecordingRateLimiter records requests to the quota coordinator before rate limiting them
+	usageRecordingRateLimiter struct {
+		coordinator *NamespaceQuotaCoordinator
+		rateLimiter quotas.RequestRateLimiter
+	}
+)
+
+var _ common.Daemon = (*NamespaceQuotaCoordinator)(nil)
+var _ quotas.RequestRateLimiter = (*usageRecordingRateLimiter)(nil)
+
+func NewNamespaceQuotaCoordinator(
+	config *Config,
+	resolver membership.ServiceResolver,
+	monitor membership.Monitor,
+	clientFactory client.Factory,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) *NamespaceQuotaCoordinator {
+	return &NamespaceQuotaCoordinator{
+		status:       common.DaemonStatusInitialized,
+		config:       config,
+		resolver:     resolver,
+		monitor:      monitor,
+		adminClients: newHostAdminClients(clientFactory),
+		timeSource:   timeSource,
+		logger:       logger,
+		shutdownCh:   make(chan struct{}),
+
+		requestCounts:  make(map[string]int64),
+		countStartTime: timeSource.Now(),
+		hostReports:    make(map[string]namespaceQuotaReport),
+	}
+}
+
+func (c *NamespaceQuotaCoordinator) Start() {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	go c.reportLoop()
+}
+
+func (c *NamespaceQuotaCoordinator) Stop() {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(c.shutdownCh)
+}
+
+// RecordRequests records requests of a namespace received by this host
+func (c *NamespaceQuotaCoordinator) RecordRequests(namespaceName string, count int) {
+	if !c.config.EnableGlobalNamespaceRPSCoordination() {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	c.requestCounts[namespaceName] += int64(count)
+}
+
+// Allotment returns the share of the global rate limit of a namespace allotted to this host,
+// false is returned if coordination is disabled or this host has no recent allotment
+func (c *NamespaceQuotaCoordinator) Allotment(namespaceName string) (float64, bool) {
+	if !c.config.EnableGlobalNamespaceRPSCoordination() {
+		return 0, false
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if !c.timeSource.Now().Before(c.allotmentExpiration) {
+		return 0, false
+	}
+	allotment, ok := c.allotments[namespaceName]
+	return allotment, ok
+}
+
+// Allot records the request rates reported by a frontend host and returns the rate limits allotted to it,
+// it is invoked on the coordinator host
+func (c *NamespaceQuotaCoordinator) Allot(
+	hostAddress string,
+	namespaceRPS map[string]float64,
+) map[string]float64 {
+	now := c.timeSource.Now()
+	reportExpiration := now.Add(-c.config.GlobalNamespaceRPSReportInterval() * namespaceQuotaExpirationIntervals)
+
+	c.Lock()
+	defer c.Unlock()
+
+	c.hostReports[hostAddress] = namespaceQuotaReport{
+		namespaceRPS: namespaceRPS,
+		reportTime:   now,
+	}
+
+	namespaceHostRPS := make(map[string][]float64)
+	for address, report := range c.hostReports {
+		if report.reportTime.Before(reportExpiration) {
+			delete(c.hostReports, address)
+			continue
+		}
+		for namespaceName, rps := range report.namespaceRPS {
+			namespaceHostRPS[namespaceName] = append(namespaceHostRPS[namespaceName], rps)
+		}
+	}
+
+	memberCount := c.resolver.MemberCount()
+	allotments := make(map[string]float64, len(namespaceHostRPS))
+	for namespaceName, hostRPS := range namespaceHostRPS {
+		globalRPS := float64(c.config.GlobalNamespaceRPS(namespaceName))
+		if globalRPS <= 0 {
+			continue
+		}
+
+		// hosts which did not report the namespace are accounted for with the minimum share
+		hosts := int(math.Max(float64(memberCount), float64(len(hostRPS))))
+		minShare := namespaceQuotaMinShareRatio * globalRPS / float64(hosts)
+		totalShares := float64(hosts-len(hostRPS)) * minShare
+		for _, rps := range hostRPS {
+			totalShares += math.Max(rps, minShare)
+		}
+		allotments[namespaceName] = globalRPS * math.Max(namespaceRPS[namespaceName], minShare) / totalShares
+	}
+	return allotments
+}
+
+func (c *NamespaceQuotaCoordinator) reportLoop() {
+	timer := time.NewTimer(c.config.GlobalNamespaceRPSReportInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-c.shutdownCh:
+			return
+		case <-timer.C:
+			if c.config.EnableGlobalNamespaceRPSCoordination() {
+				c.report()
+			}
+			timer.Reset(c.config.GlobalNamespaceRPSReportInterval())
+		}
+	}
+}
+
+func (c *NamespaceQuotaCoordinator) report() {
+	namespaceRPS := c.takeNamespaceRPS()
+
+	self, err := c.monitor.WhoAmI()
+	if err != nil {
+		c.logger.Warn("Unable to resolve current frontend host.", tag.Error(err))
+		return
+	}
+	coordinator, err := c.resolver.Lookup(namespaceQuotaCoordinatorKey)
+	if err != nil {
+		c.logger.Warn("Unable to resolve namespace quota coordinator host.", tag.Error(err))
+		return
+	}
+
+	var allotments map[string]float64
+	if coordinator.GetAddress() == self.GetAddress() {
+		allotments = c.Allot(self.GetAddress(), namespaceRPS)
+	} else {
+		ctx, cancel := context.WithTimeout(context.Background(), c.config.GlobalNamespaceRPSReportInterval())
+		resp, err := c.adminClients.get(coordinator.GetAddress()).ReportNamespaceQuotaUsage(ctx, &adminservice.ReportNamespaceQuotaUsageRequest{
+			HostAddress:  self.GetAddress(),
+			NamespaceRps: namespaceRPS,
+		})
+		cancel()
+		if err != nil {
+			c.logger.Warn("Failed to report namespace quota usage.", tag.Address(coordinator.GetAddress()), tag.Error(err))
+			return
+		}
+		allotments = resp.GetNamespaceRps()
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	c.allotments = allotments
+	c.allotmentExpiration = c.timeSource.Now().Add(c.config.GlobalNamespaceRPSReportInterval() * namespaceQuotaExpirationIntervals)
+}
+
+// takeNamespaceRPS returns the request rates of namespaces with a global rate limit since the previous call
+func (c *NamespaceQuotaCoordinator) takeNamespaceRPS() map[string]float64 {
+	now := c.timeSource.Now()
+
+	c.Lock()
+	requestCounts := c.requestCounts
+	elapsed := now.Sub(c.countStartTime)
+	c.requestCounts = make(map[string]int64, len(requestCounts))
+	c.countStartTime = now
+	c.Unlock()
+
+	namespaceRPS := make(map[string]float64, len(requestCounts))
+	if elapsed <= 0 {
+		return namespaceRPS
+	}
+	for namespaceName, count := range requestCounts {
+		if c.config.GlobalNamespaceRPS(namespaceName) <= 0 {
+			continue
+		}
+		namespaceRPS[namespaceName] = float64(count) / elapsed.Seconds()
+	}
+	return namespaceRPS
+}
+
+func newUsageRecordingRateLimiter(
+	coordinator *NamespaceQuotaCoordinator,
+	rateLimiter quotas.RequestRateLimiter,
+) *usageRecordingRateLimiter {
+	return &usageRecordingRateLimiter{
+		coordinator: coordinator,
+		rateLimiter: rateLimiter,
+	}
+}
+
+func (r *usageRecordingRateLimiter) Allow(now time.Time, request quotas.Request) bool {
+	r.coordinator.RecordRequests(request.Caller, request.Token)
+	return r.rateLimiter.Allow(now, request)
+}
+
+func (r *usageRecordingRateLimiter) Reserve(now time.Time, request quotas.Request) quotas.Reservation {
+	r.coordinator.RecordRequests(request.Caller, request.Token)
+	return r.rateLimiter.Reserve(now, request)
+}
+
+func (r *usageRecordingRateLimiter) Wait(ctx context.Context, request quotas.Request) error {
+	r.coordinator.RecordRequests(request.Caller, request.Token)
+	return r.rateLimiter.Wait(ctx, request)
+}
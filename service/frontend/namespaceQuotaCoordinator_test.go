@@ -0,0 +1,217 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/adminservicemock/v1"
+	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+)
+
+type (
+	namespaceQuotaCoordinatorSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller        *gomock.Controller
+		mockResolver      *membership.MockServiceResolver
+		mockMonitor       *membership.MockMonitor
+		mockClientFactory *client.MockFactory
+		timeSource        *clock.EventTimeSource
+
+		coordinator *NamespaceQuotaCoordinator
+	}
+)
+
+const (
+	testHostA = "10.0.0.1:7233"
+	testHostB = "10.0.0.2:7233"
+)
+
+func TestNamespaceQuotaCoordinatorSuite(t *testing.T) {
+	s := new(namespaceQuotaCoordinatorSuite)
+	suite.Run(t, s)
+}
+
+func (s *namespaceQuotaCoordinatorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockResolver = membership.NewMockServiceResolver(s.controller)
+	s.mockMonitor = membership.NewMockMonitor(s.controller)
+	s.mockClientFactory = client.NewMockFactory(s.controller)
+	s.timeSource = clock.NewEventTimeSource().Update(time.Unix(1000, 0))
+
+	config := &Config{
+		GlobalNamespaceRPS: func(namespace string) int {
+			if namespace == "global" {
+				return 100
+			}
+			return 0
+		},
+		EnableGlobalNamespaceRPSCoordination: dynamicconfig.GetBoolPropertyFn(true),
+		GlobalNamespaceRPSReportInterval:     dynamicconfig.GetDurationPropertyFn(5 * time.Second),
+	}
+	s.coordinator = NewNamespaceQuotaCoordinator(
+		config,
+		s.mockResolver,
+		s.mockMonitor,
+		s.mockClientFactory,
+		s.timeSource,
+		log.NewNoopLogger(),
+	)
+}
+
+func (s *namespaceQuotaCoordinatorSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *namespaceQuotaCoordinatorSuite) TestAllot() {
+	s.mockResolver.EXPECT().MemberCount().Return(3).AnyTimes()
+
+	allotments := s.coordinator.Allot(testHostA, map[string]float64{"global": 90, "local": 90})
+	s.Equal([]string{"global"}, allotmentNamespaces(allotments))
+	// other hosts did not report yet and are accounted for with the minimum share
+	s.InDelta(100*90/(90+2*10.0/3), allotments["global"], 0.001)
+
+	allotments = s.coordinator.Allot(testHostB, map[string]float64{"global": 10})
+	s.InDelta(100*10/(90+10+10.0/3), allotments["global"], 0.001)
+
+	allotments = s.coordinator.Allot(testHostA, map[string]float64{"global": 90})
+	s.InDelta(100*90/(90+10+10.0/3), allotments["global"], 0.001)
+
+	// host B report expired
+	s.timeSource.Update(s.timeSource.Now().Add(time.Minute))
+	allotments = s.coordinator.Allot(testHostA, map[string]float64{"global": 90})
+	s.InDelta(100*90/(90+2*10.0/3), allotments["global"], 0.001)
+}
+
+func (s *namespaceQuotaCoordinatorSuite) TestAllot_IdleHost() {
+	s.mockResolver.EXPECT().MemberCount().Return(2).AnyTimes()
+
+	s.coordinator.Allot(testHostA, map[string]float64{"global": 200})
+	allotments := s.coordinator.Allot(testHostB, map[string]float64{})
+	s.InDelta(100*5/(200+5.0), allotments["global"], 0.001)
+}
+
+func (s *namespaceQuotaCoordinatorSuite) TestReport_Coordinator() {
+	s.mockResolver.EXPECT().MemberCount().Return(1).AnyTimes()
+	s.mockMonitor.EXPECT().WhoAmI().Return(membership.NewHostInfo(testHostA, nil), nil)
+	s.mockResolver.EXPECT().Lookup(namespaceQuotaCoordinatorKey).Return(membership.NewHostInfo(testHostA, nil), nil)
+
+	_, ok := s.coordinator.Allotment("global")
+	s.False(ok)
+
+	s.coordinator.RecordRequests("global", 50)
+	s.coordinator.RecordRequests("local", 50)
+	s.timeSource.Update(s.timeSource.Now().Add(5 * time.Second))
+	s.coordinator.report()
+
+	allotment, ok := s.coordinator.Allotment("global")
+	s.True(ok)
+	s.Equal(float64(100), allotment)
+	_, ok = s.coordinator.Allotment("local")
+	s.False(ok)
+
+	// allotments expire without reports
+	s.timeSource.Update(s.timeSource.Now().Add(time.Minute))
+	_, ok = s.coordinator.Allotment("global")
+	s.False(ok)
+}
+
+func (s *namespaceQuotaCoordinatorSuite) TestReport_RemoteCoordinator() {
+	mockAdminClient := adminservicemock.NewMockAdminServiceClient(s.controller)
+	s.mockMonitor.EXPECT().WhoAmI().Return(membership.NewHostInfo(testHostA, nil), nil).Times(2)
+	s.mockResolver.EXPECT().Lookup(namespaceQuotaCoordinatorKey).Return(membership.NewHostInfo(testHostB, nil), nil).Times(2)
+	s.mockClientFactory.EXPECT().NewAdminClientWithTimeout(testHostB, gomock.Any(), gomock.Any()).Return(mockAdminClient)
+	mockAdminClient.EXPECT().ReportNamespaceQuotaUsage(gomock.Any(), &adminservice.ReportNamespaceQuotaUsageRequest{
+		HostAddress:  testHostA,
+		NamespaceRps: map[string]float64{"global": 10},
+	}).Return(&adminservice.ReportNamespaceQuotaUsageResponse{
+		NamespaceRps: map[string]float64{"global": 40},
+	}, nil)
+
+	s.coordinator.RecordRequests("global", 50)
+	s.timeSource.Update(s.timeSource.Now().Add(5 * time.Second))
+	s.coordinator.report()
+
+	allotment, ok := s.coordinator.Allotment("global")
+	s.True(ok)
+	s.Equal(float64(40), allotment)
+
+	mockAdminClient.EXPECT().ReportNamespaceQuotaUsage(gomock.Any(), &adminservice.ReportNamespaceQuotaUsageRequest{
+		HostAddress:  testHostA,
+		NamespaceRps: map[string]float64{},
+	}).Return(&adminservice.ReportNamespaceQuotaUsageResponse{
+		NamespaceRps: map[string]float64{"global": 20},
+	}, nil)
+	s.timeSource.Update(s.timeSource.Now().Add(5 * time.Second))
+	s.coordinator.report()
+
+	allotment, ok = s.coordinator.Allotment("global")
+	s.True(ok)
+	s.Equal(float64(20), allotment)
+}
+
+func (s *namespaceQuotaCoordinatorSuite) TestNamespaceRPS() {
+	s.mockResolver.EXPECT().MemberCount().Return(4).AnyTimes()
+	perInstanceRPS := dynamicconfig.GetIntPropertyFilteredByNamespace(10)
+
+	// falls back to dividing the global limit by the number of hosts without allotment
+	rps := namespaceRPS(perInstanceRPS, s.coordinator.config.GlobalNamespaceRPS, s.mockResolver, s.coordinator, "global")
+	s.InDelta(10+100*0.6872892787909722, rps, 0.001)
+
+	s.mockMonitor.EXPECT().WhoAmI().Return(membership.NewHostInfo(testHostA, nil), nil)
+	s.mockResolver.EXPECT().Lookup(namespaceQuotaCoordinatorKey).Return(membership.NewHostInfo(testHostA, nil), nil)
+	s.coordinator.RecordRequests("global", 50)
+	s.timeSource.Update(s.timeSource.Now().Add(5 * time.Second))
+	s.coordinator.report()
+
+	allotment, ok := s.coordinator.Allotment("global")
+	s.True(ok)
+	rps = namespaceRPS(perInstanceRPS, s.coordinator.config.GlobalNamespaceRPS, s.mockResolver, s.coordinator, "global")
+	// the per instance limit is not added on top of the allotment
+	s.Equal(allotment, rps)
+	s.LessOrEqual(rps, float64(s.coordinator.config.GlobalNamespaceRPS("global")))
+}
+
+func allotmentNamespaces(m map[string]float64) []string {
+	result := make([]string, 0, len(m))
+	for key := range m {
+		result = append(result, key)
+	}
+	return result
+}
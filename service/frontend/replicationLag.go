@@ -0,0 +1,190 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	defaultReplicationLagSlowestShardCount = 10
+)
+
+// getReplicationLag fetches replication status of all shards from history and aggregates it per remote cluster.
+func getReplicationLag(
+	ctx context.Context,
+	historyClient historyservice.HistoryServiceClient,
+	namespaceRegistry namespace.Registry,
+	remoteClusters []string,
+	slowestShardCount int,
+) (map[string]*replicationspb.ClusterReplicationLag, error) {
+
+	resp, err := historyClient.GetReplicationStatus(ctx, &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: remoteClusters,
+		IncludeDetails: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return aggregateReplicationLag(
+		resp.GetShards(),
+		slowestShardCount,
+		func(namespaceID string) string {
+			namespaceName, err := namespaceRegistry.GetNamespaceName(namespace.ID(namespaceID))
+			if err != nil {
+				return namespaceID
+			}
+			return namespaceName.String()
+		},
+	), nil
+}
+
+// aggregateReplicationLag aggregates replication status of all shards per remote cluster.
+// Namespace lag is keyed by the value returned from namespaceName for the namespace id.
+func aggregateReplicationLag(
+	shards []*historyservice.ShardReplicationStatus,
+	slowestShardCount int,
+	namespaceName func(namespaceID string) string,
+) map[string]*replicationspb.ClusterReplicationLag {
+
+	if slowestShardCount <= 0 {
+		slowestShardCount = defaultReplicationLagSlowestShardCount
+	}
+
+	result := make(map[string]*replicationspb.ClusterReplicationLag)
+	shardLags := make(map[string][]*replicationspb.ShardReplicationLag)
+	for _, shard := range shards {
+		for clusterName, clusterStatus := range shard.GetRemoteClusters() {
+			clusterLag, ok := result[clusterName]
+			if !ok {
+				clusterLag = &replicationspb.ClusterReplicationLag{
+					MaxTimeLag: timestamp.DurationPtr(0),
+					Namespaces: make(map[string]*replicationspb.NamespaceReplicationLag),
+				}
+				result[clusterName] = clusterLag
+			}
+
+			shardLag := newShardReplicationLag(shard, clusterStatus)
+			shardLags[clusterName] = append(shardLags[clusterName], shardLag)
+
+			clusterLag.TotalTaskLag += shardLag.TaskLag
+			if shardLag.TaskLag > clusterLag.MaxTaskLag {
+				clusterLag.MaxTaskLag = shardLag.TaskLag
+			}
+			if *shardLag.TimeLag > *clusterLag.MaxTimeLag {
+				clusterLag.MaxTimeLag = shardLag.TimeLag
+			}
+			clusterLag.DlqSize += shardLag.DlqSize
+			clusterLag.Truncated = clusterLag.Truncated || clusterStatus.GetTruncated()
+
+			for namespaceID, shardNamespaceLag := range clusterStatus.GetNamespaces() {
+				name := namespaceName(namespaceID)
+				namespaceLag, ok := clusterLag.Namespaces[name]
+				if !ok {
+					namespaceLag = &replicationspb.NamespaceReplicationLag{}
+					clusterLag.Namespaces[name] = namespaceLag
+				}
+				namespaceLag.PendingTaskCount += shardNamespaceLag.GetPendingTaskCount()
+				oldest := shardNamespaceLag.GetOldestPendingTaskTime()
+				if oldest != nil && (namespaceLag.OldestPendingTaskTime == nil || oldest.Before(*namespaceLag.OldestPendingTaskTime)) {
+					namespaceLag.OldestPendingTaskTime = oldest
+				}
+			}
+		}
+	}
+
+	for clusterName, lags := range shardLags {
+		sort.SliceStable(lags, func(i, j int) bool {
+			if *lags[i].TimeLag != *lags[j].TimeLag {
+				return *lags[i].TimeLag > *lags[j].TimeLag
+			}
+			return lags[i].TaskLag > lags[j].TaskLag
+		})
+		if len(lags) > slowestShardCount {
+			lags = lags[:slowestShardCount]
+		}
+		result[clusterName].SlowestShards = lags
+	}
+	return result
+}
+
+func newShardReplicationLag(
+	shard *historyservice.ShardReplicationStatus,
+	clusterStatus *historyservice.ShardReplicationStatusPerCluster,
+) *replicationspb.ShardReplicationLag {
+
+	taskLag := shard.GetMaxReplicationTaskId() - clusterStatus.GetAckedTaskId()
+	if taskLag < 0 {
+		taskLag = 0
+	}
+
+	// time lag is only meaningful if there are tasks not yet acked by remote cluster
+	var timeLag time.Duration
+	ackedTime := timestamp.TimeValue(clusterStatus.GetAckedTaskVisibilityTime())
+	if taskLag > 0 && !ackedTime.IsZero() {
+		timeLag = timestamp.TimeValue(shard.GetShardLocalTime()).Sub(ackedTime)
+		if timeLag < 0 {
+			timeLag = 0
+		}
+	}
+
+	return &replicationspb.ShardReplicationLag{
+		ShardId: shard.GetShardId(),
+		TaskLag: taskLag,
+		TimeLag: timestamp.DurationPtr(timeLag),
+		DlqSize: clusterStatus.GetDlqSize(),
+	}
+}
+
+func emitReplicationLagMetrics(
+	metricsClient metrics.Client,
+	remoteClusters map[string]*replicationspb.ClusterReplicationLag,
+	now time.Time,
+) {
+	for clusterName, clusterLag := range remoteClusters {
+		scope := metricsClient.Scope(metrics.ReplicationLagScope, metrics.TargetClusterTag(clusterName))
+		scope.UpdateGauge(metrics.ClusterReplicationTaskLagGauge, float64(clusterLag.GetTotalTaskLag()))
+		scope.UpdateGauge(metrics.ClusterReplicationMaxTaskLagGauge, float64(clusterLag.GetMaxTaskLag()))
+		scope.UpdateGauge(metrics.ClusterReplicationTimeLagGauge, timestamp.DurationValue(clusterLag.GetMaxTimeLag()).Seconds())
+		scope.UpdateGauge(metrics.ClusterReplicationDLQSizeGauge, float64(clusterLag.GetDlqSize()))
+
+		for namespaceName, namespaceLag := range clusterLag.GetNamespaces() {
+			namespaceScope := scope.Tagged(metrics.NamespaceTag(namespaceName))
+			namespaceScope.UpdateGauge(metrics.NamespaceReplicationTaskLagGauge, float64(namespaceLag.GetPendingTaskCount()))
+			if oldest := namespaceLag.GetOldestPendingTaskTime(); oldest != nil {
+				namespaceScope.UpdateGauge(metrics.NamespaceReplicationTimeLagGauge, now.Sub(*oldest).Seconds())
+			}
+		}
+	}
+}
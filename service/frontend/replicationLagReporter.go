@@ -0,0 +1,156 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+)
+
+const (
+	// replicationLagReporterKey is looked up in the frontend membership ring to designate
+	// the host which emits replication lag metrics
+	replicationLagReporterKey = "replication-lag-reporter"
+	// replicationLagDisabledRecheckInterval is how often the interval is checked again while metrics are disabled
+	replicationLagDisabledRecheckInterval = time.Minute
+)
+
+type (
+	// ReplicationLagReporter periodically emits replication lag metrics of all shards aggregated per remote cluster
+	// and namespace. Only the frontend host owning replicationLagReporterKey in the membership ring emits them,
+	// so that history is not polled by every frontend host.
+	ReplicationLagReporter struct {
+		status            int32
+		config            *Config
+		historyClient     historyservice.HistoryServiceClient
+		namespaceRegistry namespace.Registry
+		resolver          membership.ServiceResolver
+		monitor           membership.Monitor
+		metricsClient     metrics.Client
+		timeSource        clock.TimeSource
+		logger            log.Logger
+		shutdownCh        chan struct{}
+	}
+)
+
+var _ common.Daemon = (*ReplicationLagReporter)(nil)
+
+func NewReplicationLagReporter(
+	config *Config,
+	historyClient historyservice.HistoryServiceClient,
+	namespaceRegistry namespace.Registry,
+	resolver membership.ServiceResolver,
+	monitor membership.Monitor,
+	metricsClient metrics.Client,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) *ReplicationLagReporter {
+	return &ReplicationLagReporter{
+		status:            common.DaemonStatusInitialized,
+		config:            config,
+		historyClient:     historyClient,
+		namespaceRegistry: namespaceRegistry,
+		resolver:          resolver,
+		monitor:           monitor,
+		metricsClient:     metricsClient,
+		timeSource:        timeSource,
+		logger:            logger,
+		shutdownCh:        make(chan struct{}),
+	}
+}
+
+func (r *ReplicationLagReporter) Start() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	go r.reportLoop()
+}
+
+func (r *ReplicationLagReporter) Stop() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(r.shutdownCh)
+}
+
+func (r *ReplicationLagReporter) reportLoop() {
+	timer := time.NewTimer(r.interval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-r.shutdownCh:
+			return
+		case <-timer.C:
+			if r.config.ReplicationLagMetricsInterval() > 0 && r.isReporterHost() {
+				r.report()
+			}
+			timer.Reset(r.interval())
+		}
+	}
+}
+
+func (r *ReplicationLagReporter) interval() time.Duration {
+	if interval := r.config.ReplicationLagMetricsInterval(); interval > 0 {
+		return interval
+	}
+	return replicationLagDisabledRecheckInterval
+}
+
+func (r *ReplicationLagReporter) isReporterHost() bool {
+	self, err := r.monitor.WhoAmI()
+	if err != nil {
+		r.logger.Warn("Unable to resolve current frontend host.", tag.Error(err))
+		return false
+	}
+	reporter, err := r.resolver.Lookup(replicationLagReporterKey)
+	if err != nil {
+		r.logger.Warn("Unable to resolve replication lag reporter host.", tag.Error(err))
+		return false
+	}
+	return reporter.GetAddress() == self.GetAddress()
+}
+
+func (r *ReplicationLagReporter) report() {
+	ctx, cancel := context.WithTimeout(context.Background(), r.interval())
+	defer cancel()
+
+	remoteClusters, err := getReplicationLag(ctx, r.historyClient, r.namespaceRegistry, nil, 0)
+	if err != nil {
+		r.logger.Warn("Unable to get replication lag.", tag.Error(err))
+		return
+	}
+	emitReplicationLagMetrics(r.metricsClient, remoteClusters, r.timeSource.Now())
+}
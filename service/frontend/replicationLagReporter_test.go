@@ -0,0 +1,96 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+)
+
+func TestReplicationLagReporter(t *testing.T) {
+	s := require.New(t)
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockHistoryClient := historyservicemock.NewMockHistoryServiceClient(controller)
+	mockNamespaceRegistry := namespace.NewMockRegistry(controller)
+	mockResolver := membership.NewMockServiceResolver(controller)
+	mockMonitor := membership.NewMockMonitor(controller)
+	config := &Config{
+		ReplicationLagMetricsInterval: dynamicconfig.GetDurationPropertyFn(time.Minute),
+	}
+	reporter := NewReplicationLagReporter(
+		config,
+		mockHistoryClient,
+		mockNamespaceRegistry,
+		mockResolver,
+		mockMonitor,
+		metrics.NoopClient,
+		clock.NewEventTimeSource().Update(time.Unix(1000, 0)),
+		log.NewNoopLogger(),
+	)
+
+	mockMonitor.EXPECT().WhoAmI().Return(membership.NewHostInfo(testHostA, nil), nil).Times(2)
+	mockResolver.EXPECT().Lookup(replicationLagReporterKey).Return(membership.NewHostInfo(testHostB, nil), nil)
+	s.False(reporter.isReporterHost())
+	mockResolver.EXPECT().Lookup(replicationLagReporterKey).Return(membership.NewHostInfo(testHostA, nil), nil)
+	s.True(reporter.isReporterHost())
+
+	mockHistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), &historyservice.GetReplicationStatusRequest{
+		IncludeDetails: true,
+	}).Return(&historyservice.GetReplicationStatusResponse{
+		Shards: []*historyservice.ShardReplicationStatus{{
+			ShardId:              1,
+			MaxReplicationTaskId: 10,
+			RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+				"standby": {
+					AckedTaskId: 5,
+					Namespaces: map[string]*replicationspb.NamespaceReplicationLag{
+						"namespace-id": {PendingTaskCount: 5},
+					},
+				},
+			},
+		}},
+	}, nil)
+	mockNamespaceRegistry.EXPECT().GetNamespaceName(namespace.ID("namespace-id")).Return(namespace.Name("namespace"), nil)
+	reporter.report()
+
+	// the interval is checked again later while metrics are disabled
+	config.ReplicationLagMetricsInterval = dynamicconfig.GetDurationPropertyFn(0)
+	s.Equal(replicationLagDisabledRecheckInterval, reporter.interval())
+}
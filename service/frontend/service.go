@@ -72,6 +72,7 @@ type Config struct {
 	MaxNamespaceRPSPerInstance             dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxNamespaceBurstPerInstance           dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxNamespaceCountPerInstance           dynamicconfig.IntPropertyFnWithNamespaceFilter
+	MaxTaskQueuePollerCountPerInstance     dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 	MaxNamespaceVisibilityRPSPerInstance   dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxNamespaceVisibilityBurstPerInstance dynamicconfig.IntPropertyFnWithNamespaceFilter
 	GlobalNamespaceRPS                     dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -82,12 +83,33 @@ type Config struct {
 
 	MaxBadBinaries dynamicconfig.IntPropertyFnWithNamespaceFilter
 
+	// EnableGlobalNamespaceRPSCoordination distributes GlobalNamespaceRPS across frontend hosts by their load
+	EnableGlobalNamespaceRPSCoordination dynamicconfig.BoolPropertyFn
+	// GlobalNamespaceRPSReportInterval is how often namespace request rates are reported to the quota coordinator
+	GlobalNamespaceRPSReportInterval dynamicconfig.DurationPropertyFn
+
+	// ReplicationLagMetricsInterval is how often replication lag metrics are emitted, 0 disables them
+	ReplicationLagMetricsInterval dynamicconfig.DurationPropertyFn
+
+	// NamespaceRenameAliasGracePeriod is how long the previous name of a renamed namespace keeps resolving to it
+	NamespaceRenameAliasGracePeriod dynamicconfig.DurationPropertyFn
+	// APIKeyRotationGracePeriod is how long the previous secret of a rotated API key stays valid
+	APIKeyRotationGracePeriod dynamicconfig.DurationPropertyFn
+
+	// adaptive load shedding settings
+	EnableAdaptiveLoadShedding             dynamicconfig.BoolPropertyFn
+	LoadSheddingHistoryLatencyThreshold    dynamicconfig.DurationPropertyFn
+	LoadSheddingHistoryErrorRatioThreshold dynamicconfig.FloatPropertyFn
+	LoadSheddingRetryAfter                 dynamicconfig.DurationPropertyFn
+
 	// security protection settings
 	DisableListVisibilityByFilter dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	// size limit system protection
-	BlobSizeLimitError dynamicconfig.IntPropertyFnWithNamespaceFilter
-	BlobSizeLimitWarn  dynamicconfig.IntPropertyFnWithNamespaceFilter
+	BlobSizeLimitError    dynamicconfig.IntPropertyFnWithNamespaceFilter
+	BlobSizeLimitWarn     dynamicconfig.IntPropertyFnWithNamespaceFilter
+	RequestSizeLimitError dynamicconfig.IntPropertyFnWithNamespaceFilter
+	RequestSizeLimitWarn  dynamicconfig.IntPropertyFnWithNamespaceFilter
 
 	ThrottledLogRPS dynamicconfig.IntPropertyFn
 
@@ -162,14 +184,28 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int32, esIndexName
 		MaxNamespaceRPSPerInstance:             dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxNamespaceRPSPerInstance, 2400),
 		MaxNamespaceBurstPerInstance:           dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxNamespaceBurstPerInstance, 4800),
 		MaxNamespaceCountPerInstance:           dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxNamespaceCountPerInstance, 1200),
+		MaxTaskQueuePollerCountPerInstance:     dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.FrontendMaxTaskQueuePollerCountPerInstance, 0),
 		MaxNamespaceVisibilityRPSPerInstance:   dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxNamespaceVisibilityRPSPerInstance, 10),
 		MaxNamespaceVisibilityBurstPerInstance: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxNamespaceVisibilityBurstPerInstance, 10),
 		GlobalNamespaceRPS:                     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendGlobalNamespaceRPS, 0),
+		EnableGlobalNamespaceRPSCoordination:   dc.GetBoolProperty(dynamicconfig.FrontendEnableGlobalNamespaceRPSCoordination, false),
+		GlobalNamespaceRPSReportInterval:       dc.GetDurationProperty(dynamicconfig.FrontendGlobalNamespaceRPSReportInterval, 5*time.Second),
+		ReplicationLagMetricsInterval:          dc.GetDurationProperty(dynamicconfig.FrontendReplicationLagMetricsInterval, time.Minute),
 		MaxIDLengthLimit:                       dc.GetIntProperty(dynamicconfig.MaxIDLengthLimit, 1000),
 		MaxBadBinaries:                         dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxBadBinaries, namespace.MaxBadBinaries),
+		NamespaceRenameAliasGracePeriod:        dc.GetDurationProperty(dynamicconfig.FrontendNamespaceRenameAliasGracePeriod, 7*24*time.Hour),
+		APIKeyRotationGracePeriod:              dc.GetDurationProperty(dynamicconfig.FrontendAPIKeyRotationGracePeriod, 24*time.Hour),
+
+		EnableAdaptiveLoadShedding:             dc.GetBoolProperty(dynamicconfig.FrontendEnableAdaptiveLoadShedding, false),
+		LoadSheddingHistoryLatencyThreshold:    dc.GetDurationProperty(dynamicconfig.FrontendLoadSheddingHistoryLatencyThreshold, time.Second),
+		LoadSheddingHistoryErrorRatioThreshold: dc.GetFloat64Property(dynamicconfig.FrontendLoadSheddingHistoryErrorRatioThreshold, 0.2),
+		LoadSheddingRetryAfter:                 dc.GetDurationProperty(dynamicconfig.FrontendLoadSheddingRetryAfter, time.Second),
+
 		DisableListVisibilityByFilter:          dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.DisableListVisibilityByFilter, false),
 		BlobSizeLimitError:                     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
 		BlobSizeLimitWarn:                      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitWarn, 256*1024),
+		RequestSizeLimitError:                  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.RequestSizeLimitError, 4*1024*1024),
+		RequestSizeLimitWarn:                   dc.GetIntPropertyFilteredByNamespace(dynamicconfig.RequestSizeLimitWarn, 512*1024),
 		ThrottledLogRPS:                        dc.GetIntProperty(dynamicconfig.FrontendThrottledLogRPS, 20),
 		ShutdownDrainDuration:                  dc.GetDurationProperty(dynamicconfig.FrontendShutdownDrainDuration, 0),
 		EnableNamespaceNotActiveAutoForwarding: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableNamespaceNotActiveAutoForwarding, true),
@@ -327,10 +363,18 @@ func namespaceRPS(
 	perInstanceRPSFn dynamicconfig.IntPropertyFnWithNamespaceFilter,
 	globalRPSFn dynamicconfig.IntPropertyFnWithNamespaceFilter,
 	frontendResolver membership.ServiceResolver,
+	quotaCoordinator *NamespaceQuotaCoordinator,
 	namespace string,
 ) float64 {
 	hostRPS := float64(perInstanceRPSFn(namespace))
 	globalRPS := float64(globalRPSFn(namespace))
+	if globalRPS > 0 {
+		// the allotment is this host's share of the global limit, adding the per instance limit
+		// on top of it would let the namespace exceed the global limit
+		if allotment, ok := quotaCoordinator.Allotment(namespace); ok {
+			return allotment
+		}
+	}
 	hosts := float64(numFrontendHosts(frontendResolver))
 
 	rps := hostRPS + globalRPS*math.Exp((1.0-hosts)/8.0)
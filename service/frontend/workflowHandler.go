@@ -142,6 +142,7 @@ func NewWorkflowHandler(
 			config.MaxBadBinaries,
 			logger,
 			persistenceMetadataManager,
+			namespaceRegistry,
 			clusterMetadata,
 			namespace.NewNamespaceReplicator(namespaceReplicationQueue, logger),
 			archivalMetadata,
@@ -62,7 +62,7 @@ type (
 		enableCrossNamespaceCommands    dynamicconfig.BoolPropertyFn
 	}
 
-	workflowSizeChecker struct {
+	workflowSizeLimits struct {
 		blobSizeLimitWarn  int
 		blobSizeLimitError int
 
@@ -75,6 +75,19 @@ type (
 		historyCountLimitWarn  int
 		historyCountLimitError int
 
+		numPendingActivitiesLimitWarn  int
+		numPendingActivitiesLimitError int
+
+		numPendingChildExecutionsLimitWarn  int
+		numPendingChildExecutionsLimitError int
+
+		numPendingSignalsLimitWarn  int
+		numPendingSignalsLimitError int
+	}
+
+	workflowSizeChecker struct {
+		workflowSizeLimits
+
 		completedID               int64
 		mutableState              workflow.MutableState
 		searchAttributesValidator *searchattribute.Validator
@@ -105,14 +118,7 @@ func newCommandAttrValidator(
 }
 
 func newWorkflowSizeChecker(
-	blobSizeLimitWarn int,
-	blobSizeLimitError int,
-	memoSizeLimitWarn int,
-	memoSizeLimitError int,
-	historySizeLimitWarn int,
-	historySizeLimitError int,
-	historyCountLimitWarn int,
-	historyCountLimitError int,
+	limits workflowSizeLimits,
 	completedID int64,
 	mutableState workflow.MutableState,
 	searchAttributesValidator *searchattribute.Validator,
@@ -121,14 +127,7 @@ func newWorkflowSizeChecker(
 	logger log.Logger,
 ) *workflowSizeChecker {
 	return &workflowSizeChecker{
-		blobSizeLimitWarn:         blobSizeLimitWarn,
-		blobSizeLimitError:        blobSizeLimitError,
-		memoSizeLimitWarn:         memoSizeLimitWarn,
-		memoSizeLimitError:        memoSizeLimitError,
-		historySizeLimitWarn:      historySizeLimitWarn,
-		historySizeLimitError:     historySizeLimitError,
-		historyCountLimitWarn:     historyCountLimitWarn,
-		historyCountLimitError:    historyCountLimitError,
+		workflowSizeLimits:        limits,
 		completedID:               completedID,
 		mutableState:              mutableState,
 		searchAttributesValidator: searchAttributesValidator,
@@ -231,6 +230,76 @@ func (c *workflowSizeChecker) failWorkflowIfSearchAttributesSizeExceedsLimit(
 	return true, nil
 }
 
+func (c *workflowSizeChecker) checkIfNumPendingActivitiesExceedsLimit() error {
+	return c.checkIfNumPendingItemsExceedsLimit(
+		len(c.mutableState.GetPendingActivityInfos()),
+		c.numPendingActivitiesLimitWarn,
+		c.numPendingActivitiesLimitError,
+		"pending activities",
+	)
+}
+
+func (c *workflowSizeChecker) checkIfNumPendingChildExecutionsExceedsLimit() error {
+	return c.checkIfNumPendingItemsExceedsLimit(
+		len(c.mutableState.GetPendingChildExecutionInfos()),
+		c.numPendingChildExecutionsLimitWarn,
+		c.numPendingChildExecutionsLimitError,
+		"pending child workflows",
+	)
+}
+
+func (c *workflowSizeChecker) checkIfNumPendingSignalsExceedsLimit() error {
+	return c.checkIfNumPendingItemsExceedsLimit(
+		len(c.mutableState.GetPendingSignalExternalInfos()),
+		c.numPendingSignalsLimitWarn,
+		c.numPendingSignalsLimitError,
+		"pending signals to external workflows",
+	)
+}
+
+// checkIfNumPendingItemsExceedsLimit returns an InvalidArgument error if adding one more pending item
+// to the mutable state would exceed the error limit, and logs a warning once the warn limit is reached.
+func (c *workflowSizeChecker) checkIfNumPendingItemsExceedsLimit(
+	numPending int,
+	limitWarn int,
+	limitError int,
+	itemName string,
+) error {
+
+	if limitWarn <= 0 && limitError <= 0 {
+		return nil
+	}
+
+	executionInfo := c.mutableState.GetExecutionInfo()
+	executionState := c.mutableState.GetExecutionState()
+	if limitError > 0 && numPending >= limitError {
+		c.logger.Warn(
+			fmt.Sprintf("Number of %v exceeds limit. Fail workflow task.", itemName),
+			tag.WorkflowNamespaceID(executionInfo.NamespaceId),
+			tag.WorkflowID(executionInfo.WorkflowId),
+			tag.WorkflowRunID(executionState.RunId),
+			tag.Counter(numPending),
+		)
+		return serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"The number of %v, %d, has reached the per-workflow limit of %d.",
+			itemName,
+			numPending,
+			limitError,
+		))
+	}
+
+	if limitWarn > 0 && numPending >= limitWarn {
+		c.logger.Warn(
+			fmt.Sprintf("Number of %v exceeds warn limit.", itemName),
+			tag.WorkflowNamespaceID(executionInfo.NamespaceId),
+			tag.WorkflowID(executionInfo.WorkflowId),
+			tag.WorkflowRunID(executionState.RunId),
+			tag.Counter(numPending),
+		)
+	}
+	return nil
+}
+
 func (v *commandAttrValidator) validateActivityScheduleAttributes(
 	namespaceID namespace.ID,
 	targetNamespaceID namespace.ID,
@@ -43,12 +43,15 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/tests"
+	"go.temporal.io/server/service/history/workflow"
 )
 
 var (
@@ -675,3 +678,50 @@ func (s *commandAttrValidatorSuite) TestValidateCommandSequence_InvalidTerminalC
 		s.IsType(&serviceerror.InvalidArgument{}, err)
 	}
 }
+
+func (s *commandAttrValidatorSuite) TestCheckIfNumPendingActivitiesExceedsLimit() {
+	mutableState := workflow.NewMockMutableState(s.controller)
+	mutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
+		NamespaceId: s.testNamespaceID.String(),
+		WorkflowId:  "test workflow ID",
+	}).AnyTimes()
+	mutableState.EXPECT().GetExecutionState().Return(&persistencespb.WorkflowExecutionState{
+		RunId: "test run ID",
+	}).AnyTimes()
+	checker := newWorkflowSizeChecker(
+		workflowSizeLimits{
+			numPendingActivitiesLimitWarn:  1,
+			numPendingActivitiesLimitError: 2,
+		},
+		common.EmptyEventID,
+		mutableState,
+		nil,
+		nil,
+		metrics.NoopScope,
+		log.NewNoopLogger(),
+	)
+
+	mutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistencespb.ActivityInfo{})
+	s.NoError(checker.checkIfNumPendingActivitiesExceedsLimit())
+
+	mutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistencespb.ActivityInfo{1: {}})
+	s.NoError(checker.checkIfNumPendingActivitiesExceedsLimit())
+
+	mutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistencespb.ActivityInfo{1: {}, 2: {}})
+	err := checker.checkIfNumPendingActivitiesExceedsLimit()
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *commandAttrValidatorSuite) TestCheckIfNumPendingItemsExceedsLimit_Disabled() {
+	checker := newWorkflowSizeChecker(
+		workflowSizeLimits{},
+		common.EmptyEventID,
+		workflow.NewMockMutableState(s.controller),
+		nil,
+		nil,
+		metrics.NoopScope,
+		log.NewNoopLogger(),
+	)
+
+	s.NoError(checker.checkIfNumPendingItemsExceedsLimit(100, 0, 0, "pending signals to external workflows"))
+}
@@ -32,6 +32,7 @@ var (
 	APIToPriority = map[string]int{
 		"CloseShard":                          0,
 		"GetShard":                            0,
+		"CompleteShardHandoff":                0,
 		"DeleteWorkflowExecution":             0,
 		"DescribeHistoryHost":                 0,
 		"DescribeMutableState":                0,
@@ -69,6 +70,7 @@ var (
 		"SyncActivity":                        0,
 		"SyncShardStatus":                     0,
 		"TerminateWorkflowExecution":          0,
+		"UnpauseWorkflowExecution":            0,
 		"GenerateLastHistoryReplicationTasks": 0,
 		"GetReplicationStatus":                0,
 	}
@@ -29,6 +29,7 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
@@ -613,6 +614,26 @@ func (h *Handler) GetShard(ctx context.Context, request *historyservice.GetShard
 	return &historyservice.GetShardResponse{ShardInfo: resp.ShardInfo}, nil
 }
 
+// CompleteShardHandoff is called by the previous owner of a shard once it has drained and released the shard
+func (h *Handler) CompleteShardHandoff(_ context.Context, request *historyservice.CompleteShardHandoffRequest) (_ *historyservice.CompleteShardHandoffResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	err := h.controller.CompleteShardHandoff(
+		request.GetShardId(),
+		request.GetPreviousOwner(),
+		timestamp.TimeValue(request.GetHandoffStartTime()),
+	)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return &historyservice.CompleteShardHandoffResponse{}, nil
+}
+
 // RebuildMutableState attempts to rebuild mutable state according to persisted history events
 func (h *Handler) RebuildMutableState(ctx context.Context, request *historyservice.RebuildMutableStateRequest) (_ *historyservice.RebuildMutableStateResponse, retError error) {
 	defer log.CapturePanic(h.logger, &retError)
@@ -1277,6 +1298,23 @@ func (h *Handler) GetReplicationMessages(ctx context.Context, request *historyse
 				return
 			}
 
+			if waitForNewTasks := timestamp.DurationValue(request.GetWaitForNewTasks()); waitForNewTasks > 0 &&
+				len(tasks.GetReplicationTasks()) == 0 && !tasks.GetHasMore() {
+				engine.WaitNewReplicationTasks(ctx, tasks.GetLastRetrievedMessageId(), waitForNewTasks)
+				// ack level is already updated by the first call
+				tasks, err = engine.GetReplicationMessages(
+					ctx,
+					request.GetClusterName(),
+					persistence.EmptyQueueMessageID,
+					time.Time{},
+					tasks.GetLastRetrievedMessageId(),
+				)
+				if err != nil {
+					h.logger.Warn("Failed to get replication tasks for shard", tag.Error(err))
+					return
+				}
+			}
+
 			result.Store(token.GetShardId(), tasks)
 		}(token)
 	}
@@ -1342,6 +1380,7 @@ func (h *Handler) GetDLQReplicationMessages(ctx context.Context, request *histor
 
 		tasks, err := engine.GetDLQReplicationMessages(
 			ctx,
+			request.GetClusterName(),
 			taskInfos,
 		)
 		if err != nil {
@@ -1443,12 +1482,12 @@ func (h *Handler) PurgeDLQMessages(ctx context.Context, request *historyservice.
 		return nil, err
 	}
 
-	err = engine.PurgeDLQMessages(ctx, request)
+	resp, err := engine.PurgeDLQMessages(ctx, request)
 	if err != nil {
 		err = h.convertError(err)
 		return nil, err
 	}
-	return &historyservice.PurgeDLQMessagesResponse{}, nil
+	return resp, nil
 }
 
 func (h *Handler) MergeDLQMessages(ctx context.Context, request *historyservice.MergeDLQMessagesRequest) (_ *historyservice.MergeDLQMessagesResponse, retError error) {
@@ -1508,6 +1547,34 @@ func (h *Handler) RefreshWorkflowTasks(ctx context.Context, request *historyserv
 	return &historyservice.RefreshWorkflowTasksResponse{}, nil
 }
 
+// UnpauseWorkflowExecution resumes workflow task scheduling of a workflow paused after repeated workflow task failures
+func (h *Handler) UnpauseWorkflowExecution(ctx context.Context, request *historyservice.UnpauseWorkflowExecutionRequest) (_ *historyservice.UnpauseWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.GetRequest().GetExecution().GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(ctx, namespaceID, workflowID)
+	if err1 != nil {
+		return nil, h.convertError(err1)
+	}
+
+	err2 := engine.UnpauseWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return nil, h.convertError(err2)
+	}
+
+	return &historyservice.UnpauseWorkflowExecutionResponse{}, nil
+}
+
 func (h *Handler) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *historyservice.GenerateLastHistoryReplicationTasksRequest,
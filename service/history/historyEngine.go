@@ -28,6 +28,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -1171,6 +1172,7 @@ func (e *historyEngineImpl) DescribeMutableState(
 	}
 
 	response.DatabaseMutableState = mutableState.CloneToProto()
+	response.SizeInfo = workflow.NewExecutionSizeInfo(response.DatabaseMutableState, context.GetHistorySize())
 	return response, nil
 }
 
@@ -1363,6 +1365,8 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 		result.PendingWorkflowTask = pendingWorkflowTask
 	}
 
+	result.SizeInfo = workflow.NewExecutionSizeInfo(mutableState.CloneToProto(), context.GetHistorySize())
+	result.ConflictResolutionRecords = executionInfo.GetConflictResolutionRecords()
 	return result, nil
 }
 
@@ -2093,7 +2097,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 			}
 
 			// Create a transfer task to schedule a workflow task
-			if !mutableState.HasPendingWorkflowTask() {
+			if !mutableState.HasPendingWorkflowTask() && !mutableState.IsWorkflowTaskPaused() {
 				_, err := mutableState.AddWorkflowTaskScheduledEvent(false)
 				if err != nil {
 					return nil, err
@@ -2719,7 +2723,7 @@ UpdateHistoryLoop:
 		mutableState := workflowContext.getMutableState()
 		if postActions.createWorkflowTask {
 			// Create a transfer task to schedule a workflow task
-			if !mutableState.HasPendingWorkflowTask() {
+			if !mutableState.HasPendingWorkflowTask() && !mutableState.IsWorkflowTaskPaused() {
 				if _, err := mutableState.AddWorkflowTaskScheduledEvent(
 					false,
 				); err != nil {
@@ -2846,8 +2850,14 @@ func (e *historyEngineImpl) NotifyNewTasks(
 			continue
 		}
 
-		if len(tasksByCategory) > 0 {
-			e.queueProcessors[category].NotifyNewTasks(clusterName, tasksByCategory)
+		if len(tasksByCategory) == 0 {
+			continue
+		}
+
+		// tasks of a category without a registered processor are persisted
+		// and will be loaded once a processor for the category is registered
+		if queueProcessor, ok := e.queueProcessors[category]; ok {
+			queueProcessor.NotifyNewTasks(clusterName, tasksByCategory)
 		}
 	}
 }
@@ -3181,18 +3191,31 @@ func (e *historyEngineImpl) GetReplicationMessages(
 	return replicationMessages, nil
 }
 
+// WaitNewReplicationTasks blocks until a replication task after lastRetrievedMessageID is generated or timeout.
+func (e *historyEngineImpl) WaitNewReplicationTasks(
+	ctx context.Context,
+	lastRetrievedMessageID int64,
+	timeout time.Duration,
+) {
+	e.replicatorProcessor.waitNewTasks(ctx, lastRetrievedMessageID, timeout)
+}
+
 func (e *historyEngineImpl) GetDLQReplicationMessages(
 	ctx context.Context,
+	pollingCluster string,
 	taskInfos []*replicationspb.ReplicationTaskInfo,
 ) ([]*replicationspb.ReplicationTask, error) {
 
 	tasks := make([]*replicationspb.ReplicationTask, 0, len(taskInfos))
 	for _, taskInfo := range taskInfos {
-		task, err := e.replicatorProcessor.getTask(ctx, taskInfo)
+		task, err := e.replicatorProcessor.getTask(ctx, pollingCluster, taskInfo)
 		if err != nil {
 			e.logger.Error("Failed to fetch DLQ replication messages.", tag.Error(err))
 			return nil, err
 		}
+		if task == nil {
+			continue
+		}
 		tasks = append(tasks, task)
 	}
 
@@ -3347,7 +3370,7 @@ func (e *historyEngineImpl) ReapplyEvents(
 			if mutableState.GetExecutionInfo().CronSchedule != "" && !mutableState.HasProcessedOrPendingWorkflowTask() {
 				postActions.createWorkflowTask = false
 			}
-			reappliedEvents, err := e.eventsReapplier.reapplyEvents(
+			reappliedEvents, isConflictRecorded, err := e.eventsReapplier.reapplyEvents(
 				ctx,
 				mutableState,
 				toReapplyEvents,
@@ -3358,8 +3381,9 @@ func (e *historyEngineImpl) ReapplyEvents(
 				return nil, err
 			}
 			if len(reappliedEvents) == 0 {
+				// the conflict resolution record of only dropped events still needs to be persisted
 				return &updateWorkflowAction{
-					noop:               true,
+					noop:               !isConflictRecorded,
 					createWorkflowTask: false,
 				}, nil
 			}
@@ -3397,18 +3421,40 @@ func (e *historyEngineImpl) GetDLQMessages(
 func (e *historyEngineImpl) PurgeDLQMessages(
 	ctx context.Context,
 	request *historyservice.PurgeDLQMessagesRequest,
-) error {
+) (*historyservice.PurgeDLQMessagesResponse, error) {
 
 	_, ok := e.clusterMetadata.GetAllClusterInfo()[request.GetSourceCluster()]
 	if !ok {
-		return consts.ErrUnknownCluster
+		return nil, consts.ErrUnknownCluster
+	}
+
+	if request.GetFilter() != nil || request.GetDryRun() {
+		count, token, err := e.replicationDLQHandler.purgeFilteredMessages(
+			ctx,
+			request.GetSourceCluster(),
+			request.GetInclusiveEndMessageId(),
+			int(request.GetMaximumPageSize()),
+			request.GetNextPageToken(),
+			request.GetFilter(),
+			request.GetDryRun(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &historyservice.PurgeDLQMessagesResponse{
+			MessageCount:  count,
+			NextPageToken: token,
+		}, nil
 	}
 
-	return e.replicationDLQHandler.purgeMessages(
+	if err := e.replicationDLQHandler.purgeMessages(
 		ctx,
 		request.GetSourceCluster(),
 		request.GetInclusiveEndMessageId(),
-	)
+	); err != nil {
+		return nil, err
+	}
+	return &historyservice.PurgeDLQMessagesResponse{}, nil
 }
 
 func (e *historyEngineImpl) MergeDLQMessages(
@@ -3421,6 +3467,25 @@ func (e *historyEngineImpl) MergeDLQMessages(
 		return nil, consts.ErrUnknownCluster
 	}
 
+	if request.GetFilter() != nil || request.GetDryRun() {
+		count, token, err := e.replicationDLQHandler.mergeFilteredMessages(
+			ctx,
+			request.GetSourceCluster(),
+			request.GetInclusiveEndMessageId(),
+			int(request.GetMaximumPageSize()),
+			request.GetNextPageToken(),
+			request.GetFilter(),
+			request.GetDryRun(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &historyservice.MergeDLQMessagesResponse{
+			NextPageToken: token,
+			MessageCount:  count,
+		}, nil
+	}
+
 	token, err := e.replicationDLQHandler.mergeMessages(
 		ctx,
 		request.GetSourceCluster(),
@@ -3505,6 +3570,47 @@ func (e *historyEngineImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+func (e *historyEngineImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UnpauseWorkflowExecutionRequest,
+) error {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(namespace.ID(request.GetNamespaceId()))
+	if err != nil {
+		return err
+	}
+	namespaceID := namespaceEntry.ID()
+
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: request.GetRequest().GetExecution().GetWorkflowId(),
+		RunId:      request.GetRequest().GetExecution().GetRunId(),
+	}
+
+	return e.updateWorkflowExecution(
+		ctx,
+		namespaceID,
+		execution,
+		func(workflowContext workflowContext) (*updateWorkflowAction, error) {
+			mutableState := workflowContext.getMutableState()
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, consts.ErrWorkflowCompleted
+			}
+			if !mutableState.IsWorkflowTaskPaused() {
+				return &updateWorkflowAction{
+					noop: true,
+				}, nil
+			}
+
+			if err := mutableState.UnpauseWorkflowTask(); err != nil {
+				return nil, err
+			}
+			return &updateWorkflowAction{
+				noop:               false,
+				createWorkflowTask: true,
+			}, nil
+		})
+}
+
 func (e *historyEngineImpl) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *historyservice.GenerateLastHistoryReplicationTasksRequest,
@@ -3572,9 +3678,145 @@ func (e *historyEngineImpl) GetReplicationStatus(
 	}
 	resp.RemoteClusters = remoteClusters
 	resp.HandoverNamespaces = handoverNamespaces
+
+	if request.GetIncludeDetails() {
+		if err := e.addReplicationStatusDetails(ctx, resp); err != nil {
+			return nil, err
+		}
+	}
 	return resp, nil
 }
 
+// addReplicationStatusDetails scans pending replication tasks and replication DLQ of the shard to report
+// per namespace lag and DLQ size for each remote cluster. Each scan is bounded by ReplicationStatusMaxScanTasks.
+func (e *historyEngineImpl) addReplicationStatusDetails(
+	ctx context.Context,
+	status *historyservice.ShardReplicationStatus,
+) error {
+
+	if len(status.RemoteClusters) == 0 {
+		return nil
+	}
+
+	maxScanTasks := e.config.ReplicationStatusMaxScanTasks()
+	batchSize := e.config.ReplicatorProcessorFetchTasksBatchSize()
+	minAckedTaskID := int64(math.MaxInt64)
+	for _, clusterStatus := range status.RemoteClusters {
+		clusterStatus.Namespaces = make(map[string]*replicationspb.NamespaceReplicationLag)
+		if clusterStatus.AckedTaskId < minAckedTaskID {
+			minAckedTaskID = clusterStatus.AckedTaskId
+		}
+	}
+
+	maxTaskID := e.replicatorProcessor.GetMaxReplicationTaskID()
+	if minAckedTaskID < maxTaskID {
+		if err := e.scanPendingReplicationTasks(ctx, status.RemoteClusters, minAckedTaskID, maxTaskID, batchSize, maxScanTasks); err != nil {
+			return err
+		}
+	}
+
+	for clusterName, clusterStatus := range status.RemoteClusters {
+		dlqSize, truncated, err := e.countReplicationDLQTasks(ctx, clusterName, batchSize, maxScanTasks)
+		if err != nil {
+			return err
+		}
+		clusterStatus.DlqSize = dlqSize
+		clusterStatus.Truncated = clusterStatus.Truncated || truncated
+	}
+	return nil
+}
+
+func (e *historyEngineImpl) scanPendingReplicationTasks(
+	ctx context.Context,
+	remoteClusters map[string]*historyservice.ShardReplicationStatusPerCluster,
+	minTaskID int64,
+	maxTaskID int64,
+	batchSize int,
+	maxScanTasks int,
+) error {
+
+	var pageToken []byte
+	scannedTasks := 0
+	for {
+		resp, err := e.executionManager.GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
+			ShardID:             e.shard.GetShardID(),
+			TaskCategory:        tasks.CategoryReplication,
+			InclusiveMinTaskKey: tasks.Key{TaskID: minTaskID + 1},
+			ExclusiveMaxTaskKey: tasks.Key{TaskID: maxTaskID + 1},
+			BatchSize:           batchSize,
+			NextPageToken:       pageToken,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, task := range resp.Tasks {
+			for _, clusterStatus := range remoteClusters {
+				if task.GetTaskID() <= clusterStatus.AckedTaskId {
+					continue
+				}
+				namespaceLag, ok := clusterStatus.Namespaces[task.GetNamespaceID()]
+				if !ok {
+					namespaceLag = &replicationspb.NamespaceReplicationLag{}
+					clusterStatus.Namespaces[task.GetNamespaceID()] = namespaceLag
+				}
+				namespaceLag.PendingTaskCount++
+				if namespaceLag.OldestPendingTaskTime == nil || task.GetVisibilityTime().Before(*namespaceLag.OldestPendingTaskTime) {
+					namespaceLag.OldestPendingTaskTime = timestamp.TimePtr(task.GetVisibilityTime())
+				}
+			}
+		}
+
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return nil
+		}
+		scannedTasks += len(resp.Tasks)
+		if scannedTasks >= maxScanTasks {
+			for _, clusterStatus := range remoteClusters {
+				clusterStatus.Truncated = true
+			}
+			return nil
+		}
+	}
+}
+
+func (e *historyEngineImpl) countReplicationDLQTasks(
+	ctx context.Context,
+	sourceCluster string,
+	batchSize int,
+	maxScanTasks int,
+) (int64, bool, error) {
+
+	var pageToken []byte
+	var count int64
+	for {
+		resp, err := e.executionManager.GetReplicationTasksFromDLQ(ctx, &persistence.GetReplicationTasksFromDLQRequest{
+			GetHistoryTasksRequest: persistence.GetHistoryTasksRequest{
+				ShardID:             e.shard.GetShardID(),
+				TaskCategory:        tasks.CategoryReplication,
+				InclusiveMinTaskKey: tasks.Key{TaskID: e.shard.GetReplicatorDLQAckLevel(sourceCluster) + 1},
+				ExclusiveMaxTaskKey: tasks.Key{TaskID: math.MaxInt64},
+				BatchSize:           batchSize,
+				NextPageToken:       pageToken,
+			},
+			SourceClusterName: sourceCluster,
+		})
+		if err != nil {
+			return 0, false, err
+		}
+
+		count += int64(len(resp.Tasks))
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return count, false, nil
+		}
+		if count >= int64(maxScanTasks) {
+			return count, true, nil
+		}
+	}
+}
+
 func (e *historyEngineImpl) loadWorkflowOnce(
 	ctx context.Context,
 	namespaceID namespace.ID,
@@ -48,6 +48,7 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
@@ -60,6 +61,8 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
@@ -1569,6 +1572,114 @@ func (s *engineSuite) TestRespondWorkflowTaskCompletedBadBinary() {
 	s.True(updatedWorkflowMutation.ExecutionInfo.WorkflowTaskScheduleId != common.EmptyEventID)
 }
 
+func (s *engineSuite) TestRespondWorkflowTaskCompletedBadBinary_WorkflowTaskPaused() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+	tl := "testTaskQueue"
+	tt := &tokenspb.Task{
+		ScheduleAttempt: 1,
+		WorkflowId:      tests.WorkflowID,
+		RunId:           tests.RunID,
+		ScheduleId:      2,
+	}
+	taskToken, _ := tt.Marshal()
+	identity := "testIdentity"
+	s.config.WorkflowTaskPauseAttempts = dynamicconfig.GetIntPropertyFilteredByNamespace(1)
+
+	ns := tests.LocalNamespaceEntry.Clone(
+		namespace.WithID(uuid.New()),
+		namespace.WithBadBinary("test-bad-binary"),
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(ns.ID()).Return(ns, nil).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespace(ns.ID()).Return(ns, nil).AnyTimes()
+	msBuilder := workflow.TestLocalMutableState(s.mockHistoryEngine.shard, s.eventsCache,
+		ns, log.NewTestLogger(), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+	addWorkflowTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+
+	gwmsResponse1 := &persistence.GetWorkflowExecutionResponse{State: workflow.TestCloneToProto(msBuilder)}
+	gwmsResponse2 := &persistence.GetWorkflowExecutionResponse{State: workflow.TestCloneToProto(msBuilder)}
+
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(gwmsResponse1, nil)
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(gwmsResponse2, nil)
+	var updatedWorkflowMutation persistence.WorkflowMutation
+	s.mockExecutionMgr.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+		updatedWorkflowMutation = request.UpdateWorkflowMutation
+		return tests.UpdateWorkflowExecutionResponse, nil
+	})
+
+	_, err := s.mockHistoryEngine.RespondWorkflowTaskCompleted(context.Background(), &historyservice.RespondWorkflowTaskCompletedRequest{
+		NamespaceId: ns.ID().String(),
+		CompleteRequest: &workflowservice.RespondWorkflowTaskCompletedRequest{
+			TaskToken:      taskToken,
+			Identity:       identity,
+			BinaryChecksum: "test-bad-binary",
+		},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	// the workflow task failed event is recorded, but no new workflow task is scheduled
+	s.NotNil(updatedWorkflowMutation.ExecutionInfo)
+	s.Equal(int64(5), updatedWorkflowMutation.NextEventID)
+	s.True(updatedWorkflowMutation.ExecutionInfo.WorkflowTaskPaused)
+	s.Equal(common.EmptyEventID, updatedWorkflowMutation.ExecutionInfo.WorkflowTaskScheduleId)
+	s.Empty(updatedWorkflowMutation.Tasks[tasks.CategoryTransfer])
+}
+
+func (s *engineSuite) TestRespondWorkflowTaskFailed_WorkflowTaskPaused() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+	tl := "testTaskQueue"
+	tt := &tokenspb.Task{
+		ScheduleAttempt: 1,
+		WorkflowId:      tests.WorkflowID,
+		RunId:           tests.RunID,
+		ScheduleId:      2,
+	}
+	taskToken, _ := tt.Marshal()
+	identity := "testIdentity"
+	s.config.WorkflowTaskPauseAttempts = dynamicconfig.GetIntPropertyFilteredByNamespace(1)
+
+	msBuilder := workflow.TestLocalMutableState(s.mockHistoryEngine.shard, s.eventsCache,
+		tests.LocalNamespaceEntry, log.NewTestLogger(), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+	addWorkflowTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: workflow.TestCloneToProto(msBuilder)}
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(gwmsResponse, nil)
+	var updatedWorkflowMutation persistence.WorkflowMutation
+	s.mockExecutionMgr.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+		updatedWorkflowMutation = request.UpdateWorkflowMutation
+		return tests.UpdateWorkflowExecutionResponse, nil
+	})
+
+	err := s.mockHistoryEngine.RespondWorkflowTaskFailed(context.Background(), &historyservice.RespondWorkflowTaskFailedRequest{
+		NamespaceId: tests.NamespaceID.String(),
+		FailedRequest: &workflowservice.RespondWorkflowTaskFailedRequest{
+			TaskToken: taskToken,
+			Cause:     enumspb.WORKFLOW_TASK_FAILED_CAUSE_WORKFLOW_WORKER_UNHANDLED_FAILURE,
+			Identity:  identity,
+		},
+	})
+	s.NoError(err)
+
+	s.NotNil(updatedWorkflowMutation.ExecutionInfo)
+	s.Equal(int64(5), updatedWorkflowMutation.NextEventID)
+	s.True(updatedWorkflowMutation.ExecutionInfo.WorkflowTaskPaused)
+	s.Equal(common.EmptyEventID, updatedWorkflowMutation.ExecutionInfo.WorkflowTaskScheduleId)
+	s.Empty(updatedWorkflowMutation.Tasks[tasks.CategoryTransfer])
+
+	executionBuilder := s.getBuilder(tests.NamespaceID, we)
+	s.True(executionBuilder.IsWorkflowTaskPaused())
+	s.False(executionBuilder.HasPendingWorkflowTask())
+}
+
 func (s *engineSuite) TestRespondWorkflowTaskCompletedSingleActivityScheduledWorkflowTask() {
 
 	we := commonpb.WorkflowExecution{
@@ -4959,6 +5070,129 @@ func (s *engineSuite) TestRemoveSignalMutableState() {
 	s.Nil(err)
 }
 
+func (s *engineSuite) TestUnpauseWorkflowExecution() {
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+	taskqueue := "testTaskQueue"
+	identity := "testIdentity"
+	unpauseRequest := &historyservice.UnpauseWorkflowExecutionRequest{
+		NamespaceId: tests.NamespaceID.String(),
+		Request: &adminservice.UnpauseWorkflowExecutionRequest{
+			Namespace: tests.Namespace.String(),
+			Execution: &execution,
+		},
+	}
+
+	msBuilder := workflow.TestLocalMutableState(s.mockHistoryEngine.shard, s.eventsCache,
+		tests.LocalNamespaceEntry, log.NewTestLogger(), tests.RunID)
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", taskqueue, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	ms := workflow.TestCloneToProto(msBuilder)
+	ms.ExecutionInfo.WorkflowTaskPaused = true
+	ms.ExecutionInfo.WorkflowTaskAttempt = 4
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(gwmsResponse, nil)
+	s.mockExecutionMgr.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).Return(tests.UpdateWorkflowExecutionResponse, nil)
+
+	err := s.mockHistoryEngine.UnpauseWorkflowExecution(context.Background(), unpauseRequest)
+	s.NoError(err)
+
+	executionBuilder := s.getBuilder(tests.NamespaceID, execution)
+	s.False(executionBuilder.IsWorkflowTaskPaused())
+	s.True(executionBuilder.HasPendingWorkflowTask())
+	s.Equal(int32(1), executionBuilder.GetExecutionInfo().WorkflowTaskAttempt)
+}
+
+func (s *engineSuite) TestUnpauseWorkflowExecution_NotPaused() {
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+	taskqueue := "testTaskQueue"
+	identity := "testIdentity"
+	unpauseRequest := &historyservice.UnpauseWorkflowExecutionRequest{
+		NamespaceId: tests.NamespaceID.String(),
+		Request: &adminservice.UnpauseWorkflowExecutionRequest{
+			Namespace: tests.Namespace.String(),
+			Execution: &execution,
+		},
+	}
+
+	msBuilder := workflow.TestLocalMutableState(s.mockHistoryEngine.shard, s.eventsCache,
+		tests.LocalNamespaceEntry, log.NewTestLogger(), tests.RunID)
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", taskqueue, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	addWorkflowTaskScheduledEvent(msBuilder)
+	ms := workflow.TestCloneToProto(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(gwmsResponse, nil)
+
+	err := s.mockHistoryEngine.UnpauseWorkflowExecution(context.Background(), unpauseRequest)
+	s.NoError(err)
+}
+
+func (s *engineSuite) TestGetReplicationStatus_IncludeDetails() {
+	now := time.Now().UTC()
+	maxTaskID := int64(10)
+	s.mockHistoryEngine.replicatorProcessor = &replicatorQueueProcessorImpl{
+		shard:     s.mockShard,
+		maxTaskID: &maxTaskID,
+	}
+	s.mockShard.UpdateRemoteClusterInfo("remote1", 7, now.Add(-time.Minute))
+	s.mockShard.UpdateRemoteClusterInfo("remote2", 9, now)
+
+	newTask := func(namespaceID string, taskID int64, age time.Duration) tasks.Task {
+		return &tasks.HistoryReplicationTask{
+			WorkflowKey:         definition.NewWorkflowKey(namespaceID, tests.WorkflowID, tests.RunID),
+			VisibilityTimestamp: now.Add(-age),
+			TaskID:              taskID,
+		}
+	}
+	s.mockExecutionMgr.EXPECT().GetHistoryTasks(gomock.Any(), &persistence.GetHistoryTasksRequest{
+		ShardID:             s.mockShard.GetShardID(),
+		TaskCategory:        tasks.CategoryReplication,
+		InclusiveMinTaskKey: tasks.Key{TaskID: 8},
+		ExclusiveMaxTaskKey: tasks.Key{TaskID: 11},
+		BatchSize:           s.config.ReplicatorProcessorFetchTasksBatchSize(),
+	}).Return(&persistence.GetHistoryTasksResponse{
+		Tasks: []tasks.Task{
+			newTask("namespace1", 8, 3*time.Second),
+			newTask("namespace2", 9, 2*time.Second),
+			newTask("namespace1", 10, time.Second),
+		},
+	}, nil)
+	s.mockExecutionMgr.EXPECT().GetReplicationTasksFromDLQ(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.GetReplicationTasksFromDLQRequest) (*persistence.GetHistoryTasksResponse, error) {
+			if request.SourceClusterName == "remote1" {
+				return &persistence.GetHistoryTasksResponse{Tasks: []tasks.Task{newTask("namespace1", 1, 0)}}, nil
+			}
+			return &persistence.GetHistoryTasksResponse{}, nil
+		},
+	).Times(2)
+
+	status, err := s.mockHistoryEngine.GetReplicationStatus(context.Background(), &historyservice.GetReplicationStatusRequest{
+		IncludeDetails: true,
+	})
+	s.NoError(err)
+	s.Equal(maxTaskID, status.GetMaxReplicationTaskId())
+
+	remote1 := status.GetRemoteClusters()["remote1"]
+	s.Equal(int64(1), remote1.GetDlqSize())
+	s.False(remote1.GetTruncated())
+	s.Len(remote1.GetNamespaces(), 2)
+	s.Equal(int64(2), remote1.GetNamespaces()["namespace1"].GetPendingTaskCount())
+	s.Equal(now.Add(-3*time.Second), timestamp.TimeValue(remote1.GetNamespaces()["namespace1"].GetOldestPendingTaskTime()))
+	s.Equal(int64(1), remote1.GetNamespaces()["namespace2"].GetPendingTaskCount())
+
+	remote2 := status.GetRemoteClusters()["remote2"]
+	s.Equal(int64(0), remote2.GetDlqSize())
+	s.Len(remote2.GetNamespaces(), 1)
+	s.Equal(int64(1), remote2.GetNamespaces()["namespace1"].GetPendingTaskCount())
+	s.Equal(now.Add(-time.Second), timestamp.TimeValue(remote2.GetNamespaces()["namespace1"].GetOldestPendingTaskTime()))
+}
+
 func (s *engineSuite) TestReapplyEvents_ReturnSuccess() {
 	workflowExecution := commonpb.WorkflowExecution{
 		WorkflowId: "test-reapply",
@@ -5000,6 +5234,60 @@ func (s *engineSuite) TestReapplyEvents_ReturnSuccess() {
 	s.NoError(err)
 }
 
+func (s *engineSuite) TestReapplyEvents_DroppedEventsOnly() {
+	workflowExecution := commonpb.WorkflowExecution{
+		WorkflowId: "test-reapply-dropped",
+		RunId:      tests.RunID,
+	}
+	taskqueue := "testTaskQueue"
+	identity := "testIdentity"
+
+	history := []*historypb.HistoryEvent{
+		{
+			EventId:   5,
+			EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+			Version:   1,
+		},
+	}
+	msBuilder := workflow.TestLocalMutableState(
+		s.mockHistoryEngine.shard,
+		s.eventsCache,
+		tests.LocalNamespaceEntry,
+		log.NewTestLogger(),
+		workflowExecution.GetRunId(),
+	)
+	// Add dummy event
+	addWorkflowExecutionStartedEvent(msBuilder, workflowExecution, "wType", taskqueue, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	ms := workflow.TestCloneToProto(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: tests.RunID}
+	s.mockExecutionMgr.EXPECT().GetCurrentExecution(gomock.Any(), gomock.Any()).Return(gceResponse, nil)
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(gwmsResponse, nil)
+	s.mockEventsReapplier.EXPECT().reapplyEvents(gomock.Any(), gomock.Any(), history, tests.RunID).DoAndReturn(
+		func(_ context.Context, mutableState workflow.MutableState, events []*historypb.HistoryEvent, runID string) ([]*historypb.HistoryEvent, bool, error) {
+			return nil, true, mutableState.AddConflictResolutionRecord(runID, nil, events)
+		},
+	)
+	var updatedWorkflowMutation persistence.WorkflowMutation
+	s.mockExecutionMgr.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+		updatedWorkflowMutation = request.UpdateWorkflowMutation
+		return tests.UpdateWorkflowExecutionResponse, nil
+	})
+
+	err := s.mockHistoryEngine.ReapplyEvents(
+		context.Background(),
+		tests.NamespaceID,
+		workflowExecution.GetWorkflowId(),
+		workflowExecution.GetRunId(),
+		history,
+	)
+	s.NoError(err)
+	records := updatedWorkflowMutation.ExecutionInfo.GetConflictResolutionRecords()
+	s.Len(records, 1)
+	s.Equal(int64(1), records[0].GetDroppedEventCount())
+	s.Equal(common.EmptyEventID, updatedWorkflowMutation.ExecutionInfo.GetWorkflowTaskScheduleId())
+}
+
 func (s *engineSuite) TestReapplyEvents_IgnoreSameVersionEvents() {
 	workflowExecution := commonpb.WorkflowExecution{
 		WorkflowId: "test-reapply-same-version",
@@ -41,12 +41,15 @@ import (
 
 type (
 	nDCEventsReapplier interface {
+		// reapplyEvents reapplies the events to the mutable state and returns the reapplied events.
+		// isConflictRecorded is set if a conflict resolution record was added to the mutable state,
+		// which also happens if events are only dropped.
 		reapplyEvents(
 			ctx context.Context,
 			msBuilder workflow.MutableState,
 			historyEvents []*historypb.HistoryEvent,
 			runID string,
-		) ([]*historypb.HistoryEvent, error)
+		) (reappliedEvents []*historypb.HistoryEvent, isConflictRecorded bool, err error)
 	}
 
 	nDCEventsReapplierImpl struct {
@@ -71,9 +74,10 @@ func (r *nDCEventsReapplierImpl) reapplyEvents(
 	msBuilder workflow.MutableState,
 	historyEvents []*historypb.HistoryEvent,
 	runID string,
-) ([]*historypb.HistoryEvent, error) {
+) ([]*historypb.HistoryEvent, bool, error) {
 
 	var reappliedEvents []*historypb.HistoryEvent
+	var droppedEvents []*historypb.HistoryEvent
 	for _, event := range historyEvents {
 		switch event.GetEventType() {
 		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED:
@@ -83,16 +87,25 @@ func (r *nDCEventsReapplierImpl) reapplyEvents(
 				continue
 			}
 			reappliedEvents = append(reappliedEvents, event)
+		default:
+			droppedEvents = append(droppedEvents, event)
 		}
 	}
 
 	if len(reappliedEvents) == 0 {
-		return nil, nil
+		if len(droppedEvents) == 0 {
+			return nil, false, nil
+		}
+		// nothing to reapply, but the dropped events are still lost to the conflict resolution
+		if err := msBuilder.AddConflictResolutionRecord(runID, nil, droppedEvents); err != nil {
+			return nil, false, err
+		}
+		return nil, true, nil
 	}
 
 	// sanity check workflow still running
 	if !msBuilder.IsWorkflowExecutionRunning() {
-		return nil, serviceerror.NewInternal("unable to reapply events to closed workflow.")
+		return nil, false, serviceerror.NewInternal("unable to reapply events to closed workflow.")
 	}
 
 	for _, event := range reappliedEvents {
@@ -103,10 +116,14 @@ func (r *nDCEventsReapplierImpl) reapplyEvents(
 			signal.GetIdentity(),
 			signal.GetHeader(),
 		); err != nil {
-			return nil, err
+			return nil, false, err
 		}
 		deDupResource := definition.NewEventReappliedID(runID, event.GetEventId(), event.GetVersion())
 		msBuilder.UpdateDuplicatedResource(deDupResource)
 	}
-	return reappliedEvents, nil
+
+	if err := msBuilder.AddConflictResolutionRecord(runID, reappliedEvents, droppedEvents); err != nil {
+		return nil, false, err
+	}
+	return reappliedEvents, true, nil
 }
@@ -61,12 +61,13 @@ func (m *MocknDCEventsReapplier) EXPECT() *MocknDCEventsReapplierMockRecorder {
 }
 
 // reapplyEvents mocks base method.
-func (m *MocknDCEventsReapplier) reapplyEvents(ctx context.Context, msBuilder workflow.MutableState, historyEvents []*history.HistoryEvent, runID string) ([]*history.HistoryEvent, error) {
+func (m *MocknDCEventsReapplier) reapplyEvents(ctx context.Context, msBuilder workflow.MutableState, historyEvents []*history.HistoryEvent, runID string) ([]*history.HistoryEvent, bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "reapplyEvents", ctx, msBuilder, historyEvents, runID)
 	ret0, _ := ret[0].([]*history.HistoryEvent)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // reapplyEvents indicates an expected call of reapplyEvents.
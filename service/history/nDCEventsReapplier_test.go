@@ -112,8 +112,14 @@ func (s *nDCEventReapplicationSuite) TestReapplyEvents_AppliedEvent() {
 		{EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
 		event,
 	}
-	appliedEvent, err := s.nDCReapplication.reapplyEvents(context.Background(), msBuilderCurrent, events, runID)
+	msBuilderCurrent.EXPECT().AddConflictResolutionRecord(
+		runID,
+		[]*historypb.HistoryEvent{event},
+		[]*historypb.HistoryEvent{events[0]},
+	).Return(nil)
+	appliedEvent, isConflictRecorded, err := s.nDCReapplication.reapplyEvents(context.Background(), msBuilderCurrent, events, runID)
 	s.NoError(err)
+	s.True(isConflictRecorded)
 	s.Equal(1, len(appliedEvent))
 }
 
@@ -136,8 +142,33 @@ func (s *nDCEventReapplicationSuite) TestReapplyEvents_Noop() {
 		{EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
 		event,
 	}
-	appliedEvent, err := s.nDCReapplication.reapplyEvents(context.Background(), msBuilderCurrent, events, runID)
+	msBuilderCurrent.EXPECT().AddConflictResolutionRecord(
+		runID,
+		nil,
+		[]*historypb.HistoryEvent{events[0]},
+	).Return(nil)
+	appliedEvent, isConflictRecorded, err := s.nDCReapplication.reapplyEvents(context.Background(), msBuilderCurrent, events, runID)
 	s.NoError(err)
+	s.True(isConflictRecorded)
+	s.Equal(0, len(appliedEvent))
+}
+
+func (s *nDCEventReapplicationSuite) TestReapplyEvents_DroppedEventsOnly() {
+	runID := uuid.New()
+	events := []*historypb.HistoryEvent{
+		{EventId: 5, EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED, Version: 2},
+		{EventId: 6, EventType: enumspb.EVENT_TYPE_TIMER_STARTED, Version: 2},
+	}
+
+	msBuilderCurrent := workflow.NewMockMutableState(s.controller)
+	msBuilderCurrent.EXPECT().AddConflictResolutionRecord(
+		runID,
+		nil,
+		events,
+	).Return(nil)
+	appliedEvent, isConflictRecorded, err := s.nDCReapplication.reapplyEvents(context.Background(), msBuilderCurrent, events, runID)
+	s.NoError(err)
+	s.True(isConflictRecorded)
 	s.Equal(0, len(appliedEvent))
 }
 
@@ -188,8 +219,14 @@ func (s *nDCEventReapplicationSuite) TestReapplyEvents_PartialAppliedEvent() {
 		event1,
 		event2,
 	}
-	appliedEvent, err := s.nDCReapplication.reapplyEvents(context.Background(), msBuilderCurrent, events, runID)
+	msBuilderCurrent.EXPECT().AddConflictResolutionRecord(
+		runID,
+		[]*historypb.HistoryEvent{event1},
+		[]*historypb.HistoryEvent{events[0]},
+	).Return(nil)
+	appliedEvent, isConflictRecorded, err := s.nDCReapplication.reapplyEvents(context.Background(), msBuilderCurrent, events, runID)
 	s.NoError(err)
+	s.True(isConflictRecorded)
 	s.Equal(1, len(appliedEvent))
 }
 
@@ -226,7 +263,8 @@ func (s *nDCEventReapplicationSuite) TestReapplyEvents_Error() {
 		{EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
 		event,
 	}
-	appliedEvent, err := s.nDCReapplication.reapplyEvents(context.Background(), msBuilderCurrent, events, runID)
+	appliedEvent, isConflictRecorded, err := s.nDCReapplication.reapplyEvents(context.Background(), msBuilderCurrent, events, runID)
 	s.Error(err)
+	s.False(isConflictRecorded)
 	s.Equal(0, len(appliedEvent))
 }
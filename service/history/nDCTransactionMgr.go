@@ -303,7 +303,7 @@ func (r *nDCTransactionMgrImpl) backfillWorkflowEventsReapply(
 	if isCurrentWorkflow && isActiveCluster {
 		// case 1.a
 		if isWorkflowRunning {
-			if _, err := r.eventsReapplier.reapplyEvents(
+			if _, _, err := r.eventsReapplier.reapplyEvents(
 				ctx,
 				targetWorkflow.getMutableState(),
 				targetWorkflowEvents.Events,
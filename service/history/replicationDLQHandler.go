@@ -44,6 +44,7 @@ import (
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
@@ -76,6 +77,29 @@ type (
 			pageSize int,
 			pageToken []byte,
 		) ([]byte, error)
+		// purgeFilteredMessages purges one page of messages matching the filter.
+		// Unlike purgeMessages, it deletes messages one by one and does not move the DLQ ack level.
+		purgeFilteredMessages(
+			ctx context.Context,
+			sourceCluster string,
+			lastMessageID int64,
+			pageSize int,
+			pageToken []byte,
+			filter *replicationspb.ReplicationDLQFilter,
+			dryRun bool,
+		) (int64, []byte, error)
+		// mergeFilteredMessages merges one page of messages matching the filter and returns the number
+		// of merged messages. Unlike mergeMessages, it only deletes merged messages and does not move
+		// the DLQ ack level, messages which fail to apply are kept in the DLQ.
+		mergeFilteredMessages(
+			ctx context.Context,
+			sourceCluster string,
+			lastMessageID int64,
+			pageSize int,
+			pageToken []byte,
+			filter *replicationspb.ReplicationDLQFilter,
+			dryRun bool,
+		) (int64, []byte, error)
 	}
 
 	replicationDLQHandlerImpl struct {
@@ -146,13 +170,39 @@ func (r *replicationDLQHandlerImpl) readMessagesWithAckLevel(
 	pageToken []byte,
 ) ([]*replicationspb.ReplicationTask, int64, []byte, error) {
 
+	taskInfo, ackLevel, pageToken, err := r.readTaskInfo(
+		ctx,
+		sourceCluster,
+		lastMessageID,
+		pageSize,
+		pageToken,
+	)
+	if err != nil {
+		return nil, ackLevel, nil, err
+	}
+
+	replicationTasks, err := r.getReplicationTasks(ctx, sourceCluster, taskInfo)
+	if err != nil {
+		return nil, ackLevel, nil, err
+	}
+	return replicationTasks, ackLevel, pageToken, nil
+}
+
+func (r *replicationDLQHandlerImpl) readTaskInfo(
+	ctx context.Context,
+	sourceCluster string,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*replicationspb.ReplicationTaskInfo, int64, []byte, error) {
+
 	ackLevel := r.shard.GetReplicatorDLQAckLevel(sourceCluster)
 	resp, err := r.shard.GetExecutionManager().GetReplicationTasksFromDLQ(ctx, &persistence.GetReplicationTasksFromDLQRequest{
 		GetHistoryTasksRequest: persistence.GetHistoryTasksRequest{
 			ShardID:             r.shard.GetShardID(),
 			TaskCategory:        tasks.CategoryReplication,
 			InclusiveMinTaskKey: tasks.Key{TaskID: ackLevel + 1},
-			ExclusiveMaxTaskKey: tasks.Key{TaskID: lastMessageID + 1},
+			ExclusiveMaxTaskKey: tasks.Key{TaskID: exclusiveMaxMessageID(lastMessageID)},
 			BatchSize:           pageSize,
 			NextPageToken:       pageToken,
 		},
@@ -161,9 +211,7 @@ func (r *replicationDLQHandlerImpl) readMessagesWithAckLevel(
 	if err != nil {
 		return nil, ackLevel, nil, err
 	}
-	pageToken = resp.NextPageToken
 
-	remoteAdminClient := r.shard.GetRemoteAdminClient(sourceCluster)
 	taskInfo := make([]*replicationspb.ReplicationTaskInfo, 0, len(resp.Tasks))
 	for _, task := range resp.Tasks {
 		switch task := task.(type) {
@@ -195,22 +243,30 @@ func (r *replicationDLQHandlerImpl) readMessagesWithAckLevel(
 			panic(fmt.Sprintf("Unknown repication task type: %v", task))
 		}
 	}
+	return taskInfo, ackLevel, resp.NextPageToken, nil
+}
+
+func (r *replicationDLQHandlerImpl) getReplicationTasks(
+	ctx context.Context,
+	sourceCluster string,
+	taskInfo []*replicationspb.ReplicationTaskInfo,
+) ([]*replicationspb.ReplicationTask, error) {
 
 	if len(taskInfo) == 0 {
-		return nil, ackLevel, pageToken, nil
+		return nil, nil
 	}
 
-	dlqResponse, err := remoteAdminClient.GetDLQReplicationMessages(
+	dlqResponse, err := r.shard.GetRemoteAdminClient(sourceCluster).GetDLQReplicationMessages(
 		ctx,
 		&adminservice.GetDLQReplicationMessagesRequest{
-			TaskInfos: taskInfo,
+			TaskInfos:   taskInfo,
+			ClusterName: r.shard.GetClusterMetadata().GetCurrentClusterName(),
 		},
 	)
 	if err != nil {
-		return nil, ackLevel, nil, err
+		return nil, err
 	}
-
-	return dlqResponse.ReplicationTasks, ackLevel, pageToken, nil
+	return dlqResponse.ReplicationTasks, nil
 }
 
 func (r *replicationDLQHandlerImpl) purgeMessages(
@@ -227,7 +283,7 @@ func (r *replicationDLQHandlerImpl) purgeMessages(
 				ShardID:             r.shard.GetShardID(),
 				TaskCategory:        tasks.CategoryReplication,
 				InclusiveMinTaskKey: tasks.Key{TaskID: ackLevel + 1},
-				ExclusiveMaxTaskKey: tasks.Key{TaskID: lastMessageID + 1},
+				ExclusiveMaxTaskKey: tasks.Key{TaskID: exclusiveMaxMessageID(lastMessageID)},
 			},
 			SourceClusterName: sourceCluster,
 		},
@@ -283,7 +339,7 @@ func (r *replicationDLQHandlerImpl) mergeMessages(
 				ShardID:             r.shard.GetShardID(),
 				TaskCategory:        tasks.CategoryReplication,
 				InclusiveMinTaskKey: tasks.Key{TaskID: ackLevel + 1},
-				ExclusiveMaxTaskKey: tasks.Key{TaskID: lastMessageID + 1},
+				ExclusiveMaxTaskKey: tasks.Key{TaskID: exclusiveMaxMessageID(lastMessageID)},
 			},
 			SourceClusterName: sourceCluster,
 		},
@@ -302,6 +358,128 @@ func (r *replicationDLQHandlerImpl) mergeMessages(
 	return token, nil
 }
 
+func (r *replicationDLQHandlerImpl) purgeFilteredMessages(
+	ctx context.Context,
+	sourceCluster string,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+	filter *replicationspb.ReplicationDLQFilter,
+	dryRun bool,
+) (int64, []byte, error) {
+
+	taskInfo, _, token, err := r.readTaskInfo(
+		ctx,
+		sourceCluster,
+		lastMessageID,
+		pageSize,
+		pageToken,
+	)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	taskInfo = filterReplicationTaskInfo(taskInfo, filter)
+	if dryRun {
+		return int64(len(taskInfo)), token, nil
+	}
+
+	if err := r.deleteMessages(ctx, sourceCluster, taskInfo); err != nil {
+		return 0, nil, err
+	}
+	return int64(len(taskInfo)), token, nil
+}
+
+func (r *replicationDLQHandlerImpl) mergeFilteredMessages(
+	ctx context.Context,
+	sourceCluster string,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+	filter *replicationspb.ReplicationDLQFilter,
+	dryRun bool,
+) (int64, []byte, error) {
+
+	taskInfo, _, token, err := r.readTaskInfo(
+		ctx,
+		sourceCluster,
+		lastMessageID,
+		pageSize,
+		pageToken,
+	)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	taskInfo = filterReplicationTaskInfo(taskInfo, filter)
+	if dryRun {
+		return int64(len(taskInfo)), token, nil
+	}
+
+	replicationTasks, err := r.getReplicationTasks(ctx, sourceCluster, taskInfo)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	taskExecutor, err := r.getOrCreateTaskExecutor(sourceCluster)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// a failing task stays in the DLQ so that it does not block the merge of the other tasks,
+	// tasks which are no longer returned by the source cluster have nothing left to apply
+	failedTaskIDs := make(map[int64]struct{})
+	for _, task := range replicationTasks {
+		if _, err := taskExecutor.execute(
+			task,
+			true,
+		); err != nil {
+			r.logger.Warn("Failed to merge replication DLQ message.",
+				tag.SourceCluster(sourceCluster),
+				tag.TaskID(task.GetSourceTaskId()),
+				tag.Error(err),
+			)
+			r.shard.GetMetricsClient().IncCounter(metrics.ReplicationDLQStatsScope, metrics.ReplicationDLQMergeFailed)
+			failedTaskIDs[task.GetSourceTaskId()] = struct{}{}
+		}
+	}
+
+	mergedTaskInfo := make([]*replicationspb.ReplicationTaskInfo, 0, len(taskInfo))
+	for _, info := range taskInfo {
+		if _, ok := failedTaskIDs[info.GetTaskId()]; !ok {
+			mergedTaskInfo = append(mergedTaskInfo, info)
+		}
+	}
+	if err := r.deleteMessages(ctx, sourceCluster, mergedTaskInfo); err != nil {
+		return 0, nil, err
+	}
+	return int64(len(mergedTaskInfo)), token, nil
+}
+
+func (r *replicationDLQHandlerImpl) deleteMessages(
+	ctx context.Context,
+	sourceCluster string,
+	taskInfo []*replicationspb.ReplicationTaskInfo,
+) error {
+
+	for _, info := range taskInfo {
+		if err := r.shard.GetExecutionManager().DeleteReplicationTaskFromDLQ(
+			ctx,
+			&persistence.DeleteReplicationTaskFromDLQRequest{
+				CompleteHistoryTaskRequest: persistence.CompleteHistoryTaskRequest{
+					ShardID:      r.shard.GetShardID(),
+					TaskCategory: tasks.CategoryReplication,
+					TaskKey:      tasks.Key{TaskID: info.GetTaskId()},
+				},
+				SourceClusterName: sourceCluster,
+			},
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *replicationDLQHandlerImpl) getOrCreateTaskExecutor(clusterName string) (replicationTaskExecutor, error) {
 	r.taskExecutorsLock.Lock()
 	defer r.taskExecutorsLock.Unlock()
@@ -348,3 +526,33 @@ func (r *replicationDLQHandlerImpl) getOrCreateTaskExecutor(clusterName string)
 	r.taskExecutors[clusterName] = taskExecutor
 	return taskExecutor, nil
 }
+
+func filterReplicationTaskInfo(
+	taskInfo []*replicationspb.ReplicationTaskInfo,
+	filter *replicationspb.ReplicationDLQFilter,
+) []*replicationspb.ReplicationTaskInfo {
+
+	filtered := make([]*replicationspb.ReplicationTaskInfo, 0, len(taskInfo))
+	for _, info := range taskInfo {
+		if filter.GetNamespaceId() != "" && filter.GetNamespaceId() != info.GetNamespaceId() {
+			continue
+		}
+		if filter.GetWorkflowId() != "" && filter.GetWorkflowId() != info.GetWorkflowId() {
+			continue
+		}
+		if filter.GetTaskType() != enumsspb.TASK_TYPE_UNSPECIFIED && filter.GetTaskType() != info.GetTaskType() {
+			continue
+		}
+		filtered = append(filtered, info)
+	}
+	return filtered
+}
+
+// exclusiveMaxMessageID converts inclusive last message ID to exclusive max task ID,
+// without overflowing when last message ID is common.EndMessageID.
+func exclusiveMaxMessageID(lastMessageID int64) int64 {
+	if lastMessageID == common.EndMessageID {
+		return lastMessageID
+	}
+	return lastMessageID + 1
+}
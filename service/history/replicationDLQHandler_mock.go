@@ -75,6 +75,22 @@ func (mr *MockreplicationDLQHandlerMockRecorder) getMessages(ctx, sourceCluster,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getMessages", reflect.TypeOf((*MockreplicationDLQHandler)(nil).getMessages), ctx, sourceCluster, lastMessageID, pageSize, pageToken)
 }
 
+// mergeFilteredMessages mocks base method.
+func (m *MockreplicationDLQHandler) mergeFilteredMessages(ctx context.Context, sourceCluster string, lastMessageID int64, pageSize int, pageToken []byte, filter *repication.ReplicationDLQFilter, dryRun bool) (int64, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "mergeFilteredMessages", ctx, sourceCluster, lastMessageID, pageSize, pageToken, filter, dryRun)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// mergeFilteredMessages indicates an expected call of mergeFilteredMessages.
+func (mr *MockreplicationDLQHandlerMockRecorder) mergeFilteredMessages(ctx, sourceCluster, lastMessageID, pageSize, pageToken, filter, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mergeFilteredMessages", reflect.TypeOf((*MockreplicationDLQHandler)(nil).mergeFilteredMessages), ctx, sourceCluster, lastMessageID, pageSize, pageToken, filter, dryRun)
+}
+
 // mergeMessages mocks base method.
 func (m *MockreplicationDLQHandler) mergeMessages(ctx context.Context, sourceCluster string, lastMessageID int64, pageSize int, pageToken []byte) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -90,6 +106,22 @@ func (mr *MockreplicationDLQHandlerMockRecorder) mergeMessages(ctx, sourceCluste
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mergeMessages", reflect.TypeOf((*MockreplicationDLQHandler)(nil).mergeMessages), ctx, sourceCluster, lastMessageID, pageSize, pageToken)
 }
 
+// purgeFilteredMessages mocks base method.
+func (m *MockreplicationDLQHandler) purgeFilteredMessages(ctx context.Context, sourceCluster string, lastMessageID int64, pageSize int, pageToken []byte, filter *repication.ReplicationDLQFilter, dryRun bool) (int64, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "purgeFilteredMessages", ctx, sourceCluster, lastMessageID, pageSize, pageToken, filter, dryRun)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// purgeFilteredMessages indicates an expected call of purgeFilteredMessages.
+func (mr *MockreplicationDLQHandlerMockRecorder) purgeFilteredMessages(ctx, sourceCluster, lastMessageID, pageSize, pageToken, filter, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "purgeFilteredMessages", reflect.TypeOf((*MockreplicationDLQHandler)(nil).purgeFilteredMessages), ctx, sourceCluster, lastMessageID, pageSize, pageToken, filter, dryRun)
+}
+
 // purgeMessages mocks base method.
 func (m *MockreplicationDLQHandler) purgeMessages(ctx context.Context, sourceCluster string, lastMessageID int64) error {
 	m.ctrl.T.Helper()
@@ -33,6 +33,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/adminservicemock/v1"
@@ -298,3 +299,110 @@ func (s *replicationDLQHandlerSuite) TestMergeMessages() {
 	s.NoError(err)
 	s.Equal(pageToken, token)
 }
+
+func (s *replicationDLQHandlerSuite) TestPurgeFilteredMessages() {
+	namespaceID := uuid.New()
+	workflowID := uuid.New()
+	lastMessageID := int64(1394)
+	pageSize := 10
+	pageToken := []byte("some random token")
+
+	dbResp := &persistence.GetHistoryTasksResponse{
+		Tasks: []tasks.Task{
+			&tasks.HistoryReplicationTask{
+				WorkflowKey: definition.NewWorkflowKey(namespaceID, workflowID, uuid.New()),
+				TaskID:      1,
+			},
+			&tasks.HistoryReplicationTask{
+				WorkflowKey: definition.NewWorkflowKey(namespaceID, uuid.New(), uuid.New()),
+				TaskID:      2,
+			},
+		},
+		NextPageToken: pageToken,
+	}
+	s.executionManager.EXPECT().GetReplicationTasksFromDLQ(gomock.Any(), gomock.Any()).Return(dbResp, nil).Times(2)
+	s.executionManager.EXPECT().DeleteReplicationTaskFromDLQ(
+		gomock.Any(),
+		&persistence.DeleteReplicationTaskFromDLQRequest{
+			CompleteHistoryTaskRequest: persistence.CompleteHistoryTaskRequest{
+				ShardID:      s.mockShard.GetShardID(),
+				TaskCategory: tasks.CategoryReplication,
+				TaskKey:      tasks.Key{TaskID: 1},
+			},
+			SourceClusterName: s.sourceCluster,
+		}).Return(nil)
+
+	filter := &replicationspb.ReplicationDLQFilter{
+		NamespaceId: namespaceID,
+		WorkflowId:  workflowID,
+	}
+	count, token, err := s.replicationMessageHandler.purgeFilteredMessages(
+		context.Background(), s.sourceCluster, lastMessageID, pageSize, nil, filter, true,
+	)
+	s.NoError(err)
+	s.Equal(int64(1), count)
+	s.Equal(pageToken, token)
+
+	count, token, err = s.replicationMessageHandler.purgeFilteredMessages(
+		context.Background(), s.sourceCluster, lastMessageID, pageSize, nil, filter, false,
+	)
+	s.NoError(err)
+	s.Equal(int64(1), count)
+	s.Equal(pageToken, token)
+}
+
+func (s *replicationDLQHandlerSuite) TestMergeFilteredMessages_KeepFailedMessages() {
+	ctx := context.Background()
+	namespaceID := uuid.New()
+	lastMessageID := int64(1394)
+	pageSize := 10
+	pageToken := []byte("some random token")
+
+	dbResp := &persistence.GetHistoryTasksResponse{
+		Tasks: []tasks.Task{
+			&tasks.HistoryReplicationTask{
+				WorkflowKey: definition.NewWorkflowKey(namespaceID, uuid.New(), uuid.New()),
+				TaskID:      1,
+			},
+			&tasks.HistoryReplicationTask{
+				WorkflowKey: definition.NewWorkflowKey(namespaceID, uuid.New(), uuid.New()),
+				TaskID:      2,
+			},
+		},
+		NextPageToken: pageToken,
+	}
+	failedTask := &replicationspb.ReplicationTask{
+		TaskType:     enumsspb.REPLICATION_TASK_TYPE_HISTORY_TASK,
+		SourceTaskId: 1,
+	}
+	mergedTask := &replicationspb.ReplicationTask{
+		TaskType:     enumsspb.REPLICATION_TASK_TYPE_HISTORY_TASK,
+		SourceTaskId: 2,
+	}
+
+	s.executionManager.EXPECT().GetReplicationTasksFromDLQ(gomock.Any(), gomock.Any()).Return(dbResp, nil)
+	s.mockClientBean.EXPECT().GetRemoteAdminClient(s.sourceCluster).Return(s.adminClient).AnyTimes()
+	s.adminClient.EXPECT().GetDLQReplicationMessages(ctx, gomock.Any()).
+		Return(&adminservice.GetDLQReplicationMessagesResponse{
+			ReplicationTasks: []*replicationspb.ReplicationTask{failedTask, mergedTask},
+		}, nil)
+	s.taskExecutor.EXPECT().execute(failedTask, true).Return(0, serviceerror.NewInternal("poison message"))
+	s.taskExecutor.EXPECT().execute(mergedTask, true).Return(0, nil)
+	s.executionManager.EXPECT().DeleteReplicationTaskFromDLQ(
+		gomock.Any(),
+		&persistence.DeleteReplicationTaskFromDLQRequest{
+			CompleteHistoryTaskRequest: persistence.CompleteHistoryTaskRequest{
+				ShardID:      s.mockShard.GetShardID(),
+				TaskCategory: tasks.CategoryReplication,
+				TaskKey:      tasks.Key{TaskID: 2},
+			},
+			SourceClusterName: s.sourceCluster,
+		}).Return(nil)
+
+	count, token, err := s.replicationMessageHandler.mergeFilteredMessages(
+		ctx, s.sourceCluster, lastMessageID, pageSize, nil, &replicationspb.ReplicationDLQFilter{}, false,
+	)
+	s.NoError(err)
+	s.Equal(int64(1), count)
+	s.Equal(pageToken, token)
+}
@@ -90,6 +90,7 @@ type (
 		rateLimiter    quotas.RateLimiter
 		requestChan    chan *replicationTaskRequest
 		shutdownChan   chan struct{}
+		streams        *replicationTaskStreams
 
 		workers map[int]*replicationTaskFetcherWorker
 	}
@@ -104,6 +105,7 @@ type (
 		rateLimiter    quotas.RateLimiter
 		requestChan    chan *replicationTaskRequest
 		shutdownChan   chan struct{}
+		streams        *replicationTaskStreams
 
 		requestByShard map[int32]*replicationTaskRequest
 	}
@@ -223,6 +225,13 @@ func newReplicationTaskFetcher(
 	rateLimiter := quotas.NewDefaultOutgoingRateLimiter(
 		func() float64 { return config.ReplicationTaskProcessorHostQPS() },
 	)
+	streams := newReplicationTaskStreams(
+		logger,
+		sourceCluster,
+		currentCluster,
+		config,
+		clientBean,
+	)
 
 	workers := make(map[int]*replicationTaskFetcherWorker)
 	for i := 0; i < numWorker; i++ {
@@ -235,6 +244,7 @@ func newReplicationTaskFetcher(
 			rateLimiter,
 			requestChan,
 			shutdownChan,
+			streams,
 		)
 	}
 
@@ -248,6 +258,7 @@ func newReplicationTaskFetcher(
 		rateLimiter:    rateLimiter,
 		requestChan:    requestChan,
 		shutdownChan:   shutdownChan,
+		streams:        streams,
 		workers:        workers,
 	}
 }
@@ -279,6 +290,7 @@ func (f *ReplicationTaskFetcherImpl) Stop() {
 	}
 
 	close(f.shutdownChan)
+	f.streams.stop()
 	for _, worker := range f.workers {
 		worker.Stop()
 	}
@@ -309,6 +321,7 @@ func newReplicationTaskFetcherWorker(
 	rateLimiter quotas.RateLimiter,
 	requestChan chan *replicationTaskRequest,
 	shutdownChan chan struct{},
+	streams *replicationTaskStreams,
 ) *replicationTaskFetcherWorker {
 	return &replicationTaskFetcherWorker{
 		status:         common.DaemonStatusInitialized,
@@ -320,6 +333,7 @@ func newReplicationTaskFetcherWorker(
 		rateLimiter:    rateLimiter,
 		requestChan:    requestChan,
 		shutdownChan:   shutdownChan,
+		streams:        streams,
 
 		requestByShard: make(map[int32]*replicationTaskRequest, requestsMapSize),
 	}
@@ -363,6 +377,10 @@ func (f *replicationTaskFetcherWorker) fetchTasks() {
 	for {
 		select {
 		case request := <-f.requestChan:
+			if f.streams.isEnabled() {
+				f.streams.sendRequest(request)
+				continue
+			}
 			f.bufferRequests(request)
 
 		case <-timer.C:
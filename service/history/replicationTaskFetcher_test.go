@@ -29,6 +29,7 @@ import (
 	"reflect"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -41,6 +42,7 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/tests"
@@ -296,6 +298,82 @@ func (s *replicationTaskFetcherSuite) TestGetMessages_Error() {
 	s.Equal((*replicationspb.ReplicationMessages)(nil), <-respChan2)
 }
 
+func (s *replicationTaskFetcherSuite) TestStreamRequest_Success() {
+	s.config.ReplicationTaskFetcherStreamingClusters = dynamicconfig.GetMapPropertyFn(map[string]interface{}{
+		cluster.TestAlternativeClusterName: true,
+	})
+	s.config.ReplicationTaskFetcherStreamMaxWait = dynamicconfig.GetDurationPropertyFn(time.Second)
+	s.True(s.replicationTaskFetcher.streams.isEnabled())
+	defer s.replicationTaskFetcher.streams.stop()
+
+	shardID := int32(1)
+	respChan := make(chan *replicationspb.ReplicationMessages, 1)
+	shardRequest := &replicationTaskRequest{
+		token: &replicationspb.ReplicationToken{
+			ShardId:                shardID,
+			LastProcessedMessageId: 1,
+			LastRetrievedMessageId: 2,
+		},
+		respChan: respChan,
+	}
+	messages := &replicationspb.ReplicationMessages{
+		ReplicationTasks:       []*replicationspb.ReplicationTask{{SourceTaskId: 3}},
+		LastRetrievedMessageId: 3,
+	}
+
+	stream := adminservicemock.NewMockAdminService_StreamReplicationMessagesClient(s.controller)
+	s.frontendClient.EXPECT().StreamReplicationMessages(gomock.Any()).Return(stream, nil)
+	stream.EXPECT().Send(&adminservice.StreamReplicationMessagesRequest{
+		ClusterName: cluster.TestCurrentClusterName,
+		Token:       shardRequest.token,
+		MaxWait:     timestamp.DurationPtr(time.Second),
+	}).Return(nil)
+	stream.EXPECT().Recv().Return(&adminservice.StreamReplicationMessagesResponse{
+		ShardId:  shardID,
+		Messages: messages,
+	}, nil)
+
+	s.replicationTaskFetcher.streams.sendRequest(shardRequest)
+	resp := <-respChan
+	s.Equal(messages, resp)
+	s.True(resp.GetHasMore())
+}
+
+func (s *replicationTaskFetcherSuite) TestStreamRequest_Error() {
+	s.config.ReplicationTaskFetcherStreamingClusters = dynamicconfig.GetMapPropertyFn(map[string]interface{}{
+		cluster.TestAlternativeClusterName: true,
+	})
+	s.config.ReplicationTaskFetcherErrorRetryWait = dynamicconfig.GetDurationPropertyFn(time.Millisecond)
+	defer s.replicationTaskFetcher.streams.stop()
+
+	respChan := make(chan *replicationspb.ReplicationMessages, 1)
+	shardRequest := &replicationTaskRequest{
+		token: &replicationspb.ReplicationToken{
+			ShardId:                1,
+			LastProcessedMessageId: 1,
+			LastRetrievedMessageId: 2,
+		},
+		respChan: respChan,
+	}
+
+	stream := adminservicemock.NewMockAdminService_StreamReplicationMessagesClient(s.controller)
+	s.frontendClient.EXPECT().StreamReplicationMessages(gomock.Any()).Return(stream, nil)
+	stream.EXPECT().Send(gomock.Any()).Return(nil)
+	stream.EXPECT().Recv().Return(nil, errors.New("random error"))
+
+	s.replicationTaskFetcher.streams.sendRequest(shardRequest)
+	s.Equal((*replicationspb.ReplicationMessages)(nil), <-respChan)
+}
+
+func (s *replicationTaskFetcherSuite) TestStreamRequest_Disabled() {
+	s.False(s.replicationTaskFetcher.streams.isEnabled())
+
+	s.config.ReplicationTaskFetcherStreamingClusters = dynamicconfig.GetMapPropertyFn(map[string]interface{}{
+		cluster.TestCurrentClusterName: true,
+	})
+	s.False(s.replicationTaskFetcher.streams.isEnabled())
+}
+
 func (s *replicationTaskFetcherSuite) TestConcurrentFetchAndProcess_Success() {
 	numShards := 1024
 
@@ -59,6 +59,8 @@ import (
 const (
 	dropSyncShardTaskTimeThreshold = 10 * time.Minute
 	replicationTimeout             = 30 * time.Second
+	dlqAutoMergeDisabledRecheck    = time.Minute
+	dlqAutoMergePageTimeout        = 30 * time.Second
 )
 
 var (
@@ -92,6 +94,11 @@ type (
 		maxRxProcessedTimestamp time.Time
 		maxRxReceivedTaskID     int64
 		rxTaskBackoff           time.Duration
+		// whether the last fetch from source cluster succeeded,
+		// used to decide if replication DLQ can be merged automatically
+		sourceHealthy bool
+		// set while an automatic replication DLQ merge is running
+		dlqMergeInProgress int32
 
 		requestChan   chan<- *replicationTaskRequest
 		syncShardChan chan *replicationspb.SyncShardStatus
@@ -207,6 +214,9 @@ func (p *ReplicationTaskProcessorImpl) eventLoop() {
 	replicationTimer := time.NewTimer(0)
 	defer replicationTimer.Stop()
 
+	dlqMergeTimer := time.NewTimer(p.dlqAutoMergeInterval())
+	defer dlqMergeTimer.Stop()
+
 	var syncShardTask *replicationspb.SyncShardStatus
 	for {
 		select {
@@ -232,6 +242,12 @@ func (p *ReplicationTaskProcessorImpl) eventLoop() {
 				p.config.ReplicationTaskProcessorCleanupJitterCoefficient(shardID),
 			))
 
+		case <-dlqMergeTimer.C:
+			if p.config.ReplicationTaskProcessorDLQAutoMergeInterval(shardID) > 0 && p.sourceHealthy {
+				p.startDLQMerge()
+			}
+			dlqMergeTimer.Reset(p.dlqAutoMergeInterval())
+
 		case <-p.shutdownChan:
 			return
 
@@ -247,6 +263,7 @@ func (p *ReplicationTaskProcessorImpl) eventLoop() {
 func (p *ReplicationTaskProcessorImpl) pollProcessReplicationTasks() (retError error) {
 	defer func() {
 		if retError != nil {
+			p.sourceHealthy = false
 			p.maxRxReceivedTaskID = p.maxRxProcessedTaskID
 			p.rxTaskBackoff = p.config.ReplicationTaskFetcherErrorRetryWait()
 		}
@@ -447,8 +464,10 @@ func (p *ReplicationTaskProcessorImpl) paginationFn(_ []byte) ([]interface{}, []
 	select {
 	case resp, ok := <-respChan:
 		if !ok {
+			p.sourceHealthy = false
 			return nil, nil, nil
 		}
+		p.sourceHealthy = true
 
 		select {
 		case p.syncShardChan <- resp.GetSyncShardStatus():
@@ -475,6 +494,74 @@ func (p *ReplicationTaskProcessorImpl) paginationFn(_ []byte) ([]interface{}, []
 	}
 }
 
+func (p *ReplicationTaskProcessorImpl) dlqAutoMergeInterval() time.Duration {
+	interval := p.config.ReplicationTaskProcessorDLQAutoMergeInterval(p.shard.GetShardID())
+	if interval <= 0 {
+		return dlqAutoMergeDisabledRecheck
+	}
+	return backoff.JitDuration(
+		interval,
+		p.config.ReplicationTaskProcessorCleanupJitterCoefficient(p.shard.GetShardID()),
+	)
+}
+
+// startDLQMerge merges the replication DLQ in the background, so that the merge does not
+// block replication task processing. A merge is skipped if the previous one is still running.
+func (p *ReplicationTaskProcessorImpl) startDLQMerge() {
+	if !atomic.CompareAndSwapInt32(&p.dlqMergeInProgress, 0, 1) {
+		return
+	}
+	go func() {
+		defer atomic.StoreInt32(&p.dlqMergeInProgress, 0)
+		if err := p.mergeDLQMessages(); err != nil {
+			p.logger.Error("Failed to automatically merge replication DLQ messages.", tag.Error(err))
+		}
+	}()
+}
+
+// mergeDLQMessages re-applies all replication DLQ messages of this shard from source cluster
+func (p *ReplicationTaskProcessorImpl) mergeDLQMessages() error {
+	var totalCount int64
+	var pageToken []byte
+	for !p.isStopped() {
+		resp, err := p.mergeDLQPage(pageToken)
+		if err != nil {
+			return err
+		}
+		totalCount += resp.GetMessageCount()
+		pageToken = resp.GetNextPageToken()
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+
+	if totalCount > 0 {
+		p.logger.Info("Automatically merged replication DLQ messages.",
+			tag.SourceCluster(p.sourceCluster),
+			tag.Counter(int(totalCount)),
+		)
+	}
+	return nil
+}
+
+func (p *ReplicationTaskProcessorImpl) mergeDLQPage(
+	pageToken []byte,
+) (*historyservice.MergeDLQMessagesResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), dlqAutoMergePageTimeout)
+	defer cancel()
+
+	return p.historyEngine.MergeDLQMessages(ctx, &historyservice.MergeDLQMessagesRequest{
+		Type:                  enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION,
+		ShardId:               p.shard.GetShardID(),
+		SourceCluster:         p.sourceCluster,
+		InclusiveEndMessageId: common.EndMessageID,
+		MaximumPageSize:       common.ReadDLQMessagesPageSize,
+		NextPageToken:         pageToken,
+		// empty filter merges every message and only deletes the merged ones
+		Filter: &replicationspb.ReplicationDLQFilter{},
+	})
+}
+
 func (p *ReplicationTaskProcessorImpl) cleanupReplicationTasks() error {
 
 	clusterMetadata := p.shard.GetClusterMetadata()
@@ -25,7 +25,9 @@
 package history
 
 import (
+	"context"
 	"math/rand"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -36,6 +38,7 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/api/adminservicemock/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
@@ -53,6 +56,7 @@ import (
 	"go.temporal.io/server/api/historyservicemock/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/client"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
@@ -579,3 +583,58 @@ func (s *replicationTaskProcessorSuite) TestPaginationFn_Error() {
 		s.Equal(rxTaskBackoff, s.replicationTaskProcessor.rxTaskBackoff)
 	}
 }
+
+func (s *replicationTaskProcessorSuite) TestMergeDLQMessages() {
+	pageToken := []byte("some random token")
+	s.mockEngine.EXPECT().MergeDLQMessages(gomock.Any(), &historyservice.MergeDLQMessagesRequest{
+		Type:                  enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION,
+		ShardId:               s.shardID,
+		SourceCluster:         cluster.TestAlternativeClusterName,
+		InclusiveEndMessageId: common.EndMessageID,
+		MaximumPageSize:       common.ReadDLQMessagesPageSize,
+		Filter:                &replicationspb.ReplicationDLQFilter{},
+	}).Return(&historyservice.MergeDLQMessagesResponse{
+		MessageCount:  2,
+		NextPageToken: pageToken,
+	}, nil)
+	s.mockEngine.EXPECT().MergeDLQMessages(gomock.Any(), &historyservice.MergeDLQMessagesRequest{
+		Type:                  enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION,
+		ShardId:               s.shardID,
+		SourceCluster:         cluster.TestAlternativeClusterName,
+		InclusiveEndMessageId: common.EndMessageID,
+		MaximumPageSize:       common.ReadDLQMessagesPageSize,
+		NextPageToken:         pageToken,
+		Filter:                &replicationspb.ReplicationDLQFilter{},
+	}).Return(&historyservice.MergeDLQMessagesResponse{
+		MessageCount: 1,
+	}, nil)
+
+	err := s.replicationTaskProcessor.mergeDLQMessages()
+	s.NoError(err)
+}
+
+func (s *replicationTaskProcessorSuite) TestMergeDLQMessages_Error() {
+	s.mockEngine.EXPECT().MergeDLQMessages(gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewUnavailable("random error"))
+
+	err := s.replicationTaskProcessor.mergeDLQMessages()
+	s.Error(err)
+}
+
+func (s *replicationTaskProcessorSuite) TestStartDLQMerge() {
+	mergeDone := make(chan struct{})
+	s.mockEngine.EXPECT().MergeDLQMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *historyservice.MergeDLQMessagesRequest) (*historyservice.MergeDLQMessagesResponse, error) {
+			<-mergeDone
+			return &historyservice.MergeDLQMessagesResponse{}, nil
+		},
+	)
+
+	s.replicationTaskProcessor.startDLQMerge()
+	// the merge in progress skips the next one
+	s.replicationTaskProcessor.startDLQMerge()
+	close(mergeDone)
+	s.Eventually(func() bool {
+		return atomic.LoadInt32(&s.replicationTaskProcessor.dlqMergeInProgress) == 0
+	}, time.Second, 10*time.Millisecond)
+}
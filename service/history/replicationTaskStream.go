@@ -0,0 +1,248 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/configs"
+)
+
+const (
+	replicationTaskStreamIdleTimeout = time.Minute
+)
+
+type (
+	// replicationTaskStreams fetches replication tasks of each shard through a dedicated stream to the source cluster.
+	// Source cluster answers each request with the next batch of tasks as soon as there is one, so there is at most
+	// one batch in flight per shard and processed tasks are acked by the next request.
+	replicationTaskStreams struct {
+		currentCluster string
+		sourceCluster  string
+		config         *configs.Config
+		clientBean     client.Bean
+		logger         log.Logger
+
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		sync.Mutex
+		streams map[int32]*replicationTaskStream
+	}
+
+	replicationTaskStream struct {
+		shardID     int32
+		streams     *replicationTaskStreams
+		requestChan chan *replicationTaskRequest
+
+		client          adminservice.AdminService_StreamReplicationMessagesClient
+		cancelClient    context.CancelFunc
+		lastRequestTime time.Time
+	}
+)
+
+func newReplicationTaskStreams(
+	logger log.Logger,
+	sourceCluster string,
+	currentCluster string,
+	config *configs.Config,
+	clientBean client.Bean,
+) *replicationTaskStreams {
+
+	ctx, cancel := context.WithCancel(headers.SetVersions(context.Background()))
+	return &replicationTaskStreams{
+		currentCluster: currentCluster,
+		sourceCluster:  sourceCluster,
+		config:         config,
+		clientBean:     clientBean,
+		logger:         logger,
+		ctx:            ctx,
+		cancel:         cancel,
+		streams:        make(map[int32]*replicationTaskStream),
+	}
+}
+
+// isEnabled returns true if replication tasks of source cluster are fetched through streams instead of polling.
+func (s *replicationTaskStreams) isEnabled() bool {
+	enabled, ok := s.config.ReplicationTaskFetcherStreamingClusters()[s.sourceCluster].(bool)
+	return ok && enabled
+}
+
+func (s *replicationTaskStreams) stop() {
+	s.cancel()
+}
+
+// sendRequest hands the request to the stream of its shard.
+func (s *replicationTaskStreams) sendRequest(request *replicationTaskRequest) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.ctx.Err() != nil {
+		close(request.respChan)
+		return
+	}
+
+	shardID := request.token.GetShardId()
+	stream, ok := s.streams[shardID]
+	if !ok {
+		stream = &replicationTaskStream{
+			shardID:     shardID,
+			streams:     s,
+			requestChan: make(chan *replicationTaskRequest, 1),
+		}
+		s.streams[shardID] = stream
+		go stream.run()
+	}
+
+	select {
+	case stream.requestChan <- request:
+	default:
+		// since this replication task fetcher is per host
+		// and replication task processor is per shard
+		// during shard movement, duplicated requests can appear
+		close(request.respChan)
+	}
+}
+
+// removeIfIdle removes the stream unless a request is pending for it.
+func (s *replicationTaskStreams) removeIfIdle(stream *replicationTaskStream) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	if len(stream.requestChan) > 0 {
+		return false
+	}
+	delete(s.streams, stream.shardID)
+	return true
+}
+
+func (s *replicationTaskStream) run() {
+	defer s.closeClient()
+
+	s.lastRequestTime = time.Now()
+	idleTimer := time.NewTimer(replicationTaskStreamIdleTimeout)
+	defer idleTimer.Stop()
+
+	for {
+		select {
+		case request := <-s.requestChan:
+			s.handleRequest(request)
+			s.lastRequestTime = time.Now()
+
+		case <-idleTimer.C:
+			// shard is not processed by this host anymore
+			if idleTime := time.Since(s.lastRequestTime); idleTime < replicationTaskStreamIdleTimeout {
+				idleTimer.Reset(replicationTaskStreamIdleTimeout - idleTime)
+				continue
+			}
+			if s.streams.removeIfIdle(s) {
+				return
+			}
+			idleTimer.Reset(replicationTaskStreamIdleTimeout)
+
+		case <-s.streams.ctx.Done():
+			select {
+			case request := <-s.requestChan:
+				close(request.respChan)
+			default:
+			}
+			return
+		}
+	}
+}
+
+func (s *replicationTaskStream) handleRequest(request *replicationTaskRequest) {
+	if s.client == nil {
+		ctx, cancel := context.WithCancel(s.streams.ctx)
+		client, err := s.streams.clientBean.GetRemoteAdminClient(s.streams.sourceCluster).StreamReplicationMessages(ctx)
+		if err != nil {
+			cancel()
+			s.failRequest(request, err)
+			return
+		}
+		s.client = client
+		s.cancelClient = cancel
+	}
+
+	if err := s.fetch(request); err != nil {
+		s.closeClient()
+		s.failRequest(request, err)
+	}
+}
+
+func (s *replicationTaskStream) fetch(request *replicationTaskRequest) error {
+	maxWait := s.streams.config.ReplicationTaskFetcherStreamMaxWait()
+	if err := s.client.Send(&adminservice.StreamReplicationMessagesRequest{
+		ClusterName: s.streams.currentCluster,
+		Token:       request.token,
+		MaxWait:     timestamp.DurationPtr(maxWait),
+	}); err != nil {
+		return err
+	}
+	resp, err := s.client.Recv()
+	if err != nil {
+		return err
+	}
+
+	if messages := resp.GetMessages(); messages != nil {
+		if maxWait > 0 {
+			// source cluster holds the next request until there are new tasks, no need to wait before sending it
+			messages.HasMore = true
+		}
+		request.respChan <- messages
+	}
+	close(request.respChan)
+	return nil
+}
+
+// failRequest backs off before returning the request without response, so processor does not retry right away.
+func (s *replicationTaskStream) failRequest(request *replicationTaskRequest, err error) {
+	s.streams.logger.Error("Failed to get replication tasks through stream", tag.ShardID(s.shardID), tag.Error(err))
+
+	timer := time.NewTimer(s.streams.config.ReplicationTaskFetcherErrorRetryWait())
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-s.streams.ctx.Done():
+	}
+	close(request.respChan)
+}
+
+func (s *replicationTaskStream) closeClient() {
+	if s.client == nil {
+		return
+	}
+	s.cancelClient()
+	s.client = nil
+	s.cancelClient = nil
+}
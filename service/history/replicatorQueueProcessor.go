@@ -71,6 +71,8 @@ type (
 		// largest replication task ID generated
 		maxTaskID       *int64
 		sanityCheckTime time.Time
+		// closed and replaced when new replication tasks are generated
+		newTaskCh chan struct{}
 	}
 )
 
@@ -105,6 +107,7 @@ func newReplicatorQueueProcessor(
 
 		maxTaskID:       nil,
 		sanityCheckTime: time.Time{},
+		newTaskCh:       make(chan struct{}),
 	}
 }
 
@@ -127,6 +130,32 @@ func (p *replicatorQueueProcessorImpl) NotifyNewTasks(
 	if p.maxTaskID == nil || *p.maxTaskID < maxTaskID {
 		p.maxTaskID = &maxTaskID
 	}
+	close(p.newTaskCh)
+	p.newTaskCh = make(chan struct{})
+}
+
+// waitNewTasks blocks until a replication task after lastRetrievedMessageID is generated, timeout or ctx is done.
+func (p *replicatorQueueProcessorImpl) waitNewTasks(
+	ctx context.Context,
+	lastRetrievedMessageID int64,
+	timeout time.Duration,
+) {
+
+	p.Lock()
+	if p.maxTaskID != nil && *p.maxTaskID > lastRetrievedMessageID {
+		p.Unlock()
+		return
+	}
+	newTaskCh := p.newTaskCh
+	p.Unlock()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-newTaskCh:
+	case <-timer.C:
+	case <-ctx.Done():
+	}
 }
 
 func (p *replicatorQueueProcessorImpl) GetMaxReplicationTaskID() int64 {
@@ -153,6 +182,7 @@ func (p *replicatorQueueProcessorImpl) paginateTasks(
 	minTaskID, maxTaskID := p.taskIDsRange(queryMessageID)
 	replicationTasks, lastTaskID, err := p.getTasks(
 		ctx,
+		pollingCluster,
 		minTaskID,
 		maxTaskID,
 		p.pageSize,
@@ -194,6 +224,7 @@ func (p *replicatorQueueProcessorImpl) paginateTasks(
 
 func (p *replicatorQueueProcessorImpl) getTasks(
 	ctx context.Context,
+	pollingCluster string,
 	minTaskID int64,
 	maxTaskID int64,
 	batchSize int,
@@ -226,6 +257,7 @@ func (p *replicatorQueueProcessorImpl) getTasks(
 		for _, task := range response.Tasks {
 			if replicationTask, err := p.taskInfoToTask(
 				ctx,
+				pollingCluster,
 				task,
 			); err != nil {
 				return nil, 0, err
@@ -254,12 +286,13 @@ func (p *replicatorQueueProcessorImpl) getTasks(
 
 func (p *replicatorQueueProcessorImpl) getTask(
 	ctx context.Context,
+	pollingCluster string,
 	taskInfo *replicationspb.ReplicationTaskInfo,
 ) (*replicationspb.ReplicationTask, error) {
 
 	switch taskInfo.TaskType {
 	case enumsspb.TASK_TYPE_REPLICATION_SYNC_ACTIVITY:
-		return p.taskInfoToTask(ctx, &tasks.SyncActivityTask{
+		return p.taskInfoToTask(ctx, pollingCluster, &tasks.SyncActivityTask{
 			WorkflowKey: definition.NewWorkflowKey(
 				taskInfo.GetNamespaceId(),
 				taskInfo.GetWorkflowId(),
@@ -271,7 +304,7 @@ func (p *replicatorQueueProcessorImpl) getTask(
 			ScheduledID:         taskInfo.ScheduledId,
 		})
 	case enumsspb.TASK_TYPE_REPLICATION_HISTORY:
-		return p.taskInfoToTask(ctx, &tasks.HistoryReplicationTask{
+		return p.taskInfoToTask(ctx, pollingCluster, &tasks.HistoryReplicationTask{
 			WorkflowKey: definition.NewWorkflowKey(
 				taskInfo.GetNamespaceId(),
 				taskInfo.GetWorkflowId(),
@@ -290,8 +323,18 @@ func (p *replicatorQueueProcessorImpl) getTask(
 
 func (p *replicatorQueueProcessorImpl) taskInfoToTask(
 	ctx context.Context,
+	pollingCluster string,
 	task tasks.Task,
 ) (*replicationspb.ReplicationTask, error) {
+	allowed, err := p.allowedByDataResidencyPolicy(namespace.ID(task.GetNamespaceID()), pollingCluster)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		// namespace data must not be replicated to the polling cluster
+		return nil, nil
+	}
+
 	var replicationTask *replicationspb.ReplicationTask
 	op := func() error {
 		var err error
@@ -582,6 +625,14 @@ func (p *replicatorQueueProcessorImpl) processReplication(
 			// workflow already finished, no need to process the replication task
 			return nil, nil
 		}
+		match, err := p.matchReplicationFilter(namespaceID, msBuilder)
+		if err != nil {
+			return nil, err
+		}
+		if !match {
+			// workflow is excluded from replication by namespace replication filter
+			return nil, nil
+		}
 		return action(msBuilder)
 	case *serviceerror.NotFound:
 		return nil, nil
@@ -589,3 +640,45 @@ func (p *replicatorQueueProcessorImpl) processReplication(
 		return nil, err
 	}
 }
+
+func (p *replicatorQueueProcessorImpl) matchReplicationFilter(
+	namespaceID namespace.ID,
+	mutableState workflow.MutableState,
+) (bool, error) {
+
+	namespaceEntry, err := p.shard.GetNamespaceRegistry().GetNamespaceByID(namespaceID)
+	if err != nil {
+		return false, err
+	}
+	filter := namespaceEntry.ReplicationFilter()
+	if filter == nil {
+		return true, nil
+	}
+
+	executionInfo := mutableState.GetExecutionInfo()
+	return filter.Match(&namespace.ReplicationFilterAttributes{
+		WorkflowID:       executionInfo.WorkflowId,
+		WorkflowType:     executionInfo.WorkflowTypeName,
+		TaskQueue:        executionInfo.TaskQueue,
+		SearchAttributes: executionInfo.SearchAttributes,
+	}), nil
+}
+
+// allowedByDataResidencyPolicy double-checks that the namespace may be replicated to the target cluster,
+// namespace handler already validates the replication config of a namespace against its policy.
+func (p *replicatorQueueProcessorImpl) allowedByDataResidencyPolicy(
+	namespaceID namespace.ID,
+	targetCluster string,
+) (bool, error) {
+
+	namespaceEntry, err := p.shard.GetNamespaceRegistry().GetNamespaceByID(namespaceID)
+	switch err.(type) {
+	case nil:
+		return namespaceEntry.DataResidencyPolicy().AllowsCluster(targetCluster), nil
+	case *serviceerror.NotFound:
+		// leave handling of the missing namespace to the replication task generation
+		return true, nil
+	default:
+		return false, err
+	}
+}
@@ -303,6 +303,137 @@ func (s *replicatorQueueProcessorSuite) TestSyncActivity_WorkflowCompleted() {
 	s.Nil(result)
 }
 
+func (s *replicatorQueueProcessorSuite) TestSyncActivity_FilteredOut() {
+	ctx := context.Background()
+	namespaceName := namespace.Name("some random namespace name")
+	namespaceID := tests.NamespaceID
+	workflowID := "some random workflow ID"
+	runID := uuid.New()
+	scheduleID := int64(144)
+	taskID := int64(1444)
+	version := int64(2333)
+	task := &tasks.SyncActivityTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			namespaceID.String(),
+			workflowID,
+			runID,
+		),
+		VisibilityTimestamp: time.Now().UTC(),
+		TaskID:              taskID,
+		Version:             version,
+		ScheduledID:         scheduleID,
+	}
+
+	context, release, _ := s.replicatorQueueProcessor.historyCache.GetOrCreateWorkflowExecution(
+		ctx,
+		namespaceID,
+		commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		workflow.CallerTypeTask,
+	)
+	context.(*workflow.ContextImpl).MutableState = s.mockMutableState
+	release(nil)
+	s.mockMutableState.EXPECT().StartTransaction(gomock.Any()).Return(false, nil)
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
+		WorkflowId:       workflowID,
+		WorkflowTypeName: "some random workflow type",
+	}).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(namespaceID).Return(namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: namespaceID.String(), Name: namespaceName.String()},
+		&persistencespb.NamespaceConfig{Retention: timestamp.DurationFromDays(1)},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []string{
+				cluster.TestCurrentClusterName,
+				cluster.TestAlternativeClusterName,
+			},
+			Filter: "WorkflowType = 'some other workflow type'",
+		},
+		version,
+	), nil).AnyTimes()
+
+	result, err := s.replicatorQueueProcessor.generateSyncActivityTask(ctx, task)
+	s.NoError(err)
+	s.Nil(result)
+}
+
+func (s *replicatorQueueProcessorSuite) TestGetTasks_NotAllowedByDataResidencyPolicy() {
+	ctx := context.Background()
+	namespaceID := tests.NamespaceID
+	task := &tasks.SyncActivityTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			namespaceID.String(),
+			"some random workflow ID",
+			uuid.New(),
+		),
+		VisibilityTimestamp: time.Now().UTC(),
+		TaskID:              int64(1444),
+		Version:             int64(2333),
+		ScheduledID:         int64(144),
+	}
+
+	s.mockExecutionMgr.EXPECT().GetHistoryTasks(gomock.Any(), gomock.Any()).Return(&persistence.GetHistoryTasksResponse{
+		Tasks: []tasks.Task{task},
+	}, nil)
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(namespaceID).Return(namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{
+			Id:   namespaceID.String(),
+			Name: "some random namespace name",
+			Data: map[string]string{namespace.DataResidencyAllowedClustersKey: cluster.TestCurrentClusterName},
+		},
+		&persistencespb.NamespaceConfig{Retention: timestamp.DurationFromDays(1)},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []string{
+				cluster.TestCurrentClusterName,
+			},
+		},
+		task.Version,
+	), nil)
+
+	replicationTasks, lastTaskID, err := s.replicatorQueueProcessor.getTasks(ctx, cluster.TestAlternativeClusterName, 0, 2000, 10)
+	s.NoError(err)
+	s.Empty(replicationTasks)
+	s.Equal(int64(2000), lastTaskID)
+}
+
+func (s *replicatorQueueProcessorSuite) TestGetTask_NotAllowedByDataResidencyPolicy() {
+	ctx := context.Background()
+	namespaceID := tests.NamespaceID
+	taskInfo := &replicationspb.ReplicationTaskInfo{
+		NamespaceId: namespaceID.String(),
+		WorkflowId:  "some random workflow ID",
+		RunId:       uuid.New(),
+		TaskType:    enumsspb.TASK_TYPE_REPLICATION_HISTORY,
+		TaskId:      int64(1444),
+		Version:     int64(2333),
+	}
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(namespaceID).Return(namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{
+			Id:   namespaceID.String(),
+			Name: "some random namespace name",
+			Data: map[string]string{namespace.DataResidencyAllowedClustersKey: cluster.TestCurrentClusterName},
+		},
+		&persistencespb.NamespaceConfig{Retention: timestamp.DurationFromDays(1)},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []string{
+				cluster.TestCurrentClusterName,
+			},
+		},
+		taskInfo.Version,
+	), nil)
+
+	// DLQ messages are not generated for clusters the namespace may not be replicated to
+	replicationTask, err := s.replicatorQueueProcessor.getTask(ctx, cluster.TestAlternativeClusterName, taskInfo)
+	s.NoError(err)
+	s.Nil(replicationTask)
+}
+
 func (s *replicatorQueueProcessorSuite) TestSyncActivity_ActivityCompleted() {
 	ctx := context.Background()
 	namespaceName := namespace.Name("some random namespace name")
@@ -72,6 +72,7 @@ const (
 	contextStateInitialized contextState = iota
 	contextStateAcquiring
 	contextStateAcquired
+	contextStateHandingOff
 	contextStateStopping
 	contextStateStopped
 )
@@ -107,6 +108,10 @@ type (
 		lifecycleCtx    context.Context
 		lifecycleCancel context.CancelFunc
 
+		// Closed by the controller once the previous owner of this shard reports that it has released it
+		handoffCompletedCh   chan struct{}
+		handoffCompletedOnce sync.Once
+
 		// All following fields are protected by rwLock, and only valid if state >= Acquiring:
 		rwLock                       sync.RWMutex
 		state                        contextState
@@ -140,6 +145,7 @@ type (
 	contextRequestAcquire    struct{}
 	contextRequestAcquired   struct{}
 	contextRequestLost       struct{ newMaxReadLevel int64 }
+	contextRequestHandoff    struct{}
 	contextRequestStop       struct{}
 	contextRequestFinishStop struct{}
 )
@@ -1032,6 +1038,11 @@ func (s *ContextImpl) errorByStateLocked() error {
 		return ErrShardStatusUnknown
 	case contextStateAcquired:
 		return nil
+	case contextStateHandingOff:
+		return &persistence.ShardOwnershipLostError{
+			ShardID: s.shardID,
+			Msg:     "Shard is being handed off to a new owner",
+		}
 	case contextStateStopping, contextStateStopped:
 		return ErrShardClosed
 	default:
@@ -1107,15 +1118,18 @@ func (s *ContextImpl) updateShardInfoLocked() error {
 		return err
 	}
 
-	var err error
 	now := clock.NewRealTimeSource().Now()
 	if s.lastUpdated.Add(s.config.ShardUpdateMinInterval()).After(now) {
 		return nil
 	}
+	return s.persistShardInfoLocked(now)
+}
+
+func (s *ContextImpl) persistShardInfoLocked(now time.Time) error {
 	updatedShardInfo := copyShardInfo(s.shardInfo)
 	s.emitShardInfoMetricsLogsLocked()
 
-	err = s.persistenceShardManager.UpdateShard(context.TODO(), &persistence.UpdateShardRequest{
+	err := s.persistenceShardManager.UpdateShard(context.TODO(), &persistence.UpdateShardRequest{
 		ShardInfo:       updatedShardInfo.ShardInfo,
 		PreviousRangeID: s.shardInfo.GetRangeId(),
 	})
@@ -1393,6 +1407,62 @@ func (s *ContextImpl) Unload() {
 	s.transitionLocked(contextRequestStop{})
 }
 
+// startHandoff should only be called by the controller. It stops accepting writes, stops the
+// engine so the queue processors flush their final ack levels, and persists the latest shard
+// info, so the new owner can pick up from the most recent ack levels.
+func (s *ContextImpl) startHandoff() error {
+	s.wLock()
+	if s.state != contextStateAcquired {
+		defer s.wUnlock()
+		return s.errorByStateLocked()
+	}
+	s.transitionLocked(contextRequestHandoff{})
+	engine := s.engine
+	s.engine = nil
+	s.wUnlock()
+
+	// Stop the engine outside the lock, the queue processors update their ack levels on shutdown.
+	if engine != nil {
+		engine.Stop()
+	}
+
+	s.wLock()
+	defer s.wUnlock()
+
+	if s.state != contextStateHandingOff {
+		return s.errorByStateLocked()
+	}
+	return s.persistShardInfoLocked(clock.NewRealTimeSource().Now())
+}
+
+// completeHandoff should only be called by the controller. It unblocks acquireShard if it
+// is waiting for the previous owner to release the shard.
+func (s *ContextImpl) completeHandoff() {
+	s.handoffCompletedOnce.Do(func() {
+		close(s.handoffCompletedCh)
+	})
+}
+
+// awaitHandoff waits until the previous owner has released the shard, the handoff timeout
+// expires, or the shard is stopped.
+func (s *ContextImpl) awaitHandoff(previousOwner string) {
+	timeout := s.config.ShardHandoffTimeout()
+	if !s.config.EnableShardHandoff() || timeout <= 0 {
+		return
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-s.handoffCompletedCh:
+	case <-s.lifecycleCtx.Done():
+	case <-timer.C:
+		s.metricsClient.IncCounter(metrics.ShardInfoScope, metrics.ShardHandoffTimeoutCounter)
+		s.contextTaggedLogger.Warn("Timed out waiting for shard handoff", tag.HostID(previousOwner))
+	}
+}
+
 // finishStop should only be called by the controller.
 func (s *ContextImpl) finishStop() {
 	s.wLock()
@@ -1468,6 +1538,16 @@ func (s *ContextImpl) transitionLocked(request contextRequest) {
 			controller removes from map and calls finishStop()
 		Stopped
 
+	If the shard is moved to another host by a membership change:
+		Acquired
+			controller calls startHandoff()
+		HandingOff
+			controller removes from map and calls finishStop()
+		Stopped
+
+	While HandingOff, all write methods fail with ShardOwnershipLostError so that callers are redirected to
+	the new owner, and the new owner waits for the controller to notify it before stealing the rangeid lock.
+
 	Stopping can be triggered internally (if we get a ShardOwnershipLostError, or fail to acquire the rangeid
 	lock after several minutes) or externally (from controller, e.g. controller shutting down or admin force-
 	unload shard). If it's triggered internally, we transition to Stopping, then make an asynchronous callback
@@ -1550,6 +1630,20 @@ func (s *ContextImpl) transitionLocked(request contextRequest) {
 		case contextRequestLost:
 			setStateAcquiring(request.newMaxReadLevel)
 			return
+		case contextRequestHandoff:
+			s.state = contextStateHandingOff
+			return
+		case contextRequestStop:
+			setStateStopping()
+			return
+		case contextRequestFinishStop:
+			setStateStopped()
+			return
+		}
+	case contextStateHandingOff:
+		switch request.(type) {
+		case contextRequestAcquire, contextRequestLost, contextRequestHandoff:
+			return // nothing to do, the shard is being released
 		case contextRequestStop:
 			setStateStopping()
 			return
@@ -1573,7 +1667,7 @@ func (s *ContextImpl) transitionLocked(request contextRequest) {
 	)
 }
 
-func (s *ContextImpl) loadShardMetadata(ownershipChanged *bool) error {
+func (s *ContextImpl) loadShardMetadata(ownershipChanged *bool, previousOwner *string) error {
 	// Only have to do this once, we can just re-acquire the rangeid lock after that
 	s.rLock()
 
@@ -1604,6 +1698,7 @@ func (s *ContextImpl) loadShardMetadata(ownershipChanged *bool) error {
 	// copyShardInfo also ensures that all maps are non-nil
 	updatedShardInfo := copyShardInfo(shardInfo)
 	*ownershipChanged = shardInfo.Owner != s.hostInfoProvider.HostInfo().Identity()
+	*previousOwner = shardInfo.Owner
 	updatedShardInfo.Owner = s.hostInfoProvider.HostInfo().Identity()
 
 	// initialize the cluster current time to be the same as ack level
@@ -1712,16 +1807,36 @@ func (s *ContextImpl) acquireShard(newMaxReadLevel int64) {
 	policy := backoff.NewExponentialRetryPolicy(50 * time.Millisecond)
 	policy.SetExpirationInterval(5 * time.Minute)
 
-	// Remember this value across attempts
+	// Remember these values across attempts
 	ownershipChanged := false
+	previousOwner := ""
+	awaitedHandoff := false
 
 	op := func() error {
 		// Initial load of shard metadata
-		err := s.loadShardMetadata(&ownershipChanged)
+		err := s.loadShardMetadata(&ownershipChanged, &previousOwner)
 		if err != nil {
 			return err
 		}
 
+		// If another host owned this shard, give it a chance to drain and release the shard
+		// before we steal it.
+		if ownershipChanged && previousOwner != "" && !awaitedHandoff {
+			awaitedHandoff = true
+			s.awaitHandoff(previousOwner)
+
+			// The previous owner persisted its latest ack levels while handing off, reload the
+			// shard metadata so that renewing the range does not overwrite them with stale values.
+			s.wLock()
+			s.shardInfo = nil
+			s.wUnlock()
+			var reloadOwnershipChanged bool
+			var reloadPreviousOwner string
+			if err := s.loadShardMetadata(&reloadOwnershipChanged, &reloadPreviousOwner); err != nil {
+				return err
+			}
+		}
+
 		s.wLock()
 		defer s.wUnlock()
 
@@ -1816,6 +1931,7 @@ func newContext(
 
 	shardContext := &ContextImpl{
 		state:                   contextStateInitialized,
+		handoffCompletedCh:      make(chan struct{}),
 		shardID:                 shardID,
 		executionManager:        persistenceExecutionManager,
 		metricsClient:           metricsClient,
@@ -171,7 +171,7 @@ func (s *contextSuite) TestTimerMaxReadLevelInitialization() {
 	// clear shardInfo and load from persistence
 	shardContextImpl := s.shardContext.(*ContextTest)
 	shardContextImpl.shardInfo = nil
-	err := shardContextImpl.loadShardMetadata(convert.BoolPtr(false))
+	err := shardContextImpl.loadShardMetadata(convert.BoolPtr(false), convert.StringPtr(""))
 	s.NoError(err)
 
 	for clusterName, info := range s.shardContext.GetClusterMetadata().GetAllClusterInfo() {
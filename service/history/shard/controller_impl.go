@@ -175,6 +175,63 @@ func (c *ControllerImpl) CloseShardByID(shardID int32) {
 	}
 }
 
+// handoffShard releases a shard that was moved to another host. Instead of unloading the shard right away,
+// it stops accepting writes, flushes the shard info so the new owner can resume from the latest ack levels,
+// unloads the shard and then notifies the new owner that it can take over.
+func (c *ControllerImpl) handoffShard(shardID int32) {
+	c.RLock()
+	shard, ok := c.historyShards[shardID]
+	c.RUnlock()
+	if !ok {
+		return
+	}
+
+	if !c.config.EnableShardHandoff() {
+		c.CloseShardByID(shardID)
+		return
+	}
+
+	sw := c.metricsScope.StartTimer(metrics.ShardHandoffDrainLatency)
+	handoffStartTime := c.timeSource.Now()
+	if err := shard.startHandoff(); err != nil {
+		// The shard was not acquired or we failed to flush the shard info, the new owner
+		// will steal the shard once the handoff timeout expires.
+		c.metricsScope.IncCounter(metrics.ShardHandoffFailedCounter)
+		shard.contextTaggedLogger.Warn("Failed to drain shard for handoff", tag.Error(err))
+		c.CloseShardByID(shardID)
+		sw.Stop()
+		return
+	}
+	c.CloseShardByID(shardID)
+	sw.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.ShardHandoffTimeout())
+	defer cancel()
+	if _, err := c.historyClient.CompleteShardHandoff(ctx, &historyservice.CompleteShardHandoffRequest{
+		ShardId:          shardID,
+		PreviousOwner:    c.hostInfoProvider.HostInfo().Identity(),
+		HandoffStartTime: &handoffStartTime,
+	}); err != nil {
+		c.metricsScope.IncCounter(metrics.ShardHandoffFailedCounter)
+		c.contextTaggedLogger.Warn("Failed to notify new owner of shard handoff", tag.Error(err), tag.ShardID(shardID))
+	}
+}
+
+// CompleteShardHandoff is called on the new owner of a shard once the previous owner has released it.
+func (c *ControllerImpl) CompleteShardHandoff(shardID int32, previousOwner string, handoffStartTime time.Time) error {
+	shard, err := c.getOrCreateShardContext(shardID)
+	if err != nil {
+		return err
+	}
+	shard.completeHandoff()
+
+	if !handoffStartTime.IsZero() {
+		c.metricsScope.RecordTimer(metrics.ShardHandoffLatency, c.timeSource.Now().Sub(handoffStartTime))
+	}
+	shard.contextTaggedLogger.Info("Shard handoff completed", tag.HostID(previousOwner))
+	return nil
+}
+
 func (c *ControllerImpl) shardClosedCallback(shard *ContextImpl) {
 	sw := c.metricsScope.StartTimer(metrics.RemoveEngineForShardLatency)
 	defer sw.Stop()
@@ -342,8 +399,8 @@ func (c *ControllerImpl) acquireShards() {
 							}
 							cancel()
 						} else {
-							// current host is not owner of shard, unload it if it is already loaded.
-							c.CloseShardByID(shardID)
+							// current host is not owner of shard, hand it off if it is already loaded.
+							c.handoffShard(shardID)
 						}
 					}
 				}
@@ -33,6 +33,7 @@ import (
 	"testing"
 	"time"
 
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/tests"
@@ -42,6 +43,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
@@ -679,6 +681,102 @@ func (s *controllerSuite) TestShardExplicitUnload() {
 	s.False(shard.isValid())
 }
 
+func (s *controllerSuite) TestShardHandoff() {
+	s.config.NumberOfShards = 1
+	shardID := int32(1)
+
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	mockEngine := NewMockEngine(s.controller)
+	s.setupMocksForAcquireShard(shardID, mockEngine, 5, 6, true)
+	s.shardController.acquireShards()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := s.shardController.GetEngineForShard(ctx, shardID)
+	s.NoError(err)
+
+	// the shard is moved to another host: the shard info is flushed, the engine is stopped
+	// and the new owner is notified
+	differentHostInfo := membership.NewHostInfo("another-host", nil)
+	s.mockServiceResolver.EXPECT().Lookup(convert.Int32ToString(shardID)).Return(differentHostInfo, nil).AnyTimes()
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateShardRequest) error {
+			s.Equal(int64(6), request.PreviousRangeID)
+			return nil
+		},
+	)
+	mockEngine.EXPECT().Stop()
+	s.mockResource.HistoryClient.EXPECT().CompleteShardHandoff(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.CompleteShardHandoffRequest, _ ...grpc.CallOption) (*historyservice.CompleteShardHandoffResponse, error) {
+			s.Equal(shardID, request.GetShardId())
+			s.Equal(s.hostInfo.Identity(), request.GetPreviousOwner())
+			s.NotNil(request.GetHandoffStartTime())
+			return &historyservice.CompleteShardHandoffResponse{}, nil
+		},
+	)
+
+	s.shardController.acquireShards()
+	s.Equal(0, s.shardController.NumShards())
+}
+
+func (s *controllerSuite) TestCompleteShardHandoff() {
+	s.config.NumberOfShards = 1
+	s.config.ShardHandoffTimeout = dynamicconfig.GetDurationPropertyFn(time.Minute)
+	shardID := int32(1)
+
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	mockEngine := NewMockEngine(s.controller)
+	mockEngine.EXPECT().Start()
+	mockEngine.EXPECT().Stop().AnyTimes()
+	s.mockServiceResolver.EXPECT().Lookup(convert.Int32ToString(shardID)).Return(s.hostInfo, nil).AnyTimes()
+	s.mockEngineFactory.EXPECT().CreateEngine(newContextMatcher(shardID)).Return(mockEngine)
+	s.mockShardManager.EXPECT().GetOrCreateShard(gomock.Any(), getOrCreateShardRequestMatcher(shardID)).Return(
+		&persistence.GetOrCreateShardResponse{
+			ShardInfo: &persistencespb.ShardInfo{
+				ShardId: shardID,
+				Owner:   "another-host",
+				RangeId: 5,
+			},
+		}, nil).Times(1)
+	// the shard info is reloaded once the previous owner has persisted its latest ack levels
+	s.mockShardManager.EXPECT().GetOrCreateShard(gomock.Any(), getOrCreateShardRequestMatcher(shardID)).Return(
+		&persistence.GetOrCreateShardResponse{
+			ShardInfo: &persistencespb.ShardInfo{
+				ShardId:          shardID,
+				Owner:            "another-host",
+				RangeId:          6,
+				TransferAckLevel: 100,
+			},
+		}, nil).Times(1)
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateShardRequest) error {
+			s.Equal(int64(6), request.PreviousRangeID)
+			s.Equal(int64(100), request.ShardInfo.TransferAckLevel)
+			return nil
+		},
+	)
+
+	shard, err := s.shardController.getOrCreateShardContext(shardID)
+	s.NoError(err)
+
+	// the previous owner has not released the shard yet
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = shard.getOrCreateEngine(ctx)
+	s.Error(err)
+
+	err = s.shardController.CompleteShardHandoff(shardID, "another-host", time.Now().Add(-time.Second))
+	s.NoError(err)
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	engine, err := s.shardController.GetEngineForShard(ctx, shardID)
+	s.NoError(err)
+	s.Equal(mockEngine, engine)
+}
+
 func (s *controllerSuite) setupMocksForAcquireShard(shardID int32, mockEngine *MockEngine, currentRangeID,
 	newRangeID int64, required bool) {
 
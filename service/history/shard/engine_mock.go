@@ -141,18 +141,18 @@ func (mr *MockEngineMockRecorder) GetDLQMessages(ctx, messagesRequest interface{
 }
 
 // GetDLQReplicationMessages mocks base method.
-func (m *MockEngine) GetDLQReplicationMessages(ctx context.Context, taskInfos []*repication.ReplicationTaskInfo) ([]*repication.ReplicationTask, error) {
+func (m *MockEngine) GetDLQReplicationMessages(ctx context.Context, pollingCluster string, taskInfos []*repication.ReplicationTaskInfo) ([]*repication.ReplicationTask, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDLQReplicationMessages", ctx, taskInfos)
+	ret := m.ctrl.Call(m, "GetDLQReplicationMessages", ctx, pollingCluster, taskInfos)
 	ret0, _ := ret[0].([]*repication.ReplicationTask)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetDLQReplicationMessages indicates an expected call of GetDLQReplicationMessages.
-func (mr *MockEngineMockRecorder) GetDLQReplicationMessages(ctx, taskInfos interface{}) *gomock.Call {
+func (mr *MockEngineMockRecorder) GetDLQReplicationMessages(ctx, pollingCluster, taskInfos interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQReplicationMessages", reflect.TypeOf((*MockEngine)(nil).GetDLQReplicationMessages), ctx, taskInfos)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQReplicationMessages", reflect.TypeOf((*MockEngine)(nil).GetDLQReplicationMessages), ctx, pollingCluster, taskInfos)
 }
 
 // GetMutableState mocks base method.
@@ -255,11 +255,12 @@ func (mr *MockEngineMockRecorder) PollMutableState(ctx, request interface{}) *go
 }
 
 // PurgeDLQMessages mocks base method.
-func (m *MockEngine) PurgeDLQMessages(ctx context.Context, messagesRequest *historyservice.PurgeDLQMessagesRequest) error {
+func (m *MockEngine) PurgeDLQMessages(ctx context.Context, messagesRequest *historyservice.PurgeDLQMessagesRequest) (*historyservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "PurgeDLQMessages", ctx, messagesRequest)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*historyservice.PurgeDLQMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // PurgeDLQMessages indicates an expected call of PurgeDLQMessages.
@@ -650,3 +651,29 @@ func (mr *MockEngineMockRecorder) TerminateWorkflowExecution(ctx, request interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).TerminateWorkflowExecution), ctx, request)
 }
+
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockEngine) UnpauseWorkflowExecution(ctx context.Context, request *historyservice.UnpauseWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockEngineMockRecorder) UnpauseWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).UnpauseWorkflowExecution), ctx, request)
+}
+
+// WaitNewReplicationTasks mocks base method.
+func (m *MockEngine) WaitNewReplicationTasks(ctx context.Context, lastRetrievedMessageID int64, timeout time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "WaitNewReplicationTasks", ctx, lastRetrievedMessageID, timeout)
+}
+
+// WaitNewReplicationTasks indicates an expected call of WaitNewReplicationTasks.
+func (mr *MockEngineMockRecorder) WaitNewReplicationTasks(ctx, lastRetrievedMessageID, timeout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitNewReplicationTasks", reflect.TypeOf((*MockEngine)(nil).WaitNewReplicationTasks), ctx, lastRetrievedMessageID, timeout)
+}
@@ -37,6 +37,7 @@ import (
 
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -77,6 +78,7 @@ type (
 		isStarted                 int32
 		isStopped                 int32
 		shutdownChan              chan struct{}
+		shutdownWG                sync.WaitGroup
 		activeTaskProcessor       *transferQueueActiveProcessorImpl
 		standbyTaskProcessorsLock sync.RWMutex
 		standbyTaskProcessors     map[string]*transferQueueStandbyProcessorImpl
@@ -137,6 +139,7 @@ func (t *transferQueueProcessorImpl) Start() {
 		t.listenToClusterMetadataChange()
 	}
 
+	t.shutdownWG.Add(1)
 	go t.completeTransferLoop()
 }
 
@@ -154,6 +157,7 @@ func (t *transferQueueProcessorImpl) Stop() {
 		t.standbyTaskProcessorsLock.RUnlock()
 	}
 	close(t.shutdownChan)
+	common.AwaitWaitGroup(&t.shutdownWG, time.Minute)
 }
 
 // NotifyNewTasks - Notify the processor about the new active / standby transfer task arrival.
@@ -247,6 +251,8 @@ func (t *transferQueueProcessorImpl) Category() tasks.Category {
 }
 
 func (t *transferQueueProcessorImpl) completeTransferLoop() {
+	defer t.shutdownWG.Done()
+
 	timer := time.NewTimer(t.config.TransferProcessorCompleteTransferInterval())
 	defer timer.Stop()
 
@@ -267,7 +273,7 @@ func (t *transferQueueProcessorImpl) completeTransferLoop() {
 					t.logger.Info("Failed to complete transfer task", tag.Error(err))
 					if err == shard.ErrShardClosed {
 						// shard closed, trigger shutdown and bail out
-						t.Stop()
+						go t.Stop()
 						return
 					}
 					backoff := time.Duration((attempt - 1) * 100)
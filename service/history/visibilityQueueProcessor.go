@@ -27,9 +27,11 @@ package history
 import (
 	"context"
 	"errors"
+	"sync"
 	"sync/atomic"
 	"time"
 
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -68,6 +70,7 @@ type (
 		isStarted    int32
 		isStopped    int32
 		shutdownChan chan struct{}
+		shutdownWG   sync.WaitGroup
 	}
 )
 
@@ -169,6 +172,7 @@ func (t *visibilityQueueProcessorImpl) Start() {
 		return
 	}
 	t.queueProcessorBase.Start()
+	t.shutdownWG.Add(1)
 	go t.completeTaskLoop()
 }
 
@@ -178,6 +182,7 @@ func (t *visibilityQueueProcessorImpl) Stop() {
 	}
 	t.queueProcessorBase.Stop()
 	close(t.shutdownChan)
+	common.AwaitWaitGroup(&t.shutdownWG, time.Minute)
 }
 
 // NotifyNewTasks - Notify the processor about the new visibility task arrival.
@@ -210,6 +215,8 @@ func (t *visibilityQueueProcessorImpl) Category() tasks.Category {
 }
 
 func (t *visibilityQueueProcessorImpl) completeTaskLoop() {
+	defer t.shutdownWG.Done()
+
 	timer := time.NewTimer(t.config.VisibilityProcessorCompleteTaskInterval())
 	defer timer.Stop()
 
@@ -232,7 +239,7 @@ func (t *visibilityQueueProcessorImpl) completeTaskLoop() {
 				t.logger.Info("Failed to complete visibility task", tag.Error(err))
 				if errors.Is(err, shard.ErrShardClosed) {
 					// shard closed, trigger shutdown and bail out
-					t.Stop()
+					go t.Stop()
 					return
 				}
 				backoff := time.Duration((attempt-1)*100) * time.Millisecond
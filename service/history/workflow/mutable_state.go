@@ -186,8 +186,11 @@ type (
 		IsSignalRequested(requestID string) bool
 		IsStickyTaskQueueEnabled() bool
 		IsWorkflowExecutionRunning() bool
+		IsWorkflowTaskPaused() bool
 		IsResourceDuplicated(resourceDedupKey definition.DeduplicationID) bool
 		UpdateDuplicatedResource(resourceDedupKey definition.DeduplicationID)
+		AddConflictResolutionRecord(sourceRunID string, reappliedEvents []*historypb.HistoryEvent, droppedEvents []*historypb.HistoryEvent) error
+		UnpauseWorkflowTask() error
 		ReplicateActivityInfo(*historyservice.SyncActivityRequest, bool) error
 		ReplicateActivityTaskCancelRequestedEvent(*historypb.HistoryEvent) error
 		ReplicateActivityTaskCanceledEvent(*historypb.HistoryEvent) error
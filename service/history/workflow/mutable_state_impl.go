@@ -73,6 +73,11 @@ import (
 const (
 	emptyUUID = "emptyUuid"
 
+	// maxConflictResolutionRecords is the number of most recent conflict resolution records kept in mutable state
+	maxConflictResolutionRecords = 10
+	// maxConflictResolutionDroppedEvents is the number of dropped events kept in a conflict resolution record
+	maxConflictResolutionDroppedEvents = 100
+
 	mutableStateInvalidHistoryActionMsg         = "invalid history builder state for action"
 	mutableStateInvalidHistoryActionMsgTemplate = mutableStateInvalidHistoryActionMsg + ": %v, %v"
 )
@@ -1250,6 +1255,14 @@ func (e *MutableStateImpl) HasInFlightWorkflowTask() bool {
 	return e.workflowTaskManager.HasInFlightWorkflowTask()
 }
 
+func (e *MutableStateImpl) IsWorkflowTaskPaused() bool {
+	return e.workflowTaskManager.IsWorkflowTaskPaused()
+}
+
+func (e *MutableStateImpl) UnpauseWorkflowTask() error {
+	return e.workflowTaskManager.UnpauseWorkflowTask()
+}
+
 func (e *MutableStateImpl) GetInFlightWorkflowTask() (*WorkflowTaskInfo, bool) {
 	return e.workflowTaskManager.GetInFlightWorkflowTask()
 }
@@ -3886,6 +3899,74 @@ func (e *MutableStateImpl) UpdateDuplicatedResource(
 	e.appliedEvents[id] = struct{}{}
 }
 
+// AddConflictResolutionRecord records that events of a branch which lost nDC conflict resolution
+// were reapplied or dropped, and marks the workflow with TemporalConflictResolved search attribute.
+func (e *MutableStateImpl) AddConflictResolutionRecord(
+	sourceRunID string,
+	reappliedEvents []*historypb.HistoryEvent,
+	droppedEvents []*historypb.HistoryEvent,
+) error {
+
+	losingVersion := common.EmptyVersion
+	for _, events := range [][]*historypb.HistoryEvent{reappliedEvents, droppedEvents} {
+		for _, event := range events {
+			if event.GetVersion() > losingVersion {
+				losingVersion = event.GetVersion()
+			}
+		}
+	}
+	droppedEventCount := int64(len(droppedEvents))
+	if len(droppedEvents) > maxConflictResolutionDroppedEvents {
+		droppedEvents = droppedEvents[:maxConflictResolutionDroppedEvents]
+	}
+	record := &persistencespb.ConflictResolutionRecord{
+		ResolveTime:       timestamp.TimePtr(e.timeSource.Now()),
+		SourceRunId:       sourceRunID,
+		LosingVersion:     losingVersion,
+		WinningVersion:    e.GetCurrentVersion(),
+		ReappliedEvents:   newConflictResolutionEvents(reappliedEvents),
+		DroppedEvents:     newConflictResolutionEvents(droppedEvents),
+		DroppedEventCount: droppedEventCount,
+	}
+
+	exeInfo := e.executionInfo
+	records := append(exeInfo.ConflictResolutionRecords, record)
+	if len(records) > maxConflictResolutionRecords {
+		records = records[len(records)-maxConflictResolutionRecords:]
+	}
+	exeInfo.ConflictResolutionRecords = records
+
+	if _, ok := exeInfo.SearchAttributes[searchattribute.TemporalConflictResolved]; ok {
+		return nil
+	}
+	resolvedPayload, err := searchattribute.EncodeValue(true, enumspb.INDEXED_VALUE_TYPE_BOOL)
+	if err != nil {
+		return err
+	}
+	if exeInfo.SearchAttributes == nil {
+		exeInfo.SearchAttributes = make(map[string]*commonpb.Payload, 1)
+	}
+	exeInfo.SearchAttributes[searchattribute.TemporalConflictResolved] = resolvedPayload
+	if e.shard.GetConfig().AdvancedVisibilityWritingMode() != visibility.AdvancedVisibilityWritingModeOff {
+		return e.taskGenerator.GenerateWorkflowSearchAttrTasks(e.timeSource.Now())
+	}
+	return nil
+}
+
+func newConflictResolutionEvents(
+	events []*historypb.HistoryEvent,
+) []*persistencespb.ConflictResolutionEvent {
+	result := make([]*persistencespb.ConflictResolutionEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, &persistencespb.ConflictResolutionEvent{
+			EventId:   event.GetEventId(),
+			Version:   event.GetVersion(),
+			EventType: event.GetEventType(),
+		})
+	}
+	return result
+}
+
 func (e *MutableStateImpl) GenerateLastHistoryReplicationTasks(
 	now time.Time,
 ) (tasks.Task, error) {
@@ -53,6 +53,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/events"
 	"go.temporal.io/server/service/history/shard"
@@ -423,6 +424,91 @@ func (s *mutableStateSuite) TestTransientWorkflowTaskStart_CurrentVersionChanged
 	s.Equal(0, s.mutableState.hBuilder.BufferEventSize())
 }
 
+func (s *mutableStateSuite) TestWorkflowTaskPause() {
+	s.mockConfig.WorkflowTaskPauseAttempts = dynamicconfig.GetIntPropertyFilteredByNamespace(3)
+	s.mutableState = TestLocalMutableState(s.mockShard, s.mockEventsCache, tests.LocalNamespaceEntry, s.logger, uuid.New())
+
+	scheduleID := int64(2)
+	startedID := int64(3)
+	for attempt := int32(1); attempt <= 3; attempt++ {
+		s.False(s.mutableState.IsWorkflowTaskPaused())
+		s.mutableState.UpdateWorkflowTask(&WorkflowTaskInfo{
+			Version:    common.EmptyVersion,
+			ScheduleID: scheduleID,
+			StartedID:  startedID,
+			RequestID:  uuid.New(),
+			Attempt:    attempt,
+		})
+		_, err := s.mutableState.AddWorkflowTaskTimedOutEvent(scheduleID, startedID)
+		s.NoError(err)
+	}
+	s.True(s.mutableState.IsWorkflowTaskPaused())
+	s.Contains(s.mutableState.GetExecutionInfo().SearchAttributes, searchattribute.TemporalWorkflowTaskPaused)
+
+	err := s.mutableState.UnpauseWorkflowTask()
+	s.NoError(err)
+	s.False(s.mutableState.IsWorkflowTaskPaused())
+	s.Equal(int32(1), s.mutableState.GetExecutionInfo().WorkflowTaskAttempt)
+	s.NotContains(s.mutableState.GetExecutionInfo().SearchAttributes, searchattribute.TemporalWorkflowTaskPaused)
+}
+
+func (s *mutableStateSuite) TestAddConflictResolutionRecord() {
+	sourceRunID := uuid.New()
+	reappliedEvents := []*historypb.HistoryEvent{{
+		EventId:   5,
+		Version:   101,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+	}}
+	droppedEvents := []*historypb.HistoryEvent{{
+		EventId:   6,
+		Version:   101,
+		EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+	}}
+
+	for i := 0; i < maxConflictResolutionRecords+1; i++ {
+		err := s.mutableState.AddConflictResolutionRecord(sourceRunID, reappliedEvents, droppedEvents)
+		s.NoError(err)
+	}
+
+	records := s.mutableState.GetExecutionInfo().GetConflictResolutionRecords()
+	s.Len(records, maxConflictResolutionRecords)
+	record := records[len(records)-1]
+	s.Equal(sourceRunID, record.GetSourceRunId())
+	s.Equal(int64(101), record.GetLosingVersion())
+	s.Equal(s.mutableState.GetCurrentVersion(), record.GetWinningVersion())
+	s.Equal([]*persistencespb.ConflictResolutionEvent{{
+		EventId:   5,
+		Version:   101,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+	}}, record.GetReappliedEvents())
+	s.Equal([]*persistencespb.ConflictResolutionEvent{{
+		EventId:   6,
+		Version:   101,
+		EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+	}}, record.GetDroppedEvents())
+	s.Equal(int64(1), record.GetDroppedEventCount())
+	s.Contains(s.mutableState.GetExecutionInfo().SearchAttributes, searchattribute.TemporalConflictResolved)
+}
+
+func (s *mutableStateSuite) TestAddConflictResolutionRecord_MaxDroppedEvents() {
+	droppedEvents := make([]*historypb.HistoryEvent, 0, maxConflictResolutionDroppedEvents+1)
+	for i := 0; i < maxConflictResolutionDroppedEvents+1; i++ {
+		droppedEvents = append(droppedEvents, &historypb.HistoryEvent{
+			EventId:   int64(i + 1),
+			Version:   101,
+			EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+		})
+	}
+
+	err := s.mutableState.AddConflictResolutionRecord(uuid.New(), nil, droppedEvents)
+	s.NoError(err)
+
+	records := s.mutableState.GetExecutionInfo().GetConflictResolutionRecords()
+	s.Len(records, 1)
+	s.Len(records[0].GetDroppedEvents(), maxConflictResolutionDroppedEvents)
+	s.Equal(int64(maxConflictResolutionDroppedEvents+1), records[0].GetDroppedEventCount())
+}
+
 func (s *mutableStateSuite) prepareTransientWorkflowTaskCompletionFirstBatchReplicated(version int64, runID string) (*historypb.HistoryEvent, *historypb.HistoryEvent) {
 	namespaceID := tests.NamespaceID
 	execution := commonpb.WorkflowExecution{
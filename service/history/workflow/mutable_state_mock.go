@@ -286,6 +286,20 @@ func (mr *MockMutableStateMockRecorder) AddCompletedWorkflowEvent(arg0, arg1, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCompletedWorkflowEvent", reflect.TypeOf((*MockMutableState)(nil).AddCompletedWorkflowEvent), arg0, arg1, arg2)
 }
 
+// AddConflictResolutionRecord mocks base method.
+func (m *MockMutableState) AddConflictResolutionRecord(sourceRunID string, reappliedEvents, droppedEvents []*v13.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddConflictResolutionRecord", sourceRunID, reappliedEvents, droppedEvents)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddConflictResolutionRecord indicates an expected call of AddConflictResolutionRecord.
+func (mr *MockMutableStateMockRecorder) AddConflictResolutionRecord(sourceRunID, reappliedEvents, droppedEvents interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddConflictResolutionRecord", reflect.TypeOf((*MockMutableState)(nil).AddConflictResolutionRecord), sourceRunID, reappliedEvents, droppedEvents)
+}
+
 // AddContinueAsNewEvent mocks base method.
 func (m *MockMutableState) AddContinueAsNewEvent(arg0, arg1 int64, arg2 namespace.Name, arg3 *v1.ContinueAsNewWorkflowExecutionCommandAttributes) (*v13.HistoryEvent, MutableState, error) {
 	m.ctrl.T.Helper()
@@ -1647,6 +1661,20 @@ func (mr *MockMutableStateMockRecorder) IsWorkflowExecutionRunning() *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowExecutionRunning", reflect.TypeOf((*MockMutableState)(nil).IsWorkflowExecutionRunning))
 }
 
+// IsWorkflowTaskPaused mocks base method.
+func (m *MockMutableState) IsWorkflowTaskPaused() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsWorkflowTaskPaused")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsWorkflowTaskPaused indicates an expected call of IsWorkflowTaskPaused.
+func (mr *MockMutableStateMockRecorder) IsWorkflowTaskPaused() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowTaskPaused", reflect.TypeOf((*MockMutableState)(nil).IsWorkflowTaskPaused))
+}
+
 // ReplicateActivityInfo mocks base method.
 func (m *MockMutableState) ReplicateActivityInfo(arg0 *v18.SyncActivityRequest, arg1 bool) error {
 	m.ctrl.T.Helper()
@@ -2309,6 +2337,20 @@ func (mr *MockMutableStateMockRecorder) StartTransaction(entry interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTransaction", reflect.TypeOf((*MockMutableState)(nil).StartTransaction), entry)
 }
 
+// UnpauseWorkflowTask mocks base method.
+func (m *MockMutableState) UnpauseWorkflowTask() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowTask")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseWorkflowTask indicates an expected call of UnpauseWorkflowTask.
+func (mr *MockMutableStateMockRecorder) UnpauseWorkflowTask() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowTask", reflect.TypeOf((*MockMutableState)(nil).UnpauseWorkflowTask))
+}
+
 // UpdateActivity mocks base method.
 func (m *MockMutableState) UpdateActivity(arg0 *v19.ActivityInfo) error {
 	m.ctrl.T.Helper()
@@ -31,6 +31,8 @@ import (
 	"go.temporal.io/api/serviceerror"
 	workflowpb "go.temporal.io/api/workflow/v1"
 
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/namespace"
@@ -66,7 +68,7 @@ func ScheduleWorkflowTask(
 	mutableState MutableState,
 ) error {
 
-	if mutableState.HasPendingWorkflowTask() {
+	if mutableState.HasPendingWorkflowTask() || mutableState.IsWorkflowTaskPaused() {
 		return nil
 	}
 
@@ -180,3 +182,22 @@ func FindAutoResetPoint(
 	}
 	return "", nil
 }
+
+// NewExecutionSizeInfo returns the size and the number of pending items of a workflow execution
+func NewExecutionSizeInfo(
+	state *persistencespb.WorkflowMutableState,
+	historySize int64,
+) *workflowspb.ExecutionSizeInfo {
+	return &workflowspb.ExecutionSizeInfo{
+		HistorySizeBytes:           historySize,
+		HistoryEventCount:          state.GetNextEventId() - common.FirstEventID,
+		MutableStateSizeBytes:      int64(state.Size()),
+		PendingActivityCount:       int64(len(state.GetActivityInfos())),
+		PendingTimerCount:          int64(len(state.GetTimerInfos())),
+		PendingChildExecutionCount: int64(len(state.GetChildExecutionInfos())),
+		PendingRequestCancelCount:  int64(len(state.GetRequestCancelInfos())),
+		PendingSignalCount:         int64(len(state.GetSignalInfos())),
+		SignalCount:                state.GetExecutionInfo().GetSignalCount(),
+		BufferedEventCount:         int64(len(state.GetBufferedEvents())),
+	}
+}
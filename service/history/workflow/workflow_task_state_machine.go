@@ -31,6 +31,7 @@ import (
 	"math"
 	"time"
 
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	failurepb "go.temporal.io/api/failure/v1"
 	historypb "go.temporal.io/api/history/v1"
@@ -42,7 +43,9 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 type (
@@ -530,6 +533,10 @@ func (m *workflowTaskStateMachine) AddWorkflowTaskFailedEvent(
 		cause == enumspb.WORKFLOW_TASK_FAILED_CAUSE_FAILOVER_CLOSE_COMMAND {
 		m.ms.executionInfo.WorkflowTaskAttempt = 1
 	}
+
+	if err := m.pauseWorkflowTaskIfAttemptsExceeded(); err != nil {
+		return nil, err
+	}
 	return event, nil
 }
 
@@ -561,6 +568,10 @@ func (m *workflowTaskStateMachine) AddWorkflowTaskTimedOutEvent(
 	if err := m.ReplicateWorkflowTaskTimedOutEvent(enumspb.TIMEOUT_TYPE_START_TO_CLOSE); err != nil {
 		return nil, err
 	}
+
+	if err := m.pauseWorkflowTaskIfAttemptsExceeded(); err != nil {
+		return nil, err
+	}
 	return event, nil
 }
 
@@ -589,6 +600,66 @@ func (m *workflowTaskStateMachine) FailWorkflowTask(
 	m.UpdateWorkflowTask(failWorkflowTaskInfo)
 }
 
+// IsWorkflowTaskPaused returns true if workflow tasks are not scheduled because of repeated workflow task failures.
+func (m *workflowTaskStateMachine) IsWorkflowTaskPaused() bool {
+	return m.ms.executionInfo.WorkflowTaskPaused
+}
+
+// UnpauseWorkflowTask allows workflow tasks to be scheduled again, starting over from the first attempt.
+func (m *workflowTaskStateMachine) UnpauseWorkflowTask() error {
+	if !m.IsWorkflowTaskPaused() {
+		return nil
+	}
+
+	m.ms.executionInfo.WorkflowTaskAttempt = 1
+	return m.updateWorkflowTaskPaused(false)
+}
+
+func (m *workflowTaskStateMachine) pauseWorkflowTaskIfAttemptsExceeded() error {
+	namespaceName := m.ms.GetNamespaceEntry().Name().String()
+	pauseAttempts := m.ms.config.WorkflowTaskPauseAttempts(namespaceName)
+	// workflow task attempt is already incremented for the next workflow task at this point
+	if pauseAttempts <= 0 || m.ms.executionInfo.WorkflowTaskAttempt <= int32(pauseAttempts) {
+		return nil
+	}
+
+	m.ms.metricsClient.Scope(
+		metrics.WorkflowContextScope,
+		metrics.NamespaceTag(namespaceName),
+	).IncCounter(metrics.WorkflowTaskPausedCounter)
+	m.ms.shard.GetThrottledLogger().Warn("Pause workflow task scheduling after repeated workflow task failures",
+		tag.WorkflowNamespace(namespaceName),
+		tag.WorkflowID(m.ms.executionInfo.WorkflowId),
+		tag.WorkflowRunID(m.ms.executionState.RunId),
+		tag.Attempt(m.ms.executionInfo.WorkflowTaskAttempt-1),
+	)
+	return m.updateWorkflowTaskPaused(true)
+}
+
+func (m *workflowTaskStateMachine) updateWorkflowTaskPaused(
+	paused bool,
+) error {
+	executionInfo := m.ms.executionInfo
+	executionInfo.WorkflowTaskPaused = paused
+	if paused {
+		pausedPayload, err := searchattribute.EncodeValue(true, enumspb.INDEXED_VALUE_TYPE_BOOL)
+		if err != nil {
+			return err
+		}
+		if executionInfo.SearchAttributes == nil {
+			executionInfo.SearchAttributes = make(map[string]*commonpb.Payload, 1)
+		}
+		executionInfo.SearchAttributes[searchattribute.TemporalWorkflowTaskPaused] = pausedPayload
+	} else {
+		delete(executionInfo.SearchAttributes, searchattribute.TemporalWorkflowTaskPaused)
+	}
+
+	if m.ms.config.AdvancedVisibilityWritingMode() != visibility.AdvancedVisibilityWritingModeOff {
+		return m.ms.taskGenerator.GenerateWorkflowSearchAttrTasks(m.ms.timeSource.Now())
+	}
+	return nil
+}
+
 // DeleteWorkflowTask deletes a workflow task.
 func (m *workflowTaskStateMachine) DeleteWorkflowTask() {
 	resetWorkflowTaskInfo := &WorkflowTaskInfo{
@@ -221,6 +221,7 @@ func (r *workflowResetterImpl) resetWorkflow(
 		resetWorkflow.getMutableState(),
 		resetReapplyType,
 		reapplyEventsFn,
+		baseRunID,
 		additionalReapplyEvents,
 	); err != nil {
 		return err
@@ -318,6 +319,7 @@ func (r *workflowResetterImpl) reapplyEventsToResetWorkflow(
 	resetMutableState workflow.MutableState,
 	resetReapplyType enumspb.ResetReapplyType,
 	reapplyEventsApplier workflowResetReapplyEventsFn,
+	baseRunID string,
 	additionalReapplyEvents []*historypb.HistoryEvent,
 ) error {
 	switch resetReapplyType {
@@ -334,11 +336,25 @@ func (r *workflowResetterImpl) reapplyEventsToResetWorkflow(
 		panic(fmt.Sprintf("unknown reset type: %v", resetReapplyType))
 	}
 
+	if len(additionalReapplyEvents) == 0 {
+		return nil
+	}
 	if err := r.reapplyEvents(resetMutableState, additionalReapplyEvents); err != nil {
 		return err
 	}
 
-	return nil
+	// additional events come from a branch which lost nDC conflict resolution
+	var reappliedEvents []*historypb.HistoryEvent
+	var droppedEvents []*historypb.HistoryEvent
+	for _, event := range additionalReapplyEvents {
+		switch event.GetEventType() {
+		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED:
+			reappliedEvents = append(reappliedEvents, event)
+		default:
+			droppedEvents = append(droppedEvents, event)
+		}
+	}
+	return resetMutableState.AddConflictResolutionRecord(baseRunID, reappliedEvents, droppedEvents)
 }
 
 func (r *workflowResetterImpl) persistToDB(
@@ -442,14 +442,22 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskCompleted(
 	} else {
 		namespace := namespaceEntry.Name()
 		workflowSizeChecker := newWorkflowSizeChecker(
-			handler.config.BlobSizeLimitWarn(namespace.String()),
-			handler.config.BlobSizeLimitError(namespace.String()),
-			handler.config.MemoSizeLimitWarn(namespace.String()),
-			handler.config.MemoSizeLimitError(namespace.String()),
-			handler.config.HistorySizeLimitWarn(namespace.String()),
-			handler.config.HistorySizeLimitError(namespace.String()),
-			handler.config.HistoryCountLimitWarn(namespace.String()),
-			handler.config.HistoryCountLimitError(namespace.String()),
+			workflowSizeLimits{
+				blobSizeLimitWarn:                   handler.config.BlobSizeLimitWarn(namespace.String()),
+				blobSizeLimitError:                  handler.config.BlobSizeLimitError(namespace.String()),
+				memoSizeLimitWarn:                   handler.config.MemoSizeLimitWarn(namespace.String()),
+				memoSizeLimitError:                  handler.config.MemoSizeLimitError(namespace.String()),
+				historySizeLimitWarn:                handler.config.HistorySizeLimitWarn(namespace.String()),
+				historySizeLimitError:               handler.config.HistorySizeLimitError(namespace.String()),
+				historyCountLimitWarn:               handler.config.HistoryCountLimitWarn(namespace.String()),
+				historyCountLimitError:              handler.config.HistoryCountLimitError(namespace.String()),
+				numPendingActivitiesLimitWarn:       handler.config.NumPendingActivitiesLimitWarn(namespace.String()),
+				numPendingActivitiesLimitError:      handler.config.NumPendingActivitiesLimitError(namespace.String()),
+				numPendingChildExecutionsLimitWarn:  handler.config.NumPendingChildExecutionsLimitWarn(namespace.String()),
+				numPendingChildExecutionsLimitError: handler.config.NumPendingChildExecutionsLimitError(namespace.String()),
+				numPendingSignalsLimitWarn:          handler.config.NumPendingSignalsLimitWarn(namespace.String()),
+				numPendingSignalsLimitError:         handler.config.NumPendingSignalsLimitError(namespace.String()),
+			},
 			completedEvent.GetEventId(),
 			msBuilder,
 			handler.historyEngine.searchAttributesValidator,
@@ -511,7 +519,9 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskCompleted(
 		newStateBuilder = nil
 	}
 
-	createNewWorkflowTask := msBuilder.IsWorkflowExecutionRunning() && (hasUnhandledEvents || request.GetForceCreateNewWorkflowTask() || activityNotStartedCancelled)
+	// failing the workflow task may have paused workflow task scheduling
+	createNewWorkflowTask := msBuilder.IsWorkflowExecutionRunning() && !msBuilder.IsWorkflowTaskPaused() &&
+		(hasUnhandledEvents || request.GetForceCreateNewWorkflowTask() || activityNotStartedCancelled)
 	var newWorkflowTaskScheduledID int64
 	if createNewWorkflowTask {
 		bypassTaskGeneration := request.GetReturnNewWorkflowTask() && wtFailedCause == nil
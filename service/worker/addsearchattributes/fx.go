@@ -27,8 +27,11 @@ package addsearchattributes
 import (
 	sdkworker "go.temporal.io/sdk/worker"
 	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/searchattribute"
 	workercommon "go.temporal.io/server/service/worker/common"
@@ -43,10 +46,12 @@ type (
 
 	initParams struct {
 		fx.In
-		EsClient      esclient.Client
-		Manager       searchattribute.Manager
-		MetricsClient metrics.Client
-		Logger        log.Logger
+		EsClient                  esclient.Client
+		Manager                   searchattribute.Manager
+		NamespaceReplicationQueue persistence.NamespaceReplicationQueue
+		ClusterMetadata           cluster.Metadata
+		MetricsClient             metrics.Client
+		Logger                    log.Logger
 	}
 
 	fxResult struct {
@@ -80,9 +85,11 @@ func (wc *addSearchAttributes) DedicatedWorkerOptions() *workercommon.DedicatedW
 
 func (wc *addSearchAttributes) activities() *activities {
 	return &activities{
-		esClient:      wc.EsClient,
-		saManager:     wc.Manager,
-		metricsClient: wc.MetricsClient,
-		logger:        wc.Logger,
+		esClient:            wc.EsClient,
+		saManager:           wc.Manager,
+		namespaceReplicator: namespace.NewNamespaceReplicator(wc.NamespaceReplicationQueue, wc.Logger),
+		clusterMetadata:     wc.ClusterMetadata,
+		metricsClient:       wc.MetricsClient,
+		logger:              wc.Logger,
 	}
 }
@@ -34,9 +34,11 @@ import (
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
 
+	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/searchattribute"
 )
@@ -55,13 +57,18 @@ type (
 		CustomAttributesToAdd map[string]enumspb.IndexedValueType
 		// If true skip Elasticsearch schema update and only update cluster metadata.
 		SkipSchemaUpdate bool
+		// If true search attributes are not replicated to remote clusters.
+		// Set when search attributes are added as result of replication.
+		SkipReplication bool
 	}
 
 	activities struct {
-		esClient      esclient.Client
-		saManager     searchattribute.Manager
-		metricsClient metrics.Client
-		logger        log.Logger
+		esClient            esclient.Client
+		saManager           searchattribute.Manager
+		namespaceReplicator namespace.Replicator
+		clusterMetadata     cluster.Metadata
+		metricsClient       metrics.Client
+		logger              log.Logger
 	}
 )
 
@@ -90,10 +97,19 @@ var (
 		ScheduleToCloseTimeout: 10 * time.Second,
 	}
 
+	replicateSearchAttributesActivityOptions = workflow.ActivityOptions{
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: 1 * time.Second,
+		},
+		StartToCloseTimeout:    2 * time.Second,
+		ScheduleToCloseTimeout: 10 * time.Second,
+	}
+
 	ErrUnableToUpdateESMapping      = errors.New("unable to update Elasticsearch mapping")
 	ErrUnableToExecuteActivity      = errors.New("unable to execute activity")
 	ErrUnableToGetSearchAttributes  = errors.New("unable to get search attributes from cluster metadata")
 	ErrUnableToSaveSearchAttributes = errors.New("unable to save search attributes to cluster metadata")
+	ErrUnableToReplicate            = errors.New("unable to replicate search attributes to remote clusters")
 )
 
 // AddSearchAttributesWorkflow is the workflow that adds search attributes to the cluster for specific index.
@@ -124,6 +140,14 @@ func AddSearchAttributesWorkflow(ctx workflow.Context, params WorkflowParams) er
 		return fmt.Errorf("%w: UpdateClusterMetadataActivity: %v", ErrUnableToExecuteActivity, err)
 	}
 
+	if !params.SkipReplication {
+		ctx4 := workflow.WithActivityOptions(ctx, replicateSearchAttributesActivityOptions)
+		err = workflow.ExecuteActivity(ctx4, a.ReplicateSearchAttributesActivity, params).Get(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("%w: ReplicateSearchAttributesActivity: %v", ErrUnableToExecuteActivity, err)
+		}
+	}
+
 	logger.Info("Workflow finished successfully.", tag.WorkflowType(WorkflowName))
 	return nil
 }
@@ -188,3 +212,18 @@ func (a *activities) UpdateClusterMetadataActivity(ctx context.Context, params W
 	a.logger.Info("Search attributes saved to cluster metadata.", tag.ESIndex(params.IndexName))
 	return nil
 }
+
+func (a *activities) ReplicateSearchAttributesActivity(ctx context.Context, params WorkflowParams) error {
+	if !a.clusterMetadata.IsGlobalNamespaceEnabled() {
+		return nil
+	}
+
+	err := a.namespaceReplicator.HandleSearchAttributesTransmissionTask(ctx, params.CustomAttributesToAdd)
+	if err != nil {
+		a.logger.Error("Unable to replicate search attributes to remote clusters.", tag.ESIndex(params.IndexName), tag.Error(err))
+		a.metricsClient.IncCounter(metrics.AddSearchAttributesWorkflowScope, metrics.AddSearchAttributesFailuresCount)
+		return fmt.Errorf("%w: %v", ErrUnableToReplicate, err)
+	}
+	a.logger.Info("Search attributes replicated to remote clusters.", tag.ESIndex(params.IndexName))
+	return nil
+}
@@ -0,0 +1,100 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package auditlog
+
+import (
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/authorization"
+)
+
+const (
+	// WorkflowName is the workflow type of the system workflows keeping audit records
+	WorkflowName = authorization.AuditLogWorkflowName
+	// SignalName is the signal carrying an audit record
+	SignalName = "audit-record"
+
+	// RecordWindow is the time window of audit records kept by one workflow
+	RecordWindow = time.Hour
+	// windowGracePeriod keeps the workflow open for records which arrive late
+	windowGracePeriod = 10 * time.Minute
+	// maxRecordsPerRun bounds the history size of a single run
+	maxRecordsPerRun = 10000
+)
+
+type (
+	// WorkflowParams is the parameters for the audit log workflow.
+	WorkflowParams struct {
+		// WindowEnd is the end of the time window of audit records kept by the workflow
+		WindowEnd time.Time
+	}
+)
+
+// WorkflowID returns the ID of the audit log workflow keeping records at time t
+func WorkflowID(t time.Time) string {
+	return fmt.Sprintf("%s-%s", WorkflowName, t.UTC().Truncate(RecordWindow).Format("2006-01-02T15"))
+}
+
+// AuditLogWorkflow keeps the audit records it receives as signals in its history. It stays open until
+// the end of its time window and continues as new when a run has received too many records.
+func AuditLogWorkflow(ctx workflow.Context, params WorkflowParams) error {
+	records := workflow.GetSignalChannel(ctx, SignalName)
+
+	timerCtx, cancelTimer := workflow.WithCancel(ctx)
+	defer cancelTimer()
+	closeDelay := params.WindowEnd.Add(windowGracePeriod).Sub(workflow.Now(ctx))
+	if closeDelay < time.Second {
+		closeDelay = time.Second
+	}
+	closeTimer := workflow.NewTimer(timerCtx, closeDelay)
+
+	recordCount := 0
+	closed := false
+	selector := workflow.NewSelector(ctx)
+	selector.AddReceive(records, func(c workflow.ReceiveChannel, more bool) {
+		var record authorization.AuditRecord
+		c.Receive(ctx, &record)
+		recordCount++
+	})
+	selector.AddFuture(closeTimer, func(f workflow.Future) {
+		closed = true
+	})
+	for !closed && recordCount < maxRecordsPerRun {
+		selector.Select(ctx)
+	}
+
+	// records stay in the history, drain the ones received before closing
+	var record authorization.AuditRecord
+	for records.ReceiveAsync(&record) {
+	}
+
+	if !closed {
+		return workflow.NewContinueAsNewError(ctx, AuditLogWorkflow, params)
+	}
+	return nil
+}
@@ -29,6 +29,8 @@ import (
 	sdkworker "go.temporal.io/sdk/worker"
 	"go.temporal.io/sdk/workflow"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -48,6 +50,8 @@ type (
 		FrontendClient    workflowservice.WorkflowServiceClient
 		Logger            log.Logger
 		MetricsClient     metrics.Client
+		ClientBean        client.Bean
+		ClusterMetadata   cluster.Metadata
 	}
 
 	fxResult struct {
@@ -75,7 +79,7 @@ func NewResult(params initParams) fxResult {
 
 func (wc *replicationWorkerComponent) Register(worker sdkworker.Worker) {
 	worker.RegisterWorkflowWithOptions(ForceReplicationWorkflow, workflow.RegisterOptions{Name: forceReplicationWorkflowName})
-	worker.RegisterWorkflowWithOptions(NamespaceHandoverWorkflow, workflow.RegisterOptions{Name: namespaceHandoverWorkflowName})
+	worker.RegisterWorkflowWithOptions(NamespaceHandoverWorkflow, workflow.RegisterOptions{Name: NamespaceHandoverWorkflowName})
 	worker.RegisterActivity(wc.activities())
 }
 
@@ -93,5 +97,7 @@ func (wc *replicationWorkerComponent) activities() *activities {
 		frontendClient:    wc.FrontendClient,
 		logger:            wc.Logger,
 		metricsClient:     wc.MetricsClient,
+		clientBean:        wc.ClientBean,
+		clusterMetadata:   wc.ClusterMetadata,
 	}
 }
@@ -0,0 +1,234 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/namespace"
+)
+
+const (
+	HandoverStepPending   HandoverStepState = "Pending"
+	HandoverStepRunning   HandoverStepState = "Running"
+	HandoverStepCompleted HandoverStepState = "Completed"
+	HandoverStepFailed    HandoverStepState = "Failed"
+	HandoverStepSkipped   HandoverStepState = "Skipped"
+)
+
+const (
+	handoverStepGetMetadata           = "GetMetadata"
+	handoverStepCheckRemoteCluster    = "CheckRemoteCluster"
+	handoverStepCheckReplicationDLQ   = "CheckReplicationDLQ"
+	handoverStepCheckSearchAttributes = "CheckSearchAttributes"
+	handoverStepWaitReplication       = "WaitReplication"
+	handoverStepStartHandover         = "StartHandover"
+	handoverStepWaitHandover          = "WaitHandover"
+	handoverStepUpdateActiveCluster   = "UpdateActiveCluster"
+	handoverStepResetState            = "ResetNamespaceState"
+	handoverStepRollback              = "Rollback"
+
+	preCheckFailedErrorType = "HandoverPreCheckFailed"
+)
+
+var handoverSteps = []string{
+	handoverStepGetMetadata,
+	handoverStepCheckRemoteCluster,
+	handoverStepCheckReplicationDLQ,
+	handoverStepCheckSearchAttributes,
+	handoverStepWaitReplication,
+	handoverStepStartHandover,
+	handoverStepWaitHandover,
+	handoverStepUpdateActiveCluster,
+	handoverStepResetState,
+}
+
+type (
+	HandoverStepState string
+
+	// HandoverReport is the per step status of a namespace handover, returned by HandoverReportQueryType query.
+	HandoverReport struct {
+		Namespace     string
+		RemoteCluster string
+		DryRun        bool
+		RolledBack    bool
+		Steps         []*HandoverStepStatus
+	}
+
+	HandoverStepStatus struct {
+		Name      string
+		State     HandoverStepState
+		Error     string
+		StartTime time.Time
+		EndTime   time.Time
+	}
+)
+
+func newHandoverReport(params NamespaceHandoverParams) *HandoverReport {
+	report := &HandoverReport{
+		Namespace:     params.Namespace,
+		RemoteCluster: params.RemoteCluster,
+		DryRun:        params.DryRun,
+	}
+	for _, name := range handoverSteps {
+		report.Steps = append(report.Steps, &HandoverStepStatus{
+			Name:  name,
+			State: HandoverStepPending,
+		})
+	}
+	return report
+}
+
+// runStep runs fn as the named step and records its outcome. Steps not known upfront, e.g. rollback, are appended.
+func (r *HandoverReport) runStep(ctx workflow.Context, name string, fn func() error) error {
+	step := r.step(name)
+	if step == nil {
+		step = &HandoverStepStatus{Name: name}
+		r.Steps = append(r.Steps, step)
+	}
+
+	step.State = HandoverStepRunning
+	step.StartTime = workflow.Now(ctx)
+	err := fn()
+	step.EndTime = workflow.Now(ctx)
+	if err != nil {
+		step.State = HandoverStepFailed
+		step.Error = err.Error()
+		return err
+	}
+	step.State = HandoverStepCompleted
+	return nil
+}
+
+func (r *HandoverReport) skipPendingSteps() {
+	for _, step := range r.Steps {
+		if step.State == HandoverStepPending {
+			step.State = HandoverStepSkipped
+		}
+	}
+}
+
+func (r *HandoverReport) step(name string) *HandoverStepStatus {
+	for _, step := range r.Steps {
+		if step.Name == name {
+			return step
+		}
+	}
+	return nil
+}
+
+// CheckRemoteCluster verifies remote cluster is reachable and namespace can be handed over to it.
+func (a *activities) CheckRemoteCluster(ctx context.Context, req checkRemoteClusterRequest) error {
+	if _, ok := a.clusterMetadata.GetAllClusterInfo()[req.RemoteCluster]; !ok {
+		return newPreCheckFailedError("remote cluster %s is unknown", req.RemoteCluster)
+	}
+
+	nsEntry, err := a.namespaceRegistry.GetNamespace(namespace.Name(req.Namespace))
+	if err != nil {
+		return err
+	}
+	if !nsEntry.IsGlobalNamespace() {
+		return newPreCheckFailedError("namespace %s is not a global namespace", req.Namespace)
+	}
+	if nsEntry.ActiveClusterName() != a.clusterMetadata.GetCurrentClusterName() {
+		return newPreCheckFailedError("namespace %s is active in cluster %s", req.Namespace, nsEntry.ActiveClusterName())
+	}
+	if !isNamespaceOnCluster(nsEntry, req.RemoteCluster) {
+		return newPreCheckFailedError("namespace %s is not replicated to cluster %s", req.Namespace, req.RemoteCluster)
+	}
+
+	resp, err := a.clientBean.GetRemoteAdminClient(req.RemoteCluster).DescribeCluster(ctx, &adminservice.DescribeClusterRequest{})
+	if err != nil {
+		return err
+	}
+	if resp.GetClusterName() != req.RemoteCluster {
+		return newPreCheckFailedError("remote cluster %s reports cluster name %s", req.RemoteCluster, resp.GetClusterName())
+	}
+	if !resp.GetIsGlobalNamespaceEnabled() {
+		return newPreCheckFailedError("remote cluster %s has global namespace disabled", req.RemoteCluster)
+	}
+	return nil
+}
+
+// CheckReplicationDLQ verifies remote cluster has no replication tasks from current cluster in DLQ.
+func (a *activities) CheckReplicationDLQ(ctx context.Context, remoteCluster string) error {
+	currentCluster := a.clusterMetadata.GetCurrentClusterName()
+	resp, err := a.clientBean.GetRemoteAdminClient(remoteCluster).GetReplicationLag(ctx, &adminservice.GetReplicationLagRequest{
+		RemoteClusters: []string{currentCluster},
+	})
+	if err != nil {
+		return err
+	}
+	if dlqSize := resp.GetRemoteClusters()[currentCluster].GetDlqSize(); dlqSize > 0 {
+		return newPreCheckFailedError("remote cluster %s has %d replication tasks in DLQ", remoteCluster, dlqSize)
+	}
+	return nil
+}
+
+// CheckSearchAttributes verifies all search attributes of current cluster are defined with the same type in remote cluster.
+func (a *activities) CheckSearchAttributes(ctx context.Context, remoteCluster string) error {
+	localResp, err := a.frontendClient.GetSearchAttributes(ctx, &workflowservice.GetSearchAttributesRequest{})
+	if err != nil {
+		return err
+	}
+	remoteResp, err := a.clientBean.GetRemoteAdminClient(remoteCluster).GetSearchAttributes(ctx, &adminservice.GetSearchAttributesRequest{})
+	if err != nil {
+		return err
+	}
+
+	for name, localType := range localResp.GetKeys() {
+		remoteType, ok := remoteResp.GetCustomAttributes()[name]
+		if !ok {
+			remoteType, ok = remoteResp.GetSystemAttributes()[name]
+		}
+		if !ok {
+			return newPreCheckFailedError("search attribute %s is not defined in remote cluster %s", name, remoteCluster)
+		}
+		if remoteType != localType {
+			return newPreCheckFailedError("search attribute %s has type %s in remote cluster %s, expected %s", name, remoteType, remoteCluster, localType)
+		}
+	}
+	return nil
+}
+
+func isNamespaceOnCluster(nsEntry *namespace.Namespace, clusterName string) bool {
+	for _, name := range nsEntry.ClusterNames() {
+		if name == clusterName {
+			return true
+		}
+	}
+	return false
+}
+
+func newPreCheckFailedError(format string, args ...interface{}) error {
+	return temporal.NewNonRetryableApplicationError(fmt.Sprintf(format, args...), preCheckFailedErrorType, nil)
+}
@@ -39,8 +39,10 @@ import (
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/client"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -51,8 +53,13 @@ import (
 )
 
 const (
-	forceReplicationWorkflowName  = "force-replication"
-	namespaceHandoverWorkflowName = "namespace-handover"
+	forceReplicationWorkflowName = "force-replication"
+	// NamespaceHandoverWorkflowName is the name of the workflow handing over a namespace to a remote cluster.
+	NamespaceHandoverWorkflowName = "namespace-handover"
+	// HandoverReportQueryType is the query type returning the HandoverReport of a namespace handover workflow.
+	HandoverReportQueryType = "handover-report"
+
+	namespaceHandoverPreChecksChangeID = "namespace-handover-pre-checks"
 
 	defaultListWorkflowsPageSize = 1000
 	defaultPageCountPerExecution = 200
@@ -60,6 +67,7 @@ const (
 
 	minimumAllowedLaggingSeconds  = 5
 	minimumHandoverTimeoutSeconds = 30
+	preCheckMaximumAttempts       = 3
 )
 
 type (
@@ -81,6 +89,8 @@ type (
 		AllowedLaggingSeconds int
 		// how long to wait for handover to complete before rollback
 		HandoverTimeoutSeconds int
+		// only run pre-checks and wait for replication, namespace is not handed over
+		DryRun bool
 	}
 
 	activities struct {
@@ -91,6 +101,8 @@ type (
 		frontendClient    workflowservice.WorkflowServiceClient
 		logger            log.Logger
 		metricsClient     metrics.Client
+		clientBean        client.Bean
+		clusterMetadata   cluster.Metadata
 	}
 
 	listWorkflowsResponse struct {
@@ -139,6 +151,11 @@ type (
 		Namespace     string
 		RemoteCluster string // remote cluster name
 	}
+
+	checkRemoteClusterRequest struct {
+		Namespace     string
+		RemoteCluster string
+	}
 )
 
 var (
@@ -241,7 +258,7 @@ func ForceReplicationWorkflow(ctx workflow.Context, params ForceReplicationParam
 	return workflow.NewContinueAsNewError(ctx, ForceReplicationWorkflow, params)
 }
 
-func NamespaceHandoverWorkflow(ctx workflow.Context, params NamespaceHandoverParams) error {
+func NamespaceHandoverWorkflow(ctx workflow.Context, params NamespaceHandoverParams) (retErr error) {
 	// validate input params
 	if len(params.Namespace) == 0 {
 		return errors.New("InvalidArgument: Namespace is required")
@@ -256,6 +273,172 @@ func NamespaceHandoverWorkflow(ctx workflow.Context, params NamespaceHandoverPar
 		params.HandoverTimeoutSeconds = minimumHandoverTimeoutSeconds
 	}
 
+	report := newHandoverReport(params)
+	if err := workflow.SetQueryHandler(ctx, HandoverReportQueryType, func() (*HandoverReport, error) {
+		return report, nil
+	}); err != nil {
+		return err
+	}
+
+	if workflow.GetVersion(ctx, namespaceHandoverPreChecksChangeID, workflow.DefaultVersion, 1) == workflow.DefaultVersion {
+		return namespaceHandoverWorkflowV0(ctx, params)
+	}
+
+	retryPolicy := &temporal.RetryPolicy{
+		InitialInterval:    time.Second,
+		MaximumInterval:    time.Second,
+		BackoffCoefficient: 1,
+	}
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Second * 10,
+		RetryPolicy:         retryPolicy,
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	// pre-checks fail the handover instead of retrying forever, remote cluster may be unreachable
+	preCheckCtx := workflow.WithRetryPolicy(ctx, temporal.RetryPolicy{
+		InitialInterval:    time.Second,
+		MaximumInterval:    time.Second,
+		BackoffCoefficient: 1,
+		MaximumAttempts:    preCheckMaximumAttempts,
+	})
+
+	var a *activities
+
+	// ** Step 1, Get cluster metadata **
+	var metadataResp metadataResponse
+	err := report.runStep(ctx, handoverStepGetMetadata, func() error {
+		metadataRequest := metadataRequest{Namespace: params.Namespace}
+		return workflow.ExecuteActivity(ctx, a.GetMetadata, metadataRequest).Get(ctx, &metadataResp)
+	})
+	if err != nil {
+		return err
+	}
+
+	// ** Step 2, pre-flight checks of remote cluster
+	err = report.runStep(ctx, handoverStepCheckRemoteCluster, func() error {
+		checkRequest := checkRemoteClusterRequest{Namespace: params.Namespace, RemoteCluster: params.RemoteCluster}
+		return workflow.ExecuteActivity(preCheckCtx, a.CheckRemoteCluster, checkRequest).Get(preCheckCtx, nil)
+	})
+	if err != nil {
+		return err
+	}
+	err = report.runStep(ctx, handoverStepCheckReplicationDLQ, func() error {
+		return workflow.ExecuteActivity(preCheckCtx, a.CheckReplicationDLQ, params.RemoteCluster).Get(preCheckCtx, nil)
+	})
+	if err != nil {
+		return err
+	}
+	err = report.runStep(ctx, handoverStepCheckSearchAttributes, func() error {
+		return workflow.ExecuteActivity(preCheckCtx, a.CheckSearchAttributes, params.RemoteCluster).Get(preCheckCtx, nil)
+	})
+	if err != nil {
+		return err
+	}
+
+	// ** Step 3, wait remote cluster to catch up on replication tasks
+	err = report.runStep(ctx, handoverStepWaitReplication, func() error {
+		var repStatus replicationStatus
+		if err := workflow.ExecuteActivity(ctx, a.GetMaxReplicationTaskIDs).Get(ctx, &repStatus); err != nil {
+			return err
+		}
+
+		ao3 := workflow.ActivityOptions{
+			StartToCloseTimeout: time.Hour,
+			HeartbeatTimeout:    time.Second * 10,
+			RetryPolicy:         retryPolicy,
+		}
+		ctx3 := workflow.WithActivityOptions(ctx, ao3)
+		waitRequest := waitReplicationRequest{
+			ShardCount:     metadataResp.ShardCount,
+			RemoteCluster:  params.RemoteCluster,
+			AllowedLagging: time.Duration(params.AllowedLaggingSeconds) * time.Second,
+			WaitForTaskIds: repStatus.MaxReplicationTaskIds,
+		}
+		return workflow.ExecuteActivity(ctx3, a.WaitReplication, waitRequest).Get(ctx3, nil)
+	})
+	if err != nil {
+		return err
+	}
+
+	if params.DryRun {
+		report.skipPendingSteps()
+		return nil
+	}
+
+	// from now on namespace may be in Handover state, any failure moves it back to Normal state
+	defer func() {
+		if retErr == nil {
+			return
+		}
+		// rollback even if the workflow is canceled
+		rollbackCtx, _ := workflow.NewDisconnectedContext(ctx)
+		report.RolledBack = report.runStep(rollbackCtx, handoverStepRollback, func() error {
+			rollbackRequest := updateStateRequest{
+				Namespace: params.Namespace,
+				NewState:  enumspb.REPLICATION_STATE_NORMAL,
+			}
+			return workflow.ExecuteActivity(rollbackCtx, a.UpdateNamespaceState, rollbackRequest).Get(rollbackCtx, nil)
+		}) == nil
+	}()
+
+	// ** Step 4, initiate handover
+	err = report.runStep(ctx, handoverStepStartHandover, func() error {
+		handoverRequest := updateStateRequest{
+			Namespace: params.Namespace,
+			NewState:  enumspb.REPLICATION_STATE_HANDOVER,
+		}
+		return workflow.ExecuteActivity(ctx, a.UpdateNamespaceState, handoverRequest).Get(ctx, nil)
+	})
+	if err != nil {
+		return err
+	}
+
+	// ** Step 5, wait remote to ack handover task id
+	err = report.runStep(ctx, handoverStepWaitHandover, func() error {
+		ao5 := workflow.ActivityOptions{
+			StartToCloseTimeout:    time.Second * 30,
+			HeartbeatTimeout:       time.Second * 10,
+			ScheduleToCloseTimeout: time.Second * time.Duration(params.HandoverTimeoutSeconds),
+			RetryPolicy:            retryPolicy,
+		}
+		ctx5 := workflow.WithActivityOptions(ctx, ao5)
+		waitHandover := waitHandoverRequest{
+			ShardCount:    metadataResp.ShardCount,
+			Namespace:     params.Namespace,
+			RemoteCluster: params.RemoteCluster,
+		}
+		return workflow.ExecuteActivity(ctx5, a.WaitHandover, waitHandover).Get(ctx5, nil)
+	})
+	if err != nil {
+		return err
+	}
+
+	// ** Step 6, remote cluster is ready to take over, update Namespace to use remote cluster as active
+	err = report.runStep(ctx, handoverStepUpdateActiveCluster, func() error {
+		updateRequest := updateActiveClusterRequest{
+			Namespace:     params.Namespace,
+			ActiveCluster: params.RemoteCluster,
+		}
+		return workflow.ExecuteActivity(ctx, a.UpdateActiveCluster, updateRequest).Get(ctx, nil)
+	})
+	if err != nil {
+		return err
+	}
+
+	// ** Step 7, reset namespace state from Handover -> Registered
+	return report.runStep(ctx, handoverStepResetState, func() error {
+		resetStateRequest := updateStateRequest{
+			Namespace: params.Namespace,
+			NewState:  enumspb.REPLICATION_STATE_NORMAL,
+		}
+		return workflow.ExecuteActivity(ctx, a.UpdateNamespaceState, resetStateRequest).Get(ctx, nil)
+	})
+}
+
+// namespaceHandoverWorkflowV0 is the handover flow of executions started before the pre-checks were added,
+// kept so that those executions replay deterministically.
+func namespaceHandoverWorkflowV0(ctx workflow.Context, params NamespaceHandoverParams) error {
 	retryPolicy := &temporal.RetryPolicy{
 		InitialInterval:    time.Second,
 		MaximumInterval:    time.Second,
@@ -364,14 +547,29 @@ func (a *activities) GetMetadata(ctx context.Context, request metadataRequest) (
 	}, nil
 }
 
+// ListWorkflows lists workflows of the namespace, workflows excluded by the namespace replication filter are skipped.
 func (a *activities) ListWorkflows(ctx context.Context, request *workflowservice.ListWorkflowExecutionsRequest) (*listWorkflowsResponse, error) {
+	nsEntry, err := a.namespaceRegistry.GetNamespace(namespace.Name(request.Namespace))
+	if err != nil {
+		return nil, err
+	}
 	resp, err := a.frontendClient.ListWorkflowExecutions(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	executions := make([]commonpb.WorkflowExecution, len(resp.Executions))
-	for i, e := range resp.Executions {
-		executions[i] = *e.Execution
+
+	filter := nsEntry.ReplicationFilter()
+	executions := make([]commonpb.WorkflowExecution, 0, len(resp.Executions))
+	for _, e := range resp.Executions {
+		if !filter.Match(&namespace.ReplicationFilterAttributes{
+			WorkflowID:       e.Execution.GetWorkflowId(),
+			WorkflowType:     e.Type.GetName(),
+			TaskQueue:        e.GetTaskQueue(),
+			SearchAttributes: e.SearchAttributes.GetIndexedFields(),
+		}) {
+			continue
+		}
+		executions = append(executions, *e.Execution)
 	}
 	return &listWorkflowsResponse{Executions: executions, NextPageToken: resp.NextPageToken}, nil
 }
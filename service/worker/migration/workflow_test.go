@@ -28,13 +28,26 @@ import (
 	"context"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/api/workflowservicemock/v1"
+	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/testsuite"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/adminservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/namespace"
 )
 
 func TestForceReplicationWorkflow(t *testing.T) {
@@ -125,3 +138,247 @@ func TestForceReplicationWorkflow_ContinueAsNew(t *testing.T) {
 	require.Contains(t, err.Error(), "continue as new")
 	env.AssertExpectations(t)
 }
+
+func TestListWorkflows_ReplicationFilter(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	namespaceRegistry := namespace.NewMockRegistry(controller)
+	frontendClient := workflowservicemock.NewMockWorkflowServiceClient(controller)
+	a := &activities{
+		namespaceRegistry: namespaceRegistry,
+		frontendClient:    frontendClient,
+	}
+
+	namespaceRegistry.EXPECT().GetNamespace(namespace.Name("test-ns")).Return(namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Name: "test-ns"},
+		nil,
+		&persistencespb.NamespaceReplicationConfig{Filter: "WorkflowType = 'replicated'"},
+		0,
+	), nil)
+	request := &workflowservice.ListWorkflowExecutionsRequest{Namespace: "test-ns"}
+	frontendClient.EXPECT().ListWorkflowExecutions(gomock.Any(), request).Return(&workflowservice.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{
+				Execution: &commonpb.WorkflowExecution{WorkflowId: "wf-1", RunId: "run-1"},
+				Type:      &commonpb.WorkflowType{Name: "replicated"},
+			},
+			{
+				Execution: &commonpb.WorkflowExecution{WorkflowId: "wf-2", RunId: "run-2"},
+				Type:      &commonpb.WorkflowType{Name: "not-replicated"},
+			},
+		},
+		NextPageToken: []byte("next-page-token"),
+	}, nil)
+
+	resp, err := a.ListWorkflows(context.Background(), request)
+	require.NoError(t, err)
+	require.Equal(t, []commonpb.WorkflowExecution{{WorkflowId: "wf-1", RunId: "run-1"}}, resp.Executions)
+	require.Equal(t, []byte("next-page-token"), resp.NextPageToken)
+}
+
+func TestNamespaceHandoverWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	mockHandoverPreChecks(env, a)
+	env.OnActivity(a.UpdateNamespaceState, mock.Anything, updateStateRequest{Namespace: "test-ns", NewState: enumspb.REPLICATION_STATE_HANDOVER}).Return(nil).Once()
+	env.OnActivity(a.WaitHandover, mock.Anything, mock.Anything).Return(nil).Once()
+	env.OnActivity(a.UpdateActiveCluster, mock.Anything, updateActiveClusterRequest{Namespace: "test-ns", ActiveCluster: "remote"}).Return(nil).Once()
+	env.OnActivity(a.UpdateNamespaceState, mock.Anything, updateStateRequest{Namespace: "test-ns", NewState: enumspb.REPLICATION_STATE_NORMAL}).Return(nil).Once()
+
+	env.ExecuteWorkflow(NamespaceHandoverWorkflow, NamespaceHandoverParams{
+		Namespace:     "test-ns",
+		RemoteCluster: "remote",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+
+	report := queryHandoverReport(t, env)
+	require.False(t, report.RolledBack)
+	require.Len(t, report.Steps, len(handoverSteps))
+	for _, step := range report.Steps {
+		require.Equal(t, HandoverStepCompleted, step.State, step.Name)
+	}
+}
+
+func TestNamespaceHandoverWorkflow_DryRun(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	mockHandoverPreChecks(env, a)
+
+	env.ExecuteWorkflow(NamespaceHandoverWorkflow, NamespaceHandoverParams{
+		Namespace:     "test-ns",
+		RemoteCluster: "remote",
+		DryRun:        true,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+
+	report := queryHandoverReport(t, env)
+	require.True(t, report.DryRun)
+	require.Equal(t, HandoverStepCompleted, report.step(handoverStepWaitReplication).State)
+	require.Equal(t, HandoverStepSkipped, report.step(handoverStepStartHandover).State)
+	require.Equal(t, HandoverStepSkipped, report.step(handoverStepResetState).State)
+}
+
+func TestNamespaceHandoverWorkflow_PreCheckFailed(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.GetMetadata, mock.Anything, metadataRequest{Namespace: "test-ns"}).Return(&metadataResponse{ShardCount: 4, NamespaceID: uuid.New()}, nil)
+	env.OnActivity(a.CheckRemoteCluster, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(a.CheckReplicationDLQ, mock.Anything, "remote").Return(newPreCheckFailedError("remote cluster remote has 1 replication tasks in DLQ")).Once()
+
+	env.ExecuteWorkflow(NamespaceHandoverWorkflow, NamespaceHandoverParams{
+		Namespace:     "test-ns",
+		RemoteCluster: "remote",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.Error(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+
+	report := queryHandoverReport(t, env)
+	require.False(t, report.RolledBack)
+	require.Equal(t, HandoverStepFailed, report.step(handoverStepCheckReplicationDLQ).State)
+	require.Contains(t, report.step(handoverStepCheckReplicationDLQ).Error, "DLQ")
+	require.Equal(t, HandoverStepPending, report.step(handoverStepStartHandover).State)
+	require.Nil(t, report.step(handoverStepRollback))
+}
+
+func TestNamespaceHandoverWorkflow_Rollback(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	mockHandoverPreChecks(env, a)
+	env.OnActivity(a.UpdateNamespaceState, mock.Anything, updateStateRequest{Namespace: "test-ns", NewState: enumspb.REPLICATION_STATE_HANDOVER}).Return(nil).Once()
+	env.OnActivity(a.WaitHandover, mock.Anything, mock.Anything).Return(temporal.NewNonRetryableApplicationError("handover timed out", "", nil)).Once()
+	env.OnActivity(a.UpdateNamespaceState, mock.Anything, updateStateRequest{Namespace: "test-ns", NewState: enumspb.REPLICATION_STATE_NORMAL}).Return(nil).Once()
+
+	env.ExecuteWorkflow(NamespaceHandoverWorkflow, NamespaceHandoverParams{
+		Namespace:     "test-ns",
+		RemoteCluster: "remote",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.Error(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+
+	report := queryHandoverReport(t, env)
+	require.True(t, report.RolledBack)
+	require.Equal(t, HandoverStepFailed, report.step(handoverStepWaitHandover).State)
+	require.Equal(t, HandoverStepPending, report.step(handoverStepUpdateActiveCluster).State)
+	require.Equal(t, HandoverStepCompleted, report.step(handoverStepRollback).State)
+}
+
+func TestNamespaceHandoverWorkflowV0(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.GetMetadata, mock.Anything, metadataRequest{Namespace: "test-ns"}).Return(&metadataResponse{ShardCount: 4, NamespaceID: uuid.New()}, nil).Once()
+	env.OnActivity(a.GetMaxReplicationTaskIDs, mock.Anything).Return(&replicationStatus{}, nil).Once()
+	env.OnActivity(a.WaitReplication, mock.Anything, mock.Anything).Return(nil).Once()
+	env.OnActivity(a.UpdateNamespaceState, mock.Anything, updateStateRequest{Namespace: "test-ns", NewState: enumspb.REPLICATION_STATE_HANDOVER}).Return(nil).Once()
+	env.OnActivity(a.WaitHandover, mock.Anything, mock.Anything).Return(temporal.NewNonRetryableApplicationError("handover timed out", "", nil)).Once()
+	env.OnActivity(a.UpdateNamespaceState, mock.Anything, updateStateRequest{Namespace: "test-ns", NewState: enumspb.REPLICATION_STATE_NORMAL}).Return(nil).Once()
+
+	// executions started before the pre-checks were added skip them and always reset the state
+	env.ExecuteWorkflow(namespaceHandoverWorkflowV0, NamespaceHandoverParams{
+		Namespace:     "test-ns",
+		RemoteCluster: "remote",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.Error(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+func TestCheckSearchAttributes(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	frontendClient := workflowservicemock.NewMockWorkflowServiceClient(controller)
+	remoteAdminClient := adminservicemock.NewMockAdminServiceClient(controller)
+	clientBean := client.NewMockBean(controller)
+	clientBean.EXPECT().GetRemoteAdminClient("remote").Return(remoteAdminClient).AnyTimes()
+	a := &activities{
+		frontendClient: frontendClient,
+		clientBean:     clientBean,
+	}
+
+	frontendClient.EXPECT().GetSearchAttributes(gomock.Any(), gomock.Any()).Return(&workflowservice.GetSearchAttributesResponse{
+		Keys: map[string]enumspb.IndexedValueType{
+			"WorkflowType": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			"CustomField":  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		},
+	}, nil).Times(2)
+
+	remoteAdminClient.EXPECT().GetSearchAttributes(gomock.Any(), gomock.Any()).Return(&adminservice.GetSearchAttributesResponse{
+		CustomAttributes: map[string]enumspb.IndexedValueType{"CustomField": enumspb.INDEXED_VALUE_TYPE_KEYWORD},
+		SystemAttributes: map[string]enumspb.IndexedValueType{"WorkflowType": enumspb.INDEXED_VALUE_TYPE_KEYWORD},
+	}, nil)
+	require.NoError(t, a.CheckSearchAttributes(context.Background(), "remote"))
+
+	remoteAdminClient.EXPECT().GetSearchAttributes(gomock.Any(), gomock.Any()).Return(&adminservice.GetSearchAttributesResponse{
+		CustomAttributes: map[string]enumspb.IndexedValueType{"CustomField": enumspb.INDEXED_VALUE_TYPE_TEXT},
+		SystemAttributes: map[string]enumspb.IndexedValueType{"WorkflowType": enumspb.INDEXED_VALUE_TYPE_KEYWORD},
+	}, nil)
+	err := a.CheckSearchAttributes(context.Background(), "remote")
+	var appErr *temporal.ApplicationError
+	require.ErrorAs(t, err, &appErr)
+	require.True(t, appErr.NonRetryable())
+}
+
+func TestCheckReplicationDLQ(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	remoteAdminClient := adminservicemock.NewMockAdminServiceClient(controller)
+	clientBean := client.NewMockBean(controller)
+	clientBean.EXPECT().GetRemoteAdminClient("remote").Return(remoteAdminClient).AnyTimes()
+	clusterMetadata := cluster.NewMockMetadata(controller)
+	clusterMetadata.EXPECT().GetCurrentClusterName().Return("active").AnyTimes()
+	a := &activities{
+		clientBean:      clientBean,
+		clusterMetadata: clusterMetadata,
+	}
+
+	request := &adminservice.GetReplicationLagRequest{RemoteClusters: []string{"active"}}
+	remoteAdminClient.EXPECT().GetReplicationLag(gomock.Any(), request).Return(&adminservice.GetReplicationLagResponse{
+		RemoteClusters: map[string]*replicationspb.ClusterReplicationLag{"active": {DlqSize: 0}},
+	}, nil)
+	require.NoError(t, a.CheckReplicationDLQ(context.Background(), "remote"))
+
+	remoteAdminClient.EXPECT().GetReplicationLag(gomock.Any(), request).Return(&adminservice.GetReplicationLagResponse{
+		RemoteClusters: map[string]*replicationspb.ClusterReplicationLag{"active": {DlqSize: 3}},
+	}, nil)
+	require.Error(t, a.CheckReplicationDLQ(context.Background(), "remote"))
+}
+
+func mockHandoverPreChecks(env *testsuite.TestWorkflowEnvironment, a *activities) {
+	env.OnActivity(a.GetMetadata, mock.Anything, metadataRequest{Namespace: "test-ns"}).Return(&metadataResponse{ShardCount: 4, NamespaceID: uuid.New()}, nil).Once()
+	env.OnActivity(a.CheckRemoteCluster, mock.Anything, checkRemoteClusterRequest{Namespace: "test-ns", RemoteCluster: "remote"}).Return(nil).Once()
+	env.OnActivity(a.CheckReplicationDLQ, mock.Anything, "remote").Return(nil).Once()
+	env.OnActivity(a.CheckSearchAttributes, mock.Anything, "remote").Return(nil).Once()
+	env.OnActivity(a.GetMaxReplicationTaskIDs, mock.Anything).Return(&replicationStatus{MaxReplicationTaskIds: map[int32]int64{1: 10}}, nil).Once()
+	env.OnActivity(a.WaitReplication, mock.Anything, mock.Anything).Return(nil).Once()
+}
+
+func queryHandoverReport(t *testing.T, env *testsuite.TestWorkflowEnvironment) *HandoverReport {
+	value, err := env.QueryWorkflow(HandoverReportQueryType)
+	require.NoError(t, err)
+	var report HandoverReport
+	require.NoError(t, value.Get(&report))
+	return &report
+}
@@ -32,6 +32,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
@@ -60,6 +61,7 @@ func newNamespaceReplicationMessageProcessor(
 	remotePeer adminservice.AdminServiceClient,
 	metricsClient metrics.Client,
 	taskExecutor namespace.ReplicationTaskExecutor,
+	searchAttributesTaskExecutor SearchAttributesTaskExecutor,
 	hostInfo *membership.HostInfo,
 	serviceResolver membership.ServiceResolver,
 	namespaceReplicationQueue persistence.NamespaceReplicationQueue,
@@ -77,6 +79,7 @@ func newNamespaceReplicationMessageProcessor(
 		logger:                    logger,
 		remotePeer:                remotePeer,
 		taskExecutor:              taskExecutor,
+		saTaskExecutor:            searchAttributesTaskExecutor,
 		metricsClient:             metricsClient,
 		retryPolicy:               retryPolicy,
 		lastProcessedMessageID:    -1,
@@ -96,6 +99,7 @@ type (
 		logger                    log.Logger
 		remotePeer                adminservice.AdminServiceClient
 		taskExecutor              namespace.ReplicationTaskExecutor
+		saTaskExecutor            SearchAttributesTaskExecutor
 		metricsClient             metrics.Client
 		retryPolicy               backoff.RetryPolicy
 		lastProcessedMessageID    int64
@@ -171,6 +175,10 @@ func (p *namespaceReplicationMessageProcessor) getAndHandleNamespaceReplicationT
 			p.metricsClient.IncCounter(metrics.NamespaceReplicationTaskScope, metrics.ReplicatorFailures)
 			p.logger.Error("Failed to apply namespace replication tasks", tag.Error(err))
 
+			if task.GetTaskType() == enumsspb.REPLICATION_TASK_TYPE_SEARCH_ATTRIBUTES_TASK {
+				// search attributes drift can be fixed with admin SyncSearchAttributes, no need to keep the task
+				continue
+			}
 			dlqErr := backoff.Retry(func() error {
 				return p.putNamespaceReplicationTaskToDLQ(task)
 			}, p.retryPolicy, isTransientRetryableError)
@@ -210,6 +218,9 @@ func (p *namespaceReplicationMessageProcessor) handleNamespaceReplicationTask(
 	sw := p.metricsClient.StartTimer(metrics.NamespaceReplicationTaskScope, metrics.ReplicatorLatency)
 	defer sw.Stop()
 
+	if task.GetTaskType() == enumsspb.REPLICATION_TASK_TYPE_SEARCH_ATTRIBUTES_TASK {
+		return p.saTaskExecutor.Execute(context.TODO(), task.GetSearchAttributesTaskAttributes())
+	}
 	return p.taskExecutor.Execute(context.TODO(), task.GetNamespaceTaskAttributes())
 }
 
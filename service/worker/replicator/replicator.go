@@ -45,6 +45,7 @@ type (
 		status                           int32
 		clusterMetadata                  cluster.Metadata
 		namespaceReplicationTaskExecutor namespace.ReplicationTaskExecutor
+		searchAttributesTaskExecutor     SearchAttributesTaskExecutor
 		clientBean                       client.Bean
 		logger                           log.Logger
 		metricsClient                    metrics.Client
@@ -71,6 +72,7 @@ func NewReplicator(
 	serviceResolver membership.ServiceResolver,
 	namespaceReplicationQueue persistence.NamespaceReplicationQueue,
 	namespaceReplicationTaskExecutor namespace.ReplicationTaskExecutor,
+	searchAttributesTaskExecutor SearchAttributesTaskExecutor,
 ) *Replicator {
 
 	return &Replicator{
@@ -79,6 +81,7 @@ func NewReplicator(
 		serviceResolver:                  serviceResolver,
 		clusterMetadata:                  clusterMetadata,
 		namespaceReplicationTaskExecutor: namespaceReplicationTaskExecutor,
+		searchAttributesTaskExecutor:     searchAttributesTaskExecutor,
 		namespaceProcessors:              make(map[string]*namespaceReplicationMessageProcessor),
 		clientBean:                       clientBean,
 		logger:                           log.With(logger, tag.ComponentReplicator),
@@ -145,6 +148,7 @@ func (r *Replicator) listenToClusterMetadataChange() {
 						r.clientBean.GetRemoteAdminClient(clusterName),
 						r.metricsClient,
 						r.namespaceReplicationTaskExecutor,
+						r.searchAttributesTaskExecutor,
 						r.hostInfo,
 						r.serviceResolver,
 						r.namespaceReplicationQueue,
@@ -0,0 +1,128 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replicator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	sdkclient "go.temporal.io/sdk/client"
+
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/sdk"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/service/worker/addsearchattributes"
+)
+
+const (
+	searchAttributesTaskTimeout = 2 * time.Minute
+)
+
+type (
+	// SearchAttributesTaskExecutor adds custom search attributes replicated from remote cluster
+	SearchAttributesTaskExecutor interface {
+		Execute(ctx context.Context, task *replicationspb.SearchAttributesTaskAttributes) error
+	}
+
+	searchAttributesTaskExecutorImpl struct {
+		indexName        string
+		taskQueue        string
+		saProvider       searchattribute.Provider
+		sdkClientFactory sdk.ClientFactory
+		logger           log.Logger
+	}
+)
+
+// NewSearchAttributesTaskExecutor creates a new instance of search attributes replication task executor
+func NewSearchAttributesTaskExecutor(
+	indexName string,
+	taskQueue string,
+	saProvider searchattribute.Provider,
+	sdkClientFactory sdk.ClientFactory,
+	logger log.Logger,
+) SearchAttributesTaskExecutor {
+	return &searchAttributesTaskExecutorImpl{
+		indexName:        indexName,
+		taskQueue:        taskQueue,
+		saProvider:       saProvider,
+		sdkClientFactory: sdkClientFactory,
+		logger:           logger,
+	}
+}
+
+// Execute adds search attributes which are missing in current cluster.
+// Search attributes defined with different type are not changed and are only logged.
+func (e *searchAttributesTaskExecutorImpl) Execute(
+	ctx context.Context,
+	task *replicationspb.SearchAttributesTaskAttributes,
+) error {
+	if task == nil {
+		return serviceerror.NewInvalidArgument("Search attributes replication task does not set search attributes task attribute")
+	}
+
+	currentSearchAttributes, err := e.saProvider.GetSearchAttributes(e.indexName, true)
+	if err != nil {
+		return err
+	}
+
+	_, missing, conflicts := searchattribute.DiffCustom(currentSearchAttributes.Custom(), task.GetCustomSearchAttributes())
+	if len(conflicts) > 0 {
+		e.logger.Error("Replicated search attributes have different type in current cluster.", tag.ESIndex(e.indexName), tag.Value(conflicts))
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, searchAttributesTaskTimeout)
+	defer cancel()
+
+	sdkClient := e.sdkClientFactory.GetSystemClient(e.logger)
+	run, err := sdkClient.ExecuteWorkflow(
+		ctx,
+		sdkclient.StartWorkflowOptions{
+			TaskQueue: e.taskQueue,
+			ID:        addsearchattributes.WorkflowName,
+		},
+		addsearchattributes.WorkflowName,
+		addsearchattributes.WorkflowParams{
+			CustomAttributesToAdd: missing,
+			IndexName:             e.indexName,
+			SkipReplication:       true,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to start %s workflow: %w", addsearchattributes.WorkflowName, err)
+	}
+	if err := run.Get(ctx, nil); err != nil {
+		return fmt.Errorf("%s workflow failed: %w", addsearchattributes.WorkflowName, err)
+	}
+
+	e.logger.Info("Replicated search attributes added.", tag.ESIndex(e.indexName), tag.ESMapping(missing))
+	return nil
+}
@@ -51,6 +51,7 @@ import (
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/sdk"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/worker/archiver"
 	"go.temporal.io/server/service/worker/batcher"
 	"go.temporal.io/server/service/worker/parentclosepolicy"
@@ -92,6 +93,8 @@ type (
 		stopC            chan struct{}
 		sdkClientFactory sdk.ClientFactory
 		esClient         esclient.Client
+		esConfig         *esclient.Config
+		saProvider       searchattribute.Provider
 		config           *Config
 
 		manager *workerManager
@@ -123,6 +126,8 @@ func NewService(
 	serviceConfig *Config,
 	sdkClientFactory sdk.ClientFactory,
 	esClient esclient.Client,
+	esConfig *esclient.Config,
+	saProvider searchattribute.Provider,
 	archivalMetadata carchiver.ArchivalMetadata,
 	clusterMetadata cluster.Metadata,
 	metricsClient metrics.Client,
@@ -151,6 +156,8 @@ func NewService(
 		config:                    serviceConfig,
 		sdkClientFactory:          sdkClientFactory,
 		esClient:                  esClient,
+		esConfig:                  esConfig,
+		saProvider:                saProvider,
 		stopC:                     make(chan struct{}),
 		logger:                    logger,
 		archivalMetadata:          archivalMetadata,
@@ -466,6 +473,13 @@ func (s *Service) startReplicator() {
 		s.workerServiceResolver,
 		s.namespaceReplicationQueue,
 		namespaceReplicationTaskExecutor,
+		replicator.NewSearchAttributesTaskExecutor(
+			s.esConfig.GetVisibilityIndex(),
+			DefaultWorkerTaskQueue,
+			s.saProvider,
+			s.sdkClientFactory,
+			s.logger,
+		),
 	)
 	msgReplicator.Start()
 }
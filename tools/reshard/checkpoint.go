@@ -0,0 +1,173 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reshard
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// phaseCopy copies every execution of the N source shards into the M staging shards
+	phaseCopy phase = "copy"
+	// phasePurge removes executions, current executions and tasks from the source shards
+	phasePurge phase = "purge"
+	// phaseMove moves every staging shard onto its final shard ID
+	phaseMove phase = "move"
+	// phaseFinalize persists the new shard count in cluster metadata
+	phaseFinalize phase = "finalize"
+	// phaseDone means the resharding has completed
+	phaseDone phase = "done"
+)
+
+const (
+	stepExecutions      step = "executions"
+	stepTasks           step = "tasks"
+	stepPurgeExecutions step = "purge-executions"
+	stepPurgeTasks      step = "purge-tasks"
+)
+
+type (
+	phase string
+	step  string
+
+	// checkpoint records the progress of a resharding run so that it can be resumed
+	// after a crash or an operator interruption
+	checkpoint struct {
+		SourceShardCount int32 `json:"sourceShardCount"`
+		TargetShardCount int32 `json:"targetShardCount"`
+
+		Phase phase `json:"phase"`
+		// Shard is the shard currently processed within the phase
+		Shard int32 `json:"shard"`
+		Step  step  `json:"step"`
+		// CategoryID is the task category currently processed within stepTasks
+		CategoryID int32  `json:"categoryID"`
+		PageToken  []byte `json:"pageToken,omitempty"`
+		// PageTaskIDs maps the target shards of the task page in progress to the first task ID
+		// reserved for the page, a resumed page copies its tasks with the same task IDs
+		PageTaskIDs map[int32]int64 `json:"pageTaskIDs,omitempty"`
+
+		// NamespaceNotificationVersion is the max notification version of all source shards
+		NamespaceNotificationVersion int64 `json:"namespaceNotificationVersion"`
+
+		Stats map[phase]*checkpointStats `json:"stats"`
+
+		path string
+	}
+
+	checkpointStats struct {
+		Executions int64 `json:"executions"`
+		Tasks      int64 `json:"tasks"`
+	}
+)
+
+func newCheckpoint(
+	path string,
+	sourceShardCount int32,
+	targetShardCount int32,
+) *checkpoint {
+	return &checkpoint{
+		SourceShardCount: sourceShardCount,
+		TargetShardCount: targetShardCount,
+		Phase:            phaseCopy,
+		Shard:            1,
+		Step:             stepExecutions,
+		Stats:            make(map[phase]*checkpointStats),
+		path:             path,
+	}
+}
+
+// loadCheckpoint reads the checkpoint from the given path, returns nil if the checkpoint does not exist
+func loadCheckpoint(path string) (*checkpoint, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c := &checkpoint{}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("unable to decode checkpoint %v: %w", path, err)
+	}
+	if c.Stats == nil {
+		c.Stats = make(map[phase]*checkpointStats)
+	}
+	c.path = path
+	return c, nil
+}
+
+// save atomically persists the checkpoint
+func (c *checkpoint) save() error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmpPath := c.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, filepath.Clean(c.path))
+}
+
+func (c *checkpoint) stats(p phase) *checkpointStats {
+	stats, ok := c.Stats[p]
+	if !ok {
+		stats = &checkpointStats{}
+		c.Stats[p] = stats
+	}
+	return stats
+}
+
+// advanceStep moves the checkpoint to the given step of the current shard
+func (c *checkpoint) advanceStep(s step, categoryID int32) {
+	c.Step = s
+	c.CategoryID = categoryID
+	c.PageToken = nil
+	c.PageTaskIDs = nil
+}
+
+// advanceShard moves the checkpoint to the next shard of the current phase
+func (c *checkpoint) advanceShard() {
+	c.Shard++
+	c.Step = stepExecutions
+	c.CategoryID = 0
+	c.PageToken = nil
+	c.PageTaskIDs = nil
+}
+
+// advancePhase moves the checkpoint to the first shard of the given phase
+func (c *checkpoint) advancePhase(p phase) {
+	c.Phase = p
+	c.Shard = 1
+	c.Step = stepExecutions
+	c.CategoryID = 0
+	c.PageToken = nil
+	c.PageTaskIDs = nil
+}
@@ -0,0 +1,356 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reshard
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	enumspb "go.temporal.io/api/enums/v1"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+// copyExecution copies a single execution, including its history branches and its
+// current execution record, from the source shard to the target shard and verifies
+// the copy. Executions already present on the target shard are only completed.
+func (r *Resharder) copyExecution(
+	ctx context.Context,
+	sourceShardID int32,
+	targetShardID int32,
+	state *persistencespb.WorkflowMutableState,
+) error {
+	namespaceID := state.ExecutionInfo.NamespaceId
+	workflowID := state.ExecutionInfo.WorkflowId
+	runID := state.ExecutionState.RunId
+
+	isCurrent, err := r.isCurrentExecution(ctx, sourceShardID, namespaceID, workflowID, runID)
+	if err != nil {
+		return err
+	}
+	shard, err := r.getTargetShard(ctx, targetShardID)
+	if err != nil {
+		return err
+	}
+
+	var dbRecordVersion int64
+	var needsUpdate bool
+	resp, err := r.executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     targetShardID,
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+		RunID:       runID,
+	})
+	switch {
+	case err == nil:
+		// created by a previous run of the tool, which may have been interrupted before the update
+		dbRecordVersion = resp.DBRecordVersion
+		needsUpdate = resp.State.ExecutionState.State != state.ExecutionState.State ||
+			len(resp.State.BufferedEvents) != len(state.BufferedEvents)
+	case isNotFound(err):
+		if err := r.copyHistory(ctx, sourceShardID, targetShardID, state); err != nil {
+			return err
+		}
+		dbRecordVersion, needsUpdate, err = r.createExecution(ctx, shard, isCurrent, state)
+		if err != nil {
+			return err
+		}
+	default:
+		return err
+	}
+
+	if needsUpdate {
+		updateMode := persistence.UpdateWorkflowModeUpdateCurrent
+		if !isCurrent {
+			updateMode = persistence.UpdateWorkflowModeBypassCurrent
+		}
+		if _, err := r.executionManager.UpdateWorkflowExecution(ctx, &persistence.UpdateWorkflowExecutionRequest{
+			ShardID: targetShardID,
+			RangeID: shard.shardInfo.RangeId,
+			Mode:    updateMode,
+			UpdateWorkflowMutation: persistence.WorkflowMutation{
+				ExecutionInfo:     state.ExecutionInfo,
+				ExecutionState:    state.ExecutionState,
+				NextEventID:       state.NextEventId,
+				NewBufferedEvents: state.BufferedEvents,
+				Condition:         state.NextEventId,
+				DBRecordVersion:   dbRecordVersion + 1,
+				Checksum:          state.Checksum,
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	return r.verifyExecution(ctx, targetShardID, state)
+}
+
+func (r *Resharder) copyHistory(
+	ctx context.Context,
+	sourceShardID int32,
+	targetShardID int32,
+	state *persistencespb.WorkflowMutableState,
+) error {
+	info := persistence.BuildHistoryGarbageCleanupInfo(
+		state.ExecutionInfo.NamespaceId,
+		state.ExecutionInfo.WorkflowId,
+		state.ExecutionState.RunId,
+	)
+	copiedBranches := make(map[string]struct{})
+	for _, versionHistory := range state.ExecutionInfo.GetVersionHistories().GetHistories() {
+		branchToken := versionHistory.GetBranchToken()
+		if _, ok := copiedBranches[string(branchToken)]; ok {
+			continue
+		}
+		if err := r.copyHistoryBranch(ctx, sourceShardID, targetShardID, branchToken, info); err != nil {
+			return err
+		}
+		copiedBranches[string(branchToken)] = struct{}{}
+	}
+	return nil
+}
+
+// createExecution creates the execution on the target shard. A non current run must not
+// touch the current execution record, it is therefore created as a zombie and needs to be
+// updated to its actual state afterwards. Buffered events can only be written by an update.
+func (r *Resharder) createExecution(
+	ctx context.Context,
+	shard *targetShard,
+	isCurrent bool,
+	state *persistencespb.WorkflowMutableState,
+) (int64, bool, error) {
+	createMode := persistence.CreateWorkflowModeBrandNew
+	createState := state.ExecutionState
+	if !isCurrent {
+		createMode = persistence.CreateWorkflowModeZombie
+		createState = &persistencespb.WorkflowExecutionState{
+			CreateRequestId: state.ExecutionState.CreateRequestId,
+			RunId:           state.ExecutionState.RunId,
+			State:           enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE,
+			Status:          state.ExecutionState.Status,
+		}
+	}
+	signalRequestedIDs := make(map[string]struct{}, len(state.SignalRequestedIds))
+	for _, signalRequestedID := range state.SignalRequestedIds {
+		signalRequestedIDs[signalRequestedID] = struct{}{}
+	}
+
+	dbRecordVersion := int64(1)
+	if _, err := r.executionManager.CreateWorkflowExecution(ctx, &persistence.CreateWorkflowExecutionRequest{
+		ShardID: shard.shardInfo.ShardId,
+		RangeID: shard.shardInfo.RangeId,
+		Mode:    createMode,
+		NewWorkflowSnapshot: persistence.WorkflowSnapshot{
+			ExecutionInfo:       state.ExecutionInfo,
+			ExecutionState:      createState,
+			NextEventID:         state.NextEventId,
+			ActivityInfos:       state.ActivityInfos,
+			TimerInfos:          state.TimerInfos,
+			ChildExecutionInfos: state.ChildExecutionInfos,
+			RequestCancelInfos:  state.RequestCancelInfos,
+			SignalInfos:         state.SignalInfos,
+			SignalRequestedIDs:  signalRequestedIDs,
+			Condition:           state.NextEventId,
+			DBRecordVersion:     dbRecordVersion,
+			Checksum:            state.Checksum,
+		},
+	}); err != nil {
+		return 0, false, err
+	}
+	needsUpdate := createState != state.ExecutionState || len(state.BufferedEvents) != 0
+	return dbRecordVersion, needsUpdate, nil
+}
+
+// copyHistoryBranch copies all history nodes of a branch, including the nodes of its
+// ancestor branches. Nodes which already exist on the target shard are skipped, as
+// ancestors may be shared by multiple executions.
+func (r *Resharder) copyHistoryBranch(
+	ctx context.Context,
+	sourceShardID int32,
+	targetShardID int32,
+	branchToken []byte,
+	info string,
+) error {
+	branch, err := serialization.HistoryBranchFromBlob(branchToken, enumspb.ENCODING_TYPE_PROTO3.String())
+	if err != nil {
+		return err
+	}
+
+	beginNodeID := common.FirstEventID
+	var prevTransactionID int64
+	for i, ancestor := range branch.Ancestors {
+		ancestorToken, err := serialization.HistoryBranchToBlob(&persistencespb.HistoryBranch{
+			TreeId:    branch.TreeId,
+			BranchId:  ancestor.BranchId,
+			Ancestors: branch.Ancestors[:i],
+		})
+		if err != nil {
+			return err
+		}
+		prevTransactionID, err = r.copyHistoryRange(
+			ctx,
+			sourceShardID,
+			targetShardID,
+			ancestorToken.Data,
+			ancestor.BeginNodeId,
+			ancestor.EndNodeId,
+			prevTransactionID,
+			info,
+		)
+		if err != nil {
+			return err
+		}
+		beginNodeID = ancestor.EndNodeId
+	}
+
+	_, err = r.copyHistoryRange(
+		ctx,
+		sourceShardID,
+		targetShardID,
+		branchToken,
+		beginNodeID,
+		common.EndEventID,
+		prevTransactionID,
+		info,
+	)
+	return err
+}
+
+// copyHistoryRange copies the nodes [minNodeID, maxNodeID) of a branch and returns the
+// transaction ID of the last copied node
+func (r *Resharder) copyHistoryRange(
+	ctx context.Context,
+	sourceShardID int32,
+	targetShardID int32,
+	branchToken []byte,
+	minNodeID int64,
+	maxNodeID int64,
+	prevTransactionID int64,
+	info string,
+) (int64, error) {
+	isNewBranch := true
+	var pageToken []byte
+	for {
+		resp, err := r.executionManager.ReadHistoryBranchByBatch(ctx, &persistence.ReadHistoryBranchRequest{
+			ShardID:       sourceShardID,
+			BranchToken:   branchToken,
+			MinEventID:    minNodeID,
+			MaxEventID:    maxNodeID,
+			PageSize:      r.pageSize,
+			NextPageToken: pageToken,
+		})
+		if isNotFound(err) {
+			return prevTransactionID, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		for i, batch := range resp.History {
+			transactionID := resp.TransactionIDs[i]
+			_, err := r.executionManager.AppendHistoryNodes(ctx, &persistence.AppendHistoryNodesRequest{
+				ShardID:           targetShardID,
+				IsNewBranch:       isNewBranch,
+				Info:              info,
+				BranchToken:       branchToken,
+				Events:            batch.Events,
+				PrevTransactionID: prevTransactionID,
+				TransactionID:     transactionID,
+			})
+			if _, ok := err.(*persistence.ConditionFailedError); !ok && err != nil {
+				return 0, err
+			}
+			isNewBranch = false
+			prevTransactionID = transactionID
+		}
+
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return prevTransactionID, nil
+		}
+	}
+}
+
+func (r *Resharder) isCurrentExecution(
+	ctx context.Context,
+	shardID int32,
+	namespaceID string,
+	workflowID string,
+	runID string,
+) (bool, error) {
+	resp, err := r.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+	})
+	if isNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return resp.RunID == runID, nil
+}
+
+// verifyExecution compares the mutable state on the target shard with the source mutable state
+func (r *Resharder) verifyExecution(
+	ctx context.Context,
+	targetShardID int32,
+	state *persistencespb.WorkflowMutableState,
+) error {
+	resp, err := r.executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     targetShardID,
+		NamespaceID: state.ExecutionInfo.NamespaceId,
+		WorkflowID:  state.ExecutionInfo.WorkflowId,
+		RunID:       state.ExecutionState.RunId,
+	})
+	if err != nil {
+		return err
+	}
+
+	sort.Strings(state.SignalRequestedIds)
+	sort.Strings(resp.State.SignalRequestedIds)
+	if !state.Equal(resp.State) {
+		r.logger.Error("Copied execution does not match the source execution.",
+			tag.ShardID(targetShardID),
+			tag.WorkflowNamespaceID(state.ExecutionInfo.NamespaceId),
+			tag.WorkflowID(state.ExecutionInfo.WorkflowId),
+			tag.WorkflowRunID(state.ExecutionState.RunId),
+		)
+		return fmt.Errorf("verification failed for execution %v/%v/%v on shard %v",
+			state.ExecutionInfo.NamespaceId,
+			state.ExecutionInfo.WorkflowId,
+			state.ExecutionState.RunId,
+			targetShardID,
+		)
+	}
+	return nil
+}
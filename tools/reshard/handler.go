@@ -0,0 +1,289 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reshard
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/urfave/cli"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/resolver"
+)
+
+const (
+	// memberHeartbeatWindow is the window used to detect running history hosts
+	memberHeartbeatWindow = time.Minute
+)
+
+type (
+	// stores bundles the persistence objects used by the tool
+	stores struct {
+		clusterName            string
+		serializer             serialization.Serializer
+		factory                client.Factory
+		dataStoreFactory       client.DataStoreFactory
+		executionManager       persistence.ExecutionManager
+		shardManager           persistence.ShardManager
+		clusterMetadataManager persistence.ClusterMetadataManager
+		// historyKeyedByShard is set if the default store keys history branches by shard
+		historyKeyedByShard bool
+	}
+)
+
+// migrate runs or resumes the resharding from the persisted shard count to the requested shard count
+func migrate(c *cli.Context, logger log.Logger) error {
+	targetShardCount := int32(c.Int(CLIFlagShards))
+	if targetShardCount <= 0 {
+		return fmt.Errorf("--%v must be a positive number", CLIFlagShards)
+	}
+
+	s, err := newStores(c, logger)
+	if err != nil {
+		logger.Error("Unable to initialize persistence.", tag.Error(err))
+		return err
+	}
+	defer s.close()
+
+	ctx := context.Background()
+	if !c.Bool(CLIFlagForce) {
+		if err := s.checkNoHistoryHosts(ctx); err != nil {
+			logger.Error("Refusing to reshard a running cluster.", tag.Error(err))
+			return err
+		}
+	}
+
+	checkpointPath := c.String(CLIFlagCheckpoint)
+	checkpoint, err := loadCheckpoint(checkpointPath)
+	if err != nil {
+		logger.Error("Unable to load checkpoint.", tag.Error(err))
+		return err
+	}
+	if checkpoint == nil {
+		metadata, err := s.getClusterMetadata(ctx)
+		if err != nil {
+			logger.Error("Unable to get cluster metadata.", tag.Error(err))
+			return err
+		}
+		if metadata.HistoryShardCount == targetShardCount {
+			logger.Info("Cluster already has the requested number of shards.", tag.NewInt32("shard-count", targetShardCount))
+			return nil
+		}
+		checkpoint = newCheckpoint(checkpointPath, metadata.HistoryShardCount, targetShardCount)
+		if err := checkpoint.save(); err != nil {
+			logger.Error("Unable to save checkpoint.", tag.Error(err))
+			return err
+		}
+	} else if checkpoint.TargetShardCount != targetShardCount {
+		err := fmt.Errorf("checkpoint %v was created for %v shards", checkpointPath, checkpoint.TargetShardCount)
+		logger.Error("Checkpoint does not match the requested number of shards.", tag.Error(err))
+		return err
+	}
+
+	logger.Info("Resharding cluster.",
+		tag.NewInt32("source-shard-count", checkpoint.SourceShardCount),
+		tag.NewInt32("target-shard-count", checkpoint.TargetShardCount),
+		tag.NewStringTag("phase", string(checkpoint.Phase)),
+		tag.ShardID(checkpoint.Shard),
+	)
+	resharder := NewResharder(
+		s.executionManager,
+		s.shardManager,
+		s.saveShardCount,
+		checkpoint,
+		c.Int(CLIFlagPageSize),
+		s.historyKeyedByShard,
+		logger,
+	)
+	if err := resharder.Run(ctx); err != nil {
+		logger.Error("Unable to reshard cluster, rerun the command to resume.", tag.Error(err))
+		return err
+	}
+	if err := resharder.Verify(ctx); err != nil {
+		logger.Error("Unable to verify resharding.", tag.Error(err))
+		return err
+	}
+	return nil
+}
+
+// verify checks the result of a completed resharding
+func verify(c *cli.Context, logger log.Logger) error {
+	checkpointPath := c.String(CLIFlagCheckpoint)
+	checkpoint, err := loadCheckpoint(checkpointPath)
+	if err != nil {
+		logger.Error("Unable to load checkpoint.", tag.Error(err))
+		return err
+	}
+	if checkpoint == nil {
+		err := fmt.Errorf("checkpoint %v does not exist", checkpointPath)
+		logger.Error("Unable to load checkpoint.", tag.Error(err))
+		return err
+	}
+
+	s, err := newStores(c, logger)
+	if err != nil {
+		logger.Error("Unable to initialize persistence.", tag.Error(err))
+		return err
+	}
+	defer s.close()
+
+	resharder := NewResharder(
+		s.executionManager,
+		s.shardManager,
+		s.saveShardCount,
+		checkpoint,
+		c.Int(CLIFlagPageSize),
+		s.historyKeyedByShard,
+		logger,
+	)
+	if err := resharder.Verify(context.Background()); err != nil {
+		logger.Error("Unable to verify resharding.", tag.Error(err))
+		return err
+	}
+	return nil
+}
+
+func newStores(c *cli.Context, logger log.Logger) (*stores, error) {
+	configDir := path.Join(c.GlobalString(CLIFlagRoot), c.GlobalString(CLIFlagConfig))
+	cfg, err := config.LoadConfig(c.GlobalString(CLIFlagEnv), configDir, c.GlobalString(CLIFlagZone))
+	if err != nil {
+		return nil, err
+	}
+
+	clusterName := cfg.ClusterMetadata.CurrentClusterName
+	serializer := serialization.NewSerializer()
+	dataStoreFactory, _ := client.DataStoreFactoryProvider(
+		client.ClusterName(clusterName),
+		resolver.NewNoopResolver(),
+		&cfg.Persistence,
+		nil,
+		logger,
+		nil,
+	)
+	factory := client.NewFactory(dataStoreFactory, &cfg.Persistence, nil, serializer, clusterName, nil, logger)
+
+	s := &stores{
+		clusterName:      clusterName,
+		serializer:       serializer,
+		factory:          factory,
+		dataStoreFactory: dataStoreFactory,
+		// SQL stores key history nodes and trees by shard, cassandra does not
+		historyKeyedByShard: cfg.Persistence.DataStores[cfg.Persistence.DefaultStore].SQL != nil,
+	}
+	if s.executionManager, err = factory.NewExecutionManager(); err != nil {
+		s.close()
+		return nil, err
+	}
+	if s.shardManager, err = factory.NewShardManager(); err != nil {
+		s.close()
+		return nil, err
+	}
+	if s.clusterMetadataManager, err = factory.NewClusterMetadataManager(); err != nil {
+		s.close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *stores) close() {
+	if s.executionManager != nil {
+		s.executionManager.Close()
+	}
+	if s.shardManager != nil {
+		s.shardManager.Close()
+	}
+	if s.clusterMetadataManager != nil {
+		s.clusterMetadataManager.Close()
+	}
+	s.factory.Close()
+}
+
+func (s *stores) getClusterMetadata(ctx context.Context) (*persistence.GetClusterMetadataResponse, error) {
+	return s.clusterMetadataManager.GetClusterMetadata(ctx, &persistence.GetClusterMetadataRequest{
+		ClusterName: s.clusterName,
+	})
+}
+
+// checkNoHistoryHosts returns an error if any history host has recently heartbeated
+func (s *stores) checkNoHistoryHosts(ctx context.Context) error {
+	resp, err := s.clusterMetadataManager.GetClusterMembers(ctx, &persistence.GetClusterMembersRequest{
+		LastHeartbeatWithin: memberHeartbeatWindow,
+		RoleEquals:          persistence.History,
+		PageSize:            1,
+	})
+	if err != nil {
+		return err
+	}
+	if len(resp.ActiveMembers) != 0 {
+		return fmt.Errorf("history host %v is still running, stop the history service or use --%v",
+			resp.ActiveMembers[0].RPCAddress, CLIFlagForce)
+	}
+	return nil
+}
+
+// saveShardCount persists the new history shard count of the current cluster. The shard
+// count is immutable through the cluster metadata manager, so the store is used directly.
+func (s *stores) saveShardCount(ctx context.Context, shardCount int32) error {
+	metadata, err := s.getClusterMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	if metadata.HistoryShardCount == shardCount {
+		return nil
+	}
+
+	metadata.HistoryShardCount = shardCount
+	blob, err := s.serializer.SerializeClusterMetadata(&metadata.ClusterMetadata, enumspb.ENCODING_TYPE_PROTO3)
+	if err != nil {
+		return err
+	}
+	store, err := s.dataStoreFactory.NewClusterMetadataStore()
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	applied, err := store.SaveClusterMetadata(ctx, &persistence.InternalSaveClusterMetadataRequest{
+		ClusterName:     s.clusterName,
+		ClusterMetadata: blob,
+		Version:         metadata.Version,
+	})
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return fmt.Errorf("cluster metadata was concurrently updated")
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reshard
+
+import (
+	"os"
+
+	"github.com/urfave/cli"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+const (
+	// CLIFlagRoot is the cli flag for the root directory of the execution environment
+	CLIFlagRoot = "root"
+	// CLIFlagConfig is the cli flag for the config directory
+	CLIFlagConfig = "config"
+	// CLIFlagEnv is the cli flag for the runtime environment
+	CLIFlagEnv = "env"
+	// CLIFlagZone is the cli flag for the availability zone
+	CLIFlagZone = "zone"
+	// CLIFlagShards is the cli flag for the target number of history shards
+	CLIFlagShards = "shards"
+	// CLIFlagCheckpoint is the cli flag for the checkpoint file
+	CLIFlagCheckpoint = "checkpoint"
+	// CLIFlagPageSize is the cli flag for the persistence page size
+	CLIFlagPageSize = "page-size"
+	// CLIFlagForce is the cli flag to skip the running history host check
+	CLIFlagForce = "force"
+	// CLIFlagQuiet is the cli flag for quiet mode
+	CLIFlagQuiet = "quiet"
+)
+
+const (
+	defaultCheckpoint = "reshard.checkpoint.json"
+	defaultPageSize   = 100
+)
+
+// RunTool runs the temporal-reshard-tool command line tool
+func RunTool(args []string) error {
+	app := BuildCLIOptions()
+	return app.Run(args)
+}
+
+// root handler for all cli commands
+func cliHandler(c *cli.Context, handler func(c *cli.Context, logger log.Logger) error, logger log.Logger) {
+	quiet := c.GlobalBool(CLIFlagQuiet)
+	err := handler(c, logger)
+	if err != nil && !quiet {
+		os.Exit(1)
+	}
+}
+
+// BuildCLIOptions builds the options for cli
+func BuildCLIOptions() *cli.App {
+
+	app := cli.NewApp()
+	app.Name = "temporal-reshard-tool"
+	app.Usage = "Command line tool to change the number of history shards of a temporal cluster"
+	app.Version = "0.0.1"
+
+	logger := log.NewCLILogger()
+
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:   CLIFlagRoot + ", r",
+			Value:  ".",
+			Usage:  "root directory of execution environment",
+			EnvVar: config.EnvKeyRoot,
+		},
+		cli.StringFlag{
+			Name:   CLIFlagConfig + ", c",
+			Value:  "config",
+			Usage:  "config dir path relative to root",
+			EnvVar: config.EnvKeyConfigDir,
+		},
+		cli.StringFlag{
+			Name:   CLIFlagEnv + ", e",
+			Value:  "development",
+			Usage:  "runtime environment",
+			EnvVar: config.EnvKeyEnvironment,
+		},
+		cli.StringFlag{
+			Name:   CLIFlagZone + ", az",
+			Usage:  "availability zone",
+			EnvVar: config.EnvKeyAvailabilityZone,
+		},
+		cli.BoolFlag{
+			Name:  CLIFlagQuiet,
+			Usage: "Don't set exit status to 1 on error",
+		},
+	}
+
+	checkpointFlag := cli.StringFlag{
+		Name:  CLIFlagCheckpoint,
+		Value: defaultCheckpoint,
+		Usage: "path to the checkpoint file used to resume and verify the resharding",
+	}
+	pageSizeFlag := cli.IntFlag{
+		Name:  CLIFlagPageSize,
+		Value: defaultPageSize,
+		Usage: "number of executions, history batches or tasks read per persistence request",
+	}
+
+	app.Commands = []cli.Command{
+		{
+			Name:  "migrate",
+			Usage: "migrate executions, current executions, history tasks and shard info to a new number of history shards",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  CLIFlagShards,
+					Usage: "target number of history shards",
+				},
+				checkpointFlag,
+				pageSizeFlag,
+				cli.BoolFlag{
+					Name:  CLIFlagForce,
+					Usage: "skip the check for running history hosts",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, migrate, logger)
+			},
+		},
+		{
+			Name:  "verify",
+			Usage: "verify a completed resharding",
+			Flags: []cli.Flag{
+				checkpointFlag,
+				pageSizeFlag,
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, verify, logger)
+			},
+		},
+	}
+
+	return app
+}
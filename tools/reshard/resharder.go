@@ -0,0 +1,680 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reshard
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+const (
+	// shardOwner is the owner recorded on shards acquired by the tool
+	shardOwner = "temporal-reshard-tool"
+	// defaultRangeSizeBits matches the history service default, 2^20 task IDs per range
+	defaultRangeSizeBits = 20
+)
+
+var (
+	maxScheduledTaskTime = time.Unix(0, math.MaxInt64).UTC()
+	minScheduledTaskTime = time.Unix(0, 0).UTC()
+)
+
+type (
+	// Resharder migrates executions, current executions, history tasks and shard info
+	// of a cluster from N history shards to M history shards. Executions are routed
+	// with common.WorkflowIDToHistoryShard, so the result is identical to a cluster
+	// created with M shards.
+	//
+	// Since the N source shards and the M target shards share the shard ID space,
+	// executions are first copied to the staging shards N+1..N+M, the source shards are
+	// then purged, and finally every staging shard N+t is moved onto shard t.
+	// All phases are resumable through the checkpoint. The history service must not
+	// be running while the resharding is in progress.
+	Resharder struct {
+		executionManager persistence.ExecutionManager
+		shardManager     persistence.ShardManager
+		saveShardCount   func(ctx context.Context, shardCount int32) error
+		checkpoint       *checkpoint
+		pageSize         int
+		rangeSizeBits    uint
+		logger           log.Logger
+
+		// deleteHistoryBranches is set if history branches are keyed by shard in the
+		// underlying store, so the branches of purged executions can be deleted
+		deleteHistoryBranches bool
+
+		shards map[int32]*targetShard
+	}
+
+	// targetShard tracks the range acquired on a shard written to by the tool
+	targetShard struct {
+		shardInfo  *persistencespb.ShardInfo
+		nextTaskID int64
+		maxTaskID  int64
+	}
+)
+
+// NewResharder creates a new Resharder
+func NewResharder(
+	executionManager persistence.ExecutionManager,
+	shardManager persistence.ShardManager,
+	saveShardCount func(ctx context.Context, shardCount int32) error,
+	checkpoint *checkpoint,
+	pageSize int,
+	deleteHistoryBranches bool,
+	logger log.Logger,
+) *Resharder {
+	return &Resharder{
+		executionManager:      executionManager,
+		shardManager:          shardManager,
+		saveShardCount:        saveShardCount,
+		checkpoint:            checkpoint,
+		pageSize:              pageSize,
+		rangeSizeBits:         defaultRangeSizeBits,
+		logger:                logger,
+		deleteHistoryBranches: deleteHistoryBranches,
+		shards:                make(map[int32]*targetShard),
+	}
+}
+
+// Run executes the resharding starting from the checkpoint
+func (r *Resharder) Run(ctx context.Context) error {
+	for {
+		var err error
+		c := r.checkpoint
+		switch c.Phase {
+		case phaseCopy:
+			if c.Shard > c.SourceShardCount {
+				r.logger.Info("Copied all source shards to staging shards.", tag.Counter(int(c.stats(phaseCopy).Executions)))
+				c.advancePhase(phasePurge)
+				break
+			}
+			err = r.copyShard(ctx, c.Shard, func(namespaceID string, workflowID string) int32 {
+				return r.stagingShardID(common.WorkflowIDToHistoryShard(namespaceID, workflowID, c.TargetShardCount))
+			})
+
+		case phasePurge:
+			if c.Shard > c.SourceShardCount {
+				r.logger.Info("Purged all source shards.")
+				c.advancePhase(phaseMove)
+				break
+			}
+			err = r.purgeShard(ctx, c.Shard)
+
+		case phaseMove:
+			if c.Shard > c.TargetShardCount {
+				r.logger.Info("Moved all staging shards.", tag.Counter(int(c.stats(phaseMove).Executions)))
+				c.advancePhase(phaseFinalize)
+				break
+			}
+			shardID := c.Shard
+			if c.Step == stepPurgeExecutions || c.Step == stepPurgeTasks {
+				err = r.purgeShard(ctx, r.stagingShardID(shardID))
+				break
+			}
+			err = r.copyShard(ctx, r.stagingShardID(shardID), func(_ string, _ string) int32 {
+				return shardID
+			})
+
+		case phaseFinalize:
+			err = r.finalize(ctx)
+			if err == nil {
+				c.advancePhase(phaseDone)
+			}
+
+		case phaseDone:
+			r.logger.Info("Resharding completed.", tag.NewInt32("shard-count", c.TargetShardCount))
+			return nil
+
+		default:
+			return fmt.Errorf("unknown resharding phase: %v", c.Phase)
+		}
+
+		if err != nil {
+			return err
+		}
+		if err := c.save(); err != nil {
+			return err
+		}
+	}
+}
+
+// stagingShardID returns the staging shard used for the given target shard
+func (r *Resharder) stagingShardID(shardID int32) int32 {
+	return r.checkpoint.SourceShardCount + shardID
+}
+
+// copyShard copies executions and history tasks of the given shard, executions are routed
+// to their target shard by route
+func (r *Resharder) copyShard(
+	ctx context.Context,
+	shardID int32,
+	route func(namespaceID string, workflowID string) int32,
+) error {
+	c := r.checkpoint
+	switch c.Step {
+	case stepExecutions:
+		if c.Phase == phaseCopy && len(c.PageToken) == 0 {
+			if err := r.recordSourceShardInfo(ctx, shardID); err != nil {
+				return err
+			}
+		}
+		resp, err := r.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+			ShardID:   shardID,
+			PageSize:  r.pageSize,
+			PageToken: c.PageToken,
+		})
+		if err != nil {
+			return err
+		}
+		for _, state := range resp.States {
+			targetShardID := route(state.ExecutionInfo.NamespaceId, state.ExecutionInfo.WorkflowId)
+			if err := r.copyExecution(ctx, shardID, targetShardID, state); err != nil {
+				return err
+			}
+			c.stats(c.Phase).Executions++
+		}
+		c.PageToken = resp.PageToken
+		if len(c.PageToken) == 0 {
+			c.advanceStep(stepTasks, firstCategoryID())
+		}
+		return nil
+
+	case stepTasks:
+		category, ok := tasks.GetCategoryByID(c.CategoryID)
+		if !ok {
+			if c.Phase == phaseMove {
+				// staging shard is purged right after it has been moved
+				c.advanceStep(stepPurgeExecutions, 0)
+			} else {
+				c.advanceShard()
+			}
+			return nil
+		}
+		minTaskKey, err := r.sourceAckLevel(ctx, shardID, category)
+		if err != nil {
+			return err
+		}
+		resp, err := r.executionManager.GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
+			ShardID:             shardID,
+			TaskCategory:        category,
+			InclusiveMinTaskKey: minTaskKey,
+			ExclusiveMaxTaskKey: maxTaskKey(category),
+			BatchSize:           r.pageSize,
+			NextPageToken:       c.PageToken,
+		})
+		if err != nil {
+			return err
+		}
+		// task IDs of the page are reserved and saved before the page is copied, so that an
+		// interrupted page is copied again with the same task IDs and already copied tasks are skipped
+		isResumed := c.PageTaskIDs != nil
+		if !isResumed {
+			if err := r.reservePageTaskIDs(ctx, resp.Tasks, route); err != nil {
+				return err
+			}
+			if err := c.save(); err != nil {
+				return err
+			}
+		}
+		nextTaskIDs := make(map[int32]int64, len(c.PageTaskIDs))
+		for targetShardID, taskID := range c.PageTaskIDs {
+			nextTaskIDs[targetShardID] = taskID
+		}
+		for _, task := range resp.Tasks {
+			targetShardID := route(task.GetNamespaceID(), task.GetWorkflowID())
+			taskID, ok := nextTaskIDs[targetShardID]
+			if !ok {
+				return fmt.Errorf("no task IDs reserved on shard %v for the task page", targetShardID)
+			}
+			nextTaskIDs[targetShardID]++
+			task.SetTaskID(taskID)
+			if err := r.copyTask(ctx, targetShardID, category, task, isResumed); err != nil {
+				return err
+			}
+			c.stats(c.Phase).Tasks++
+		}
+		c.PageTaskIDs = nil
+		c.PageToken = resp.NextPageToken
+		if len(c.PageToken) == 0 {
+			c.advanceStep(stepTasks, nextCategoryID(category.ID()))
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown resharding step: %v", c.Step)
+	}
+}
+
+// purgeShard deletes executions, current executions and history tasks of the given shard.
+// History branches of the executions are only deleted if the underlying store keys them
+// by shard, otherwise they are shared with the copies on the target shards.
+func (r *Resharder) purgeShard(
+	ctx context.Context,
+	shardID int32,
+) error {
+	c := r.checkpoint
+	switch c.Step {
+	case stepExecutions, stepPurgeExecutions:
+		// deleted executions disappear from the listing, so always read the first page
+		resp, err := r.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+			ShardID:  shardID,
+			PageSize: r.pageSize,
+		})
+		if err != nil {
+			return err
+		}
+		for _, state := range resp.States {
+			if err := r.deleteExecution(ctx, shardID, state); err != nil {
+				return err
+			}
+		}
+		if len(resp.States) == 0 {
+			c.advanceStep(stepPurgeTasks, 0)
+		}
+		return nil
+
+	case stepPurgeTasks:
+		for _, category := range sortedCategories() {
+			if err := r.executionManager.RangeCompleteHistoryTasks(ctx, &persistence.RangeCompleteHistoryTasksRequest{
+				ShardID:             shardID,
+				TaskCategory:        category,
+				InclusiveMinTaskKey: minTaskKey(category),
+				ExclusiveMaxTaskKey: maxTaskKey(category),
+			}); err != nil {
+				return err
+			}
+		}
+		c.advanceShard()
+		return nil
+
+	default:
+		return fmt.Errorf("unknown resharding step: %v", c.Step)
+	}
+}
+
+func (r *Resharder) deleteExecution(
+	ctx context.Context,
+	shardID int32,
+	state *persistencespb.WorkflowMutableState,
+) error {
+	namespaceID := state.ExecutionInfo.NamespaceId
+	workflowID := state.ExecutionInfo.WorkflowId
+	runID := state.ExecutionState.RunId
+
+	// branches are deleted first so that an interrupted purge still finds them
+	if r.deleteHistoryBranches {
+		if err := r.deleteHistory(ctx, shardID, state); err != nil {
+			return err
+		}
+	}
+
+	// current execution is only deleted if it points to the given run
+	if err := r.executionManager.DeleteCurrentWorkflowExecution(ctx, &persistence.DeleteCurrentWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+		RunID:       runID,
+	}); err != nil {
+		return err
+	}
+	return r.executionManager.DeleteWorkflowExecution(ctx, &persistence.DeleteWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+		RunID:       runID,
+	})
+}
+
+func (r *Resharder) deleteHistory(
+	ctx context.Context,
+	shardID int32,
+	state *persistencespb.WorkflowMutableState,
+) error {
+	deletedBranches := make(map[string]struct{})
+	for _, versionHistory := range state.ExecutionInfo.GetVersionHistories().GetHistories() {
+		branchToken := versionHistory.GetBranchToken()
+		if _, ok := deletedBranches[string(branchToken)]; ok {
+			continue
+		}
+		if err := r.executionManager.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
+			ShardID:     shardID,
+			BranchToken: branchToken,
+		}); err != nil {
+			return err
+		}
+		deletedBranches[string(branchToken)] = struct{}{}
+	}
+	return nil
+}
+
+// finalize resets the info of all target shards and persists the new shard count
+func (r *Resharder) finalize(ctx context.Context) error {
+	c := r.checkpoint
+	for shardID := int32(1); shardID <= c.TargetShardCount; shardID++ {
+		shard, err := r.getTargetShard(ctx, shardID)
+		if err != nil {
+			return err
+		}
+		shard.shardInfo.NamespaceNotificationVersion = c.NamespaceNotificationVersion
+		if err := r.renewRange(ctx, shard); err != nil {
+			return err
+		}
+	}
+	return r.saveShardCount(ctx, c.TargetShardCount)
+}
+
+func (r *Resharder) recordSourceShardInfo(
+	ctx context.Context,
+	shardID int32,
+) error {
+	resp, err := r.shardManager.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{
+		ShardID: shardID,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.ShardInfo.NamespaceNotificationVersion > r.checkpoint.NamespaceNotificationVersion {
+		r.checkpoint.NamespaceNotificationVersion = resp.ShardInfo.NamespaceNotificationVersion
+	}
+	return nil
+}
+
+// sourceAckLevel returns the min ack level of the given shard and category,
+// tasks below the ack level have already been processed and are not copied
+func (r *Resharder) sourceAckLevel(
+	ctx context.Context,
+	shardID int32,
+	category tasks.Category,
+) (tasks.Key, error) {
+	if r.checkpoint.Phase != phaseCopy {
+		// staging shards are always processed from the beginning
+		return minTaskKey(category), nil
+	}
+
+	resp, err := r.shardManager.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{
+		ShardID: shardID,
+	})
+	if err != nil {
+		return tasks.Key{}, err
+	}
+	shardInfo := resp.ShardInfo
+
+	var ackLevels []int64
+	if queueAckLevel, ok := shardInfo.QueueAckLevels[category.ID()]; ok && queueAckLevel.AckLevel != 0 {
+		ackLevels = append(ackLevels, queueAckLevel.AckLevel)
+		for _, ackLevel := range queueAckLevel.ClusterAckLevel {
+			ackLevels = append(ackLevels, ackLevel)
+		}
+	} else {
+		// backward compatibility
+		switch category {
+		case tasks.CategoryTransfer:
+			ackLevels = append(ackLevels, shardInfo.TransferAckLevel)
+			for _, ackLevel := range shardInfo.ClusterTransferAckLevel {
+				ackLevels = append(ackLevels, ackLevel)
+			}
+		case tasks.CategoryTimer:
+			ackLevels = append(ackLevels, timestamp.TimeValue(shardInfo.TimerAckLevelTime).UnixNano())
+			for _, ackLevel := range shardInfo.ClusterTimerAckLevel {
+				ackLevels = append(ackLevels, timestamp.TimeValue(ackLevel).UnixNano())
+			}
+		case tasks.CategoryReplication:
+			ackLevels = append(ackLevels, shardInfo.ReplicationAckLevel)
+		case tasks.CategoryVisibility:
+			ackLevels = append(ackLevels, shardInfo.VisibilityAckLevel)
+		}
+	}
+
+	key := minTaskKey(category)
+	if len(ackLevels) == 0 {
+		return key, nil
+	}
+	sort.Slice(ackLevels, func(i, j int) bool { return ackLevels[i] < ackLevels[j] })
+	if ackLevels[0] <= 0 {
+		return key, nil
+	}
+	if category.Type() == tasks.CategoryTypeImmediate {
+		return tasks.Key{TaskID: ackLevels[0]}, nil
+	}
+	return tasks.Key{FireTime: timestamp.UnixOrZeroTime(ackLevels[0])}, nil
+}
+
+// getTargetShard returns the target shard, acquiring it from persistence if necessary.
+// Acquiring a shard resets its ack levels so that all copied tasks are processed by
+// the history service.
+func (r *Resharder) getTargetShard(
+	ctx context.Context,
+	shardID int32,
+) (*targetShard, error) {
+	if shard, ok := r.shards[shardID]; ok {
+		return shard, nil
+	}
+
+	resp, err := r.shardManager.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{
+		ShardID: shardID,
+		InitialShardInfo: &persistencespb.ShardInfo{
+			ShardId: shardID,
+			RangeId: 0,
+			Owner:   shardOwner,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	shard := &targetShard{
+		shardInfo: &persistencespb.ShardInfo{
+			ShardId:                      shardID,
+			RangeId:                      resp.ShardInfo.RangeId,
+			Owner:                        shardOwner,
+			NamespaceNotificationVersion: resp.ShardInfo.NamespaceNotificationVersion,
+		},
+	}
+	if err := r.renewRange(ctx, shard); err != nil {
+		return nil, err
+	}
+	r.shards[shardID] = shard
+	return shard, nil
+}
+
+func (r *Resharder) renewRange(
+	ctx context.Context,
+	shard *targetShard,
+) error {
+	previousRangeID := shard.shardInfo.RangeId
+	shard.shardInfo.RangeId++
+	shard.shardInfo.UpdateTime = timestamp.TimePtr(time.Now().UTC())
+	if err := r.shardManager.UpdateShard(ctx, &persistence.UpdateShardRequest{
+		ShardInfo:       shard.shardInfo,
+		PreviousRangeID: previousRangeID,
+	}); err != nil {
+		shard.shardInfo.RangeId = previousRangeID
+		return err
+	}
+	shard.nextTaskID = shard.shardInfo.RangeId << r.rangeSizeBits
+	shard.maxTaskID = (shard.shardInfo.RangeId + 1) << r.rangeSizeBits
+	return nil
+}
+
+// reservePageTaskIDs reserves consecutive task IDs on the target shards of the given
+// tasks and records the first reserved task ID of every target shard in the checkpoint
+func (r *Resharder) reservePageTaskIDs(
+	ctx context.Context,
+	pageTasks []tasks.Task,
+	route func(namespaceID string, workflowID string) int32,
+) error {
+	taskCounts := make(map[int32]int64)
+	for _, task := range pageTasks {
+		taskCounts[route(task.GetNamespaceID(), task.GetWorkflowID())]++
+	}
+
+	pageTaskIDs := make(map[int32]int64, len(taskCounts))
+	for targetShardID, count := range taskCounts {
+		shard, err := r.getTargetShard(ctx, targetShardID)
+		if err != nil {
+			return err
+		}
+		if count > int64(1)<<r.rangeSizeBits {
+			return fmt.Errorf("task page of %v tasks exceeds the range size of shard %v", count, targetShardID)
+		}
+		if shard.nextTaskID+count > shard.maxTaskID {
+			if err := r.renewRange(ctx, shard); err != nil {
+				return err
+			}
+		}
+		pageTaskIDs[targetShardID] = shard.nextTaskID
+		shard.nextTaskID += count
+	}
+	r.checkpoint.PageTaskIDs = pageTaskIDs
+	return nil
+}
+
+// copyTask adds the task to the target shard. If skipExisting is set, the task is
+// skipped if a task with the same key has already been added to the target shard.
+func (r *Resharder) copyTask(
+	ctx context.Context,
+	targetShardID int32,
+	category tasks.Category,
+	task tasks.Task,
+	skipExisting bool,
+) error {
+	shard, err := r.getTargetShard(ctx, targetShardID)
+	if err != nil {
+		return err
+	}
+	if skipExisting {
+		exists, err := r.taskExists(ctx, targetShardID, category, task)
+		if err != nil || exists {
+			return err
+		}
+	}
+
+	return r.executionManager.AddHistoryTasks(ctx, &persistence.AddHistoryTasksRequest{
+		ShardID:     targetShardID,
+		RangeID:     shard.shardInfo.RangeId,
+		NamespaceID: task.GetNamespaceID(),
+		WorkflowID:  task.GetWorkflowID(),
+		RunID:       task.GetRunID(),
+		Tasks:       map[tasks.Category][]tasks.Task{category: {task}},
+	})
+}
+
+// taskExists returns true if the shard has a task with the task ID of the given task.
+// Scheduled tasks are only ranged by fire time, so they are matched by task ID.
+func (r *Resharder) taskExists(
+	ctx context.Context,
+	shardID int32,
+	category tasks.Category,
+	task tasks.Task,
+) (bool, error) {
+	minKey := tasks.Key{TaskID: task.GetTaskID()}
+	maxKey := tasks.Key{TaskID: task.GetTaskID() + 1}
+	if category.Type() == tasks.CategoryTypeScheduled {
+		minKey = tasks.Key{FireTime: task.GetVisibilityTime()}
+		maxKey = tasks.Key{FireTime: task.GetVisibilityTime().Add(time.Millisecond)}
+	}
+
+	var pageToken []byte
+	for {
+		resp, err := r.executionManager.GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
+			ShardID:             shardID,
+			TaskCategory:        category,
+			InclusiveMinTaskKey: minKey,
+			ExclusiveMaxTaskKey: maxKey,
+			BatchSize:           r.pageSize,
+			NextPageToken:       pageToken,
+		})
+		if err != nil {
+			return false, err
+		}
+		for _, existing := range resp.Tasks {
+			if existing.GetTaskID() == task.GetTaskID() {
+				return true, nil
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return false, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+func sortedCategories() []tasks.Category {
+	categories := tasks.GetCategories()
+	result := make([]tasks.Category, 0, len(categories))
+	for _, category := range categories {
+		result = append(result, category)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID() < result[j].ID() })
+	return result
+}
+
+func firstCategoryID() int32 {
+	return nextCategoryID(tasks.CategoryIDUnspecified)
+}
+
+// nextCategoryID returns the smallest registered category ID greater than the given one,
+// or tasks.CategoryIDUnspecified if there is none
+func nextCategoryID(categoryID int32) int32 {
+	for _, category := range sortedCategories() {
+		if category.ID() > categoryID {
+			return category.ID()
+		}
+	}
+	return tasks.CategoryIDUnspecified
+}
+
+func minTaskKey(category tasks.Category) tasks.Key {
+	if category.Type() == tasks.CategoryTypeImmediate {
+		return tasks.Key{TaskID: 0}
+	}
+	return tasks.Key{FireTime: minScheduledTaskTime}
+}
+
+func maxTaskKey(category tasks.Category) tasks.Key {
+	if category.Type() == tasks.CategoryTypeImmediate {
+		return tasks.Key{TaskID: math.MaxInt64}
+	}
+	return tasks.Key{FireTime: maxScheduledTaskTime}
+}
+
+func isNotFound(err error) bool {
+	_, ok := err.(*serviceerror.NotFound)
+	return ok
+}
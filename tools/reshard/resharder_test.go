@@ -0,0 +1,479 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reshard
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+type (
+	resharderSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller           *gomock.Controller
+		mockExecutionManager *persistence.MockExecutionManager
+		mockShardManager     *persistence.MockShardManager
+
+		checkpoint *checkpoint
+		resharder  *Resharder
+	}
+)
+
+const (
+	testSourceShardCount = 2
+	testTargetShardCount = 4
+)
+
+func TestResharderSuite(t *testing.T) {
+	s := new(resharderSuite)
+	suite.Run(t, s)
+}
+
+func (s *resharderSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockExecutionManager = persistence.NewMockExecutionManager(s.controller)
+	s.mockShardManager = persistence.NewMockShardManager(s.controller)
+
+	s.checkpoint = newCheckpoint(
+		filepath.Join(s.T().TempDir(), defaultCheckpoint),
+		testSourceShardCount,
+		testTargetShardCount,
+	)
+	s.resharder = NewResharder(
+		s.mockExecutionManager,
+		s.mockShardManager,
+		func(_ context.Context, _ int32) error { return nil },
+		s.checkpoint,
+		defaultPageSize,
+		false,
+		log.NewNoopLogger(),
+	)
+}
+
+func (s *resharderSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *resharderSuite) TestCopyShard_Executions() {
+	ctx := context.Background()
+	sourceShardID := int32(1)
+	state := s.newMutableState()
+	namespaceID := state.ExecutionInfo.NamespaceId
+	workflowID := state.ExecutionInfo.WorkflowId
+	runID := state.ExecutionState.RunId
+	stagingShardID := testSourceShardCount + common.WorkflowIDToHistoryShard(namespaceID, workflowID, testTargetShardCount)
+
+	s.mockShardManager.EXPECT().GetOrCreateShard(gomock.Any(), &persistence.GetOrCreateShardRequest{
+		ShardID: sourceShardID,
+	}).Return(&persistence.GetOrCreateShardResponse{
+		ShardInfo: &persistencespb.ShardInfo{ShardId: sourceShardID, NamespaceNotificationVersion: 5},
+	}, nil)
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:  sourceShardID,
+		PageSize: defaultPageSize,
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{state},
+	}, nil)
+	s.mockExecutionManager.EXPECT().GetCurrentExecution(gomock.Any(), &persistence.GetCurrentExecutionRequest{
+		ShardID:     sourceShardID,
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+	}).Return(&persistence.GetCurrentExecutionResponse{RunID: runID}, nil)
+	s.mockShardManager.EXPECT().GetOrCreateShard(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.GetOrCreateShardRequest) (*persistence.GetOrCreateShardResponse, error) {
+			s.Equal(stagingShardID, request.ShardID)
+			return &persistence.GetOrCreateShardResponse{
+				ShardInfo: &persistencespb.ShardInfo{ShardId: stagingShardID, RangeId: 3},
+			}, nil
+		},
+	)
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateShardRequest) error {
+			s.Equal(int64(3), request.PreviousRangeID)
+			s.Equal(int64(4), request.ShardInfo.RangeId)
+			s.Empty(request.ShardInfo.QueueAckLevels)
+			return nil
+		},
+	)
+	getRequest := &persistence.GetWorkflowExecutionRequest{
+		ShardID:     stagingShardID,
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+		RunID:       runID,
+	}
+	s.mockExecutionManager.EXPECT().GetWorkflowExecution(gomock.Any(), getRequest).Return(nil, serviceerror.NewNotFound(""))
+	s.mockExecutionManager.EXPECT().ReadHistoryBranchByBatch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.ReadHistoryBranchRequest) (*persistence.ReadHistoryBranchByBatchResponse, error) {
+			s.Equal(sourceShardID, request.ShardID)
+			s.Equal(common.FirstEventID, request.MinEventID)
+			return &persistence.ReadHistoryBranchByBatchResponse{
+				History:        []*historypb.History{{Events: []*historypb.HistoryEvent{{EventId: common.FirstEventID}}}},
+				TransactionIDs: []int64{10},
+			}, nil
+		},
+	)
+	s.mockExecutionManager.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.AppendHistoryNodesRequest) (*persistence.AppendHistoryNodesResponse, error) {
+			s.Equal(stagingShardID, request.ShardID)
+			s.True(request.IsNewBranch)
+			s.Equal(int64(10), request.TransactionID)
+			s.Equal(int64(0), request.PrevTransactionID)
+			return &persistence.AppendHistoryNodesResponse{}, nil
+		},
+	)
+	s.mockExecutionManager.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.CreateWorkflowExecutionRequest) (*persistence.CreateWorkflowExecutionResponse, error) {
+			s.Equal(stagingShardID, request.ShardID)
+			s.Equal(int64(4), request.RangeID)
+			s.Equal(persistence.CreateWorkflowModeBrandNew, request.Mode)
+			s.Equal(state.ExecutionState, request.NewWorkflowSnapshot.ExecutionState)
+			return &persistence.CreateWorkflowExecutionResponse{}, nil
+		},
+	)
+	s.mockExecutionManager.EXPECT().GetWorkflowExecution(gomock.Any(), getRequest).Return(&persistence.GetWorkflowExecutionResponse{
+		State:           state,
+		DBRecordVersion: 1,
+	}, nil)
+
+	err := s.resharder.copyShard(ctx, sourceShardID, func(namespaceID string, workflowID string) int32 {
+		return s.resharder.stagingShardID(common.WorkflowIDToHistoryShard(namespaceID, workflowID, testTargetShardCount))
+	})
+	s.NoError(err)
+	s.Equal(stepTasks, s.checkpoint.Step)
+	s.Equal(firstCategoryID(), s.checkpoint.CategoryID)
+	s.Equal(int64(1), s.checkpoint.stats(phaseCopy).Executions)
+	s.Equal(int64(5), s.checkpoint.NamespaceNotificationVersion)
+}
+
+func (s *resharderSuite) TestCopyShard_NonCurrentExecution() {
+	ctx := context.Background()
+	sourceShardID := int32(1)
+	targetShardID := int32(3)
+	state := s.newMutableState()
+	state.ExecutionState.State = enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED
+	state.ExecutionState.Status = enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	s.resharder.shards[targetShardID] = &targetShard{
+		shardInfo: &persistencespb.ShardInfo{ShardId: targetShardID, RangeId: 1},
+	}
+
+	s.mockExecutionManager.EXPECT().GetCurrentExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetCurrentExecutionResponse{
+		RunID: primitives.NewUUID().String(),
+	}, nil)
+	s.mockExecutionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound(""))
+	s.mockExecutionManager.EXPECT().ReadHistoryBranchByBatch(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound(""))
+	s.mockExecutionManager.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.CreateWorkflowExecutionRequest) (*persistence.CreateWorkflowExecutionResponse, error) {
+			s.Equal(persistence.CreateWorkflowModeZombie, request.Mode)
+			s.Equal(enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE, request.NewWorkflowSnapshot.ExecutionState.State)
+			s.Equal(int64(1), request.NewWorkflowSnapshot.DBRecordVersion)
+			return &persistence.CreateWorkflowExecutionResponse{}, nil
+		},
+	)
+	s.mockExecutionManager.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+			s.Equal(persistence.UpdateWorkflowModeBypassCurrent, request.Mode)
+			s.Equal(enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, request.UpdateWorkflowMutation.ExecutionState.State)
+			s.Equal(int64(2), request.UpdateWorkflowMutation.DBRecordVersion)
+			return &persistence.UpdateWorkflowExecutionResponse{}, nil
+		},
+	)
+	s.mockExecutionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{
+		State:           state,
+		DBRecordVersion: 2,
+	}, nil)
+
+	err := s.resharder.copyExecution(ctx, sourceShardID, targetShardID, state)
+	s.NoError(err)
+}
+
+func (s *resharderSuite) TestCopyExecution_VerificationFailure() {
+	ctx := context.Background()
+	targetShardID := int32(3)
+	state := s.newMutableState()
+	s.resharder.shards[targetShardID] = &targetShard{
+		shardInfo: &persistencespb.ShardInfo{ShardId: targetShardID, RangeId: 1},
+	}
+
+	copiedState := s.newMutableState()
+	copiedState.ExecutionInfo = state.ExecutionInfo
+	copiedState.ExecutionState = state.ExecutionState
+	copiedState.NextEventId = state.NextEventId + 1
+
+	s.mockExecutionManager.EXPECT().GetCurrentExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetCurrentExecutionResponse{
+		RunID: state.ExecutionState.RunId,
+	}, nil)
+	s.mockExecutionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{
+		State:           copiedState,
+		DBRecordVersion: 1,
+	}, nil).Times(2)
+
+	err := s.resharder.copyExecution(ctx, 1, targetShardID, state)
+	s.Error(err)
+}
+
+func (s *resharderSuite) TestCopyShard_Tasks() {
+	ctx := context.Background()
+	sourceShardID := int32(1)
+	targetShardID := int32(3)
+	s.checkpoint.advanceStep(stepTasks, tasks.CategoryIDTransfer)
+
+	s.mockShardManager.EXPECT().GetOrCreateShard(gomock.Any(), &persistence.GetOrCreateShardRequest{
+		ShardID: sourceShardID,
+	}).Return(&persistence.GetOrCreateShardResponse{
+		ShardInfo: &persistencespb.ShardInfo{
+			ShardId: sourceShardID,
+			QueueAckLevels: map[int32]*persistencespb.QueueAckLevel{
+				tasks.CategoryIDTransfer: {
+					AckLevel:        100,
+					ClusterAckLevel: map[string]int64{"standby": 80},
+				},
+			},
+		},
+	}, nil)
+	task := &tasks.WorkflowTask{
+		WorkflowKey: definition.NewWorkflowKey(primitives.NewUUID().String(), "workflow-id", primitives.NewUUID().String()),
+		TaskID:      123,
+	}
+	s.mockExecutionManager.EXPECT().GetHistoryTasks(gomock.Any(), &persistence.GetHistoryTasksRequest{
+		ShardID:             sourceShardID,
+		TaskCategory:        tasks.CategoryTransfer,
+		InclusiveMinTaskKey: tasks.Key{TaskID: 80},
+		ExclusiveMaxTaskKey: maxTaskKey(tasks.CategoryTransfer),
+		BatchSize:           defaultPageSize,
+	}).Return(&persistence.GetHistoryTasksResponse{
+		Tasks: []tasks.Task{task},
+	}, nil)
+	s.mockShardManager.EXPECT().GetOrCreateShard(gomock.Any(), gomock.Any()).Return(&persistence.GetOrCreateShardResponse{
+		ShardInfo: &persistencespb.ShardInfo{ShardId: targetShardID, RangeId: 1},
+	}, nil)
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil)
+	s.mockExecutionManager.EXPECT().AddHistoryTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.AddHistoryTasksRequest) error {
+			s.Equal(targetShardID, request.ShardID)
+			s.Equal(int64(2), request.RangeID)
+			s.Len(request.Tasks[tasks.CategoryTransfer], 1)
+			s.Equal(int64(2)<<defaultRangeSizeBits, request.Tasks[tasks.CategoryTransfer][0].GetTaskID())
+			return nil
+		},
+	)
+
+	err := s.resharder.copyShard(ctx, sourceShardID, func(_ string, _ string) int32 {
+		return targetShardID
+	})
+	s.NoError(err)
+	s.Equal(stepTasks, s.checkpoint.Step)
+	s.Equal(nextCategoryID(tasks.CategoryIDTransfer), s.checkpoint.CategoryID)
+	s.Equal(int64(1), s.checkpoint.stats(phaseCopy).Tasks)
+}
+
+func (s *resharderSuite) TestCopyShard_ResumedTasks() {
+	ctx := context.Background()
+	sourceShardID := int32(1)
+	targetShardID := int32(3)
+	reservedTaskID := int64(1) << defaultRangeSizeBits
+	s.checkpoint.advanceStep(stepTasks, tasks.CategoryIDTransfer)
+	s.checkpoint.PageToken = []byte("page-token")
+	s.checkpoint.PageTaskIDs = map[int32]int64{targetShardID: reservedTaskID}
+
+	s.mockShardManager.EXPECT().GetOrCreateShard(gomock.Any(), &persistence.GetOrCreateShardRequest{
+		ShardID: sourceShardID,
+	}).Return(&persistence.GetOrCreateShardResponse{
+		ShardInfo: &persistencespb.ShardInfo{ShardId: sourceShardID},
+	}, nil)
+	copiedTask := &tasks.WorkflowTask{
+		WorkflowKey: definition.NewWorkflowKey(primitives.NewUUID().String(), "workflow-id", primitives.NewUUID().String()),
+		TaskID:      123,
+	}
+	pendingTask := &tasks.WorkflowTask{
+		WorkflowKey: definition.NewWorkflowKey(primitives.NewUUID().String(), "workflow-id", primitives.NewUUID().String()),
+		TaskID:      124,
+	}
+	s.mockExecutionManager.EXPECT().GetHistoryTasks(gomock.Any(), &persistence.GetHistoryTasksRequest{
+		ShardID:             sourceShardID,
+		TaskCategory:        tasks.CategoryTransfer,
+		InclusiveMinTaskKey: minTaskKey(tasks.CategoryTransfer),
+		ExclusiveMaxTaskKey: maxTaskKey(tasks.CategoryTransfer),
+		BatchSize:           defaultPageSize,
+		NextPageToken:       []byte("page-token"),
+	}).Return(&persistence.GetHistoryTasksResponse{
+		Tasks: []tasks.Task{copiedTask, pendingTask},
+	}, nil)
+	s.mockShardManager.EXPECT().GetOrCreateShard(gomock.Any(), gomock.Any()).Return(&persistence.GetOrCreateShardResponse{
+		ShardInfo: &persistencespb.ShardInfo{ShardId: targetShardID, RangeId: 1},
+	}, nil)
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil)
+
+	// the first task has been copied by the interrupted run
+	s.mockExecutionManager.EXPECT().GetHistoryTasks(gomock.Any(), &persistence.GetHistoryTasksRequest{
+		ShardID:             targetShardID,
+		TaskCategory:        tasks.CategoryTransfer,
+		InclusiveMinTaskKey: tasks.Key{TaskID: reservedTaskID},
+		ExclusiveMaxTaskKey: tasks.Key{TaskID: reservedTaskID + 1},
+		BatchSize:           defaultPageSize,
+	}).Return(&persistence.GetHistoryTasksResponse{
+		Tasks: []tasks.Task{&tasks.WorkflowTask{TaskID: reservedTaskID}},
+	}, nil)
+	s.mockExecutionManager.EXPECT().GetHistoryTasks(gomock.Any(), &persistence.GetHistoryTasksRequest{
+		ShardID:             targetShardID,
+		TaskCategory:        tasks.CategoryTransfer,
+		InclusiveMinTaskKey: tasks.Key{TaskID: reservedTaskID + 1},
+		ExclusiveMaxTaskKey: tasks.Key{TaskID: reservedTaskID + 2},
+		BatchSize:           defaultPageSize,
+	}).Return(&persistence.GetHistoryTasksResponse{}, nil)
+	s.mockExecutionManager.EXPECT().AddHistoryTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.AddHistoryTasksRequest) error {
+			s.Equal(targetShardID, request.ShardID)
+			s.Len(request.Tasks[tasks.CategoryTransfer], 1)
+			s.Equal(pendingTask.WorkflowID, request.Tasks[tasks.CategoryTransfer][0].GetWorkflowID())
+			s.Equal(reservedTaskID+1, request.Tasks[tasks.CategoryTransfer][0].GetTaskID())
+			return nil
+		},
+	)
+
+	err := s.resharder.copyShard(ctx, sourceShardID, func(_ string, _ string) int32 {
+		return targetShardID
+	})
+	s.NoError(err)
+	s.Nil(s.checkpoint.PageTaskIDs)
+	s.Equal(nextCategoryID(tasks.CategoryIDTransfer), s.checkpoint.CategoryID)
+	s.Equal(int64(2), s.checkpoint.stats(phaseCopy).Tasks)
+}
+
+func (s *resharderSuite) TestPurgeShard() {
+	ctx := context.Background()
+	shardID := int32(1)
+	state := s.newMutableState()
+	s.checkpoint.advancePhase(phasePurge)
+
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{state},
+	}, nil)
+	s.mockExecutionManager.EXPECT().DeleteCurrentWorkflowExecution(gomock.Any(), &persistence.DeleteCurrentWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: state.ExecutionInfo.NamespaceId,
+		WorkflowID:  state.ExecutionInfo.WorkflowId,
+		RunID:       state.ExecutionState.RunId,
+	}).Return(nil)
+	s.mockExecutionManager.EXPECT().DeleteWorkflowExecution(gomock.Any(), &persistence.DeleteWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: state.ExecutionInfo.NamespaceId,
+		WorkflowID:  state.ExecutionInfo.WorkflowId,
+		RunID:       state.ExecutionState.RunId,
+	}).Return(nil)
+	s.NoError(s.resharder.purgeShard(ctx, shardID))
+	s.Equal(stepExecutions, s.checkpoint.Step)
+
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{}, nil)
+	s.NoError(s.resharder.purgeShard(ctx, shardID))
+	s.Equal(stepPurgeTasks, s.checkpoint.Step)
+
+	s.mockExecutionManager.EXPECT().RangeCompleteHistoryTasks(gomock.Any(), gomock.Any()).Return(nil).Times(len(tasks.GetCategories()))
+	s.NoError(s.resharder.purgeShard(ctx, shardID))
+	s.Equal(int32(2), s.checkpoint.Shard)
+	s.Equal(stepExecutions, s.checkpoint.Step)
+}
+
+func (s *resharderSuite) TestPurgeShard_DeleteHistoryBranches() {
+	ctx := context.Background()
+	shardID := int32(1)
+	state := s.newMutableState()
+	s.checkpoint.advancePhase(phasePurge)
+	s.resharder.deleteHistoryBranches = true
+
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{state},
+	}, nil)
+	gomock.InOrder(
+		s.mockExecutionManager.EXPECT().DeleteHistoryBranch(gomock.Any(), &persistence.DeleteHistoryBranchRequest{
+			ShardID:     shardID,
+			BranchToken: state.ExecutionInfo.VersionHistories.Histories[0].BranchToken,
+		}).Return(nil),
+		s.mockExecutionManager.EXPECT().DeleteCurrentWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil),
+		s.mockExecutionManager.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil),
+	)
+	s.NoError(s.resharder.purgeShard(ctx, shardID))
+	s.Equal(stepExecutions, s.checkpoint.Step)
+}
+
+func (s *resharderSuite) TestCheckpoint_SaveLoad() {
+	s.checkpoint.advancePhase(phaseMove)
+	s.checkpoint.advanceStep(stepTasks, tasks.CategoryIDTimer)
+	s.checkpoint.PageToken = []byte("page-token")
+	s.checkpoint.stats(phaseCopy).Executions = 10
+	s.NoError(s.checkpoint.save())
+
+	loaded, err := loadCheckpoint(s.checkpoint.path)
+	s.NoError(err)
+	s.Equal(s.checkpoint, loaded)
+
+	loaded, err = loadCheckpoint(filepath.Join(s.T().TempDir(), "missing.json"))
+	s.NoError(err)
+	s.Nil(loaded)
+}
+
+func (s *resharderSuite) newMutableState() *persistencespb.WorkflowMutableState {
+	runID := primitives.NewUUID().String()
+	branchToken, err := persistence.NewHistoryBranchToken(runID)
+	s.NoError(err)
+
+	return &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId: primitives.NewUUID().String(),
+			WorkflowId:  "workflow-id",
+			VersionHistories: &historyspb.VersionHistories{
+				Histories: []*historyspb.VersionHistory{{BranchToken: branchToken}},
+			},
+			ExecutionStats: &persistencespb.ExecutionStats{},
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			RunId:  runID,
+			State:  enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+			Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+		NextEventId: 2,
+	}
+}
@@ -0,0 +1,120 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reshard
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+)
+
+// Verify checks a completed resharding: every execution lives on the shard computed by
+// common.WorkflowIDToHistoryShard, every current execution record points to an existing
+// run, the staging and the removed source shards are empty, and the number of executions
+// matches the number of executions copied from the source shards.
+func (r *Resharder) Verify(ctx context.Context) error {
+	c := r.checkpoint
+	if c.Phase != phaseDone {
+		return fmt.Errorf("resharding has not completed, current phase: %v", c.Phase)
+	}
+
+	var executions int64
+	var mismatches int
+	for shardID := int32(1); shardID <= c.TargetShardCount; shardID++ {
+		var pageToken []byte
+		for {
+			resp, err := r.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+				ShardID:   shardID,
+				PageSize:  r.pageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return err
+			}
+			for _, state := range resp.States {
+				executions++
+				namespaceID := state.ExecutionInfo.NamespaceId
+				workflowID := state.ExecutionInfo.WorkflowId
+				logger := log.With(r.logger,
+					tag.ShardID(shardID),
+					tag.WorkflowNamespaceID(namespaceID),
+					tag.WorkflowID(workflowID),
+					tag.WorkflowRunID(state.ExecutionState.RunId),
+				)
+
+				if expectedShardID := common.WorkflowIDToHistoryShard(namespaceID, workflowID, c.TargetShardCount); expectedShardID != shardID {
+					logger.Error("Execution is on the wrong shard.", tag.NewInt32("expected-shard-id", expectedShardID))
+					mismatches++
+					continue
+				}
+				if _, err := r.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+					ShardID:     shardID,
+					NamespaceID: namespaceID,
+					WorkflowID:  workflowID,
+				}); err != nil {
+					logger.Error("Unable to get current execution.", tag.Error(err))
+					mismatches++
+				}
+			}
+			pageToken = resp.PageToken
+			if len(pageToken) == 0 {
+				break
+			}
+		}
+	}
+
+	// staging shards and source shards beyond the new shard count must be empty
+	for shardID := c.TargetShardCount + 1; shardID <= c.SourceShardCount+c.TargetShardCount; shardID++ {
+		resp, err := r.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+			ShardID:  shardID,
+			PageSize: 1,
+		})
+		if err != nil {
+			return err
+		}
+		if len(resp.States) != 0 {
+			r.logger.Error("Shard is expected to be empty.", tag.ShardID(shardID))
+			mismatches++
+		}
+	}
+
+	if expected := c.stats(phaseCopy).Executions; executions != expected {
+		r.logger.Error("Number of executions does not match.",
+			tag.NewInt64("expected", expected),
+			tag.NewInt64("actual", executions),
+		)
+		mismatches++
+	}
+
+	if mismatches != 0 {
+		return fmt.Errorf("verification failed with %v mismatches", mismatches)
+	}
+	r.logger.Info("Verification succeeded.", tag.Counter(int(executions)))
+	return nil
+}